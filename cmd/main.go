@@ -14,10 +14,13 @@ import (
 	authmodule "github.com/KasumiMercury/primind-central-backend/internal/auth"
 	authrepository "github.com/KasumiMercury/primind-central-backend/internal/auth/infra/repository"
 	"github.com/KasumiMercury/primind-central-backend/internal/config"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataerasure"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
 	devicemodule "github.com/KasumiMercury/primind-central-backend/internal/device"
 	"github.com/KasumiMercury/primind-central-backend/internal/health"
 	deviceconfig "github.com/KasumiMercury/primind-central-backend/internal/device/config"
 	devicerepository "github.com/KasumiMercury/primind-central-backend/internal/device/infra/repository"
+	"github.com/KasumiMercury/primind-central-backend/internal/idempotency"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/middleware"
 	taskmodule "github.com/KasumiMercury/primind-central-backend/internal/task"
@@ -25,6 +28,7 @@ import (
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
 	taskrepository "github.com/KasumiMercury/primind-central-backend/internal/task/infra/repository"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 	"connectrpc.com/grpchealth"
 	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
@@ -154,13 +158,38 @@ func run() error {
 		return err
 	}
 
-	authPath, authHandler, err := authmodule.NewHTTPHandler(ctx, authmodule.Repositories{
-		Params:       authrepository.NewOIDCParamsRepository(redisClient),
-		Sessions:     authrepository.NewSessionRepository(redisClient),
-		Users:        authrepository.NewUserRepository(db),
-		OIDCIdentity: authrepository.NewOIDCIdentityRepository(db),
-		UserIdentity: authrepository.NewUserWithIdentityRepository(db),
-	})
+	if err := authrepository.BackfillSessionIndex(ctx, redisClient); err != nil {
+		slog.ErrorContext(ctx, "failed to backfill session index",
+			slog.String("event", "session_index.backfill.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	authPath, authHandler, err := authmodule.NewHTTPHandler(
+		ctx,
+		authmodule.Repositories{
+			Params:       authrepository.NewOIDCParamsRepository(redisClient),
+			Sessions:     authrepository.NewSessionRepository(redisClient),
+			Users:        authrepository.NewUserRepository(db),
+			OIDCIdentity: authrepository.NewOIDCIdentityRepository(db),
+			UserIdentity: authrepository.NewUserWithIdentityRepository(db),
+			DeletionJobs: authrepository.NewDeletionJobRepository(db),
+		},
+		authmodule.AccountData{
+			Exporters: []dataexport.Exporter{
+				authrepository.NewDataExporter(db, redisClient),
+				devicerepository.NewDataExporter(db),
+				taskrepository.NewDataExporter(db),
+			},
+			ErasureSteps: []dataerasure.Step{
+				{Name: "task_reminders", Eraser: taskrepository.NewReminderEraser(db, taskrepository.NewOutboxRemindCancelQueue(db))},
+				{Name: "task_data", Eraser: taskrepository.NewDataEraser(db)},
+				{Name: "devices", Eraser: devicerepository.NewDataEraser(db)},
+			},
+		},
+	)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to initialize auth service",
 			slog.String("event", "auth.init.fail"),
@@ -192,21 +221,104 @@ func run() error {
 		return err
 	}
 
+	taskEvents := taskevent.NewRedisBroker(redisClient, taskevent.DefaultWatcherBuffer)
+	idempotencyStore := idempotency.NewRedisStore(redisClient, idempotency.DefaultTTL)
+
 	taskRepos := taskmodule.Repositories{
 		Tasks:               taskrepository.NewTaskRepository(db),
 		TaskArchive:         taskrepository.NewTaskArchiveRepository(db),
+		TaskSearch:          taskrepository.NewTaskSearchRepository(db),
+		TaskSync:            taskrepository.NewTaskSyncRepository(db),
+		TaskStats:           taskrepository.NewTaskStatsRepository(db),
+		Checklists:          taskrepository.NewChecklistRepository(db),
+		Tags:                taskrepository.NewTagRepository(db),
+		CalendarFeedTokens:  taskrepository.NewCalendarFeedTokenRepository(db),
 		PeriodSettings:      taskrepository.NewPeriodSettingRepository(db),
+		ReminderIntervals:   taskrepository.NewReminderIntervalSettingRepository(db),
+		QuietHours:          taskrepository.NewQuietHoursSettingRepository(db),
 		AuthClient:          authclient.NewAuthClient(taskCfg.AuthServiceURL),
 		DeviceClient:        deviceclient.NewDeviceClient(taskCfg.DeviceServiceURL),
-		RemindRegisterQueue: remindQueue,
-		RemindCancelQueue:   cancelRemindQueue,
+		RemindRegisterQueue: taskrepository.NewOutboxRemindRegisterQueue(db),
+		RemindCancelQueue:   taskrepository.NewOutboxRemindCancelQueue(db),
 		TaskQueueClient:     taskQueueClient,
+		TaskEvents:          taskEvents,
+		Idempotency:         idempotencyStore,
+		Transactor:          taskrepository.NewTransactor(db),
+	}
+
+	remindOutboxDispatcher, err := taskmodule.NewRemindOutboxDispatcher(
+		taskrepository.NewRemindOutboxStore(db),
+		remindQueue,
+		cancelRemindQueue,
+		&taskCfg.RemindOutbox,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize remind outbox dispatcher",
+			slog.String("event", "remind_outbox.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	pendingReminderReconciler, err := taskmodule.NewPendingReminderReconciler(
+		taskrepository.NewPendingReminderStore(db),
+		&taskCfg.PendingReminder,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize pending reminder reconciler",
+			slog.String("event", "pending_reminder.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	trashPurger, err := taskmodule.NewTrashPurger(
+		taskrepository.NewTrashPurgeStore(db),
+		&taskCfg.Trash,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize trash purger",
+			slog.String("event", "trash_purge.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
 	}
 
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.WithoutCancel(ctx))
+	dispatcherDone := make(chan struct{})
+
+	go func() {
+		defer close(dispatcherDone)
+
+		remindOutboxDispatcher.Run(dispatcherCtx)
+	}()
+
+	reconcilerCtx, stopReconciler := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopReconciler()
+
+	go pendingReminderReconciler.Run(reconcilerCtx)
+
+	purgerCtx, stopPurger := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopPurger()
+
+	go trashPurger.Run(purgerCtx)
+
+	taskEventsCtx, stopTaskEvents := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopTaskEvents()
+
+	go taskEvents.Run(taskEventsCtx)
+
 	var closeTaskReposOnce sync.Once
 
 	closeTaskRepos := func() {
 		closeTaskReposOnce.Do(func() {
+			// The dispatcher sends through the task queue client, so it has to stop before the client is closed.
+			stopDispatcher()
+			<-dispatcherDone
+
 			if err := taskRepos.Close(); err != nil {
 				slog.Warn("failed to close task repositories", slog.String("error", err.Error()))
 			}
@@ -227,6 +339,54 @@ func run() error {
 
 	mux.Handle(taskPath, taskHandler)
 
+	completedTaskPath, completedTaskHandler, err := taskmodule.NewCompletedTaskServiceHandler(ctx, taskRepos)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize completed task service",
+			slog.String("event", "completed_task.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	mux.Handle(completedTaskPath, completedTaskHandler)
+
+	trashPath, trashHandler, err := taskmodule.NewTrashServiceHandler(ctx, taskRepos)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize trash service",
+			slog.String("event", "trash.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	mux.Handle(trashPath, trashHandler)
+
+	checklistPath, checklistHandler, err := taskmodule.NewChecklistServiceHandler(ctx, taskRepos)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize checklist service",
+			slog.String("event", "checklist.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	mux.Handle(checklistPath, checklistHandler)
+
+	tagPath, tagHandler, err := taskmodule.NewTagServiceHandler(ctx, taskRepos)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize tag service",
+			slog.String("event", "tag.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	mux.Handle(tagPath, tagHandler)
+
 	periodPath, periodHandler, err := taskmodule.NewPeriodSettingsServiceHandler(ctx, taskRepos)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to initialize period settings service",
@@ -239,6 +399,30 @@ func run() error {
 
 	mux.Handle(periodPath, periodHandler)
 
+	calendarFeedPath, calendarFeedHandler, err := taskmodule.NewCalendarFeedServiceHandler(ctx, taskRepos)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize calendar feed service",
+			slog.String("event", "calendar_feed.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	mux.Handle(calendarFeedPath, calendarFeedHandler)
+
+	calendarFeedPattern, calendarFeedHTTPHandler, err := taskmodule.NewCalendarFeedHTTPHandler(ctx, taskRepos)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to initialize calendar feed http handler",
+			slog.String("event", "calendar_feed_http.init.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	mux.Handle(calendarFeedPattern, calendarFeedHTTPHandler)
+
 	deviceCfg, err := deviceconfig.Load()
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to load device config",
@@ -252,6 +436,7 @@ func run() error {
 	devicePath, deviceHandler, err := devicemodule.NewHTTPHandler(
 		ctx,
 		devicerepository.NewDeviceRepository(db),
+		idempotencyStore,
 		deviceCfg.AuthServiceURL,
 	)
 	if err != nil {
@@ -302,6 +487,10 @@ func run() error {
 		ReadHeaderTimeout: 5 * time.Second,
 	}
 
+	// Streaming handlers lift the read and write timeouts for themselves, so stop the task
+	// event broker on shutdown to end open watch streams instead of waiting them out.
+	server.RegisterOnShutdown(stopTaskEvents)
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -0,0 +1,194 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/deletionjob"
+	domainsession "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/session"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/infra/clock"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataerasure"
+)
+
+const (
+	stepAuthUsers    = "auth_users"
+	stepAuthSessions = "auth_sessions"
+)
+
+type DeleteAccountRequest struct {
+	SessionToken string
+}
+
+type DeleteAccountResult struct {
+	CompletedSteps []string
+}
+
+type DeleteAccountUseCase interface {
+	DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResult, error)
+}
+
+type deleteAccountHandler struct {
+	validateSession appsession.ValidateSessionUseCase
+	jobRepo         deletionjob.DeletionJobRepository
+	steps           []dataerasure.Step
+	clock           clock.Clock
+	logger          *slog.Logger
+}
+
+func newDeleteAccountHandler(
+	validateSession appsession.ValidateSessionUseCase,
+	jobRepo deletionjob.DeletionJobRepository,
+	userRepo user.UserRepository,
+	sessionRepo domainsession.SessionRepository,
+	moduleSteps []dataerasure.Step,
+	clk clock.Clock,
+) DeleteAccountUseCase {
+	// The auth user goes after the data of the other modules. Sessions go last, so a
+	// run that fails part way can still be retried with the caller's session, even
+	// once the auth user itself is gone.
+	steps := make([]dataerasure.Step, 0, len(moduleSteps)+2)
+	steps = append(steps, moduleSteps...)
+	steps = append(steps,
+		dataerasure.Step{
+			Name: stepAuthUsers,
+			Eraser: dataerasure.EraserFunc(func(ctx context.Context, userID string) error {
+				id, err := user.NewIDFromString(userID)
+				if err != nil {
+					return err
+				}
+
+				return userRepo.DeleteUser(ctx, id)
+			}),
+		},
+		dataerasure.Step{
+			Name: stepAuthSessions,
+			Eraser: dataerasure.EraserFunc(func(ctx context.Context, userID string) error {
+				id, err := user.NewIDFromString(userID)
+				if err != nil {
+					return err
+				}
+
+				return sessionRepo.DeleteSessionsByUserID(ctx, id)
+			}),
+		},
+	)
+
+	return &deleteAccountHandler{
+		validateSession: validateSession,
+		jobRepo:         jobRepo,
+		steps:           steps,
+		clock:           clk,
+		logger:          slog.Default().With(slog.String("module", "auth")).WithGroup("auth").WithGroup("account").WithGroup("delete"),
+	}
+}
+
+// NewDeleteAccountHandler returns a use case that deletes the caller's account. The
+// module steps run first, in order, followed by the auth user and its sessions. Every
+// step is recorded in a deletion job once it succeeds, so calling DeleteAccount again
+// after a failure resumes where the last run stopped.
+func NewDeleteAccountHandler(
+	validateSession appsession.ValidateSessionUseCase,
+	jobRepo deletionjob.DeletionJobRepository,
+	userRepo user.UserRepository,
+	sessionRepo domainsession.SessionRepository,
+	moduleSteps ...dataerasure.Step,
+) DeleteAccountUseCase {
+	return newDeleteAccountHandler(validateSession, jobRepo, userRepo, sessionRepo, moduleSteps, &clock.RealClock{})
+}
+
+func (h *deleteAccountHandler) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResult, error) {
+	if req == nil {
+		return nil, ErrRequestNil
+	}
+
+	session, err := h.validateSession.Validate(ctx, &appsession.ValidateSessionRequest{
+		SessionToken:     req.SessionToken,
+		AllowDeletedUser: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := h.jobRepo.GetDeletionJob(ctx, session.UserID)
+	if errors.Is(err, deletionjob.ErrDeletionJobNotFound) {
+		// Only a deletion that already removed the user may go on without it
+		if session.UserDeleted {
+			h.logger.Info("session belongs to a deleted user without a deletion job")
+
+			return nil, appsession.ErrSessionNotFound
+		}
+
+		job = deletionjob.NewJob(session.UserID, h.clock.Now())
+	} else if err != nil {
+		h.logger.Error("failed to load deletion job", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("failed to load deletion job: %w", err)
+	}
+
+	if job.IsCompleted() {
+		return &DeleteAccountResult{CompletedSteps: job.CompletedSteps()}, nil
+	}
+
+	job.Start(h.clock.Now())
+
+	if err := h.jobRepo.SaveDeletionJob(ctx, job); err != nil {
+		h.logger.Error("failed to save deletion job", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("failed to save deletion job: %w", err)
+	}
+
+	logger := h.logger.With(slog.Int("attempt", job.Attempts()))
+	userID := session.UserID.String()
+
+	for _, step := range h.steps {
+		if job.IsStepCompleted(step.Name) {
+			continue
+		}
+
+		if err := step.Eraser.EraseUserData(ctx, userID); err != nil {
+			logger.Error("account deletion step failed",
+				slog.String("step", step.Name),
+				slog.String("error", err.Error()),
+			)
+
+			job.Fail(step.Name, err, h.clock.Now())
+
+			if saveErr := h.jobRepo.SaveDeletionJob(ctx, job); saveErr != nil {
+				logger.Error("failed to record failed deletion step", slog.String("error", saveErr.Error()))
+			}
+
+			return nil, fmt.Errorf("%w: step %s: %v", ErrAccountDeletionIncomplete, step.Name, err)
+		}
+
+		if err := job.CompleteStep(step.Name, h.clock.Now()); err != nil {
+			return nil, err
+		}
+
+		if err := h.jobRepo.SaveDeletionJob(ctx, job); err != nil {
+			logger.Error("failed to record deletion step",
+				slog.String("step", step.Name),
+				slog.String("error", err.Error()),
+			)
+
+			return nil, fmt.Errorf("%w: failed to record step %s: %v", ErrAccountDeletionIncomplete, step.Name, err)
+		}
+
+		logger.Debug("account deletion step completed", slog.String("step", step.Name))
+	}
+
+	job.Complete(h.clock.Now())
+
+	if err := h.jobRepo.SaveDeletionJob(ctx, job); err != nil {
+		logger.Error("failed to complete deletion job", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("%w: failed to complete job: %v", ErrAccountDeletionIncomplete, err)
+	}
+
+	logger.Info("account deleted", slog.Int("steps", len(h.steps)))
+
+	return &DeleteAccountResult{CompletedSteps: job.CompletedSteps()}, nil
+}
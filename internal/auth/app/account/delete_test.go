@@ -0,0 +1,300 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/deletionjob"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/infra/clock"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataerasure"
+	"go.uber.org/mock/gomock"
+)
+
+type memoryJobRepo struct {
+	jobs    map[user.ID]*deletionjob.Job
+	saveErr error
+}
+
+func newMemoryJobRepo() *memoryJobRepo {
+	return &memoryJobRepo{jobs: make(map[user.ID]*deletionjob.Job), saveErr: nil}
+}
+
+func (r *memoryJobRepo) GetDeletionJob(_ context.Context, userID user.ID) (*deletionjob.Job, error) {
+	job, ok := r.jobs[userID]
+	if !ok {
+		return nil, deletionjob.ErrDeletionJobNotFound
+	}
+
+	return deletionjob.RestoreJob(job.UserID(), job.Status(), job.CompletedSteps(), job.Attempts(), job.LastError(), job.CreatedAt(), job.UpdatedAt(), job.CompletedAt())
+}
+
+func (r *memoryJobRepo) SaveDeletionJob(_ context.Context, job *deletionjob.Job) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+
+	r.jobs[job.UserID()] = job
+
+	return nil
+}
+
+type recordingEraser struct {
+	calls []string
+	err   error
+}
+
+func (e *recordingEraser) EraseUserData(_ context.Context, userID string) error {
+	e.calls = append(e.calls, userID)
+
+	return e.err
+}
+
+func TestDeleteAccountSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user id: %v", err)
+	}
+
+	validateSession := NewMockValidateSessionUseCase(ctrl)
+	validateSession.EXPECT().
+		Validate(gomock.Any(), &appsession.ValidateSessionRequest{SessionToken: "token", AllowDeletedUser: true}).
+		Return(&appsession.ValidateSessionResult{UserID: userID}, nil)
+
+	var order []string
+
+	userRepo := NewMockUserRepository(ctrl)
+	userRepo.EXPECT().DeleteUser(gomock.Any(), userID).DoAndReturn(func(context.Context, user.ID) error {
+		order = append(order, "auth_users")
+
+		return nil
+	})
+
+	sessionRepo := NewMockSessionRepository(ctrl)
+	sessionRepo.EXPECT().DeleteSessionsByUserID(gomock.Any(), userID).DoAndReturn(func(context.Context, user.ID) error {
+		order = append(order, "auth_sessions")
+
+		return nil
+	})
+
+	tasks := dataerasure.EraserFunc(func(_ context.Context, id string) error {
+		if id != userID.String() {
+			t.Errorf("expected user id %s, got %s", userID.String(), id)
+		}
+
+		order = append(order, "tasks")
+
+		return nil
+	})
+
+	jobRepo := newMemoryJobRepo()
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	handler := newDeleteAccountHandler(validateSession, jobRepo, userRepo, sessionRepo,
+		[]dataerasure.Step{{Name: "tasks", Eraser: tasks}}, clock.NewFixedClock(now))
+
+	result, err := handler.DeleteAccount(context.Background(), &DeleteAccountRequest{SessionToken: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"tasks", "auth_users", "auth_sessions"}
+	if !slices.Equal(order, want) || !slices.Equal(result.CompletedSteps, want) {
+		t.Fatalf("expected steps %v, ran %v and reported %v", want, order, result.CompletedSteps)
+	}
+
+	job := jobRepo.jobs[userID]
+	if job == nil || !job.IsCompleted() || job.Attempts() != 1 || job.CompletedAt() == nil {
+		t.Fatalf("expected a completed job, got %+v", job)
+	}
+}
+
+func TestDeleteAccountResumesAfterFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userID, _ := user.NewID()
+
+	validateSession := NewMockValidateSessionUseCase(ctrl)
+	validateSession.EXPECT().
+		Validate(gomock.Any(), gomock.Any()).
+		Return(&appsession.ValidateSessionResult{UserID: userID}, nil).
+		Times(3)
+
+	userRepo := NewMockUserRepository(ctrl)
+	userRepo.EXPECT().DeleteUser(gomock.Any(), userID).Return(nil)
+
+	sessionRepo := NewMockSessionRepository(ctrl)
+	sessionRepo.EXPECT().DeleteSessionsByUserID(gomock.Any(), userID).Return(nil)
+
+	tasks := &recordingEraser{calls: nil, err: nil}
+	devices := &recordingEraser{calls: nil, err: errors.New("database unavailable")}
+
+	jobRepo := newMemoryJobRepo()
+	handler := newDeleteAccountHandler(validateSession, jobRepo, userRepo, sessionRepo, []dataerasure.Step{
+		{Name: "tasks", Eraser: tasks},
+		{Name: "devices", Eraser: devices},
+	}, clock.NewFixedClock(time.Now()))
+
+	_, err := handler.DeleteAccount(context.Background(), &DeleteAccountRequest{SessionToken: "token"})
+	if !errors.Is(err, ErrAccountDeletionIncomplete) {
+		t.Fatalf("expected ErrAccountDeletionIncomplete, got %v", err)
+	}
+
+	job := jobRepo.jobs[userID]
+	if job.Status() != deletionjob.StatusFailed || job.LastError() != "devices: database unavailable" {
+		t.Fatalf("expected the failure to be recorded, got %s %q", job.Status(), job.LastError())
+	}
+
+	devices.err = nil
+
+	result, err := handler.DeleteAccount(context.Background(), &DeleteAccountRequest{SessionToken: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+
+	if len(tasks.calls) != 1 || len(devices.calls) != 2 {
+		t.Fatalf("expected the retry to resume at the failed step, tasks ran %d times and devices %d times", len(tasks.calls), len(devices.calls))
+	}
+
+	if !slices.Equal(result.CompletedSteps, []string{"tasks", "devices", "auth_users", "auth_sessions"}) {
+		t.Fatalf("unexpected completed steps %v", result.CompletedSteps)
+	}
+
+	// A completed deletion is reported again without running any step
+	if _, err := handler.DeleteAccount(context.Background(), &DeleteAccountRequest{SessionToken: "token"}); err != nil {
+		t.Fatalf("unexpected error after completion: %v", err)
+	}
+
+	if len(tasks.calls) != 1 || len(devices.calls) != 2 || jobRepo.jobs[userID].Attempts() != 2 {
+		t.Fatalf("expected no step to run after completion")
+	}
+}
+
+func TestDeleteAccountResumesAfterUserDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userID, _ := user.NewID()
+
+	validateSession := NewMockValidateSessionUseCase(ctrl)
+	gomock.InOrder(
+		validateSession.EXPECT().
+			Validate(gomock.Any(), &appsession.ValidateSessionRequest{SessionToken: "token", AllowDeletedUser: true}).
+			Return(&appsession.ValidateSessionResult{UserID: userID, UserDeleted: false}, nil),
+		// The user is gone by the retry, but its sessions are still there
+		validateSession.EXPECT().
+			Validate(gomock.Any(), &appsession.ValidateSessionRequest{SessionToken: "token", AllowDeletedUser: true}).
+			Return(&appsession.ValidateSessionResult{UserID: userID, UserDeleted: true}, nil),
+	)
+
+	userRepo := NewMockUserRepository(ctrl)
+	userRepo.EXPECT().DeleteUser(gomock.Any(), userID).Return(nil)
+
+	sessionRepo := NewMockSessionRepository(ctrl)
+	gomock.InOrder(
+		sessionRepo.EXPECT().DeleteSessionsByUserID(gomock.Any(), userID).Return(errors.New("redis unavailable")),
+		sessionRepo.EXPECT().DeleteSessionsByUserID(gomock.Any(), userID).Return(nil),
+	)
+
+	tasks := &recordingEraser{calls: nil, err: nil}
+
+	jobRepo := newMemoryJobRepo()
+	handler := newDeleteAccountHandler(validateSession, jobRepo, userRepo, sessionRepo,
+		[]dataerasure.Step{{Name: "tasks", Eraser: tasks}}, clock.NewFixedClock(time.Now()))
+
+	_, err := handler.DeleteAccount(context.Background(), &DeleteAccountRequest{SessionToken: "token"})
+	if !errors.Is(err, ErrAccountDeletionIncomplete) {
+		t.Fatalf("expected ErrAccountDeletionIncomplete, got %v", err)
+	}
+
+	result, err := handler.DeleteAccount(context.Background(), &DeleteAccountRequest{SessionToken: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+
+	if len(tasks.calls) != 1 {
+		t.Fatalf("expected the tasks step to run once, ran %d times", len(tasks.calls))
+	}
+
+	if !slices.Equal(result.CompletedSteps, []string{"tasks", "auth_users", "auth_sessions"}) {
+		t.Fatalf("unexpected completed steps %v", result.CompletedSteps)
+	}
+
+	if job := jobRepo.jobs[userID]; !job.IsCompleted() || job.Attempts() != 2 {
+		t.Fatalf("expected a completed job after two attempts, got %+v", job)
+	}
+}
+
+func TestDeleteAccountError(t *testing.T) {
+	errStore := errors.New("store unavailable")
+
+	tests := []struct {
+		name    string
+		req     *DeleteAccountRequest
+		setup   func(validate *MockValidateSessionUseCase, jobRepo *memoryJobRepo)
+		wantErr error
+	}{
+		{
+			name:    "nil request",
+			req:     nil,
+			setup:   func(*MockValidateSessionUseCase, *memoryJobRepo) {},
+			wantErr: ErrRequestNil,
+		},
+		{
+			name: "invalid session",
+			req:  &DeleteAccountRequest{SessionToken: "token"},
+			setup: func(validate *MockValidateSessionUseCase, _ *memoryJobRepo) {
+				validate.EXPECT().
+					Validate(gomock.Any(), gomock.Any()).
+					Return(nil, appsession.ErrSessionNotFound)
+			},
+			wantErr: appsession.ErrSessionNotFound,
+		},
+		{
+			name: "deleted user without a deletion job",
+			req:  &DeleteAccountRequest{SessionToken: "token"},
+			setup: func(validate *MockValidateSessionUseCase, _ *memoryJobRepo) {
+				userID, _ := user.NewID()
+
+				validate.EXPECT().
+					Validate(gomock.Any(), gomock.Any()).
+					Return(&appsession.ValidateSessionResult{UserID: userID, UserDeleted: true}, nil)
+			},
+			wantErr: appsession.ErrSessionNotFound,
+		},
+		{
+			name: "job cannot be saved",
+			req:  &DeleteAccountRequest{SessionToken: "token"},
+			setup: func(validate *MockValidateSessionUseCase, jobRepo *memoryJobRepo) {
+				userID, _ := user.NewID()
+
+				validate.EXPECT().
+					Validate(gomock.Any(), gomock.Any()).
+					Return(&appsession.ValidateSessionResult{UserID: userID}, nil)
+
+				jobRepo.saveErr = errStore
+			},
+			wantErr: errStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			validate := NewMockValidateSessionUseCase(ctrl)
+			jobRepo := newMemoryJobRepo()
+			tt.setup(validate, jobRepo)
+
+			handler := newDeleteAccountHandler(validate, jobRepo, NewMockUserRepository(ctrl), NewMockSessionRepository(ctrl), nil, clock.NewFixedClock(time.Now()))
+
+			if _, err := handler.DeleteAccount(context.Background(), tt.req); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package account
+
+import (
+	"errors"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
+)
+
+var (
+	ErrRequestNil              = errors.New("request is required")
+	ErrUnsupportedExportFormat = dataexport.ErrUnsupportedFormat
+	// ErrAccountDeletionIncomplete means a deletion step failed. The steps that
+	// succeeded are recorded, so calling DeleteAccount again resumes the deletion.
+	ErrAccountDeletionIncomplete = errors.New("account deletion did not finish")
+)
@@ -0,0 +1,111 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+
+	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/infra/clock"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
+)
+
+type ExportMyDataRequest struct {
+	SessionToken string
+	// Format is "json" or "zip"; empty means JSON.
+	Format string
+}
+
+type ExportMyDataResult struct {
+	Data        []byte
+	ContentType string
+	FileName    string
+	Version     int32
+}
+
+type ExportMyDataUseCase interface {
+	ExportMyData(ctx context.Context, req *ExportMyDataRequest) (*ExportMyDataResult, error)
+}
+
+type exportMyDataHandler struct {
+	validateSession appsession.ValidateSessionUseCase
+	exporters       []dataexport.Exporter
+	clock           clock.Clock
+	logger          *slog.Logger
+}
+
+func newExportMyDataHandler(
+	validateSession appsession.ValidateSessionUseCase,
+	exporters []dataexport.Exporter,
+	clk clock.Clock,
+) ExportMyDataUseCase {
+	return &exportMyDataHandler{
+		validateSession: validateSession,
+		exporters:       exporters,
+		clock:           clk,
+		logger:          slog.Default().With(slog.String("module", "auth")).WithGroup("auth").WithGroup("account").WithGroup("export"),
+	}
+}
+
+// NewExportMyDataHandler returns a use case that bundles the data every exporter holds
+// about the caller. Each module contributes its own exporter, so the auth module never
+// reads another module's tables.
+func NewExportMyDataHandler(
+	validateSession appsession.ValidateSessionUseCase,
+	exporters ...dataexport.Exporter,
+) ExportMyDataUseCase {
+	return newExportMyDataHandler(validateSession, exporters, &clock.RealClock{})
+}
+
+func (h *exportMyDataHandler) ExportMyData(ctx context.Context, req *ExportMyDataRequest) (*ExportMyDataResult, error) {
+	if req == nil {
+		return nil, ErrRequestNil
+	}
+
+	format := dataexport.FormatJSON
+
+	if req.Format != "" {
+		parsed, err := dataexport.NewFormat(req.Format)
+		if err != nil {
+			return nil, err
+		}
+
+		format = parsed
+	}
+
+	session, err := h.validateSession.Validate(ctx, &appsession.ValidateSessionRequest{
+		SessionToken:     req.SessionToken,
+		AllowDeletedUser: false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := dataexport.Collect(ctx, session.UserID.String(), h.clock.Now(), h.exporters...)
+	if err != nil {
+		h.logger.Error("failed to collect export data", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("failed to export data: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := bundle.Write(&buf, format); err != nil {
+		h.logger.Error("failed to write export bundle", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("failed to write export: %w", err)
+	}
+
+	h.logger.Info("personal data exported",
+		slog.String("format", string(format)),
+		slog.Int("sections", len(bundle.Sections)),
+		slog.Int("bytes", buf.Len()),
+	)
+
+	return &ExportMyDataResult{
+		Data:        buf.Bytes(),
+		ContentType: format.ContentType(),
+		FileName:    bundle.FileName(format),
+		Version:     dataexport.Version,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/infra/clock"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportMyDataSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user id: %v", err)
+	}
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	validateSession := NewMockValidateSessionUseCase(ctrl)
+	validateSession.EXPECT().
+		Validate(gomock.Any(), &appsession.ValidateSessionRequest{SessionToken: "token"}).
+		Return(&appsession.ValidateSessionResult{UserID: userID}, nil)
+
+	authExporter := NewMockExporter(ctrl)
+	authExporter.EXPECT().
+		ExportUserData(gomock.Any(), userID.String()).
+		Return([]dataexport.Section{{Name: "auth_users", Records: []string{userID.String()}}}, nil)
+
+	taskExporter := NewMockExporter(ctrl)
+	taskExporter.EXPECT().
+		ExportUserData(gomock.Any(), userID.String()).
+		Return([]dataexport.Section{{Name: "tasks", Records: []string{}}}, nil)
+
+	handler := newExportMyDataHandler(validateSession, []dataexport.Exporter{authExporter, taskExporter}, clock.NewFixedClock(now))
+
+	result, err := handler.ExportMyData(context.Background(), &ExportMyDataRequest{
+		SessionToken: "token",
+		Format:       "",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ContentType != "application/json" || result.FileName != "primind-export-20261017T120000Z.json" {
+		t.Errorf("unexpected result metadata: %q %q", result.ContentType, result.FileName)
+	}
+
+	if result.Version != dataexport.Version {
+		t.Errorf("expected version %d, got %d", dataexport.Version, result.Version)
+	}
+
+	var decoded struct {
+		UserID   string              `json:"user_id"`
+		Sections []string            `json:"sections"`
+		Data     map[string][]string `json:"data"`
+	}
+	if err := json.Unmarshal(result.Data, &decoded); err != nil {
+		t.Fatalf("failed to decode export: %v", err)
+	}
+
+	if decoded.UserID != userID.String() || len(decoded.Sections) != 2 || decoded.Data["auth_users"][0] != userID.String() {
+		t.Errorf("unexpected export %+v", decoded)
+	}
+}
+
+func TestExportMyDataZIP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userID, _ := user.NewID()
+
+	validateSession := NewMockValidateSessionUseCase(ctrl)
+	validateSession.EXPECT().
+		Validate(gomock.Any(), gomock.Any()).
+		Return(&appsession.ValidateSessionResult{UserID: userID}, nil)
+
+	handler := newExportMyDataHandler(validateSession, nil, clock.NewFixedClock(time.Now()))
+
+	result, err := handler.ExportMyData(context.Background(), &ExportMyDataRequest{
+		SessionToken: "token",
+		Format:       "zip",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ContentType != "application/zip" || len(result.Data) < 4 || string(result.Data[:2]) != "PK" {
+		t.Errorf("expected a zip archive, got %q", result.ContentType)
+	}
+}
+
+func TestExportMyDataError(t *testing.T) {
+	errStore := errors.New("store unavailable")
+
+	tests := []struct {
+		name    string
+		req     *ExportMyDataRequest
+		setup   func(validate *MockValidateSessionUseCase, exporter *MockExporter)
+		wantErr error
+	}{
+		{
+			name:    "nil request",
+			req:     nil,
+			setup:   func(*MockValidateSessionUseCase, *MockExporter) {},
+			wantErr: ErrRequestNil,
+		},
+		{
+			name:    "unsupported format",
+			req:     &ExportMyDataRequest{SessionToken: "token", Format: "xml"},
+			setup:   func(*MockValidateSessionUseCase, *MockExporter) {},
+			wantErr: ErrUnsupportedExportFormat,
+		},
+		{
+			name: "invalid session",
+			req:  &ExportMyDataRequest{SessionToken: "token", Format: "json"},
+			setup: func(validate *MockValidateSessionUseCase, _ *MockExporter) {
+				validate.EXPECT().
+					Validate(gomock.Any(), gomock.Any()).
+					Return(nil, appsession.ErrSessionExpired)
+			},
+			wantErr: appsession.ErrSessionExpired,
+		},
+		{
+			name: "exporter fails",
+			req:  &ExportMyDataRequest{SessionToken: "token", Format: "json"},
+			setup: func(validate *MockValidateSessionUseCase, exporter *MockExporter) {
+				userID, _ := user.NewID()
+
+				validate.EXPECT().
+					Validate(gomock.Any(), gomock.Any()).
+					Return(&appsession.ValidateSessionResult{UserID: userID}, nil)
+				exporter.EXPECT().
+					ExportUserData(gomock.Any(), userID.String()).
+					Return(nil, errStore)
+			},
+			wantErr: errStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			validate := NewMockValidateSessionUseCase(ctrl)
+			exporter := NewMockExporter(ctrl)
+			tt.setup(validate, exporter)
+
+			handler := newExportMyDataHandler(validate, []dataexport.Exporter{exporter}, clock.NewFixedClock(time.Now()))
+
+			if _, err := handler.ExportMyData(context.Background(), tt.req); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
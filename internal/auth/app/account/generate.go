@@ -0,0 +1,6 @@
+package account
+
+//go:generate mockgen -destination=mock_validate_session.go -package=account github.com/KasumiMercury/primind-central-backend/internal/auth/app/session ValidateSessionUseCase
+//go:generate mockgen -destination=mock_data_exporter.go -package=account github.com/KasumiMercury/primind-central-backend/internal/dataexport Exporter
+//go:generate mockgen -destination=mock_user_repository.go -package=account github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user UserRepository
+//go:generate mockgen -destination=mock_session_repository.go -package=account github.com/KasumiMercury/primind-central-backend/internal/auth/domain/session SessionRepository
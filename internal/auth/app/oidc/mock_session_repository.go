@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	session "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/session"
+	user "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +56,20 @@ func (mr *MockSessionRepositoryMockRecorder) DeleteSession(ctx, sessionID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSessionRepository)(nil).DeleteSession), ctx, sessionID)
 }
 
+// DeleteSessionsByUserID mocks base method.
+func (m *MockSessionRepository) DeleteSessionsByUserID(ctx context.Context, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSessionsByUserID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSessionsByUserID indicates an expected call of DeleteSessionsByUserID.
+func (mr *MockSessionRepositoryMockRecorder) DeleteSessionsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionsByUserID", reflect.TypeOf((*MockSessionRepository)(nil).DeleteSessionsByUserID), ctx, userID)
+}
+
 // GetSession mocks base method.
 func (m *MockSessionRepository) GetSession(ctx context.Context, sessionID session.ID) (*session.Session, error) {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -17,10 +18,14 @@ type TokenVerifier interface {
 
 type ValidateSessionRequest struct {
 	SessionToken string
+	// AllowDeletedUser accepts a session whose user no longer exists and reports it
+	// through UserDeleted, so that an interrupted account deletion can be resumed.
+	AllowDeletedUser bool
 }
 
 type ValidateSessionResult struct {
-	UserID user.ID
+	UserID      user.ID
+	UserDeleted bool
 }
 
 type ValidateSessionUseCase interface {
@@ -29,6 +34,7 @@ type ValidateSessionUseCase interface {
 
 type validateSessionHandler struct {
 	sessionRepo   domainsession.SessionRepository
+	userRepo      user.UserRepository
 	tokenVerifier TokenVerifier
 	clock         clock.Clock
 	logger        *slog.Logger
@@ -36,11 +42,13 @@ type validateSessionHandler struct {
 
 func newValidateSessionHandler(
 	sessionRepo domainsession.SessionRepository,
+	userRepo user.UserRepository,
 	tokenVerifier TokenVerifier,
 	clk clock.Clock,
 ) ValidateSessionUseCase {
 	return &validateSessionHandler{
 		sessionRepo:   sessionRepo,
+		userRepo:      userRepo,
 		tokenVerifier: tokenVerifier,
 		clock:         clk,
 		logger:        slog.Default().With(slog.String("module", "auth")).WithGroup("auth").WithGroup("session").WithGroup("validate"),
@@ -49,9 +57,10 @@ func newValidateSessionHandler(
 
 func NewValidateSessionHandler(
 	sessionRepo domainsession.SessionRepository,
+	userRepo user.UserRepository,
 	tokenVerifier TokenVerifier,
 ) ValidateSessionUseCase {
-	return newValidateSessionHandler(sessionRepo, tokenVerifier, &clock.RealClock{})
+	return newValidateSessionHandler(sessionRepo, userRepo, tokenVerifier, &clock.RealClock{})
 }
 
 func (h *validateSessionHandler) Validate(ctx context.Context, req *ValidateSessionRequest) (*ValidateSessionResult, error) {
@@ -99,7 +108,27 @@ func (h *validateSessionHandler) Validate(ctx context.Context, req *ValidateSess
 		return nil, ErrSessionExpired
 	}
 
+	// A session can outlive its user when the account was deleted while it was active
+	userDeleted := false
+
+	if _, err := h.userRepo.GetUserByID(ctx, session.UserID()); err != nil {
+		if !errors.Is(err, user.ErrUserNotFound) {
+			h.logger.Error("failed to get session user", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		if !req.AllowDeletedUser {
+			h.logger.Info("session belongs to a deleted user")
+
+			return nil, fmt.Errorf("%w: %v", ErrSessionNotFound, err)
+		}
+
+		userDeleted = true
+	}
+
 	return &ValidateSessionResult{
-		UserID: session.UserID(),
+		UserID:      session.UserID(),
+		UserDeleted: userDeleted,
 	}, nil
 }
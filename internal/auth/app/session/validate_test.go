@@ -43,7 +43,11 @@ func TestValidateSessionSuccess(t *testing.T) {
 		t.Fatalf("failed to generate token: %v", err)
 	}
 
-	handler := newValidateSessionHandler(repo, jwtValidator, clock.NewFixedClock(now))
+	ctrl := gomock.NewController(t)
+	userRepo := NewMockUserRepository(ctrl)
+	userRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(user.NewUser(userID, user.MustColor("#000000")), nil)
+
+	handler := newValidateSessionHandler(repo, userRepo, jwtValidator, clock.NewFixedClock(now))
 
 	result, err := handler.Validate(context.Background(), &ValidateSessionRequest{
 		SessionToken: token,
@@ -61,11 +65,13 @@ func TestValidateSessionError(t *testing.T) {
 	now := time.Now().UTC()
 	userID, _ := user.NewID()
 	validSession, _ := domainsession.NewSession(userID, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	activeSession, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
 
 	tests := []struct {
 		name        string
 		req         *ValidateSessionRequest
 		repo        domainsession.SessionRepository
+		userRepo    user.UserRepository
 		verifier    TokenVerifier
 		expectedErr error
 	}{
@@ -144,6 +150,45 @@ func TestValidateSessionError(t *testing.T) {
 			}(),
 			expectedErr: ErrSessionExpired,
 		},
+		{
+			name: "user deleted",
+			req: &ValidateSessionRequest{
+				SessionToken: "orphaned-token",
+			},
+			repo: func() domainsession.SessionRepository {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				mockRepo := NewMockSessionRepository(ctrl)
+				mockRepo.EXPECT().
+					GetSession(gomock.Any(), activeSession.ID()).
+					Return(activeSession, nil)
+
+				return mockRepo
+			}(),
+			userRepo: func() user.UserRepository {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				mockUserRepo := NewMockUserRepository(ctrl)
+				mockUserRepo.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, user.ErrUserNotFound)
+
+				return mockUserRepo
+			}(),
+			verifier: func() TokenVerifier {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				mockVerifier := NewMockTokenVerifier(ctrl)
+				mockVerifier.EXPECT().Verify("orphaned-token").Return(nil)
+				mockVerifier.EXPECT().ExtractSessionID("orphaned-token").Return(activeSession.ID().String(), nil)
+
+				return mockVerifier
+			}(),
+			expectedErr: ErrSessionNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -151,7 +196,7 @@ func TestValidateSessionError(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			handler := newValidateSessionHandler(tt.repo, tt.verifier, clock.NewFixedClock(now))
+			handler := newValidateSessionHandler(tt.repo, tt.userRepo, tt.verifier, clock.NewFixedClock(now))
 
 			_, err := handler.Validate(context.Background(), tt.req)
 			if err == nil {
@@ -165,6 +210,38 @@ func TestValidateSessionError(t *testing.T) {
 	}
 }
 
+func TestValidateSessionAllowDeletedUser(t *testing.T) {
+	now := time.Now().UTC()
+	userID, _ := user.NewID()
+	activeSession, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
+
+	ctrl := gomock.NewController(t)
+
+	sessionRepo := NewMockSessionRepository(ctrl)
+	sessionRepo.EXPECT().GetSession(gomock.Any(), activeSession.ID()).Return(activeSession, nil)
+
+	userRepo := NewMockUserRepository(ctrl)
+	userRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, user.ErrUserNotFound)
+
+	verifier := NewMockTokenVerifier(ctrl)
+	verifier.EXPECT().Verify("orphaned-token").Return(nil)
+	verifier.EXPECT().ExtractSessionID("orphaned-token").Return(activeSession.ID().String(), nil)
+
+	handler := newValidateSessionHandler(sessionRepo, userRepo, verifier, clock.NewFixedClock(now))
+
+	result, err := handler.Validate(context.Background(), &ValidateSessionRequest{
+		SessionToken:     "orphaned-token",
+		AllowDeletedUser: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.UserID != userID || !result.UserDeleted {
+		t.Fatalf("expected the deleted user %s to be reported, got %+v", userID.String(), result)
+	}
+}
+
 func setupSessionRepo(t *testing.T) domainsession.SessionRepository {
 	t.Helper()
 
@@ -0,0 +1,174 @@
+package deletionjob
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+)
+
+type Status string
+
+const (
+	StatusInProgress Status = "in_progress"
+	StatusFailed     Status = "failed"
+	StatusCompleted  Status = "completed"
+)
+
+func NewStatus(status string) (Status, error) {
+	switch Status(status) {
+	case StatusInProgress, StatusFailed, StatusCompleted:
+		return Status(status), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrStatusInvalid, status)
+	}
+}
+
+// Job tracks the deletion of an account. Deletion runs as a list of steps; each one
+// is recorded when it succeeds, so a run that failed part way resumes at the first
+// step that has not succeeded yet.
+//
+// The job outlives the account it deletes and is the record that the deletion took
+// place.
+type Job struct {
+	userID         user.ID
+	status         Status
+	completedSteps []string
+	attempts       int
+	lastError      string
+	createdAt      time.Time
+	updatedAt      time.Time
+	completedAt    *time.Time
+}
+
+func NewJob(userID user.ID, now time.Time) *Job {
+	now = now.UTC().Truncate(time.Microsecond)
+
+	return &Job{
+		userID:         userID,
+		status:         StatusInProgress,
+		completedSteps: []string{},
+		attempts:       0,
+		lastError:      "",
+		createdAt:      now,
+		updatedAt:      now,
+		completedAt:    nil,
+	}
+}
+
+// RestoreJob rebuilds a job read from storage.
+func RestoreJob(
+	userID user.ID,
+	status Status,
+	completedSteps []string,
+	attempts int,
+	lastError string,
+	createdAt time.Time,
+	updatedAt time.Time,
+	completedAt *time.Time,
+) (*Job, error) {
+	if _, err := NewStatus(string(status)); err != nil {
+		return nil, err
+	}
+
+	if completedSteps == nil {
+		completedSteps = []string{}
+	}
+
+	return &Job{
+		userID:         userID,
+		status:         status,
+		completedSteps: completedSteps,
+		attempts:       attempts,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		completedAt:    completedAt,
+	}, nil
+}
+
+func (j *Job) UserID() user.ID {
+	return j.userID
+}
+
+func (j *Job) Status() Status {
+	return j.status
+}
+
+func (j *Job) CompletedSteps() []string {
+	return slices.Clone(j.completedSteps)
+}
+
+// Attempts counts the runs of the job, including the current one.
+func (j *Job) Attempts() int {
+	return j.attempts
+}
+
+// LastError describes why the last failed run stopped. It is cleared by the next run.
+func (j *Job) LastError() string {
+	return j.lastError
+}
+
+func (j *Job) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *Job) UpdatedAt() time.Time {
+	return j.updatedAt
+}
+
+func (j *Job) CompletedAt() *time.Time {
+	return j.completedAt
+}
+
+func (j *Job) IsCompleted() bool {
+	return j.status == StatusCompleted
+}
+
+func (j *Job) IsStepCompleted(step string) bool {
+	return slices.Contains(j.completedSteps, step)
+}
+
+// Start begins a run of the job.
+func (j *Job) Start(now time.Time) {
+	j.status = StatusInProgress
+	j.attempts++
+	j.lastError = ""
+	j.touch(now)
+}
+
+// CompleteStep records that the step succeeded.
+func (j *Job) CompleteStep(step string, now time.Time) error {
+	if step == "" {
+		return ErrStepNameEmpty
+	}
+
+	if !j.IsStepCompleted(step) {
+		j.completedSteps = append(j.completedSteps, step)
+	}
+
+	j.touch(now)
+
+	return nil
+}
+
+// Fail records that the run stopped at the step.
+func (j *Job) Fail(step string, cause error, now time.Time) {
+	j.status = StatusFailed
+	j.lastError = fmt.Sprintf("%s: %v", step, cause)
+	j.touch(now)
+}
+
+// Complete records that every step succeeded.
+func (j *Job) Complete(now time.Time) {
+	j.status = StatusCompleted
+	j.touch(now)
+
+	completedAt := j.updatedAt
+	j.completedAt = &completedAt
+}
+
+func (j *Job) touch(now time.Time) {
+	j.updatedAt = now.UTC().Truncate(time.Microsecond)
+}
@@ -0,0 +1,13 @@
+package deletionjob
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+)
+
+type DeletionJobRepository interface {
+	GetDeletionJob(ctx context.Context, userID user.ID) (*Job, error)
+	// SaveDeletionJob creates the job or replaces the stored one.
+	SaveDeletionJob(ctx context.Context, job *Job) error
+}
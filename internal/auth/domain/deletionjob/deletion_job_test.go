@@ -0,0 +1,79 @@
+package deletionjob
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+)
+
+func TestJobLifecycle(t *testing.T) {
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user id: %v", err)
+	}
+
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	job := NewJob(userID, now)
+	if job.Status() != StatusInProgress || job.Attempts() != 0 || len(job.CompletedSteps()) != 0 {
+		t.Fatalf("unexpected new job: %+v", job)
+	}
+
+	job.Start(now)
+
+	if err := job.CompleteStep("tasks", now.Add(time.Second)); err != nil {
+		t.Fatalf("CompleteStep returned error: %v", err)
+	}
+
+	job.Fail("devices", errors.New("database unavailable"), now.Add(2*time.Second))
+
+	if job.Status() != StatusFailed || job.LastError() != "devices: database unavailable" {
+		t.Fatalf("expected a failed job, got %s %q", job.Status(), job.LastError())
+	}
+
+	job.Start(now.Add(time.Minute))
+
+	if job.Attempts() != 2 || job.LastError() != "" || job.Status() != StatusInProgress {
+		t.Fatalf("expected the retry to reset the failure, got %+v", job)
+	}
+
+	if !job.IsStepCompleted("tasks") || job.IsStepCompleted("devices") {
+		t.Fatalf("unexpected completed steps %v", job.CompletedSteps())
+	}
+
+	for _, step := range []string{"tasks", "devices"} {
+		if err := job.CompleteStep(step, now.Add(time.Minute)); err != nil {
+			t.Fatalf("CompleteStep returned error: %v", err)
+		}
+	}
+
+	if got := job.CompletedSteps(); !slices.Equal(got, []string{"tasks", "devices"}) {
+		t.Fatalf("expected each step to be recorded once, got %v", got)
+	}
+
+	job.Complete(now.Add(2 * time.Minute))
+
+	if !job.IsCompleted() || job.CompletedAt() == nil || !job.CompletedAt().Equal(now.Add(2*time.Minute)) {
+		t.Fatalf("expected a completed job, got %+v", job)
+	}
+}
+
+func TestJobErrors(t *testing.T) {
+	userID, _ := user.NewID()
+	now := time.Now()
+
+	if err := NewJob(userID, now).CompleteStep("", now); !errors.Is(err, ErrStepNameEmpty) {
+		t.Fatalf("expected ErrStepNameEmpty, got %v", err)
+	}
+
+	if _, err := RestoreJob(userID, Status("paused"), nil, 0, "", now, now, nil); !errors.Is(err, ErrStatusInvalid) {
+		t.Fatalf("expected ErrStatusInvalid, got %v", err)
+	}
+
+	if _, err := NewStatus("completed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+package deletionjob
+
+import "errors"
+
+var (
+	ErrDeletionJobNotFound = errors.New("account deletion job not found")
+	ErrStatusInvalid       = errors.New("account deletion job status is invalid")
+	ErrStepNameEmpty       = errors.New("account deletion step name must be specified")
+)
@@ -1,9 +1,14 @@
 package session
 
-import "context"
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+)
 
 type SessionRepository interface {
 	SaveSession(ctx context.Context, session *Session) error
 	GetSession(ctx context.Context, sessionID ID) (*Session, error)
 	DeleteSession(ctx context.Context, sessionID ID) error
+	DeleteSessionsByUserID(ctx context.Context, userID user.ID) error
 }
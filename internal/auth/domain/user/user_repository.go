@@ -5,4 +5,7 @@ import "context"
 type UserRepository interface {
 	SaveUser(ctx context.Context, user *User) error
 	GetUserByID(ctx context.Context, id ID) (*User, error)
+	// DeleteUser removes the user along with its OIDC identities. Deleting a user that
+	// does not exist is not an error.
+	DeleteUser(ctx context.Context, id ID) error
 }
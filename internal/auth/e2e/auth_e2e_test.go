@@ -2,10 +2,12 @@ package e2e
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
+	appaccount "github.com/KasumiMercury/primind-central-backend/internal/auth/app/account"
 	applogout "github.com/KasumiMercury/primind-central-backend/internal/auth/app/logout"
 	appoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/app/oidc"
 	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
@@ -87,10 +89,12 @@ func TestAuthE2ELoginValidateLogoutFlow(t *testing.T) {
 		jwtGenerator,
 		sessionCfg,
 	)
-	validateUseCase := appsession.NewValidateSessionHandler(sessionRepo, jwtValidator)
+	validateUseCase := appsession.NewValidateSessionHandler(sessionRepo, userRepo, jwtValidator)
 	logoutUseCase := applogout.NewLogoutHandler(sessionRepo, jwtValidator)
 
-	service := authsvc.NewService(paramsGenerator, loginHandler, validateUseCase, logoutUseCase)
+	exportUseCase := appaccount.NewExportMyDataHandler(validateUseCase, repository.NewDataExporter(db, redisClient))
+
+	service := authsvc.NewService(paramsGenerator, loginHandler, validateUseCase, logoutUseCase, exportUseCase, nil)
 
 	paramsResp, err := service.OIDCParams(ctx, &authv1.OIDCParamsRequest{
 		Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE,
@@ -132,6 +136,32 @@ func TestAuthE2ELoginValidateLogoutFlow(t *testing.T) {
 		t.Fatalf("expected user id in validate response")
 	}
 
+	exportResp, err := service.ExportMyData(ctx, &authv1.ExportMyDataRequest{
+		SessionToken: loginResp.GetSessionToken(),
+		Format:       authv1.ExportFormat_EXPORT_FORMAT_JSON,
+	})
+	if err != nil {
+		t.Fatalf("ExportMyData returned error: %v", err)
+	}
+
+	var exported struct {
+		UserID string                       `json:"user_id"`
+		Data   map[string][]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(exportResp.GetData(), &exported); err != nil {
+		t.Fatalf("failed to decode export: %v", err)
+	}
+
+	if exported.UserID != validateResp.GetUserId() {
+		t.Fatalf("expected export of user %s, got %s", validateResp.GetUserId(), exported.UserID)
+	}
+
+	for _, section := range []string{"auth_users", "auth_oidc_identities", "auth_sessions"} {
+		if len(exported.Data[section]) != 1 {
+			t.Fatalf("expected one record in %s, got %d", section, len(exported.Data[section]))
+		}
+	}
+
 	_, err = service.Logout(ctx, &authv1.LogoutRequest{
 		SessionToken: loginResp.GetSessionToken(),
 	})
@@ -207,9 +237,9 @@ func TestAuthE2EValidateInvalidSession(t *testing.T) {
 		jwtGenerator,
 		sessionCfg,
 	)
-	validateUseCase := appsession.NewValidateSessionHandler(sessionRepo, jwtValidator)
+	validateUseCase := appsession.NewValidateSessionHandler(sessionRepo, userRepo, jwtValidator)
 	logoutUseCase := applogout.NewLogoutHandler(sessionRepo, jwtValidator)
-	service := authsvc.NewService(paramsGenerator, loginHandler, validateUseCase, logoutUseCase)
+	service := authsvc.NewService(paramsGenerator, loginHandler, validateUseCase, logoutUseCase, nil, nil)
 
 	_, err := service.OIDCParams(ctx, &authv1.OIDCParamsRequest{
 		Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE,
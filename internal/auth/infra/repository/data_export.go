@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+type userExportRecord struct {
+	ID        string    `json:"id"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type oidcIdentityExportRecord struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type sessionExportRecord struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type dataExporter struct {
+	db     *gorm.DB
+	client *redis.Client
+}
+
+// NewDataExporter exports the auth user, its OIDC identities and its active sessions.
+func NewDataExporter(db *gorm.DB, client *redis.Client) dataexport.Exporter {
+	return &dataExporter{
+		db:     db,
+		client: client,
+	}
+}
+
+func (e *dataExporter) ExportUserData(ctx context.Context, userID string) ([]dataexport.Section, error) {
+	var users []UserModel
+	if err := e.db.WithContext(ctx).
+		Where("id = ?", userID).
+		Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	userRecords := make([]userExportRecord, 0, len(users))
+	for _, u := range users {
+		userRecords = append(userRecords, userExportRecord{
+			ID:        u.ID,
+			Color:     u.Color,
+			CreatedAt: u.CreatedAt,
+		})
+	}
+
+	var identities []OIDCIdentityModel
+	if err := e.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, provider ASC").
+		Find(&identities).Error; err != nil {
+		return nil, err
+	}
+
+	identityRecords := make([]oidcIdentityExportRecord, 0, len(identities))
+	for _, identity := range identities {
+		identityRecords = append(identityRecords, oidcIdentityExportRecord{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	sessions, err := listUserSessionRecords(ctx, e.client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+	})
+
+	sessionRecords := make([]sessionExportRecord, 0, len(sessions))
+	for _, session := range sessions {
+		sessionRecords = append(sessionRecords, sessionExportRecord{
+			ID:        session.ID,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+		})
+	}
+
+	return []dataexport.Section{
+		{Name: "auth_users", Records: userRecords},
+		{Name: "auth_oidc_identities", Records: identityRecords},
+		{Name: "auth_sessions", Records: sessionRecords},
+	}, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/oidc"
+	domainidentity "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/oidcidentity"
+	domainsession "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/session"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+)
+
+func TestDataExporterIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := setupIdentityDB(t)
+
+	client, cleanup := testutil.SetupRedisContainer(ctx, t)
+	defer cleanup()
+
+	userRepo := NewUserRepository(db)
+	identityRepo := NewOIDCIdentityRepository(db)
+	sessionRepo := NewSessionRepository(client)
+
+	userID, _ := domainuser.NewID()
+	otherUserID, _ := domainuser.NewID()
+	now := time.Now().UTC()
+
+	for _, id := range []domainuser.ID{userID, otherUserID} {
+		if err := userRepo.SaveUser(ctx, domainuser.NewUser(id, domainuser.MustColor("#abcdef"))); err != nil {
+			t.Fatalf("SaveUser returned error: %v", err)
+		}
+	}
+
+	identity, _ := domainidentity.NewOIDCIdentity(userID, domainoidc.ProviderGoogle, "subject-1")
+	otherIdentity, _ := domainidentity.NewOIDCIdentity(otherUserID, domainoidc.ProviderGoogle, "subject-2")
+
+	for _, i := range []*domainidentity.OIDCIdentity{identity, otherIdentity} {
+		if err := identityRepo.SaveOIDCIdentity(ctx, i); err != nil {
+			t.Fatalf("SaveOIDCIdentity returned error: %v", err)
+		}
+	}
+
+	session, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
+	if err := sessionRepo.SaveSession(ctx, session); err != nil {
+		t.Fatalf("SaveSession returned error: %v", err)
+	}
+
+	sections, err := NewDataExporter(db, client).ExportUserData(ctx, userID.String())
+	if err != nil {
+		t.Fatalf("ExportUserData returned error: %v", err)
+	}
+
+	if len(sections) != 3 {
+		t.Fatalf("expected 3 sections, got %d", len(sections))
+	}
+
+	users, ok := sections[0].Records.([]userExportRecord)
+	if !ok || sections[0].Name != "auth_users" || len(users) != 1 || users[0].ID != userID.String() {
+		t.Fatalf("unexpected users section %+v", sections[0])
+	}
+
+	identities, ok := sections[1].Records.([]oidcIdentityExportRecord)
+	if !ok || sections[1].Name != "auth_oidc_identities" || len(identities) != 1 || identities[0].Subject != "subject-1" {
+		t.Fatalf("unexpected identities section %+v", sections[1])
+	}
+
+	sessions, ok := sections[2].Records.([]sessionExportRecord)
+	if !ok || sections[2].Name != "auth_sessions" || len(sessions) != 1 || sessions[0].ID != session.ID().String() {
+		t.Fatalf("unexpected sessions section %+v", sections[2])
+	}
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/deletionjob"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeletionJobModel has no foreign key to auth_users: the job is kept after the user it
+// deleted is gone.
+type DeletionJobModel struct {
+	UserID         string     `gorm:"type:uuid;primaryKey"`
+	Status         string     `gorm:"type:varchar(20);not null"`
+	CompletedSteps []string   `gorm:"type:jsonb;not null;default:'[]';serializer:json"`
+	Attempts       int        `gorm:"not null;default:0"`
+	LastError      string     `gorm:"type:text;not null;default:''"`
+	CreatedAt      time.Time  `gorm:"type:timestamptz;not null"`
+	UpdatedAt      time.Time  `gorm:"type:timestamptz;not null"`
+	CompletedAt    *time.Time `gorm:"type:timestamptz"`
+}
+
+func (DeletionJobModel) TableName() string {
+	return "account_deletion_jobs"
+}
+
+type deletionJobRepository struct {
+	db *gorm.DB
+}
+
+func NewDeletionJobRepository(db *gorm.DB) deletionjob.DeletionJobRepository {
+	return &deletionJobRepository{db: db}
+}
+
+func (r *deletionJobRepository) GetDeletionJob(ctx context.Context, userID domainuser.ID) (*deletionjob.Job, error) {
+	var record DeletionJobModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID.String()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, deletionjob.ErrDeletionJobNotFound
+		}
+
+		return nil, err
+	}
+
+	status, err := deletionjob.NewStatus(record.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return deletionjob.RestoreJob(
+		userID,
+		status,
+		record.CompletedSteps,
+		record.Attempts,
+		record.LastError,
+		record.CreatedAt,
+		record.UpdatedAt,
+		record.CompletedAt,
+	)
+}
+
+func (r *deletionJobRepository) SaveDeletionJob(ctx context.Context, job *deletionjob.Job) error {
+	if job == nil {
+		return ErrDeletionJobRequired
+	}
+
+	record := DeletionJobModel{
+		UserID:         job.UserID().String(),
+		Status:         string(job.Status()),
+		CompletedSteps: job.CompletedSteps(),
+		Attempts:       job.Attempts(),
+		LastError:      job.LastError(),
+		CreatedAt:      job.CreatedAt(),
+		UpdatedAt:      job.UpdatedAt(),
+		CompletedAt:    job.CompletedAt(),
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "completed_steps", "attempts", "last_error", "updated_at", "completed_at"}),
+		}).
+		Create(&record).
+		Error
+}
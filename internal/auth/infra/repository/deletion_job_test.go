@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/deletionjob"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+)
+
+func TestDeletionJobRepositoryIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(&DeletionJobModel{}); err != nil {
+		t.Fatalf("failed to migrate deletion job table: %v", err)
+	}
+
+	repo := NewDeletionJobRepository(db)
+
+	userID, _ := domainuser.NewID()
+	if _, err := repo.GetDeletionJob(ctx, userID); !errors.Is(err, deletionjob.ErrDeletionJobNotFound) {
+		t.Fatalf("expected ErrDeletionJobNotFound, got %v", err)
+	}
+
+	if err := repo.SaveDeletionJob(ctx, nil); !errors.Is(err, ErrDeletionJobRequired) {
+		t.Fatalf("expected ErrDeletionJobRequired, got %v", err)
+	}
+
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	job := deletionjob.NewJob(userID, now)
+	job.Start(now)
+
+	if err := job.CompleteStep("tasks", now); err != nil {
+		t.Fatalf("CompleteStep returned error: %v", err)
+	}
+
+	job.Fail("devices", errors.New("timeout"), now.Add(time.Second))
+
+	if err := repo.SaveDeletionJob(ctx, job); err != nil {
+		t.Fatalf("SaveDeletionJob returned error: %v", err)
+	}
+
+	job.Start(now.Add(time.Minute))
+
+	if err := job.CompleteStep("devices", now.Add(time.Minute)); err != nil {
+		t.Fatalf("CompleteStep returned error: %v", err)
+	}
+
+	job.Complete(now.Add(time.Minute))
+
+	if err := repo.SaveDeletionJob(ctx, job); err != nil {
+		t.Fatalf("SaveDeletionJob returned error: %v", err)
+	}
+
+	found, err := repo.GetDeletionJob(ctx, userID)
+	if err != nil {
+		t.Fatalf("GetDeletionJob returned error: %v", err)
+	}
+
+	if !found.IsCompleted() || found.Attempts() != 2 || found.LastError() != "" {
+		t.Fatalf("unexpected job %+v", found)
+	}
+
+	if !slices.Equal(found.CompletedSteps(), []string{"tasks", "devices"}) {
+		t.Fatalf("unexpected completed steps %v", found.CompletedSteps())
+	}
+
+	if !found.CreatedAt().Equal(now) || found.CompletedAt() == nil || !found.CompletedAt().Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected timestamps %v %v", found.CreatedAt(), found.CompletedAt())
+	}
+}
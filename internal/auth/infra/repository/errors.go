@@ -11,4 +11,5 @@ var (
 	ErrIdentityRequired      = errors.New("identity is required")
 	ErrParamsRequired        = errors.New("oidc params required")
 	ErrParamsAlreadyExpired  = errors.New("oidc params already expired")
+	ErrDeletionJobRequired   = errors.New("deletion job is required")
 )
@@ -108,3 +108,34 @@ func TestOIDCIdentityRepositoryWithFixedClock(t *testing.T) {
 		t.Fatalf("expected CreatedAt to be %v, got %v", fixedTime, record.CreatedAt)
 	}
 }
+
+func TestUserRepositoryDeleteUserCascadesToIdentities(t *testing.T) {
+	ctx := context.Background()
+	db := setupIdentityDB(t)
+	userRepo := NewUserRepository(db)
+	identityRepo := NewOIDCIdentityRepository(db)
+
+	userID, _ := domainuser.NewID()
+	if err := userRepo.SaveUser(ctx, domainuser.NewUser(userID, domainuser.MustColor("#abcdef"))); err != nil {
+		t.Fatalf("SaveUser returned error: %v", err)
+	}
+
+	identity, _ := domainidentity.NewOIDCIdentity(userID, domainoidc.ProviderGoogle, "subject-delete")
+	if err := identityRepo.SaveOIDCIdentity(ctx, identity); err != nil {
+		t.Fatalf("SaveOIDCIdentity returned error: %v", err)
+	}
+
+	for range 2 {
+		if err := userRepo.DeleteUser(ctx, userID); err != nil {
+			t.Fatalf("DeleteUser returned error: %v", err)
+		}
+	}
+
+	if _, err := userRepo.GetUserByID(ctx, userID); !errors.Is(err, domainuser.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+
+	if _, err := identityRepo.GetOIDCIdentityByProviderSubject(ctx, domainoidc.ProviderGoogle, "subject-delete"); !errors.Is(err, domainidentity.ErrOIDCIdentityNotFound) {
+		t.Fatalf("expected ErrOIDCIdentityNotFound, got %v", err)
+	}
+}
@@ -13,6 +13,13 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	// sessionScanBatch is how many keys one SCAN step asks Redis for.
+	sessionScanBatch = 500
+	// sessionIndexBackfilledKey marks that every session has been added to its user index.
+	sessionIndexBackfilledKey = "auth:session-index:backfilled"
+)
+
 type sessionRecord struct {
 	ID        string    `json:"id"`
 	UserID    string    `json:"user_id"`
@@ -64,7 +71,20 @@ func (r *sessionRepository) SaveSession(ctx context.Context, session *domainsess
 		return err
 	}
 
-	return r.client.Set(ctx, r.key(session.ID().String()), payload, ttl).Err()
+	userKey := r.userKey(record.UserID)
+
+	// The user index lives as long as the user's longest session. ExpireNX covers a
+	// freshly created index, ExpireGT extends an existing one.
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, r.key(record.ID), payload, ttl)
+		pipe.SAdd(ctx, userKey, record.ID)
+		pipe.ExpireNX(ctx, userKey, ttl)
+		pipe.ExpireGT(ctx, userKey, ttl)
+
+		return nil
+	})
+
+	return err
 }
 
 func (r *sessionRepository) GetSession(ctx context.Context, sessionID domainsession.ID) (*domainsession.Session, error) {
@@ -82,6 +102,10 @@ func (r *sessionRepository) GetSession(ctx context.Context, sessionID domainsess
 		return nil, err
 	}
 
+	return record.toSession()
+}
+
+func (record sessionRecord) toSession() (*domainsession.Session, error) {
 	uid, err := domainuser.NewIDFromString(record.UserID)
 	if err != nil {
 		return nil, err
@@ -96,9 +120,199 @@ func (r *sessionRepository) GetSession(ctx context.Context, sessionID domainsess
 }
 
 func (r *sessionRepository) DeleteSession(ctx context.Context, sessionID domainsession.ID) error {
-	return r.client.Del(ctx, r.key(sessionID.String())).Err()
+	key := r.key(sessionID.String())
+
+	raw, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var record sessionRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return r.client.Del(ctx, key).Err()
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.SRem(ctx, r.userKey(record.UserID), record.ID)
+
+		return nil
+	})
+
+	return err
+}
+
+// DeleteSessionsByUserID deletes every session in the user index. Sessions saved before
+// the index existed are added to it by BackfillSessionIndex.
+func (r *sessionRepository) DeleteSessionsByUserID(ctx context.Context, userID domainuser.ID) error {
+	userKey := r.userKey(userID.String())
+
+	ids, err := r.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, r.key(id))
+	}
+
+	keys = append(keys, userKey)
+
+	return r.client.Del(ctx, keys...).Err()
 }
 
 func (r *sessionRepository) key(sessionID string) string {
+	return sessionKey(sessionID)
+}
+
+func (r *sessionRepository) userKey(userID string) string {
+	return userSessionsKey(userID)
+}
+
+func sessionKey(sessionID string) string {
 	return fmt.Sprintf("auth:session:%s", sessionID)
 }
+
+func userSessionsKey(userID string) string {
+	return fmt.Sprintf("auth:user-sessions:%s", userID)
+}
+
+// listUserSessionRecords returns the live sessions of a user from the user index.
+// Sessions that expired since they were indexed are dropped from the index on the way.
+func listUserSessionRecords(ctx context.Context, client *redis.Client, userID string) ([]sessionRecord, error) {
+	userKey := userSessionsKey(userID)
+
+	ids, err := client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+
+	values, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]sessionRecord, 0, len(values))
+	stale := make([]any, 0)
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			stale = append(stale, ids[i])
+
+			continue
+		}
+
+		var record sessionRecord
+		if err := json.Unmarshal([]byte(raw), &record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	if len(stale) > 0 {
+		if err := client.SRem(ctx, userKey, stale...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// BackfillSessionIndex adds the sessions saved before the user index existed to the
+// index of their user. It scans every session once and then leaves a marker, so later
+// calls return right away. Run it before serving traffic; the repository only reads the
+// user index.
+func BackfillSessionIndex(ctx context.Context, client *redis.Client) error {
+	done, err := client.Exists(ctx, sessionIndexBackfilledKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if done > 0 {
+		return nil
+	}
+
+	iter := client.Scan(ctx, 0, sessionKey("*"), sessionScanBatch).Iterator()
+	keys := make([]string, 0, sessionScanBatch)
+
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) < sessionScanBatch {
+			continue
+		}
+
+		if err := indexSessionKeys(ctx, client, keys); err != nil {
+			return err
+		}
+
+		keys = keys[:0]
+	}
+
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	if err := indexSessionKeys(ctx, client, keys); err != nil {
+		return err
+	}
+
+	return client.Set(ctx, sessionIndexBackfilledKey, time.Now().UTC().Format(time.RFC3339), 0).Err()
+}
+
+func indexSessionKeys(ctx context.Context, client *redis.Client, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	values, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue // expired since the scan
+		}
+
+		var record sessionRecord
+		if err := json.Unmarshal([]byte(raw), &record); err != nil {
+			continue
+		}
+
+		ttl := time.Until(record.ExpiresAt)
+		if ttl <= 0 {
+			continue
+		}
+
+		userKey := userSessionsKey(record.UserID)
+
+		if _, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.SAdd(ctx, userKey, record.ID)
+			pipe.ExpireNX(ctx, userKey, ttl)
+			pipe.ExpireGT(ctx, userKey, ttl)
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -74,3 +74,166 @@ func TestSessionRepositoryIntegrationError(t *testing.T) {
 		t.Fatalf("expected ErrSessionNotFound, got %v", err)
 	}
 }
+
+func TestSessionRepositoryUserIndex(t *testing.T) {
+	ctx := context.Background()
+
+	client, cleanup := testutil.SetupRedisContainer(ctx, t)
+	defer cleanup()
+
+	repo := NewSessionRepository(client)
+
+	userID, _ := domainuser.NewID()
+	otherUserID, _ := domainuser.NewID()
+	now := time.Now().UTC()
+
+	first, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
+	second, _ := domainsession.NewSession(userID, now, now.Add(2*time.Hour))
+	other, _ := domainsession.NewSession(otherUserID, now, now.Add(time.Hour))
+
+	for _, session := range []*domainsession.Session{first, second, other} {
+		if err := repo.SaveSession(ctx, session); err != nil {
+			t.Fatalf("SaveSession returned error: %v", err)
+		}
+	}
+
+	if ttl := client.TTL(ctx, userSessionsKey(userID.String())).Val(); ttl <= time.Hour {
+		t.Fatalf("expected the user index to live as long as the longest session, got %v", ttl)
+	}
+
+	if err := repo.DeleteSession(ctx, first.ID()); err != nil {
+		t.Fatalf("DeleteSession returned error: %v", err)
+	}
+
+	// A session that expired without being deleted is pruned from the index when listed.
+	if err := client.Del(ctx, sessionKey(second.ID().String())).Err(); err != nil {
+		t.Fatalf("failed to expire session: %v", err)
+	}
+
+	records, err := listUserSessionRecords(ctx, client, userID.String())
+	if err != nil {
+		t.Fatalf("listUserSessionRecords returned error: %v", err)
+	}
+
+	if len(records) != 0 {
+		t.Fatalf("expected no live sessions, got %+v", records)
+	}
+
+	if members := client.SMembers(ctx, userSessionsKey(userID.String())).Val(); len(members) != 0 {
+		t.Fatalf("expected the user index to be empty, got %v", members)
+	}
+
+	records, err = listUserSessionRecords(ctx, client, otherUserID.String())
+	if err != nil {
+		t.Fatalf("listUserSessionRecords returned error: %v", err)
+	}
+
+	if len(records) != 1 || records[0].ID != other.ID().String() {
+		t.Fatalf("expected the other user's session, got %+v", records)
+	}
+}
+
+func TestSessionRepositoryDeleteSessionsByUserID(t *testing.T) {
+	ctx := context.Background()
+
+	client, cleanup := testutil.SetupRedisContainer(ctx, t)
+	defer cleanup()
+
+	repo := NewSessionRepository(client)
+
+	userID, _ := domainuser.NewID()
+	otherUserID, _ := domainuser.NewID()
+	now := time.Now().UTC()
+
+	first, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
+	second, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
+	other, _ := domainsession.NewSession(otherUserID, now, now.Add(time.Hour))
+
+	for _, session := range []*domainsession.Session{first, second, other} {
+		if err := repo.SaveSession(ctx, session); err != nil {
+			t.Fatalf("SaveSession returned error: %v", err)
+		}
+	}
+
+	// The second session was saved before the user index existed
+	if err := client.SRem(ctx, userSessionsKey(userID.String()), second.ID().String()).Err(); err != nil {
+		t.Fatalf("failed to unlist session: %v", err)
+	}
+
+	if err := BackfillSessionIndex(ctx, client); err != nil {
+		t.Fatalf("BackfillSessionIndex returned error: %v", err)
+	}
+
+	for range 2 {
+		if err := repo.DeleteSessionsByUserID(ctx, userID); err != nil {
+			t.Fatalf("DeleteSessionsByUserID returned error: %v", err)
+		}
+	}
+
+	for _, session := range []*domainsession.Session{first, second} {
+		if _, err := repo.GetSession(ctx, session.ID()); !errors.Is(err, ErrSessionNotFound) {
+			t.Fatalf("expected ErrSessionNotFound, got %v", err)
+		}
+	}
+
+	if _, err := repo.GetSession(ctx, other.ID()); err != nil {
+		t.Fatalf("expected the other user's session to survive, got %v", err)
+	}
+}
+
+func TestBackfillSessionIndex(t *testing.T) {
+	ctx := context.Background()
+
+	client, cleanup := testutil.SetupRedisContainer(ctx, t)
+	defer cleanup()
+
+	repo := NewSessionRepository(client)
+
+	userID, _ := domainuser.NewID()
+	now := time.Now().UTC()
+
+	session, _ := domainsession.NewSession(userID, now, now.Add(time.Hour))
+	if err := repo.SaveSession(ctx, session); err != nil {
+		t.Fatalf("SaveSession returned error: %v", err)
+	}
+
+	// A session saved before the user index existed is not listed until the backfill.
+	if err := client.Del(ctx, userSessionsKey(userID.String())).Err(); err != nil {
+		t.Fatalf("failed to drop the user index: %v", err)
+	}
+
+	records, err := listUserSessionRecords(ctx, client, userID.String())
+	if err != nil {
+		t.Fatalf("listUserSessionRecords returned error: %v", err)
+	}
+
+	if len(records) != 0 {
+		t.Fatalf("expected no indexed session before the backfill, got %+v", records)
+	}
+
+	if err := BackfillSessionIndex(ctx, client); err != nil {
+		t.Fatalf("BackfillSessionIndex returned error: %v", err)
+	}
+
+	records, err = listUserSessionRecords(ctx, client, userID.String())
+	if err != nil {
+		t.Fatalf("listUserSessionRecords returned error: %v", err)
+	}
+
+	if len(records) != 1 || records[0].ID != session.ID().String() {
+		t.Fatalf("expected the backfilled session, got %+v", records)
+	}
+
+	// The backfill only runs once
+	if err := client.Del(ctx, userSessionsKey(userID.String())).Err(); err != nil {
+		t.Fatalf("failed to drop the user index: %v", err)
+	}
+
+	if err := BackfillSessionIndex(ctx, client); err != nil {
+		t.Fatalf("BackfillSessionIndex returned error: %v", err)
+	}
+
+	if members := client.SMembers(ctx, userSessionsKey(userID.String())).Val(); len(members) != 0 {
+		t.Fatalf("expected the second backfill to skip the scan, got %v", members)
+	}
+}
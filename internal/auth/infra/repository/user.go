@@ -80,3 +80,11 @@ func (r *userRepository) GetUserByID(ctx context.Context, id domainuser.ID) (*do
 
 	return domainuser.NewUser(userID, color), nil
 }
+
+func (r *userRepository) DeleteUser(ctx context.Context, id domainuser.ID) error {
+	// OIDC identities go with the user through their foreign key
+	return r.db.WithContext(ctx).
+		Where("id = ?", id.String()).
+		Delete(&UserModel{}).
+		Error
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/KasumiMercury/primind-central-backend/internal/auth/app/account (interfaces: ExportMyDataUseCase,DeleteAccountUseCase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_service_account.go -package=auth github.com/KasumiMercury/primind-central-backend/internal/auth/app/account ExportMyDataUseCase,DeleteAccountUseCase
+//
+
+// Package auth is a generated GoMock package.
+package auth
+
+import (
+	context "context"
+	reflect "reflect"
+
+	account "github.com/KasumiMercury/primind-central-backend/internal/auth/app/account"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportMyDataUseCase is a mock of ExportMyDataUseCase interface.
+type MockExportMyDataUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportMyDataUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockExportMyDataUseCaseMockRecorder is the mock recorder for MockExportMyDataUseCase.
+type MockExportMyDataUseCaseMockRecorder struct {
+	mock *MockExportMyDataUseCase
+}
+
+// NewMockExportMyDataUseCase creates a new mock instance.
+func NewMockExportMyDataUseCase(ctrl *gomock.Controller) *MockExportMyDataUseCase {
+	mock := &MockExportMyDataUseCase{ctrl: ctrl}
+	mock.recorder = &MockExportMyDataUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportMyDataUseCase) EXPECT() *MockExportMyDataUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ExportMyData mocks base method.
+func (m *MockExportMyDataUseCase) ExportMyData(ctx context.Context, req *account.ExportMyDataRequest) (*account.ExportMyDataResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMyData", ctx, req)
+	ret0, _ := ret[0].(*account.ExportMyDataResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMyData indicates an expected call of ExportMyData.
+func (mr *MockExportMyDataUseCaseMockRecorder) ExportMyData(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMyData", reflect.TypeOf((*MockExportMyDataUseCase)(nil).ExportMyData), ctx, req)
+}
+
+// MockDeleteAccountUseCase is a mock of DeleteAccountUseCase interface.
+type MockDeleteAccountUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteAccountUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteAccountUseCaseMockRecorder is the mock recorder for MockDeleteAccountUseCase.
+type MockDeleteAccountUseCaseMockRecorder struct {
+	mock *MockDeleteAccountUseCase
+}
+
+// NewMockDeleteAccountUseCase creates a new mock instance.
+func NewMockDeleteAccountUseCase(ctrl *gomock.Controller) *MockDeleteAccountUseCase {
+	mock := &MockDeleteAccountUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteAccountUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteAccountUseCase) EXPECT() *MockDeleteAccountUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteAccount mocks base method.
+func (m *MockDeleteAccountUseCase) DeleteAccount(ctx context.Context, req *account.DeleteAccountRequest) (*account.DeleteAccountResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, req)
+	ret0, _ := ret[0].(*account.DeleteAccountResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockDeleteAccountUseCaseMockRecorder) DeleteAccount(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockDeleteAccountUseCase)(nil).DeleteAccount), ctx, req)
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	connect "connectrpc.com/connect"
+	appaccount "github.com/KasumiMercury/primind-central-backend/internal/auth/app/account"
 	applogout "github.com/KasumiMercury/primind-central-backend/internal/auth/app/logout"
 	appoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/app/oidc"
 	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
@@ -20,6 +21,8 @@ type Service struct {
 	oidcLogin       appoidc.OIDCLoginUseCase
 	validateSession appsession.ValidateSessionUseCase
 	logout          applogout.LogoutUseCase
+	exportMyData    appaccount.ExportMyDataUseCase
+	deleteAccount   appaccount.DeleteAccountUseCase
 	logger          *slog.Logger
 }
 
@@ -30,12 +33,16 @@ func NewService(
 	oidcLoginUseCase appoidc.OIDCLoginUseCase,
 	validateSessionUseCase appsession.ValidateSessionUseCase,
 	logoutUseCase applogout.LogoutUseCase,
+	exportMyDataUseCase appaccount.ExportMyDataUseCase,
+	deleteAccountUseCase appaccount.DeleteAccountUseCase,
 ) *Service {
 	return &Service{
 		oidcParams:      oidcParamsGenerator,
 		oidcLogin:       oidcLoginUseCase,
 		validateSession: validateSessionUseCase,
 		logout:          logoutUseCase,
+		exportMyData:    exportMyDataUseCase,
+		deleteAccount:   deleteAccountUseCase,
 		logger:          slog.Default().With(slog.String("module", "auth")).WithGroup("auth").WithGroup("service"),
 	}
 }
@@ -184,7 +191,8 @@ func (s *Service) ValidateSession(ctx context.Context, req *authv1.ValidateSessi
 	}
 
 	result, err := s.validateSession.Validate(ctx, &appsession.ValidateSessionRequest{
-		SessionToken: req.GetSessionToken(),
+		SessionToken:     req.GetSessionToken(),
+		AllowDeletedUser: false,
 	})
 	if err != nil {
 		switch {
@@ -207,6 +215,99 @@ func (s *Service) ValidateSession(ctx context.Context, req *authv1.ValidateSessi
 	}, nil
 }
 
+func (s *Service) ExportMyData(ctx context.Context, req *authv1.ExportMyDataRequest) (*authv1.ExportMyDataResponse, error) {
+	if s.exportMyData == nil {
+		s.logger.Warn("data export requested but handler is not configured")
+
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("data export not configured"))
+	}
+
+	format, err := mapExportFormat(req.GetFormat())
+	if err != nil {
+		s.logger.Warn("invalid format in export request", slog.String("error", err.Error()))
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.exportMyData.ExportMyData(ctx, &appaccount.ExportMyDataRequest{
+		SessionToken: req.GetSessionToken(),
+		Format:       format,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appsession.ErrSessionTokenRequired),
+			errors.Is(err, appsession.ErrSessionTokenInvalid),
+			errors.Is(err, appsession.ErrSessionNotFound),
+			errors.Is(err, appsession.ErrSessionExpired):
+			s.logger.Info("data export rejected", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, appaccount.ErrUnsupportedExportFormat):
+			s.logger.Warn("data export requested in unsupported format", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected data export error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return &authv1.ExportMyDataResponse{
+		Data:        result.Data,
+		ContentType: result.ContentType,
+		FileName:    result.FileName,
+		Version:     result.Version,
+	}, nil
+}
+
+func (s *Service) DeleteAccount(ctx context.Context, req *authv1.DeleteAccountRequest) (*authv1.DeleteAccountResponse, error) {
+	if s.deleteAccount == nil {
+		s.logger.Warn("account deletion requested but handler is not configured")
+
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("account deletion not configured"))
+	}
+
+	result, err := s.deleteAccount.DeleteAccount(ctx, &appaccount.DeleteAccountRequest{
+		SessionToken: req.GetSessionToken(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appsession.ErrSessionTokenRequired),
+			errors.Is(err, appsession.ErrSessionTokenInvalid),
+			errors.Is(err, appsession.ErrSessionNotFound),
+			errors.Is(err, appsession.ErrSessionExpired):
+			s.logger.Info("account deletion rejected", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, appaccount.ErrAccountDeletionIncomplete):
+			s.logger.Warn("account deletion stopped before finishing", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		default:
+			s.logger.Error("unexpected account deletion error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return &authv1.DeleteAccountResponse{
+		Success:        true,
+		CompletedSteps: result.CompletedSteps,
+	}, nil
+}
+
+func mapExportFormat(format authv1.ExportFormat) (string, error) {
+	switch format {
+	case authv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, authv1.ExportFormat_EXPORT_FORMAT_JSON:
+		return "json", nil
+	case authv1.ExportFormat_EXPORT_FORMAT_ZIP:
+		return "zip", nil
+	default:
+		return "", fmt.Errorf("unsupported export format: %s", format.String())
+	}
+}
+
 func mapProvider(provider authv1.OIDCProvider) (domainoidc.ProviderID, error) {
 	switch provider {
 	case authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE:
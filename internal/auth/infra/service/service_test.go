@@ -3,9 +3,11 @@ package auth
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	connect "connectrpc.com/connect"
+	appaccount "github.com/KasumiMercury/primind-central-backend/internal/auth/app/account"
 	applogout "github.com/KasumiMercury/primind-central-backend/internal/auth/app/logout"
 	appoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/app/oidc"
 	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
@@ -27,7 +29,7 @@ func TestServiceOIDCParamsSuccess(t *testing.T) {
 			State:            "abc",
 		}, nil)
 
-	svc := NewService(mockGenerator, nil, nil, nil)
+	svc := NewService(mockGenerator, nil, nil, nil, nil, nil)
 
 	resp, err := svc.OIDCParams(context.Background(), &authv1.OIDCParamsRequest{
 		Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE,
@@ -50,14 +52,14 @@ func TestServiceOIDCParamsError(t *testing.T) {
 	}{
 		{
 			name:         "generator missing",
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil) },
+			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil, nil) },
 			req:          &authv1.OIDCParamsRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeFailedPrecondition,
 		},
 		{
 			name: "invalid provider",
 			service: func(ctrl *gomock.Controller) *Service {
-				return NewService(NewMockOIDCParamsGenerator(ctrl), nil, nil, nil)
+				return NewService(NewMockOIDCParamsGenerator(ctrl), nil, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCParamsRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_UNSPECIFIED},
 			expectedCode: connect.CodeInvalidArgument,
@@ -70,7 +72,7 @@ func TestServiceOIDCParamsError(t *testing.T) {
 					Generate(gomock.Any(), domainoidc.ProviderGoogle).
 					Return(nil, appoidc.ErrOIDCNotConfigured)
 
-				return NewService(mockGenerator, nil, nil, nil)
+				return NewService(mockGenerator, nil, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCParamsRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeFailedPrecondition,
@@ -83,7 +85,7 @@ func TestServiceOIDCParamsError(t *testing.T) {
 					Generate(gomock.Any(), domainoidc.ProviderGoogle).
 					Return(nil, appoidc.ErrOIDCProviderUnsupported)
 
-				return NewService(mockGenerator, nil, nil, nil)
+				return NewService(mockGenerator, nil, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCParamsRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInvalidArgument,
@@ -96,7 +98,7 @@ func TestServiceOIDCParamsError(t *testing.T) {
 					Generate(gomock.Any(), domainoidc.ProviderGoogle).
 					Return(nil, errors.New("boom"))
 
-				return NewService(mockGenerator, nil, nil, nil)
+				return NewService(mockGenerator, nil, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCParamsRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInternal,
@@ -136,7 +138,7 @@ func TestServiceOIDCLoginSuccess(t *testing.T) {
 		}).
 		Return(&appoidc.LoginResult{SessionToken: "token"}, nil)
 
-	svc := NewService(nil, mockLogin, nil, nil)
+	svc := NewService(nil, mockLogin, nil, nil, nil, nil)
 
 	resp, err := svc.OIDCLogin(context.Background(), &authv1.OIDCLoginRequest{
 		Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE,
@@ -161,14 +163,14 @@ func TestServiceOIDCLoginError(t *testing.T) {
 	}{
 		{
 			name:         "handler missing",
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil) },
+			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil, nil) },
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeFailedPrecondition,
 		},
 		{
 			name: "invalid provider",
 			service: func(ctrl *gomock.Controller) *Service {
-				return NewService(nil, NewMockOIDCLoginUseCase(ctrl), nil, nil)
+				return NewService(nil, NewMockOIDCLoginUseCase(ctrl), nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_UNSPECIFIED},
 			expectedCode: connect.CodeInvalidArgument,
@@ -181,7 +183,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, appoidc.ErrOIDCNotConfigured)
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeFailedPrecondition,
@@ -194,7 +196,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, appoidc.ErrOIDCProviderUnsupported)
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInvalidArgument,
@@ -207,7 +209,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, appoidc.ErrCodeInvalid)
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInvalidArgument,
@@ -220,7 +222,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, appoidc.ErrStateInvalid)
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInvalidArgument,
@@ -233,7 +235,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, domainoidc.ErrParamsExpired)
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInvalidArgument,
@@ -246,7 +248,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, appoidc.ErrNonceInvalid)
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInvalidArgument,
@@ -259,7 +261,7 @@ func TestServiceOIDCLoginError(t *testing.T) {
 					Login(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("boom"))
 
-				return NewService(nil, mockLogin, nil, nil)
+				return NewService(nil, mockLogin, nil, nil, nil, nil)
 			},
 			req:          &authv1.OIDCLoginRequest{Provider: authv1.OIDCProvider_OIDC_PROVIDER_GOOGLE},
 			expectedCode: connect.CodeInternal,
@@ -295,7 +297,7 @@ func TestServiceLogoutSuccess(t *testing.T) {
 		Logout(gomock.Any(), &applogout.LogoutRequest{SessionToken: "token"}).
 		Return(&applogout.LogoutResponse{Success: true}, nil)
 
-	svc := NewService(nil, nil, nil, mockLogout)
+	svc := NewService(nil, nil, nil, mockLogout, nil, nil)
 
 	resp, err := svc.Logout(context.Background(), &authv1.LogoutRequest{SessionToken: "token"})
 	if err != nil {
@@ -316,7 +318,7 @@ func TestServiceLogoutError(t *testing.T) {
 	}{
 		{
 			name:         "handler missing",
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil) },
+			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil, nil) },
 			req:          &authv1.LogoutRequest{SessionToken: "token"},
 			expectedCode: connect.CodeFailedPrecondition,
 		},
@@ -328,7 +330,7 @@ func TestServiceLogoutError(t *testing.T) {
 					Logout(gomock.Any(), gomock.Any()).
 					Return(nil, applogout.ErrSessionTokenRequired)
 
-				return NewService(nil, nil, nil, mockLogout)
+				return NewService(nil, nil, nil, mockLogout, nil, nil)
 			},
 			req:          &authv1.LogoutRequest{},
 			expectedCode: connect.CodeInvalidArgument,
@@ -341,7 +343,7 @@ func TestServiceLogoutError(t *testing.T) {
 					Logout(gomock.Any(), gomock.Any()).
 					Return(nil, applogout.ErrSessionTokenInvalid)
 
-				return NewService(nil, nil, nil, mockLogout)
+				return NewService(nil, nil, nil, mockLogout, nil, nil)
 			},
 			req:          &authv1.LogoutRequest{SessionToken: "bad"},
 			expectedCode: connect.CodeInvalidArgument,
@@ -354,7 +356,7 @@ func TestServiceLogoutError(t *testing.T) {
 					Logout(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("boom"))
 
-				return NewService(nil, nil, nil, mockLogout)
+				return NewService(nil, nil, nil, mockLogout, nil, nil)
 			},
 			req:          &authv1.LogoutRequest{SessionToken: "token"},
 			expectedCode: connect.CodeInternal,
@@ -391,7 +393,7 @@ func TestServiceValidateSessionSuccess(t *testing.T) {
 		Validate(gomock.Any(), &appsession.ValidateSessionRequest{SessionToken: "token"}).
 		Return(&appsession.ValidateSessionResult{UserID: userID}, nil)
 
-	svc := NewService(nil, nil, mockValidate, nil)
+	svc := NewService(nil, nil, mockValidate, nil, nil, nil)
 
 	resp, err := svc.ValidateSession(context.Background(), &authv1.ValidateSessionRequest{SessionToken: "token"})
 	if err != nil {
@@ -412,7 +414,7 @@ func TestServiceValidateSessionError(t *testing.T) {
 	}{
 		{
 			name:         "handler missing",
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil) },
+			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil, nil) },
 			req:          &authv1.ValidateSessionRequest{SessionToken: "token"},
 			expectedCode: connect.CodeFailedPrecondition,
 		},
@@ -424,7 +426,7 @@ func TestServiceValidateSessionError(t *testing.T) {
 					Validate(gomock.Any(), gomock.Any()).
 					Return(nil, appsession.ErrSessionTokenRequired)
 
-				return NewService(nil, nil, mockValidate, nil)
+				return NewService(nil, nil, mockValidate, nil, nil, nil)
 			},
 			req:          &authv1.ValidateSessionRequest{SessionToken: ""},
 			expectedCode: connect.CodeUnauthenticated,
@@ -437,7 +439,7 @@ func TestServiceValidateSessionError(t *testing.T) {
 					Validate(gomock.Any(), gomock.Any()).
 					Return(nil, appsession.ErrSessionTokenInvalid)
 
-				return NewService(nil, nil, mockValidate, nil)
+				return NewService(nil, nil, mockValidate, nil, nil, nil)
 			},
 			req:          &authv1.ValidateSessionRequest{SessionToken: "bad"},
 			expectedCode: connect.CodeUnauthenticated,
@@ -450,7 +452,7 @@ func TestServiceValidateSessionError(t *testing.T) {
 					Validate(gomock.Any(), gomock.Any()).
 					Return(nil, appsession.ErrSessionNotFound)
 
-				return NewService(nil, nil, mockValidate, nil)
+				return NewService(nil, nil, mockValidate, nil, nil, nil)
 			},
 			req:          &authv1.ValidateSessionRequest{SessionToken: "token"},
 			expectedCode: connect.CodeUnauthenticated,
@@ -463,7 +465,7 @@ func TestServiceValidateSessionError(t *testing.T) {
 					Validate(gomock.Any(), gomock.Any()).
 					Return(nil, appsession.ErrSessionExpired)
 
-				return NewService(nil, nil, mockValidate, nil)
+				return NewService(nil, nil, mockValidate, nil, nil, nil)
 			},
 			req:          &authv1.ValidateSessionRequest{SessionToken: "token"},
 			expectedCode: connect.CodeUnauthenticated,
@@ -476,7 +478,7 @@ func TestServiceValidateSessionError(t *testing.T) {
 					Validate(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("boom"))
 
-				return NewService(nil, nil, mockValidate, nil)
+				return NewService(nil, nil, mockValidate, nil, nil, nil)
 			},
 			req:          &authv1.ValidateSessionRequest{SessionToken: "token"},
 			expectedCode: connect.CodeInternal,
@@ -502,3 +504,218 @@ func TestServiceValidateSessionError(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceExportMyDataSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExport := NewMockExportMyDataUseCase(ctrl)
+	mockExport.EXPECT().
+		ExportMyData(gomock.Any(), &appaccount.ExportMyDataRequest{SessionToken: "token", Format: "zip"}).
+		Return(&appaccount.ExportMyDataResult{
+			Data:        []byte("PK"),
+			ContentType: "application/zip",
+			FileName:    "primind-export.zip",
+			Version:     1,
+		}, nil)
+
+	svc := NewService(nil, nil, nil, nil, mockExport, nil)
+
+	resp, err := svc.ExportMyData(context.Background(), &authv1.ExportMyDataRequest{
+		SessionToken: "token",
+		Format:       authv1.ExportFormat_EXPORT_FORMAT_ZIP,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(resp.GetData()) != "PK" || resp.GetContentType() != "application/zip" || resp.GetFileName() != "primind-export.zip" || resp.GetVersion() != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestServiceExportMyDataDefaultsToJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExport := NewMockExportMyDataUseCase(ctrl)
+	mockExport.EXPECT().
+		ExportMyData(gomock.Any(), &appaccount.ExportMyDataRequest{SessionToken: "token", Format: "json"}).
+		Return(&appaccount.ExportMyDataResult{Data: []byte("{}"), ContentType: "application/json", FileName: "primind-export.json", Version: 1}, nil)
+
+	svc := NewService(nil, nil, nil, nil, mockExport, nil)
+
+	if _, err := svc.ExportMyData(context.Background(), &authv1.ExportMyDataRequest{SessionToken: "token"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceExportMyDataError(t *testing.T) {
+	tests := []struct {
+		name         string
+		service      func(ctrl *gomock.Controller) *Service
+		req          *authv1.ExportMyDataRequest
+		expectedCode connect.Code
+	}{
+		{
+			name:         "handler missing",
+			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil, nil) },
+			req:          &authv1.ExportMyDataRequest{SessionToken: "token"},
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "unknown format",
+			service: func(ctrl *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, NewMockExportMyDataUseCase(ctrl), nil)
+			},
+			req:          &authv1.ExportMyDataRequest{SessionToken: "token", Format: authv1.ExportFormat(99)},
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "session expired",
+			service: func(ctrl *gomock.Controller) *Service {
+				mockExport := NewMockExportMyDataUseCase(ctrl)
+				mockExport.EXPECT().
+					ExportMyData(gomock.Any(), gomock.Any()).
+					Return(nil, appsession.ErrSessionExpired)
+
+				return NewService(nil, nil, nil, nil, mockExport, nil)
+			},
+			req:          &authv1.ExportMyDataRequest{SessionToken: "token"},
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "token required",
+			service: func(ctrl *gomock.Controller) *Service {
+				mockExport := NewMockExportMyDataUseCase(ctrl)
+				mockExport.EXPECT().
+					ExportMyData(gomock.Any(), gomock.Any()).
+					Return(nil, appsession.ErrSessionTokenRequired)
+
+				return NewService(nil, nil, nil, nil, mockExport, nil)
+			},
+			req:          &authv1.ExportMyDataRequest{SessionToken: ""},
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "unexpected error",
+			service: func(ctrl *gomock.Controller) *Service {
+				mockExport := NewMockExportMyDataUseCase(ctrl)
+				mockExport.EXPECT().
+					ExportMyData(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+
+				return NewService(nil, nil, nil, nil, mockExport, nil)
+			},
+			req:          &authv1.ExportMyDataRequest{SessionToken: "token"},
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.service(ctrl).ExportMyData(context.Background(), tt.req)
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestServiceDeleteAccountSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDelete := NewMockDeleteAccountUseCase(ctrl)
+	mockDelete.EXPECT().
+		DeleteAccount(gomock.Any(), &appaccount.DeleteAccountRequest{SessionToken: "token"}).
+		Return(&appaccount.DeleteAccountResult{CompletedSteps: []string{"tasks", "auth_users", "auth_sessions"}}, nil)
+
+	svc := NewService(nil, nil, nil, nil, nil, mockDelete)
+
+	resp, err := svc.DeleteAccount(context.Background(), &authv1.DeleteAccountRequest{SessionToken: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !resp.GetSuccess() || len(resp.GetCompletedSteps()) != 3 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestServiceDeleteAccountError(t *testing.T) {
+	tests := []struct {
+		name         string
+		service      func(ctrl *gomock.Controller) *Service
+		expectedCode connect.Code
+	}{
+		{
+			name:         "handler missing",
+			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil, nil) },
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "session expired",
+			service: func(ctrl *gomock.Controller) *Service {
+				mockDelete := NewMockDeleteAccountUseCase(ctrl)
+				mockDelete.EXPECT().
+					DeleteAccount(gomock.Any(), gomock.Any()).
+					Return(nil, appsession.ErrSessionExpired)
+
+				return NewService(nil, nil, nil, nil, nil, mockDelete)
+			},
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "deletion incomplete",
+			service: func(ctrl *gomock.Controller) *Service {
+				mockDelete := NewMockDeleteAccountUseCase(ctrl)
+				mockDelete.EXPECT().
+					DeleteAccount(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: step devices: timeout", appaccount.ErrAccountDeletionIncomplete))
+
+				return NewService(nil, nil, nil, nil, nil, mockDelete)
+			},
+			expectedCode: connect.CodeUnavailable,
+		},
+		{
+			name: "unexpected error",
+			service: func(ctrl *gomock.Controller) *Service {
+				mockDelete := NewMockDeleteAccountUseCase(ctrl)
+				mockDelete.EXPECT().
+					DeleteAccount(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+
+				return NewService(nil, nil, nil, nil, nil, mockDelete)
+			},
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.service(ctrl).DeleteAccount(context.Background(), &authv1.DeleteAccountRequest{SessionToken: "token"})
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
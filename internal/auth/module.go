@@ -8,10 +8,12 @@ import (
 
 	"connectrpc.com/connect"
 	"connectrpc.com/otelconnect"
+	appaccount "github.com/KasumiMercury/primind-central-backend/internal/auth/app/account"
 	applogout "github.com/KasumiMercury/primind-central-backend/internal/auth/app/logout"
 	appoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/app/oidc"
 	appsession "github.com/KasumiMercury/primind-central-backend/internal/auth/app/session"
 	authconfig "github.com/KasumiMercury/primind-central-backend/internal/auth/config"
+	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/deletionjob"
 	domainoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/oidc"
 	"github.com/KasumiMercury/primind-central-backend/internal/auth/domain/oidcidentity"
 	domainsession "github.com/KasumiMercury/primind-central-backend/internal/auth/domain/session"
@@ -19,6 +21,8 @@ import (
 	sessionjwt "github.com/KasumiMercury/primind-central-backend/internal/auth/infra/jwt"
 	infraoidc "github.com/KasumiMercury/primind-central-backend/internal/auth/infra/oidc"
 	authsvc "github.com/KasumiMercury/primind-central-backend/internal/auth/infra/service"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataerasure"
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
 	authv1connect "github.com/KasumiMercury/primind-central-backend/internal/gen/auth/v1/authv1connect"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/middleware"
@@ -32,11 +36,22 @@ type Repositories struct {
 	Users        user.UserRepository
 	OIDCIdentity oidcidentity.OIDCIdentityRepository
 	UserIdentity appoidc.UserWithOIDCIdentityRepository
+	DeletionJobs deletionjob.DeletionJobRepository
+}
+
+// AccountData is what the other modules contribute to the account-wide RPCs. Each
+// module hands in the exporters and erasers for its own data.
+type AccountData struct {
+	// Exporters fill the ExportMyData bundle.
+	Exporters []dataexport.Exporter
+	// ErasureSteps run in order on DeleteAccount, before the auth user and its
+	// sessions are deleted.
+	ErasureSteps []dataerasure.Step
 }
 
 // NewHTTPHandler wires the auth module and returns the Connect HTTP handler
 // and its base path for registration into an HTTP mux.
-func NewHTTPHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+func NewHTTPHandler(ctx context.Context, repos Repositories, accountData AccountData) (string, http.Handler, error) {
 	logger := slog.Default().With(
 		slog.String("module", string(moduleName)),
 	).WithGroup("auth")
@@ -50,7 +65,7 @@ func NewHTTPHandler(ctx context.Context, repos Repositories) (string, http.Handl
 		return "", nil, err
 	}
 
-	if repos.Params == nil || repos.Sessions == nil || repos.Users == nil || repos.OIDCIdentity == nil || repos.UserIdentity == nil {
+	if repos.Params == nil || repos.Sessions == nil || repos.Users == nil || repos.OIDCIdentity == nil || repos.UserIdentity == nil || repos.DeletionJobs == nil {
 		return "", nil, fmt.Errorf("repositories are not fully configured")
 	}
 
@@ -91,10 +106,12 @@ func NewHTTPHandler(ctx context.Context, repos Repositories) (string, http.Handl
 	}
 
 	var (
-		loginHandler        appoidc.OIDCLoginUseCase
-		jwtGenerator        *sessionjwt.SessionJWTGenerator
-		sessionValidateCase appsession.ValidateSessionUseCase
-		logoutHandler       applogout.LogoutUseCase
+		loginHandler         appoidc.OIDCLoginUseCase
+		jwtGenerator         *sessionjwt.SessionJWTGenerator
+		sessionValidateCase  appsession.ValidateSessionUseCase
+		logoutHandler        applogout.LogoutUseCase
+		exportMyDataHandler  appaccount.ExportMyDataUseCase
+		deleteAccountHandler appaccount.DeleteAccountUseCase
 	)
 
 	if authCfg.Session != nil && authCfg.OIDC != nil {
@@ -116,15 +133,23 @@ func NewHTTPHandler(ctx context.Context, repos Repositories) (string, http.Handl
 			jwtGenerator,
 			authCfg.Session,
 		)
-		sessionValidateCase = appsession.NewValidateSessionHandler(repos.Sessions, jwtValidator)
+		sessionValidateCase = appsession.NewValidateSessionHandler(repos.Sessions, repos.Users, jwtValidator)
 		logoutHandler = applogout.NewLogoutHandler(repos.Sessions, jwtValidator)
+		exportMyDataHandler = appaccount.NewExportMyDataHandler(sessionValidateCase, accountData.Exporters...)
+		deleteAccountHandler = appaccount.NewDeleteAccountHandler(
+			sessionValidateCase,
+			repos.DeletionJobs,
+			repos.Users,
+			repos.Sessions,
+			accountData.ErasureSteps...,
+		)
 
 		logger.Info("login and session validation handlers initialized")
 	} else {
 		logger.Warn("session or oidc config missing; login and session validation handlers disabled")
 	}
 
-	authService := authsvc.NewService(paramsGenerator, loginHandler, sessionValidateCase, logoutHandler)
+	authService := authsvc.NewService(paramsGenerator, loginHandler, sessionValidateCase, logoutHandler, exportMyDataHandler, deleteAccountHandler)
 
 	// Create OpenTelemetry interceptor for tracing
 	otelInterceptor, err := otelconnect.NewInterceptor()
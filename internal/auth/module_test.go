@@ -30,6 +30,7 @@ func setupTestRepositories(t *testing.T) Repositories {
 		Users:        repository.NewUserRepository(db),
 		OIDCIdentity: repository.NewOIDCIdentityRepository(db),
 		UserIdentity: repository.NewUserWithIdentityRepository(db),
+		DeletionJobs: repository.NewDeletionJobRepository(db),
 	}
 }
 
@@ -48,7 +49,7 @@ func TestNewHTTPHandlerSuccess(t *testing.T) {
 
 	repos := setupTestRepositories(t)
 
-	path, handler, err := NewHTTPHandler(context.Background(), repos)
+	path, handler, err := NewHTTPHandler(context.Background(), repos, AccountData{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -100,7 +101,7 @@ func TestNewHTTPHandlerError(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
 
-			_, _, err := NewHTTPHandler(ctx, tt.repos)
+			_, _, err := NewHTTPHandler(ctx, tt.repos, AccountData{})
 			if tt.wantError && err == nil {
 				t.Fatalf("expected error but got nil")
 			}
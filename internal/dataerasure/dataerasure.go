@@ -0,0 +1,26 @@
+// Package dataerasure lets an account deletion remove a user's data module by module.
+// Every module contributes erasers for the data it owns, so the deletion is driven
+// without any module deleting rows from another module's tables.
+package dataerasure
+
+import "context"
+
+// Eraser deletes data a module keeps about a user. Erasing data that is already gone
+// must succeed, so an interrupted deletion can be run again from the start of a step.
+type Eraser interface {
+	EraseUserData(ctx context.Context, userID string) error
+}
+
+// EraserFunc adapts a function to an Eraser.
+type EraserFunc func(ctx context.Context, userID string) error
+
+func (f EraserFunc) EraseUserData(ctx context.Context, userID string) error {
+	return f(ctx, userID)
+}
+
+// Step is one named eraser of an account deletion. The name is recorded once the step
+// succeeds, so it must stay stable across releases.
+type Step struct {
+	Name   string
+	Eraser Eraser
+}
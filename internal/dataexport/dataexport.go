@@ -0,0 +1,191 @@
+// Package dataexport assembles the personal data export of a user. Every module
+// contributes the records it owns through an Exporter, so the bundle is built without
+// any module reading another module's tables.
+package dataexport
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version is the bundle layout version. It is bumped whenever a section is renamed or a
+// record loses a field, so consumers can tell the layouts apart.
+const Version = 1
+
+const manifestFileName = "manifest.json"
+
+// Exporter returns the records a module keeps about a user, grouped into sections.
+type Exporter interface {
+	ExportUserData(ctx context.Context, userID string) ([]Section, error)
+}
+
+// Section is one named list of records in the bundle, usually one table or store.
+// Records must encode to JSON.
+type Section struct {
+	Name    string
+	Records any
+}
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatZIP  Format = "zip"
+)
+
+func NewFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatJSON, FormatZIP:
+		return Format(format), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+// ContentType returns the media type of a bundle written in the format.
+func (f Format) ContentType() string {
+	if f == FormatZIP {
+		return "application/zip"
+	}
+
+	return "application/json"
+}
+
+type Bundle struct {
+	UserID     string
+	ExportedAt time.Time
+	Sections   []Section
+}
+
+type manifest struct {
+	Version    int       `json:"version"`
+	UserID     string    `json:"user_id"`
+	ExportedAt time.Time `json:"exported_at"`
+	Sections   []string  `json:"sections"`
+}
+
+type jsonBundle struct {
+	manifest
+
+	Data map[string]any `json:"data"`
+}
+
+// Collect runs every exporter for the user and gathers their sections in order.
+func Collect(ctx context.Context, userID string, exportedAt time.Time, exporters ...Exporter) (*Bundle, error) {
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	bundle := &Bundle{
+		UserID:     userID,
+		ExportedAt: exportedAt.UTC(),
+		Sections:   nil,
+	}
+	seen := make(map[string]struct{})
+
+	for _, exporter := range exporters {
+		sections, err := exporter.ExportUserData(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, section := range sections {
+			if section.Name == "" {
+				return nil, ErrSectionNameEmpty
+			}
+
+			if _, ok := seen[section.Name]; ok {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateSection, section.Name)
+			}
+
+			seen[section.Name] = struct{}{}
+			bundle.Sections = append(bundle.Sections, section)
+		}
+	}
+
+	return bundle, nil
+}
+
+// FileName returns the suggested file name for the bundle written in the format.
+func (b *Bundle) FileName(format Format) string {
+	return fmt.Sprintf("primind-export-%s.%s", b.ExportedAt.Format("20060102T150405Z"), format)
+}
+
+// Write encodes the bundle. A JSON bundle is a single document holding the manifest
+// fields and every section under "data"; a ZIP bundle holds manifest.json and one
+// <section>.json file per section.
+func (b *Bundle) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatJSON:
+		return b.writeJSON(w)
+	case FormatZIP:
+		return b.writeZIP(w)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+func (b *Bundle) buildManifest() manifest {
+	names := make([]string, 0, len(b.Sections))
+	for _, section := range b.Sections {
+		names = append(names, section.Name)
+	}
+
+	return manifest{
+		Version:    Version,
+		UserID:     b.UserID,
+		ExportedAt: b.ExportedAt,
+		Sections:   names,
+	}
+}
+
+func (b *Bundle) writeJSON(w io.Writer) error {
+	data := make(map[string]any, len(b.Sections))
+	for _, section := range b.Sections {
+		data[section.Name] = section.Records
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(jsonBundle{
+		manifest: b.buildManifest(),
+		Data:     data,
+	})
+}
+
+func (b *Bundle) writeZIP(w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	if err := writeZIPEntry(archive, manifestFileName, b.buildManifest()); err != nil {
+		return err
+	}
+
+	for _, section := range b.Sections {
+		if err := writeZIPEntry(archive, section.Name+".json", section.Records); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func writeZIPEntry(archive *zip.Writer, name string, value any) error {
+	entry, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to export: %w", name, err)
+	}
+
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+
+	return nil
+}
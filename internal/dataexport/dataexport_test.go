@@ -0,0 +1,219 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+)
+
+type exporterFunc func(ctx context.Context, userID string) ([]Section, error)
+
+func (f exporterFunc) ExportUserData(ctx context.Context, userID string) ([]Section, error) {
+	return f(ctx, userID)
+}
+
+type record struct {
+	ID string `json:"id"`
+}
+
+func staticExporter(sections ...Section) Exporter {
+	return exporterFunc(func(context.Context, string) ([]Section, error) {
+		return sections, nil
+	})
+}
+
+func TestCollect(t *testing.T) {
+	exportedAt := time.Date(2026, 10, 17, 9, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	var gotUserID string
+
+	users := exporterFunc(func(_ context.Context, userID string) ([]Section, error) {
+		gotUserID = userID
+
+		return []Section{{Name: "auth_users", Records: []record{{ID: userID}}}}, nil
+	})
+	tasks := staticExporter(
+		Section{Name: "tasks", Records: []record{{ID: "task-1"}}},
+		Section{Name: "completed_tasks", Records: []record{}},
+	)
+
+	bundle, err := Collect(context.Background(), "user-1", exportedAt, users, tasks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotUserID != "user-1" {
+		t.Errorf("expected exporters to receive the user id, got %q", gotUserID)
+	}
+
+	if !bundle.ExportedAt.Equal(exportedAt) || bundle.ExportedAt.Location() != time.UTC {
+		t.Errorf("expected the export time in UTC, got %v", bundle.ExportedAt)
+	}
+
+	var names []string
+	for _, section := range bundle.Sections {
+		names = append(names, section.Name)
+	}
+
+	if want := []string{"auth_users", "tasks", "completed_tasks"}; !slices.Equal(names, want) {
+		t.Errorf("expected sections %v, got %v", want, names)
+	}
+
+	if got := bundle.FileName(FormatZIP); got != "primind-export-20261017T003000Z.zip" {
+		t.Errorf("unexpected file name %q", got)
+	}
+}
+
+func TestCollectError(t *testing.T) {
+	errStore := errors.New("store unavailable")
+
+	tests := []struct {
+		name      string
+		userID    string
+		exporters []Exporter
+		wantErr   error
+	}{
+		{
+			name:      "missing user id",
+			userID:    "",
+			exporters: nil,
+			wantErr:   ErrUserIDRequired,
+		},
+		{
+			name:   "exporter fails",
+			userID: "user-1",
+			exporters: []Exporter{exporterFunc(func(context.Context, string) ([]Section, error) {
+				return nil, errStore
+			})},
+			wantErr: errStore,
+		},
+		{
+			name:      "unnamed section",
+			userID:    "user-1",
+			exporters: []Exporter{staticExporter(Section{Name: "", Records: nil})},
+			wantErr:   ErrSectionNameEmpty,
+		},
+		{
+			name:   "section exported twice",
+			userID: "user-1",
+			exporters: []Exporter{
+				staticExporter(Section{Name: "tasks", Records: nil}),
+				staticExporter(Section{Name: "tasks", Records: nil}),
+			},
+			wantErr: ErrDuplicateSection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Collect(context.Background(), tt.userID, time.Now(), tt.exporters...); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func testBundle() *Bundle {
+	return &Bundle{
+		UserID:     "user-1",
+		ExportedAt: time.Date(2026, 10, 17, 0, 30, 0, 0, time.UTC),
+		Sections: []Section{
+			{Name: "auth_users", Records: []record{{ID: "user-1"}}},
+			{Name: "tasks", Records: []record{{ID: "task-1"}, {ID: "task-2"}}},
+		},
+	}
+}
+
+func TestBundleWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testBundle().Write(&buf, FormatJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Version  int                 `json:"version"`
+		UserID   string              `json:"user_id"`
+		Sections []string            `json:"sections"`
+		Data     map[string][]record `json:"data"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode bundle: %v", err)
+	}
+
+	if decoded.Version != Version || decoded.UserID != "user-1" {
+		t.Errorf("unexpected manifest fields: %+v", decoded)
+	}
+
+	if !slices.Equal(decoded.Sections, []string{"auth_users", "tasks"}) {
+		t.Errorf("unexpected sections %v", decoded.Sections)
+	}
+
+	if len(decoded.Data["tasks"]) != 2 || decoded.Data["auth_users"][0].ID != "user-1" {
+		t.Errorf("unexpected data %+v", decoded.Data)
+	}
+}
+
+func TestBundleWriteZIP(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testBundle().Write(&buf, FormatZIP); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+
+	if want := []string{"manifest.json", "auth_users.json", "tasks.json"}; !slices.Equal(names, want) {
+		t.Fatalf("expected files %v, got %v", want, names)
+	}
+
+	var tasks []record
+	readZIPEntry(t, archive.File[2], &tasks)
+
+	if len(tasks) != 2 || tasks[1].ID != "task-2" {
+		t.Errorf("unexpected tasks %+v", tasks)
+	}
+
+	var m manifest
+	readZIPEntry(t, archive.File[0], &m)
+
+	if m.Version != Version || !slices.Equal(m.Sections, []string{"auth_users", "tasks"}) {
+		t.Errorf("unexpected manifest %+v", m)
+	}
+}
+
+func TestBundleWriteUnsupportedFormat(t *testing.T) {
+	if err := testBundle().Write(io.Discard, Format("tar")); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+
+	if _, err := NewFormat("tar"); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+func readZIPEntry(t *testing.T, file *zip.File, v any) {
+	t.Helper()
+
+	rc, err := file.Open()
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", file.Name, err)
+	}
+	defer rc.Close()
+
+	if err := json.NewDecoder(rc).Decode(v); err != nil {
+		t.Fatalf("failed to decode %s: %v", file.Name, err)
+	}
+}
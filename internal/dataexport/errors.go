@@ -0,0 +1,10 @@
+package dataexport
+
+import "errors"
+
+var (
+	ErrUserIDRequired    = errors.New("user id is required")
+	ErrUnsupportedFormat = errors.New("unsupported export format")
+	ErrSectionNameEmpty  = errors.New("export section name is required")
+	ErrDuplicateSection  = errors.New("export section is produced by more than one exporter")
+)
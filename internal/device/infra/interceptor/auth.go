@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	connect "connectrpc.com/connect"
+	"github.com/KasumiMercury/primind-central-backend/internal/device/infra/authclient"
 )
 
 type contextKey string
@@ -33,7 +34,9 @@ func AuthInterceptor() connect.UnaryInterceptorFunc {
 				token = strings.TrimSpace(rawToken[len(bearerPrefix):])
 			}
 
-			ctx = context.WithValue(ctx, sessionTokenKey, token)
+			// The session cache lets the idempotency interceptor and the handler share one
+			// validation of the session
+			ctx = authclient.WithSessionCache(context.WithValue(ctx, sessionTokenKey, token))
 
 			return next(ctx, req)
 		}
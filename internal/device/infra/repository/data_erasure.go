@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/dataerasure"
+	"gorm.io/gorm"
+)
+
+type dataEraser struct {
+	db *gorm.DB
+}
+
+// NewDataEraser deletes every device of a user.
+func NewDataEraser(db *gorm.DB) dataerasure.Eraser {
+	return &dataEraser{db: db}
+}
+
+func (e *dataEraser) EraseUserData(ctx context.Context, userID string) error {
+	return e.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&DeviceModel{}).
+		Error
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
+	"gorm.io/gorm"
+)
+
+// redactedValue replaces credentials in the export; only whether one is set is kept.
+const redactedValue = "[REDACTED]"
+
+type deviceExportRecord struct {
+	ID             string    `json:"id"`
+	Timezone       string    `json:"timezone"`
+	Locale         string    `json:"locale"`
+	Platform       string    `json:"platform"`
+	FCMToken       *string   `json:"fcm_token"`
+	SessionToken   *string   `json:"session_token"`
+	UserAgent      string    `json:"user_agent"`
+	AcceptLanguage string    `json:"accept_language"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type dataExporter struct {
+	db *gorm.DB
+}
+
+// NewDataExporter exports the devices of a user with their FCM and session tokens
+// redacted.
+func NewDataExporter(db *gorm.DB) dataexport.Exporter {
+	return &dataExporter{db: db}
+}
+
+func (e *dataExporter) ExportUserData(ctx context.Context, userID string) ([]dataexport.Section, error) {
+	var devices []DeviceModel
+	if err := e.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&devices).Error; err != nil {
+		return nil, err
+	}
+
+	records := make([]deviceExportRecord, 0, len(devices))
+	for _, device := range devices {
+		records = append(records, deviceExportRecord{
+			ID:             device.ID,
+			Timezone:       device.Timezone,
+			Locale:         device.Locale,
+			Platform:       device.Platform,
+			FCMToken:       redact(device.FCMToken),
+			SessionToken:   redact(device.SessionToken),
+			UserAgent:      device.UserAgent,
+			AcceptLanguage: device.AcceptLanguage,
+			CreatedAt:      device.CreatedAt,
+			UpdatedAt:      device.UpdatedAt,
+		})
+	}
+
+	return []dataexport.Section{
+		{Name: "devices", Records: records},
+	}, nil
+}
+
+func redact(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+
+	redacted := redactedValue
+
+	return &redacted
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/KasumiMercury/primind-central-backend/internal/device/infra/interceptor"
 	devicesvc "github.com/KasumiMercury/primind-central-backend/internal/device/infra/service"
 	"github.com/KasumiMercury/primind-central-backend/internal/gen/device/v1/devicev1connect"
+	"github.com/KasumiMercury/primind-central-backend/internal/idempotency"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/middleware"
 )
@@ -21,18 +22,21 @@ import (
 const moduleName logging.Module = "device"
 
 type Repositories struct {
-	Devices    domaindevice.DeviceRepository
-	AuthClient authclient.AuthClient
+	Devices     domaindevice.DeviceRepository
+	AuthClient  authclient.AuthClient
+	Idempotency idempotency.Store
 }
 
 func NewHTTPHandler(
 	ctx context.Context,
 	deviceRepo domaindevice.DeviceRepository,
+	idempotencyStore idempotency.Store,
 	authServiceURL string,
 ) (string, http.Handler, error) {
 	return NewHTTPHandlerWithRepositories(ctx, Repositories{
-		Devices:    deviceRepo,
-		AuthClient: authclient.NewAuthClient(authServiceURL),
+		Devices:     deviceRepo,
+		AuthClient:  authclient.NewAuthClient(authServiceURL),
+		Idempotency: idempotencyStore,
 	})
 }
 
@@ -51,6 +55,10 @@ func NewHTTPHandlerWithRepositories(ctx context.Context, repos Repositories) (st
 		return "", nil, fmt.Errorf("auth client is not configured")
 	}
 
+	if repos.Idempotency == nil {
+		return "", nil, fmt.Errorf("idempotency store is not configured")
+	}
+
 	registerDeviceUseCase := appdevice.NewRegisterDeviceHandler(repos.AuthClient, repos.Devices)
 	getUserDevicesUseCase := appdevice.NewGetUserDevicesHandler(repos.AuthClient, repos.Devices)
 
@@ -70,6 +78,13 @@ func NewHTTPHandlerWithRepositories(ctx context.Context, repos Repositories) (st
 			otelInterceptor,
 			middleware.ConnectLoggingInterceptor(moduleName),
 			interceptor.AuthInterceptor(),
+			idempotency.NewInterceptor(
+				repos.Idempotency,
+				func(ctx context.Context) (string, error) {
+					return repos.AuthClient.ValidateSession(ctx, interceptor.ExtractSessionToken(ctx))
+				},
+				devicev1connect.DeviceServiceRegisterDeviceProcedure,
+			),
 		),
 	)
 	logger.Info("device service handler registered", slog.String("path", devicePath))
@@ -21,6 +21,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExportFormat is the encoding of a personal data export.
+type ExportFormat int32
+
+const (
+	// EXPORT_FORMAT_UNSPECIFIED falls back to JSON.
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// A single JSON document.
+	ExportFormat_EXPORT_FORMAT_JSON ExportFormat = 1
+	// A ZIP archive with one JSON file per section.
+	ExportFormat_EXPORT_FORMAT_ZIP ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_JSON",
+		2: "EXPORT_FORMAT_ZIP",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_JSON":        1,
+		"EXPORT_FORMAT_ZIP":         2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_v1_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_auth_v1_auth_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
 // OIDCProvider represents an external OpenID Connect identity provider.
 type OIDCProvider int32
 
@@ -54,11 +107,11 @@ func (x OIDCProvider) String() string {
 }
 
 func (OIDCProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_auth_v1_auth_proto_enumTypes[0].Descriptor()
+	return file_auth_v1_auth_proto_enumTypes[1].Descriptor()
 }
 
 func (OIDCProvider) Type() protoreflect.EnumType {
-	return &file_auth_v1_auth_proto_enumTypes[0]
+	return &file_auth_v1_auth_proto_enumTypes[1]
 }
 
 func (x OIDCProvider) Number() protoreflect.EnumNumber {
@@ -67,7 +120,7 @@ func (x OIDCProvider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OIDCProvider.Descriptor instead.
 func (OIDCProvider) EnumDescriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{0}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{1}
 }
 
 type OIDCParamsRequest struct {
@@ -454,6 +507,227 @@ func (x *ValidateSessionResponse) GetUserId() string {
 	return ""
 }
 
+type ExportMyDataRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// format defaults to JSON when unspecified.
+	Format        ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=auth.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportMyDataRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ExportMyDataRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportMyDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data is the bundle: a JSON document, or a ZIP archive holding manifest.json and
+	// one JSON file per section.
+	Data        []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName    string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// version is the layout version of the bundle.
+	Version       int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportMyDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportMyDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAccountRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// completed_steps lists the deletion steps in the order they finished.
+	CompletedSteps []string `protobuf:"bytes,2,rep,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteAccountResponse) GetCompletedSteps() []string {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
+}
+
 var File_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_proto_rawDesc = "" +
@@ -478,16 +752,35 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"\x16ValidateSessionRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"2\n" +
 	"\x17ValidateSessionResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId*G\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"i\n" +
+	"\x13ExportMyDataRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12-\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x15.auth.v1.ExportFormatR\x06format\"\x84\x01\n" +
+	"\x14ExportMyDataResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\";\n" +
+	"\x14DeleteAccountRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"Z\n" +
+	"\x15DeleteAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
+	"\x0fcompleted_steps\x18\x02 \x03(\tR\x0ecompletedSteps*\\\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x01\x12\x15\n" +
+	"\x11EXPORT_FORMAT_ZIP\x10\x02*G\n" +
 	"\fOIDCProvider\x12\x1d\n" +
 	"\x19OIDC_PROVIDER_UNSPECIFIED\x10\x00\x12\x18\n" +
-	"\x14OIDC_PROVIDER_GOOGLE\x10\x012\xa9\x02\n" +
+	"\x14OIDC_PROVIDER_GOOGLE\x10\x012\xc6\x03\n" +
 	"\vAuthService\x12E\n" +
 	"\n" +
 	"OIDCParams\x12\x1a.auth.v1.OIDCParamsRequest\x1a\x1b.auth.v1.OIDCParamsResponse\x12B\n" +
 	"\tOIDCLogin\x12\x19.auth.v1.OIDCLoginRequest\x1a\x1a.auth.v1.OIDCLoginResponse\x129\n" +
 	"\x06Logout\x12\x16.auth.v1.LogoutRequest\x1a\x17.auth.v1.LogoutResponse\x12T\n" +
-	"\x0fValidateSession\x12\x1f.auth.v1.ValidateSessionRequest\x1a .auth.v1.ValidateSessionResponseB\xa3\x01\n" +
+	"\x0fValidateSession\x12\x1f.auth.v1.ValidateSessionRequest\x1a .auth.v1.ValidateSessionResponse\x12K\n" +
+	"\fExportMyData\x12\x1c.auth.v1.ExportMyDataRequest\x1a\x1d.auth.v1.ExportMyDataResponse\x12N\n" +
+	"\rDeleteAccount\x12\x1d.auth.v1.DeleteAccountRequest\x1a\x1e.auth.v1.DeleteAccountResponseB\xa3\x01\n" +
 	"\vcom.auth.v1B\tAuthProtoP\x01ZLgithub.com/KasumiMercury/primind-central-backend/internal/gen/auth/v1;authv1\xa2\x02\x03AXX\xaa\x02\aAuth.V1\xca\x02\aAuth\\V1\xe2\x02\x13Auth\\V1\\GPBMetadata\xea\x02\bAuth::V1b\x06proto3"
 
 var (
@@ -502,35 +795,45 @@ func file_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_auth_v1_auth_proto_rawDescData
 }
 
-var file_auth_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_auth_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_auth_v1_auth_proto_goTypes = []any{
-	(OIDCProvider)(0),               // 0: auth.v1.OIDCProvider
-	(*OIDCParamsRequest)(nil),       // 1: auth.v1.OIDCParamsRequest
-	(*OIDCParamsResponse)(nil),      // 2: auth.v1.OIDCParamsResponse
-	(*OIDCLoginRequest)(nil),        // 3: auth.v1.OIDCLoginRequest
-	(*OIDCLoginResponse)(nil),       // 4: auth.v1.OIDCLoginResponse
-	(*LogoutRequest)(nil),           // 5: auth.v1.LogoutRequest
-	(*LogoutResponse)(nil),          // 6: auth.v1.LogoutResponse
-	(*ValidateSessionRequest)(nil),  // 7: auth.v1.ValidateSessionRequest
-	(*ValidateSessionResponse)(nil), // 8: auth.v1.ValidateSessionResponse
+	(ExportFormat)(0),               // 0: auth.v1.ExportFormat
+	(OIDCProvider)(0),               // 1: auth.v1.OIDCProvider
+	(*OIDCParamsRequest)(nil),       // 2: auth.v1.OIDCParamsRequest
+	(*OIDCParamsResponse)(nil),      // 3: auth.v1.OIDCParamsResponse
+	(*OIDCLoginRequest)(nil),        // 4: auth.v1.OIDCLoginRequest
+	(*OIDCLoginResponse)(nil),       // 5: auth.v1.OIDCLoginResponse
+	(*LogoutRequest)(nil),           // 6: auth.v1.LogoutRequest
+	(*LogoutResponse)(nil),          // 7: auth.v1.LogoutResponse
+	(*ValidateSessionRequest)(nil),  // 8: auth.v1.ValidateSessionRequest
+	(*ValidateSessionResponse)(nil), // 9: auth.v1.ValidateSessionResponse
+	(*ExportMyDataRequest)(nil),     // 10: auth.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),    // 11: auth.v1.ExportMyDataResponse
+	(*DeleteAccountRequest)(nil),    // 12: auth.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),   // 13: auth.v1.DeleteAccountResponse
 }
 var file_auth_v1_auth_proto_depIdxs = []int32{
-	0, // 0: auth.v1.OIDCParamsRequest.provider:type_name -> auth.v1.OIDCProvider
-	0, // 1: auth.v1.OIDCLoginRequest.provider:type_name -> auth.v1.OIDCProvider
-	1, // 2: auth.v1.AuthService.OIDCParams:input_type -> auth.v1.OIDCParamsRequest
-	3, // 3: auth.v1.AuthService.OIDCLogin:input_type -> auth.v1.OIDCLoginRequest
-	5, // 4: auth.v1.AuthService.Logout:input_type -> auth.v1.LogoutRequest
-	7, // 5: auth.v1.AuthService.ValidateSession:input_type -> auth.v1.ValidateSessionRequest
-	2, // 6: auth.v1.AuthService.OIDCParams:output_type -> auth.v1.OIDCParamsResponse
-	4, // 7: auth.v1.AuthService.OIDCLogin:output_type -> auth.v1.OIDCLoginResponse
-	6, // 8: auth.v1.AuthService.Logout:output_type -> auth.v1.LogoutResponse
-	8, // 9: auth.v1.AuthService.ValidateSession:output_type -> auth.v1.ValidateSessionResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: auth.v1.OIDCParamsRequest.provider:type_name -> auth.v1.OIDCProvider
+	1,  // 1: auth.v1.OIDCLoginRequest.provider:type_name -> auth.v1.OIDCProvider
+	0,  // 2: auth.v1.ExportMyDataRequest.format:type_name -> auth.v1.ExportFormat
+	2,  // 3: auth.v1.AuthService.OIDCParams:input_type -> auth.v1.OIDCParamsRequest
+	4,  // 4: auth.v1.AuthService.OIDCLogin:input_type -> auth.v1.OIDCLoginRequest
+	6,  // 5: auth.v1.AuthService.Logout:input_type -> auth.v1.LogoutRequest
+	8,  // 6: auth.v1.AuthService.ValidateSession:input_type -> auth.v1.ValidateSessionRequest
+	10, // 7: auth.v1.AuthService.ExportMyData:input_type -> auth.v1.ExportMyDataRequest
+	12, // 8: auth.v1.AuthService.DeleteAccount:input_type -> auth.v1.DeleteAccountRequest
+	3,  // 9: auth.v1.AuthService.OIDCParams:output_type -> auth.v1.OIDCParamsResponse
+	5,  // 10: auth.v1.AuthService.OIDCLogin:output_type -> auth.v1.OIDCLoginResponse
+	7,  // 11: auth.v1.AuthService.Logout:output_type -> auth.v1.LogoutResponse
+	9,  // 12: auth.v1.AuthService.ValidateSession:output_type -> auth.v1.ValidateSessionResponse
+	11, // 13: auth.v1.AuthService.ExportMyData:output_type -> auth.v1.ExportMyDataResponse
+	13, // 14: auth.v1.AuthService.DeleteAccount:output_type -> auth.v1.DeleteAccountResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_proto_init() }
@@ -543,8 +846,8 @@ func file_auth_v1_auth_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
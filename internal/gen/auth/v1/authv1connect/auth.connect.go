@@ -42,6 +42,12 @@ const (
 	// AuthServiceValidateSessionProcedure is the fully-qualified name of the AuthService's
 	// ValidateSession RPC.
 	AuthServiceValidateSessionProcedure = "/auth.v1.AuthService/ValidateSession"
+	// AuthServiceExportMyDataProcedure is the fully-qualified name of the AuthService's ExportMyData
+	// RPC.
+	AuthServiceExportMyDataProcedure = "/auth.v1.AuthService/ExportMyData"
+	// AuthServiceDeleteAccountProcedure is the fully-qualified name of the AuthService's DeleteAccount
+	// RPC.
+	AuthServiceDeleteAccountProcedure = "/auth.v1.AuthService/DeleteAccount"
 )
 
 // AuthServiceClient is a client for the auth.v1.AuthService service.
@@ -50,6 +56,12 @@ type AuthServiceClient interface {
 	OIDCLogin(context.Context, *v1.OIDCLoginRequest) (*v1.OIDCLoginResponse, error)
 	Logout(context.Context, *v1.LogoutRequest) (*v1.LogoutResponse, error)
 	ValidateSession(context.Context, *v1.ValidateSessionRequest) (*v1.ValidateSessionResponse, error)
+	// ExportMyData returns everything stored about the caller's account.
+	ExportMyData(context.Context, *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error)
+	// DeleteAccount deletes the caller's account and everything stored about it. A call
+	// that fails part way can be repeated with the same session token to resume where it
+	// stopped; the session is only deleted by the last step.
+	DeleteAccount(context.Context, *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error)
 }
 
 // NewAuthServiceClient constructs a client for the auth.v1.AuthService service. By default, it uses
@@ -87,6 +99,18 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("ValidateSession")),
 			connect.WithClientOptions(opts...),
 		),
+		exportMyData: connect.NewClient[v1.ExportMyDataRequest, v1.ExportMyDataResponse](
+			httpClient,
+			baseURL+AuthServiceExportMyDataProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ExportMyData")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+AuthServiceDeleteAccountProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -96,6 +120,8 @@ type authServiceClient struct {
 	oIDCLogin       *connect.Client[v1.OIDCLoginRequest, v1.OIDCLoginResponse]
 	logout          *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
 	validateSession *connect.Client[v1.ValidateSessionRequest, v1.ValidateSessionResponse]
+	exportMyData    *connect.Client[v1.ExportMyDataRequest, v1.ExportMyDataResponse]
+	deleteAccount   *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
 }
 
 // OIDCParams calls auth.v1.AuthService.OIDCParams.
@@ -134,12 +160,36 @@ func (c *authServiceClient) ValidateSession(ctx context.Context, req *v1.Validat
 	return nil, err
 }
 
+// ExportMyData calls auth.v1.AuthService.ExportMyData.
+func (c *authServiceClient) ExportMyData(ctx context.Context, req *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error) {
+	response, err := c.exportMyData.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAccount calls auth.v1.AuthService.DeleteAccount.
+func (c *authServiceClient) DeleteAccount(ctx context.Context, req *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error) {
+	response, err := c.deleteAccount.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // AuthServiceHandler is an implementation of the auth.v1.AuthService service.
 type AuthServiceHandler interface {
 	OIDCParams(context.Context, *v1.OIDCParamsRequest) (*v1.OIDCParamsResponse, error)
 	OIDCLogin(context.Context, *v1.OIDCLoginRequest) (*v1.OIDCLoginResponse, error)
 	Logout(context.Context, *v1.LogoutRequest) (*v1.LogoutResponse, error)
 	ValidateSession(context.Context, *v1.ValidateSessionRequest) (*v1.ValidateSessionResponse, error)
+	// ExportMyData returns everything stored about the caller's account.
+	ExportMyData(context.Context, *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error)
+	// DeleteAccount deletes the caller's account and everything stored about it. A call
+	// that fails part way can be repeated with the same session token to resume where it
+	// stopped; the session is only deleted by the last step.
+	DeleteAccount(context.Context, *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -173,6 +223,18 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("ValidateSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceExportMyDataHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceExportMyDataProcedure,
+		svc.ExportMyData,
+		connect.WithSchema(authServiceMethods.ByName("ExportMyData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDeleteAccountHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(authServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/auth.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceOIDCParamsProcedure:
@@ -183,6 +245,10 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceLogoutHandler.ServeHTTP(w, r)
 		case AuthServiceValidateSessionProcedure:
 			authServiceValidateSessionHandler.ServeHTTP(w, r)
+		case AuthServiceExportMyDataProcedure:
+			authServiceExportMyDataHandler.ServeHTTP(w, r)
+		case AuthServiceDeleteAccountProcedure:
+			authServiceDeleteAccountHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -207,3 +273,11 @@ func (UnimplementedAuthServiceHandler) Logout(context.Context, *v1.LogoutRequest
 func (UnimplementedAuthServiceHandler) ValidateSession(context.Context, *v1.ValidateSessionRequest) (*v1.ValidateSessionResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ValidateSession is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) ExportMyData(context.Context, *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ExportMyData is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DeleteAccount(context.Context, *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.DeleteAccount is not implemented"))
+}
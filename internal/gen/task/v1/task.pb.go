@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -85,6 +86,8 @@ const (
 	TaskStatus_TASK_STATUS_UNSPECIFIED TaskStatus = 0
 	TaskStatus_TASK_STATUS_ACTIVE      TaskStatus = 1
 	TaskStatus_TASK_STATUS_COMPLETED   TaskStatus = 2
+	// Reminders could not be registered; updating the task registers them again.
+	TaskStatus_TASK_STATUS_REMINDER_FAILED TaskStatus = 3
 )
 
 // Enum value maps for TaskStatus.
@@ -93,11 +96,13 @@ var (
 		0: "TASK_STATUS_UNSPECIFIED",
 		1: "TASK_STATUS_ACTIVE",
 		2: "TASK_STATUS_COMPLETED",
+		3: "TASK_STATUS_REMINDER_FAILED",
 	}
 	TaskStatus_value = map[string]int32{
-		"TASK_STATUS_UNSPECIFIED": 0,
-		"TASK_STATUS_ACTIVE":      1,
-		"TASK_STATUS_COMPLETED":   2,
+		"TASK_STATUS_UNSPECIFIED":     0,
+		"TASK_STATUS_ACTIVE":          1,
+		"TASK_STATUS_COMPLETED":       2,
+		"TASK_STATUS_REMINDER_FAILED": 3,
 	}
 )
 
@@ -128,11 +133,267 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return file_task_v1_task_proto_rawDescGZIP(), []int{1}
 }
 
+type TaskEventType int32
+
+const (
+	TaskEventType_TASK_EVENT_TYPE_UNSPECIFIED TaskEventType = 0
+	TaskEventType_TASK_EVENT_TYPE_CREATED     TaskEventType = 1 // also sent when a task is reopened or restored
+	TaskEventType_TASK_EVENT_TYPE_UPDATED     TaskEventType = 2
+	TaskEventType_TASK_EVENT_TYPE_COMPLETED   TaskEventType = 3
+	TaskEventType_TASK_EVENT_TYPE_DELETED     TaskEventType = 4
+)
+
+// Enum value maps for TaskEventType.
+var (
+	TaskEventType_name = map[int32]string{
+		0: "TASK_EVENT_TYPE_UNSPECIFIED",
+		1: "TASK_EVENT_TYPE_CREATED",
+		2: "TASK_EVENT_TYPE_UPDATED",
+		3: "TASK_EVENT_TYPE_COMPLETED",
+		4: "TASK_EVENT_TYPE_DELETED",
+	}
+	TaskEventType_value = map[string]int32{
+		"TASK_EVENT_TYPE_UNSPECIFIED": 0,
+		"TASK_EVENT_TYPE_CREATED":     1,
+		"TASK_EVENT_TYPE_UPDATED":     2,
+		"TASK_EVENT_TYPE_COMPLETED":   3,
+		"TASK_EVENT_TYPE_DELETED":     4,
+	}
+)
+
+func (x TaskEventType) Enum() *TaskEventType {
+	p := new(TaskEventType)
+	*p = x
+	return p
+}
+
+func (x TaskEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[2].Descriptor()
+}
+
+func (TaskEventType) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[2]
+}
+
+func (x TaskEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskEventType.Descriptor instead.
+func (TaskEventType) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+type TaskTombstoneReason int32
+
+const (
+	TaskTombstoneReason_TASK_TOMBSTONE_REASON_UNSPECIFIED TaskTombstoneReason = 0
+	TaskTombstoneReason_TASK_TOMBSTONE_REASON_COMPLETED   TaskTombstoneReason = 1
+	TaskTombstoneReason_TASK_TOMBSTONE_REASON_DELETED     TaskTombstoneReason = 2 // moved to the trash
+)
+
+// Enum value maps for TaskTombstoneReason.
+var (
+	TaskTombstoneReason_name = map[int32]string{
+		0: "TASK_TOMBSTONE_REASON_UNSPECIFIED",
+		1: "TASK_TOMBSTONE_REASON_COMPLETED",
+		2: "TASK_TOMBSTONE_REASON_DELETED",
+	}
+	TaskTombstoneReason_value = map[string]int32{
+		"TASK_TOMBSTONE_REASON_UNSPECIFIED": 0,
+		"TASK_TOMBSTONE_REASON_COMPLETED":   1,
+		"TASK_TOMBSTONE_REASON_DELETED":     2,
+	}
+)
+
+func (x TaskTombstoneReason) Enum() *TaskTombstoneReason {
+	p := new(TaskTombstoneReason)
+	*p = x
+	return p
+}
+
+func (x TaskTombstoneReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskTombstoneReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskTombstoneReason) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[3]
+}
+
+func (x TaskTombstoneReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskTombstoneReason.Descriptor instead.
+func (TaskTombstoneReason) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_ICS         ImportFormat = 1 // VTODO and VEVENT components
+	ImportFormat_IMPORT_FORMAT_CSV         ImportFormat = 2 // header row naming a title column and optionally description, due and color
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_ICS",
+		2: "IMPORT_FORMAT_CSV",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_ICS":         1,
+		"IMPORT_FORMAT_CSV":         2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[4]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 2
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_DAY",
+		2: "STATS_INTERVAL_WEEK",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_DAY":         1,
+		"STATS_INTERVAL_WEEK":        2,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[5].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[5]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+type QuietHoursPolicy int32
+
+const (
+	QuietHoursPolicy_QUIET_HOURS_POLICY_UNSPECIFIED QuietHoursPolicy = 0
+	QuietHoursPolicy_QUIET_HOURS_POLICY_SHIFT       QuietHoursPolicy = 1 // reminders in a window are sent when it ends
+	QuietHoursPolicy_QUIET_HOURS_POLICY_DROP        QuietHoursPolicy = 2 // reminders in a window are not sent
+)
+
+// Enum value maps for QuietHoursPolicy.
+var (
+	QuietHoursPolicy_name = map[int32]string{
+		0: "QUIET_HOURS_POLICY_UNSPECIFIED",
+		1: "QUIET_HOURS_POLICY_SHIFT",
+		2: "QUIET_HOURS_POLICY_DROP",
+	}
+	QuietHoursPolicy_value = map[string]int32{
+		"QUIET_HOURS_POLICY_UNSPECIFIED": 0,
+		"QUIET_HOURS_POLICY_SHIFT":       1,
+		"QUIET_HOURS_POLICY_DROP":        2,
+	}
+)
+
+func (x QuietHoursPolicy) Enum() *QuietHoursPolicy {
+	p := new(QuietHoursPolicy)
+	*p = x
+	return p
+}
+
+func (x QuietHoursPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuietHoursPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[6].Descriptor()
+}
+
+func (QuietHoursPolicy) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[6]
+}
+
+func (x QuietHoursPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuietHoursPolicy.Descriptor instead.
+func (QuietHoursPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
 type TaskSortType int32
 
 const (
 	TaskSortType_TASK_SORT_TYPE_UNSPECIFIED TaskSortType = 0
 	TaskSortType_TASK_SORT_TYPE_TARGET_AT   TaskSortType = 1
+	TaskSortType_TASK_SORT_TYPE_CREATED_AT  TaskSortType = 2
+	TaskSortType_TASK_SORT_TYPE_TITLE       TaskSortType = 3
+	TaskSortType_TASK_SORT_TYPE_TASK_TYPE   TaskSortType = 4
+	TaskSortType_TASK_SORT_TYPE_COLOR       TaskSortType = 5
+	TaskSortType_TASK_SORT_TYPE_MANUAL      TaskSortType = 6 // the order set with MoveTask
 )
 
 // Enum value maps for TaskSortType.
@@ -140,10 +401,20 @@ var (
 	TaskSortType_name = map[int32]string{
 		0: "TASK_SORT_TYPE_UNSPECIFIED",
 		1: "TASK_SORT_TYPE_TARGET_AT",
+		2: "TASK_SORT_TYPE_CREATED_AT",
+		3: "TASK_SORT_TYPE_TITLE",
+		4: "TASK_SORT_TYPE_TASK_TYPE",
+		5: "TASK_SORT_TYPE_COLOR",
+		6: "TASK_SORT_TYPE_MANUAL",
 	}
 	TaskSortType_value = map[string]int32{
 		"TASK_SORT_TYPE_UNSPECIFIED": 0,
 		"TASK_SORT_TYPE_TARGET_AT":   1,
+		"TASK_SORT_TYPE_CREATED_AT":  2,
+		"TASK_SORT_TYPE_TITLE":       3,
+		"TASK_SORT_TYPE_TASK_TYPE":   4,
+		"TASK_SORT_TYPE_COLOR":       5,
+		"TASK_SORT_TYPE_MANUAL":      6,
 	}
 )
 
@@ -158,11 +429,11 @@ func (x TaskSortType) String() string {
 }
 
 func (TaskSortType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_v1_task_proto_enumTypes[2].Descriptor()
+	return file_task_v1_task_proto_enumTypes[7].Descriptor()
 }
 
 func (TaskSortType) Type() protoreflect.EnumType {
-	return &file_task_v1_task_proto_enumTypes[2]
+	return &file_task_v1_task_proto_enumTypes[7]
 }
 
 func (x TaskSortType) Number() protoreflect.EnumNumber {
@@ -171,27 +442,133 @@ func (x TaskSortType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskSortType.Descriptor instead.
 func (TaskSortType) EnumDescriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
 }
 
-type Task struct {
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // ascending
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_v1_task_proto_enumTypes[8].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_task_v1_task_proto_enumTypes[8]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+// RFC 5545 recurrence rule for scheduled tasks
+type Recurrence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType      TaskType               `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"`
-	TaskStatus    TaskStatus             `protobuf:"varint,3,opt,name=task_status,json=taskStatus,proto3,enum=task.v1.TaskStatus" json:"task_status,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	TargetAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=target_at,json=targetAt,proto3" json:"target_at,omitempty"`
-	Color         string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`         // e.g. FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone the rule is evaluated in, UTC when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_task_v1_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recurrence) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Task struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType          TaskType               `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"`
+	TaskStatus        TaskStatus             `protobuf:"varint,3,opt,name=task_status,json=taskStatus,proto3,enum=task.v1.TaskStatus" json:"task_status,omitempty"`
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ScheduledAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TargetAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=target_at,json=targetAt,proto3" json:"target_at,omitempty"`
+	Color             string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Recurrence        *Recurrence            `protobuf:"bytes,10,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"`
+	ChecklistProgress *ChecklistProgress     `protobuf:"bytes,11,opt,name=checklist_progress,json=checklistProgress,proto3" json:"checklist_progress,omitempty"`
+	Tags              []*Tag                 `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`        // ordered by name
+	Version           int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // bumped by every edit, see UpdateTaskRequest.expected_version
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *Task) Reset() {
 	*x = Task{}
-	mi := &file_task_v1_task_proto_msgTypes[0]
+	mi := &file_task_v1_task_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -203,7 +580,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[0]
+	mi := &file_task_v1_task_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,7 +593,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{0}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Task) GetTaskId() string {
@@ -282,6 +659,34 @@ func (x *Task) GetColor() string {
 	return ""
 }
 
+func (x *Task) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Task) GetChecklistProgress() *ChecklistProgress {
+	if x != nil {
+		return x.ChecklistProgress
+	}
+	return nil
+}
+
+func (x *Task) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        *string                `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
@@ -290,13 +695,14 @@ type CreateTaskRequest struct {
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
 	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	Recurrence    *Recurrence            `protobuf:"bytes,7,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"` // only allowed for TASK_TYPE_SCHEDULED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTaskRequest) Reset() {
 	*x = CreateTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[1]
+	mi := &file_task_v1_task_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -308,7 +714,7 @@ func (x *CreateTaskRequest) String() string {
 func (*CreateTaskRequest) ProtoMessage() {}
 
 func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[1]
+	mi := &file_task_v1_task_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +727,7 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
 func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{1}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateTaskRequest) GetTaskId() string {
@@ -366,6 +772,13 @@ func (x *CreateTaskRequest) GetColor() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
 type CreateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
@@ -375,7 +788,7 @@ type CreateTaskResponse struct {
 
 func (x *CreateTaskResponse) Reset() {
 	*x = CreateTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[2]
+	mi := &file_task_v1_task_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +800,7 @@ func (x *CreateTaskResponse) String() string {
 func (*CreateTaskResponse) ProtoMessage() {}
 
 func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[2]
+	mi := &file_task_v1_task_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +813,7 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
 func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{2}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateTaskResponse) GetTask() *Task {
@@ -419,7 +832,7 @@ type GetTaskRequest struct {
 
 func (x *GetTaskRequest) Reset() {
 	*x = GetTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[3]
+	mi := &file_task_v1_task_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +844,7 @@ func (x *GetTaskRequest) String() string {
 func (*GetTaskRequest) ProtoMessage() {}
 
 func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[3]
+	mi := &file_task_v1_task_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +857,7 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{3}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTaskRequest) GetTaskId() string {
@@ -463,7 +876,7 @@ type GetTaskResponse struct {
 
 func (x *GetTaskResponse) Reset() {
 	*x = GetTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[4]
+	mi := &file_task_v1_task_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -475,7 +888,7 @@ func (x *GetTaskResponse) String() string {
 func (*GetTaskResponse) ProtoMessage() {}
 
 func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[4]
+	mi := &file_task_v1_task_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +901,7 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
 func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{4}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTaskResponse) GetTask() *Task {
@@ -501,13 +914,21 @@ func (x *GetTaskResponse) GetTask() *Task {
 type ListActiveTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SortType      TaskSortType           `protobuf:"varint,1,opt,name=sort_type,json=sortType,proto3,enum=task.v1.TaskSortType" json:"sort_type,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 uses the server default
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	TaskType      *TaskType              `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType,oneof" json:"task_type,omitempty"`
+	Color         *string                `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	TargetAtFrom  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=target_at_from,json=targetAtFrom,proto3,oneof" json:"target_at_from,omitempty"` // inclusive
+	TargetAtTo    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=target_at_to,json=targetAtTo,proto3,oneof" json:"target_at_to,omitempty"`       // exclusive
+	SortDirection SortDirection          `protobuf:"varint,8,opt,name=sort_direction,json=sortDirection,proto3,enum=task.v1.SortDirection" json:"sort_direction,omitempty"`
+	TagId         *string                `protobuf:"bytes,9,opt,name=tag_id,json=tagId,proto3,oneof" json:"tag_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListActiveTasksRequest) Reset() {
 	*x = ListActiveTasksRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[5]
+	mi := &file_task_v1_task_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +940,7 @@ func (x *ListActiveTasksRequest) String() string {
 func (*ListActiveTasksRequest) ProtoMessage() {}
 
 func (x *ListActiveTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[5]
+	mi := &file_task_v1_task_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +953,7 @@ func (x *ListActiveTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActiveTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListActiveTasksRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{5}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListActiveTasksRequest) GetSortType() TaskSortType {
@@ -542,28 +963,4488 @@ func (x *ListActiveTasksRequest) GetSortType() TaskSortType {
 	return TaskSortType_TASK_SORT_TYPE_UNSPECIFIED
 }
 
+func (x *ListActiveTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListActiveTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListActiveTasksRequest) GetTaskType() TaskType {
+	if x != nil && x.TaskType != nil {
+		return *x.TaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *ListActiveTasksRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *ListActiveTasksRequest) GetTargetAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetAtFrom
+	}
+	return nil
+}
+
+func (x *ListActiveTasksRequest) GetTargetAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetAtTo
+	}
+	return nil
+}
+
+func (x *ListActiveTasksRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListActiveTasksRequest) GetTagId() string {
+	if x != nil && x.TagId != nil {
+		return *x.TagId
+	}
+	return ""
+}
+
 type ListActiveTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty when there are no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveTasksResponse) Reset() {
+	*x = ListActiveTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActiveTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveTasksResponse) ProtoMessage() {}
+
+func (x *ListActiveTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListActiveTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListActiveTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListActiveTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateTaskRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskStatus  TaskStatus             `protobuf:"varint,2,opt,name=task_status,json=taskStatus,proto3,enum=task.v1.TaskStatus" json:"task_status,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
+	Color       string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Recurrence  *Recurrence            `protobuf:"bytes,8,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"` // cleared when in update_mask but unset
+	// The version of the task the update was made on. When the task is at another version the
+	// update fails with Aborted and the current Task in the error details, so the client can
+	// merge its edit and retry. Without it the update applies to the current version.
+	ExpectedVersion *int64 `protobuf:"varint,9,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTaskStatus() TaskStatus {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateTaskResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Task           *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	NextOccurrence *Task                  `protobuf:"bytes,2,opt,name=next_occurrence,json=nextOccurrence,proto3,oneof" json:"next_occurrence,omitempty"` // set when completing a recurring task schedules the next one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskResponse) GetNextOccurrence() *Task {
+	if x != nil {
+		return x.NextOccurrence
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActiveTasksResponse) Reset() {
-	*x = ListActiveTasksResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[6]
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{11}
+}
+
+type SnoozeTaskRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Types that are valid to be assigned to Snooze:
+	//
+	//	*SnoozeTaskRequest_Duration
+	//	*SnoozeTaskRequest_Until
+	Snooze        isSnoozeTaskRequest_Snooze `protobuf_oneof:"snooze"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeTaskRequest) Reset() {
+	*x = SnoozeTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeTaskRequest) ProtoMessage() {}
+
+func (x *SnoozeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeTaskRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SnoozeTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SnoozeTaskRequest) GetSnooze() isSnoozeTaskRequest_Snooze {
+	if x != nil {
+		return x.Snooze
+	}
+	return nil
+}
+
+func (x *SnoozeTaskRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		if x, ok := x.Snooze.(*SnoozeTaskRequest_Duration); ok {
+			return x.Duration
+		}
+	}
+	return nil
+}
+
+func (x *SnoozeTaskRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Snooze.(*SnoozeTaskRequest_Until); ok {
+			return x.Until
+		}
+	}
+	return nil
+}
+
+type isSnoozeTaskRequest_Snooze interface {
+	isSnoozeTaskRequest_Snooze()
+}
+
+type SnoozeTaskRequest_Duration struct {
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3,oneof"` // snooze relative to now
+}
+
+type SnoozeTaskRequest_Until struct {
+	Until *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3,oneof"` // snooze to an absolute time
+}
+
+func (*SnoozeTaskRequest_Duration) isSnoozeTaskRequest_Snooze() {}
+
+func (*SnoozeTaskRequest_Until) isSnoozeTaskRequest_Snooze() {}
+
+type SnoozeTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeTaskResponse) Reset() {
+	*x = SnoozeTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeTaskResponse) ProtoMessage() {}
+
+func (x *SnoozeTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeTaskResponse.ProtoReflect.Descriptor instead.
+func (*SnoozeTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SnoozeTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type MoveTaskRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// at least one of before_id and after_id is required
+	BeforeId      *string `protobuf:"bytes,2,opt,name=before_id,json=beforeId,proto3,oneof" json:"before_id,omitempty"` // the task to place after
+	AfterId       *string `protobuf:"bytes,3,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`    // the task to place before
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MoveTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetBeforeId() string {
+	if x != nil && x.BeforeId != nil {
+		return *x.BeforeId
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetAfterId() string {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return ""
+}
+
+type MoveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskResponse) Reset() {
+	*x = MoveTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskResponse) ProtoMessage() {}
+
+func (x *MoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*MoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type BatchUpdateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updates       []*UpdateTaskRequest   `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksRequest) Reset() {
+	*x = BatchUpdateTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTasksRequest) ProtoMessage() {}
+
+func (x *BatchUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchUpdateTasksRequest) GetUpdates() []*UpdateTaskRequest {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+// The reason a single task of a batch failed. code is the Connect code the matching single
+// task RPC would have returned, e.g. "not_found".
+type BatchTaskError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTaskError) Reset() {
+	*x = BatchTaskError{}
+	mi := &file_task_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTaskError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTaskError) ProtoMessage() {}
+
+func (x *BatchTaskError) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTaskError.ProtoReflect.Descriptor instead.
+func (*BatchTaskError) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchTaskError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchTaskError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchUpdateTaskResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*BatchUpdateTaskResult_Updated
+	//	*BatchUpdateTaskResult_Error
+	Result        isBatchUpdateTaskResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTaskResult) Reset() {
+	*x = BatchUpdateTaskResult{}
+	mi := &file_task_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTaskResult) ProtoMessage() {}
+
+func (x *BatchUpdateTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTaskResult.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTaskResult) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchUpdateTaskResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *BatchUpdateTaskResult) GetResult() isBatchUpdateTaskResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *BatchUpdateTaskResult) GetUpdated() *UpdateTaskResponse {
+	if x != nil {
+		if x, ok := x.Result.(*BatchUpdateTaskResult_Updated); ok {
+			return x.Updated
+		}
+	}
+	return nil
+}
+
+func (x *BatchUpdateTaskResult) GetError() *BatchTaskError {
+	if x != nil {
+		if x, ok := x.Result.(*BatchUpdateTaskResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isBatchUpdateTaskResult_Result interface {
+	isBatchUpdateTaskResult_Result()
+}
+
+type BatchUpdateTaskResult_Updated struct {
+	Updated *UpdateTaskResponse `protobuf:"bytes,2,opt,name=updated,proto3,oneof"`
+}
+
+type BatchUpdateTaskResult_Error struct {
+	Error *BatchTaskError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchUpdateTaskResult_Updated) isBatchUpdateTaskResult_Result() {}
+
+func (*BatchUpdateTaskResult_Error) isBatchUpdateTaskResult_Result() {}
+
+type BatchUpdateTasksResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*BatchUpdateTaskResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksResponse) Reset() {
+	*x = BatchUpdateTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTasksResponse) ProtoMessage() {}
+
+func (x *BatchUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchUpdateTasksResponse) GetResults() []*BatchUpdateTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeleteTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []string               `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTasksRequest) Reset() {
+	*x = BatchDeleteTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTasksRequest) ProtoMessage() {}
+
+func (x *BatchDeleteTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchDeleteTasksRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+type BatchDeleteTaskResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Error         *BatchTaskError        `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"` // unset when the task was deleted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTaskResult) Reset() {
+	*x = BatchDeleteTaskResult{}
+	mi := &file_task_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTaskResult) ProtoMessage() {}
+
+func (x *BatchDeleteTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTaskResult.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTaskResult) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchDeleteTaskResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *BatchDeleteTaskResult) GetError() *BatchTaskError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BatchDeleteTasksResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*BatchDeleteTaskResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteTasksResponse) Reset() {
+	*x = BatchDeleteTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteTasksResponse) ProtoMessage() {}
+
+func (x *BatchDeleteTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchDeleteTasksResponse) GetResults() []*BatchDeleteTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ImportTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ImportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=task.v1.ImportFormat" json:"format,omitempty"`
+	Data   []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Type of entries without a date; dated entries become TASK_TYPE_SCHEDULED
+	DefaultTaskType TaskType `protobuf:"varint,3,opt,name=default_task_type,json=defaultTaskType,proto3,enum=task.v1.TaskType" json:"default_task_type,omitempty"`
+	DefaultColor    string   `protobuf:"bytes,4,opt,name=default_color,json=defaultColor,proto3" json:"default_color,omitempty"` // for entries that do not set one
+	Timezone        string   `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                             // IANA name for dates without an offset; empty means UTC
+	DryRun          bool     `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                  // validate every entry without creating any task
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportTasksRequest) Reset() {
+	*x = ImportTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksRequest) ProtoMessage() {}
+
+func (x *ImportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportTasksRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportTasksRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportTasksRequest) GetDefaultTaskType() TaskType {
+	if x != nil {
+		return x.DefaultTaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *ImportTasksRequest) GetDefaultColor() string {
+	if x != nil {
+		return x.DefaultColor
+	}
+	return ""
+}
+
+func (x *ImportTasksRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ImportTasksRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportTaskResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Row   int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1-based position of the entry in the file
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ImportTaskResult_Task
+	//	*ImportTaskResult_Error
+	Result        isImportTaskResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTaskResult) Reset() {
+	*x = ImportTaskResult{}
+	mi := &file_task_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTaskResult) ProtoMessage() {}
+
+func (x *ImportTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTaskResult.ProtoReflect.Descriptor instead.
+func (*ImportTaskResult) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportTaskResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportTaskResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportTaskResult) GetResult() isImportTaskResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ImportTaskResult) GetTask() *Task {
+	if x != nil {
+		if x, ok := x.Result.(*ImportTaskResult_Task); ok {
+			return x.Task
+		}
+	}
+	return nil
+}
+
+func (x *ImportTaskResult) GetError() *BatchTaskError {
+	if x != nil {
+		if x, ok := x.Result.(*ImportTaskResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isImportTaskResult_Result interface {
+	isImportTaskResult_Result()
+}
+
+type ImportTaskResult_Task struct {
+	Task *Task `protobuf:"bytes,3,opt,name=task,proto3,oneof"` // the task created, or that would be created on a dry run
+}
+
+type ImportTaskResult_Error struct {
+	Error *BatchTaskError `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+func (*ImportTaskResult_Task) isImportTaskResult_Result() {}
+
+func (*ImportTaskResult_Error) isImportTaskResult_Result() {}
+
+type ImportTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ImportTaskResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`    // in file order
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"` // always 0 on a dry run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksResponse) Reset() {
+	*x = ImportTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksResponse) ProtoMessage() {}
+
+func (x *ImportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportTasksResponse) GetResults() []*ImportTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+type SearchTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 uses the server default
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	TagId         *string                `protobuf:"bytes,4,opt,name=tag_id,json=tagId,proto3,oneof" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetTagId() string {
+	if x != nil && x.TagId != nil {
+		return *x.TagId
+	}
+	return ""
+}
+
+// Highlights enclose the matched terms in <mark> and </mark>. The rest of the text is
+// HTML-escaped, so the highlights can be rendered as HTML.
+type SearchTaskHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Task:
+	//
+	//	*SearchTaskHit_ActiveTask
+	//	*SearchTaskHit_CompletedTask
+	Task                 isSearchTaskHit_Task `protobuf_oneof:"task"`
+	Score                float32              `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"` // relevance, hits are ordered from the highest
+	TitleHighlight       string               `protobuf:"bytes,4,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionHighlight string               `protobuf:"bytes,5,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SearchTaskHit) Reset() {
+	*x = SearchTaskHit{}
+	mi := &file_task_v1_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTaskHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTaskHit) ProtoMessage() {}
+
+func (x *SearchTaskHit) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTaskHit.ProtoReflect.Descriptor instead.
+func (*SearchTaskHit) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchTaskHit) GetTask() isSearchTaskHit_Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *SearchTaskHit) GetActiveTask() *Task {
+	if x != nil {
+		if x, ok := x.Task.(*SearchTaskHit_ActiveTask); ok {
+			return x.ActiveTask
+		}
+	}
+	return nil
+}
+
+func (x *SearchTaskHit) GetCompletedTask() *CompletedTask {
+	if x != nil {
+		if x, ok := x.Task.(*SearchTaskHit_CompletedTask); ok {
+			return x.CompletedTask
+		}
+	}
+	return nil
+}
+
+func (x *SearchTaskHit) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchTaskHit) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchTaskHit) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+type isSearchTaskHit_Task interface {
+	isSearchTaskHit_Task()
+}
+
+type SearchTaskHit_ActiveTask struct {
+	ActiveTask *Task `protobuf:"bytes,1,opt,name=active_task,json=activeTask,proto3,oneof"`
+}
+
+type SearchTaskHit_CompletedTask struct {
+	CompletedTask *CompletedTask `protobuf:"bytes,2,opt,name=completed_task,json=completedTask,proto3,oneof"`
+}
+
+func (*SearchTaskHit_ActiveTask) isSearchTaskHit_Task() {}
+
+func (*SearchTaskHit_CompletedTask) isSearchTaskHit_Task() {}
+
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchTaskHit       `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty when there are no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchTasksResponse) GetHits() []*SearchTaskHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WatchTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTasksRequest) Reset() {
+	*x = WatchTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTasksRequest) ProtoMessage() {}
+
+func (x *WatchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTasksRequest.ProtoReflect.Descriptor instead.
+func (*WatchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{29}
+}
+
+// Events are pushed after the change commits. Events are not replayed, so a client refetches
+// its tasks whenever it (re)connects. The server ends a stream after 30 minutes, and with
+// UNAVAILABLE when the client falls behind or the server shuts down.
+type TaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TaskEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=task.v1.TaskEventType" json:"type,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task          *Task                  `protobuf:"bytes,3,opt,name=task,proto3,oneof" json:"task,omitempty"` // the current task for created and updated events
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_task_v1_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TaskEvent) GetType() TaskEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskEventType_TASK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TaskEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// An empty since_token asks for a full sync, which returns every active task. Apply each
+// response, then sync again from next_sync_token: right away while has_more is set, and
+// later to pick up new changes. A task is in at most one of tasks and tombstones. A token
+// rejected with FAILED_PRECONDITION is no longer usable; drop local tasks and sync again
+// from an empty token.
+type SyncTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SinceToken    string                 `protobuf:"bytes,1,opt,name=since_token,json=sinceToken,proto3" json:"since_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 uses the server default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncTasksRequest) Reset() {
+	*x = SyncTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTasksRequest) ProtoMessage() {}
+
+func (x *SyncTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTasksRequest.ProtoReflect.Descriptor instead.
+func (*SyncTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SyncTasksRequest) GetSinceToken() string {
+	if x != nil {
+		return x.SinceToken
+	}
+	return ""
+}
+
+func (x *SyncTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// TaskTombstone is a task that left the active tasks since the token.
+type TaskTombstone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Reason        TaskTombstoneReason    `protobuf:"varint,2,opt,name=reason,proto3,enum=task.v1.TaskTombstoneReason" json:"reason,omitempty"`
+	RemovedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTombstone) Reset() {
+	*x = TaskTombstone{}
+	mi := &file_task_v1_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTombstone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTombstone) ProtoMessage() {}
+
+func (x *TaskTombstone) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTombstone.ProtoReflect.Descriptor instead.
+func (*TaskTombstone) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TaskTombstone) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskTombstone) GetReason() TaskTombstoneReason {
+	if x != nil {
+		return x.Reason
+	}
+	return TaskTombstoneReason_TASK_TOMBSTONE_REASON_UNSPECIFIED
+}
+
+func (x *TaskTombstone) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return nil
+}
+
+type SyncTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // created or changed, with their current state
+	Tombstones    []*TaskTombstone       `protobuf:"bytes,2,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	NextSyncToken string                 `protobuf:"bytes,3,opt,name=next_sync_token,json=nextSyncToken,proto3" json:"next_sync_token,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncTasksResponse) Reset() {
+	*x = SyncTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTasksResponse) ProtoMessage() {}
+
+func (x *SyncTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTasksResponse.ProtoReflect.Descriptor instead.
+func (*SyncTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SyncTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *SyncTasksResponse) GetTombstones() []*TaskTombstone {
+	if x != nil {
+		return x.Tombstones
+	}
+	return nil
+}
+
+func (x *SyncTasksResponse) GetNextSyncToken() string {
+	if x != nil {
+		return x.NextSyncToken
+	}
+	return ""
+}
+
+func (x *SyncTasksResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type CompletedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType      TaskType               `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TargetAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=target_at,json=targetAt,proto3" json:"target_at,omitempty"`
+	Color         string                 `protobuf:"bytes,8,opt,name=color,proto3" json:"color,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Checklist     []*ChecklistItem       `protobuf:"bytes,10,rep,name=checklist,proto3" json:"checklist,omitempty"` // as it was when the task was completed
+	Tags          []*Tag                 `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`           // ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletedTask) Reset() {
+	*x = CompletedTask{}
+	mi := &file_task_v1_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletedTask) ProtoMessage() {}
+
+func (x *CompletedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletedTask.ProtoReflect.Descriptor instead.
+func (*CompletedTask) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CompletedTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CompletedTask) GetTaskType() TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *CompletedTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CompletedTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CompletedTask) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *CompletedTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CompletedTask) GetTargetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetAt
+	}
+	return nil
+}
+
+func (x *CompletedTask) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CompletedTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CompletedTask) GetChecklist() []*ChecklistItem {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *CompletedTask) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListCompletedTasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 uses the server default
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	TaskType        *TaskType              `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType,oneof" json:"task_type,omitempty"`
+	CompletedAtFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at_from,json=completedAtFrom,proto3,oneof" json:"completed_at_from,omitempty"` // inclusive
+	CompletedAtTo   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at_to,json=completedAtTo,proto3,oneof" json:"completed_at_to,omitempty"`       // exclusive
+	TagId           *string                `protobuf:"bytes,6,opt,name=tag_id,json=tagId,proto3,oneof" json:"tag_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCompletedTasksRequest) Reset() {
+	*x = ListCompletedTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompletedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompletedTasksRequest) ProtoMessage() {}
+
+func (x *ListCompletedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompletedTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListCompletedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListCompletedTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCompletedTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCompletedTasksRequest) GetTaskType() TaskType {
+	if x != nil && x.TaskType != nil {
+		return *x.TaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *ListCompletedTasksRequest) GetCompletedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAtFrom
+	}
+	return nil
+}
+
+func (x *ListCompletedTasksRequest) GetCompletedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAtTo
+	}
+	return nil
+}
+
+func (x *ListCompletedTasksRequest) GetTagId() string {
+	if x != nil && x.TagId != nil {
+		return *x.TagId
+	}
+	return ""
+}
+
+type ListCompletedTasksResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CompletedTasks []*CompletedTask       `protobuf:"bytes,1,rep,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"` // most recently completed first
+	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`  // empty when there are no more pages
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCompletedTasksResponse) Reset() {
+	*x = ListCompletedTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompletedTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompletedTasksResponse) ProtoMessage() {}
+
+func (x *ListCompletedTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompletedTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListCompletedTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListCompletedTasksResponse) GetCompletedTasks() []*CompletedTask {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return nil
+}
+
+func (x *ListCompletedTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCompletedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompletedTaskRequest) Reset() {
+	*x = GetCompletedTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompletedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompletedTaskRequest) ProtoMessage() {}
+
+func (x *GetCompletedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompletedTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetCompletedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCompletedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type GetCompletedTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompletedTask *CompletedTask         `protobuf:"bytes,1,opt,name=completed_task,json=completedTask,proto3" json:"completed_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompletedTaskResponse) Reset() {
+	*x = GetCompletedTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompletedTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompletedTaskResponse) ProtoMessage() {}
+
+func (x *GetCompletedTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompletedTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetCompletedTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCompletedTaskResponse) GetCompletedTask() *CompletedTask {
+	if x != nil {
+		return x.CompletedTask
+	}
+	return nil
+}
+
+type ReopenTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskRequest) Reset() {
+	*x = ReopenTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskRequest) ProtoMessage() {}
+
+func (x *ReopenTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReopenTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReopenTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ReopenTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskResponse) Reset() {
+	*x = ReopenTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskResponse) ProtoMessage() {}
+
+func (x *ReopenTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskResponse.ProtoReflect.Descriptor instead.
+func (*ReopenTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReopenTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Stats are bucketed by calendar day or week in the given timezone. Weeks start on
+// Monday. The buckets end with the one containing the current time.
+type GetTaskStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone, e.g. Asia/Tokyo
+	Interval      StatsInterval          `protobuf:"varint,2,opt,name=interval,proto3,enum=task.v1.StatsInterval" json:"interval,omitempty"`
+	BucketCount   int32                  `protobuf:"varint,3,opt,name=bucket_count,json=bucketCount,proto3" json:"bucket_count,omitempty"` // 0 uses the server default of 30
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsRequest) Reset() {
+	*x = GetTaskStatsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsRequest) ProtoMessage() {}
+
+func (x *GetTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTaskStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetTaskStatsRequest) GetBucketCount() int32 {
+	if x != nil {
+		return x.BucketCount
+	}
+	return 0
+}
+
+type CompletionBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // local midnight starting the bucket
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletionBucket) Reset() {
+	*x = CompletionBucket{}
+	mi := &file_task_v1_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletionBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionBucket) ProtoMessage() {}
+
+func (x *CompletionBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionBucket.ProtoReflect.Descriptor instead.
+func (*CompletionBucket) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CompletionBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CompletionBucket) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+type TaskTypeStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TaskType             TaskType               `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"`
+	Completed            int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	OnTime               int32                  `protobuf:"varint,3,opt,name=on_time,json=onTime,proto3" json:"on_time,omitempty"`                                              // completed no later than target_at
+	MedianTimeToComplete *durationpb.Duration   `protobuf:"bytes,4,opt,name=median_time_to_complete,json=medianTimeToComplete,proto3" json:"median_time_to_complete,omitempty"` // from created_at to completed_at
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TaskTypeStats) Reset() {
+	*x = TaskTypeStats{}
+	mi := &file_task_v1_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTypeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTypeStats) ProtoMessage() {}
+
+func (x *TaskTypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTypeStats.ProtoReflect.Descriptor instead.
+func (*TaskTypeStats) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TaskTypeStats) GetTaskType() TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *TaskTypeStats) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TaskTypeStats) GetOnTime() int32 {
+	if x != nil {
+		return x.OnTime
+	}
+	return 0
+}
+
+func (x *TaskTypeStats) GetMedianTimeToComplete() *durationpb.Duration {
+	if x != nil {
+		return x.MedianTimeToComplete
+	}
+	return nil
+}
+
+type GetTaskStatsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Buckets []*CompletionBucket    `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"` // oldest first
+	// The totals and task types cover the completions in the buckets
+	Completed   int32            `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	OnTime      int32            `protobuf:"varint,3,opt,name=on_time,json=onTime,proto3" json:"on_time,omitempty"`
+	OnTimeRatio float64          `protobuf:"fixed64,4,opt,name=on_time_ratio,json=onTimeRatio,proto3" json:"on_time_ratio,omitempty"` // 0 when nothing was completed
+	TaskTypes   []*TaskTypeStats `protobuf:"bytes,5,rep,name=task_types,json=taskTypes,proto3" json:"task_types,omitempty"`           // only types with completions
+	// Streaks count consecutive buckets with a completion over the whole history. The
+	// current streak is still running when the current bucket has no completion yet.
+	CurrentStreak int32 `protobuf:"varint,6,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	LongestStreak int32 `protobuf:"varint,7,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsResponse) Reset() {
+	*x = GetTaskStatsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsResponse) ProtoMessage() {}
+
+func (x *GetTaskStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTaskStatsResponse) GetBuckets() []*CompletionBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetOnTime() int32 {
+	if x != nil {
+		return x.OnTime
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetOnTimeRatio() float64 {
+	if x != nil {
+		return x.OnTimeRatio
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetTaskTypes() []*TaskTypeStats {
+	if x != nil {
+		return x.TaskTypes
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+type DeletedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedTask) Reset() {
+	*x = DeletedTask{}
+	mi := &file_task_v1_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedTask) ProtoMessage() {}
+
+func (x *DeletedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedTask.ProtoReflect.Descriptor instead.
+func (*DeletedTask) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeletedTask) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *DeletedTask) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ListDeletedTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 uses the server default
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTasksRequest) Reset() {
+	*x = ListDeletedTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTasksRequest) ProtoMessage() {}
+
+func (x *ListDeletedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListDeletedTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDeletedTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedTasks  []*DeletedTask         `protobuf:"bytes,1,rep,name=deleted_tasks,json=deletedTasks,proto3" json:"deleted_tasks,omitempty"`      // most recently deleted first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty when there are no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTasksResponse) Reset() {
+	*x = ListDeletedTasksResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTasksResponse) ProtoMessage() {}
+
+func (x *ListDeletedTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListDeletedTasksResponse) GetDeletedTasks() []*DeletedTask {
+	if x != nil {
+		return x.DeletedTasks
+	}
+	return nil
+}
+
+func (x *ListDeletedTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskRequest) ProtoMessage() {}
+
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RestoreTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type RestoreTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskResponse) Reset() {
+	*x = RestoreTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskResponse) ProtoMessage() {}
+
+func (x *RestoreTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RestoreTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"` // 0-based order within the checklist
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_task_v1_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChecklistItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ChecklistProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Done          int32                  `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistProgress) Reset() {
+	*x = ChecklistProgress{}
+	mi := &file_task_v1_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistProgress) ProtoMessage() {}
+
+func (x *ChecklistProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistProgress.ProtoReflect.Descriptor instead.
+func (*ChecklistProgress) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ChecklistProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChecklistProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+type ListChecklistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsRequest) Reset() {
+	*x = ListChecklistItemsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ListChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ListChecklistItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // ordered by position
+	Progress      *ChecklistProgress     `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsResponse) Reset() {
+	*x = ListChecklistItemsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsResponse) ProtoMessage() {}
+
+func (x *ListChecklistItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListChecklistItemsResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListChecklistItemsResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type AddChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChecklistItemRequest) Reset() {
+	*x = AddChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChecklistItemRequest) ProtoMessage() {}
+
+func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddChecklistItemRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AddChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // ordered by position, the new item last
+	Progress      *ChecklistProgress     `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChecklistItemResponse) Reset() {
+	*x = AddChecklistItemResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChecklistItemResponse) ProtoMessage() {}
+
+func (x *AddChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddChecklistItemResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AddChecklistItemResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type ToggleChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"` // the state to switch the item to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleChecklistItemRequest) Reset() {
+	*x = ToggleChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleChecklistItemRequest) ProtoMessage() {}
+
+func (x *ToggleChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*ToggleChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ToggleChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ToggleChecklistItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ToggleChecklistItemRequest) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type ToggleChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Progress      *ChecklistProgress     `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleChecklistItemResponse) Reset() {
+	*x = ToggleChecklistItemResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleChecklistItemResponse) ProtoMessage() {}
+
+func (x *ToggleChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*ToggleChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ToggleChecklistItemResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ToggleChecklistItemResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type ReorderChecklistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"` // every item of the checklist in its new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChecklistItemsRequest) Reset() {
+	*x = ReorderChecklistItemsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ReorderChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReorderChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReorderChecklistItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type ReorderChecklistItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Progress      *ChecklistProgress     `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChecklistItemsResponse) Reset() {
+	*x = ReorderChecklistItemsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChecklistItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChecklistItemsResponse) ProtoMessage() {}
+
+func (x *ReorderChecklistItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChecklistItemsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReorderChecklistItemsResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReorderChecklistItemsResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type DeleteChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteChecklistItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type DeleteChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Progress      *ChecklistProgress     `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemResponse) Reset() {
+	*x = DeleteChecklistItemResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemResponse) ProtoMessage() {}
+
+func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteChecklistItemResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DeleteChecklistItemResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_task_v1_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Tag) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Tag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{65}
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+type UpdateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagResponse) Reset() {
+	*x = UpdateTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagResponse) ProtoMessage() {}
+
+func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{70}
+}
+
+type AttachTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachTagRequest) Reset() {
+	*x = AttachTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachTagRequest) ProtoMessage() {}
+
+func (x *AttachTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachTagRequest.ProtoReflect.Descriptor instead.
+func (*AttachTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AttachTagRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AttachTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type AttachTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // every tag of the task, ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachTagResponse) Reset() {
+	*x = AttachTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachTagResponse) ProtoMessage() {}
+
+func (x *AttachTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachTagResponse.ProtoReflect.Descriptor instead.
+func (*AttachTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AttachTagResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DetachTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachTagRequest) Reset() {
+	*x = DetachTagRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachTagRequest) ProtoMessage() {}
+
+func (x *DetachTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachTagRequest.ProtoReflect.Descriptor instead.
+func (*DetachTagRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DetachTagRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DetachTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type DetachTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // every tag of the task, ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachTagResponse) Reset() {
+	*x = DetachTagResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachTagResponse) ProtoMessage() {}
+
+func (x *DetachTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachTagResponse.ProtoReflect.Descriptor instead.
+func (*DetachTagResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DetachTagResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Period setting for a specific task type
+type PeriodSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskType      TaskType               `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"` // SHORT, NEAR, RELAXED only (not SCHEDULED)
+	PeriodMinutes int64                  `protobuf:"varint,2,opt,name=period_minutes,json=periodMinutes,proto3" json:"period_minutes,omitempty"`        // 1 minute to 7 days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodSetting) Reset() {
+	*x = PeriodSetting{}
+	mi := &file_task_v1_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodSetting) ProtoMessage() {}
+
+func (x *PeriodSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodSetting.ProtoReflect.Descriptor instead.
+func (*PeriodSetting) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PeriodSetting) GetTaskType() TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *PeriodSetting) GetPeriodMinutes() int64 {
+	if x != nil {
+		return x.PeriodMinutes
+	}
+	return 0
+}
+
+type GetUserPeriodSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPeriodSettingsRequest) Reset() {
+	*x = GetUserPeriodSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPeriodSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPeriodSettingsRequest) ProtoMessage() {}
+
+func (x *GetUserPeriodSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPeriodSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPeriodSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{76}
+}
+
+type GetUserPeriodSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*PeriodSetting       `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"` // User's custom settings
+	Defaults      []*PeriodSetting       `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty"` // Default values for reference
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPeriodSettingsResponse) Reset() {
+	*x = GetUserPeriodSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPeriodSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPeriodSettingsResponse) ProtoMessage() {}
+
+func (x *GetUserPeriodSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPeriodSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPeriodSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetUserPeriodSettingsResponse) GetSettings() []*PeriodSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GetUserPeriodSettingsResponse) GetDefaults() []*PeriodSetting {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+type UpdateUserPeriodSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*PeriodSetting       `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserPeriodSettingsRequest) Reset() {
+	*x = UpdateUserPeriodSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserPeriodSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPeriodSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateUserPeriodSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPeriodSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserPeriodSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateUserPeriodSettingsRequest) GetSettings() []*PeriodSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateUserPeriodSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*PeriodSetting       `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserPeriodSettingsResponse) Reset() {
+	*x = UpdateUserPeriodSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserPeriodSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPeriodSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateUserPeriodSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPeriodSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserPeriodSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateUserPeriodSettingsResponse) GetSettings() []*PeriodSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Reminder intervals for a specific task type
+type ReminderIntervalSetting struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TaskType TaskType               `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"`
+	// Fractions of the window between creation and target_at after which a reminder is
+	// sent; the reminder at target_at is always sent. Empty means only that one.
+	Intervals     []float64 `protobuf:"fixed64,2,rep,packed,name=intervals,proto3" json:"intervals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReminderIntervalSetting) Reset() {
+	*x = ReminderIntervalSetting{}
+	mi := &file_task_v1_task_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderIntervalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderIntervalSetting) ProtoMessage() {}
+
+func (x *ReminderIntervalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderIntervalSetting.ProtoReflect.Descriptor instead.
+func (*ReminderIntervalSetting) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReminderIntervalSetting) GetTaskType() TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *ReminderIntervalSetting) GetIntervals() []float64 {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+type GetUserReminderIntervalSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReminderIntervalSettingsRequest) Reset() {
+	*x = GetUserReminderIntervalSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReminderIntervalSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReminderIntervalSettingsRequest) ProtoMessage() {}
+
+func (x *GetUserReminderIntervalSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReminderIntervalSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReminderIntervalSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{81}
+}
+
+type GetUserReminderIntervalSettingsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Settings      []*ReminderIntervalSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"` // User's custom settings
+	Defaults      []*ReminderIntervalSetting `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty"` // Default values for reference; scheduled tasks use one of them by window length
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReminderIntervalSettingsResponse) Reset() {
+	*x = GetUserReminderIntervalSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReminderIntervalSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReminderIntervalSettingsResponse) ProtoMessage() {}
+
+func (x *GetUserReminderIntervalSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReminderIntervalSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReminderIntervalSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetUserReminderIntervalSettingsResponse) GetSettings() []*ReminderIntervalSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GetUserReminderIntervalSettingsResponse) GetDefaults() []*ReminderIntervalSetting {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+type UpdateUserReminderIntervalSettingsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Settings      []*ReminderIntervalSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"` // replaces every custom setting; omitted types go back to the defaults
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserReminderIntervalSettingsRequest) Reset() {
+	*x = UpdateUserReminderIntervalSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserReminderIntervalSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserReminderIntervalSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateUserReminderIntervalSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserReminderIntervalSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserReminderIntervalSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateUserReminderIntervalSettingsRequest) GetSettings() []*ReminderIntervalSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateUserReminderIntervalSettingsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Settings      []*ReminderIntervalSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserReminderIntervalSettingsResponse) Reset() {
+	*x = UpdateUserReminderIntervalSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserReminderIntervalSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserReminderIntervalSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateUserReminderIntervalSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserReminderIntervalSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserReminderIntervalSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateUserReminderIntervalSettingsResponse) GetSettings() []*ReminderIntervalSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// A quiet window starting on a weekday. Minutes are counted from local midnight; a window
+// whose end is not after its start ends on the following day, so 1320-420 is 22:00-07:00.
+type QuietWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"` // 0 is Sunday
+	StartMinute   int32                  `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute     int32                  `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietWindow) Reset() {
+	*x = QuietWindow{}
+	mi := &file_task_v1_task_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietWindow) ProtoMessage() {}
+
+func (x *QuietWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietWindow.ProtoReflect.Descriptor instead.
+func (*QuietWindow) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *QuietWindow) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *QuietWindow) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *QuietWindow) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+type QuietHoursSettings struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Timezone            string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone, e.g. Asia/Tokyo
+	Windows             []*QuietWindow         `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	Policy              QuietHoursPolicy       `protobuf:"varint,3,opt,name=policy,proto3,enum=task.v1.QuietHoursPolicy" json:"policy,omitempty"`
+	DeadlineAlwaysFires bool                   `protobuf:"varint,4,opt,name=deadline_always_fires,json=deadlineAlwaysFires,proto3" json:"deadline_always_fires,omitempty"` // the reminder at target_at is sent even inside a window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QuietHoursSettings) Reset() {
+	*x = QuietHoursSettings{}
+	mi := &file_task_v1_task_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActiveTasksResponse) String() string {
+func (x *QuietHoursSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActiveTasksResponse) ProtoMessage() {}
+func (*QuietHoursSettings) ProtoMessage() {}
 
-func (x *ListActiveTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[6]
+func (x *QuietHoursSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,46 +5455,60 @@ func (x *ListActiveTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActiveTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListActiveTasksResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QuietHoursSettings.ProtoReflect.Descriptor instead.
+func (*QuietHoursSettings) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ListActiveTasksResponse) GetTasks() []*Task {
+func (x *QuietHoursSettings) GetTimezone() string {
 	if x != nil {
-		return x.Tasks
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *QuietHoursSettings) GetWindows() []*QuietWindow {
+	if x != nil {
+		return x.Windows
 	}
 	return nil
 }
 
-type UpdateTaskRequest struct {
+func (x *QuietHoursSettings) GetPolicy() QuietHoursPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return QuietHoursPolicy_QUIET_HOURS_POLICY_UNSPECIFIED
+}
+
+func (x *QuietHoursSettings) GetDeadlineAlwaysFires() bool {
+	if x != nil {
+		return x.DeadlineAlwaysFires
+	}
+	return false
+}
+
+type GetUserQuietHoursSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskStatus    TaskStatus             `protobuf:"varint,2,opt,name=task_status,json=taskStatus,proto3,enum=task.v1.TaskStatus" json:"task_status,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3,oneof" json:"scheduled_at,omitempty"`
-	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[7]
+func (x *GetUserQuietHoursSettingsRequest) Reset() {
+	*x = GetUserQuietHoursSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *GetUserQuietHoursSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*GetUserQuietHoursSettingsRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[7]
+func (x *GetUserQuietHoursSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,82 +5519,77 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetUserQuietHoursSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserQuietHoursSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UpdateTaskRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+type GetUserQuietHoursSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *QuietHoursSettings    `protobuf:"bytes,1,opt,name=settings,proto3,oneof" json:"settings,omitempty"` // unset when the user has no quiet hours
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) GetTaskStatus() TaskStatus {
-	if x != nil {
-		return x.TaskStatus
-	}
-	return TaskStatus_TASK_STATUS_UNSPECIFIED
+func (x *GetUserQuietHoursSettingsResponse) Reset() {
+	*x = GetUserQuietHoursSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *GetUserQuietHoursSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateTaskRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
+func (*GetUserQuietHoursSettingsResponse) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) GetScheduledAt() *timestamppb.Timestamp {
+func (x *GetUserQuietHoursSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[88]
 	if x != nil {
-		return x.ScheduledAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTaskRequest) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
+// Deprecated: Use GetUserQuietHoursSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserQuietHoursSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetUserQuietHoursSettingsResponse) GetSettings() *QuietHoursSettings {
 	if x != nil {
-		return x.UpdateMask
+		return x.Settings
 	}
 	return nil
 }
 
-type UpdateTaskResponse struct {
+type UpdateUserQuietHoursSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Settings      *QuietHoursSettings    `protobuf:"bytes,1,opt,name=settings,proto3,oneof" json:"settings,omitempty"` // unset clears the quiet hours
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[8]
+func (x *UpdateUserQuietHoursSettingsRequest) Reset() {
+	*x = UpdateUserQuietHoursSettingsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *UpdateUserQuietHoursSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*UpdateUserQuietHoursSettingsRequest) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[8]
+func (x *UpdateUserQuietHoursSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,40 +5600,40 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UpdateUserQuietHoursSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserQuietHoursSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateTaskResponse) GetTask() *Task {
+func (x *UpdateUserQuietHoursSettingsRequest) GetSettings() *QuietHoursSettings {
 	if x != nil {
-		return x.Task
+		return x.Settings
 	}
 	return nil
 }
 
-type DeleteTaskRequest struct {
+type UpdateUserQuietHoursSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Settings      *QuietHoursSettings    `protobuf:"bytes,1,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[9]
+func (x *UpdateUserQuietHoursSettingsResponse) Reset() {
+	*x = UpdateUserQuietHoursSettingsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *UpdateUserQuietHoursSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*UpdateUserQuietHoursSettingsResponse) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[9]
+func (x *UpdateUserQuietHoursSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,39 +5644,39 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateUserQuietHoursSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserQuietHoursSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *DeleteTaskRequest) GetTaskId() string {
+func (x *UpdateUserQuietHoursSettingsResponse) GetSettings() *QuietHoursSettings {
 	if x != nil {
-		return x.TaskId
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-type DeleteTaskResponse struct {
+type CreateCalendarFeedTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[10]
+func (x *CreateCalendarFeedTokenRequest) Reset() {
+	*x = CreateCalendarFeedTokenRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *CreateCalendarFeedTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*CreateCalendarFeedTokenRequest) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[10]
+func (x *CreateCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,35 +5687,34 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{91}
 }
 
-// Period setting for a specific task type
-type PeriodSetting struct {
+type CreateCalendarFeedTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskType      TaskType               `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=task.v1.TaskType" json:"task_type,omitempty"` // SHORT, NEAR, RELAXED only (not SCHEDULED)
-	PeriodMinutes int64                  `protobuf:"varint,2,opt,name=period_minutes,json=periodMinutes,proto3" json:"period_minutes,omitempty"`        // 1 minute to 7 days
+	FeedToken     string                 `protobuf:"bytes,1,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"` // only returned here and by RotateCalendarFeedToken
+	FeedPath      string                 `protobuf:"bytes,2,opt,name=feed_path,json=feedPath,proto3" json:"feed_path,omitempty"`    // path of the iCalendar feed, relative to the server
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PeriodSetting) Reset() {
-	*x = PeriodSetting{}
-	mi := &file_task_v1_task_proto_msgTypes[11]
+func (x *CreateCalendarFeedTokenResponse) Reset() {
+	*x = CreateCalendarFeedTokenResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PeriodSetting) String() string {
+func (x *CreateCalendarFeedTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeriodSetting) ProtoMessage() {}
+func (*CreateCalendarFeedTokenResponse) ProtoMessage() {}
 
-func (x *PeriodSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[11]
+func (x *CreateCalendarFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,46 +5725,46 @@ func (x *PeriodSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeriodSetting.ProtoReflect.Descriptor instead.
-func (*PeriodSetting) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateCalendarFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *PeriodSetting) GetTaskType() TaskType {
+func (x *CreateCalendarFeedTokenResponse) GetFeedToken() string {
 	if x != nil {
-		return x.TaskType
+		return x.FeedToken
 	}
-	return TaskType_TASK_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *PeriodSetting) GetPeriodMinutes() int64 {
+func (x *CreateCalendarFeedTokenResponse) GetFeedPath() string {
 	if x != nil {
-		return x.PeriodMinutes
+		return x.FeedPath
 	}
-	return 0
+	return ""
 }
 
-type GetUserPeriodSettingsRequest struct {
+type RotateCalendarFeedTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPeriodSettingsRequest) Reset() {
-	*x = GetUserPeriodSettingsRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[12]
+func (x *RotateCalendarFeedTokenRequest) Reset() {
+	*x = RotateCalendarFeedTokenRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPeriodSettingsRequest) String() string {
+func (x *RotateCalendarFeedTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPeriodSettingsRequest) ProtoMessage() {}
+func (*RotateCalendarFeedTokenRequest) ProtoMessage() {}
 
-func (x *GetUserPeriodSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[12]
+func (x *RotateCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,34 +5775,34 @@ func (x *GetUserPeriodSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPeriodSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPeriodSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RotateCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{93}
 }
 
-type GetUserPeriodSettingsResponse struct {
+type RotateCalendarFeedTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      []*PeriodSetting       `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"` // User's custom settings
-	Defaults      []*PeriodSetting       `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty"` // Default values for reference
+	FeedToken     string                 `protobuf:"bytes,1,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"`
+	FeedPath      string                 `protobuf:"bytes,2,opt,name=feed_path,json=feedPath,proto3" json:"feed_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPeriodSettingsResponse) Reset() {
-	*x = GetUserPeriodSettingsResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[13]
+func (x *RotateCalendarFeedTokenResponse) Reset() {
+	*x = RotateCalendarFeedTokenResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPeriodSettingsResponse) String() string {
+func (x *RotateCalendarFeedTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPeriodSettingsResponse) ProtoMessage() {}
+func (*RotateCalendarFeedTokenResponse) ProtoMessage() {}
 
-func (x *GetUserPeriodSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[13]
+func (x *RotateCalendarFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,47 +5813,46 @@ func (x *GetUserPeriodSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPeriodSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPeriodSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RotateCalendarFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetUserPeriodSettingsResponse) GetSettings() []*PeriodSetting {
+func (x *RotateCalendarFeedTokenResponse) GetFeedToken() string {
 	if x != nil {
-		return x.Settings
+		return x.FeedToken
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserPeriodSettingsResponse) GetDefaults() []*PeriodSetting {
+func (x *RotateCalendarFeedTokenResponse) GetFeedPath() string {
 	if x != nil {
-		return x.Defaults
+		return x.FeedPath
 	}
-	return nil
+	return ""
 }
 
-type UpdateUserPeriodSettingsRequest struct {
+type RevokeCalendarFeedTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      []*PeriodSetting       `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserPeriodSettingsRequest) Reset() {
-	*x = UpdateUserPeriodSettingsRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[14]
+func (x *RevokeCalendarFeedTokenRequest) Reset() {
+	*x = RevokeCalendarFeedTokenRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserPeriodSettingsRequest) String() string {
+func (x *RevokeCalendarFeedTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPeriodSettingsRequest) ProtoMessage() {}
+func (*RevokeCalendarFeedTokenRequest) ProtoMessage() {}
 
-func (x *UpdateUserPeriodSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[14]
+func (x *RevokeCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,40 +5863,32 @@ func (x *UpdateUserPeriodSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPeriodSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserPeriodSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *UpdateUserPeriodSettingsRequest) GetSettings() []*PeriodSetting {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use RevokeCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{95}
 }
 
-type UpdateUserPeriodSettingsResponse struct {
+type RevokeCalendarFeedTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      []*PeriodSetting       `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserPeriodSettingsResponse) Reset() {
-	*x = UpdateUserPeriodSettingsResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[15]
+func (x *RevokeCalendarFeedTokenResponse) Reset() {
+	*x = RevokeCalendarFeedTokenResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserPeriodSettingsResponse) String() string {
+func (x *RevokeCalendarFeedTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPeriodSettingsResponse) ProtoMessage() {}
+func (*RevokeCalendarFeedTokenResponse) ProtoMessage() {}
 
-func (x *UpdateUserPeriodSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[15]
+func (x *RevokeCalendarFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,23 +5899,21 @@ func (x *UpdateUserPeriodSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPeriodSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserPeriodSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *UpdateUserPeriodSettingsResponse) GetSettings() []*PeriodSetting {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use RevokeCalendarFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{96}
 }
 
 var File_task_v1_task_proto protoreflect.FileDescriptor
 
 const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
-	"\x12task/v1/task.proto\x12\atask.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x03\n" +
+	"\x12task/v1/task.proto\x12\atask.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"H\n" +
+	"\n" +
+	"Recurrence\x12\x1e\n" +
+	"\x04rule\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x04rule\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\x92\x05\n" +
 	"\x04Task\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12>\n" +
 	"\ttask_type\x18\x02 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04R\btaskType\x12@\n" +
@@ -1048,28 +5926,58 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
 	"\ttarget_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\btargetAt\x12\x14\n" +
-	"\x05color\x18\t \x01(\tR\x05colorB\x0f\n" +
-	"\r_scheduled_at\"\xaa\x02\n" +
+	"\x05color\x18\t \x01(\tR\x05color\x128\n" +
+	"\n" +
+	"recurrence\x18\n" +
+	" \x01(\v2\x13.task.v1.RecurrenceH\x01R\n" +
+	"recurrence\x88\x01\x01\x12I\n" +
+	"\x12checklist_progress\x18\v \x01(\v2\x1a.task.v1.ChecklistProgressR\x11checklistProgress\x12 \n" +
+	"\x04tags\x18\f \x03(\v2\f.task.v1.TagR\x04tags\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversionB\x0f\n" +
+	"\r_scheduled_atB\r\n" +
+	"\v_recurrence\"\xf3\x02\n" +
 	"\x11CreateTaskRequest\x12&\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06taskId\x88\x01\x01\x12>\n" +
 	"\ttask_type\x18\x02 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04R\btaskType\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12B\n" +
 	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vscheduledAt\x88\x01\x01\x12\x14\n" +
-	"\x05color\x18\x06 \x01(\tR\x05colorB\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\x128\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\v2\x13.task.v1.RecurrenceH\x02R\n" +
+	"recurrence\x88\x01\x01B\n" +
 	"\n" +
 	"\b_task_idB\x0f\n" +
-	"\r_scheduled_at\"7\n" +
+	"\r_scheduled_atB\r\n" +
+	"\v_recurrence\"7\n" +
 	"\x12CreateTaskResponse\x12!\n" +
 	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"3\n" +
 	"\x0eGetTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"4\n" +
 	"\x0fGetTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"L\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\xaa\x04\n" +
 	"\x16ListActiveTasksRequest\x122\n" +
-	"\tsort_type\x18\x01 \x01(\x0e2\x15.task.v1.TaskSortTypeR\bsortType\">\n" +
+	"\tsort_type\x18\x01 \x01(\x0e2\x15.task.v1.TaskSortTypeR\bsortType\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12C\n" +
+	"\ttask_type\x18\x04 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04H\x00R\btaskType\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x05 \x01(\tH\x01R\x05color\x88\x01\x01\x12E\n" +
+	"\x0etarget_at_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\ftargetAtFrom\x88\x01\x01\x12A\n" +
+	"\ftarget_at_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x03R\n" +
+	"targetAtTo\x88\x01\x01\x12=\n" +
+	"\x0esort_direction\x18\b \x01(\x0e2\x16.task.v1.SortDirectionR\rsortDirection\x12$\n" +
+	"\x06tag_id\x18\t \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x04R\x05tagId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_task_typeB\b\n" +
+	"\x06_colorB\x11\n" +
+	"\x0f_target_at_fromB\x0f\n" +
+	"\r_target_at_toB\t\n" +
+	"\a_tag_id\"f\n" +
 	"\x17ListActiveTasksResponse\x12#\n" +
-	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\"\xd4\x02\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xeb\x03\n" +
 	"\x11UpdateTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x124\n" +
 	"\vtask_status\x18\x02 \x01(\x0e2\x13.task.v1.TaskStatusR\n" +
@@ -1079,13 +5987,273 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vscheduledAt\x88\x01\x01\x12\x14\n" +
 	"\x05color\x18\x06 \x01(\tR\x05color\x12C\n" +
 	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskB\x06\xbaH\x03\xc8\x01\x01R\n" +
-	"updateMaskB\x0f\n" +
-	"\r_scheduled_at\"7\n" +
+	"updateMask\x128\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x13.task.v1.RecurrenceH\x01R\n" +
+	"recurrence\x88\x01\x01\x127\n" +
+	"\x10expected_version\x18\t \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\x0fexpectedVersion\x88\x01\x01B\x0f\n" +
+	"\r_scheduled_atB\r\n" +
+	"\v_recurrenceB\x13\n" +
+	"\x11_expected_version\"\x88\x01\n" +
 	"\x12UpdateTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"6\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\x12;\n" +
+	"\x0fnext_occurrence\x18\x02 \x01(\v2\r.task.v1.TaskH\x00R\x0enextOccurrence\x88\x01\x01B\x12\n" +
+	"\x10_next_occurrence\"6\n" +
 	"\x11DeleteTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"\x14\n" +
-	"\x12DeleteTaskResponse\"\x80\x01\n" +
+	"\x12DeleteTaskResponse\"\xb4\x01\n" +
+	"\x11SnoozeTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x127\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\bduration\x122\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05untilB\x0f\n" +
+	"\x06snooze\x12\x05\xbaH\x02\b\x01\"7\n" +
+	"\x12SnoozeTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\xa5\x01\n" +
+	"\x0fMoveTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12*\n" +
+	"\tbefore_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bbeforeId\x88\x01\x01\x12(\n" +
+	"\bafter_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\aafterId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_before_idB\v\n" +
+	"\t_after_id\"5\n" +
+	"\x10MoveTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"[\n" +
+	"\x17BatchUpdateTasksRequest\x12@\n" +
+	"\aupdates\x18\x01 \x03(\v2\x1a.task.v1.UpdateTaskRequestB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\aupdates\">\n" +
+	"\x0eBatchTaskError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa4\x01\n" +
+	"\x15BatchUpdateTaskResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x127\n" +
+	"\aupdated\x18\x02 \x01(\v2\x1b.task.v1.UpdateTaskResponseH\x00R\aupdated\x12/\n" +
+	"\x05error\x18\x03 \x01(\v2\x17.task.v1.BatchTaskErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"T\n" +
+	"\x18BatchUpdateTasksResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.task.v1.BatchUpdateTaskResultR\aresults\"G\n" +
+	"\x17BatchDeleteTasksRequest\x12,\n" +
+	"\btask_ids\x18\x01 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\ataskIds\"n\n" +
+	"\x15BatchDeleteTaskResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x122\n" +
+	"\x05error\x18\x02 \x01(\v2\x17.task.v1.BatchTaskErrorH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"T\n" +
+	"\x18BatchDeleteTasksResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.task.v1.BatchDeleteTaskResultR\aresults\"\xa0\x02\n" +
+	"\x12ImportTasksRequest\x129\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x15.task.v1.ImportFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x18\x01\x18\x02R\x06format\x12\x1f\n" +
+	"\x04data\x18\x02 \x01(\fB\v\xbaH\bz\x06\x10\x01\x18\x80\x80@R\x04data\x12K\n" +
+	"\x11default_task_type\x18\x03 \x01(\x0e2\x11.task.v1.TaskTypeB\f\xbaH\t\x82\x01\x06\x18\x01\x18\x02\x18\x03R\x0fdefaultTaskType\x12#\n" +
+	"\rdefault_color\x18\x04 \x01(\tR\fdefaultColor\x12#\n" +
+	"\btimezone\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18@R\btimezone\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\x9a\x01\n" +
+	"\x10ImportTaskResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12#\n" +
+	"\x04task\x18\x03 \x01(\v2\r.task.v1.TaskH\x00R\x04task\x12/\n" +
+	"\x05error\x18\x04 \x01(\v2\x17.task.v1.BatchTaskErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"f\n" +
+	"\x13ImportTasksResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.task.v1.ImportTaskResultR\aresults\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\"\xaf\x01\n" +
+	"\x12SearchTasksRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12$\n" +
+	"\x06tag_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x05tagId\x88\x01\x01B\t\n" +
+	"\a_tag_id\"\xfe\x01\n" +
+	"\rSearchTaskHit\x120\n" +
+	"\vactive_task\x18\x01 \x01(\v2\r.task.v1.TaskH\x00R\n" +
+	"activeTask\x12?\n" +
+	"\x0ecompleted_task\x18\x02 \x01(\v2\x16.task.v1.CompletedTaskH\x00R\rcompletedTask\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\x12'\n" +
+	"\x0ftitle_highlight\x18\x04 \x01(\tR\x0etitleHighlight\x123\n" +
+	"\x15description_highlight\x18\x05 \x01(\tR\x14descriptionHighlightB\x06\n" +
+	"\x04task\"i\n" +
+	"\x13SearchTasksResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.task.v1.SearchTaskHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x13\n" +
+	"\x11WatchTasksRequest\"\xbe\x01\n" +
+	"\tTaskEvent\x12*\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.task.v1.TaskEventTypeR\x04type\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12&\n" +
+	"\x04task\x18\x03 \x01(\v2\r.task.v1.TaskH\x00R\x04task\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\a\n" +
+	"\x05_task\"\\\n" +
+	"\x10SyncTasksRequest\x12\x1f\n" +
+	"\vsince_token\x18\x01 \x01(\tR\n" +
+	"sinceToken\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\"\x99\x01\n" +
+	"\rTaskTombstone\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x124\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1c.task.v1.TaskTombstoneReasonR\x06reason\x129\n" +
+	"\n" +
+	"removed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tremovedAt\"\xb3\x01\n" +
+	"\x11SyncTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x126\n" +
+	"\n" +
+	"tombstones\x18\x02 \x03(\v2\x16.task.v1.TaskTombstoneR\n" +
+	"tombstones\x12&\n" +
+	"\x0fnext_sync_token\x18\x03 \x01(\tR\rnextSyncToken\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\xa0\x04\n" +
+	"\rCompletedTask\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12>\n" +
+	"\ttask_type\x18\x02 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04R\btaskType\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12B\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vscheduledAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\ttarget_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\btargetAt\x12\x14\n" +
+	"\x05color\x18\b \x01(\tR\x05color\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x124\n" +
+	"\tchecklist\x18\n" +
+	" \x03(\v2\x16.task.v1.ChecklistItemR\tchecklist\x12 \n" +
+	"\x04tags\x18\v \x03(\v2\f.task.v1.TagR\x04tagsB\x0f\n" +
+	"\r_scheduled_at\"\xa7\x03\n" +
+	"\x19ListCompletedTasksRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12C\n" +
+	"\ttask_type\x18\x03 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04H\x00R\btaskType\x88\x01\x01\x12K\n" +
+	"\x11completed_at_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0fcompletedAtFrom\x88\x01\x01\x12G\n" +
+	"\x0fcompleted_at_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\rcompletedAtTo\x88\x01\x01\x12$\n" +
+	"\x06tag_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\x05tagId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_task_typeB\x14\n" +
+	"\x12_completed_at_fromB\x12\n" +
+	"\x10_completed_at_toB\t\n" +
+	"\a_tag_id\"\x85\x01\n" +
+	"\x1aListCompletedTasksResponse\x12?\n" +
+	"\x0fcompleted_tasks\x18\x01 \x03(\v2\x16.task.v1.CompletedTaskR\x0ecompletedTasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"<\n" +
+	"\x17GetCompletedTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"Y\n" +
+	"\x18GetCompletedTaskResponse\x12=\n" +
+	"\x0ecompleted_task\x18\x01 \x01(\v2\x16.task.v1.CompletedTaskR\rcompletedTask\"6\n" +
+	"\x11ReopenTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"7\n" +
+	"\x12ReopenTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\xab\x01\n" +
+	"\x13GetTaskStatsRequest\x12%\n" +
+	"\btimezone\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\btimezone\x12>\n" +
+	"\binterval\x18\x02 \x01(\x0e2\x16.task.v1.StatsIntervalB\n" +
+	"\xbaH\a\x82\x01\x04\x18\x01\x18\x02R\binterval\x12-\n" +
+	"\fbucket_count\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xee\x02(\x00R\vbucketCount\"b\n" +
+	"\x10CompletionBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\"\xd8\x01\n" +
+	"\rTaskTypeStats\x12>\n" +
+	"\ttask_type\x18\x01 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04R\btaskType\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x17\n" +
+	"\aon_time\x18\x03 \x01(\x05R\x06onTime\x12P\n" +
+	"\x17median_time_to_complete\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x14medianTimeToComplete\"\xab\x02\n" +
+	"\x14GetTaskStatsResponse\x123\n" +
+	"\abuckets\x18\x01 \x03(\v2\x19.task.v1.CompletionBucketR\abuckets\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x17\n" +
+	"\aon_time\x18\x03 \x01(\x05R\x06onTime\x12\"\n" +
+	"\ron_time_ratio\x18\x04 \x01(\x01R\vonTimeRatio\x125\n" +
+	"\n" +
+	"task_types\x18\x05 \x03(\v2\x16.task.v1.TaskTypeStatsR\ttaskTypes\x12%\n" +
+	"\x0ecurrent_streak\x18\x06 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\a \x01(\x05R\rlongestStreak\"k\n" +
+	"\vDeletedTask\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"a\n" +
+	"\x17ListDeletedTasksRequest\x12'\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"}\n" +
+	"\x18ListDeletedTasksResponse\x129\n" +
+	"\rdeleted_tasks\x18\x01 \x03(\v2\x14.task.v1.DeletedTaskR\fdeletedTasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"7\n" +
+	"\x12RestoreTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"8\n" +
+	"\x13RestoreTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"v\n" +
+	"\rChecklistItem\x12!\n" +
+	"\aitem_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"=\n" +
+	"\x11ChecklistProgress\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\x05R\x04done\">\n" +
+	"\x19ListChecklistItemsRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"\x82\x01\n" +
+	"\x1aListChecklistItemsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\x126\n" +
+	"\bprogress\x18\x02 \x01(\v2\x1a.task.v1.ChecklistProgressR\bprogress\"\\\n" +
+	"\x17AddChecklistItemRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12\x1e\n" +
+	"\x04text\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x04text\"\x80\x01\n" +
+	"\x18AddChecklistItemResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\x126\n" +
+	"\bprogress\x18\x02 \x01(\v2\x1a.task.v1.ChecklistProgressR\bprogress\"v\n" +
+	"\x1aToggleChecklistItemRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12!\n" +
+	"\aitem_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\"\x83\x01\n" +
+	"\x1bToggleChecklistItemResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\x126\n" +
+	"\bprogress\x18\x02 \x01(\v2\x1a.task.v1.ChecklistProgressR\bprogress\"o\n" +
+	"\x1cReorderChecklistItemsRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12,\n" +
+	"\bitem_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\aitemIds\"\x85\x01\n" +
+	"\x1dReorderChecklistItemsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\x126\n" +
+	"\bprogress\x18\x02 \x01(\v2\x1a.task.v1.ChecklistProgressR\bprogress\"b\n" +
+	"\x1aDeleteChecklistItemRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12!\n" +
+	"\aitem_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\"\x83\x01\n" +
+	"\x1bDeleteChecklistItemResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\x126\n" +
+	"\bprogress\x18\x02 \x01(\v2\x1a.task.v1.ChecklistProgressR\bprogress\"\x8b\x01\n" +
+	"\x03Tag\x12\x1f\n" +
+	"\x06tag_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"G\n" +
+	"\x10CreateTagRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\"3\n" +
+	"\x11CreateTagResponse\x12\x1e\n" +
+	"\x03tag\x18\x01 \x01(\v2\f.task.v1.TagR\x03tag\"\x11\n" +
+	"\x0fListTagsRequest\"4\n" +
+	"\x10ListTagsResponse\x12 \n" +
+	"\x04tags\x18\x01 \x03(\v2\f.task.v1.TagR\x04tags\"\x85\x01\n" +
+	"\x10UpdateTagRequest\x12\x1f\n" +
+	"\x06tag_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\x12\"\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182H\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_color\"3\n" +
+	"\x11UpdateTagResponse\x12\x1e\n" +
+	"\x03tag\x18\x01 \x01(\v2\f.task.v1.TagR\x03tag\"3\n" +
+	"\x10DeleteTagRequest\x12\x1f\n" +
+	"\x06tag_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\"\x13\n" +
+	"\x11DeleteTagResponse\"V\n" +
+	"\x10AttachTagRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12\x1f\n" +
+	"\x06tag_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\"5\n" +
+	"\x11AttachTagResponse\x12 \n" +
+	"\x04tags\x18\x01 \x03(\v2\f.task.v1.TagR\x04tags\"V\n" +
+	"\x10DetachTagRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12\x1f\n" +
+	"\x06tag_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\"5\n" +
+	"\x11DetachTagResponse\x12 \n" +
+	"\x04tags\x18\x01 \x03(\v2\f.task.v1.TagR\x04tags\"\x80\x01\n" +
 	"\rPeriodSetting\x12<\n" +
 	"\ttask_type\x18\x01 \x01(\x0e2\x11.task.v1.TaskTypeB\f\xbaH\t\x82\x01\x06\x18\x01\x18\x02\x18\x03R\btaskType\x121\n" +
 	"\x0eperiod_minutes\x18\x02 \x01(\x03B\n" +
@@ -1097,21 +6265,100 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\x1fUpdateUserPeriodSettingsRequest\x122\n" +
 	"\bsettings\x18\x01 \x03(\v2\x16.task.v1.PeriodSettingR\bsettings\"V\n" +
 	" UpdateUserPeriodSettingsResponse\x122\n" +
-	"\bsettings\x18\x01 \x03(\v2\x16.task.v1.PeriodSettingR\bsettings*~\n" +
+	"\bsettings\x18\x01 \x03(\v2\x16.task.v1.PeriodSettingR\bsettings\"\x99\x01\n" +
+	"\x17ReminderIntervalSetting\x12>\n" +
+	"\ttask_type\x18\x01 \x01(\x0e2\x11.task.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04R\btaskType\x12>\n" +
+	"\tintervals\x18\x02 \x03(\x01B \xbaH\x1d\x92\x01\x1a\x10\n" +
+	"\x18\x01\"\x14\x12\x12\x11\x00\x00\x00\x00\x00\x00\xf0?!\x00\x00\x00\x00\x00\x00\x00\x00R\tintervals\"(\n" +
+	"&GetUserReminderIntervalSettingsRequest\"\xa5\x01\n" +
+	"'GetUserReminderIntervalSettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x03(\v2 .task.v1.ReminderIntervalSettingR\bsettings\x12<\n" +
+	"\bdefaults\x18\x02 \x03(\v2 .task.v1.ReminderIntervalSettingR\bdefaults\"i\n" +
+	")UpdateUserReminderIntervalSettingsRequest\x12<\n" +
+	"\bsettings\x18\x01 \x03(\v2 .task.v1.ReminderIntervalSettingR\bsettings\"j\n" +
+	"*UpdateUserReminderIntervalSettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x03(\v2 .task.v1.ReminderIntervalSettingR\bsettings\"\x8c\x01\n" +
+	"\vQuietWindow\x12#\n" +
+	"\aweekday\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x06(\x00R\aweekday\x12-\n" +
+	"\fstart_minute\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x10\xa0\v(\x00R\vstartMinute\x12)\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x10\xa0\v(\x00R\tendMinute\"\xe8\x01\n" +
+	"\x12QuietHoursSettings\x12%\n" +
+	"\btimezone\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\btimezone\x128\n" +
+	"\awindows\x18\x02 \x03(\v2\x14.task.v1.QuietWindowB\b\xbaH\x05\x92\x01\x02\x10\x0eR\awindows\x12=\n" +
+	"\x06policy\x18\x03 \x01(\x0e2\x19.task.v1.QuietHoursPolicyB\n" +
+	"\xbaH\a\x82\x01\x04\x18\x01\x18\x02R\x06policy\x122\n" +
+	"\x15deadline_always_fires\x18\x04 \x01(\bR\x13deadlineAlwaysFires\"\"\n" +
+	" GetUserQuietHoursSettingsRequest\"n\n" +
+	"!GetUserQuietHoursSettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.task.v1.QuietHoursSettingsH\x00R\bsettings\x88\x01\x01B\v\n" +
+	"\t_settings\"p\n" +
+	"#UpdateUserQuietHoursSettingsRequest\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.task.v1.QuietHoursSettingsH\x00R\bsettings\x88\x01\x01B\v\n" +
+	"\t_settings\"q\n" +
+	"$UpdateUserQuietHoursSettingsResponse\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.task.v1.QuietHoursSettingsH\x00R\bsettings\x88\x01\x01B\v\n" +
+	"\t_settings\" \n" +
+	"\x1eCreateCalendarFeedTokenRequest\"]\n" +
+	"\x1fCreateCalendarFeedTokenResponse\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x01 \x01(\tR\tfeedToken\x12\x1b\n" +
+	"\tfeed_path\x18\x02 \x01(\tR\bfeedPath\" \n" +
+	"\x1eRotateCalendarFeedTokenRequest\"]\n" +
+	"\x1fRotateCalendarFeedTokenResponse\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x01 \x01(\tR\tfeedToken\x12\x1b\n" +
+	"\tfeed_path\x18\x02 \x01(\tR\bfeedPath\" \n" +
+	"\x1eRevokeCalendarFeedTokenRequest\"!\n" +
+	"\x1fRevokeCalendarFeedTokenResponse*~\n" +
 	"\bTaskType\x12\x19\n" +
 	"\x15TASK_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fTASK_TYPE_SHORT\x10\x01\x12\x12\n" +
 	"\x0eTASK_TYPE_NEAR\x10\x02\x12\x15\n" +
 	"\x11TASK_TYPE_RELAXED\x10\x03\x12\x17\n" +
-	"\x13TASK_TYPE_SCHEDULED\x10\x04*\\\n" +
+	"\x13TASK_TYPE_SCHEDULED\x10\x04*}\n" +
 	"\n" +
 	"TaskStatus\x12\x1b\n" +
 	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12TASK_STATUS_ACTIVE\x10\x01\x12\x19\n" +
-	"\x15TASK_STATUS_COMPLETED\x10\x02*L\n" +
+	"\x15TASK_STATUS_COMPLETED\x10\x02\x12\x1f\n" +
+	"\x1bTASK_STATUS_REMINDER_FAILED\x10\x03*\xa6\x01\n" +
+	"\rTaskEventType\x12\x1f\n" +
+	"\x1bTASK_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_UPDATED\x10\x02\x12\x1d\n" +
+	"\x19TASK_EVENT_TYPE_COMPLETED\x10\x03\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_DELETED\x10\x04*\x84\x01\n" +
+	"\x13TaskTombstoneReason\x12%\n" +
+	"!TASK_TOMBSTONE_REASON_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fTASK_TOMBSTONE_REASON_COMPLETED\x10\x01\x12!\n" +
+	"\x1dTASK_TOMBSTONE_REASON_DELETED\x10\x02*[\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_FORMAT_ICS\x10\x01\x12\x15\n" +
+	"\x11IMPORT_FORMAT_CSV\x10\x02*`\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x02*q\n" +
+	"\x10QuietHoursPolicy\x12\"\n" +
+	"\x1eQUIET_HOURS_POLICY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18QUIET_HOURS_POLICY_SHIFT\x10\x01\x12\x1b\n" +
+	"\x17QUIET_HOURS_POLICY_DROP\x10\x02*\xd8\x01\n" +
 	"\fTaskSortType\x12\x1e\n" +
 	"\x1aTASK_SORT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
-	"\x18TASK_SORT_TYPE_TARGET_AT\x10\x012\xf6\x02\n" +
+	"\x18TASK_SORT_TYPE_TARGET_AT\x10\x01\x12\x1d\n" +
+	"\x19TASK_SORT_TYPE_CREATED_AT\x10\x02\x12\x18\n" +
+	"\x14TASK_SORT_TYPE_TITLE\x10\x03\x12\x1c\n" +
+	"\x18TASK_SORT_TYPE_TASK_TYPE\x10\x04\x12\x18\n" +
+	"\x14TASK_SORT_TYPE_COLOR\x10\x05\x12\x19\n" +
+	"\x15TASK_SORT_TYPE_MANUAL\x10\x06*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x01\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x022\xc8\a\n" +
 	"\vTaskService\x12E\n" +
 	"\n" +
 	"CreateTask\x12\x1a.task.v1.CreateTaskRequest\x1a\x1b.task.v1.CreateTaskResponse\x12<\n" +
@@ -1120,10 +6367,51 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"UpdateTask\x12\x1a.task.v1.UpdateTaskRequest\x1a\x1b.task.v1.UpdateTaskResponse\x12E\n" +
 	"\n" +
-	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x1b.task.v1.DeleteTaskResponse2\xf4\x01\n" +
+	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x1b.task.v1.DeleteTaskResponse\x12E\n" +
+	"\n" +
+	"SnoozeTask\x12\x1a.task.v1.SnoozeTaskRequest\x1a\x1b.task.v1.SnoozeTaskResponse\x12?\n" +
+	"\bMoveTask\x12\x18.task.v1.MoveTaskRequest\x1a\x19.task.v1.MoveTaskResponse\x12H\n" +
+	"\vSearchTasks\x12\x1b.task.v1.SearchTasksRequest\x1a\x1c.task.v1.SearchTasksResponse\x12W\n" +
+	"\x10BatchUpdateTasks\x12 .task.v1.BatchUpdateTasksRequest\x1a!.task.v1.BatchUpdateTasksResponse\x12W\n" +
+	"\x10BatchDeleteTasks\x12 .task.v1.BatchDeleteTasksRequest\x1a!.task.v1.BatchDeleteTasksResponse\x12>\n" +
+	"\n" +
+	"WatchTasks\x12\x1a.task.v1.WatchTasksRequest\x1a\x12.task.v1.TaskEvent0\x01\x12B\n" +
+	"\tSyncTasks\x12\x19.task.v1.SyncTasksRequest\x1a\x1a.task.v1.SyncTasksResponse\x12H\n" +
+	"\vImportTasks\x12\x1b.task.v1.ImportTasksRequest\x1a\x1c.task.v1.ImportTasksResponse2\xe2\x02\n" +
+	"\x14CompletedTaskService\x12]\n" +
+	"\x12ListCompletedTasks\x12\".task.v1.ListCompletedTasksRequest\x1a#.task.v1.ListCompletedTasksResponse\x12W\n" +
+	"\x10GetCompletedTask\x12 .task.v1.GetCompletedTaskRequest\x1a!.task.v1.GetCompletedTaskResponse\x12E\n" +
+	"\n" +
+	"ReopenTask\x12\x1a.task.v1.ReopenTaskRequest\x1a\x1b.task.v1.ReopenTaskResponse\x12K\n" +
+	"\fGetTaskStats\x12\x1c.task.v1.GetTaskStatsRequest\x1a\x1d.task.v1.GetTaskStatsResponse2\xb5\x01\n" +
+	"\x10TaskTrashService\x12W\n" +
+	"\x10ListDeletedTasks\x12 .task.v1.ListDeletedTasksRequest\x1a!.task.v1.ListDeletedTasksResponse\x12H\n" +
+	"\vRestoreTask\x12\x1b.task.v1.RestoreTaskRequest\x1a\x1c.task.v1.RestoreTaskResponse2\xfa\x03\n" +
+	"\x14TaskChecklistService\x12]\n" +
+	"\x12ListChecklistItems\x12\".task.v1.ListChecklistItemsRequest\x1a#.task.v1.ListChecklistItemsResponse\x12W\n" +
+	"\x10AddChecklistItem\x12 .task.v1.AddChecklistItemRequest\x1a!.task.v1.AddChecklistItemResponse\x12`\n" +
+	"\x13ToggleChecklistItem\x12#.task.v1.ToggleChecklistItemRequest\x1a$.task.v1.ToggleChecklistItemResponse\x12f\n" +
+	"\x15ReorderChecklistItems\x12%.task.v1.ReorderChecklistItemsRequest\x1a&.task.v1.ReorderChecklistItemsResponse\x12`\n" +
+	"\x13DeleteChecklistItem\x12#.task.v1.DeleteChecklistItemRequest\x1a$.task.v1.DeleteChecklistItemResponse2\xa1\x03\n" +
+	"\n" +
+	"TagService\x12B\n" +
+	"\tCreateTag\x12\x19.task.v1.CreateTagRequest\x1a\x1a.task.v1.CreateTagResponse\x12?\n" +
+	"\bListTags\x12\x18.task.v1.ListTagsRequest\x1a\x19.task.v1.ListTagsResponse\x12B\n" +
+	"\tUpdateTag\x12\x19.task.v1.UpdateTagRequest\x1a\x1a.task.v1.UpdateTagResponse\x12B\n" +
+	"\tDeleteTag\x12\x19.task.v1.DeleteTagRequest\x1a\x1a.task.v1.DeleteTagResponse\x12B\n" +
+	"\tAttachTag\x12\x19.task.v1.AttachTagRequest\x1a\x1a.task.v1.AttachTagResponse\x12B\n" +
+	"\tDetachTag\x12\x19.task.v1.DetachTagRequest\x1a\x1a.task.v1.DetachTagResponse2\xfc\x05\n" +
 	"\x19UserPeriodSettingsService\x12f\n" +
 	"\x15GetUserPeriodSettings\x12%.task.v1.GetUserPeriodSettingsRequest\x1a&.task.v1.GetUserPeriodSettingsResponse\x12o\n" +
-	"\x18UpdateUserPeriodSettings\x12(.task.v1.UpdateUserPeriodSettingsRequest\x1a).task.v1.UpdateUserPeriodSettingsResponseB\xa3\x01\n" +
+	"\x18UpdateUserPeriodSettings\x12(.task.v1.UpdateUserPeriodSettingsRequest\x1a).task.v1.UpdateUserPeriodSettingsResponse\x12\x84\x01\n" +
+	"\x1fGetUserReminderIntervalSettings\x12/.task.v1.GetUserReminderIntervalSettingsRequest\x1a0.task.v1.GetUserReminderIntervalSettingsResponse\x12\x8d\x01\n" +
+	"\"UpdateUserReminderIntervalSettings\x122.task.v1.UpdateUserReminderIntervalSettingsRequest\x1a3.task.v1.UpdateUserReminderIntervalSettingsResponse\x12r\n" +
+	"\x19GetUserQuietHoursSettings\x12).task.v1.GetUserQuietHoursSettingsRequest\x1a*.task.v1.GetUserQuietHoursSettingsResponse\x12{\n" +
+	"\x1cUpdateUserQuietHoursSettings\x12,.task.v1.UpdateUserQuietHoursSettingsRequest\x1a-.task.v1.UpdateUserQuietHoursSettingsResponse2\xdf\x02\n" +
+	"\x13CalendarFeedService\x12l\n" +
+	"\x17CreateCalendarFeedToken\x12'.task.v1.CreateCalendarFeedTokenRequest\x1a(.task.v1.CreateCalendarFeedTokenResponse\x12l\n" +
+	"\x17RotateCalendarFeedToken\x12'.task.v1.RotateCalendarFeedTokenRequest\x1a(.task.v1.RotateCalendarFeedTokenResponse\x12l\n" +
+	"\x17RevokeCalendarFeedToken\x12'.task.v1.RevokeCalendarFeedTokenRequest\x1a(.task.v1.RevokeCalendarFeedTokenResponseB\xa3\x01\n" +
 	"\vcom.task.v1B\tTaskProtoP\x01ZLgithub.com/KasumiMercury/primind-central-backend/internal/gen/task/v1;taskv1\xa2\x02\x03TXX\xaa\x02\aTask.V1\xca\x02\aTask\\V1\xe2\x02\x13Task\\V1\\GPBMetadata\xea\x02\bTask::V1b\x06proto3"
 
 var (
@@ -1138,71 +6426,307 @@ func file_task_v1_task_proto_rawDescGZIP() []byte {
 	return file_task_v1_task_proto_rawDescData
 }
 
-var file_task_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_task_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
 var file_task_v1_task_proto_goTypes = []any{
-	(TaskType)(0),                            // 0: task.v1.TaskType
-	(TaskStatus)(0),                          // 1: task.v1.TaskStatus
-	(TaskSortType)(0),                        // 2: task.v1.TaskSortType
-	(*Task)(nil),                             // 3: task.v1.Task
-	(*CreateTaskRequest)(nil),                // 4: task.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),               // 5: task.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),                   // 6: task.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),                  // 7: task.v1.GetTaskResponse
-	(*ListActiveTasksRequest)(nil),           // 8: task.v1.ListActiveTasksRequest
-	(*ListActiveTasksResponse)(nil),          // 9: task.v1.ListActiveTasksResponse
-	(*UpdateTaskRequest)(nil),                // 10: task.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),               // 11: task.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),                // 12: task.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),               // 13: task.v1.DeleteTaskResponse
-	(*PeriodSetting)(nil),                    // 14: task.v1.PeriodSetting
-	(*GetUserPeriodSettingsRequest)(nil),     // 15: task.v1.GetUserPeriodSettingsRequest
-	(*GetUserPeriodSettingsResponse)(nil),    // 16: task.v1.GetUserPeriodSettingsResponse
-	(*UpdateUserPeriodSettingsRequest)(nil),  // 17: task.v1.UpdateUserPeriodSettingsRequest
-	(*UpdateUserPeriodSettingsResponse)(nil), // 18: task.v1.UpdateUserPeriodSettingsResponse
-	(*timestamppb.Timestamp)(nil),            // 19: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),            // 20: google.protobuf.FieldMask
+	(TaskType)(0),                                      // 0: task.v1.TaskType
+	(TaskStatus)(0),                                    // 1: task.v1.TaskStatus
+	(TaskEventType)(0),                                 // 2: task.v1.TaskEventType
+	(TaskTombstoneReason)(0),                           // 3: task.v1.TaskTombstoneReason
+	(ImportFormat)(0),                                  // 4: task.v1.ImportFormat
+	(StatsInterval)(0),                                 // 5: task.v1.StatsInterval
+	(QuietHoursPolicy)(0),                              // 6: task.v1.QuietHoursPolicy
+	(TaskSortType)(0),                                  // 7: task.v1.TaskSortType
+	(SortDirection)(0),                                 // 8: task.v1.SortDirection
+	(*Recurrence)(nil),                                 // 9: task.v1.Recurrence
+	(*Task)(nil),                                       // 10: task.v1.Task
+	(*CreateTaskRequest)(nil),                          // 11: task.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),                         // 12: task.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                             // 13: task.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                            // 14: task.v1.GetTaskResponse
+	(*ListActiveTasksRequest)(nil),                     // 15: task.v1.ListActiveTasksRequest
+	(*ListActiveTasksResponse)(nil),                    // 16: task.v1.ListActiveTasksResponse
+	(*UpdateTaskRequest)(nil),                          // 17: task.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),                         // 18: task.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),                          // 19: task.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),                         // 20: task.v1.DeleteTaskResponse
+	(*SnoozeTaskRequest)(nil),                          // 21: task.v1.SnoozeTaskRequest
+	(*SnoozeTaskResponse)(nil),                         // 22: task.v1.SnoozeTaskResponse
+	(*MoveTaskRequest)(nil),                            // 23: task.v1.MoveTaskRequest
+	(*MoveTaskResponse)(nil),                           // 24: task.v1.MoveTaskResponse
+	(*BatchUpdateTasksRequest)(nil),                    // 25: task.v1.BatchUpdateTasksRequest
+	(*BatchTaskError)(nil),                             // 26: task.v1.BatchTaskError
+	(*BatchUpdateTaskResult)(nil),                      // 27: task.v1.BatchUpdateTaskResult
+	(*BatchUpdateTasksResponse)(nil),                   // 28: task.v1.BatchUpdateTasksResponse
+	(*BatchDeleteTasksRequest)(nil),                    // 29: task.v1.BatchDeleteTasksRequest
+	(*BatchDeleteTaskResult)(nil),                      // 30: task.v1.BatchDeleteTaskResult
+	(*BatchDeleteTasksResponse)(nil),                   // 31: task.v1.BatchDeleteTasksResponse
+	(*ImportTasksRequest)(nil),                         // 32: task.v1.ImportTasksRequest
+	(*ImportTaskResult)(nil),                           // 33: task.v1.ImportTaskResult
+	(*ImportTasksResponse)(nil),                        // 34: task.v1.ImportTasksResponse
+	(*SearchTasksRequest)(nil),                         // 35: task.v1.SearchTasksRequest
+	(*SearchTaskHit)(nil),                              // 36: task.v1.SearchTaskHit
+	(*SearchTasksResponse)(nil),                        // 37: task.v1.SearchTasksResponse
+	(*WatchTasksRequest)(nil),                          // 38: task.v1.WatchTasksRequest
+	(*TaskEvent)(nil),                                  // 39: task.v1.TaskEvent
+	(*SyncTasksRequest)(nil),                           // 40: task.v1.SyncTasksRequest
+	(*TaskTombstone)(nil),                              // 41: task.v1.TaskTombstone
+	(*SyncTasksResponse)(nil),                          // 42: task.v1.SyncTasksResponse
+	(*CompletedTask)(nil),                              // 43: task.v1.CompletedTask
+	(*ListCompletedTasksRequest)(nil),                  // 44: task.v1.ListCompletedTasksRequest
+	(*ListCompletedTasksResponse)(nil),                 // 45: task.v1.ListCompletedTasksResponse
+	(*GetCompletedTaskRequest)(nil),                    // 46: task.v1.GetCompletedTaskRequest
+	(*GetCompletedTaskResponse)(nil),                   // 47: task.v1.GetCompletedTaskResponse
+	(*ReopenTaskRequest)(nil),                          // 48: task.v1.ReopenTaskRequest
+	(*ReopenTaskResponse)(nil),                         // 49: task.v1.ReopenTaskResponse
+	(*GetTaskStatsRequest)(nil),                        // 50: task.v1.GetTaskStatsRequest
+	(*CompletionBucket)(nil),                           // 51: task.v1.CompletionBucket
+	(*TaskTypeStats)(nil),                              // 52: task.v1.TaskTypeStats
+	(*GetTaskStatsResponse)(nil),                       // 53: task.v1.GetTaskStatsResponse
+	(*DeletedTask)(nil),                                // 54: task.v1.DeletedTask
+	(*ListDeletedTasksRequest)(nil),                    // 55: task.v1.ListDeletedTasksRequest
+	(*ListDeletedTasksResponse)(nil),                   // 56: task.v1.ListDeletedTasksResponse
+	(*RestoreTaskRequest)(nil),                         // 57: task.v1.RestoreTaskRequest
+	(*RestoreTaskResponse)(nil),                        // 58: task.v1.RestoreTaskResponse
+	(*ChecklistItem)(nil),                              // 59: task.v1.ChecklistItem
+	(*ChecklistProgress)(nil),                          // 60: task.v1.ChecklistProgress
+	(*ListChecklistItemsRequest)(nil),                  // 61: task.v1.ListChecklistItemsRequest
+	(*ListChecklistItemsResponse)(nil),                 // 62: task.v1.ListChecklistItemsResponse
+	(*AddChecklistItemRequest)(nil),                    // 63: task.v1.AddChecklistItemRequest
+	(*AddChecklistItemResponse)(nil),                   // 64: task.v1.AddChecklistItemResponse
+	(*ToggleChecklistItemRequest)(nil),                 // 65: task.v1.ToggleChecklistItemRequest
+	(*ToggleChecklistItemResponse)(nil),                // 66: task.v1.ToggleChecklistItemResponse
+	(*ReorderChecklistItemsRequest)(nil),               // 67: task.v1.ReorderChecklistItemsRequest
+	(*ReorderChecklistItemsResponse)(nil),              // 68: task.v1.ReorderChecklistItemsResponse
+	(*DeleteChecklistItemRequest)(nil),                 // 69: task.v1.DeleteChecklistItemRequest
+	(*DeleteChecklistItemResponse)(nil),                // 70: task.v1.DeleteChecklistItemResponse
+	(*Tag)(nil),                                        // 71: task.v1.Tag
+	(*CreateTagRequest)(nil),                           // 72: task.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),                          // 73: task.v1.CreateTagResponse
+	(*ListTagsRequest)(nil),                            // 74: task.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                           // 75: task.v1.ListTagsResponse
+	(*UpdateTagRequest)(nil),                           // 76: task.v1.UpdateTagRequest
+	(*UpdateTagResponse)(nil),                          // 77: task.v1.UpdateTagResponse
+	(*DeleteTagRequest)(nil),                           // 78: task.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                          // 79: task.v1.DeleteTagResponse
+	(*AttachTagRequest)(nil),                           // 80: task.v1.AttachTagRequest
+	(*AttachTagResponse)(nil),                          // 81: task.v1.AttachTagResponse
+	(*DetachTagRequest)(nil),                           // 82: task.v1.DetachTagRequest
+	(*DetachTagResponse)(nil),                          // 83: task.v1.DetachTagResponse
+	(*PeriodSetting)(nil),                              // 84: task.v1.PeriodSetting
+	(*GetUserPeriodSettingsRequest)(nil),               // 85: task.v1.GetUserPeriodSettingsRequest
+	(*GetUserPeriodSettingsResponse)(nil),              // 86: task.v1.GetUserPeriodSettingsResponse
+	(*UpdateUserPeriodSettingsRequest)(nil),            // 87: task.v1.UpdateUserPeriodSettingsRequest
+	(*UpdateUserPeriodSettingsResponse)(nil),           // 88: task.v1.UpdateUserPeriodSettingsResponse
+	(*ReminderIntervalSetting)(nil),                    // 89: task.v1.ReminderIntervalSetting
+	(*GetUserReminderIntervalSettingsRequest)(nil),     // 90: task.v1.GetUserReminderIntervalSettingsRequest
+	(*GetUserReminderIntervalSettingsResponse)(nil),    // 91: task.v1.GetUserReminderIntervalSettingsResponse
+	(*UpdateUserReminderIntervalSettingsRequest)(nil),  // 92: task.v1.UpdateUserReminderIntervalSettingsRequest
+	(*UpdateUserReminderIntervalSettingsResponse)(nil), // 93: task.v1.UpdateUserReminderIntervalSettingsResponse
+	(*QuietWindow)(nil),                                // 94: task.v1.QuietWindow
+	(*QuietHoursSettings)(nil),                         // 95: task.v1.QuietHoursSettings
+	(*GetUserQuietHoursSettingsRequest)(nil),           // 96: task.v1.GetUserQuietHoursSettingsRequest
+	(*GetUserQuietHoursSettingsResponse)(nil),          // 97: task.v1.GetUserQuietHoursSettingsResponse
+	(*UpdateUserQuietHoursSettingsRequest)(nil),        // 98: task.v1.UpdateUserQuietHoursSettingsRequest
+	(*UpdateUserQuietHoursSettingsResponse)(nil),       // 99: task.v1.UpdateUserQuietHoursSettingsResponse
+	(*CreateCalendarFeedTokenRequest)(nil),             // 100: task.v1.CreateCalendarFeedTokenRequest
+	(*CreateCalendarFeedTokenResponse)(nil),            // 101: task.v1.CreateCalendarFeedTokenResponse
+	(*RotateCalendarFeedTokenRequest)(nil),             // 102: task.v1.RotateCalendarFeedTokenRequest
+	(*RotateCalendarFeedTokenResponse)(nil),            // 103: task.v1.RotateCalendarFeedTokenResponse
+	(*RevokeCalendarFeedTokenRequest)(nil),             // 104: task.v1.RevokeCalendarFeedTokenRequest
+	(*RevokeCalendarFeedTokenResponse)(nil),            // 105: task.v1.RevokeCalendarFeedTokenResponse
+	(*timestamppb.Timestamp)(nil),                      // 106: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                      // 107: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                        // 108: google.protobuf.Duration
 }
 var file_task_v1_task_proto_depIdxs = []int32{
-	0,  // 0: task.v1.Task.task_type:type_name -> task.v1.TaskType
-	1,  // 1: task.v1.Task.task_status:type_name -> task.v1.TaskStatus
-	19, // 2: task.v1.Task.scheduled_at:type_name -> google.protobuf.Timestamp
-	19, // 3: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	19, // 4: task.v1.Task.target_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: task.v1.CreateTaskRequest.task_type:type_name -> task.v1.TaskType
-	19, // 6: task.v1.CreateTaskRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	3,  // 7: task.v1.CreateTaskResponse.task:type_name -> task.v1.Task
-	3,  // 8: task.v1.GetTaskResponse.task:type_name -> task.v1.Task
-	2,  // 9: task.v1.ListActiveTasksRequest.sort_type:type_name -> task.v1.TaskSortType
-	3,  // 10: task.v1.ListActiveTasksResponse.tasks:type_name -> task.v1.Task
-	1,  // 11: task.v1.UpdateTaskRequest.task_status:type_name -> task.v1.TaskStatus
-	19, // 12: task.v1.UpdateTaskRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	20, // 13: task.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3,  // 14: task.v1.UpdateTaskResponse.task:type_name -> task.v1.Task
-	0,  // 15: task.v1.PeriodSetting.task_type:type_name -> task.v1.TaskType
-	14, // 16: task.v1.GetUserPeriodSettingsResponse.settings:type_name -> task.v1.PeriodSetting
-	14, // 17: task.v1.GetUserPeriodSettingsResponse.defaults:type_name -> task.v1.PeriodSetting
-	14, // 18: task.v1.UpdateUserPeriodSettingsRequest.settings:type_name -> task.v1.PeriodSetting
-	14, // 19: task.v1.UpdateUserPeriodSettingsResponse.settings:type_name -> task.v1.PeriodSetting
-	4,  // 20: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
-	6,  // 21: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
-	8,  // 22: task.v1.TaskService.ListActiveTasks:input_type -> task.v1.ListActiveTasksRequest
-	10, // 23: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
-	12, // 24: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
-	15, // 25: task.v1.UserPeriodSettingsService.GetUserPeriodSettings:input_type -> task.v1.GetUserPeriodSettingsRequest
-	17, // 26: task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings:input_type -> task.v1.UpdateUserPeriodSettingsRequest
-	5,  // 27: task.v1.TaskService.CreateTask:output_type -> task.v1.CreateTaskResponse
-	7,  // 28: task.v1.TaskService.GetTask:output_type -> task.v1.GetTaskResponse
-	9,  // 29: task.v1.TaskService.ListActiveTasks:output_type -> task.v1.ListActiveTasksResponse
-	11, // 30: task.v1.TaskService.UpdateTask:output_type -> task.v1.UpdateTaskResponse
-	13, // 31: task.v1.TaskService.DeleteTask:output_type -> task.v1.DeleteTaskResponse
-	16, // 32: task.v1.UserPeriodSettingsService.GetUserPeriodSettings:output_type -> task.v1.GetUserPeriodSettingsResponse
-	18, // 33: task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings:output_type -> task.v1.UpdateUserPeriodSettingsResponse
-	27, // [27:34] is the sub-list for method output_type
-	20, // [20:27] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,   // 0: task.v1.Task.task_type:type_name -> task.v1.TaskType
+	1,   // 1: task.v1.Task.task_status:type_name -> task.v1.TaskStatus
+	106, // 2: task.v1.Task.scheduled_at:type_name -> google.protobuf.Timestamp
+	106, // 3: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	106, // 4: task.v1.Task.target_at:type_name -> google.protobuf.Timestamp
+	9,   // 5: task.v1.Task.recurrence:type_name -> task.v1.Recurrence
+	60,  // 6: task.v1.Task.checklist_progress:type_name -> task.v1.ChecklistProgress
+	71,  // 7: task.v1.Task.tags:type_name -> task.v1.Tag
+	0,   // 8: task.v1.CreateTaskRequest.task_type:type_name -> task.v1.TaskType
+	106, // 9: task.v1.CreateTaskRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	9,   // 10: task.v1.CreateTaskRequest.recurrence:type_name -> task.v1.Recurrence
+	10,  // 11: task.v1.CreateTaskResponse.task:type_name -> task.v1.Task
+	10,  // 12: task.v1.GetTaskResponse.task:type_name -> task.v1.Task
+	7,   // 13: task.v1.ListActiveTasksRequest.sort_type:type_name -> task.v1.TaskSortType
+	0,   // 14: task.v1.ListActiveTasksRequest.task_type:type_name -> task.v1.TaskType
+	106, // 15: task.v1.ListActiveTasksRequest.target_at_from:type_name -> google.protobuf.Timestamp
+	106, // 16: task.v1.ListActiveTasksRequest.target_at_to:type_name -> google.protobuf.Timestamp
+	8,   // 17: task.v1.ListActiveTasksRequest.sort_direction:type_name -> task.v1.SortDirection
+	10,  // 18: task.v1.ListActiveTasksResponse.tasks:type_name -> task.v1.Task
+	1,   // 19: task.v1.UpdateTaskRequest.task_status:type_name -> task.v1.TaskStatus
+	106, // 20: task.v1.UpdateTaskRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	107, // 21: task.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,   // 22: task.v1.UpdateTaskRequest.recurrence:type_name -> task.v1.Recurrence
+	10,  // 23: task.v1.UpdateTaskResponse.task:type_name -> task.v1.Task
+	10,  // 24: task.v1.UpdateTaskResponse.next_occurrence:type_name -> task.v1.Task
+	108, // 25: task.v1.SnoozeTaskRequest.duration:type_name -> google.protobuf.Duration
+	106, // 26: task.v1.SnoozeTaskRequest.until:type_name -> google.protobuf.Timestamp
+	10,  // 27: task.v1.SnoozeTaskResponse.task:type_name -> task.v1.Task
+	10,  // 28: task.v1.MoveTaskResponse.task:type_name -> task.v1.Task
+	17,  // 29: task.v1.BatchUpdateTasksRequest.updates:type_name -> task.v1.UpdateTaskRequest
+	18,  // 30: task.v1.BatchUpdateTaskResult.updated:type_name -> task.v1.UpdateTaskResponse
+	26,  // 31: task.v1.BatchUpdateTaskResult.error:type_name -> task.v1.BatchTaskError
+	27,  // 32: task.v1.BatchUpdateTasksResponse.results:type_name -> task.v1.BatchUpdateTaskResult
+	26,  // 33: task.v1.BatchDeleteTaskResult.error:type_name -> task.v1.BatchTaskError
+	30,  // 34: task.v1.BatchDeleteTasksResponse.results:type_name -> task.v1.BatchDeleteTaskResult
+	4,   // 35: task.v1.ImportTasksRequest.format:type_name -> task.v1.ImportFormat
+	0,   // 36: task.v1.ImportTasksRequest.default_task_type:type_name -> task.v1.TaskType
+	10,  // 37: task.v1.ImportTaskResult.task:type_name -> task.v1.Task
+	26,  // 38: task.v1.ImportTaskResult.error:type_name -> task.v1.BatchTaskError
+	33,  // 39: task.v1.ImportTasksResponse.results:type_name -> task.v1.ImportTaskResult
+	10,  // 40: task.v1.SearchTaskHit.active_task:type_name -> task.v1.Task
+	43,  // 41: task.v1.SearchTaskHit.completed_task:type_name -> task.v1.CompletedTask
+	36,  // 42: task.v1.SearchTasksResponse.hits:type_name -> task.v1.SearchTaskHit
+	2,   // 43: task.v1.TaskEvent.type:type_name -> task.v1.TaskEventType
+	10,  // 44: task.v1.TaskEvent.task:type_name -> task.v1.Task
+	106, // 45: task.v1.TaskEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	3,   // 46: task.v1.TaskTombstone.reason:type_name -> task.v1.TaskTombstoneReason
+	106, // 47: task.v1.TaskTombstone.removed_at:type_name -> google.protobuf.Timestamp
+	10,  // 48: task.v1.SyncTasksResponse.tasks:type_name -> task.v1.Task
+	41,  // 49: task.v1.SyncTasksResponse.tombstones:type_name -> task.v1.TaskTombstone
+	0,   // 50: task.v1.CompletedTask.task_type:type_name -> task.v1.TaskType
+	106, // 51: task.v1.CompletedTask.scheduled_at:type_name -> google.protobuf.Timestamp
+	106, // 52: task.v1.CompletedTask.created_at:type_name -> google.protobuf.Timestamp
+	106, // 53: task.v1.CompletedTask.target_at:type_name -> google.protobuf.Timestamp
+	106, // 54: task.v1.CompletedTask.completed_at:type_name -> google.protobuf.Timestamp
+	59,  // 55: task.v1.CompletedTask.checklist:type_name -> task.v1.ChecklistItem
+	71,  // 56: task.v1.CompletedTask.tags:type_name -> task.v1.Tag
+	0,   // 57: task.v1.ListCompletedTasksRequest.task_type:type_name -> task.v1.TaskType
+	106, // 58: task.v1.ListCompletedTasksRequest.completed_at_from:type_name -> google.protobuf.Timestamp
+	106, // 59: task.v1.ListCompletedTasksRequest.completed_at_to:type_name -> google.protobuf.Timestamp
+	43,  // 60: task.v1.ListCompletedTasksResponse.completed_tasks:type_name -> task.v1.CompletedTask
+	43,  // 61: task.v1.GetCompletedTaskResponse.completed_task:type_name -> task.v1.CompletedTask
+	10,  // 62: task.v1.ReopenTaskResponse.task:type_name -> task.v1.Task
+	5,   // 63: task.v1.GetTaskStatsRequest.interval:type_name -> task.v1.StatsInterval
+	106, // 64: task.v1.CompletionBucket.start:type_name -> google.protobuf.Timestamp
+	0,   // 65: task.v1.TaskTypeStats.task_type:type_name -> task.v1.TaskType
+	108, // 66: task.v1.TaskTypeStats.median_time_to_complete:type_name -> google.protobuf.Duration
+	51,  // 67: task.v1.GetTaskStatsResponse.buckets:type_name -> task.v1.CompletionBucket
+	52,  // 68: task.v1.GetTaskStatsResponse.task_types:type_name -> task.v1.TaskTypeStats
+	10,  // 69: task.v1.DeletedTask.task:type_name -> task.v1.Task
+	106, // 70: task.v1.DeletedTask.deleted_at:type_name -> google.protobuf.Timestamp
+	54,  // 71: task.v1.ListDeletedTasksResponse.deleted_tasks:type_name -> task.v1.DeletedTask
+	10,  // 72: task.v1.RestoreTaskResponse.task:type_name -> task.v1.Task
+	59,  // 73: task.v1.ListChecklistItemsResponse.items:type_name -> task.v1.ChecklistItem
+	60,  // 74: task.v1.ListChecklistItemsResponse.progress:type_name -> task.v1.ChecklistProgress
+	59,  // 75: task.v1.AddChecklistItemResponse.items:type_name -> task.v1.ChecklistItem
+	60,  // 76: task.v1.AddChecklistItemResponse.progress:type_name -> task.v1.ChecklistProgress
+	59,  // 77: task.v1.ToggleChecklistItemResponse.items:type_name -> task.v1.ChecklistItem
+	60,  // 78: task.v1.ToggleChecklistItemResponse.progress:type_name -> task.v1.ChecklistProgress
+	59,  // 79: task.v1.ReorderChecklistItemsResponse.items:type_name -> task.v1.ChecklistItem
+	60,  // 80: task.v1.ReorderChecklistItemsResponse.progress:type_name -> task.v1.ChecklistProgress
+	59,  // 81: task.v1.DeleteChecklistItemResponse.items:type_name -> task.v1.ChecklistItem
+	60,  // 82: task.v1.DeleteChecklistItemResponse.progress:type_name -> task.v1.ChecklistProgress
+	106, // 83: task.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 84: task.v1.CreateTagResponse.tag:type_name -> task.v1.Tag
+	71,  // 85: task.v1.ListTagsResponse.tags:type_name -> task.v1.Tag
+	71,  // 86: task.v1.UpdateTagResponse.tag:type_name -> task.v1.Tag
+	71,  // 87: task.v1.AttachTagResponse.tags:type_name -> task.v1.Tag
+	71,  // 88: task.v1.DetachTagResponse.tags:type_name -> task.v1.Tag
+	0,   // 89: task.v1.PeriodSetting.task_type:type_name -> task.v1.TaskType
+	84,  // 90: task.v1.GetUserPeriodSettingsResponse.settings:type_name -> task.v1.PeriodSetting
+	84,  // 91: task.v1.GetUserPeriodSettingsResponse.defaults:type_name -> task.v1.PeriodSetting
+	84,  // 92: task.v1.UpdateUserPeriodSettingsRequest.settings:type_name -> task.v1.PeriodSetting
+	84,  // 93: task.v1.UpdateUserPeriodSettingsResponse.settings:type_name -> task.v1.PeriodSetting
+	0,   // 94: task.v1.ReminderIntervalSetting.task_type:type_name -> task.v1.TaskType
+	89,  // 95: task.v1.GetUserReminderIntervalSettingsResponse.settings:type_name -> task.v1.ReminderIntervalSetting
+	89,  // 96: task.v1.GetUserReminderIntervalSettingsResponse.defaults:type_name -> task.v1.ReminderIntervalSetting
+	89,  // 97: task.v1.UpdateUserReminderIntervalSettingsRequest.settings:type_name -> task.v1.ReminderIntervalSetting
+	89,  // 98: task.v1.UpdateUserReminderIntervalSettingsResponse.settings:type_name -> task.v1.ReminderIntervalSetting
+	94,  // 99: task.v1.QuietHoursSettings.windows:type_name -> task.v1.QuietWindow
+	6,   // 100: task.v1.QuietHoursSettings.policy:type_name -> task.v1.QuietHoursPolicy
+	95,  // 101: task.v1.GetUserQuietHoursSettingsResponse.settings:type_name -> task.v1.QuietHoursSettings
+	95,  // 102: task.v1.UpdateUserQuietHoursSettingsRequest.settings:type_name -> task.v1.QuietHoursSettings
+	95,  // 103: task.v1.UpdateUserQuietHoursSettingsResponse.settings:type_name -> task.v1.QuietHoursSettings
+	11,  // 104: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
+	13,  // 105: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
+	15,  // 106: task.v1.TaskService.ListActiveTasks:input_type -> task.v1.ListActiveTasksRequest
+	17,  // 107: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
+	19,  // 108: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
+	21,  // 109: task.v1.TaskService.SnoozeTask:input_type -> task.v1.SnoozeTaskRequest
+	23,  // 110: task.v1.TaskService.MoveTask:input_type -> task.v1.MoveTaskRequest
+	35,  // 111: task.v1.TaskService.SearchTasks:input_type -> task.v1.SearchTasksRequest
+	25,  // 112: task.v1.TaskService.BatchUpdateTasks:input_type -> task.v1.BatchUpdateTasksRequest
+	29,  // 113: task.v1.TaskService.BatchDeleteTasks:input_type -> task.v1.BatchDeleteTasksRequest
+	38,  // 114: task.v1.TaskService.WatchTasks:input_type -> task.v1.WatchTasksRequest
+	40,  // 115: task.v1.TaskService.SyncTasks:input_type -> task.v1.SyncTasksRequest
+	32,  // 116: task.v1.TaskService.ImportTasks:input_type -> task.v1.ImportTasksRequest
+	44,  // 117: task.v1.CompletedTaskService.ListCompletedTasks:input_type -> task.v1.ListCompletedTasksRequest
+	46,  // 118: task.v1.CompletedTaskService.GetCompletedTask:input_type -> task.v1.GetCompletedTaskRequest
+	48,  // 119: task.v1.CompletedTaskService.ReopenTask:input_type -> task.v1.ReopenTaskRequest
+	50,  // 120: task.v1.CompletedTaskService.GetTaskStats:input_type -> task.v1.GetTaskStatsRequest
+	55,  // 121: task.v1.TaskTrashService.ListDeletedTasks:input_type -> task.v1.ListDeletedTasksRequest
+	57,  // 122: task.v1.TaskTrashService.RestoreTask:input_type -> task.v1.RestoreTaskRequest
+	61,  // 123: task.v1.TaskChecklistService.ListChecklistItems:input_type -> task.v1.ListChecklistItemsRequest
+	63,  // 124: task.v1.TaskChecklistService.AddChecklistItem:input_type -> task.v1.AddChecklistItemRequest
+	65,  // 125: task.v1.TaskChecklistService.ToggleChecklistItem:input_type -> task.v1.ToggleChecklistItemRequest
+	67,  // 126: task.v1.TaskChecklistService.ReorderChecklistItems:input_type -> task.v1.ReorderChecklistItemsRequest
+	69,  // 127: task.v1.TaskChecklistService.DeleteChecklistItem:input_type -> task.v1.DeleteChecklistItemRequest
+	72,  // 128: task.v1.TagService.CreateTag:input_type -> task.v1.CreateTagRequest
+	74,  // 129: task.v1.TagService.ListTags:input_type -> task.v1.ListTagsRequest
+	76,  // 130: task.v1.TagService.UpdateTag:input_type -> task.v1.UpdateTagRequest
+	78,  // 131: task.v1.TagService.DeleteTag:input_type -> task.v1.DeleteTagRequest
+	80,  // 132: task.v1.TagService.AttachTag:input_type -> task.v1.AttachTagRequest
+	82,  // 133: task.v1.TagService.DetachTag:input_type -> task.v1.DetachTagRequest
+	85,  // 134: task.v1.UserPeriodSettingsService.GetUserPeriodSettings:input_type -> task.v1.GetUserPeriodSettingsRequest
+	87,  // 135: task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings:input_type -> task.v1.UpdateUserPeriodSettingsRequest
+	90,  // 136: task.v1.UserPeriodSettingsService.GetUserReminderIntervalSettings:input_type -> task.v1.GetUserReminderIntervalSettingsRequest
+	92,  // 137: task.v1.UserPeriodSettingsService.UpdateUserReminderIntervalSettings:input_type -> task.v1.UpdateUserReminderIntervalSettingsRequest
+	96,  // 138: task.v1.UserPeriodSettingsService.GetUserQuietHoursSettings:input_type -> task.v1.GetUserQuietHoursSettingsRequest
+	98,  // 139: task.v1.UserPeriodSettingsService.UpdateUserQuietHoursSettings:input_type -> task.v1.UpdateUserQuietHoursSettingsRequest
+	100, // 140: task.v1.CalendarFeedService.CreateCalendarFeedToken:input_type -> task.v1.CreateCalendarFeedTokenRequest
+	102, // 141: task.v1.CalendarFeedService.RotateCalendarFeedToken:input_type -> task.v1.RotateCalendarFeedTokenRequest
+	104, // 142: task.v1.CalendarFeedService.RevokeCalendarFeedToken:input_type -> task.v1.RevokeCalendarFeedTokenRequest
+	12,  // 143: task.v1.TaskService.CreateTask:output_type -> task.v1.CreateTaskResponse
+	14,  // 144: task.v1.TaskService.GetTask:output_type -> task.v1.GetTaskResponse
+	16,  // 145: task.v1.TaskService.ListActiveTasks:output_type -> task.v1.ListActiveTasksResponse
+	18,  // 146: task.v1.TaskService.UpdateTask:output_type -> task.v1.UpdateTaskResponse
+	20,  // 147: task.v1.TaskService.DeleteTask:output_type -> task.v1.DeleteTaskResponse
+	22,  // 148: task.v1.TaskService.SnoozeTask:output_type -> task.v1.SnoozeTaskResponse
+	24,  // 149: task.v1.TaskService.MoveTask:output_type -> task.v1.MoveTaskResponse
+	37,  // 150: task.v1.TaskService.SearchTasks:output_type -> task.v1.SearchTasksResponse
+	28,  // 151: task.v1.TaskService.BatchUpdateTasks:output_type -> task.v1.BatchUpdateTasksResponse
+	31,  // 152: task.v1.TaskService.BatchDeleteTasks:output_type -> task.v1.BatchDeleteTasksResponse
+	39,  // 153: task.v1.TaskService.WatchTasks:output_type -> task.v1.TaskEvent
+	42,  // 154: task.v1.TaskService.SyncTasks:output_type -> task.v1.SyncTasksResponse
+	34,  // 155: task.v1.TaskService.ImportTasks:output_type -> task.v1.ImportTasksResponse
+	45,  // 156: task.v1.CompletedTaskService.ListCompletedTasks:output_type -> task.v1.ListCompletedTasksResponse
+	47,  // 157: task.v1.CompletedTaskService.GetCompletedTask:output_type -> task.v1.GetCompletedTaskResponse
+	49,  // 158: task.v1.CompletedTaskService.ReopenTask:output_type -> task.v1.ReopenTaskResponse
+	53,  // 159: task.v1.CompletedTaskService.GetTaskStats:output_type -> task.v1.GetTaskStatsResponse
+	56,  // 160: task.v1.TaskTrashService.ListDeletedTasks:output_type -> task.v1.ListDeletedTasksResponse
+	58,  // 161: task.v1.TaskTrashService.RestoreTask:output_type -> task.v1.RestoreTaskResponse
+	62,  // 162: task.v1.TaskChecklistService.ListChecklistItems:output_type -> task.v1.ListChecklistItemsResponse
+	64,  // 163: task.v1.TaskChecklistService.AddChecklistItem:output_type -> task.v1.AddChecklistItemResponse
+	66,  // 164: task.v1.TaskChecklistService.ToggleChecklistItem:output_type -> task.v1.ToggleChecklistItemResponse
+	68,  // 165: task.v1.TaskChecklistService.ReorderChecklistItems:output_type -> task.v1.ReorderChecklistItemsResponse
+	70,  // 166: task.v1.TaskChecklistService.DeleteChecklistItem:output_type -> task.v1.DeleteChecklistItemResponse
+	73,  // 167: task.v1.TagService.CreateTag:output_type -> task.v1.CreateTagResponse
+	75,  // 168: task.v1.TagService.ListTags:output_type -> task.v1.ListTagsResponse
+	77,  // 169: task.v1.TagService.UpdateTag:output_type -> task.v1.UpdateTagResponse
+	79,  // 170: task.v1.TagService.DeleteTag:output_type -> task.v1.DeleteTagResponse
+	81,  // 171: task.v1.TagService.AttachTag:output_type -> task.v1.AttachTagResponse
+	83,  // 172: task.v1.TagService.DetachTag:output_type -> task.v1.DetachTagResponse
+	86,  // 173: task.v1.UserPeriodSettingsService.GetUserPeriodSettings:output_type -> task.v1.GetUserPeriodSettingsResponse
+	88,  // 174: task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings:output_type -> task.v1.UpdateUserPeriodSettingsResponse
+	91,  // 175: task.v1.UserPeriodSettingsService.GetUserReminderIntervalSettings:output_type -> task.v1.GetUserReminderIntervalSettingsResponse
+	93,  // 176: task.v1.UserPeriodSettingsService.UpdateUserReminderIntervalSettings:output_type -> task.v1.UpdateUserReminderIntervalSettingsResponse
+	97,  // 177: task.v1.UserPeriodSettingsService.GetUserQuietHoursSettings:output_type -> task.v1.GetUserQuietHoursSettingsResponse
+	99,  // 178: task.v1.UserPeriodSettingsService.UpdateUserQuietHoursSettings:output_type -> task.v1.UpdateUserQuietHoursSettingsResponse
+	101, // 179: task.v1.CalendarFeedService.CreateCalendarFeedToken:output_type -> task.v1.CreateCalendarFeedTokenResponse
+	103, // 180: task.v1.CalendarFeedService.RotateCalendarFeedToken:output_type -> task.v1.RotateCalendarFeedTokenResponse
+	105, // 181: task.v1.CalendarFeedService.RevokeCalendarFeedToken:output_type -> task.v1.RevokeCalendarFeedTokenResponse
+	143, // [143:182] is the sub-list for method output_type
+	104, // [104:143] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
 }
 
 func init() { file_task_v1_task_proto_init() }
@@ -1210,18 +6734,46 @@ func file_task_v1_task_proto_init() {
 	if File_task_v1_task_proto != nil {
 		return
 	}
-	file_task_v1_task_proto_msgTypes[0].OneofWrappers = []any{}
 	file_task_v1_task_proto_msgTypes[1].OneofWrappers = []any{}
-	file_task_v1_task_proto_msgTypes[7].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[2].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[6].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[8].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[9].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[12].OneofWrappers = []any{
+		(*SnoozeTaskRequest_Duration)(nil),
+		(*SnoozeTaskRequest_Until)(nil),
+	}
+	file_task_v1_task_proto_msgTypes[14].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[18].OneofWrappers = []any{
+		(*BatchUpdateTaskResult_Updated)(nil),
+		(*BatchUpdateTaskResult_Error)(nil),
+	}
+	file_task_v1_task_proto_msgTypes[21].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[24].OneofWrappers = []any{
+		(*ImportTaskResult_Task)(nil),
+		(*ImportTaskResult_Error)(nil),
+	}
+	file_task_v1_task_proto_msgTypes[26].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[27].OneofWrappers = []any{
+		(*SearchTaskHit_ActiveTask)(nil),
+		(*SearchTaskHit_CompletedTask)(nil),
+	}
+	file_task_v1_task_proto_msgTypes[30].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[34].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[35].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[67].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[88].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[89].OneofWrappers = []any{}
+	file_task_v1_task_proto_msgTypes[90].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_v1_task_proto_rawDesc), len(file_task_v1_task_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   16,
+			NumEnums:      9,
+			NumMessages:   97,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   7,
 		},
 		GoTypes:           file_task_v1_task_proto_goTypes,
 		DependencyIndexes: file_task_v1_task_proto_depIdxs,
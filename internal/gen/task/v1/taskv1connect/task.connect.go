@@ -23,9 +23,19 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// TaskServiceName is the fully-qualified name of the TaskService service.
 	TaskServiceName = "task.v1.TaskService"
+	// CompletedTaskServiceName is the fully-qualified name of the CompletedTaskService service.
+	CompletedTaskServiceName = "task.v1.CompletedTaskService"
+	// TaskTrashServiceName is the fully-qualified name of the TaskTrashService service.
+	TaskTrashServiceName = "task.v1.TaskTrashService"
+	// TaskChecklistServiceName is the fully-qualified name of the TaskChecklistService service.
+	TaskChecklistServiceName = "task.v1.TaskChecklistService"
+	// TagServiceName is the fully-qualified name of the TagService service.
+	TagServiceName = "task.v1.TagService"
 	// UserPeriodSettingsServiceName is the fully-qualified name of the UserPeriodSettingsService
 	// service.
 	UserPeriodSettingsServiceName = "task.v1.UserPeriodSettingsService"
+	// CalendarFeedServiceName is the fully-qualified name of the CalendarFeedService service.
+	CalendarFeedServiceName = "task.v1.CalendarFeedService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -47,12 +57,96 @@ const (
 	TaskServiceUpdateTaskProcedure = "/task.v1.TaskService/UpdateTask"
 	// TaskServiceDeleteTaskProcedure is the fully-qualified name of the TaskService's DeleteTask RPC.
 	TaskServiceDeleteTaskProcedure = "/task.v1.TaskService/DeleteTask"
+	// TaskServiceSnoozeTaskProcedure is the fully-qualified name of the TaskService's SnoozeTask RPC.
+	TaskServiceSnoozeTaskProcedure = "/task.v1.TaskService/SnoozeTask"
+	// TaskServiceMoveTaskProcedure is the fully-qualified name of the TaskService's MoveTask RPC.
+	TaskServiceMoveTaskProcedure = "/task.v1.TaskService/MoveTask"
+	// TaskServiceSearchTasksProcedure is the fully-qualified name of the TaskService's SearchTasks RPC.
+	TaskServiceSearchTasksProcedure = "/task.v1.TaskService/SearchTasks"
+	// TaskServiceBatchUpdateTasksProcedure is the fully-qualified name of the TaskService's
+	// BatchUpdateTasks RPC.
+	TaskServiceBatchUpdateTasksProcedure = "/task.v1.TaskService/BatchUpdateTasks"
+	// TaskServiceBatchDeleteTasksProcedure is the fully-qualified name of the TaskService's
+	// BatchDeleteTasks RPC.
+	TaskServiceBatchDeleteTasksProcedure = "/task.v1.TaskService/BatchDeleteTasks"
+	// TaskServiceWatchTasksProcedure is the fully-qualified name of the TaskService's WatchTasks RPC.
+	TaskServiceWatchTasksProcedure = "/task.v1.TaskService/WatchTasks"
+	// TaskServiceSyncTasksProcedure is the fully-qualified name of the TaskService's SyncTasks RPC.
+	TaskServiceSyncTasksProcedure = "/task.v1.TaskService/SyncTasks"
+	// TaskServiceImportTasksProcedure is the fully-qualified name of the TaskService's ImportTasks RPC.
+	TaskServiceImportTasksProcedure = "/task.v1.TaskService/ImportTasks"
+	// CompletedTaskServiceListCompletedTasksProcedure is the fully-qualified name of the
+	// CompletedTaskService's ListCompletedTasks RPC.
+	CompletedTaskServiceListCompletedTasksProcedure = "/task.v1.CompletedTaskService/ListCompletedTasks"
+	// CompletedTaskServiceGetCompletedTaskProcedure is the fully-qualified name of the
+	// CompletedTaskService's GetCompletedTask RPC.
+	CompletedTaskServiceGetCompletedTaskProcedure = "/task.v1.CompletedTaskService/GetCompletedTask"
+	// CompletedTaskServiceReopenTaskProcedure is the fully-qualified name of the CompletedTaskService's
+	// ReopenTask RPC.
+	CompletedTaskServiceReopenTaskProcedure = "/task.v1.CompletedTaskService/ReopenTask"
+	// CompletedTaskServiceGetTaskStatsProcedure is the fully-qualified name of the
+	// CompletedTaskService's GetTaskStats RPC.
+	CompletedTaskServiceGetTaskStatsProcedure = "/task.v1.CompletedTaskService/GetTaskStats"
+	// TaskTrashServiceListDeletedTasksProcedure is the fully-qualified name of the TaskTrashService's
+	// ListDeletedTasks RPC.
+	TaskTrashServiceListDeletedTasksProcedure = "/task.v1.TaskTrashService/ListDeletedTasks"
+	// TaskTrashServiceRestoreTaskProcedure is the fully-qualified name of the TaskTrashService's
+	// RestoreTask RPC.
+	TaskTrashServiceRestoreTaskProcedure = "/task.v1.TaskTrashService/RestoreTask"
+	// TaskChecklistServiceListChecklistItemsProcedure is the fully-qualified name of the
+	// TaskChecklistService's ListChecklistItems RPC.
+	TaskChecklistServiceListChecklistItemsProcedure = "/task.v1.TaskChecklistService/ListChecklistItems"
+	// TaskChecklistServiceAddChecklistItemProcedure is the fully-qualified name of the
+	// TaskChecklistService's AddChecklistItem RPC.
+	TaskChecklistServiceAddChecklistItemProcedure = "/task.v1.TaskChecklistService/AddChecklistItem"
+	// TaskChecklistServiceToggleChecklistItemProcedure is the fully-qualified name of the
+	// TaskChecklistService's ToggleChecklistItem RPC.
+	TaskChecklistServiceToggleChecklistItemProcedure = "/task.v1.TaskChecklistService/ToggleChecklistItem"
+	// TaskChecklistServiceReorderChecklistItemsProcedure is the fully-qualified name of the
+	// TaskChecklistService's ReorderChecklistItems RPC.
+	TaskChecklistServiceReorderChecklistItemsProcedure = "/task.v1.TaskChecklistService/ReorderChecklistItems"
+	// TaskChecklistServiceDeleteChecklistItemProcedure is the fully-qualified name of the
+	// TaskChecklistService's DeleteChecklistItem RPC.
+	TaskChecklistServiceDeleteChecklistItemProcedure = "/task.v1.TaskChecklistService/DeleteChecklistItem"
+	// TagServiceCreateTagProcedure is the fully-qualified name of the TagService's CreateTag RPC.
+	TagServiceCreateTagProcedure = "/task.v1.TagService/CreateTag"
+	// TagServiceListTagsProcedure is the fully-qualified name of the TagService's ListTags RPC.
+	TagServiceListTagsProcedure = "/task.v1.TagService/ListTags"
+	// TagServiceUpdateTagProcedure is the fully-qualified name of the TagService's UpdateTag RPC.
+	TagServiceUpdateTagProcedure = "/task.v1.TagService/UpdateTag"
+	// TagServiceDeleteTagProcedure is the fully-qualified name of the TagService's DeleteTag RPC.
+	TagServiceDeleteTagProcedure = "/task.v1.TagService/DeleteTag"
+	// TagServiceAttachTagProcedure is the fully-qualified name of the TagService's AttachTag RPC.
+	TagServiceAttachTagProcedure = "/task.v1.TagService/AttachTag"
+	// TagServiceDetachTagProcedure is the fully-qualified name of the TagService's DetachTag RPC.
+	TagServiceDetachTagProcedure = "/task.v1.TagService/DetachTag"
 	// UserPeriodSettingsServiceGetUserPeriodSettingsProcedure is the fully-qualified name of the
 	// UserPeriodSettingsService's GetUserPeriodSettings RPC.
 	UserPeriodSettingsServiceGetUserPeriodSettingsProcedure = "/task.v1.UserPeriodSettingsService/GetUserPeriodSettings"
 	// UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure is the fully-qualified name of the
 	// UserPeriodSettingsService's UpdateUserPeriodSettings RPC.
 	UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure = "/task.v1.UserPeriodSettingsService/UpdateUserPeriodSettings"
+	// UserPeriodSettingsServiceGetUserReminderIntervalSettingsProcedure is the fully-qualified name of
+	// the UserPeriodSettingsService's GetUserReminderIntervalSettings RPC.
+	UserPeriodSettingsServiceGetUserReminderIntervalSettingsProcedure = "/task.v1.UserPeriodSettingsService/GetUserReminderIntervalSettings"
+	// UserPeriodSettingsServiceUpdateUserReminderIntervalSettingsProcedure is the fully-qualified name
+	// of the UserPeriodSettingsService's UpdateUserReminderIntervalSettings RPC.
+	UserPeriodSettingsServiceUpdateUserReminderIntervalSettingsProcedure = "/task.v1.UserPeriodSettingsService/UpdateUserReminderIntervalSettings"
+	// UserPeriodSettingsServiceGetUserQuietHoursSettingsProcedure is the fully-qualified name of the
+	// UserPeriodSettingsService's GetUserQuietHoursSettings RPC.
+	UserPeriodSettingsServiceGetUserQuietHoursSettingsProcedure = "/task.v1.UserPeriodSettingsService/GetUserQuietHoursSettings"
+	// UserPeriodSettingsServiceUpdateUserQuietHoursSettingsProcedure is the fully-qualified name of the
+	// UserPeriodSettingsService's UpdateUserQuietHoursSettings RPC.
+	UserPeriodSettingsServiceUpdateUserQuietHoursSettingsProcedure = "/task.v1.UserPeriodSettingsService/UpdateUserQuietHoursSettings"
+	// CalendarFeedServiceCreateCalendarFeedTokenProcedure is the fully-qualified name of the
+	// CalendarFeedService's CreateCalendarFeedToken RPC.
+	CalendarFeedServiceCreateCalendarFeedTokenProcedure = "/task.v1.CalendarFeedService/CreateCalendarFeedToken"
+	// CalendarFeedServiceRotateCalendarFeedTokenProcedure is the fully-qualified name of the
+	// CalendarFeedService's RotateCalendarFeedToken RPC.
+	CalendarFeedServiceRotateCalendarFeedTokenProcedure = "/task.v1.CalendarFeedService/RotateCalendarFeedToken"
+	// CalendarFeedServiceRevokeCalendarFeedTokenProcedure is the fully-qualified name of the
+	// CalendarFeedService's RevokeCalendarFeedToken RPC.
+	CalendarFeedServiceRevokeCalendarFeedTokenProcedure = "/task.v1.CalendarFeedService/RevokeCalendarFeedToken"
 )
 
 // TaskServiceClient is a client for the task.v1.TaskService service.
@@ -62,6 +156,14 @@ type TaskServiceClient interface {
 	ListActiveTasks(context.Context, *v1.ListActiveTasksRequest) (*v1.ListActiveTasksResponse, error)
 	UpdateTask(context.Context, *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error)
 	DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error)
+	SnoozeTask(context.Context, *v1.SnoozeTaskRequest) (*v1.SnoozeTaskResponse, error)
+	MoveTask(context.Context, *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error)
+	SearchTasks(context.Context, *v1.SearchTasksRequest) (*v1.SearchTasksResponse, error)
+	BatchUpdateTasks(context.Context, *v1.BatchUpdateTasksRequest) (*v1.BatchUpdateTasksResponse, error)
+	BatchDeleteTasks(context.Context, *v1.BatchDeleteTasksRequest) (*v1.BatchDeleteTasksResponse, error)
+	WatchTasks(context.Context, *v1.WatchTasksRequest) (*connect.ServerStreamForClient[v1.TaskEvent], error)
+	SyncTasks(context.Context, *v1.SyncTasksRequest) (*v1.SyncTasksResponse, error)
+	ImportTasks(context.Context, *v1.ImportTasksRequest) (*v1.ImportTasksResponse, error)
 }
 
 // NewTaskServiceClient constructs a client for the task.v1.TaskService service. By default, it uses
@@ -105,16 +207,72 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("DeleteTask")),
 			connect.WithClientOptions(opts...),
 		),
+		snoozeTask: connect.NewClient[v1.SnoozeTaskRequest, v1.SnoozeTaskResponse](
+			httpClient,
+			baseURL+TaskServiceSnoozeTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("SnoozeTask")),
+			connect.WithClientOptions(opts...),
+		),
+		moveTask: connect.NewClient[v1.MoveTaskRequest, v1.MoveTaskResponse](
+			httpClient,
+			baseURL+TaskServiceMoveTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("MoveTask")),
+			connect.WithClientOptions(opts...),
+		),
+		searchTasks: connect.NewClient[v1.SearchTasksRequest, v1.SearchTasksResponse](
+			httpClient,
+			baseURL+TaskServiceSearchTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("SearchTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateTasks: connect.NewClient[v1.BatchUpdateTasksRequest, v1.BatchUpdateTasksResponse](
+			httpClient,
+			baseURL+TaskServiceBatchUpdateTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("BatchUpdateTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeleteTasks: connect.NewClient[v1.BatchDeleteTasksRequest, v1.BatchDeleteTasksResponse](
+			httpClient,
+			baseURL+TaskServiceBatchDeleteTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("BatchDeleteTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		watchTasks: connect.NewClient[v1.WatchTasksRequest, v1.TaskEvent](
+			httpClient,
+			baseURL+TaskServiceWatchTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("WatchTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		syncTasks: connect.NewClient[v1.SyncTasksRequest, v1.SyncTasksResponse](
+			httpClient,
+			baseURL+TaskServiceSyncTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("SyncTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		importTasks: connect.NewClient[v1.ImportTasksRequest, v1.ImportTasksResponse](
+			httpClient,
+			baseURL+TaskServiceImportTasksProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ImportTasks")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // taskServiceClient implements TaskServiceClient.
 type taskServiceClient struct {
-	createTask      *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	getTask         *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	listActiveTasks *connect.Client[v1.ListActiveTasksRequest, v1.ListActiveTasksResponse]
-	updateTask      *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	deleteTask      *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	createTask       *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask          *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listActiveTasks  *connect.Client[v1.ListActiveTasksRequest, v1.ListActiveTasksResponse]
+	updateTask       *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	deleteTask       *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	snoozeTask       *connect.Client[v1.SnoozeTaskRequest, v1.SnoozeTaskResponse]
+	moveTask         *connect.Client[v1.MoveTaskRequest, v1.MoveTaskResponse]
+	searchTasks      *connect.Client[v1.SearchTasksRequest, v1.SearchTasksResponse]
+	batchUpdateTasks *connect.Client[v1.BatchUpdateTasksRequest, v1.BatchUpdateTasksResponse]
+	batchDeleteTasks *connect.Client[v1.BatchDeleteTasksRequest, v1.BatchDeleteTasksResponse]
+	watchTasks       *connect.Client[v1.WatchTasksRequest, v1.TaskEvent]
+	syncTasks        *connect.Client[v1.SyncTasksRequest, v1.SyncTasksResponse]
+	importTasks      *connect.Client[v1.ImportTasksRequest, v1.ImportTasksResponse]
 }
 
 // CreateTask calls task.v1.TaskService.CreateTask.
@@ -162,6 +320,74 @@ func (c *taskServiceClient) DeleteTask(ctx context.Context, req *v1.DeleteTaskRe
 	return nil, err
 }
 
+// SnoozeTask calls task.v1.TaskService.SnoozeTask.
+func (c *taskServiceClient) SnoozeTask(ctx context.Context, req *v1.SnoozeTaskRequest) (*v1.SnoozeTaskResponse, error) {
+	response, err := c.snoozeTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MoveTask calls task.v1.TaskService.MoveTask.
+func (c *taskServiceClient) MoveTask(ctx context.Context, req *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error) {
+	response, err := c.moveTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchTasks calls task.v1.TaskService.SearchTasks.
+func (c *taskServiceClient) SearchTasks(ctx context.Context, req *v1.SearchTasksRequest) (*v1.SearchTasksResponse, error) {
+	response, err := c.searchTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchUpdateTasks calls task.v1.TaskService.BatchUpdateTasks.
+func (c *taskServiceClient) BatchUpdateTasks(ctx context.Context, req *v1.BatchUpdateTasksRequest) (*v1.BatchUpdateTasksResponse, error) {
+	response, err := c.batchUpdateTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchDeleteTasks calls task.v1.TaskService.BatchDeleteTasks.
+func (c *taskServiceClient) BatchDeleteTasks(ctx context.Context, req *v1.BatchDeleteTasksRequest) (*v1.BatchDeleteTasksResponse, error) {
+	response, err := c.batchDeleteTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WatchTasks calls task.v1.TaskService.WatchTasks.
+func (c *taskServiceClient) WatchTasks(ctx context.Context, req *v1.WatchTasksRequest) (*connect.ServerStreamForClient[v1.TaskEvent], error) {
+	return c.watchTasks.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// SyncTasks calls task.v1.TaskService.SyncTasks.
+func (c *taskServiceClient) SyncTasks(ctx context.Context, req *v1.SyncTasksRequest) (*v1.SyncTasksResponse, error) {
+	response, err := c.syncTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ImportTasks calls task.v1.TaskService.ImportTasks.
+func (c *taskServiceClient) ImportTasks(ctx context.Context, req *v1.ImportTasksRequest) (*v1.ImportTasksResponse, error) {
+	response, err := c.importTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // TaskServiceHandler is an implementation of the task.v1.TaskService service.
 type TaskServiceHandler interface {
 	CreateTask(context.Context, *v1.CreateTaskRequest) (*v1.CreateTaskResponse, error)
@@ -169,6 +395,14 @@ type TaskServiceHandler interface {
 	ListActiveTasks(context.Context, *v1.ListActiveTasksRequest) (*v1.ListActiveTasksResponse, error)
 	UpdateTask(context.Context, *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error)
 	DeleteTask(context.Context, *v1.DeleteTaskRequest) (*v1.DeleteTaskResponse, error)
+	SnoozeTask(context.Context, *v1.SnoozeTaskRequest) (*v1.SnoozeTaskResponse, error)
+	MoveTask(context.Context, *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error)
+	SearchTasks(context.Context, *v1.SearchTasksRequest) (*v1.SearchTasksResponse, error)
+	BatchUpdateTasks(context.Context, *v1.BatchUpdateTasksRequest) (*v1.BatchUpdateTasksResponse, error)
+	BatchDeleteTasks(context.Context, *v1.BatchDeleteTasksRequest) (*v1.BatchDeleteTasksResponse, error)
+	WatchTasks(context.Context, *v1.WatchTasksRequest, *connect.ServerStream[v1.TaskEvent]) error
+	SyncTasks(context.Context, *v1.SyncTasksRequest) (*v1.SyncTasksResponse, error)
+	ImportTasks(context.Context, *v1.ImportTasksRequest) (*v1.ImportTasksResponse, error)
 }
 
 // NewTaskServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -208,6 +442,54 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("DeleteTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceSnoozeTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceSnoozeTaskProcedure,
+		svc.SnoozeTask,
+		connect.WithSchema(taskServiceMethods.ByName("SnoozeTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceMoveTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceMoveTaskProcedure,
+		svc.MoveTask,
+		connect.WithSchema(taskServiceMethods.ByName("MoveTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceSearchTasksHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceSearchTasksProcedure,
+		svc.SearchTasks,
+		connect.WithSchema(taskServiceMethods.ByName("SearchTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceBatchUpdateTasksHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceBatchUpdateTasksProcedure,
+		svc.BatchUpdateTasks,
+		connect.WithSchema(taskServiceMethods.ByName("BatchUpdateTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceBatchDeleteTasksHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceBatchDeleteTasksProcedure,
+		svc.BatchDeleteTasks,
+		connect.WithSchema(taskServiceMethods.ByName("BatchDeleteTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceWatchTasksHandler := connect.NewServerStreamHandlerSimple(
+		TaskServiceWatchTasksProcedure,
+		svc.WatchTasks,
+		connect.WithSchema(taskServiceMethods.ByName("WatchTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceSyncTasksHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceSyncTasksProcedure,
+		svc.SyncTasks,
+		connect.WithSchema(taskServiceMethods.ByName("SyncTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceImportTasksHandler := connect.NewUnaryHandlerSimple(
+		TaskServiceImportTasksProcedure,
+		svc.ImportTasks,
+		connect.WithSchema(taskServiceMethods.ByName("ImportTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/task.v1.TaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TaskServiceCreateTaskProcedure:
@@ -220,6 +502,22 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 			taskServiceUpdateTaskHandler.ServeHTTP(w, r)
 		case TaskServiceDeleteTaskProcedure:
 			taskServiceDeleteTaskHandler.ServeHTTP(w, r)
+		case TaskServiceSnoozeTaskProcedure:
+			taskServiceSnoozeTaskHandler.ServeHTTP(w, r)
+		case TaskServiceMoveTaskProcedure:
+			taskServiceMoveTaskHandler.ServeHTTP(w, r)
+		case TaskServiceSearchTasksProcedure:
+			taskServiceSearchTasksHandler.ServeHTTP(w, r)
+		case TaskServiceBatchUpdateTasksProcedure:
+			taskServiceBatchUpdateTasksHandler.ServeHTTP(w, r)
+		case TaskServiceBatchDeleteTasksProcedure:
+			taskServiceBatchDeleteTasksHandler.ServeHTTP(w, r)
+		case TaskServiceWatchTasksProcedure:
+			taskServiceWatchTasksHandler.ServeHTTP(w, r)
+		case TaskServiceSyncTasksProcedure:
+			taskServiceSyncTasksHandler.ServeHTTP(w, r)
+		case TaskServiceImportTasksProcedure:
+			taskServiceImportTasksHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -249,107 +547,1082 @@ func (UnimplementedTaskServiceHandler) DeleteTask(context.Context, *v1.DeleteTas
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.DeleteTask is not implemented"))
 }
 
-// UserPeriodSettingsServiceClient is a client for the task.v1.UserPeriodSettingsService service.
-type UserPeriodSettingsServiceClient interface {
-	GetUserPeriodSettings(context.Context, *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error)
-	UpdateUserPeriodSettings(context.Context, *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error)
+func (UnimplementedTaskServiceHandler) SnoozeTask(context.Context, *v1.SnoozeTaskRequest) (*v1.SnoozeTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.SnoozeTask is not implemented"))
 }
 
-// NewUserPeriodSettingsServiceClient constructs a client for the task.v1.UserPeriodSettingsService
-// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
-// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
-// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+func (UnimplementedTaskServiceHandler) MoveTask(context.Context, *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.MoveTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) SearchTasks(context.Context, *v1.SearchTasksRequest) (*v1.SearchTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.SearchTasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) BatchUpdateTasks(context.Context, *v1.BatchUpdateTasksRequest) (*v1.BatchUpdateTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.BatchUpdateTasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) BatchDeleteTasks(context.Context, *v1.BatchDeleteTasksRequest) (*v1.BatchDeleteTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.BatchDeleteTasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) WatchTasks(context.Context, *v1.WatchTasksRequest, *connect.ServerStream[v1.TaskEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.WatchTasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) SyncTasks(context.Context, *v1.SyncTasksRequest) (*v1.SyncTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.SyncTasks is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ImportTasks(context.Context, *v1.ImportTasksRequest) (*v1.ImportTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskService.ImportTasks is not implemented"))
+}
+
+// CompletedTaskServiceClient is a client for the task.v1.CompletedTaskService service.
+type CompletedTaskServiceClient interface {
+	ListCompletedTasks(context.Context, *v1.ListCompletedTasksRequest) (*v1.ListCompletedTasksResponse, error)
+	GetCompletedTask(context.Context, *v1.GetCompletedTaskRequest) (*v1.GetCompletedTaskResponse, error)
+	ReopenTask(context.Context, *v1.ReopenTaskRequest) (*v1.ReopenTaskResponse, error)
+	GetTaskStats(context.Context, *v1.GetTaskStatsRequest) (*v1.GetTaskStatsResponse, error)
+}
+
+// NewCompletedTaskServiceClient constructs a client for the task.v1.CompletedTaskService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewUserPeriodSettingsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UserPeriodSettingsServiceClient {
+func NewCompletedTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CompletedTaskServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
-	userPeriodSettingsServiceMethods := v1.File_task_v1_task_proto.Services().ByName("UserPeriodSettingsService").Methods()
-	return &userPeriodSettingsServiceClient{
-		getUserPeriodSettings: connect.NewClient[v1.GetUserPeriodSettingsRequest, v1.GetUserPeriodSettingsResponse](
+	completedTaskServiceMethods := v1.File_task_v1_task_proto.Services().ByName("CompletedTaskService").Methods()
+	return &completedTaskServiceClient{
+		listCompletedTasks: connect.NewClient[v1.ListCompletedTasksRequest, v1.ListCompletedTasksResponse](
 			httpClient,
-			baseURL+UserPeriodSettingsServiceGetUserPeriodSettingsProcedure,
-			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserPeriodSettings")),
+			baseURL+CompletedTaskServiceListCompletedTasksProcedure,
+			connect.WithSchema(completedTaskServiceMethods.ByName("ListCompletedTasks")),
 			connect.WithClientOptions(opts...),
 		),
-		updateUserPeriodSettings: connect.NewClient[v1.UpdateUserPeriodSettingsRequest, v1.UpdateUserPeriodSettingsResponse](
+		getCompletedTask: connect.NewClient[v1.GetCompletedTaskRequest, v1.GetCompletedTaskResponse](
 			httpClient,
-			baseURL+UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure,
-			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserPeriodSettings")),
+			baseURL+CompletedTaskServiceGetCompletedTaskProcedure,
+			connect.WithSchema(completedTaskServiceMethods.ByName("GetCompletedTask")),
+			connect.WithClientOptions(opts...),
+		),
+		reopenTask: connect.NewClient[v1.ReopenTaskRequest, v1.ReopenTaskResponse](
+			httpClient,
+			baseURL+CompletedTaskServiceReopenTaskProcedure,
+			connect.WithSchema(completedTaskServiceMethods.ByName("ReopenTask")),
+			connect.WithClientOptions(opts...),
+		),
+		getTaskStats: connect.NewClient[v1.GetTaskStatsRequest, v1.GetTaskStatsResponse](
+			httpClient,
+			baseURL+CompletedTaskServiceGetTaskStatsProcedure,
+			connect.WithSchema(completedTaskServiceMethods.ByName("GetTaskStats")),
 			connect.WithClientOptions(opts...),
 		),
 	}
 }
 
-// userPeriodSettingsServiceClient implements UserPeriodSettingsServiceClient.
-type userPeriodSettingsServiceClient struct {
-	getUserPeriodSettings    *connect.Client[v1.GetUserPeriodSettingsRequest, v1.GetUserPeriodSettingsResponse]
-	updateUserPeriodSettings *connect.Client[v1.UpdateUserPeriodSettingsRequest, v1.UpdateUserPeriodSettingsResponse]
+// completedTaskServiceClient implements CompletedTaskServiceClient.
+type completedTaskServiceClient struct {
+	listCompletedTasks *connect.Client[v1.ListCompletedTasksRequest, v1.ListCompletedTasksResponse]
+	getCompletedTask   *connect.Client[v1.GetCompletedTaskRequest, v1.GetCompletedTaskResponse]
+	reopenTask         *connect.Client[v1.ReopenTaskRequest, v1.ReopenTaskResponse]
+	getTaskStats       *connect.Client[v1.GetTaskStatsRequest, v1.GetTaskStatsResponse]
 }
 
-// GetUserPeriodSettings calls task.v1.UserPeriodSettingsService.GetUserPeriodSettings.
-func (c *userPeriodSettingsServiceClient) GetUserPeriodSettings(ctx context.Context, req *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error) {
-	response, err := c.getUserPeriodSettings.CallUnary(ctx, connect.NewRequest(req))
+// ListCompletedTasks calls task.v1.CompletedTaskService.ListCompletedTasks.
+func (c *completedTaskServiceClient) ListCompletedTasks(ctx context.Context, req *v1.ListCompletedTasksRequest) (*v1.ListCompletedTasksResponse, error) {
+	response, err := c.listCompletedTasks.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// UpdateUserPeriodSettings calls task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings.
-func (c *userPeriodSettingsServiceClient) UpdateUserPeriodSettings(ctx context.Context, req *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error) {
-	response, err := c.updateUserPeriodSettings.CallUnary(ctx, connect.NewRequest(req))
+// GetCompletedTask calls task.v1.CompletedTaskService.GetCompletedTask.
+func (c *completedTaskServiceClient) GetCompletedTask(ctx context.Context, req *v1.GetCompletedTaskRequest) (*v1.GetCompletedTaskResponse, error) {
+	response, err := c.getCompletedTask.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// UserPeriodSettingsServiceHandler is an implementation of the task.v1.UserPeriodSettingsService
-// service.
-type UserPeriodSettingsServiceHandler interface {
-	GetUserPeriodSettings(context.Context, *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error)
-	UpdateUserPeriodSettings(context.Context, *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error)
+// ReopenTask calls task.v1.CompletedTaskService.ReopenTask.
+func (c *completedTaskServiceClient) ReopenTask(ctx context.Context, req *v1.ReopenTaskRequest) (*v1.ReopenTaskResponse, error) {
+	response, err := c.reopenTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
 }
 
-// NewUserPeriodSettingsServiceHandler builds an HTTP handler from the service implementation. It
-// returns the path on which to mount the handler and the handler itself.
+// GetTaskStats calls task.v1.CompletedTaskService.GetTaskStats.
+func (c *completedTaskServiceClient) GetTaskStats(ctx context.Context, req *v1.GetTaskStatsRequest) (*v1.GetTaskStatsResponse, error) {
+	response, err := c.getTaskStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CompletedTaskServiceHandler is an implementation of the task.v1.CompletedTaskService service.
+type CompletedTaskServiceHandler interface {
+	ListCompletedTasks(context.Context, *v1.ListCompletedTasksRequest) (*v1.ListCompletedTasksResponse, error)
+	GetCompletedTask(context.Context, *v1.GetCompletedTaskRequest) (*v1.GetCompletedTaskResponse, error)
+	ReopenTask(context.Context, *v1.ReopenTaskRequest) (*v1.ReopenTaskResponse, error)
+	GetTaskStats(context.Context, *v1.GetTaskStatsRequest) (*v1.GetTaskStatsResponse, error)
+}
+
+// NewCompletedTaskServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewUserPeriodSettingsServiceHandler(svc UserPeriodSettingsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	userPeriodSettingsServiceMethods := v1.File_task_v1_task_proto.Services().ByName("UserPeriodSettingsService").Methods()
-	userPeriodSettingsServiceGetUserPeriodSettingsHandler := connect.NewUnaryHandlerSimple(
-		UserPeriodSettingsServiceGetUserPeriodSettingsProcedure,
-		svc.GetUserPeriodSettings,
-		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserPeriodSettings")),
+func NewCompletedTaskServiceHandler(svc CompletedTaskServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	completedTaskServiceMethods := v1.File_task_v1_task_proto.Services().ByName("CompletedTaskService").Methods()
+	completedTaskServiceListCompletedTasksHandler := connect.NewUnaryHandlerSimple(
+		CompletedTaskServiceListCompletedTasksProcedure,
+		svc.ListCompletedTasks,
+		connect.WithSchema(completedTaskServiceMethods.ByName("ListCompletedTasks")),
 		connect.WithHandlerOptions(opts...),
 	)
-	userPeriodSettingsServiceUpdateUserPeriodSettingsHandler := connect.NewUnaryHandlerSimple(
-		UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure,
-		svc.UpdateUserPeriodSettings,
-		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserPeriodSettings")),
+	completedTaskServiceGetCompletedTaskHandler := connect.NewUnaryHandlerSimple(
+		CompletedTaskServiceGetCompletedTaskProcedure,
+		svc.GetCompletedTask,
+		connect.WithSchema(completedTaskServiceMethods.ByName("GetCompletedTask")),
 		connect.WithHandlerOptions(opts...),
 	)
-	return "/task.v1.UserPeriodSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	completedTaskServiceReopenTaskHandler := connect.NewUnaryHandlerSimple(
+		CompletedTaskServiceReopenTaskProcedure,
+		svc.ReopenTask,
+		connect.WithSchema(completedTaskServiceMethods.ByName("ReopenTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	completedTaskServiceGetTaskStatsHandler := connect.NewUnaryHandlerSimple(
+		CompletedTaskServiceGetTaskStatsProcedure,
+		svc.GetTaskStats,
+		connect.WithSchema(completedTaskServiceMethods.ByName("GetTaskStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/task.v1.CompletedTaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
-		case UserPeriodSettingsServiceGetUserPeriodSettingsProcedure:
-			userPeriodSettingsServiceGetUserPeriodSettingsHandler.ServeHTTP(w, r)
-		case UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure:
-			userPeriodSettingsServiceUpdateUserPeriodSettingsHandler.ServeHTTP(w, r)
+		case CompletedTaskServiceListCompletedTasksProcedure:
+			completedTaskServiceListCompletedTasksHandler.ServeHTTP(w, r)
+		case CompletedTaskServiceGetCompletedTaskProcedure:
+			completedTaskServiceGetCompletedTaskHandler.ServeHTTP(w, r)
+		case CompletedTaskServiceReopenTaskProcedure:
+			completedTaskServiceReopenTaskHandler.ServeHTTP(w, r)
+		case CompletedTaskServiceGetTaskStatsProcedure:
+			completedTaskServiceGetTaskStatsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
-// UnimplementedUserPeriodSettingsServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedUserPeriodSettingsServiceHandler struct{}
+// UnimplementedCompletedTaskServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCompletedTaskServiceHandler struct{}
 
-func (UnimplementedUserPeriodSettingsServiceHandler) GetUserPeriodSettings(context.Context, *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.GetUserPeriodSettings is not implemented"))
+func (UnimplementedCompletedTaskServiceHandler) ListCompletedTasks(context.Context, *v1.ListCompletedTasksRequest) (*v1.ListCompletedTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CompletedTaskService.ListCompletedTasks is not implemented"))
 }
 
-func (UnimplementedUserPeriodSettingsServiceHandler) UpdateUserPeriodSettings(context.Context, *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings is not implemented"))
+func (UnimplementedCompletedTaskServiceHandler) GetCompletedTask(context.Context, *v1.GetCompletedTaskRequest) (*v1.GetCompletedTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CompletedTaskService.GetCompletedTask is not implemented"))
+}
+
+func (UnimplementedCompletedTaskServiceHandler) ReopenTask(context.Context, *v1.ReopenTaskRequest) (*v1.ReopenTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CompletedTaskService.ReopenTask is not implemented"))
+}
+
+func (UnimplementedCompletedTaskServiceHandler) GetTaskStats(context.Context, *v1.GetTaskStatsRequest) (*v1.GetTaskStatsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CompletedTaskService.GetTaskStats is not implemented"))
+}
+
+// TaskTrashServiceClient is a client for the task.v1.TaskTrashService service.
+type TaskTrashServiceClient interface {
+	ListDeletedTasks(context.Context, *v1.ListDeletedTasksRequest) (*v1.ListDeletedTasksResponse, error)
+	RestoreTask(context.Context, *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error)
+}
+
+// NewTaskTrashServiceClient constructs a client for the task.v1.TaskTrashService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTaskTrashServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TaskTrashServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	taskTrashServiceMethods := v1.File_task_v1_task_proto.Services().ByName("TaskTrashService").Methods()
+	return &taskTrashServiceClient{
+		listDeletedTasks: connect.NewClient[v1.ListDeletedTasksRequest, v1.ListDeletedTasksResponse](
+			httpClient,
+			baseURL+TaskTrashServiceListDeletedTasksProcedure,
+			connect.WithSchema(taskTrashServiceMethods.ByName("ListDeletedTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreTask: connect.NewClient[v1.RestoreTaskRequest, v1.RestoreTaskResponse](
+			httpClient,
+			baseURL+TaskTrashServiceRestoreTaskProcedure,
+			connect.WithSchema(taskTrashServiceMethods.ByName("RestoreTask")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// taskTrashServiceClient implements TaskTrashServiceClient.
+type taskTrashServiceClient struct {
+	listDeletedTasks *connect.Client[v1.ListDeletedTasksRequest, v1.ListDeletedTasksResponse]
+	restoreTask      *connect.Client[v1.RestoreTaskRequest, v1.RestoreTaskResponse]
+}
+
+// ListDeletedTasks calls task.v1.TaskTrashService.ListDeletedTasks.
+func (c *taskTrashServiceClient) ListDeletedTasks(ctx context.Context, req *v1.ListDeletedTasksRequest) (*v1.ListDeletedTasksResponse, error) {
+	response, err := c.listDeletedTasks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreTask calls task.v1.TaskTrashService.RestoreTask.
+func (c *taskTrashServiceClient) RestoreTask(ctx context.Context, req *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error) {
+	response, err := c.restoreTask.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TaskTrashServiceHandler is an implementation of the task.v1.TaskTrashService service.
+type TaskTrashServiceHandler interface {
+	ListDeletedTasks(context.Context, *v1.ListDeletedTasksRequest) (*v1.ListDeletedTasksResponse, error)
+	RestoreTask(context.Context, *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error)
+}
+
+// NewTaskTrashServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTaskTrashServiceHandler(svc TaskTrashServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	taskTrashServiceMethods := v1.File_task_v1_task_proto.Services().ByName("TaskTrashService").Methods()
+	taskTrashServiceListDeletedTasksHandler := connect.NewUnaryHandlerSimple(
+		TaskTrashServiceListDeletedTasksProcedure,
+		svc.ListDeletedTasks,
+		connect.WithSchema(taskTrashServiceMethods.ByName("ListDeletedTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskTrashServiceRestoreTaskHandler := connect.NewUnaryHandlerSimple(
+		TaskTrashServiceRestoreTaskProcedure,
+		svc.RestoreTask,
+		connect.WithSchema(taskTrashServiceMethods.ByName("RestoreTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/task.v1.TaskTrashService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TaskTrashServiceListDeletedTasksProcedure:
+			taskTrashServiceListDeletedTasksHandler.ServeHTTP(w, r)
+		case TaskTrashServiceRestoreTaskProcedure:
+			taskTrashServiceRestoreTaskHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTaskTrashServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTaskTrashServiceHandler struct{}
+
+func (UnimplementedTaskTrashServiceHandler) ListDeletedTasks(context.Context, *v1.ListDeletedTasksRequest) (*v1.ListDeletedTasksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskTrashService.ListDeletedTasks is not implemented"))
+}
+
+func (UnimplementedTaskTrashServiceHandler) RestoreTask(context.Context, *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskTrashService.RestoreTask is not implemented"))
+}
+
+// TaskChecklistServiceClient is a client for the task.v1.TaskChecklistService service.
+type TaskChecklistServiceClient interface {
+	ListChecklistItems(context.Context, *v1.ListChecklistItemsRequest) (*v1.ListChecklistItemsResponse, error)
+	AddChecklistItem(context.Context, *v1.AddChecklistItemRequest) (*v1.AddChecklistItemResponse, error)
+	ToggleChecklistItem(context.Context, *v1.ToggleChecklistItemRequest) (*v1.ToggleChecklistItemResponse, error)
+	ReorderChecklistItems(context.Context, *v1.ReorderChecklistItemsRequest) (*v1.ReorderChecklistItemsResponse, error)
+	DeleteChecklistItem(context.Context, *v1.DeleteChecklistItemRequest) (*v1.DeleteChecklistItemResponse, error)
+}
+
+// NewTaskChecklistServiceClient constructs a client for the task.v1.TaskChecklistService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTaskChecklistServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TaskChecklistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	taskChecklistServiceMethods := v1.File_task_v1_task_proto.Services().ByName("TaskChecklistService").Methods()
+	return &taskChecklistServiceClient{
+		listChecklistItems: connect.NewClient[v1.ListChecklistItemsRequest, v1.ListChecklistItemsResponse](
+			httpClient,
+			baseURL+TaskChecklistServiceListChecklistItemsProcedure,
+			connect.WithSchema(taskChecklistServiceMethods.ByName("ListChecklistItems")),
+			connect.WithClientOptions(opts...),
+		),
+		addChecklistItem: connect.NewClient[v1.AddChecklistItemRequest, v1.AddChecklistItemResponse](
+			httpClient,
+			baseURL+TaskChecklistServiceAddChecklistItemProcedure,
+			connect.WithSchema(taskChecklistServiceMethods.ByName("AddChecklistItem")),
+			connect.WithClientOptions(opts...),
+		),
+		toggleChecklistItem: connect.NewClient[v1.ToggleChecklistItemRequest, v1.ToggleChecklistItemResponse](
+			httpClient,
+			baseURL+TaskChecklistServiceToggleChecklistItemProcedure,
+			connect.WithSchema(taskChecklistServiceMethods.ByName("ToggleChecklistItem")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderChecklistItems: connect.NewClient[v1.ReorderChecklistItemsRequest, v1.ReorderChecklistItemsResponse](
+			httpClient,
+			baseURL+TaskChecklistServiceReorderChecklistItemsProcedure,
+			connect.WithSchema(taskChecklistServiceMethods.ByName("ReorderChecklistItems")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteChecklistItem: connect.NewClient[v1.DeleteChecklistItemRequest, v1.DeleteChecklistItemResponse](
+			httpClient,
+			baseURL+TaskChecklistServiceDeleteChecklistItemProcedure,
+			connect.WithSchema(taskChecklistServiceMethods.ByName("DeleteChecklistItem")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// taskChecklistServiceClient implements TaskChecklistServiceClient.
+type taskChecklistServiceClient struct {
+	listChecklistItems    *connect.Client[v1.ListChecklistItemsRequest, v1.ListChecklistItemsResponse]
+	addChecklistItem      *connect.Client[v1.AddChecklistItemRequest, v1.AddChecklistItemResponse]
+	toggleChecklistItem   *connect.Client[v1.ToggleChecklistItemRequest, v1.ToggleChecklistItemResponse]
+	reorderChecklistItems *connect.Client[v1.ReorderChecklistItemsRequest, v1.ReorderChecklistItemsResponse]
+	deleteChecklistItem   *connect.Client[v1.DeleteChecklistItemRequest, v1.DeleteChecklistItemResponse]
+}
+
+// ListChecklistItems calls task.v1.TaskChecklistService.ListChecklistItems.
+func (c *taskChecklistServiceClient) ListChecklistItems(ctx context.Context, req *v1.ListChecklistItemsRequest) (*v1.ListChecklistItemsResponse, error) {
+	response, err := c.listChecklistItems.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddChecklistItem calls task.v1.TaskChecklistService.AddChecklistItem.
+func (c *taskChecklistServiceClient) AddChecklistItem(ctx context.Context, req *v1.AddChecklistItemRequest) (*v1.AddChecklistItemResponse, error) {
+	response, err := c.addChecklistItem.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ToggleChecklistItem calls task.v1.TaskChecklistService.ToggleChecklistItem.
+func (c *taskChecklistServiceClient) ToggleChecklistItem(ctx context.Context, req *v1.ToggleChecklistItemRequest) (*v1.ToggleChecklistItemResponse, error) {
+	response, err := c.toggleChecklistItem.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReorderChecklistItems calls task.v1.TaskChecklistService.ReorderChecklistItems.
+func (c *taskChecklistServiceClient) ReorderChecklistItems(ctx context.Context, req *v1.ReorderChecklistItemsRequest) (*v1.ReorderChecklistItemsResponse, error) {
+	response, err := c.reorderChecklistItems.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteChecklistItem calls task.v1.TaskChecklistService.DeleteChecklistItem.
+func (c *taskChecklistServiceClient) DeleteChecklistItem(ctx context.Context, req *v1.DeleteChecklistItemRequest) (*v1.DeleteChecklistItemResponse, error) {
+	response, err := c.deleteChecklistItem.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TaskChecklistServiceHandler is an implementation of the task.v1.TaskChecklistService service.
+type TaskChecklistServiceHandler interface {
+	ListChecklistItems(context.Context, *v1.ListChecklistItemsRequest) (*v1.ListChecklistItemsResponse, error)
+	AddChecklistItem(context.Context, *v1.AddChecklistItemRequest) (*v1.AddChecklistItemResponse, error)
+	ToggleChecklistItem(context.Context, *v1.ToggleChecklistItemRequest) (*v1.ToggleChecklistItemResponse, error)
+	ReorderChecklistItems(context.Context, *v1.ReorderChecklistItemsRequest) (*v1.ReorderChecklistItemsResponse, error)
+	DeleteChecklistItem(context.Context, *v1.DeleteChecklistItemRequest) (*v1.DeleteChecklistItemResponse, error)
+}
+
+// NewTaskChecklistServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTaskChecklistServiceHandler(svc TaskChecklistServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	taskChecklistServiceMethods := v1.File_task_v1_task_proto.Services().ByName("TaskChecklistService").Methods()
+	taskChecklistServiceListChecklistItemsHandler := connect.NewUnaryHandlerSimple(
+		TaskChecklistServiceListChecklistItemsProcedure,
+		svc.ListChecklistItems,
+		connect.WithSchema(taskChecklistServiceMethods.ByName("ListChecklistItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskChecklistServiceAddChecklistItemHandler := connect.NewUnaryHandlerSimple(
+		TaskChecklistServiceAddChecklistItemProcedure,
+		svc.AddChecklistItem,
+		connect.WithSchema(taskChecklistServiceMethods.ByName("AddChecklistItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskChecklistServiceToggleChecklistItemHandler := connect.NewUnaryHandlerSimple(
+		TaskChecklistServiceToggleChecklistItemProcedure,
+		svc.ToggleChecklistItem,
+		connect.WithSchema(taskChecklistServiceMethods.ByName("ToggleChecklistItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskChecklistServiceReorderChecklistItemsHandler := connect.NewUnaryHandlerSimple(
+		TaskChecklistServiceReorderChecklistItemsProcedure,
+		svc.ReorderChecklistItems,
+		connect.WithSchema(taskChecklistServiceMethods.ByName("ReorderChecklistItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskChecklistServiceDeleteChecklistItemHandler := connect.NewUnaryHandlerSimple(
+		TaskChecklistServiceDeleteChecklistItemProcedure,
+		svc.DeleteChecklistItem,
+		connect.WithSchema(taskChecklistServiceMethods.ByName("DeleteChecklistItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/task.v1.TaskChecklistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TaskChecklistServiceListChecklistItemsProcedure:
+			taskChecklistServiceListChecklistItemsHandler.ServeHTTP(w, r)
+		case TaskChecklistServiceAddChecklistItemProcedure:
+			taskChecklistServiceAddChecklistItemHandler.ServeHTTP(w, r)
+		case TaskChecklistServiceToggleChecklistItemProcedure:
+			taskChecklistServiceToggleChecklistItemHandler.ServeHTTP(w, r)
+		case TaskChecklistServiceReorderChecklistItemsProcedure:
+			taskChecklistServiceReorderChecklistItemsHandler.ServeHTTP(w, r)
+		case TaskChecklistServiceDeleteChecklistItemProcedure:
+			taskChecklistServiceDeleteChecklistItemHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTaskChecklistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTaskChecklistServiceHandler struct{}
+
+func (UnimplementedTaskChecklistServiceHandler) ListChecklistItems(context.Context, *v1.ListChecklistItemsRequest) (*v1.ListChecklistItemsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskChecklistService.ListChecklistItems is not implemented"))
+}
+
+func (UnimplementedTaskChecklistServiceHandler) AddChecklistItem(context.Context, *v1.AddChecklistItemRequest) (*v1.AddChecklistItemResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskChecklistService.AddChecklistItem is not implemented"))
+}
+
+func (UnimplementedTaskChecklistServiceHandler) ToggleChecklistItem(context.Context, *v1.ToggleChecklistItemRequest) (*v1.ToggleChecklistItemResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskChecklistService.ToggleChecklistItem is not implemented"))
+}
+
+func (UnimplementedTaskChecklistServiceHandler) ReorderChecklistItems(context.Context, *v1.ReorderChecklistItemsRequest) (*v1.ReorderChecklistItemsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskChecklistService.ReorderChecklistItems is not implemented"))
+}
+
+func (UnimplementedTaskChecklistServiceHandler) DeleteChecklistItem(context.Context, *v1.DeleteChecklistItemRequest) (*v1.DeleteChecklistItemResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TaskChecklistService.DeleteChecklistItem is not implemented"))
+}
+
+// TagServiceClient is a client for the task.v1.TagService service.
+type TagServiceClient interface {
+	CreateTag(context.Context, *v1.CreateTagRequest) (*v1.CreateTagResponse, error)
+	ListTags(context.Context, *v1.ListTagsRequest) (*v1.ListTagsResponse, error)
+	UpdateTag(context.Context, *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error)
+	DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error)
+	AttachTag(context.Context, *v1.AttachTagRequest) (*v1.AttachTagResponse, error)
+	DetachTag(context.Context, *v1.DetachTagRequest) (*v1.DetachTagResponse, error)
+}
+
+// NewTagServiceClient constructs a client for the task.v1.TagService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TagServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tagServiceMethods := v1.File_task_v1_task_proto.Services().ByName("TagService").Methods()
+	return &tagServiceClient{
+		createTag: connect.NewClient[v1.CreateTagRequest, v1.CreateTagResponse](
+			httpClient,
+			baseURL+TagServiceCreateTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("CreateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+TagServiceListTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTag: connect.NewClient[v1.UpdateTagRequest, v1.UpdateTagResponse](
+			httpClient,
+			baseURL+TagServiceUpdateTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("UpdateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+TagServiceDeleteTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+		attachTag: connect.NewClient[v1.AttachTagRequest, v1.AttachTagResponse](
+			httpClient,
+			baseURL+TagServiceAttachTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("AttachTag")),
+			connect.WithClientOptions(opts...),
+		),
+		detachTag: connect.NewClient[v1.DetachTagRequest, v1.DetachTagResponse](
+			httpClient,
+			baseURL+TagServiceDetachTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("DetachTag")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tagServiceClient implements TagServiceClient.
+type tagServiceClient struct {
+	createTag *connect.Client[v1.CreateTagRequest, v1.CreateTagResponse]
+	listTags  *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	updateTag *connect.Client[v1.UpdateTagRequest, v1.UpdateTagResponse]
+	deleteTag *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+	attachTag *connect.Client[v1.AttachTagRequest, v1.AttachTagResponse]
+	detachTag *connect.Client[v1.DetachTagRequest, v1.DetachTagResponse]
+}
+
+// CreateTag calls task.v1.TagService.CreateTag.
+func (c *tagServiceClient) CreateTag(ctx context.Context, req *v1.CreateTagRequest) (*v1.CreateTagResponse, error) {
+	response, err := c.createTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTags calls task.v1.TagService.ListTags.
+func (c *tagServiceClient) ListTags(ctx context.Context, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	response, err := c.listTags.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateTag calls task.v1.TagService.UpdateTag.
+func (c *tagServiceClient) UpdateTag(ctx context.Context, req *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error) {
+	response, err := c.updateTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTag calls task.v1.TagService.DeleteTag.
+func (c *tagServiceClient) DeleteTag(ctx context.Context, req *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	response, err := c.deleteTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AttachTag calls task.v1.TagService.AttachTag.
+func (c *tagServiceClient) AttachTag(ctx context.Context, req *v1.AttachTagRequest) (*v1.AttachTagResponse, error) {
+	response, err := c.attachTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DetachTag calls task.v1.TagService.DetachTag.
+func (c *tagServiceClient) DetachTag(ctx context.Context, req *v1.DetachTagRequest) (*v1.DetachTagResponse, error) {
+	response, err := c.detachTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TagServiceHandler is an implementation of the task.v1.TagService service.
+type TagServiceHandler interface {
+	CreateTag(context.Context, *v1.CreateTagRequest) (*v1.CreateTagResponse, error)
+	ListTags(context.Context, *v1.ListTagsRequest) (*v1.ListTagsResponse, error)
+	UpdateTag(context.Context, *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error)
+	DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error)
+	AttachTag(context.Context, *v1.AttachTagRequest) (*v1.AttachTagResponse, error)
+	DetachTag(context.Context, *v1.DetachTagRequest) (*v1.DetachTagResponse, error)
+}
+
+// NewTagServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTagServiceHandler(svc TagServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tagServiceMethods := v1.File_task_v1_task_proto.Services().ByName("TagService").Methods()
+	tagServiceCreateTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceCreateTagProcedure,
+		svc.CreateTag,
+		connect.WithSchema(tagServiceMethods.ByName("CreateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceListTagsHandler := connect.NewUnaryHandlerSimple(
+		TagServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceUpdateTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceUpdateTagProcedure,
+		svc.UpdateTag,
+		connect.WithSchema(tagServiceMethods.ByName("UpdateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceDeleteTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceAttachTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceAttachTagProcedure,
+		svc.AttachTag,
+		connect.WithSchema(tagServiceMethods.ByName("AttachTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceDetachTagHandler := connect.NewUnaryHandlerSimple(
+		TagServiceDetachTagProcedure,
+		svc.DetachTag,
+		connect.WithSchema(tagServiceMethods.ByName("DetachTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/task.v1.TagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TagServiceCreateTagProcedure:
+			tagServiceCreateTagHandler.ServeHTTP(w, r)
+		case TagServiceListTagsProcedure:
+			tagServiceListTagsHandler.ServeHTTP(w, r)
+		case TagServiceUpdateTagProcedure:
+			tagServiceUpdateTagHandler.ServeHTTP(w, r)
+		case TagServiceDeleteTagProcedure:
+			tagServiceDeleteTagHandler.ServeHTTP(w, r)
+		case TagServiceAttachTagProcedure:
+			tagServiceAttachTagHandler.ServeHTTP(w, r)
+		case TagServiceDetachTagProcedure:
+			tagServiceDetachTagHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTagServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTagServiceHandler struct{}
+
+func (UnimplementedTagServiceHandler) CreateTag(context.Context, *v1.CreateTagRequest) (*v1.CreateTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TagService.CreateTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) ListTags(context.Context, *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TagService.ListTags is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) UpdateTag(context.Context, *v1.UpdateTagRequest) (*v1.UpdateTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TagService.UpdateTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TagService.DeleteTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) AttachTag(context.Context, *v1.AttachTagRequest) (*v1.AttachTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TagService.AttachTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) DetachTag(context.Context, *v1.DetachTagRequest) (*v1.DetachTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.TagService.DetachTag is not implemented"))
+}
+
+// UserPeriodSettingsServiceClient is a client for the task.v1.UserPeriodSettingsService service.
+type UserPeriodSettingsServiceClient interface {
+	GetUserPeriodSettings(context.Context, *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error)
+	UpdateUserPeriodSettings(context.Context, *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error)
+	GetUserReminderIntervalSettings(context.Context, *v1.GetUserReminderIntervalSettingsRequest) (*v1.GetUserReminderIntervalSettingsResponse, error)
+	UpdateUserReminderIntervalSettings(context.Context, *v1.UpdateUserReminderIntervalSettingsRequest) (*v1.UpdateUserReminderIntervalSettingsResponse, error)
+	GetUserQuietHoursSettings(context.Context, *v1.GetUserQuietHoursSettingsRequest) (*v1.GetUserQuietHoursSettingsResponse, error)
+	UpdateUserQuietHoursSettings(context.Context, *v1.UpdateUserQuietHoursSettingsRequest) (*v1.UpdateUserQuietHoursSettingsResponse, error)
+}
+
+// NewUserPeriodSettingsServiceClient constructs a client for the task.v1.UserPeriodSettingsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewUserPeriodSettingsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UserPeriodSettingsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	userPeriodSettingsServiceMethods := v1.File_task_v1_task_proto.Services().ByName("UserPeriodSettingsService").Methods()
+	return &userPeriodSettingsServiceClient{
+		getUserPeriodSettings: connect.NewClient[v1.GetUserPeriodSettingsRequest, v1.GetUserPeriodSettingsResponse](
+			httpClient,
+			baseURL+UserPeriodSettingsServiceGetUserPeriodSettingsProcedure,
+			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserPeriodSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserPeriodSettings: connect.NewClient[v1.UpdateUserPeriodSettingsRequest, v1.UpdateUserPeriodSettingsResponse](
+			httpClient,
+			baseURL+UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure,
+			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserPeriodSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserReminderIntervalSettings: connect.NewClient[v1.GetUserReminderIntervalSettingsRequest, v1.GetUserReminderIntervalSettingsResponse](
+			httpClient,
+			baseURL+UserPeriodSettingsServiceGetUserReminderIntervalSettingsProcedure,
+			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserReminderIntervalSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserReminderIntervalSettings: connect.NewClient[v1.UpdateUserReminderIntervalSettingsRequest, v1.UpdateUserReminderIntervalSettingsResponse](
+			httpClient,
+			baseURL+UserPeriodSettingsServiceUpdateUserReminderIntervalSettingsProcedure,
+			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserReminderIntervalSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserQuietHoursSettings: connect.NewClient[v1.GetUserQuietHoursSettingsRequest, v1.GetUserQuietHoursSettingsResponse](
+			httpClient,
+			baseURL+UserPeriodSettingsServiceGetUserQuietHoursSettingsProcedure,
+			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserQuietHoursSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserQuietHoursSettings: connect.NewClient[v1.UpdateUserQuietHoursSettingsRequest, v1.UpdateUserQuietHoursSettingsResponse](
+			httpClient,
+			baseURL+UserPeriodSettingsServiceUpdateUserQuietHoursSettingsProcedure,
+			connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserQuietHoursSettings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// userPeriodSettingsServiceClient implements UserPeriodSettingsServiceClient.
+type userPeriodSettingsServiceClient struct {
+	getUserPeriodSettings              *connect.Client[v1.GetUserPeriodSettingsRequest, v1.GetUserPeriodSettingsResponse]
+	updateUserPeriodSettings           *connect.Client[v1.UpdateUserPeriodSettingsRequest, v1.UpdateUserPeriodSettingsResponse]
+	getUserReminderIntervalSettings    *connect.Client[v1.GetUserReminderIntervalSettingsRequest, v1.GetUserReminderIntervalSettingsResponse]
+	updateUserReminderIntervalSettings *connect.Client[v1.UpdateUserReminderIntervalSettingsRequest, v1.UpdateUserReminderIntervalSettingsResponse]
+	getUserQuietHoursSettings          *connect.Client[v1.GetUserQuietHoursSettingsRequest, v1.GetUserQuietHoursSettingsResponse]
+	updateUserQuietHoursSettings       *connect.Client[v1.UpdateUserQuietHoursSettingsRequest, v1.UpdateUserQuietHoursSettingsResponse]
+}
+
+// GetUserPeriodSettings calls task.v1.UserPeriodSettingsService.GetUserPeriodSettings.
+func (c *userPeriodSettingsServiceClient) GetUserPeriodSettings(ctx context.Context, req *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error) {
+	response, err := c.getUserPeriodSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateUserPeriodSettings calls task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings.
+func (c *userPeriodSettingsServiceClient) UpdateUserPeriodSettings(ctx context.Context, req *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error) {
+	response, err := c.updateUserPeriodSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetUserReminderIntervalSettings calls
+// task.v1.UserPeriodSettingsService.GetUserReminderIntervalSettings.
+func (c *userPeriodSettingsServiceClient) GetUserReminderIntervalSettings(ctx context.Context, req *v1.GetUserReminderIntervalSettingsRequest) (*v1.GetUserReminderIntervalSettingsResponse, error) {
+	response, err := c.getUserReminderIntervalSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateUserReminderIntervalSettings calls
+// task.v1.UserPeriodSettingsService.UpdateUserReminderIntervalSettings.
+func (c *userPeriodSettingsServiceClient) UpdateUserReminderIntervalSettings(ctx context.Context, req *v1.UpdateUserReminderIntervalSettingsRequest) (*v1.UpdateUserReminderIntervalSettingsResponse, error) {
+	response, err := c.updateUserReminderIntervalSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetUserQuietHoursSettings calls task.v1.UserPeriodSettingsService.GetUserQuietHoursSettings.
+func (c *userPeriodSettingsServiceClient) GetUserQuietHoursSettings(ctx context.Context, req *v1.GetUserQuietHoursSettingsRequest) (*v1.GetUserQuietHoursSettingsResponse, error) {
+	response, err := c.getUserQuietHoursSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateUserQuietHoursSettings calls
+// task.v1.UserPeriodSettingsService.UpdateUserQuietHoursSettings.
+func (c *userPeriodSettingsServiceClient) UpdateUserQuietHoursSettings(ctx context.Context, req *v1.UpdateUserQuietHoursSettingsRequest) (*v1.UpdateUserQuietHoursSettingsResponse, error) {
+	response, err := c.updateUserQuietHoursSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UserPeriodSettingsServiceHandler is an implementation of the task.v1.UserPeriodSettingsService
+// service.
+type UserPeriodSettingsServiceHandler interface {
+	GetUserPeriodSettings(context.Context, *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error)
+	UpdateUserPeriodSettings(context.Context, *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error)
+	GetUserReminderIntervalSettings(context.Context, *v1.GetUserReminderIntervalSettingsRequest) (*v1.GetUserReminderIntervalSettingsResponse, error)
+	UpdateUserReminderIntervalSettings(context.Context, *v1.UpdateUserReminderIntervalSettingsRequest) (*v1.UpdateUserReminderIntervalSettingsResponse, error)
+	GetUserQuietHoursSettings(context.Context, *v1.GetUserQuietHoursSettingsRequest) (*v1.GetUserQuietHoursSettingsResponse, error)
+	UpdateUserQuietHoursSettings(context.Context, *v1.UpdateUserQuietHoursSettingsRequest) (*v1.UpdateUserQuietHoursSettingsResponse, error)
+}
+
+// NewUserPeriodSettingsServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewUserPeriodSettingsServiceHandler(svc UserPeriodSettingsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	userPeriodSettingsServiceMethods := v1.File_task_v1_task_proto.Services().ByName("UserPeriodSettingsService").Methods()
+	userPeriodSettingsServiceGetUserPeriodSettingsHandler := connect.NewUnaryHandlerSimple(
+		UserPeriodSettingsServiceGetUserPeriodSettingsProcedure,
+		svc.GetUserPeriodSettings,
+		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserPeriodSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userPeriodSettingsServiceUpdateUserPeriodSettingsHandler := connect.NewUnaryHandlerSimple(
+		UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure,
+		svc.UpdateUserPeriodSettings,
+		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserPeriodSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userPeriodSettingsServiceGetUserReminderIntervalSettingsHandler := connect.NewUnaryHandlerSimple(
+		UserPeriodSettingsServiceGetUserReminderIntervalSettingsProcedure,
+		svc.GetUserReminderIntervalSettings,
+		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserReminderIntervalSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userPeriodSettingsServiceUpdateUserReminderIntervalSettingsHandler := connect.NewUnaryHandlerSimple(
+		UserPeriodSettingsServiceUpdateUserReminderIntervalSettingsProcedure,
+		svc.UpdateUserReminderIntervalSettings,
+		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserReminderIntervalSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userPeriodSettingsServiceGetUserQuietHoursSettingsHandler := connect.NewUnaryHandlerSimple(
+		UserPeriodSettingsServiceGetUserQuietHoursSettingsProcedure,
+		svc.GetUserQuietHoursSettings,
+		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("GetUserQuietHoursSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userPeriodSettingsServiceUpdateUserQuietHoursSettingsHandler := connect.NewUnaryHandlerSimple(
+		UserPeriodSettingsServiceUpdateUserQuietHoursSettingsProcedure,
+		svc.UpdateUserQuietHoursSettings,
+		connect.WithSchema(userPeriodSettingsServiceMethods.ByName("UpdateUserQuietHoursSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/task.v1.UserPeriodSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case UserPeriodSettingsServiceGetUserPeriodSettingsProcedure:
+			userPeriodSettingsServiceGetUserPeriodSettingsHandler.ServeHTTP(w, r)
+		case UserPeriodSettingsServiceUpdateUserPeriodSettingsProcedure:
+			userPeriodSettingsServiceUpdateUserPeriodSettingsHandler.ServeHTTP(w, r)
+		case UserPeriodSettingsServiceGetUserReminderIntervalSettingsProcedure:
+			userPeriodSettingsServiceGetUserReminderIntervalSettingsHandler.ServeHTTP(w, r)
+		case UserPeriodSettingsServiceUpdateUserReminderIntervalSettingsProcedure:
+			userPeriodSettingsServiceUpdateUserReminderIntervalSettingsHandler.ServeHTTP(w, r)
+		case UserPeriodSettingsServiceGetUserQuietHoursSettingsProcedure:
+			userPeriodSettingsServiceGetUserQuietHoursSettingsHandler.ServeHTTP(w, r)
+		case UserPeriodSettingsServiceUpdateUserQuietHoursSettingsProcedure:
+			userPeriodSettingsServiceUpdateUserQuietHoursSettingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedUserPeriodSettingsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedUserPeriodSettingsServiceHandler struct{}
+
+func (UnimplementedUserPeriodSettingsServiceHandler) GetUserPeriodSettings(context.Context, *v1.GetUserPeriodSettingsRequest) (*v1.GetUserPeriodSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.GetUserPeriodSettings is not implemented"))
+}
+
+func (UnimplementedUserPeriodSettingsServiceHandler) UpdateUserPeriodSettings(context.Context, *v1.UpdateUserPeriodSettingsRequest) (*v1.UpdateUserPeriodSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.UpdateUserPeriodSettings is not implemented"))
+}
+
+func (UnimplementedUserPeriodSettingsServiceHandler) GetUserReminderIntervalSettings(context.Context, *v1.GetUserReminderIntervalSettingsRequest) (*v1.GetUserReminderIntervalSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.GetUserReminderIntervalSettings is not implemented"))
+}
+
+func (UnimplementedUserPeriodSettingsServiceHandler) UpdateUserReminderIntervalSettings(context.Context, *v1.UpdateUserReminderIntervalSettingsRequest) (*v1.UpdateUserReminderIntervalSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.UpdateUserReminderIntervalSettings is not implemented"))
+}
+
+func (UnimplementedUserPeriodSettingsServiceHandler) GetUserQuietHoursSettings(context.Context, *v1.GetUserQuietHoursSettingsRequest) (*v1.GetUserQuietHoursSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.GetUserQuietHoursSettings is not implemented"))
+}
+
+func (UnimplementedUserPeriodSettingsServiceHandler) UpdateUserQuietHoursSettings(context.Context, *v1.UpdateUserQuietHoursSettingsRequest) (*v1.UpdateUserQuietHoursSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.UserPeriodSettingsService.UpdateUserQuietHoursSettings is not implemented"))
+}
+
+// CalendarFeedServiceClient is a client for the task.v1.CalendarFeedService service.
+type CalendarFeedServiceClient interface {
+	CreateCalendarFeedToken(context.Context, *v1.CreateCalendarFeedTokenRequest) (*v1.CreateCalendarFeedTokenResponse, error)
+	RotateCalendarFeedToken(context.Context, *v1.RotateCalendarFeedTokenRequest) (*v1.RotateCalendarFeedTokenResponse, error)
+	RevokeCalendarFeedToken(context.Context, *v1.RevokeCalendarFeedTokenRequest) (*v1.RevokeCalendarFeedTokenResponse, error)
+}
+
+// NewCalendarFeedServiceClient constructs a client for the task.v1.CalendarFeedService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCalendarFeedServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CalendarFeedServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	calendarFeedServiceMethods := v1.File_task_v1_task_proto.Services().ByName("CalendarFeedService").Methods()
+	return &calendarFeedServiceClient{
+		createCalendarFeedToken: connect.NewClient[v1.CreateCalendarFeedTokenRequest, v1.CreateCalendarFeedTokenResponse](
+			httpClient,
+			baseURL+CalendarFeedServiceCreateCalendarFeedTokenProcedure,
+			connect.WithSchema(calendarFeedServiceMethods.ByName("CreateCalendarFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateCalendarFeedToken: connect.NewClient[v1.RotateCalendarFeedTokenRequest, v1.RotateCalendarFeedTokenResponse](
+			httpClient,
+			baseURL+CalendarFeedServiceRotateCalendarFeedTokenProcedure,
+			connect.WithSchema(calendarFeedServiceMethods.ByName("RotateCalendarFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeCalendarFeedToken: connect.NewClient[v1.RevokeCalendarFeedTokenRequest, v1.RevokeCalendarFeedTokenResponse](
+			httpClient,
+			baseURL+CalendarFeedServiceRevokeCalendarFeedTokenProcedure,
+			connect.WithSchema(calendarFeedServiceMethods.ByName("RevokeCalendarFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// calendarFeedServiceClient implements CalendarFeedServiceClient.
+type calendarFeedServiceClient struct {
+	createCalendarFeedToken *connect.Client[v1.CreateCalendarFeedTokenRequest, v1.CreateCalendarFeedTokenResponse]
+	rotateCalendarFeedToken *connect.Client[v1.RotateCalendarFeedTokenRequest, v1.RotateCalendarFeedTokenResponse]
+	revokeCalendarFeedToken *connect.Client[v1.RevokeCalendarFeedTokenRequest, v1.RevokeCalendarFeedTokenResponse]
+}
+
+// CreateCalendarFeedToken calls task.v1.CalendarFeedService.CreateCalendarFeedToken.
+func (c *calendarFeedServiceClient) CreateCalendarFeedToken(ctx context.Context, req *v1.CreateCalendarFeedTokenRequest) (*v1.CreateCalendarFeedTokenResponse, error) {
+	response, err := c.createCalendarFeedToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RotateCalendarFeedToken calls task.v1.CalendarFeedService.RotateCalendarFeedToken.
+func (c *calendarFeedServiceClient) RotateCalendarFeedToken(ctx context.Context, req *v1.RotateCalendarFeedTokenRequest) (*v1.RotateCalendarFeedTokenResponse, error) {
+	response, err := c.rotateCalendarFeedToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeCalendarFeedToken calls task.v1.CalendarFeedService.RevokeCalendarFeedToken.
+func (c *calendarFeedServiceClient) RevokeCalendarFeedToken(ctx context.Context, req *v1.RevokeCalendarFeedTokenRequest) (*v1.RevokeCalendarFeedTokenResponse, error) {
+	response, err := c.revokeCalendarFeedToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CalendarFeedServiceHandler is an implementation of the task.v1.CalendarFeedService service.
+type CalendarFeedServiceHandler interface {
+	CreateCalendarFeedToken(context.Context, *v1.CreateCalendarFeedTokenRequest) (*v1.CreateCalendarFeedTokenResponse, error)
+	RotateCalendarFeedToken(context.Context, *v1.RotateCalendarFeedTokenRequest) (*v1.RotateCalendarFeedTokenResponse, error)
+	RevokeCalendarFeedToken(context.Context, *v1.RevokeCalendarFeedTokenRequest) (*v1.RevokeCalendarFeedTokenResponse, error)
+}
+
+// NewCalendarFeedServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCalendarFeedServiceHandler(svc CalendarFeedServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	calendarFeedServiceMethods := v1.File_task_v1_task_proto.Services().ByName("CalendarFeedService").Methods()
+	calendarFeedServiceCreateCalendarFeedTokenHandler := connect.NewUnaryHandlerSimple(
+		CalendarFeedServiceCreateCalendarFeedTokenProcedure,
+		svc.CreateCalendarFeedToken,
+		connect.WithSchema(calendarFeedServiceMethods.ByName("CreateCalendarFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	calendarFeedServiceRotateCalendarFeedTokenHandler := connect.NewUnaryHandlerSimple(
+		CalendarFeedServiceRotateCalendarFeedTokenProcedure,
+		svc.RotateCalendarFeedToken,
+		connect.WithSchema(calendarFeedServiceMethods.ByName("RotateCalendarFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	calendarFeedServiceRevokeCalendarFeedTokenHandler := connect.NewUnaryHandlerSimple(
+		CalendarFeedServiceRevokeCalendarFeedTokenProcedure,
+		svc.RevokeCalendarFeedToken,
+		connect.WithSchema(calendarFeedServiceMethods.ByName("RevokeCalendarFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/task.v1.CalendarFeedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CalendarFeedServiceCreateCalendarFeedTokenProcedure:
+			calendarFeedServiceCreateCalendarFeedTokenHandler.ServeHTTP(w, r)
+		case CalendarFeedServiceRotateCalendarFeedTokenProcedure:
+			calendarFeedServiceRotateCalendarFeedTokenHandler.ServeHTTP(w, r)
+		case CalendarFeedServiceRevokeCalendarFeedTokenProcedure:
+			calendarFeedServiceRevokeCalendarFeedTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCalendarFeedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCalendarFeedServiceHandler struct{}
+
+func (UnimplementedCalendarFeedServiceHandler) CreateCalendarFeedToken(context.Context, *v1.CreateCalendarFeedTokenRequest) (*v1.CreateCalendarFeedTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CalendarFeedService.CreateCalendarFeedToken is not implemented"))
+}
+
+func (UnimplementedCalendarFeedServiceHandler) RotateCalendarFeedToken(context.Context, *v1.RotateCalendarFeedTokenRequest) (*v1.RotateCalendarFeedTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CalendarFeedService.RotateCalendarFeedToken is not implemented"))
+}
+
+func (UnimplementedCalendarFeedServiceHandler) RevokeCalendarFeedToken(context.Context, *v1.RevokeCalendarFeedTokenRequest) (*v1.RevokeCalendarFeedTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("task.v1.CalendarFeedService.RevokeCalendarFeedToken is not implemented"))
 }
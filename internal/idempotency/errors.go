@@ -0,0 +1,9 @@
+package idempotency
+
+import "errors"
+
+var (
+	ErrKeyTooLong        = errors.New("idempotency key cannot exceed 255 characters")
+	ErrKeyReused         = errors.New("idempotency key was already used for a different request")
+	ErrRequestInProgress = errors.New("a request with this idempotency key is still in progress")
+)
@@ -0,0 +1,204 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// HeaderName is the request header that carries the client's idempotency key.
+	HeaderName = "Idempotency-Key"
+	// ReplayedHeaderName is set on responses replayed from an earlier request.
+	ReplayedHeaderName = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+)
+
+// UserResolver returns the user a request is made by. Keys are scoped to the user, so two
+// users sending the same key never see each other's responses.
+type UserResolver func(ctx context.Context) (string, error)
+
+// NewInterceptor returns a server interceptor that makes the given unary procedures
+// idempotent for requests carrying an Idempotency-Key header. The first request with a
+// key runs the handler and stores its response; a retry with the same key and request
+// gets the stored response without running the handler again.
+//
+// Failed requests are not stored, so they can be retried with the same key. When the
+// store is unavailable requests run as if they carried no key.
+func NewInterceptor(store Store, resolveUser UserResolver, procedures ...string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+
+			if req.Spec().IsClient || !slices.Contains(procedures, procedure) {
+				return next(ctx, req)
+			}
+
+			key := req.Header().Get(HeaderName)
+			if key == "" {
+				return next(ctx, req)
+			}
+
+			if len(key) > maxKeyLength {
+				return nil, connect.NewError(connect.CodeInvalidArgument, ErrKeyTooLong)
+			}
+
+			// Requests of unknown users are left to the handler to reject.
+			userID, err := resolveUser(ctx)
+			if err != nil || userID == "" {
+				return next(ctx, req)
+			}
+
+			request, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			fingerprint, err := fingerprintOf(procedure, request)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+
+			storeKey := userID + ":" + procedure + ":" + key
+
+			existing, reserved, err := store.Reserve(ctx, storeKey, fingerprint)
+			if err != nil {
+				slog.WarnContext(ctx, "idempotency store unavailable, handling request without it",
+					slog.String("event", "idempotency.reserve.fail"),
+					slog.String("procedure", procedure),
+					slog.String("error", err.Error()),
+				)
+
+				return next(ctx, req)
+			}
+
+			if !reserved {
+				return replay(ctx, req, existing, fingerprint)
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				// The request can still complete after the client goes away, so the key is
+				// released regardless of the request context.
+				if releaseErr := store.Release(context.WithoutCancel(ctx), storeKey); releaseErr != nil {
+					slog.WarnContext(ctx, "failed to release idempotency key",
+						slog.String("event", "idempotency.release.fail"),
+						slog.String("procedure", procedure),
+						slog.String("error", releaseErr.Error()),
+					)
+				}
+
+				return nil, err
+			}
+
+			complete(ctx, store, storeKey, fingerprint, procedure, resp)
+
+			return resp, nil
+		}
+	}
+}
+
+func complete(ctx context.Context, store Store, storeKey, fingerprint, procedure string, resp connect.AnyResponse) {
+	response, ok := resp.Any().(proto.Message)
+	if !ok {
+		return
+	}
+
+	payload, err := proto.Marshal(response)
+	if err == nil {
+		err = store.Complete(context.WithoutCancel(ctx), storeKey, Record{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			Response:    payload,
+		})
+	}
+
+	// The response is still returned; a retry then fails while the pending record lasts.
+	if err != nil {
+		slog.WarnContext(ctx, "failed to store idempotent response",
+			slog.String("event", "idempotency.complete.fail"),
+			slog.String("procedure", procedure),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func replay(ctx context.Context, req connect.AnyRequest, record Record, fingerprint string) (connect.AnyResponse, error) {
+	procedure := req.Spec().Procedure
+
+	if record.Fingerprint != fingerprint {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrKeyReused)
+	}
+
+	if !record.Completed {
+		return nil, connect.NewError(connect.CodeAborted, ErrRequestInProgress)
+	}
+
+	response, err := newResponseMessage(req.Spec())
+	if err == nil {
+		err = proto.Unmarshal(record.Response, response)
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to decode idempotent response",
+			slog.String("event", "idempotency.replay.fail"),
+			slog.String("procedure", procedure),
+			slog.String("error", err.Error()),
+		)
+
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to replay response"))
+	}
+
+	slog.InfoContext(ctx, "replayed idempotent response",
+		slog.String("event", "idempotency.replay"),
+		slog.String("procedure", procedure),
+	)
+
+	resp := connect.NewResponse(&replayedMessage{Message: response})
+	resp.Header().Set(ReplayedHeaderName, "true")
+
+	return resp, nil
+}
+
+// replayedMessage lets a response message of a type unknown at compile time be sent; the
+// codecs only use its protobuf reflection, which is that of the wrapped message.
+type replayedMessage struct {
+	proto.Message
+}
+
+func newResponseMessage(spec connect.Spec) (proto.Message, error) {
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("procedure %s has no schema", spec.Procedure)
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	return messageType.New().Interface(), nil
+}
+
+func fingerprintOf(procedure string, request proto.Message) (string, error) {
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	sum := sha256.New()
+	sum.Write([]byte(procedure))
+	sum.Write(payload)
+
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
@@ -0,0 +1,325 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	devicev1 "github.com/KasumiMercury/primind-central-backend/internal/gen/device/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/device/v1/devicev1connect"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+type userKey struct{}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]Record)}
+}
+
+func (s *memoryStore) Reserve(_ context.Context, key string, fingerprint string) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		return record, false, nil
+	}
+
+	s.records[key] = Record{Fingerprint: fingerprint}
+
+	return Record{}, true, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, key string, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[key] = record
+
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+
+	return nil
+}
+
+type fakeDeviceService struct {
+	devicev1connect.UnimplementedDeviceServiceHandler
+
+	mu    sync.Mutex
+	calls int
+	fail  bool
+}
+
+func (s *fakeDeviceService) RegisterDevice(
+	_ context.Context,
+	_ *devicev1.RegisterDeviceRequest,
+) (*devicev1.RegisterDeviceResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+
+	if s.fail {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("device store unavailable"))
+	}
+
+	return &devicev1.RegisterDeviceResponse{
+		DeviceId: fmt.Sprintf("device-%d", s.calls),
+		IsNew:    true,
+	}, nil
+}
+
+func (s *fakeDeviceService) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls
+}
+
+func setupIdempotentServer(t *testing.T, store Store) (*fakeDeviceService, devicev1connect.DeviceServiceClient) {
+	t.Helper()
+
+	resolveUser := func(ctx context.Context) (string, error) {
+		userID, _ := ctx.Value(userKey{}).(string)
+
+		return userID, nil
+	}
+
+	// Stands in for the auth interceptor, which resolves the user from the session.
+	withUser := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(context.WithValue(ctx, userKey{}, req.Header().Get("X-User")), req)
+		}
+	})
+
+	service := &fakeDeviceService{}
+
+	_, handler := devicev1connect.NewDeviceServiceHandler(
+		service,
+		connect.WithInterceptors(withUser, NewInterceptor(store, resolveUser, devicev1connect.DeviceServiceRegisterDeviceProcedure)),
+	)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return service, devicev1connect.NewDeviceServiceClient(server.Client(), server.URL)
+}
+
+type registerCall struct {
+	user     string
+	key      string
+	timezone string
+}
+
+// register sends the call and returns the response with its headers.
+func register(
+	client devicev1connect.DeviceServiceClient,
+	call registerCall,
+) (*devicev1.RegisterDeviceResponse, http.Header, error) {
+	ctx, info := connect.NewClientContext(context.Background())
+	info.RequestHeader().Set("X-User", call.user)
+
+	if call.key != "" {
+		info.RequestHeader().Set(HeaderName, call.key)
+	}
+
+	resp, err := client.RegisterDevice(ctx, &devicev1.RegisterDeviceRequest{Timezone: call.timezone})
+
+	return resp, info.ResponseHeader(), err
+}
+
+func TestInterceptorReplaysResponse(t *testing.T) {
+	service, client := setupIdempotentServer(t, newMemoryStore())
+
+	first, firstHeader, err := register(client, registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	retry, retryHeader, err := register(client, registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+
+	if service.callCount() != 1 {
+		t.Fatalf("expected the handler to run once, got %d", service.callCount())
+	}
+
+	if !proto.Equal(first, retry) {
+		t.Errorf("expected the original response %v, got %v", first, retry)
+	}
+
+	if firstHeader.Get(ReplayedHeaderName) != "" || retryHeader.Get(ReplayedHeaderName) != "true" {
+		t.Errorf("expected only the retry to be marked as replayed")
+	}
+}
+
+func TestInterceptorRunsHandler(t *testing.T) {
+	tests := []struct {
+		name  string
+		calls []registerCall
+	}{
+		{
+			name: "without a key",
+			calls: []registerCall{
+				registerCall{user: "user-1", key: "", timezone: "Asia/Tokyo"},
+				registerCall{user: "user-1", key: "", timezone: "Asia/Tokyo"},
+			},
+		},
+		{
+			name: "with different keys",
+			calls: []registerCall{
+				registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"},
+				registerCall{user: "user-1", key: "key-2", timezone: "Asia/Tokyo"},
+			},
+		},
+		{
+			name: "for different users with the same key",
+			calls: []registerCall{
+				registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"},
+				registerCall{user: "user-2", key: "key-1", timezone: "Asia/Tokyo"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, client := setupIdempotentServer(t, newMemoryStore())
+
+			for _, call := range tt.calls {
+				_, header, err := register(client, call)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if header.Get(ReplayedHeaderName) != "" {
+					t.Fatalf("expected a fresh response, got a replay")
+				}
+			}
+
+			if service.callCount() != len(tt.calls) {
+				t.Fatalf("expected the handler to run %d times, got %d", len(tt.calls), service.callCount())
+			}
+		})
+	}
+}
+
+func TestInterceptorRejectsRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(store *memoryStore)
+		call         registerCall
+		expectedCode connect.Code
+	}{
+		{
+			name: "key reused for a different request",
+			setup: func(store *memoryStore) {
+				store.records["user-1:"+devicev1connect.DeviceServiceRegisterDeviceProcedure+":key-1"] = Record{
+					Fingerprint: "other-request",
+					Completed:   true,
+				}
+			},
+			call:         registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"},
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "request still in progress",
+			setup: func(store *memoryStore) {
+				fingerprint, err := fingerprintOf(
+					devicev1connect.DeviceServiceRegisterDeviceProcedure,
+					&devicev1.RegisterDeviceRequest{Timezone: "Asia/Tokyo"},
+				)
+				if err != nil {
+					t.Fatalf("failed to fingerprint request: %v", err)
+				}
+
+				store.records["user-1:"+devicev1connect.DeviceServiceRegisterDeviceProcedure+":key-1"] = Record{
+					Fingerprint: fingerprint,
+				}
+			},
+			call:         registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"},
+			expectedCode: connect.CodeAborted,
+		},
+		{
+			name:         "key too long",
+			setup:        func(*memoryStore) {},
+			call:         registerCall{user: "user-1", key: strings.Repeat("k", maxKeyLength+1), timezone: "Asia/Tokyo"},
+			expectedCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore()
+			tt.setup(store)
+
+			service, client := setupIdempotentServer(t, store)
+
+			_, _, err := register(client, tt.call)
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, err)
+			}
+
+			if service.callCount() != 0 {
+				t.Fatalf("expected the handler not to run, got %d calls", service.callCount())
+			}
+		})
+	}
+}
+
+func TestInterceptorReleasesFailedRequest(t *testing.T) {
+	service, client := setupIdempotentServer(t, newMemoryStore())
+
+	service.fail = true
+
+	if _, _, err := register(client, registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"}); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected the handler error, got %v", err)
+	}
+
+	service.fail = false
+
+	_, header, err := register(client, registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+
+	if service.callCount() != 2 || header.Get(ReplayedHeaderName) != "" {
+		t.Fatalf("expected the retry to run the handler again, got %d calls", service.callCount())
+	}
+}
+
+func TestInterceptorStoreUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := NewMockStore(ctrl)
+	store.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(Record{}, false, errors.New("connection refused")).Times(2)
+
+	service, client := setupIdempotentServer(t, store)
+
+	for range 2 {
+		if _, _, err := register(client, registerCall{user: "user-1", key: "key-1", timezone: "Asia/Tokyo"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if service.callCount() != 2 {
+		t.Fatalf("expected the handler to run for every request, got %d", service.callCount())
+	}
+}
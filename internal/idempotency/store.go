@@ -0,0 +1,108 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+//go:generate mockgen -source=store.go -destination=mock_store.go -package=idempotency
+
+const (
+	// DefaultTTL is how long a response is replayed for retries of its request.
+	DefaultTTL = 24 * time.Hour
+	// pendingTTL bounds how long a request that never completes, e.g. because the server
+	// stopped, blocks its key.
+	pendingTTL = time.Minute
+
+	keyPrefix = "idempotency:"
+)
+
+// Record is what is stored under an idempotency key. A pending record marks a request that
+// is being handled; a completed one holds its response.
+type Record struct {
+	// Fingerprint identifies the request message, so a key reused for another request is
+	// told apart from a retry.
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	// Response is the binary protobuf encoding of the response message
+	Response []byte `json:"response,omitempty"`
+}
+
+type Store interface {
+	// Reserve stores a pending record for the request under key unless the key is taken. It
+	// reports whether the key was reserved, and returns the record under the key otherwise.
+	Reserve(ctx context.Context, key string, fingerprint string) (Record, bool, error)
+	// Complete replaces the pending record of a reserved key with the response.
+	Complete(ctx context.Context, key string, record Record) error
+	// Release frees a reserved key whose request failed so that it can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+type redisStore struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewRedisStore returns a store that keeps completed responses for ttl.
+func NewRedisStore(client *redis.Client, ttl time.Duration) Store {
+	return &redisStore{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (s *redisStore) Reserve(ctx context.Context, key string, fingerprint string) (Record, bool, error) {
+	pending, err := json.Marshal(Record{Fingerprint: fingerprint, Completed: false, Response: nil})
+	if err != nil {
+		return Record{}, false, err
+	}
+
+	// The existing record can expire between the two commands, so the reservation is
+	// attempted again once when it is gone.
+	for range 2 {
+		reserved, err := s.client.SetNX(ctx, keyPrefix+key, pending, pendingTTL).Result()
+		if err != nil {
+			return Record{}, false, err
+		}
+
+		if reserved {
+			return Record{}, true, nil
+		}
+
+		raw, err := s.client.Get(ctx, keyPrefix+key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+
+		if err != nil {
+			return Record{}, false, err
+		}
+
+		var record Record
+		if err := json.Unmarshal(raw, &record); err != nil {
+			return Record{}, false, fmt.Errorf("failed to decode idempotency record: %w", err)
+		}
+
+		return record, false, nil
+	}
+
+	return Record{}, false, fmt.Errorf("idempotency key %s kept expiring during reservation", key)
+}
+
+func (s *redisStore) Complete(ctx context.Context, key string, record Record) error {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Set(ctx, keyPrefix+key, payload, s.ttl).Err()
+}
+
+func (s *redisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, keyPrefix+key).Err()
+}
@@ -0,0 +1,57 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+)
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+
+	redisClient, cleanup := testutil.SetupRedisContainer(ctx, t)
+	if redisClient == nil {
+		t.Skip("redis container unavailable")
+	}
+
+	t.Cleanup(cleanup)
+
+	store := NewRedisStore(redisClient, time.Hour)
+
+	if _, reserved, err := store.Reserve(ctx, "user:procedure:key", "request"); err != nil || !reserved {
+		t.Fatalf("expected the key to be reserved, got %v %v", reserved, err)
+	}
+
+	pending, reserved, err := store.Reserve(ctx, "user:procedure:key", "request")
+	if err != nil || reserved {
+		t.Fatalf("expected the key to be taken, got %v %v", reserved, err)
+	}
+
+	if pending.Fingerprint != "request" || pending.Completed {
+		t.Fatalf("expected a pending record, got %+v", pending)
+	}
+
+	completed := Record{Fingerprint: "request", Completed: true, Response: []byte("response")}
+	if err := store.Complete(ctx, "user:procedure:key", completed); err != nil {
+		t.Fatalf("failed to complete: %v", err)
+	}
+
+	record, _, err := store.Reserve(ctx, "user:procedure:key", "request")
+	if err != nil || !record.Completed || string(record.Response) != "response" {
+		t.Fatalf("expected the completed record, got %+v %v", record, err)
+	}
+
+	if ttl := redisClient.TTL(ctx, keyPrefix+"user:procedure:key").Val(); ttl <= pendingTTL {
+		t.Errorf("expected the completed record to outlive the pending ttl, got %v", ttl)
+	}
+
+	if err := store.Release(ctx, "user:procedure:key"); err != nil {
+		t.Fatalf("failed to release: %v", err)
+	}
+
+	if _, reserved, err := store.Reserve(ctx, "user:procedure:key", "request"); err != nil || !reserved {
+		t.Fatalf("expected a released key to be reserved again, got %v %v", reserved, err)
+	}
+}
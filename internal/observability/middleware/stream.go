@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// WithoutDeadlines lifts the server's read and write timeouts for the given procedures, whose
+// responses are long-lived streams. Other requests keep the server timeouts.
+func WithoutDeadlines(next http.Handler, procedures ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(procedures, r.URL.Path) {
+			rc := http.NewResponseController(w)
+
+			// An expired read deadline cancels the request context on HTTP/1.1
+			if err := rc.SetReadDeadline(time.Time{}); err != nil {
+				slog.WarnContext(r.Context(), "failed to clear read deadline",
+					slog.String("path", r.URL.Path),
+					slog.String("error", err.Error()),
+				)
+			}
+
+			if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+				slog.WarnContext(r.Context(), "failed to clear write deadline",
+					slog.String("path", r.URL.Path),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithoutDeadlines(t *testing.T) {
+	const timeout = 100 * time.Millisecond
+
+	// Writes a first chunk, then keeps the response open past the server timeouts
+	slow := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "first;")
+		w.(http.Flusher).Flush()
+
+		time.Sleep(3 * timeout)
+
+		_, _ = io.WriteString(w, "second")
+	})
+
+	server := httptest.NewUnstartedServer(WithoutDeadlines(slow, "/stream"))
+	server.Config.ReadTimeout = timeout
+	server.Config.WriteTimeout = timeout
+	server.Start()
+
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		complete bool
+	}{
+		{name: "listed procedure outlives the timeouts", path: "/stream", complete: true},
+		{name: "other paths keep the timeouts", path: "/unary", complete: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.Client().Get(server.URL + tt.path)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if complete := err == nil && string(body) == "first;second"; complete != tt.complete {
+				t.Fatalf("expected complete=%v, got body %q err %v", tt.complete, body, err)
+			}
+		})
+	}
+}
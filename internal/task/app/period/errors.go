@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
 )
 
@@ -15,4 +16,17 @@ var (
 	ErrScheduledTypeNotAllowed             = period.ErrScheduledTypeNotAllowed
 	ErrInvalidPeriodMinutes                = period.ErrInvalidPeriodMinutes
 	ErrInvalidTaskType                     = period.ErrInvalidTaskType
+
+	ErrGetReminderIntervalsRequestRequired    = errors.New("get reminder interval settings request is required")
+	ErrUpdateReminderIntervalsRequestRequired = errors.New("update reminder interval settings request is required")
+	ErrInvalidReminderInterval                = period.ErrInvalidReminderInterval
+	ErrTooManyReminderIntervals               = period.ErrTooManyReminderIntervals
+	ErrDuplicateReminderInterval              = period.ErrDuplicateReminderInterval
+
+	ErrGetQuietHoursRequestRequired    = errors.New("get quiet hours settings request is required")
+	ErrUpdateQuietHoursRequestRequired = errors.New("update quiet hours settings request is required")
+	ErrInvalidQuietHoursTimezone       = task.ErrInvalidQuietHoursTimezone
+	ErrInvalidQuietHoursPolicy         = task.ErrInvalidQuietHoursPolicy
+	ErrInvalidQuietWindow              = task.ErrInvalidQuietWindow
+	ErrTooManyQuietWindows             = task.ErrTooManyQuietWindows
 )
@@ -0,0 +1,200 @@
+package periodsetting
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+)
+
+// QuietWindowItem represents a quiet window that starts on a weekday. Minutes are counted
+// from local midnight; an end not after the start falls on the following day.
+type QuietWindowItem struct {
+	Weekday     time.Weekday
+	StartMinute int
+	EndMinute   int
+}
+
+// QuietHoursSettings represents a user's quiet hours
+type QuietHoursSettings struct {
+	Timezone            string
+	Windows             []QuietWindowItem
+	Policy              task.QuietHoursPolicy
+	DeadlineAlwaysFires bool
+}
+
+// GetQuietHoursSettingsRequest is the request for getting quiet hours settings
+type GetQuietHoursSettingsRequest struct {
+	SessionToken string
+}
+
+// GetQuietHoursSettingsResult is the result of getting quiet hours settings.
+// Settings is nil when the user has no quiet hours.
+type GetQuietHoursSettingsResult struct {
+	Settings *QuietHoursSettings
+}
+
+// UpdateQuietHoursSettingsRequest is the request for updating quiet hours settings.
+// A nil Settings clears the quiet hours.
+type UpdateQuietHoursSettingsRequest struct {
+	SessionToken string
+	Settings     *QuietHoursSettings
+}
+
+// UpdateQuietHoursSettingsResult is the result of updating quiet hours settings
+type UpdateQuietHoursSettingsResult struct {
+	Settings *QuietHoursSettings
+}
+
+// GetQuietHoursSettingsUseCase defines the interface for getting quiet hours settings
+type GetQuietHoursSettingsUseCase interface {
+	GetQuietHoursSettings(ctx context.Context, req *GetQuietHoursSettingsRequest) (*GetQuietHoursSettingsResult, error)
+}
+
+// UpdateQuietHoursSettingsUseCase defines the interface for updating quiet hours settings
+type UpdateQuietHoursSettingsUseCase interface {
+	UpdateQuietHoursSettings(ctx context.Context, req *UpdateQuietHoursSettingsRequest) (*UpdateQuietHoursSettingsResult, error)
+}
+
+type getQuietHoursSettingsHandler struct {
+	authClient authclient.AuthClient
+	quietHours period.QuietHoursSettingRepository
+	logger     *slog.Logger
+}
+
+// NewGetQuietHoursSettingsHandler creates a new handler for getting quiet hours settings
+func NewGetQuietHoursSettingsHandler(
+	authClient authclient.AuthClient,
+	quietHoursRepo period.QuietHoursSettingRepository,
+) GetQuietHoursSettingsUseCase {
+	return &getQuietHoursSettingsHandler{
+		authClient: authClient,
+		quietHours: quietHoursRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("getquiethours"),
+	}
+}
+
+func (h *getQuietHoursSettingsHandler) GetQuietHoursSettings(
+	ctx context.Context,
+	req *GetQuietHoursSettingsRequest,
+) (*GetQuietHoursSettingsResult, error) {
+	if req == nil {
+		return nil, ErrGetQuietHoursRequestRequired
+	}
+
+	userID, err := validateSession(ctx, h.authClient, req.SessionToken, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := h.quietHours.GetByUserID(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get quiet hours settings", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	h.logger.Info("quiet hours settings retrieved", slog.Bool("set", !settings.IsEmpty()))
+
+	return &GetQuietHoursSettingsResult{
+		Settings: toQuietHoursSettings(settings.QuietHours()),
+	}, nil
+}
+
+type updateQuietHoursSettingsHandler struct {
+	authClient authclient.AuthClient
+	quietHours period.QuietHoursSettingRepository
+	logger     *slog.Logger
+}
+
+// NewUpdateQuietHoursSettingsHandler creates a new handler for updating quiet hours settings
+func NewUpdateQuietHoursSettingsHandler(
+	authClient authclient.AuthClient,
+	quietHoursRepo period.QuietHoursSettingRepository,
+) UpdateQuietHoursSettingsUseCase {
+	return &updateQuietHoursSettingsHandler{
+		authClient: authClient,
+		quietHours: quietHoursRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("updatequiethours"),
+	}
+}
+
+func (h *updateQuietHoursSettingsHandler) UpdateQuietHoursSettings(
+	ctx context.Context,
+	req *UpdateQuietHoursSettingsRequest,
+) (*UpdateQuietHoursSettingsResult, error) {
+	if req == nil {
+		return nil, ErrUpdateQuietHoursRequestRequired
+	}
+
+	userID, err := validateSession(ctx, h.authClient, req.SessionToken, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var quietHours *task.QuietHours
+
+	if req.Settings != nil {
+		// Create domain objects (this validates the input)
+		quietHours, err = newQuietHours(req.Settings)
+		if err != nil {
+			h.logger.Warn("invalid quiet hours settings", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+	}
+
+	settings := period.NewUserQuietHoursSettings(userID, quietHours)
+
+	if err := h.quietHours.Save(ctx, settings); err != nil {
+		h.logger.Error("failed to save quiet hours settings", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	h.logger.Info("quiet hours settings updated", slog.Bool("set", !settings.IsEmpty()))
+
+	return &UpdateQuietHoursSettingsResult{
+		Settings: toQuietHoursSettings(settings.QuietHours()),
+	}, nil
+}
+
+func newQuietHours(settings *QuietHoursSettings) (*task.QuietHours, error) {
+	windows := make([]task.QuietWindow, 0, len(settings.Windows))
+
+	for _, item := range settings.Windows {
+		window, err := task.NewQuietWindow(item.Weekday, item.StartMinute, item.EndMinute)
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, window)
+	}
+
+	return task.NewQuietHours(settings.Timezone, windows, settings.Policy, settings.DeadlineAlwaysFires)
+}
+
+func toQuietHoursSettings(quietHours *task.QuietHours) *QuietHoursSettings {
+	if quietHours == nil {
+		return nil
+	}
+
+	windows := make([]QuietWindowItem, 0, len(quietHours.Windows()))
+	for _, w := range quietHours.Windows() {
+		windows = append(windows, QuietWindowItem{
+			Weekday:     w.Weekday(),
+			StartMinute: w.StartMinute(),
+			EndMinute:   w.EndMinute(),
+		})
+	}
+
+	return &QuietHoursSettings{
+		Timezone:            quietHours.Timezone(),
+		Windows:             windows,
+		Policy:              quietHours.Policy(),
+		DeadlineAlwaysFires: quietHours.DeadlineAlwaysFires(),
+	}
+}
@@ -0,0 +1,207 @@
+package periodsetting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+)
+
+// ReminderIntervalSettingItem represents the reminder intervals of a single task type
+type ReminderIntervalSettingItem struct {
+	TaskType  task.Type
+	Intervals []task.ReminderInterval
+}
+
+// GetReminderIntervalSettingsRequest is the request for getting reminder interval settings
+type GetReminderIntervalSettingsRequest struct {
+	SessionToken string
+}
+
+// GetReminderIntervalSettingsResult is the result of getting reminder interval settings
+type GetReminderIntervalSettingsResult struct {
+	Settings []ReminderIntervalSettingItem
+	Defaults []ReminderIntervalSettingItem
+}
+
+// UpdateReminderIntervalSettingsRequest is the request for updating reminder interval settings.
+// The settings replace the stored ones; task types left out go back to the defaults.
+type UpdateReminderIntervalSettingsRequest struct {
+	SessionToken string
+	Settings     []ReminderIntervalSettingItem
+}
+
+// UpdateReminderIntervalSettingsResult is the result of updating reminder interval settings
+type UpdateReminderIntervalSettingsResult struct {
+	Settings []ReminderIntervalSettingItem
+}
+
+// GetReminderIntervalSettingsUseCase defines the interface for getting reminder interval settings
+type GetReminderIntervalSettingsUseCase interface {
+	GetReminderIntervalSettings(ctx context.Context, req *GetReminderIntervalSettingsRequest) (*GetReminderIntervalSettingsResult, error)
+}
+
+// UpdateReminderIntervalSettingsUseCase defines the interface for updating reminder interval settings
+type UpdateReminderIntervalSettingsUseCase interface {
+	UpdateReminderIntervalSettings(ctx context.Context, req *UpdateReminderIntervalSettingsRequest) (*UpdateReminderIntervalSettingsResult, error)
+}
+
+type getReminderIntervalSettingsHandler struct {
+	authClient       authclient.AuthClient
+	reminderInterval period.ReminderIntervalSettingRepository
+	logger           *slog.Logger
+}
+
+// NewGetReminderIntervalSettingsHandler creates a new handler for getting reminder interval settings
+func NewGetReminderIntervalSettingsHandler(
+	authClient authclient.AuthClient,
+	reminderIntervalRepo period.ReminderIntervalSettingRepository,
+) GetReminderIntervalSettingsUseCase {
+	return &getReminderIntervalSettingsHandler{
+		authClient:       authClient,
+		reminderInterval: reminderIntervalRepo,
+		logger:           slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("getreminderinterval"),
+	}
+}
+
+func (h *getReminderIntervalSettingsHandler) GetReminderIntervalSettings(
+	ctx context.Context,
+	req *GetReminderIntervalSettingsRequest,
+) (*GetReminderIntervalSettingsResult, error) {
+	if req == nil {
+		return nil, ErrGetReminderIntervalsRequestRequired
+	}
+
+	userID, err := validateSession(ctx, h.authClient, req.SessionToken, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := h.reminderInterval.GetByUserID(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get reminder interval settings", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	settingsItems := toReminderIntervalItems(settings.Intervals())
+
+	h.logger.Info("reminder interval settings retrieved", slog.Int("custom_count", len(settingsItems)))
+
+	return &GetReminderIntervalSettingsResult{
+		Settings: settingsItems,
+		Defaults: toReminderIntervalItems(period.DefaultReminderIntervalSettings()),
+	}, nil
+}
+
+type updateReminderIntervalSettingsHandler struct {
+	authClient       authclient.AuthClient
+	reminderInterval period.ReminderIntervalSettingRepository
+	logger           *slog.Logger
+}
+
+// NewUpdateReminderIntervalSettingsHandler creates a new handler for updating reminder interval settings
+func NewUpdateReminderIntervalSettingsHandler(
+	authClient authclient.AuthClient,
+	reminderIntervalRepo period.ReminderIntervalSettingRepository,
+) UpdateReminderIntervalSettingsUseCase {
+	return &updateReminderIntervalSettingsHandler{
+		authClient:       authClient,
+		reminderInterval: reminderIntervalRepo,
+		logger:           slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("updatereminderinterval"),
+	}
+}
+
+func (h *updateReminderIntervalSettingsHandler) UpdateReminderIntervalSettings(
+	ctx context.Context,
+	req *UpdateReminderIntervalSettingsRequest,
+) (*UpdateReminderIntervalSettingsResult, error) {
+	if req == nil {
+		return nil, ErrUpdateReminderIntervalsRequestRequired
+	}
+
+	userID, err := validateSession(ctx, h.authClient, req.SessionToken, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make(map[task.Type][]task.ReminderInterval, len(req.Settings))
+	for _, item := range req.Settings {
+		intervals[item.TaskType] = item.Intervals
+	}
+
+	// Create domain object (this validates the input)
+	settings, err := period.NewUserReminderIntervalSettings(userID, intervals)
+	if err != nil {
+		h.logger.Warn("invalid reminder interval settings", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if err := h.reminderInterval.Save(ctx, settings); err != nil {
+		h.logger.Error("failed to save reminder interval settings", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	resultItems := toReminderIntervalItems(settings.Intervals())
+
+	h.logger.Info("reminder interval settings updated", slog.Int("count", len(resultItems)))
+
+	return &UpdateReminderIntervalSettingsResult{
+		Settings: resultItems,
+	}, nil
+}
+
+func validateSession(
+	ctx context.Context,
+	authClient authclient.AuthClient,
+	sessionToken string,
+	logger *slog.Logger,
+) (domainuser.ID, error) {
+	userIDstr, err := authClient.ValidateSession(ctx, sessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return domainuser.ID{}, ErrUnauthorized
+		}
+
+		logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, err
+	}
+
+	return userID, nil
+}
+
+// toReminderIntervalItems converts the intervals map into items ordered by task type
+func toReminderIntervalItems(intervals map[task.Type][]task.ReminderInterval) []ReminderIntervalSettingItem {
+	items := make([]ReminderIntervalSettingItem, 0, len(intervals))
+	for taskType, values := range intervals {
+		items = append(items, ReminderIntervalSettingItem{
+			TaskType:  taskType,
+			Intervals: values,
+		})
+	}
+
+	slices.SortFunc(items, func(a, b ReminderIntervalSettingItem) int {
+		return strings.Compare(string(a.TaskType), string(b.TaskType))
+	})
+
+	return items
+}
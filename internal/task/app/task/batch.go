@@ -0,0 +1,506 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+)
+
+// MaxBatchSize is the largest number of tasks a single batch request may contain.
+const MaxBatchSize = 100
+
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return ErrBatchEmpty
+	}
+
+	if size > MaxBatchSize {
+		return ErrBatchTooLarge
+	}
+
+	return nil
+}
+
+type BatchUpdateTasksRequest struct {
+	SessionToken string
+	// Updates use the batch session token; their own SessionToken is ignored
+	Updates []UpdateTaskRequest
+}
+
+// BatchUpdateTaskResult is the outcome of one update of a batch. Exactly one of Task and Err
+// is set.
+type BatchUpdateTaskResult struct {
+	TaskID string
+	Task   *UpdateTaskResult
+	Err    error
+}
+
+type BatchUpdateTasksResult struct {
+	Results []BatchUpdateTaskResult // in request order
+}
+
+type BatchUpdateTasksUseCase interface {
+	BatchUpdateTasks(ctx context.Context, req *BatchUpdateTasksRequest) (*BatchUpdateTasksResult, error)
+}
+
+type batchUpdateTasksHandler struct {
+	update *updateTaskHandler
+	logger *slog.Logger
+}
+
+func NewBatchUpdateTasksHandler(
+	authClient authclient.AuthClient,
+	deviceClient deviceclient.DeviceClient,
+	taskRepo domaintask.TaskRepository,
+	archiveRepo domaintask.TaskArchiveRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	remindQueue remindregister.Queue,
+	cancelRemindQueue remindcancel.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) BatchUpdateTasksUseCase {
+	logger := slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("batchupdatetasks")
+
+	return &batchUpdateTasksHandler{
+		update: &updateTaskHandler{
+			authClient:        authClient,
+			deviceClient:      deviceClient,
+			taskRepo:          taskRepo,
+			archiveRepo:       archiveRepo,
+			intervalRepo:      intervalRepo,
+			quietHoursRepo:    quietHoursRepo,
+			remindQueue:       remindQueue,
+			cancelRemindQueue: cancelRemindQueue,
+			transactor:        transactor,
+			publisher:         publisher,
+			logger:            logger,
+		},
+		logger: logger,
+	}
+}
+
+// batchUpdate is an update of a batch that passed validation, along with what persisting it
+// requires.
+type batchUpdate struct {
+	*preparedUpdate
+
+	index         int
+	completedTask *domaintask.CompletedTask
+	nextTask      *domaintask.Task
+	reminderInfo  *domaintask.ReminderInfo
+}
+
+// BatchUpdateTasks validates the session once and persists every valid update in a single
+// transaction. An update that fails validation, or whose task was deleted or changed before
+// it could be saved, is reported in its result without affecting the others. Any other
+// failure to persist rolls back the whole batch.
+func (h *batchUpdateTasksHandler) BatchUpdateTasks(ctx context.Context, req *BatchUpdateTasksRequest) (*BatchUpdateTasksResult, error) {
+	if req == nil {
+		return nil, ErrBatchUpdateTasksRequestRequired
+	}
+
+	if err := validateBatchSize(len(req.Updates)); err != nil {
+		h.logger.Warn("invalid batch size", slog.Int("size", len(req.Updates)))
+
+		return nil, err
+	}
+
+	userID, err := resolveUser(ctx, h.update.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDstr := userID.String()
+	completedAt := time.Now()
+
+	results := make([]BatchUpdateTaskResult, len(req.Updates))
+	updates := make([]*batchUpdate, 0, len(req.Updates))
+	seen := make(map[domaintask.ID]bool, len(req.Updates))
+	needsDevices := false
+
+	for i := range req.Updates {
+		results[i] = BatchUpdateTaskResult{TaskID: req.Updates[i].TaskID, Task: nil, Err: nil}
+
+		update, err := h.prepare(ctx, &req.Updates[i], userID, completedAt)
+		if err != nil {
+			results[i].Err = err
+
+			continue
+		}
+
+		if seen[update.updated.ID()] {
+			results[i].Err = ErrDuplicateTaskInBatch
+
+			continue
+		}
+
+		seen[update.updated.ID()] = true
+		update.index = i
+		updates = append(updates, update)
+		needsDevices = needsDevices || update.reschedules() || update.nextTask != nil
+	}
+
+	var validDevices, domainDevices []domaintask.DeviceInfo
+
+	var deviceErr error
+
+	if needsDevices {
+		// Devices are fetched once for the whole batch
+		validDevices, domainDevices, deviceErr = fetchReminderDevices(ctx, h.update.deviceClient, req.SessionToken, h.logger)
+	}
+
+	pending := updates[:0]
+
+	for _, update := range updates {
+		if update.reschedules() {
+			if deviceErr != nil {
+				results[update.index].Err = deviceErr
+
+				continue
+			}
+
+			update.reminderInfo = h.update.rescheduledReminderInfo(ctx, update.updated, userIDstr, validDevices, domainDevices)
+		}
+
+		pending = append(pending, update)
+	}
+
+	var persisted []*batchUpdate
+
+	if len(pending) > 0 {
+		if err := h.update.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			persisted, err = h.persist(ctx, pending, results, userID, userIDstr)
+
+			return err
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, update := range persisted {
+		nextTask := update.nextTask
+		if nextTask != nil {
+			if deviceErr != nil {
+				h.logger.Warn("device fetch failed, next occurrence left pending",
+					slog.String("next_task_id", nextTask.ID().String()),
+					slog.String("error", deviceErr.Error()),
+				)
+			} else {
+				nextTask = h.update.activateNextOccurrenceWithDevices(ctx, nextTask, userIDstr, validDevices, domainDevices)
+			}
+		}
+
+		results[update.index].Task = toUpdateTaskResult(update.updated, nextTask)
+
+		h.update.publishUpdate(ctx, userID, update.preparedUpdate, nextTask)
+	}
+
+	h.logger.Info("batch update applied",
+		slog.Int("updated", len(persisted)),
+		slog.Int("failed", len(req.Updates)-len(persisted)),
+	)
+
+	return &BatchUpdateTasksResult{Results: results}, nil
+}
+
+func (h *batchUpdateTasksHandler) prepare(
+	ctx context.Context,
+	req *UpdateTaskRequest,
+	userID domainuser.ID,
+	completedAt time.Time,
+) (*batchUpdate, error) {
+	prepared, err := h.update.prepareUpdate(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &batchUpdate{
+		preparedUpdate: prepared,
+		index:          0,
+		completedTask:  nil,
+		nextTask:       nil,
+		reminderInfo:   nil,
+	}
+
+	if !prepared.completes() {
+		return update, nil
+	}
+
+	update.completedTask, err = domaintask.NewCompletedTask(prepared.updated, completedAt)
+	if err != nil {
+		h.logger.Error("failed to create completed task", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	update.nextTask, err = prepared.updated.NextOccurrence(completedAt)
+	if err != nil {
+		h.logger.Error("failed to build next occurrence", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return update, nil
+}
+
+// persist writes the updates and then records their reminder changes. Each update is saved
+// in its own savepoint: one whose task was deleted or changed since it was read is rolled
+// back and reported in its result, and the updates that were saved are returned. The
+// cancellations of all saved tasks are coalesced into one queue call, and they precede the
+// registrations so that each task's cancel is delivered before its new reminders.
+func (h *batchUpdateTasksHandler) persist(
+	ctx context.Context,
+	updates []*batchUpdate,
+	results []BatchUpdateTaskResult,
+	userID domainuser.ID,
+	userIDstr string,
+) ([]*batchUpdate, error) {
+	persisted := make([]*batchUpdate, 0, len(updates))
+	cancelReqs := make([]*remindcancel.CancelRemindRequest, 0, len(updates))
+
+	for _, update := range updates {
+		taskID := update.updated.ID()
+
+		err := h.update.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return h.persistOne(ctx, update, userID)
+		})
+
+		switch {
+		case errors.Is(err, domaintask.ErrTaskNotFound):
+			h.logger.Info("task disappeared during batch update", slog.String("task_id", taskID.String()))
+
+			results[update.index].Err = ErrTaskNotFound
+
+			continue
+		case errors.Is(err, domaintask.ErrTaskVersionConflict):
+			results[update.index].Err = h.update.versionConflict(ctx, update.updated)
+
+			continue
+		case err != nil:
+			h.logger.Error("failed to persist batch update",
+				slog.String("task_id", taskID.String()),
+				slog.String("error", err.Error()),
+			)
+
+			return nil, err
+		}
+
+		persisted = append(persisted, update)
+
+		if update.completes() || update.reschedules() {
+			cancelReqs = append(cancelReqs, &remindcancel.CancelRemindRequest{
+				TaskID: taskID.String(),
+				UserID: userIDstr,
+			})
+		}
+	}
+
+	if err := remindcancel.CancelAll(ctx, h.update.cancelRemindQueue, cancelReqs); err != nil {
+		h.logger.Error("failed to cancel reminds",
+			slog.Int("task_count", len(cancelReqs)),
+			slog.String("error", err.Error()),
+		)
+
+		return nil, ErrCancelRemindFailed
+	}
+
+	for _, update := range persisted {
+		if update.reminderInfo == nil {
+			continue
+		}
+
+		logReminderInfo(h.logger, update.reminderInfo)
+
+		if _, err := h.update.remindQueue.RegisterRemind(ctx, convertToRemindRequest(update.reminderInfo)); err != nil {
+			h.logger.Error("failed to register remind to queue",
+				slog.String("task_id", update.updated.ID().String()),
+				slog.String("error", err.Error()),
+			)
+
+			return nil, ErrRemindQueueRegistrationFailed
+		}
+	}
+
+	return persisted, nil
+}
+
+func (h *batchUpdateTasksHandler) persistOne(ctx context.Context, update *batchUpdate, userID domainuser.ID) error {
+	taskID := update.updated.ID()
+
+	switch {
+	case update.nextTask != nil:
+		return h.update.archiveRepo.ArchiveTaskWithNextOccurrence(ctx, update.completedTask, taskID, userID, update.existing.Version(), update.nextTask)
+	case update.completedTask != nil:
+		return h.update.archiveRepo.ArchiveTask(ctx, update.completedTask, taskID, userID, update.existing.Version())
+	default:
+		return h.update.taskRepo.UpdateTask(ctx, update.updated)
+	}
+}
+
+type BatchDeleteTasksRequest struct {
+	SessionToken string
+	TaskIDs      []string
+}
+
+// BatchDeleteTaskResult is the outcome of deleting one task of a batch. Err is nil when the
+// task was deleted.
+type BatchDeleteTaskResult struct {
+	TaskID string
+	Err    error
+}
+
+type BatchDeleteTasksResult struct {
+	Results []BatchDeleteTaskResult // in request order
+}
+
+type BatchDeleteTasksUseCase interface {
+	BatchDeleteTasks(ctx context.Context, req *BatchDeleteTasksRequest) (*BatchDeleteTasksResult, error)
+}
+
+type batchDeleteTasksHandler struct {
+	authClient        authclient.AuthClient
+	taskRepo          domaintask.TaskRepository
+	cancelRemindQueue remindcancel.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
+	logger            *slog.Logger
+}
+
+func NewBatchDeleteTasksHandler(
+	authClient authclient.AuthClient,
+	taskRepo domaintask.TaskRepository,
+	cancelRemindQueue remindcancel.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) BatchDeleteTasksUseCase {
+	return &batchDeleteTasksHandler{
+		authClient:        authClient,
+		taskRepo:          taskRepo,
+		cancelRemindQueue: cancelRemindQueue,
+		transactor:        transactor,
+		publisher:         publisher,
+		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("batchdeletetasks"),
+	}
+}
+
+// BatchDeleteTasks validates the session once and deletes the tasks in a single transaction,
+// coalescing their reminder cancellations into one queue call. Invalid and missing tasks are
+// reported in their result without affecting the others.
+func (h *batchDeleteTasksHandler) BatchDeleteTasks(ctx context.Context, req *BatchDeleteTasksRequest) (*BatchDeleteTasksResult, error) {
+	if req == nil {
+		return nil, ErrBatchDeleteTasksRequestRequired
+	}
+
+	if err := validateBatchSize(len(req.TaskIDs)); err != nil {
+		h.logger.Warn("invalid batch size", slog.Int("size", len(req.TaskIDs)))
+
+		return nil, err
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchDeleteTaskResult, len(req.TaskIDs))
+	indexes := make(map[domaintask.ID]int, len(req.TaskIDs))
+	taskIDs := make([]domaintask.ID, 0, len(req.TaskIDs))
+
+	for i, taskIDstr := range req.TaskIDs {
+		results[i] = BatchDeleteTaskResult{TaskID: taskIDstr, Err: nil}
+
+		if taskIDstr == "" {
+			results[i].Err = ErrTaskIDRequired
+
+			continue
+		}
+
+		taskID, err := domaintask.NewIDFromString(taskIDstr)
+		if err != nil {
+			h.logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+			results[i].Err = err
+
+			continue
+		}
+
+		if _, ok := indexes[taskID]; ok {
+			results[i].Err = ErrDuplicateTaskInBatch
+
+			continue
+		}
+
+		indexes[taskID] = i
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	deleted := 0
+
+	if len(taskIDs) > 0 {
+		if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			cancelReqs := make([]*remindcancel.CancelRemindRequest, 0, len(taskIDs))
+
+			for _, taskID := range taskIDs {
+				if err := h.taskRepo.DeleteTask(ctx, taskID, userID); err != nil {
+					if errors.Is(err, domaintask.ErrTaskNotFound) {
+						h.logger.Info("task not found", slog.String("task_id", taskID.String()))
+
+						results[indexes[taskID]].Err = ErrTaskNotFound
+
+						continue
+					}
+
+					h.logger.Error("failed to delete task", slog.String("error", err.Error()))
+
+					return err
+				}
+
+				cancelReqs = append(cancelReqs, &remindcancel.CancelRemindRequest{
+					TaskID: taskID.String(),
+					UserID: userID.String(),
+				})
+			}
+
+			if err := remindcancel.CancelAll(ctx, h.cancelRemindQueue, cancelReqs); err != nil {
+				h.logger.Error("failed to cancel reminds",
+					slog.Int("task_count", len(cancelReqs)),
+					slog.String("error", err.Error()),
+				)
+
+				return ErrCancelRemindFailed
+			}
+
+			deleted = len(cancelReqs)
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	h.logger.Info("batch delete applied",
+		slog.Int("deleted", deleted),
+		slog.Int("failed", len(req.TaskIDs)-deleted),
+	)
+
+	for _, taskID := range taskIDs {
+		if results[indexes[taskID]].Err == nil {
+			publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeDeleted, userID, taskID)
+		}
+	}
+
+	return &BatchDeleteTasksResult{Results: results}, nil
+}
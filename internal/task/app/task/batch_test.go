@@ -0,0 +1,490 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+// batchCancelQueue is a cancel queue that also accepts batches, like the outbox queue.
+type batchCancelQueue struct {
+	*remindcancel.MockQueue
+	*remindcancel.MockBatchQueue
+}
+
+// countingTransactor runs the callback directly and counts the transactions it was asked for.
+type countingTransactor struct {
+	calls int
+}
+
+func (c *countingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	c.calls++
+
+	return fn(ctx)
+}
+
+func cancelledTaskIDs(reqs []*remindcancel.CancelRemindRequest) []string {
+	ids := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		ids = append(ids, req.TaskID)
+	}
+
+	return ids
+}
+
+func TestBatchUpdateTasksSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	scheduled := now.Add(2 * time.Hour)
+	newScheduled := now.Add(4 * time.Hour)
+	color := domaintask.MustColor("#FF6B6B")
+
+	newTask := func(title string, taskType domaintask.Type, scheduledAt *time.Time, targetAt time.Time) *domaintask.Task {
+		taskID, err := domaintask.NewID()
+		if err != nil {
+			t.Fatalf("failed to generate task id: %v", err)
+		}
+
+		task, err := domaintask.NewTask(taskID, userID, title, taskType, domaintask.StatusActive, "", scheduledAt, now, targetAt, color)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		return task
+	}
+
+	completing := newTask("Complete me", domaintask.TypeNear, nil, now.Add(time.Hour))
+	rescheduling := newTask("Move me", domaintask.TypeScheduled, &scheduled, scheduled)
+	renaming := newTask("Rename me", domaintask.TypeNear, nil, now.Add(time.Hour))
+
+	missingID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	fcmToken := "valid-fcm-token"
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil).Times(1)
+
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil).Times(1)
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), completing.ID(), userID).Return(completing, nil).Times(2)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), rescheduling.ID(), userID).Return(rescheduling, nil)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), renaming.ID(), userID).Return(renaming, nil)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), missingID, userID).Return(nil, domaintask.ErrTaskNotFound)
+
+	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockCancelQueue := batchCancelQueue{remindcancel.NewMockQueue(ctrl), remindcancel.NewMockBatchQueue(ctrl)}
+	mockRegisterQueue := remindregister.NewMockQueue(ctrl)
+
+	gomock.InOrder(
+		mockArchiveRepo.EXPECT().ArchiveTask(gomock.Any(), gomock.Any(), completing.ID(), userID, completing.Version()).Return(nil),
+		mockRepo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+				if task.ID() != rescheduling.ID() || !task.TargetAt().Equal(newScheduled) {
+					t.Errorf("expected rescheduled task at %v, got %s at %v", newScheduled, task.ID(), task.TargetAt())
+				}
+
+				return nil
+			}),
+		mockRepo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+				if task.ID() != renaming.ID() || task.Title() != "Renamed" {
+					t.Errorf("expected renamed task, got %s %q", task.ID(), task.Title())
+				}
+
+				return nil
+			}),
+		// The cancels of both tasks whose reminders change go out in one call
+		mockCancelQueue.MockBatchQueue.EXPECT().CancelReminds(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reqs []*remindcancel.CancelRemindRequest) error {
+				ids := cancelledTaskIDs(reqs)
+				if len(ids) != 2 || ids[0] != completing.ID().String() || ids[1] != rescheduling.ID().String() {
+					t.Errorf("unexpected cancels %v", ids)
+				}
+
+				return nil
+			}),
+		mockRegisterQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+				if req.TaskID != rescheduling.ID().String() {
+					t.Errorf("expected reminders of the rescheduled task, got %s", req.TaskID)
+				}
+
+				return &remindregister.RemindResponse{}, nil
+			}),
+	)
+
+	handler := NewBatchUpdateTasksHandler(mockAuth, mockDevice, mockRepo, mockArchiveRepo, nil, nil, mockRegisterQueue, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	completed := domaintask.StatusCompleted
+	title := "Renamed"
+
+	result, err := handler.BatchUpdateTasks(ctx, &BatchUpdateTasksRequest{
+		SessionToken: "token",
+		Updates: []UpdateTaskRequest{
+			{TaskID: completing.ID().String(), UpdateMask: []string{"task_status"}, TaskStatus: &completed},
+			{TaskID: rescheduling.ID().String(), UpdateMask: []string{"scheduled_at"}, ScheduledAt: &newScheduled},
+			{TaskID: missingID.String(), UpdateMask: []string{"title"}, Title: &title},
+			{TaskID: renaming.ID().String(), UpdateMask: []string{"title"}, Title: &title},
+			{TaskID: completing.ID().String(), UpdateMask: []string{"title"}, Title: &title},
+			{TaskID: renaming.ID().String()},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedErrs := []error{nil, nil, ErrTaskNotFound, nil, ErrDuplicateTaskInBatch, domaintask.ErrNoFieldsToUpdate}
+
+	if len(result.Results) != len(expectedErrs) {
+		t.Fatalf("expected %d results, got %d", len(expectedErrs), len(result.Results))
+	}
+
+	for i, expectedErr := range expectedErrs {
+		got := result.Results[i]
+
+		if expectedErr == nil {
+			if got.Err != nil || got.Task == nil {
+				t.Errorf("result %d: expected success, got %v", i, got.Err)
+			}
+
+			continue
+		}
+
+		if !errors.Is(got.Err, expectedErr) || got.Task != nil {
+			t.Errorf("result %d: expected error %v, got %v", i, expectedErr, got.Err)
+		}
+	}
+
+	if result.Results[0].Task.TaskStatus != domaintask.StatusCompleted {
+		t.Errorf("expected completed task, got %s", result.Results[0].Task.TaskStatus)
+	}
+
+	if result.Results[2].TaskID != missingID.String() {
+		t.Errorf("expected result to keep the requested task ID, got %s", result.Results[2].TaskID)
+	}
+}
+
+func TestBatchUpdateTasksPersistFailuresStayPerTask(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	color := domaintask.MustColor("#FF6B6B")
+
+	newTask := func(title string) *domaintask.Task {
+		taskID, err := domaintask.NewID()
+		if err != nil {
+			t.Fatalf("failed to generate task id: %v", err)
+		}
+
+		task, err := domaintask.NewTask(taskID, userID, title, domaintask.TypeNear, domaintask.StatusActive, "", nil, now, now.Add(time.Hour), color)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		return task
+	}
+
+	deleted := newTask("Deleted meanwhile")
+	conflicting := newTask("Edited meanwhile")
+	renaming := newTask("Rename me")
+
+	// The version the conflicting task is at after the concurrent edit
+	otherTitle := "Edited elsewhere"
+
+	current, err := conflicting.ApplyUpdate(&domaintask.TaskUpdateInput{Title: &otherTitle})
+	if err != nil {
+		t.Fatalf("failed to edit task: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), deleted.ID(), userID).Return(deleted, nil)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), renaming.ID(), userID).Return(renaming, nil)
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetTaskByID(gomock.Any(), conflicting.ID(), userID).Return(conflicting, nil),
+		mockRepo.EXPECT().GetTaskByID(gomock.Any(), conflicting.ID(), userID).Return(current, nil),
+	)
+
+	mockRepo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+			switch task.ID() {
+			case deleted.ID():
+				return domaintask.ErrTaskNotFound
+			case conflicting.ID():
+				return domaintask.ErrTaskVersionConflict
+			default:
+				return nil
+			}
+		}).Times(3)
+
+	savepoints := &countingTransactor{}
+
+	handler := NewBatchUpdateTasksHandler(mockAuth, NewMockDeviceClient(ctrl), mockRepo, domaintask.NewMockTaskArchiveRepository(ctrl), nil, nil, remindregister.NewMockQueue(ctrl), remindcancel.NewMockQueue(ctrl), savepoints, taskevent.NewNoopBroker())
+
+	title := "Renamed"
+
+	result, err := handler.BatchUpdateTasks(ctx, &BatchUpdateTasksRequest{
+		SessionToken: "token",
+		Updates: []UpdateTaskRequest{
+			{TaskID: deleted.ID().String(), UpdateMask: []string{"title"}, Title: &title},
+			{TaskID: conflicting.ID().String(), UpdateMask: []string{"title"}, Title: &title},
+			{TaskID: renaming.ID().String(), UpdateMask: []string{"title"}, Title: &title},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !errors.Is(result.Results[0].Err, ErrTaskNotFound) {
+		t.Errorf("expected ErrTaskNotFound for the deleted task, got %v", result.Results[0].Err)
+	}
+
+	var conflict *TaskVersionConflictError
+	if !errors.As(result.Results[1].Err, &conflict) {
+		t.Fatalf("expected TaskVersionConflictError for the edited task, got %v", result.Results[1].Err)
+	}
+
+	if conflict.Current.Version != current.Version() {
+		t.Errorf("expected current version %d, got %d", current.Version(), conflict.Current.Version)
+	}
+
+	if result.Results[2].Err != nil || result.Results[2].Task == nil || result.Results[2].Task.Title != title {
+		t.Errorf("expected the renamed task to be saved, got %+v", result.Results[2])
+	}
+
+	// The batch transaction plus one savepoint per task
+	if savepoints.calls != 4 {
+		t.Errorf("expected 4 transactions, got %d", savepoints.calls)
+	}
+}
+
+func TestBatchUpdateTasksError(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	existingTask, err := domaintask.NewTask(taskID, userID, "Task", domaintask.TypeNear, domaintask.StatusActive, "", nil, now, now.Add(time.Hour), domaintask.MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	title := "Renamed"
+	rename := UpdateTaskRequest{TaskID: taskID.String(), UpdateMask: []string{"title"}, Title: &title}
+
+	tests := []struct {
+		name        string
+		req         *BatchUpdateTasksRequest
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		setupRepo   func(ctrl *gomock.Controller) domaintask.TaskRepository
+		expectedErr error
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrBatchUpdateTasksRequestRequired,
+		},
+		{
+			name:        "empty batch",
+			req:         &BatchUpdateTasksRequest{SessionToken: "token"},
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrBatchEmpty,
+		},
+		{
+			name:        "too many updates",
+			req:         &BatchUpdateTasksRequest{SessionToken: "token", Updates: make([]UpdateTaskRequest, MaxBatchSize+1)},
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrBatchTooLarge,
+		},
+		{
+			name: "unauthorized session",
+			req:  &BatchUpdateTasksRequest{SessionToken: "bad-token", Updates: []UpdateTaskRequest{rename}},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "persist failure fails the whole batch",
+			req:  &BatchUpdateTasksRequest{SessionToken: "token", Updates: []UpdateTaskRequest{rename}},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				return mockAuth
+			},
+			setupRepo: func(ctrl *gomock.Controller) domaintask.TaskRepository {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, userID).Return(existingTask, nil)
+				mockRepo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+
+				return mockRepo
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var repo domaintask.TaskRepository = domaintask.NewMockTaskRepository(ctrl)
+			if tt.setupRepo != nil {
+				repo = tt.setupRepo(ctrl)
+			}
+
+			handler := NewBatchUpdateTasksHandler(
+				tt.setupAuth(ctrl),
+				NewMockDeviceClient(ctrl),
+				repo,
+				domaintask.NewMockTaskArchiveRepository(ctrl),
+				nil,
+				nil,
+				remindregister.NewMockQueue(ctrl),
+				remindcancel.NewMockQueue(ctrl),
+				inlineTransactor{},
+				taskevent.NewNoopBroker(),
+			)
+
+			result, err := handler.BatchUpdateTasks(ctx, tt.req)
+			if err == nil {
+				t.Fatalf("expected error %v, got results %+v", tt.expectedErr, result)
+			}
+
+			if !errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error() {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestBatchDeleteTasks(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	deletedID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	missingID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	t.Run("reports failures per task", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil).Times(1)
+
+		mockRepo := domaintask.NewMockTaskRepository(ctrl)
+		mockCancelQueue := batchCancelQueue{remindcancel.NewMockQueue(ctrl), remindcancel.NewMockBatchQueue(ctrl)}
+
+		gomock.InOrder(
+			mockRepo.EXPECT().DeleteTask(gomock.Any(), deletedID, userID).Return(nil),
+			mockRepo.EXPECT().DeleteTask(gomock.Any(), missingID, userID).Return(domaintask.ErrTaskNotFound),
+			mockCancelQueue.MockBatchQueue.EXPECT().CancelReminds(gomock.Any(), []*remindcancel.CancelRemindRequest{
+				{TaskID: deletedID.String(), UserID: userID.String()},
+			}).Return(nil),
+		)
+
+		handler := NewBatchDeleteTasksHandler(mockAuth, mockRepo, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+		result, err := handler.BatchDeleteTasks(ctx, &BatchDeleteTasksRequest{
+			SessionToken: "token",
+			TaskIDs:      []string{deletedID.String(), "not-a-uuid", missingID.String(), deletedID.String(), ""},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectedErrs := []error{nil, domaintask.ErrIDInvalidFormat, ErrTaskNotFound, ErrDuplicateTaskInBatch, ErrTaskIDRequired}
+
+		if len(result.Results) != len(expectedErrs) {
+			t.Fatalf("expected %d results, got %d", len(expectedErrs), len(result.Results))
+		}
+
+		for i, expectedErr := range expectedErrs {
+			got := result.Results[i].Err
+			if (expectedErr == nil && got != nil) || (expectedErr != nil && !errors.Is(got, expectedErr)) {
+				t.Errorf("result %d: expected error %v, got %v", i, expectedErr, got)
+			}
+		}
+	})
+
+	t.Run("cancel failure fails the whole batch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+		mockRepo := domaintask.NewMockTaskRepository(ctrl)
+		mockRepo.EXPECT().DeleteTask(gomock.Any(), deletedID, userID).Return(nil)
+
+		// Queues without batch support get one call per task
+		mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+		mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).Return(nil, errors.New("queue unavailable"))
+
+		handler := NewBatchDeleteTasksHandler(mockAuth, mockRepo, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+		_, err := handler.BatchDeleteTasks(ctx, &BatchDeleteTasksRequest{
+			SessionToken: "token",
+			TaskIDs:      []string{deletedID.String()},
+		})
+		if !errors.Is(err, ErrCancelRemindFailed) {
+			t.Fatalf("expected ErrCancelRemindFailed, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,322 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+)
+
+// maxCalendarFeedTasks caps the active and the completed tasks of a feed each.
+const maxCalendarFeedTasks = 1000
+
+type CalendarFeedTokenRequest struct {
+	SessionToken string
+}
+
+type CalendarFeedTokenResult struct {
+	// FeedToken is only handed out here; it cannot be retrieved later.
+	FeedToken string
+}
+
+type CreateCalendarFeedTokenUseCase interface {
+	CreateCalendarFeedToken(ctx context.Context, req *CalendarFeedTokenRequest) (*CalendarFeedTokenResult, error)
+}
+
+type RotateCalendarFeedTokenUseCase interface {
+	RotateCalendarFeedToken(ctx context.Context, req *CalendarFeedTokenRequest) (*CalendarFeedTokenResult, error)
+}
+
+type RevokeCalendarFeedTokenUseCase interface {
+	RevokeCalendarFeedToken(ctx context.Context, req *CalendarFeedTokenRequest) error
+}
+
+type calendarFeedTokenHandler struct {
+	authClient authclient.AuthClient
+	feedRepo   domaintask.CalendarFeedTokenRepository
+	logger     *slog.Logger
+}
+
+func newCalendarFeedTokenHandler(
+	authClient authclient.AuthClient,
+	feedRepo domaintask.CalendarFeedTokenRepository,
+	name string,
+) *calendarFeedTokenHandler {
+	return &calendarFeedTokenHandler{
+		authClient: authClient,
+		feedRepo:   feedRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup(name),
+	}
+}
+
+func NewCreateCalendarFeedTokenHandler(
+	authClient authclient.AuthClient,
+	feedRepo domaintask.CalendarFeedTokenRepository,
+) CreateCalendarFeedTokenUseCase {
+	return newCalendarFeedTokenHandler(authClient, feedRepo, "createcalendarfeedtoken")
+}
+
+func NewRotateCalendarFeedTokenHandler(
+	authClient authclient.AuthClient,
+	feedRepo domaintask.CalendarFeedTokenRepository,
+) RotateCalendarFeedTokenUseCase {
+	return newCalendarFeedTokenHandler(authClient, feedRepo, "rotatecalendarfeedtoken")
+}
+
+func NewRevokeCalendarFeedTokenHandler(
+	authClient authclient.AuthClient,
+	feedRepo domaintask.CalendarFeedTokenRepository,
+) RevokeCalendarFeedTokenUseCase {
+	return newCalendarFeedTokenHandler(authClient, feedRepo, "revokecalendarfeedtoken")
+}
+
+func (h *calendarFeedTokenHandler) CreateCalendarFeedToken(
+	ctx context.Context,
+	req *CalendarFeedTokenRequest,
+) (*CalendarFeedTokenResult, error) {
+	return h.issue(ctx, req, h.feedRepo.CreateCalendarFeedToken)
+}
+
+func (h *calendarFeedTokenHandler) RotateCalendarFeedToken(
+	ctx context.Context,
+	req *CalendarFeedTokenRequest,
+) (*CalendarFeedTokenResult, error) {
+	return h.issue(ctx, req, h.feedRepo.RotateCalendarFeedToken)
+}
+
+func (h *calendarFeedTokenHandler) issue(
+	ctx context.Context,
+	req *CalendarFeedTokenRequest,
+	save func(context.Context, *domaintask.CalendarFeedToken) error,
+) (*CalendarFeedTokenResult, error) {
+	if req == nil {
+		return nil, ErrCalendarFeedTokenRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	feedToken, token, err := domaintask.NewCalendarFeedToken(userID, time.Now())
+	if err != nil {
+		h.logger.Error("failed to generate calendar feed token", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if err := save(ctx, feedToken); err != nil {
+		if isCalendarFeedTokenStateError(err) {
+			h.logger.Info("calendar feed token not issued", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		h.logger.Error("failed to save calendar feed token", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	h.logger.Info("calendar feed token issued")
+
+	return &CalendarFeedTokenResult{FeedToken: token}, nil
+}
+
+func (h *calendarFeedTokenHandler) RevokeCalendarFeedToken(ctx context.Context, req *CalendarFeedTokenRequest) error {
+	if req == nil {
+		return ErrCalendarFeedTokenRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return err
+	}
+
+	if err := h.feedRepo.DeleteCalendarFeedToken(ctx, userID); err != nil {
+		if errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound) {
+			h.logger.Info("no calendar feed token to revoke")
+
+			return err
+		}
+
+		h.logger.Error("failed to revoke calendar feed token", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	h.logger.Info("calendar feed token revoked")
+
+	return nil
+}
+
+func isCalendarFeedTokenStateError(err error) bool {
+	return errors.Is(err, domaintask.ErrCalendarFeedTokenAlreadyExists) ||
+		errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound)
+}
+
+type GetCalendarFeedRequest struct {
+	FeedToken string
+}
+
+// GetCalendarFeedResult holds the user's active tasks by target time and the tasks they
+// completed within CalendarFeedCompletedWithin, most recent first.
+type GetCalendarFeedResult struct {
+	Tasks          []TaskItem
+	CompletedTasks []CompletedTaskItem
+}
+
+type GetCalendarFeedUseCase interface {
+	GetCalendarFeed(ctx context.Context, req *GetCalendarFeedRequest) (*GetCalendarFeedResult, error)
+}
+
+type getCalendarFeedHandler struct {
+	feedRepo    domaintask.CalendarFeedTokenRepository
+	taskRepo    domaintask.TaskRepository
+	archiveRepo domaintask.TaskArchiveRepository
+	logger      *slog.Logger
+}
+
+func NewGetCalendarFeedHandler(
+	feedRepo domaintask.CalendarFeedTokenRepository,
+	taskRepo domaintask.TaskRepository,
+	archiveRepo domaintask.TaskArchiveRepository,
+) GetCalendarFeedUseCase {
+	return &getCalendarFeedHandler{
+		feedRepo:    feedRepo,
+		taskRepo:    taskRepo,
+		archiveRepo: archiveRepo,
+		logger:      slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("getcalendarfeed"),
+	}
+}
+
+func (h *getCalendarFeedHandler) GetCalendarFeed(
+	ctx context.Context,
+	req *GetCalendarFeedRequest,
+) (*GetCalendarFeedResult, error) {
+	if req == nil {
+		return nil, ErrGetCalendarFeedRequestRequired
+	}
+
+	hash, err := domaintask.HashCalendarFeedToken(req.FeedToken)
+	if err != nil {
+		h.logger.Info("malformed calendar feed token")
+
+		return nil, ErrCalendarFeedTokenNotFound
+	}
+
+	userID, err := h.feedRepo.GetUserIDByCalendarFeedToken(ctx, hash)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound) {
+			h.logger.Info("unknown calendar feed token")
+
+			return nil, err
+		}
+
+		h.logger.Error("failed to look up calendar feed token", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	tasks, err := h.listActiveTasks(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to list active tasks", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	completedTasks, err := h.listRecentlyCompletedTasks(ctx, userID, time.Now().Add(-domaintask.CalendarFeedCompletedWithin))
+	if err != nil {
+		h.logger.Error("failed to list completed tasks", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	h.logger.Info("calendar feed served",
+		slog.Int("tasks", len(tasks)),
+		slog.Int("completed_tasks", len(completedTasks)),
+	)
+
+	return &GetCalendarFeedResult{
+		Tasks:          tasks,
+		CompletedTasks: completedTasks,
+	}, nil
+}
+
+func (h *getCalendarFeedHandler) listActiveTasks(ctx context.Context, userID domainuser.ID) ([]TaskItem, error) {
+	items := make([]TaskItem, 0)
+
+	var cursor *domaintask.PageCursor
+
+	for len(items) < maxCalendarFeedTasks {
+		tasks, next, err := h.taskRepo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType:      domaintask.SortTypeTargetAt,
+			SortDirection: domaintask.SortDirectionAsc,
+			Filter: domaintask.ActiveTaskFilter{
+				TaskType:     nil,
+				Color:        nil,
+				TargetAtFrom: nil,
+				TargetAtTo:   nil,
+				TagID:        nil,
+			},
+			PageSize: domaintask.MaxPageSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range tasks {
+			items = append(items, toTaskItem(task))
+		}
+
+		if next == nil {
+			break
+		}
+
+		cursor = next
+	}
+
+	return items[:min(len(items), maxCalendarFeedTasks)], nil
+}
+
+func (h *getCalendarFeedHandler) listRecentlyCompletedTasks(
+	ctx context.Context,
+	userID domainuser.ID,
+	since time.Time,
+) ([]CompletedTaskItem, error) {
+	items := make([]CompletedTaskItem, 0)
+
+	var cursor *domaintask.PageCursor
+
+	for len(items) < maxCalendarFeedTasks {
+		completedTasks, next, err := h.archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			Filter: domaintask.CompletedTaskFilter{
+				TaskType:        nil,
+				CompletedAtFrom: &since,
+				CompletedAtTo:   nil,
+				TagID:           nil,
+			},
+			PageSize: domaintask.MaxPageSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, completedTask := range completedTasks {
+			items = append(items, toCompletedTaskItem(completedTask))
+		}
+
+		if next == nil {
+			break
+		}
+
+		cursor = next
+	}
+
+	return items[:min(len(items), maxCalendarFeedTasks)], nil
+}
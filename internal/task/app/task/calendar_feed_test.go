@@ -0,0 +1,272 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCalendarFeedTokenSuccess(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	var saved *domaintask.CalendarFeedToken
+
+	mockRepo := domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+	mockRepo.EXPECT().
+		CreateCalendarFeedToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, token *domaintask.CalendarFeedToken) error {
+			saved = token
+
+			return nil
+		})
+
+	handler := NewCreateCalendarFeedTokenHandler(mockAuth, mockRepo)
+
+	result, err := handler.CreateCalendarFeedToken(context.Background(), &CalendarFeedTokenRequest{SessionToken: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash, err := domaintask.HashCalendarFeedToken(result.FeedToken)
+	if err != nil {
+		t.Fatalf("expected a valid feed token, got %v", err)
+	}
+
+	if saved.UserID() != userID || saved.Hash() != hash {
+		t.Errorf("expected the hash of the returned token to be saved for the user")
+	}
+}
+
+func TestCalendarFeedTokenError(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		req         *CalendarFeedTokenRequest
+		setup       func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockCalendarFeedTokenRepository)
+		call        func(ctx context.Context, auth *MockAuthClient, repo *domaintask.MockCalendarFeedTokenRepository, req *CalendarFeedTokenRequest) error
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockCalendarFeedTokenRepository) {
+				return NewMockAuthClient(ctrl), domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+			},
+			call:        createCalendarFeedToken,
+			expectedErr: ErrCalendarFeedTokenRequestRequired,
+		},
+		{
+			name: "unauthorized",
+			req:  &CalendarFeedTokenRequest{SessionToken: "bad-token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockCalendarFeedTokenRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").Return("", authclient.ErrUnauthorized)
+
+				return mockAuth, domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+			},
+			call:        createCalendarFeedToken,
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "create when a token exists",
+			req:  &CalendarFeedTokenRequest{SessionToken: "token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockCalendarFeedTokenRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				mockRepo := domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+				mockRepo.EXPECT().CreateCalendarFeedToken(gomock.Any(), gomock.Any()).Return(domaintask.ErrCalendarFeedTokenAlreadyExists)
+
+				return mockAuth, mockRepo
+			},
+			call:        createCalendarFeedToken,
+			expectedErr: ErrCalendarFeedTokenAlreadyExists,
+		},
+		{
+			name: "rotate without a token",
+			req:  &CalendarFeedTokenRequest{SessionToken: "token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockCalendarFeedTokenRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				mockRepo := domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+				mockRepo.EXPECT().RotateCalendarFeedToken(gomock.Any(), gomock.Any()).Return(domaintask.ErrCalendarFeedTokenNotFound)
+
+				return mockAuth, mockRepo
+			},
+			call: func(ctx context.Context, auth *MockAuthClient, repo *domaintask.MockCalendarFeedTokenRepository, req *CalendarFeedTokenRequest) error {
+				_, err := NewRotateCalendarFeedTokenHandler(auth, repo).RotateCalendarFeedToken(ctx, req)
+
+				return err
+			},
+			expectedErr: ErrCalendarFeedTokenNotFound,
+		},
+		{
+			name: "revoke without a token",
+			req:  &CalendarFeedTokenRequest{SessionToken: "token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockCalendarFeedTokenRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				mockRepo := domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+				mockRepo.EXPECT().DeleteCalendarFeedToken(gomock.Any(), userID).Return(domaintask.ErrCalendarFeedTokenNotFound)
+
+				return mockAuth, mockRepo
+			},
+			call: func(ctx context.Context, auth *MockAuthClient, repo *domaintask.MockCalendarFeedTokenRepository, req *CalendarFeedTokenRequest) error {
+				return NewRevokeCalendarFeedTokenHandler(auth, repo).RevokeCalendarFeedToken(ctx, req)
+			},
+			expectedErr: ErrCalendarFeedTokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth, mockRepo := tt.setup(ctrl)
+
+			err := tt.call(context.Background(), mockAuth, mockRepo, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func createCalendarFeedToken(
+	ctx context.Context,
+	auth *MockAuthClient,
+	repo *domaintask.MockCalendarFeedTokenRepository,
+	req *CalendarFeedTokenRequest,
+) error {
+	_, err := NewCreateCalendarFeedTokenHandler(auth, repo).CreateCalendarFeedToken(ctx, req)
+
+	return err
+}
+
+func TestGetCalendarFeedSuccess(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	feedToken, token, err := domaintask.NewCalendarFeedToken(userID, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create feed token: %v", err)
+	}
+
+	active := newTestDeletedTask(t, userID, time.Now().Add(time.Hour)).Task()
+	completed := newTestCompletedTask(t, userID, time.Now().Add(-time.Hour))
+
+	ctrl := gomock.NewController(t)
+
+	mockFeedRepo := domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+	mockFeedRepo.EXPECT().GetUserIDByCalendarFeedToken(gomock.Any(), feedToken.Hash()).Return(userID, nil)
+
+	mockTaskRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockTaskRepo.EXPECT().
+		ListActiveTasksByUserID(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domainuser.ID, query domaintask.ListActiveTasksQuery) ([]*domaintask.Task, *domaintask.PageCursor, error) {
+			if query.SortType != domaintask.SortTypeTargetAt || query.PageSize != domaintask.MaxPageSize {
+				t.Errorf("unexpected active task query %+v", query)
+			}
+
+			return []*domaintask.Task{active}, nil, nil
+		})
+
+	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchiveRepo.EXPECT().
+		ListCompletedTasksByUserID(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domainuser.ID, query domaintask.ListCompletedTasksQuery) ([]*domaintask.CompletedTask, *domaintask.PageCursor, error) {
+			since := time.Now().Add(-domaintask.CalendarFeedCompletedWithin)
+			if from := query.Filter.CompletedAtFrom; from == nil || from.Sub(since).Abs() > time.Minute {
+				t.Errorf("expected completed tasks since %v, got %v", since, from)
+			}
+
+			return []*domaintask.CompletedTask{completed}, nil, nil
+		})
+
+	handler := NewGetCalendarFeedHandler(mockFeedRepo, mockTaskRepo, mockArchiveRepo)
+
+	result, err := handler.GetCalendarFeed(context.Background(), &GetCalendarFeedRequest{FeedToken: token})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Tasks) != 1 || result.Tasks[0].TaskID != active.ID().String() {
+		t.Errorf("expected the active task, got %+v", result.Tasks)
+	}
+
+	if len(result.CompletedTasks) != 1 || result.CompletedTasks[0].TaskID != completed.ID().String() {
+		t.Errorf("expected the completed task, got %+v", result.CompletedTasks)
+	}
+}
+
+func TestGetCalendarFeedError(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *GetCalendarFeedRequest
+		setup       func(repo *domaintask.MockCalendarFeedTokenRepository)
+		expectedErr error
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			setup:       func(*domaintask.MockCalendarFeedTokenRepository) {},
+			expectedErr: ErrGetCalendarFeedRequestRequired,
+		},
+		{
+			name:        "malformed token",
+			req:         &GetCalendarFeedRequest{FeedToken: "not-a-token"},
+			setup:       func(*domaintask.MockCalendarFeedTokenRepository) {},
+			expectedErr: ErrCalendarFeedTokenNotFound,
+		},
+		{
+			name: "unknown token",
+			req:  &GetCalendarFeedRequest{FeedToken: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"},
+			setup: func(repo *domaintask.MockCalendarFeedTokenRepository) {
+				repo.EXPECT().GetUserIDByCalendarFeedToken(gomock.Any(), gomock.Any()).Return(domainuser.ID{}, domaintask.ErrCalendarFeedTokenNotFound)
+			},
+			expectedErr: ErrCalendarFeedTokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockFeedRepo := domaintask.NewMockCalendarFeedTokenRepository(ctrl)
+			tt.setup(mockFeedRepo)
+
+			handler := NewGetCalendarFeedHandler(
+				mockFeedRepo,
+				domaintask.NewMockTaskRepository(ctrl),
+				domaintask.NewMockTaskArchiveRepository(ctrl),
+			)
+
+			_, err := handler.GetCalendarFeed(context.Background(), tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
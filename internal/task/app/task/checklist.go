@@ -0,0 +1,589 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+)
+
+type ChecklistItem struct {
+	ItemID   string
+	Text     string
+	Done     bool
+	Position int
+}
+
+func toChecklistItems(items []*domaintask.ChecklistItem) []ChecklistItem {
+	result := make([]ChecklistItem, 0, len(items))
+
+	for _, item := range items {
+		result = append(result, ChecklistItem{
+			ItemID:   item.ID().String(),
+			Text:     item.Text(),
+			Done:     item.Done(),
+			Position: item.Position(),
+		})
+	}
+
+	return result
+}
+
+type ChecklistProgress struct {
+	Total int
+	Done  int
+}
+
+func toChecklistProgress(progress domaintask.ChecklistProgress) ChecklistProgress {
+	return ChecklistProgress{
+		Total: progress.Total(),
+		Done:  progress.Done(),
+	}
+}
+
+// ChecklistResult is the whole checklist of a task after a change, ordered by position.
+type ChecklistResult struct {
+	TaskID   string
+	Items    []ChecklistItem
+	Progress ChecklistProgress
+}
+
+func toChecklistResult(taskID domaintask.ID, items []*domaintask.ChecklistItem) *ChecklistResult {
+	return &ChecklistResult{
+		TaskID:   taskID.String(),
+		Items:    toChecklistItems(items),
+		Progress: toChecklistProgress(domaintask.ChecklistProgressOf(items)),
+	}
+}
+
+// resolveUser validates the session and returns the ID of its user.
+func resolveUser(
+	ctx context.Context,
+	authClient authclient.AuthClient,
+	logger *slog.Logger,
+	sessionToken string,
+) (domainuser.ID, error) {
+	userIDstr, err := authClient.ValidateSession(ctx, sessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return domainuser.ID{}, ErrUnauthorized
+		}
+
+		logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, err
+	}
+
+	return userID, nil
+}
+
+// resolveUserTask validates the session and the ID of the task whose checklist or tags
+// are being accessed.
+func resolveUserTask(
+	ctx context.Context,
+	authClient authclient.AuthClient,
+	logger *slog.Logger,
+	sessionToken string,
+	taskIDstr string,
+) (domainuser.ID, domaintask.ID, error) {
+	userIDstr, err := authClient.ValidateSession(ctx, sessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return domainuser.ID{}, domaintask.ID{}, ErrUnauthorized
+		}
+
+		logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, domaintask.ID{}, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, domaintask.ID{}, err
+	}
+
+	if taskIDstr == "" {
+		logger.Warn("task accessed with empty task ID")
+
+		return domainuser.ID{}, domaintask.ID{}, ErrTaskIDRequired
+	}
+
+	taskID, err := domaintask.NewIDFromString(taskIDstr)
+	if err != nil {
+		logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+		return domainuser.ID{}, domaintask.ID{}, err
+	}
+
+	return userID, taskID, nil
+}
+
+// mapChecklistError turns repository errors into use case errors, logging unexpected ones.
+func mapChecklistError(logger *slog.Logger, taskID domaintask.ID, err error, msg string) error {
+	switch {
+	case errors.Is(err, domaintask.ErrTaskNotFound):
+		logger.Info("task not found", slog.String("task_id", taskID.String()))
+
+		return ErrTaskNotFound
+	case errors.Is(err, domaintask.ErrChecklistItemNotFound):
+		logger.Info("checklist item not found", slog.String("task_id", taskID.String()))
+
+		return ErrChecklistItemNotFound
+	default:
+		logger.Error(msg, slog.String("error", err.Error()))
+
+		return err
+	}
+}
+
+type ListChecklistItemsRequest struct {
+	SessionToken string
+	TaskID       string
+}
+
+type ListChecklistItemsUseCase interface {
+	ListChecklistItems(ctx context.Context, req *ListChecklistItemsRequest) (*ChecklistResult, error)
+}
+
+type listChecklistItemsHandler struct {
+	authClient    authclient.AuthClient
+	checklistRepo domaintask.ChecklistRepository
+	logger        *slog.Logger
+}
+
+func NewListChecklistItemsHandler(
+	authClient authclient.AuthClient,
+	checklistRepo domaintask.ChecklistRepository,
+) ListChecklistItemsUseCase {
+	return &listChecklistItemsHandler{
+		authClient:    authClient,
+		checklistRepo: checklistRepo,
+		logger:        slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("listchecklistitems"),
+	}
+}
+
+func (h *listChecklistItemsHandler) ListChecklistItems(
+	ctx context.Context,
+	req *ListChecklistItemsRequest,
+) (*ChecklistResult, error) {
+	if req == nil {
+		return nil, ErrListChecklistItemsRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.checklistRepo.ListChecklistItems(ctx, taskID, userID)
+	if err != nil {
+		return nil, mapChecklistError(h.logger, taskID, err, "failed to list checklist items")
+	}
+
+	return toChecklistResult(taskID, items), nil
+}
+
+type AddChecklistItemRequest struct {
+	SessionToken string
+	TaskID       string
+	Text         string
+}
+
+type AddChecklistItemUseCase interface {
+	AddChecklistItem(ctx context.Context, req *AddChecklistItemRequest) (*ChecklistResult, error)
+}
+
+type addChecklistItemHandler struct {
+	authClient    authclient.AuthClient
+	checklistRepo domaintask.ChecklistRepository
+	transactor    domaintask.Transactor
+	publisher     taskevent.Publisher
+	logger        *slog.Logger
+}
+
+func NewAddChecklistItemHandler(
+	authClient authclient.AuthClient,
+	checklistRepo domaintask.ChecklistRepository,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) AddChecklistItemUseCase {
+	return &addChecklistItemHandler{
+		authClient:    authClient,
+		checklistRepo: checklistRepo,
+		transactor:    transactor,
+		publisher:     publisher,
+		logger:        slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("addchecklistitem"),
+	}
+}
+
+func (h *addChecklistItemHandler) AddChecklistItem(
+	ctx context.Context,
+	req *AddChecklistItemRequest,
+) (*ChecklistResult, error) {
+	if req == nil {
+		return nil, ErrAddChecklistItemRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemID, err := domaintask.NewChecklistItemID()
+	if err != nil {
+		h.logger.Error("failed to generate checklist item ID", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	var items []*domaintask.ChecklistItem
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := h.checklistRepo.ListChecklistItems(ctx, taskID, userID)
+		if err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to list checklist items")
+		}
+
+		if len(existing) >= domaintask.MaxChecklistItems {
+			h.logger.Info("checklist is full", slog.String("task_id", taskID.String()))
+
+			return ErrChecklistFull
+		}
+
+		// New items are appended to the end of the checklist
+		item, err := domaintask.NewChecklistItem(itemID, taskID, req.Text, false, len(existing))
+		if err != nil {
+			h.logger.Warn("invalid checklist item", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		if err := h.checklistRepo.SaveChecklistItem(ctx, item, userID); err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to save checklist item")
+		}
+
+		items = append(existing, item)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, taskID)
+
+	h.logger.Info("checklist item added",
+		slog.String("task_id", taskID.String()),
+		slog.String("item_id", itemID.String()),
+	)
+
+	return toChecklistResult(taskID, items), nil
+}
+
+type ToggleChecklistItemRequest struct {
+	SessionToken string
+	TaskID       string
+	ItemID       string
+	// Done is the state the item is switched to, so that repeating a request is harmless
+	Done bool
+}
+
+type ToggleChecklistItemUseCase interface {
+	ToggleChecklistItem(ctx context.Context, req *ToggleChecklistItemRequest) (*ChecklistResult, error)
+}
+
+type toggleChecklistItemHandler struct {
+	authClient    authclient.AuthClient
+	checklistRepo domaintask.ChecklistRepository
+	transactor    domaintask.Transactor
+	publisher     taskevent.Publisher
+	logger        *slog.Logger
+}
+
+func NewToggleChecklistItemHandler(
+	authClient authclient.AuthClient,
+	checklistRepo domaintask.ChecklistRepository,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) ToggleChecklistItemUseCase {
+	return &toggleChecklistItemHandler{
+		authClient:    authClient,
+		checklistRepo: checklistRepo,
+		transactor:    transactor,
+		publisher:     publisher,
+		logger:        slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("togglechecklistitem"),
+	}
+}
+
+func (h *toggleChecklistItemHandler) ToggleChecklistItem(
+	ctx context.Context,
+	req *ToggleChecklistItemRequest,
+) (*ChecklistResult, error) {
+	if req == nil {
+		return nil, ErrToggleChecklistItemRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemID, err := parseChecklistItemID(h.logger, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*domaintask.ChecklistItem
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := h.checklistRepo.ListChecklistItems(ctx, taskID, userID)
+		if err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to list checklist items")
+		}
+
+		for i, item := range existing {
+			if item.ID() != itemID {
+				continue
+			}
+
+			toggled := item.SetDone(req.Done)
+
+			if err := h.checklistRepo.UpdateChecklistItemDone(ctx, toggled, userID); err != nil {
+				return mapChecklistError(h.logger, taskID, err, "failed to update checklist item")
+			}
+
+			existing[i] = toggled
+			items = existing
+
+			return nil
+		}
+
+		h.logger.Info("checklist item not found",
+			slog.String("task_id", taskID.String()),
+			slog.String("item_id", req.ItemID),
+		)
+
+		return ErrChecklistItemNotFound
+	}); err != nil {
+		return nil, err
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, taskID)
+
+	h.logger.Info("checklist item toggled",
+		slog.String("task_id", taskID.String()),
+		slog.String("item_id", req.ItemID),
+		slog.Bool("done", req.Done),
+	)
+
+	return toChecklistResult(taskID, items), nil
+}
+
+type ReorderChecklistItemsRequest struct {
+	SessionToken string
+	TaskID       string
+	// ItemIDs lists every item of the checklist in its new order
+	ItemIDs []string
+}
+
+type ReorderChecklistItemsUseCase interface {
+	ReorderChecklistItems(ctx context.Context, req *ReorderChecklistItemsRequest) (*ChecklistResult, error)
+}
+
+type reorderChecklistItemsHandler struct {
+	authClient    authclient.AuthClient
+	checklistRepo domaintask.ChecklistRepository
+	transactor    domaintask.Transactor
+	publisher     taskevent.Publisher
+	logger        *slog.Logger
+}
+
+func NewReorderChecklistItemsHandler(
+	authClient authclient.AuthClient,
+	checklistRepo domaintask.ChecklistRepository,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) ReorderChecklistItemsUseCase {
+	return &reorderChecklistItemsHandler{
+		authClient:    authClient,
+		checklistRepo: checklistRepo,
+		transactor:    transactor,
+		publisher:     publisher,
+		logger:        slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("reorderchecklistitems"),
+	}
+}
+
+func (h *reorderChecklistItemsHandler) ReorderChecklistItems(
+	ctx context.Context,
+	req *ReorderChecklistItemsRequest,
+) (*ChecklistResult, error) {
+	if req == nil {
+		return nil, ErrReorderChecklistItemsRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]domaintask.ChecklistItemID, 0, len(req.ItemIDs))
+
+	for _, id := range req.ItemIDs {
+		itemID, err := parseChecklistItemID(h.logger, id)
+		if err != nil {
+			return nil, err
+		}
+
+		order = append(order, itemID)
+	}
+
+	var items []*domaintask.ChecklistItem
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := h.checklistRepo.ListChecklistItems(ctx, taskID, userID)
+		if err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to list checklist items")
+		}
+
+		reordered, err := domaintask.ReorderChecklist(existing, order)
+		if err != nil {
+			h.logger.Info("checklist order does not match the checklist",
+				slog.String("task_id", taskID.String()),
+				slog.Int("item_count", len(existing)),
+				slog.Int("order_count", len(order)),
+			)
+
+			return err
+		}
+
+		if err := h.checklistRepo.UpdateChecklistPositions(ctx, taskID, userID, reordered); err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to update checklist positions")
+		}
+
+		items = reordered
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, taskID)
+
+	h.logger.Info("checklist reordered", slog.String("task_id", taskID.String()))
+
+	return toChecklistResult(taskID, items), nil
+}
+
+type DeleteChecklistItemRequest struct {
+	SessionToken string
+	TaskID       string
+	ItemID       string
+}
+
+type DeleteChecklistItemUseCase interface {
+	DeleteChecklistItem(ctx context.Context, req *DeleteChecklistItemRequest) (*ChecklistResult, error)
+}
+
+type deleteChecklistItemHandler struct {
+	authClient    authclient.AuthClient
+	checklistRepo domaintask.ChecklistRepository
+	transactor    domaintask.Transactor
+	publisher     taskevent.Publisher
+	logger        *slog.Logger
+}
+
+func NewDeleteChecklistItemHandler(
+	authClient authclient.AuthClient,
+	checklistRepo domaintask.ChecklistRepository,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) DeleteChecklistItemUseCase {
+	return &deleteChecklistItemHandler{
+		authClient:    authClient,
+		checklistRepo: checklistRepo,
+		transactor:    transactor,
+		publisher:     publisher,
+		logger:        slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("deletechecklistitem"),
+	}
+}
+
+func (h *deleteChecklistItemHandler) DeleteChecklistItem(
+	ctx context.Context,
+	req *DeleteChecklistItemRequest,
+) (*ChecklistResult, error) {
+	if req == nil {
+		return nil, ErrDeleteChecklistItemRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemID, err := parseChecklistItemID(h.logger, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*domaintask.ChecklistItem
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.checklistRepo.DeleteChecklistItem(ctx, taskID, itemID, userID); err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to delete checklist item")
+		}
+
+		remaining, err := h.checklistRepo.ListChecklistItems(ctx, taskID, userID)
+		if err != nil {
+			return mapChecklistError(h.logger, taskID, err, "failed to list checklist items")
+		}
+
+		items = remaining
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, taskID)
+
+	h.logger.Info("checklist item deleted",
+		slog.String("task_id", taskID.String()),
+		slog.String("item_id", req.ItemID),
+	)
+
+	return toChecklistResult(taskID, items), nil
+}
+
+func parseChecklistItemID(logger *slog.Logger, id string) (domaintask.ChecklistItemID, error) {
+	if id == "" {
+		logger.Warn("checklist item ID is empty")
+
+		return domaintask.ChecklistItemID{}, ErrChecklistItemIDRequired
+	}
+
+	itemID, err := domaintask.NewChecklistItemIDFromString(id)
+	if err != nil {
+		logger.Warn("invalid checklist item ID format", slog.String("error", err.Error()))
+
+		return domaintask.ChecklistItemID{}, err
+	}
+
+	return itemID, nil
+}
@@ -0,0 +1,439 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+// expectTaskUpdated returns a publisher that expects one update event for the task.
+func expectTaskUpdated(t *testing.T, ctrl *gomock.Controller, userID domainuser.ID, taskID domaintask.ID) taskevent.Publisher {
+	t.Helper()
+
+	publisher := taskevent.NewMockPublisher(ctrl)
+	publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event taskevent.Event) error {
+			if event.Type != taskevent.TypeUpdated || event.TaskID != taskID.String() || event.UserID != userID.String() {
+				t.Fatalf("unexpected event %+v", event)
+			}
+
+			return nil
+		})
+
+	return publisher
+}
+
+func newChecklistItems(t *testing.T, taskID domaintask.ID, texts ...string) []*domaintask.ChecklistItem {
+	t.Helper()
+
+	items := make([]*domaintask.ChecklistItem, 0, len(texts))
+
+	for position, text := range texts {
+		id, err := domaintask.NewChecklistItemID()
+		if err != nil {
+			t.Fatalf("failed to generate checklist item id: %v", err)
+		}
+
+		item, err := domaintask.NewChecklistItem(id, taskID, text, false, position)
+		if err != nil {
+			t.Fatalf("failed to create checklist item: %v", err)
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func TestAddChecklistItemSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	existing := newChecklistItems(t, taskID, "pack lunch")
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+	mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, userID).Return(existing, nil)
+	mockRepo.EXPECT().SaveChecklistItem(gomock.Any(), gomock.Any(), userID).
+		DoAndReturn(func(_ context.Context, item *domaintask.ChecklistItem, _ domainuser.ID) error {
+			if item.Text() != "water plants" || item.Position() != 1 || item.Done() {
+				t.Fatalf("expected unchecked item appended at position 1, got %q at %d", item.Text(), item.Position())
+			}
+
+			return nil
+		})
+
+	handler := NewAddChecklistItemHandler(mockAuth, mockRepo, inlineTransactor{}, expectTaskUpdated(t, ctrl, userID, taskID))
+
+	result, err := handler.AddChecklistItem(ctx, &AddChecklistItemRequest{
+		SessionToken: "valid-token",
+		TaskID:       taskID.String(),
+		Text:         " water plants ",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Items) != 2 || result.Items[1].Text != "water plants" {
+		t.Fatalf("expected new item at the end, got %+v", result.Items)
+	}
+
+	if result.Progress.Total != 2 || result.Progress.Done != 0 {
+		t.Errorf("expected 0 of 2 done, got %d of %d", result.Progress.Done, result.Progress.Total)
+	}
+}
+
+func TestAddChecklistItemFull(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	texts := make([]string, domaintask.MaxChecklistItems)
+	for i := range texts {
+		texts[i] = "item"
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+	mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, userID).Return(newChecklistItems(t, taskID, texts...), nil)
+
+	handler := NewAddChecklistItemHandler(mockAuth, mockRepo, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	_, err = handler.AddChecklistItem(ctx, &AddChecklistItemRequest{
+		SessionToken: "valid-token",
+		TaskID:       taskID.String(),
+		Text:         "one more",
+	})
+	if !errors.Is(err, ErrChecklistFull) {
+		t.Fatalf("expected ErrChecklistFull, got %v", err)
+	}
+}
+
+func TestToggleChecklistItemSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	items := newChecklistItems(t, taskID, "pack lunch", "water plants")
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+	mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, userID).Return(items, nil)
+	mockRepo.EXPECT().UpdateChecklistItemDone(gomock.Any(), gomock.Any(), userID).
+		DoAndReturn(func(_ context.Context, item *domaintask.ChecklistItem, _ domainuser.ID) error {
+			if item.ID() != items[1].ID() || !item.Done() {
+				t.Fatalf("expected second item to be checked")
+			}
+
+			return nil
+		})
+
+	handler := NewToggleChecklistItemHandler(mockAuth, mockRepo, inlineTransactor{}, expectTaskUpdated(t, ctrl, userID, taskID))
+
+	result, err := handler.ToggleChecklistItem(ctx, &ToggleChecklistItemRequest{
+		SessionToken: "valid-token",
+		TaskID:       taskID.String(),
+		ItemID:       items[1].ID().String(),
+		Done:         true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.Items[1].Done || result.Progress.Done != 1 {
+		t.Errorf("expected second item done and progress 1, got %+v", result)
+	}
+}
+
+func TestReorderChecklistItemsSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	items := newChecklistItems(t, taskID, "first", "second")
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+	mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, userID).Return(items, nil)
+	mockRepo.EXPECT().UpdateChecklistPositions(gomock.Any(), taskID, userID, gomock.Any()).Return(nil)
+
+	handler := NewReorderChecklistItemsHandler(mockAuth, mockRepo, inlineTransactor{}, expectTaskUpdated(t, ctrl, userID, taskID))
+
+	result, err := handler.ReorderChecklistItems(ctx, &ReorderChecklistItemsRequest{
+		SessionToken: "valid-token",
+		TaskID:       taskID.String(),
+		ItemIDs:      []string{items[1].ID().String(), items[0].ID().String()},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Items[0].Text != "second" || result.Items[0].Position != 0 || result.Items[1].Position != 1 {
+		t.Errorf("expected second item first, got %+v", result.Items)
+	}
+}
+
+func TestChecklistError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	itemID, err := domaintask.NewChecklistItemID()
+	if err != nil {
+		t.Fatalf("failed to generate checklist item id: %v", err)
+	}
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	tests := []struct {
+		name        string
+		call        func(authclient.AuthClient, domaintask.ChecklistRepository) error
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		setupRepo   func(ctrl *gomock.Controller) domaintask.ChecklistRepository
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewAddChecklistItemHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).AddChecklistItem(ctx, nil)
+
+				return err
+			},
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrAddChecklistItemRequestRequired,
+		},
+		{
+			name: "unauthorized session",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewListChecklistItemsHandler(auth, repo).ListChecklistItems(ctx, &ListChecklistItemsRequest{
+					SessionToken: "bad-token",
+					TaskID:       taskID.String(),
+				})
+
+				return err
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "empty task ID",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewListChecklistItemsHandler(auth, repo).ListChecklistItems(ctx, &ListChecklistItemsRequest{
+					SessionToken: "valid-token",
+				})
+
+				return err
+			},
+			setupAuth:   validAuth,
+			expectedErr: ErrTaskIDRequired,
+		},
+		{
+			name: "empty item text",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewAddChecklistItemHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).AddChecklistItem(ctx, &AddChecklistItemRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+					Text:         "  ",
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.ChecklistRepository {
+				mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+				mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, validUserID).Return(nil, nil)
+
+				return mockRepo
+			},
+			expectedErr: ErrChecklistItemTextEmpty,
+		},
+		{
+			name: "task not found",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewListChecklistItemsHandler(auth, repo).ListChecklistItems(ctx, &ListChecklistItemsRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.ChecklistRepository {
+				mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+				mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, validUserID).
+					Return(nil, domaintask.ErrTaskNotFound)
+
+				return mockRepo
+			},
+			expectedErr: ErrTaskNotFound,
+		},
+		{
+			name: "empty item ID",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewToggleChecklistItemHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).ToggleChecklistItem(ctx, &ToggleChecklistItemRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+				})
+
+				return err
+			},
+			setupAuth:   validAuth,
+			expectedErr: ErrChecklistItemIDRequired,
+		},
+		{
+			name: "toggled item not in checklist",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewToggleChecklistItemHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).ToggleChecklistItem(ctx, &ToggleChecklistItemRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+					ItemID:       itemID.String(),
+					Done:         true,
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.ChecklistRepository {
+				mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+				mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, validUserID).
+					Return(newChecklistItems(t, taskID, "pack lunch"), nil)
+
+				return mockRepo
+			},
+			expectedErr: ErrChecklistItemNotFound,
+		},
+		{
+			name: "reorder does not list every item",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewReorderChecklistItemsHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).ReorderChecklistItems(ctx, &ReorderChecklistItemsRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+					ItemIDs:      []string{itemID.String()},
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.ChecklistRepository {
+				mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+				mockRepo.EXPECT().ListChecklistItems(gomock.Any(), taskID, validUserID).
+					Return(newChecklistItems(t, taskID, "first", "second"), nil)
+
+				return mockRepo
+			},
+			expectedErr: ErrChecklistOrderMismatch,
+		},
+		{
+			name: "deleted item not found",
+			call: func(auth authclient.AuthClient, repo domaintask.ChecklistRepository) error {
+				_, err := NewDeleteChecklistItemHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).DeleteChecklistItem(ctx, &DeleteChecklistItemRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+					ItemID:       itemID.String(),
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.ChecklistRepository {
+				mockRepo := domaintask.NewMockChecklistRepository(ctrl)
+				mockRepo.EXPECT().DeleteChecklistItem(gomock.Any(), taskID, itemID, validUserID).
+					Return(domaintask.ErrChecklistItemNotFound)
+
+				return mockRepo
+			},
+			expectedErr: ErrChecklistItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var repo domaintask.ChecklistRepository
+			if tt.setupRepo != nil {
+				repo = tt.setupRepo(ctrl)
+			}
+
+			err := tt.call(tt.setupAuth(ctrl), repo)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
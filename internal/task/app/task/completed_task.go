@@ -0,0 +1,485 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+)
+
+type CompletedTaskItem struct {
+	TaskID      string
+	Title       string
+	TaskType    domaintask.Type
+	Description string
+	ScheduledAt *time.Time
+	CreatedAt   time.Time
+	TargetAt    time.Time
+	Color       string
+	CompletedAt time.Time
+	// Checklist is the task's checklist as it was when the task was completed
+	Checklist []ChecklistItem
+	Tags      []TagItem
+}
+
+func toCompletedTaskItem(completedTask *domaintask.CompletedTask) CompletedTaskItem {
+	return CompletedTaskItem{
+		TaskID:      completedTask.ID().String(),
+		Title:       completedTask.Title(),
+		TaskType:    completedTask.TaskType(),
+		Description: completedTask.Description(),
+		ScheduledAt: completedTask.ScheduledAt(),
+		CreatedAt:   completedTask.CreatedAt(),
+		TargetAt:    completedTask.TargetAt(),
+		Color:       completedTask.Color().String(),
+		CompletedAt: completedTask.CompletedAt(),
+		Checklist:   toChecklistItems(completedTask.Checklist()),
+		Tags:        toTagItems(completedTask.Tags()),
+	}
+}
+
+type ListCompletedTasksRequest struct {
+	SessionToken    string
+	PageSize        int
+	PageToken       string
+	TaskType        *domaintask.Type
+	CompletedAtFrom *time.Time
+	CompletedAtTo   *time.Time
+	TagID           *string
+}
+
+type ListCompletedTasksResult struct {
+	CompletedTasks []CompletedTaskItem
+	NextPageToken  string
+}
+
+type ListCompletedTasksUseCase interface {
+	ListCompletedTasks(ctx context.Context, req *ListCompletedTasksRequest) (*ListCompletedTasksResult, error)
+}
+
+type listCompletedTasksHandler struct {
+	authClient  authclient.AuthClient
+	archiveRepo domaintask.TaskArchiveRepository
+	logger      *slog.Logger
+}
+
+func NewListCompletedTasksHandler(
+	authClient authclient.AuthClient,
+	archiveRepo domaintask.TaskArchiveRepository,
+) ListCompletedTasksUseCase {
+	return &listCompletedTasksHandler{
+		authClient:  authClient,
+		archiveRepo: archiveRepo,
+		logger:      slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("listcompletedtasks"),
+	}
+}
+
+func (h *listCompletedTasksHandler) ListCompletedTasks(
+	ctx context.Context,
+	req *ListCompletedTasksRequest,
+) (*ListCompletedTasksResult, error) {
+	if req == nil {
+		return nil, ErrListCompletedTasksRequestRequired
+	}
+
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	query, err := h.buildQuery(req)
+	if err != nil {
+		h.logger.Warn("invalid list completed tasks query", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	completedTasks, nextCursor, err := h.archiveRepo.ListCompletedTasksByUserID(ctx, userID, query)
+	if err != nil {
+		h.logger.Error("failed to list completed tasks", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	result := &ListCompletedTasksResult{
+		CompletedTasks: make([]CompletedTaskItem, 0, len(completedTasks)),
+		NextPageToken:  "",
+	}
+
+	if nextCursor != nil {
+		result.NextPageToken = nextCursor.Encode()
+	}
+
+	for _, completedTask := range completedTasks {
+		result.CompletedTasks = append(result.CompletedTasks, toCompletedTaskItem(completedTask))
+	}
+
+	h.logger.Info("completed tasks listed",
+		slog.Int("count", len(result.CompletedTasks)),
+		slog.Bool("has_next_page", result.NextPageToken != ""),
+	)
+
+	return result, nil
+}
+
+func (h *listCompletedTasksHandler) buildQuery(req *ListCompletedTasksRequest) (domaintask.ListCompletedTasksQuery, error) {
+	query := domaintask.ListCompletedTasksQuery{
+		Filter: domaintask.CompletedTaskFilter{
+			TaskType:        req.TaskType,
+			CompletedAtFrom: req.CompletedAtFrom,
+			CompletedAtTo:   req.CompletedAtTo,
+			TagID:           nil,
+		},
+		PageSize: 0,
+		Cursor:   nil,
+	}
+
+	pageSize, err := domaintask.NormalizePageSize(req.PageSize)
+	if err != nil {
+		return query, err
+	}
+
+	query.PageSize = pageSize
+
+	tagID, err := parseTagFilter(req.TagID)
+	if err != nil {
+		return query, err
+	}
+
+	query.Filter.TagID = tagID
+
+	if err := query.Filter.Validate(); err != nil {
+		return query, err
+	}
+
+	if req.PageToken != "" {
+		cursor, err := domaintask.DecodeCompletedTaskPageCursor(req.PageToken)
+		if err != nil {
+			return query, err
+		}
+
+		query.Cursor = cursor
+	}
+
+	return query, nil
+}
+
+type GetCompletedTaskRequest struct {
+	SessionToken string
+	TaskID       string
+}
+
+type GetCompletedTaskResult struct {
+	CompletedTask CompletedTaskItem
+}
+
+type GetCompletedTaskUseCase interface {
+	GetCompletedTask(ctx context.Context, req *GetCompletedTaskRequest) (*GetCompletedTaskResult, error)
+}
+
+type getCompletedTaskHandler struct {
+	authClient  authclient.AuthClient
+	archiveRepo domaintask.TaskArchiveRepository
+	logger      *slog.Logger
+}
+
+func NewGetCompletedTaskHandler(
+	authClient authclient.AuthClient,
+	archiveRepo domaintask.TaskArchiveRepository,
+) GetCompletedTaskUseCase {
+	return &getCompletedTaskHandler{
+		authClient:  authClient,
+		archiveRepo: archiveRepo,
+		logger:      slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("getcompletedtask"),
+	}
+}
+
+func (h *getCompletedTaskHandler) GetCompletedTask(
+	ctx context.Context,
+	req *GetCompletedTaskRequest,
+) (*GetCompletedTaskResult, error) {
+	if req == nil {
+		return nil, ErrGetCompletedTaskRequestRequired
+	}
+
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if req.TaskID == "" {
+		h.logger.Warn("get completed task called with empty task ID")
+
+		return nil, ErrTaskIDRequired
+	}
+
+	taskID, err := domaintask.NewIDFromString(req.TaskID)
+	if err != nil {
+		h.logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	completedTask, err := h.archiveRepo.GetCompletedTaskByID(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrCompletedTaskNotFound) {
+			h.logger.Info("completed task not found", slog.String("task_id", req.TaskID))
+
+			return nil, ErrCompletedTaskNotFound
+		}
+
+		h.logger.Error("failed to get completed task", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return &GetCompletedTaskResult{
+		CompletedTask: toCompletedTaskItem(completedTask),
+	}, nil
+}
+
+type ReopenTaskRequest struct {
+	SessionToken string
+	TaskID       string
+}
+
+type ReopenTaskResult struct {
+	TaskID      string
+	Title       string
+	TaskType    domaintask.Type
+	TaskStatus  domaintask.Status
+	Description string
+	ScheduledAt *time.Time
+	CreatedAt   time.Time
+	TargetAt    time.Time
+	Color       string
+	Version     int64
+}
+
+type ReopenTaskUseCase interface {
+	ReopenTask(ctx context.Context, req *ReopenTaskRequest) (*ReopenTaskResult, error)
+}
+
+type reopenTaskHandler struct {
+	authClient        authclient.AuthClient
+	deviceClient      deviceclient.DeviceClient
+	taskRepo          domaintask.TaskRepository
+	archiveRepo       domaintask.TaskArchiveRepository
+	periodSettingRepo period.PeriodSettingRepository
+	intervalRepo      period.ReminderIntervalSettingRepository
+	quietHoursRepo    period.QuietHoursSettingRepository
+	remindQueue       remindregister.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
+	logger            *slog.Logger
+}
+
+func NewReopenTaskHandler(
+	authClient authclient.AuthClient,
+	deviceClient deviceclient.DeviceClient,
+	taskRepo domaintask.TaskRepository,
+	archiveRepo domaintask.TaskArchiveRepository,
+	periodSettingRepo period.PeriodSettingRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	remindQueue remindregister.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) ReopenTaskUseCase {
+	return &reopenTaskHandler{
+		authClient:        authClient,
+		deviceClient:      deviceClient,
+		taskRepo:          taskRepo,
+		archiveRepo:       archiveRepo,
+		periodSettingRepo: periodSettingRepo,
+		intervalRepo:      intervalRepo,
+		quietHoursRepo:    quietHoursRepo,
+		remindQueue:       remindQueue,
+		transactor:        transactor,
+		publisher:         publisher,
+		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("reopentask"),
+	}
+}
+
+func (h *reopenTaskHandler) ReopenTask(ctx context.Context, req *ReopenTaskRequest) (*ReopenTaskResult, error) {
+	if req == nil {
+		return nil, ErrReopenTaskRequestRequired
+	}
+
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if req.TaskID == "" {
+		h.logger.Warn("reopen task called with empty task ID")
+
+		return nil, ErrTaskIDRequired
+	}
+
+	taskID, err := domaintask.NewIDFromString(req.TaskID)
+	if err != nil {
+		h.logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	completedTask, err := h.archiveRepo.GetCompletedTaskByID(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrCompletedTaskNotFound) {
+			h.logger.Info("completed task not found", slog.String("task_id", req.TaskID))
+
+			return nil, ErrCompletedTaskNotFound
+		}
+
+		h.logger.Error("failed to get completed task", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	reopenedAt := time.Now().UTC()
+	customPeriod := lookupCustomPeriod(ctx, h.periodSettingRepo, userID, completedTask.TaskType(), h.logger)
+
+	task, err := completedTask.Reopen(reopenedAt, customPeriod)
+	if err != nil {
+		h.logger.Error("failed to reopen task entity", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, req.SessionToken, h.logger)
+	if err != nil {
+		h.logger.Warn("device fetch failed, returning reopen failure",
+			slog.String("task_id", req.TaskID),
+			slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	var reminderInfo *domaintask.ReminderInfo
+
+	switch {
+	case !task.TargetAt().After(reopenedAt):
+		h.logger.Info("reminder registration skipped: target time has already passed",
+			slog.String("task_id", req.TaskID),
+			slog.Time("target_at", task.TargetAt()),
+		)
+	case len(validDevices) > 0:
+		prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, userID, h.logger)
+		reminderInfo = domaintask.CalculateReminderTimesFrom(task, reopenedAt, userIDstr, validDevices, prefs)
+	case len(domainDevices) > 0:
+		h.logger.Info("reminder registration skipped: all devices don't have FCM tokens",
+			slog.String("task_id", req.TaskID),
+			slog.Int("device_count", len(domainDevices)),
+		)
+	}
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.archiveRepo.UnarchiveTask(ctx, task); err != nil {
+			if errors.Is(err, domaintask.ErrCompletedTaskNotFound) {
+				h.logger.Info("completed task disappeared before reopen", slog.String("task_id", req.TaskID))
+
+				return ErrCompletedTaskNotFound
+			}
+
+			h.logger.Error("failed to unarchive task", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		if reminderInfo != nil {
+			logReminderInfo(h.logger, reminderInfo)
+
+			if _, err := h.remindQueue.RegisterRemind(ctx, convertToRemindRequest(reminderInfo)); err != nil {
+				h.logger.Error("failed to register remind to queue",
+					slog.String("task_id", req.TaskID),
+					slog.String("error", err.Error()))
+
+				return ErrRemindQueueRegistrationFailed
+			}
+		}
+
+		if err := h.taskRepo.UpdateTaskStatus(ctx, taskID, userID, domaintask.StatusActive); err != nil {
+			h.logger.Error("failed to update task status to active",
+				slog.String("task_id", req.TaskID),
+				slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("task reopened", slog.String("task_id", req.TaskID))
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeCreated, userID, task.ID())
+
+	return &ReopenTaskResult{
+		TaskID:      task.ID().String(),
+		Title:       task.Title(),
+		TaskType:    task.TaskType(),
+		TaskStatus:  domaintask.StatusActive,
+		Description: task.Description(),
+		ScheduledAt: task.ScheduledAt(),
+		CreatedAt:   task.CreatedAt(),
+		TargetAt:    task.TargetAt(),
+		Color:       task.Color().String(),
+		Version:     task.Version(),
+	}, nil
+}
@@ -0,0 +1,546 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestCompletedTask(t *testing.T, userID domainuser.ID, completedAt time.Time) *domaintask.CompletedTask {
+	t.Helper()
+
+	id, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	task, err := domaintask.NewTask(
+		id,
+		userID,
+		"Completed",
+		domaintask.TypeNear,
+		domaintask.StatusCompleted,
+		"",
+		nil,
+		completedAt.Add(-time.Hour),
+		completedAt,
+		domaintask.MustColor("#FF6B6B"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	completedTask, err := domaintask.NewCompletedTask(task, completedAt)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	return completedTask
+}
+
+func TestListCompletedTasksSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	first := newTestCompletedTask(t, userID, now)
+	second := newTestCompletedTask(t, userID, now.Add(-time.Hour))
+
+	nextCursor, err := domaintask.NewCompletedTaskPageCursor(second)
+	if err != nil {
+		t.Fatalf("failed to create cursor: %v", err)
+	}
+
+	pageToken := nextCursor.Encode()
+	from := now.Add(-7 * 24 * time.Hour)
+	taskType := domaintask.TypeNear
+
+	ctrl := gomock.NewController(t)
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockArchive := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchive.EXPECT().
+		ListCompletedTasksByUserID(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domainuser.ID, query domaintask.ListCompletedTasksQuery) ([]*domaintask.CompletedTask, *domaintask.PageCursor, error) {
+			if query.PageSize != 2 {
+				t.Fatalf("expected page size 2, got %d", query.PageSize)
+			}
+
+			if query.Cursor == nil || query.Cursor.ID() != second.ID() {
+				t.Fatalf("expected cursor positioned at second task, got %v", query.Cursor)
+			}
+
+			if query.Filter.TaskType == nil || *query.Filter.TaskType != domaintask.TypeNear {
+				t.Fatalf("expected task type filter near, got %v", query.Filter.TaskType)
+			}
+
+			if query.Filter.CompletedAtFrom == nil || !query.Filter.CompletedAtFrom.Equal(from) {
+				t.Fatalf("expected completed_at_from %v, got %v", from, query.Filter.CompletedAtFrom)
+			}
+
+			return []*domaintask.CompletedTask{first, second}, nextCursor, nil
+		})
+
+	handler := NewListCompletedTasksHandler(mockAuth, mockArchive)
+
+	result, err := handler.ListCompletedTasks(ctx, &ListCompletedTasksRequest{
+		SessionToken:    "valid-token",
+		PageSize:        2,
+		PageToken:       pageToken,
+		TaskType:        &taskType,
+		CompletedAtFrom: &from,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.CompletedTasks) != 2 {
+		t.Fatalf("expected 2 completed tasks, got %d", len(result.CompletedTasks))
+	}
+
+	if result.CompletedTasks[0].TaskID != first.ID().String() {
+		t.Errorf("expected first task %s, got %s", first.ID().String(), result.CompletedTasks[0].TaskID)
+	}
+
+	if !result.CompletedTasks[0].CompletedAt.Equal(now) {
+		t.Errorf("expected completed_at %v, got %v", now, result.CompletedTasks[0].CompletedAt)
+	}
+
+	if result.NextPageToken != pageToken {
+		t.Errorf("expected next page token %q, got %q", pageToken, result.NextPageToken)
+	}
+}
+
+func TestListCompletedTasksError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC()
+	earlier := now.Add(-time.Hour)
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	tests := []struct {
+		name        string
+		req         *ListCompletedTasksRequest
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		expectedErr error
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrListCompletedTasksRequestRequired,
+		},
+		{
+			name: "unauthorized session",
+			req:  &ListCompletedTasksRequest{SessionToken: "invalid-token"},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "invalid-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name:        "invalid page size",
+			req:         &ListCompletedTasksRequest{SessionToken: "valid-token", PageSize: -1},
+			setupAuth:   validAuth,
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "malformed page token",
+			req:         &ListCompletedTasksRequest{SessionToken: "valid-token", PageToken: "garbage"},
+			setupAuth:   validAuth,
+			expectedErr: ErrInvalidPageToken,
+		},
+		{
+			name: "inverted completed_at window",
+			req: &ListCompletedTasksRequest{
+				SessionToken:    "valid-token",
+				CompletedAtFrom: &now,
+				CompletedAtTo:   &earlier,
+			},
+			setupAuth:   validAuth,
+			expectedErr: ErrInvalidCompletedAtRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := NewListCompletedTasksHandler(tt.setupAuth(ctrl), domaintask.NewMockTaskArchiveRepository(ctrl))
+
+			_, err := handler.ListCompletedTasks(ctx, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestGetCompletedTaskSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	completedTask := newTestCompletedTask(t, userID, time.Now().UTC().Truncate(time.Microsecond))
+
+	ctrl := gomock.NewController(t)
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockArchive := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchive.EXPECT().
+		GetCompletedTaskByID(gomock.Any(), completedTask.ID(), userID).
+		Return(completedTask, nil)
+
+	handler := NewGetCompletedTaskHandler(mockAuth, mockArchive)
+
+	result, err := handler.GetCompletedTask(ctx, &GetCompletedTaskRequest{
+		SessionToken: "valid-token",
+		TaskID:       completedTask.ID().String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.CompletedTask.TaskID != completedTask.ID().String() {
+		t.Errorf("expected task id %s, got %s", completedTask.ID().String(), result.CompletedTask.TaskID)
+	}
+
+	if !result.CompletedTask.CompletedAt.Equal(completedTask.CompletedAt()) {
+		t.Errorf("expected completed_at %v, got %v", completedTask.CompletedAt(), result.CompletedTask.CompletedAt)
+	}
+}
+
+func TestGetCompletedTaskError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	tests := []struct {
+		name         string
+		req          *GetCompletedTaskRequest
+		setupAuth    func(ctrl *gomock.Controller) authclient.AuthClient
+		setupArchive func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository
+		expectedErr  error
+	}{
+		{
+			name:      "nil request",
+			req:       nil,
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				return domaintask.NewMockTaskArchiveRepository(ctrl)
+			},
+			expectedErr: ErrGetCompletedTaskRequestRequired,
+		},
+		{
+			name:      "empty task id",
+			req:       &GetCompletedTaskRequest{SessionToken: "valid-token"},
+			setupAuth: validAuth,
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				return domaintask.NewMockTaskArchiveRepository(ctrl)
+			},
+			expectedErr: ErrTaskIDRequired,
+		},
+		{
+			name:      "invalid task id",
+			req:       &GetCompletedTaskRequest{SessionToken: "valid-token", TaskID: "not-a-uuid"},
+			setupAuth: validAuth,
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				return domaintask.NewMockTaskArchiveRepository(ctrl)
+			},
+			expectedErr: domaintask.ErrIDInvalidFormat,
+		},
+		{
+			name:      "not found",
+			req:       &GetCompletedTaskRequest{SessionToken: "valid-token", TaskID: taskID.String()},
+			setupAuth: validAuth,
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				mockArchive := domaintask.NewMockTaskArchiveRepository(ctrl)
+				mockArchive.EXPECT().
+					GetCompletedTaskByID(gomock.Any(), taskID, validUserID).
+					Return(nil, domaintask.ErrCompletedTaskNotFound)
+
+				return mockArchive
+			},
+			expectedErr: ErrCompletedTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := NewGetCompletedTaskHandler(tt.setupAuth(ctrl), tt.setupArchive(ctrl))
+
+			_, err := handler.GetCompletedTask(ctx, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestReopenTaskSuccess(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	completedTask := newTestCompletedTask(t, userID, time.Now().UTC().Truncate(time.Microsecond))
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	fcmToken := "valid-fcm-token"
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+	mockArchive := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchive.EXPECT().GetCompletedTaskByID(gomock.Any(), completedTask.ID(), userID).Return(completedTask, nil)
+	mockArchive.EXPECT().UnarchiveTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, task *domaintask.Task) error {
+			return repo.SaveTask(ctx, task)
+		})
+
+	mockQueue := remindregister.NewMockQueue(ctrl)
+	mockQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+			if req.TaskID != completedTask.ID().String() {
+				t.Fatalf("expected task id %s, got %s", completedTask.ID(), req.TaskID)
+			}
+
+			if len(req.Times) == 0 {
+				t.Fatalf("expected reminder times to be set")
+			}
+
+			return &remindregister.RemindResponse{Name: "test-task"}, nil
+		})
+
+	handler := NewReopenTaskHandler(mockAuth, mockDevice, repo, mockArchive, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	result, err := handler.ReopenTask(ctx, &ReopenTaskRequest{SessionToken: "token", TaskID: completedTask.ID().String()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.TaskStatus != domaintask.StatusActive {
+		t.Errorf("expected status %s, got %s", domaintask.StatusActive, result.TaskStatus)
+	}
+
+	if !result.TargetAt.After(completedTask.CompletedAt()) {
+		t.Errorf("expected target_at after completion, got %v", result.TargetAt)
+	}
+
+	stored, err := repo.GetTaskByID(ctx, completedTask.ID(), userID)
+	if err != nil {
+		t.Fatalf("failed to load reopened task: %v", err)
+	}
+
+	if stored.TaskStatus() != domaintask.StatusActive {
+		t.Errorf("expected stored status %s, got %s", domaintask.StatusActive, stored.TaskStatus())
+	}
+}
+
+func TestReopenTaskRegisterRemindFailedRollsBack(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	completedTask := newTestCompletedTask(t, userID, time.Now().UTC().Truncate(time.Microsecond))
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	fcmToken := "valid-fcm-token"
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+	mockArchive := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchive.EXPECT().GetCompletedTaskByID(gomock.Any(), completedTask.ID(), userID).Return(completedTask, nil)
+	mockArchive.EXPECT().UnarchiveTask(gomock.Any(), gomock.Any()).Return(nil)
+
+	mockQueue := remindregister.NewMockQueue(ctrl)
+	mockQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).Return(nil, errors.New("queue unavailable"))
+
+	// The unarchive is undone by rolling back the transaction the failure is returned from
+	mockTransactor := domaintask.NewMockTransactor(ctrl)
+	mockTransactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			err := fn(ctx)
+			if !errors.Is(err, ErrRemindQueueRegistrationFailed) {
+				t.Errorf("expected transaction to fail with %v, got %v", ErrRemindQueueRegistrationFailed, err)
+			}
+
+			return err
+		})
+
+	handler := NewReopenTaskHandler(mockAuth, mockDevice, nil, mockArchive, &MockPeriodSettingRepository{}, nil, nil, mockQueue, mockTransactor, taskevent.NewNoopBroker())
+
+	_, err = handler.ReopenTask(ctx, &ReopenTaskRequest{SessionToken: "token", TaskID: completedTask.ID().String()})
+	if !errors.Is(err, ErrRemindQueueRegistrationFailed) {
+		t.Fatalf("expected ErrRemindQueueRegistrationFailed, got %v", err)
+	}
+}
+
+func TestReopenTaskError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	tests := []struct {
+		name         string
+		req          *ReopenTaskRequest
+		setupAuth    func(ctrl *gomock.Controller) authclient.AuthClient
+		setupArchive func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository
+		expectedErr  error
+	}{
+		{
+			name:      "nil request",
+			req:       nil,
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				return domaintask.NewMockTaskArchiveRepository(ctrl)
+			},
+			expectedErr: ErrReopenTaskRequestRequired,
+		},
+		{
+			name: "unauthorized",
+			req:  &ReopenTaskRequest{SessionToken: "bad-token", TaskID: taskID.String()},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				return domaintask.NewMockTaskArchiveRepository(ctrl)
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name:      "empty task id",
+			req:       &ReopenTaskRequest{SessionToken: "valid-token"},
+			setupAuth: validAuth,
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				return domaintask.NewMockTaskArchiveRepository(ctrl)
+			},
+			expectedErr: ErrTaskIDRequired,
+		},
+		{
+			name:      "not found",
+			req:       &ReopenTaskRequest{SessionToken: "valid-token", TaskID: taskID.String()},
+			setupAuth: validAuth,
+			setupArchive: func(ctrl *gomock.Controller) domaintask.TaskArchiveRepository {
+				mockArchive := domaintask.NewMockTaskArchiveRepository(ctrl)
+				mockArchive.EXPECT().
+					GetCompletedTaskByID(gomock.Any(), taskID, validUserID).
+					Return(nil, domaintask.ErrCompletedTaskNotFound)
+
+				return mockArchive
+			},
+			expectedErr: ErrCompletedTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := NewReopenTaskHandler(
+				tt.setupAuth(ctrl),
+				NewMockDeviceClient(ctrl),
+				nil,
+				tt.setupArchive(ctrl),
+				&MockPeriodSettingRepository{},
+				nil,
+				nil,
+				remindregister.NewMockQueue(ctrl),
+				inlineTransactor{},
+				taskevent.NewNoopBroker(),
+			)
+
+			_, err := handler.ReopenTask(ctx, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -6,23 +6,126 @@ import (
 	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskimport"
 )
 
 var (
-	ErrUnauthorized                   = authclient.ErrUnauthorized
-	ErrAuthServiceUnavailable         = authclient.ErrAuthServiceUnavailable
-	ErrDeviceServiceUnavailable       = deviceclient.ErrDeviceServiceUnavailable
-	ErrDeviceInvalidArgument          = deviceclient.ErrInvalidArgument
-	ErrCreateTaskRequestRequired      = errors.New("create task request is required")
-	ErrGetTaskRequestRequired         = errors.New("get tasks request is required")
-	ErrListActiveTasksRequestRequired = errors.New("list active tasks request is required")
-	ErrUpdateTaskRequestRequired      = errors.New("update task request is required")
-	ErrDeleteTaskRequestRequired      = errors.New("delete task request is required")
-	ErrTitleRequired                  = errors.New("task title is required")
-	ErrTaskNotFound                   = domaintask.ErrTaskNotFound
-	ErrTaskIDRequired                 = errors.New("task ID is required")
-	ErrTaskIDAlreadyExists            = domaintask.ErrTaskIDAlreadyExists
-	ErrInvalidSortType                = domaintask.ErrInvalidSortType
-	ErrRemindQueueRegistrationFailed  = errors.New("failed to register remind to queue")
-	ErrCancelRemindFailed             = errors.New("failed to cancel remind")
+	ErrUnauthorized                      = authclient.ErrUnauthorized
+	ErrAuthServiceUnavailable            = authclient.ErrAuthServiceUnavailable
+	ErrDeviceServiceUnavailable          = deviceclient.ErrDeviceServiceUnavailable
+	ErrDeviceInvalidArgument             = deviceclient.ErrInvalidArgument
+	ErrCreateTaskRequestRequired         = errors.New("create task request is required")
+	ErrGetTaskRequestRequired            = errors.New("get tasks request is required")
+	ErrListActiveTasksRequestRequired    = errors.New("list active tasks request is required")
+	ErrUpdateTaskRequestRequired         = errors.New("update task request is required")
+	ErrDeleteTaskRequestRequired         = errors.New("delete task request is required")
+	ErrListCompletedTasksRequestRequired = errors.New("list completed tasks request is required")
+	ErrGetCompletedTaskRequestRequired   = errors.New("get completed task request is required")
+	ErrReopenTaskRequestRequired         = errors.New("reopen task request is required")
+	ErrListDeletedTasksRequestRequired   = errors.New("list deleted tasks request is required")
+	ErrRestoreTaskRequestRequired        = errors.New("restore task request is required")
+	ErrSnoozeTaskRequestRequired         = errors.New("snooze task request is required")
+	ErrSnoozeTargetRequired              = errors.New("exactly one of snooze duration or until is required")
+	ErrSnoozeUntilNotInFuture            = domaintask.ErrSnoozeUntilNotInFuture
+	ErrSnoozeCompletedTask               = domaintask.ErrSnoozeCompletedTask
+	ErrTitleRequired                     = errors.New("task title is required")
+	ErrTaskNotFound                      = domaintask.ErrTaskNotFound
+	ErrTaskVersionConflict               = domaintask.ErrTaskVersionConflict
+	ErrInvalidExpectedVersion            = domaintask.ErrInvalidExpectedVersion
+	ErrCompletedTaskNotFound             = domaintask.ErrCompletedTaskNotFound
+	ErrDeletedTaskNotFound               = domaintask.ErrDeletedTaskNotFound
+	ErrTaskIDRequired                    = errors.New("task ID is required")
+	ErrTaskIDAlreadyExists               = domaintask.ErrTaskIDAlreadyExists
+	ErrInvalidSortType                   = domaintask.ErrInvalidSortType
+	ErrInvalidSortDirection              = domaintask.ErrInvalidSortDirection
+	ErrMoveTaskRequestRequired           = errors.New("move task request is required")
+	ErrMoveTargetRequired                = errors.New("at least one of before ID or after ID is required")
+	ErrInvalidMovePosition               = errors.New("before task must sort before after task and neither may be the moved task")
+	ErrSearchTasksRequestRequired        = errors.New("search tasks request is required")
+	ErrSearchTextEmpty                   = domaintask.ErrSearchTextEmpty
+	ErrSearchTextTooLong                 = domaintask.ErrSearchTextTooLong
+	ErrInvalidPageSize                   = domaintask.ErrInvalidPageSize
+	ErrInvalidPageToken                  = domaintask.ErrInvalidPageToken
+	ErrInvalidTargetAtRange              = domaintask.ErrInvalidTargetAtRange
+	ErrInvalidCompletedAtRange           = domaintask.ErrInvalidCompletedAtRange
+	ErrRemindQueueRegistrationFailed     = errors.New("failed to register remind to queue")
+	ErrCancelRemindFailed                = errors.New("failed to cancel remind")
+)
+
+var (
+	ErrListChecklistItemsRequestRequired    = errors.New("list checklist items request is required")
+	ErrAddChecklistItemRequestRequired      = errors.New("add checklist item request is required")
+	ErrToggleChecklistItemRequestRequired   = errors.New("toggle checklist item request is required")
+	ErrReorderChecklistItemsRequestRequired = errors.New("reorder checklist items request is required")
+	ErrDeleteChecklistItemRequestRequired   = errors.New("delete checklist item request is required")
+	ErrChecklistItemIDRequired              = errors.New("checklist item ID is required")
+	ErrChecklistItemTextEmpty               = domaintask.ErrChecklistItemTextEmpty
+	ErrChecklistItemTextTooLong             = domaintask.ErrChecklistItemTextTooLong
+	ErrChecklistItemNotFound                = domaintask.ErrChecklistItemNotFound
+	ErrChecklistFull                        = domaintask.ErrChecklistFull
+	ErrChecklistOrderMismatch               = domaintask.ErrChecklistOrderMismatch
+)
+
+var (
+	ErrCreateTagRequestRequired = errors.New("create tag request is required")
+	ErrUpdateTagRequestRequired = errors.New("update tag request is required")
+	ErrDeleteTagRequestRequired = errors.New("delete tag request is required")
+	ErrListTagsRequestRequired  = errors.New("list tags request is required")
+	ErrAttachTagRequestRequired = errors.New("attach tag request is required")
+	ErrDetachTagRequestRequired = errors.New("detach tag request is required")
+	ErrTagIDRequired            = errors.New("tag ID is required")
+	ErrTagNameEmpty             = domaintask.ErrTagNameEmpty
+	ErrTagNameTooLong           = domaintask.ErrTagNameTooLong
+	ErrTagNameAlreadyExists     = domaintask.ErrTagNameAlreadyExists
+	ErrTagNotFound              = domaintask.ErrTagNotFound
+	ErrTooManyTags              = domaintask.ErrTooManyTags
+)
+
+var (
+	ErrBatchUpdateTasksRequestRequired = errors.New("batch update tasks request is required")
+	ErrBatchDeleteTasksRequestRequired = errors.New("batch delete tasks request is required")
+	ErrBatchEmpty                      = errors.New("batch must contain at least one task")
+	ErrBatchTooLarge                   = errors.New("batch contains too many tasks")
+	ErrDuplicateTaskInBatch            = errors.New("task appears more than once in the batch")
+)
+
+var (
+	ErrWatchTasksRequestRequired = errors.New("watch tasks request is required")
+	ErrTaskEventStreamClosed     = errors.New("task event stream closed, reconnect and refetch")
+)
+
+var (
+	ErrSyncTasksRequestRequired = errors.New("sync tasks request is required")
+	ErrInvalidSyncToken         = domaintask.ErrInvalidSyncToken
+	ErrSyncTokenExpired         = domaintask.ErrSyncTokenExpired
+	ErrInvalidSyncPageSize      = domaintask.ErrInvalidSyncPageSize
+)
+
+var (
+	ErrGetTaskStatsRequestRequired = errors.New("get task stats request is required")
+	ErrInvalidStatsInterval        = domaintask.ErrInvalidStatsInterval
+	ErrInvalidStatsTimezone        = domaintask.ErrInvalidStatsTimezone
+	ErrInvalidStatsBucketCount     = domaintask.ErrInvalidStatsBucketCount
+)
+
+var (
+	ErrCalendarFeedTokenRequestRequired = errors.New("calendar feed token request is required")
+	ErrGetCalendarFeedRequestRequired   = errors.New("get calendar feed request is required")
+	ErrCalendarFeedTokenNotFound        = domaintask.ErrCalendarFeedTokenNotFound
+	ErrCalendarFeedTokenAlreadyExists   = domaintask.ErrCalendarFeedTokenAlreadyExists
+)
+
+var (
+	ErrImportTasksRequestRequired   = errors.New("import tasks request is required")
+	ErrInvalidImportDefaultTaskType = errors.New("default task type must be short, near or relaxed")
+	ErrInvalidImportTimezone        = errors.New("invalid import timezone")
+	ErrScheduledAtInPast            = errors.New("scheduled time is not in the future")
+	ErrUnsupportedImportFormat      = taskimport.ErrUnsupportedFormat
+	ErrImportTooLarge               = taskimport.ErrTooManyEntries
+	ErrImportEmpty                  = taskimport.ErrNoEntries
+	ErrInvalidImportICS             = taskimport.ErrInvalidICS
+	ErrInvalidImportCSV             = taskimport.ErrInvalidCSV
+	ErrImportTitleColumnMissing     = taskimport.ErrMissingTitle
+	ErrInvalidImportDate            = taskimport.ErrInvalidDate
+	ErrImportEntryClosed            = taskimport.ErrEntryClosed
 )
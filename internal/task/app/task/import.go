@@ -0,0 +1,348 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskimport"
+)
+
+type ImportTasksRequest struct {
+	SessionToken string
+	// Format is "ics" or "csv"
+	Format string
+	Data   []byte
+	// DefaultTaskType is the type of entries without a date; dated entries are scheduled
+	DefaultTaskType domaintask.Type
+	// DefaultColor is the color of entries that do not set one
+	DefaultColor string
+	// Timezone reads dates without a UTC offset; empty means UTC
+	Timezone string
+	// DryRun validates the entries without creating any task
+	DryRun bool
+}
+
+// ImportTaskResult is the outcome of one entry of an import. Exactly one of Task and Err
+// is set.
+type ImportTaskResult struct {
+	// Row is the 1-based position of the entry in the file
+	Row   int
+	Title string
+	Task  *TaskItem
+	Err   error
+}
+
+type ImportTasksResult struct {
+	Results []ImportTaskResult // in file order
+	// Imported is the number of tasks created, always 0 for a dry run
+	Imported int
+}
+
+type ImportTasksUseCase interface {
+	ImportTasks(ctx context.Context, req *ImportTasksRequest) (*ImportTasksResult, error)
+}
+
+type importTasksHandler struct {
+	authClient        authclient.AuthClient
+	deviceClient      deviceclient.DeviceClient
+	taskRepo          domaintask.TaskRepository
+	periodSettingRepo period.PeriodSettingRepository
+	intervalRepo      period.ReminderIntervalSettingRepository
+	quietHoursRepo    period.QuietHoursSettingRepository
+	remindQueue       remindregister.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
+	logger            *slog.Logger
+}
+
+func NewImportTasksHandler(
+	authClient authclient.AuthClient,
+	deviceClient deviceclient.DeviceClient,
+	taskRepo domaintask.TaskRepository,
+	periodSettingRepo period.PeriodSettingRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	remindQueue remindregister.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) ImportTasksUseCase {
+	return &importTasksHandler{
+		authClient:        authClient,
+		deviceClient:      deviceClient,
+		taskRepo:          taskRepo,
+		periodSettingRepo: periodSettingRepo,
+		intervalRepo:      intervalRepo,
+		quietHoursRepo:    quietHoursRepo,
+		remindQueue:       remindQueue,
+		transactor:        transactor,
+		publisher:         publisher,
+		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("importtasks"),
+	}
+}
+
+// ImportTasks creates a task for every valid entry of the file in a single transaction.
+// Dated entries become scheduled tasks and the rest take the default type. An entry that
+// fails validation is reported in its result without affecting the others.
+func (h *importTasksHandler) ImportTasks(ctx context.Context, req *ImportTasksRequest) (*ImportTasksResult, error) {
+	if req == nil {
+		return nil, ErrImportTasksRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := h.parse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ImportTaskResult, len(entries))
+	tasks := make([]*domaintask.Task, 0, len(entries))
+	indexes := make([]int, 0, len(entries))
+	customPeriods := make(map[domaintask.Type]*time.Duration)
+	now := time.Now().UTC()
+
+	for i, entry := range entries {
+		results[i] = ImportTaskResult{Row: i + 1, Title: entry.Title, Task: nil, Err: nil}
+
+		taskType := req.DefaultTaskType
+		if entry.At != nil {
+			taskType = domaintask.TypeScheduled
+		}
+
+		customPeriod, ok := customPeriods[taskType]
+		if !ok {
+			customPeriod = lookupCustomPeriod(ctx, h.periodSettingRepo, userID, taskType, h.logger)
+			customPeriods[taskType] = customPeriod
+		}
+
+		task, err := h.prepare(entry, userID, taskType, req.DefaultColor, customPeriod, now)
+		if err != nil {
+			results[i].Err = err
+
+			continue
+		}
+
+		tasks = append(tasks, task)
+		indexes = append(indexes, i)
+	}
+
+	if req.DryRun || len(tasks) == 0 {
+		for j, task := range tasks {
+			results[indexes[j]].Task = importedTaskItem(task)
+		}
+
+		h.logger.Info("import validated",
+			slog.Bool("dry_run", req.DryRun),
+			slog.Int("valid", len(tasks)),
+			slog.Int("invalid", len(entries)-len(tasks)),
+		)
+
+		return &ImportTasksResult{Results: results, Imported: 0}, nil
+	}
+
+	remindReqs, err := h.remindRequests(ctx, req.SessionToken, userID, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return h.persist(ctx, tasks, remindReqs, userID)
+	}); err != nil {
+		return nil, err
+	}
+
+	for j, task := range tasks {
+		results[indexes[j]].Task = importedTaskItem(task)
+
+		publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeCreated, userID, task.ID())
+	}
+
+	h.logger.Info("tasks imported",
+		slog.Int("imported", len(tasks)),
+		slog.Int("failed", len(entries)-len(tasks)),
+	)
+
+	return &ImportTasksResult{Results: results, Imported: len(tasks)}, nil
+}
+
+// parse checks the import options and reads the entries of the file.
+func (h *importTasksHandler) parse(req *ImportTasksRequest) ([]taskimport.Entry, error) {
+	format, err := taskimport.NewFormat(req.Format)
+	if err != nil {
+		h.logger.Warn("unsupported import format", slog.String("format", req.Format))
+
+		return nil, err
+	}
+
+	if req.DefaultTaskType == domaintask.TypeScheduled {
+		h.logger.Warn("scheduled default task type")
+
+		return nil, ErrInvalidImportDefaultTaskType
+	}
+
+	if _, err := domaintask.NewType(string(req.DefaultTaskType)); err != nil {
+		h.logger.Warn("invalid default task type", slog.String("error", err.Error()))
+
+		return nil, ErrInvalidImportDefaultTaskType
+	}
+
+	loc, ok := domaintask.LoadUserLocationOrUTC(req.Timezone)
+	if !ok {
+		h.logger.Warn("invalid import timezone", slog.String("timezone", req.Timezone))
+
+		return nil, ErrInvalidImportTimezone
+	}
+
+	entries, err := taskimport.Parse(format, req.Data, loc)
+	if err != nil {
+		h.logger.Warn("failed to read import", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (h *importTasksHandler) prepare(
+	entry taskimport.Entry,
+	userID domainuser.ID,
+	taskType domaintask.Type,
+	defaultColor string,
+	customPeriod *time.Duration,
+	now time.Time,
+) (*domaintask.Task, error) {
+	if entry.Err != nil {
+		return nil, entry.Err
+	}
+
+	if entry.Title == "" {
+		return nil, ErrTitleRequired
+	}
+
+	// Entries from an old calendar would otherwise become tasks that are already due
+	if entry.At != nil && !entry.At.After(now) {
+		return nil, ErrScheduledAtInPast
+	}
+
+	colorValue := entry.Color
+	if colorValue == "" {
+		colorValue = defaultColor
+	}
+
+	color, err := domaintask.NewColor(colorValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return domaintask.CreateTask(
+		nil,
+		userID,
+		entry.Title,
+		taskType,
+		entry.Description,
+		entry.At,
+		color,
+		customPeriod,
+	)
+}
+
+// remindRequests fetches the user's devices once and prepares the reminders of every task.
+func (h *importTasksHandler) remindRequests(
+	ctx context.Context,
+	sessionToken string,
+	userID domainuser.ID,
+	tasks []*domaintask.Task,
+) ([]*remindregister.CreateRemindRequest, error) {
+	validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, sessionToken, h.logger)
+	if err != nil {
+		h.logger.Warn("device fetch failed, returning import failure", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if len(validDevices) == 0 {
+		if len(domainDevices) > 0 {
+			h.logger.Info("reminder registration skipped: all devices don't have FCM tokens",
+				slog.Int("device_count", len(domainDevices)),
+			)
+		}
+
+		return nil, nil
+	}
+
+	prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, userID, h.logger)
+	remindReqs := make([]*remindregister.CreateRemindRequest, 0, len(tasks))
+
+	for _, task := range tasks {
+		reminderInfo := domaintask.CalculateReminderTimes(task, userID.String(), validDevices, prefs)
+		if reminderInfo == nil {
+			continue
+		}
+
+		logReminderInfo(h.logger, reminderInfo)
+
+		remindReqs = append(remindReqs, convertToRemindRequest(reminderInfo))
+	}
+
+	return remindReqs, nil
+}
+
+// persist saves the tasks, registers all their reminders together and then activates them.
+func (h *importTasksHandler) persist(
+	ctx context.Context,
+	tasks []*domaintask.Task,
+	remindReqs []*remindregister.CreateRemindRequest,
+	userID domainuser.ID,
+) error {
+	for _, task := range tasks {
+		if err := h.taskRepo.SaveTask(ctx, task); err != nil {
+			h.logger.Error("failed to save imported task",
+				slog.String("task_id", task.ID().String()),
+				slog.String("error", err.Error()),
+			)
+
+			return err
+		}
+	}
+
+	if err := remindregister.RegisterAll(ctx, h.remindQueue, remindReqs); err != nil {
+		h.logger.Error("failed to register reminds",
+			slog.Int("task_count", len(remindReqs)),
+			slog.String("error", err.Error()),
+		)
+
+		return ErrRemindQueueRegistrationFailed
+	}
+
+	for _, task := range tasks {
+		if err := h.taskRepo.UpdateTaskStatus(ctx, task.ID(), userID, domaintask.StatusActive); err != nil {
+			h.logger.Error("failed to update task status to active",
+				slog.String("task_id", task.ID().String()),
+				slog.String("error", err.Error()),
+			)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importedTaskItem describes a task as it is once imported, which activates it.
+func importedTaskItem(task *domaintask.Task) *TaskItem {
+	item := toTaskItem(task)
+	item.TaskStatus = domaintask.StatusActive
+
+	return &item
+}
@@ -0,0 +1,286 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+// batchRegisterQueue is a register queue that also accepts batches, like the outbox queue.
+type batchRegisterQueue struct {
+	*remindregister.MockQueue
+	*remindregister.MockBatchQueue
+}
+
+func importTestCSV(scheduledAt time.Time) []byte {
+	return []byte(strings.Join([]string{
+		"title,description,due,color",
+		"Dentist,checkup," + scheduledAt.Format(time.RFC3339) + ",",
+		"Buy milk,,,#4ECDC4",
+		strings.Repeat("x", 501) + ",,,",
+		"Too late,,2000-01-01,",
+		"Bad color,,,red",
+	}, "\n"))
+}
+
+func TestImportTasksSuccess(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	scheduledAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+	fcmToken := "valid-fcm-token"
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil).Times(1)
+
+	mockPeriodRepo := period.NewMockPeriodSettingRepository(ctrl)
+	mockPeriodRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(nil, errors.New("no settings")).Times(1)
+
+	intervals, err := period.NewUserReminderIntervalSettings(userID, map[domaintask.Type][]domaintask.ReminderInterval{
+		domaintask.TypeScheduled: {0.5},
+		domaintask.TypeNear:      {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create reminder interval settings: %v", err)
+	}
+
+	// The overrides are read once for the whole import
+	mockIntervalRepo := period.NewMockReminderIntervalSettingRepository(ctrl)
+	mockIntervalRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(intervals, nil).Times(1)
+
+	saved := make([]*domaintask.Task, 0, 2)
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRegisterQueue := batchRegisterQueue{remindregister.NewMockQueue(ctrl), remindregister.NewMockBatchQueue(ctrl)}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().SaveTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+				saved = append(saved, task)
+
+				return nil
+			}).Times(2),
+		// The reminders of every imported task go out in one call
+		mockRegisterQueue.MockBatchQueue.EXPECT().RegisterReminds(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reqs []*remindregister.CreateRemindRequest) error {
+				if len(reqs) != 2 || reqs[0].TaskID != saved[0].ID().String() || reqs[1].TaskID != saved[1].ID().String() {
+					t.Errorf("expected reminders for both imported tasks, got %d", len(reqs))
+				}
+
+				if len(reqs) == 2 && (len(reqs[0].Times) != 2 || len(reqs[1].Times) != 1) {
+					t.Errorf("expected the user's reminder intervals, got %v and %v", reqs[0].Times, reqs[1].Times)
+				}
+
+				return nil
+			}),
+		mockRepo.EXPECT().UpdateTaskStatus(gomock.Any(), gomock.Any(), userID, domaintask.StatusActive).Return(nil).Times(2),
+	)
+
+	handler := NewImportTasksHandler(mockAuth, mockDevice, mockRepo, mockPeriodRepo, mockIntervalRepo, nil, mockRegisterQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	result, err := handler.ImportTasks(context.Background(), &ImportTasksRequest{
+		SessionToken:    "token",
+		Format:          "csv",
+		Data:            importTestCSV(scheduledAt),
+		DefaultTaskType: domaintask.TypeNear,
+		DefaultColor:    "#FF6B6B",
+		Timezone:        "Asia/Tokyo",
+		DryRun:          false,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Imported != 2 || len(result.Results) != 5 {
+		t.Fatalf("expected 2 of 5 entries imported, got %d of %d", result.Imported, len(result.Results))
+	}
+
+	dentist := result.Results[0]
+	if dentist.Row != 1 || dentist.Task == nil || dentist.Task.TaskType != domaintask.TypeScheduled ||
+		dentist.Task.ScheduledAt == nil || !dentist.Task.ScheduledAt.Equal(scheduledAt) ||
+		dentist.Task.Color != "#FF6B6B" || dentist.Task.TaskStatus != domaintask.StatusActive {
+		t.Errorf("expected a scheduled task with the default color, got %+v", dentist.Task)
+	}
+
+	milk := result.Results[1]
+	if milk.Task == nil || milk.Task.TaskType != domaintask.TypeNear || milk.Task.Color != "#4ECDC4" {
+		t.Errorf("expected a near task with its own color, got %+v", milk.Task)
+	}
+
+	for i, expectedErr := range []error{domaintask.ErrTitleTooLong, ErrScheduledAtInPast, domaintask.ErrColorInvalidFormat} {
+		row := result.Results[i+2]
+		if row.Task != nil || !errors.Is(row.Err, expectedErr) {
+			t.Errorf("expected row %d to fail with %v, got %v", row.Row, expectedErr, row.Err)
+		}
+	}
+}
+
+func TestImportTasksDryRun(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockPeriodRepo := period.NewMockPeriodSettingRepository(ctrl)
+	mockPeriodRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(nil, errors.New("no settings")).AnyTimes()
+
+	// Nothing is saved and no devices are fetched
+	handler := NewImportTasksHandler(
+		mockAuth,
+		NewMockDeviceClient(ctrl),
+		domaintask.NewMockTaskRepository(ctrl),
+		mockPeriodRepo,
+		nil,
+		nil,
+		remindregister.NewMockQueue(ctrl),
+		inlineTransactor{},
+		taskevent.NewNoopBroker(),
+	)
+
+	result, err := handler.ImportTasks(context.Background(), &ImportTasksRequest{
+		SessionToken:    "token",
+		Format:          "csv",
+		Data:            importTestCSV(time.Now().Add(time.Hour)),
+		DefaultTaskType: domaintask.TypeRelaxed,
+		DefaultColor:    "#FF6B6B",
+		Timezone:        "",
+		DryRun:          true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Imported != 0 {
+		t.Errorf("expected nothing imported on a dry run, got %d", result.Imported)
+	}
+
+	if result.Results[0].Task == nil || result.Results[1].Task == nil || !errors.Is(result.Results[2].Err, domaintask.ErrTitleTooLong) {
+		t.Errorf("expected the validation of every row, got %+v", result.Results)
+	}
+
+	if row := result.Results[3]; row.Task != nil || !errors.Is(row.Err, ErrScheduledAtInPast) {
+		t.Errorf("expected the past entry to be rejected, got %+v", row)
+	}
+}
+
+func TestImportTasksError(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	validRequest := func(modify func(req *ImportTasksRequest)) *ImportTasksRequest {
+		req := &ImportTasksRequest{
+			SessionToken:    "token",
+			Format:          "csv",
+			Data:            []byte("title\nTask\n"),
+			DefaultTaskType: domaintask.TypeNear,
+			DefaultColor:    "#FF6B6B",
+			Timezone:        "",
+			DryRun:          false,
+		}
+		modify(req)
+
+		return req
+	}
+
+	tests := []struct {
+		name        string
+		req         *ImportTasksRequest
+		authErr     error
+		expectedErr error
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			expectedErr: ErrImportTasksRequestRequired,
+		},
+		{
+			name:        "unauthorized",
+			req:         validRequest(func(*ImportTasksRequest) {}),
+			authErr:     authclient.ErrUnauthorized,
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name:        "unsupported format",
+			req:         validRequest(func(req *ImportTasksRequest) { req.Format = "xlsx" }),
+			expectedErr: ErrUnsupportedImportFormat,
+		},
+		{
+			name:        "scheduled default type",
+			req:         validRequest(func(req *ImportTasksRequest) { req.DefaultTaskType = domaintask.TypeScheduled }),
+			expectedErr: ErrInvalidImportDefaultTaskType,
+		},
+		{
+			name:        "invalid timezone",
+			req:         validRequest(func(req *ImportTasksRequest) { req.Timezone = "Mars/Olympus" }),
+			expectedErr: ErrInvalidImportTimezone,
+		},
+		{
+			name:        "server local timezone",
+			req:         validRequest(func(req *ImportTasksRequest) { req.Timezone = "Local" }),
+			expectedErr: ErrInvalidImportTimezone,
+		},
+		{
+			name:        "invalid file",
+			req:         validRequest(func(req *ImportTasksRequest) { req.Format = "ics" }),
+			expectedErr: ErrInvalidImportICS,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth := NewMockAuthClient(ctrl)
+			if tt.req != nil {
+				if tt.authErr != nil {
+					mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return("", tt.authErr)
+				} else {
+					mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+				}
+			}
+
+			handler := NewImportTasksHandler(
+				mockAuth,
+				NewMockDeviceClient(ctrl),
+				domaintask.NewMockTaskRepository(ctrl),
+				period.NewMockPeriodSettingRepository(ctrl),
+				nil,
+				nil,
+				remindregister.NewMockQueue(ctrl),
+				inlineTransactor{},
+				taskevent.NewNoopBroker(),
+			)
+
+			_, err := handler.ImportTasks(context.Background(), tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
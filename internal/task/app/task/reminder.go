@@ -0,0 +1,181 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+)
+
+// lookupCustomPeriod returns the user's configured active period for the task type.
+// Errors are logged and treated as "no custom period" so that defaults apply.
+func lookupCustomPeriod(
+	ctx context.Context,
+	periodSettingRepo period.PeriodSettingRepository,
+	userID domainuser.ID,
+	taskType domaintask.Type,
+	logger *slog.Logger,
+) *time.Duration {
+	if taskType == domaintask.TypeScheduled || periodSettingRepo == nil {
+		return nil
+	}
+
+	periodSettings, err := periodSettingRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		logger.Warn("failed to get period settings, using defaults", slog.String("error", err.Error()))
+		// Continue with default period on error
+		return nil
+	}
+
+	customPeriod, ok := periodSettings.GetPeriod(taskType)
+	if !ok {
+		return nil
+	}
+
+	logger.Debug("using custom period for task type",
+		slog.String("task_type", string(taskType)),
+		slog.Duration("period", customPeriod))
+
+	return &customPeriod
+}
+
+// lookupReminderPreferences returns the user's reminder interval overrides and quiet
+// hours. Errors are logged and treated as "not set" so that defaults apply.
+func lookupReminderPreferences(
+	ctx context.Context,
+	reminderIntervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	userID domainuser.ID,
+	logger *slog.Logger,
+) domaintask.ReminderPreferences {
+	var prefs domaintask.ReminderPreferences
+
+	if reminderIntervalRepo != nil {
+		settings, err := reminderIntervalRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			logger.Warn("failed to get reminder interval settings, using defaults", slog.String("error", err.Error()))
+		} else {
+			prefs.Intervals = settings.Overrides()
+		}
+	}
+
+	if quietHoursRepo != nil {
+		settings, err := quietHoursRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			logger.Warn("failed to get quiet hours settings, ignoring quiet hours", slog.String("error", err.Error()))
+		} else {
+			prefs.QuietHours = settings.QuietHours()
+		}
+	}
+
+	return prefs
+}
+
+// fetchReminderDevices fetches the user's devices and returns those that can receive
+// reminders along with the full device list.
+func fetchReminderDevices(
+	ctx context.Context,
+	deviceClient deviceclient.DeviceClient,
+	sessionToken string,
+	logger *slog.Logger,
+) ([]domaintask.DeviceInfo, []domaintask.DeviceInfo, error) {
+	devices, err := deviceClient.GetUserDevicesWithRetry(ctx, sessionToken, deviceclient.DefaultRetryConfig())
+	if err != nil {
+		if errors.Is(err, deviceclient.ErrUnauthorized) {
+			logger.Info("device service: unauthorized", slog.String("error", err.Error()))
+
+			return nil, nil, ErrUnauthorized
+		}
+
+		if errors.Is(err, deviceclient.ErrInvalidArgument) {
+			logger.Error("device service: invalid argument", slog.String("error", err.Error()))
+
+			return nil, nil, ErrDeviceInvalidArgument
+		}
+
+		logger.Warn("device fetch failed after retries", slog.String("error", err.Error()))
+
+		return nil, nil, ErrDeviceServiceUnavailable
+	}
+
+	domainDevices := make([]domaintask.DeviceInfo, 0, len(devices))
+	for _, d := range devices {
+		domainDevices = append(domainDevices, domaintask.DeviceInfo{
+			DeviceID: d.DeviceID,
+			FCMToken: d.FCMToken,
+		})
+	}
+
+	validDevices, filteredCount := filterDevicesWithFCMToken(domainDevices)
+	if filteredCount > 0 {
+		logger.Warn("devices filtered out due to missing FCM token",
+			slog.Int("filtered_count", filteredCount),
+			slog.Int("remaining_count", len(validDevices)),
+			slog.Int("total_count", len(domainDevices)),
+		)
+	}
+
+	return validDevices, domainDevices, nil
+}
+
+func logReminderInfo(logger *slog.Logger, info *domaintask.ReminderInfo) {
+	reminderTimesStr := make([]string, len(info.ReminderTimes))
+	for i, t := range info.ReminderTimes {
+		reminderTimesStr[i] = t.Format(time.RFC3339)
+	}
+
+	logger.Info("reminder schedule prepared",
+		slog.String("task_id", info.TaskID.String()),
+		slog.String("task_type", string(info.TaskType)),
+		slog.Int("reminder_count", len(info.ReminderTimes)),
+		slog.Int("device_count", len(info.Devices)),
+	)
+
+	logger.Debug("reminder times calculated",
+		slog.String("task_id", info.TaskID.String()),
+		slog.Any("reminder_times", reminderTimesStr),
+	)
+}
+
+func convertToRemindRequest(info *domaintask.ReminderInfo) *remindregister.CreateRemindRequest {
+	devices := make([]remindregister.DeviceRequest, 0, len(info.Devices))
+	for _, d := range info.Devices {
+		fcmToken := ""
+		if d.FCMToken != nil {
+			fcmToken = *d.FCMToken
+		}
+
+		devices = append(devices, remindregister.DeviceRequest{
+			DeviceID: d.DeviceID,
+			FCMToken: fcmToken,
+		})
+	}
+
+	return &remindregister.CreateRemindRequest{
+		Times:    info.ReminderTimes,
+		UserID:   info.UserID,
+		Devices:  devices,
+		TaskID:   info.TaskID.String(),
+		TaskType: string(info.TaskType),
+		Color:    info.Color,
+	}
+}
+
+func filterDevicesWithFCMToken(devices []domaintask.DeviceInfo) ([]domaintask.DeviceInfo, int) {
+	valid := make([]domaintask.DeviceInfo, 0, len(devices))
+	for _, d := range devices {
+		if d.FCMToken != nil && *d.FCMToken != "" {
+			valid = append(valid, d)
+		}
+	}
+
+	filteredCount := len(devices) - len(valid)
+
+	return valid, filteredCount
+}
@@ -0,0 +1,178 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+)
+
+type SearchTasksRequest struct {
+	SessionToken string
+	Text         string
+	TagID        *string
+	PageSize     int
+	PageToken    string
+}
+
+type SearchTasksResult struct {
+	Hits          []SearchHitItem
+	NextPageToken string
+}
+
+// SearchHitItem is a task matching a search. Exactly one of Task and CompletedTask is set.
+// The highlights enclose matched terms in domaintask.SearchHighlightStart and
+// domaintask.SearchHighlightStop.
+type SearchHitItem struct {
+	Task                 *TaskItem
+	CompletedTask        *CompletedTaskItem
+	Score                float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+func toSearchHitItem(hit *domaintask.SearchHit) SearchHitItem {
+	item := SearchHitItem{
+		Task:                 nil,
+		CompletedTask:        nil,
+		Score:                hit.Score(),
+		TitleHighlight:       hit.TitleHighlight(),
+		DescriptionHighlight: hit.DescriptionHighlight(),
+	}
+
+	if hit.Task() != nil {
+		task := toTaskItem(hit.Task())
+		item.Task = &task
+	} else {
+		completedTask := toCompletedTaskItem(hit.CompletedTask())
+		item.CompletedTask = &completedTask
+	}
+
+	return item
+}
+
+type SearchTasksUseCase interface {
+	SearchTasks(ctx context.Context, req *SearchTasksRequest) (*SearchTasksResult, error)
+}
+
+type searchTasksHandler struct {
+	authClient authclient.AuthClient
+	searchRepo domaintask.TaskSearchRepository
+	logger     *slog.Logger
+}
+
+func NewSearchTasksHandler(
+	authClient authclient.AuthClient,
+	searchRepo domaintask.TaskSearchRepository,
+) SearchTasksUseCase {
+	return &searchTasksHandler{
+		authClient: authClient,
+		searchRepo: searchRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("searchtasks"),
+	}
+}
+
+func (h *searchTasksHandler) SearchTasks(ctx context.Context, req *SearchTasksRequest) (*SearchTasksResult, error) {
+	if req == nil {
+		return nil, ErrSearchTasksRequestRequired
+	}
+
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	query, err := h.buildQuery(req)
+	if err != nil {
+		h.logger.Warn("invalid search tasks query", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	hits, nextCursor, err := h.searchRepo.SearchTasks(ctx, userID, query)
+	if err != nil {
+		h.logger.Error("failed to search tasks", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	result := &SearchTasksResult{
+		Hits:          make([]SearchHitItem, 0, len(hits)),
+		NextPageToken: "",
+	}
+
+	if nextCursor != nil {
+		result.NextPageToken = nextCursor.Encode()
+	}
+
+	for _, hit := range hits {
+		result.Hits = append(result.Hits, toSearchHitItem(hit))
+	}
+
+	h.logger.Info("tasks searched",
+		slog.Int("count", len(result.Hits)),
+		slog.Bool("has_next_page", result.NextPageToken != ""),
+	)
+
+	return result, nil
+}
+
+func (h *searchTasksHandler) buildQuery(req *SearchTasksRequest) (domaintask.SearchTasksQuery, error) {
+	query := domaintask.SearchTasksQuery{
+		Text:     "",
+		TagID:    nil,
+		PageSize: 0,
+		Cursor:   nil,
+	}
+
+	text, err := domaintask.NewSearchText(req.Text)
+	if err != nil {
+		return query, err
+	}
+
+	query.Text = text
+
+	tagID, err := parseTagFilter(req.TagID)
+	if err != nil {
+		return query, err
+	}
+
+	query.TagID = tagID
+
+	pageSize, err := domaintask.NormalizePageSize(req.PageSize)
+	if err != nil {
+		return query, err
+	}
+
+	query.PageSize = pageSize
+
+	if req.PageToken != "" {
+		cursor, err := domaintask.DecodeSearchCursor(req.PageToken, text)
+		if err != nil {
+			return query, err
+		}
+
+		query.Cursor = cursor
+	}
+
+	return query, nil
+}
@@ -0,0 +1,201 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchTasksSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	createdAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+
+	activeTask, err := domaintask.NewTask(taskID, userID, "Buy groceries", domaintask.TypeNear, domaintask.StatusActive, "", nil, createdAt, createdAt.Add(2*time.Hour), domaintask.MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	completedTask, err := domaintask.NewCompletedTask(activeTask, createdAt.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	activeHit, err := domaintask.NewActiveSearchHit(activeTask, 0.9, "Buy <mark>groceries</mark>", "")
+	if err != nil {
+		t.Fatalf("failed to create hit: %v", err)
+	}
+
+	completedHit, err := domaintask.NewCompletedSearchHit(completedTask, 0.5, "Buy <mark>groceries</mark>", "")
+	if err != nil {
+		t.Fatalf("failed to create hit: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTaskSearchRepository(ctrl)
+	mockRepo.EXPECT().SearchTasks(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domainuser.ID, query domaintask.SearchTasksQuery) ([]*domaintask.SearchHit, *domaintask.SearchCursor, error) {
+			if query.Text != "groceries" {
+				t.Fatalf("expected trimmed text, got %q", query.Text)
+			}
+
+			if query.PageSize != 2 {
+				t.Fatalf("expected page size 2, got %d", query.PageSize)
+			}
+
+			if query.Cursor == nil || query.Cursor.Offset() != 2 {
+				t.Fatalf("expected cursor at offset 2, got %v", query.Cursor)
+			}
+
+			return []*domaintask.SearchHit{activeHit, completedHit}, domaintask.NewSearchCursor(query.Text, 4), nil
+		})
+
+	handler := NewSearchTasksHandler(mockAuth, mockRepo)
+
+	result, err := handler.SearchTasks(ctx, &SearchTasksRequest{
+		SessionToken: "valid-token",
+		Text:         " groceries ",
+		PageSize:     2,
+		PageToken:    domaintask.NewSearchCursor("groceries", 2).Encode(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Hits) != 2 {
+		t.Fatalf("expected 2 hits, got %d", len(result.Hits))
+	}
+
+	if result.Hits[0].Task == nil || result.Hits[0].CompletedTask != nil {
+		t.Errorf("expected first hit to be an active task")
+	}
+
+	if result.Hits[1].CompletedTask == nil || result.Hits[1].Task != nil {
+		t.Errorf("expected second hit to be a completed task")
+	}
+
+	if result.Hits[0].TitleHighlight != "Buy <mark>groceries</mark>" {
+		t.Errorf("unexpected title highlight %q", result.Hits[0].TitleHighlight)
+	}
+
+	if result.NextPageToken == "" {
+		t.Errorf("expected next page token")
+	}
+}
+
+func TestSearchTasksError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	tests := []struct {
+		name        string
+		req         *SearchTasksRequest
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		setupRepo   func(ctrl *gomock.Controller) domaintask.TaskSearchRepository
+		expectedErr error
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrSearchTasksRequestRequired,
+		},
+		{
+			name: "unauthorized session",
+			req:  &SearchTasksRequest{SessionToken: "bad-token", Text: "milk"},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name:        "empty text",
+			req:         &SearchTasksRequest{SessionToken: "valid-token", Text: "  "},
+			setupAuth:   validAuth,
+			expectedErr: ErrSearchTextEmpty,
+		},
+		{
+			name:        "invalid page size",
+			req:         &SearchTasksRequest{SessionToken: "valid-token", Text: "milk", PageSize: domaintask.MaxPageSize + 1},
+			setupAuth:   validAuth,
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "page token for another text",
+			req:         &SearchTasksRequest{SessionToken: "valid-token", Text: "milk", PageToken: domaintask.NewSearchCursor("bread", 50).Encode()},
+			setupAuth:   validAuth,
+			expectedErr: ErrInvalidPageToken,
+		},
+		{
+			name:      "repository error",
+			req:       &SearchTasksRequest{SessionToken: "valid-token", Text: "milk"},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.TaskSearchRepository {
+				mockRepo := domaintask.NewMockTaskSearchRepository(ctrl)
+				mockRepo.EXPECT().SearchTasks(gomock.Any(), validUserID, gomock.Any()).
+					Return(nil, nil, errors.New("database error"))
+
+				return mockRepo
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var repo domaintask.TaskSearchRepository
+			if tt.setupRepo != nil {
+				repo = tt.setupRepo(ctrl)
+			}
+
+			handler := NewSearchTasksHandler(tt.setupAuth(ctrl), repo)
+
+			_, err := handler.SearchTasks(ctx, tt.req)
+			if err == nil {
+				t.Fatalf("expected error %v, got nil", tt.expectedErr)
+			}
+
+			if !errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error() {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package task
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+)
+
+type GetTaskStatsRequest struct {
+	SessionToken string
+	// Timezone is the IANA timezone buckets are aligned to
+	Timezone    string
+	Interval    domaintask.StatsInterval
+	BucketCount int
+}
+
+type CompletionBucketItem struct {
+	Start     time.Time
+	Completed int
+}
+
+type TaskTypeStatsItem struct {
+	TaskType             domaintask.Type
+	Completed            int
+	OnTime               int
+	MedianTimeToComplete time.Duration
+}
+
+// GetTaskStatsResult reports the completions within the requested buckets. Streaks are
+// counted in buckets over the user's whole history.
+type GetTaskStatsResult struct {
+	Buckets       []CompletionBucketItem
+	Completed     int
+	OnTime        int
+	OnTimeRatio   float64
+	TaskTypes     []TaskTypeStatsItem
+	CurrentStreak int
+	LongestStreak int
+}
+
+type GetTaskStatsUseCase interface {
+	GetTaskStats(ctx context.Context, req *GetTaskStatsRequest) (*GetTaskStatsResult, error)
+}
+
+type getTaskStatsHandler struct {
+	authClient authclient.AuthClient
+	statsRepo  domaintask.TaskStatsRepository
+	logger     *slog.Logger
+}
+
+func NewGetTaskStatsHandler(
+	authClient authclient.AuthClient,
+	statsRepo domaintask.TaskStatsRepository,
+) GetTaskStatsUseCase {
+	return &getTaskStatsHandler{
+		authClient: authClient,
+		statsRepo:  statsRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("gettaskstats"),
+	}
+}
+
+func (h *getTaskStatsHandler) GetTaskStats(ctx context.Context, req *GetTaskStatsRequest) (*GetTaskStatsResult, error) {
+	if req == nil {
+		return nil, ErrGetTaskStatsRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := domaintask.NewStatsWindow(req.Interval, req.Timezone, req.BucketCount, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid stats window", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	history, err := h.statsRepo.GetCompletionHistory(ctx, userID, window)
+	if err != nil {
+		h.logger.Error("failed to get completion history", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	stats := domaintask.NewTaskStats(window, *history)
+
+	result := &GetTaskStatsResult{
+		Buckets:       make([]CompletionBucketItem, 0, len(stats.Buckets())),
+		Completed:     stats.Completed(),
+		OnTime:        stats.OnTime(),
+		OnTimeRatio:   stats.OnTimeRatio(),
+		TaskTypes:     make([]TaskTypeStatsItem, 0, len(stats.Types())),
+		CurrentStreak: stats.CurrentStreak(),
+		LongestStreak: stats.LongestStreak(),
+	}
+
+	for _, bucket := range stats.Buckets() {
+		result.Buckets = append(result.Buckets, CompletionBucketItem{
+			Start:     bucket.Start,
+			Completed: bucket.Completed,
+		})
+	}
+
+	for _, completions := range stats.Types() {
+		result.TaskTypes = append(result.TaskTypes, TaskTypeStatsItem{
+			TaskType:             completions.TaskType,
+			Completed:            completions.Completed,
+			OnTime:               completions.OnTime,
+			MedianTimeToComplete: completions.MedianTimeToComplete,
+		})
+	}
+
+	h.logger.Info("task stats computed",
+		slog.String("interval", string(window.Interval())),
+		slog.Int("buckets", len(result.Buckets)),
+		slog.Int("completed", result.Completed),
+	)
+
+	return result, nil
+}
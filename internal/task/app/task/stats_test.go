@@ -0,0 +1,161 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTaskStatsSuccess(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTaskStatsRepository(ctrl)
+	mockRepo.EXPECT().
+		GetCompletionHistory(gomock.Any(), userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domainuser.ID, window *domaintask.StatsWindow) (*domaintask.CompletionHistory, error) {
+			if window.Interval() != domaintask.StatsIntervalDay || window.Location().String() != "Asia/Tokyo" {
+				t.Errorf("unexpected window %s in %s", window.Interval(), window.Location())
+			}
+
+			starts := window.BucketStarts()
+
+			return &domaintask.CompletionHistory{
+				Days: []domaintask.DailyCompletions{
+					{Date: starts[5], Completed: 1},
+					{Date: starts[6], Completed: 2},
+				},
+				Types: []domaintask.TypeCompletions{
+					{TaskType: domaintask.TypeNear, Completed: 3, OnTime: 2, MedianTimeToComplete: time.Hour},
+				},
+			}, nil
+		})
+
+	handler := NewGetTaskStatsHandler(mockAuth, mockRepo)
+
+	result, err := handler.GetTaskStats(context.Background(), &GetTaskStatsRequest{
+		SessionToken: "token",
+		Timezone:     "Asia/Tokyo",
+		Interval:     domaintask.StatsIntervalDay,
+		BucketCount:  7,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Buckets) != 7 || result.Buckets[5].Completed != 1 || result.Buckets[6].Completed != 2 {
+		t.Errorf("unexpected buckets %+v", result.Buckets)
+	}
+
+	if result.Completed != 3 || result.OnTime != 2 || result.OnTimeRatio != 2.0/3.0 {
+		t.Errorf("unexpected totals %d/%d (%v)", result.OnTime, result.Completed, result.OnTimeRatio)
+	}
+
+	if len(result.TaskTypes) != 1 || result.TaskTypes[0].MedianTimeToComplete != time.Hour {
+		t.Errorf("unexpected task types %+v", result.TaskTypes)
+	}
+
+	if result.CurrentStreak != 2 || result.LongestStreak != 2 {
+		t.Errorf("expected streaks 2/2, got %d/%d", result.CurrentStreak, result.LongestStreak)
+	}
+}
+
+func TestGetTaskStatsError(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	repoErr := errors.New("database unavailable")
+
+	tests := []struct {
+		name        string
+		req         *GetTaskStatsRequest
+		setup       func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskStatsRepository)
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskStatsRepository) {
+				return NewMockAuthClient(ctrl), domaintask.NewMockTaskStatsRepository(ctrl)
+			},
+			expectedErr: ErrGetTaskStatsRequestRequired,
+		},
+		{
+			name: "unauthorized",
+			req:  &GetTaskStatsRequest{SessionToken: "bad-token", Timezone: "UTC", Interval: domaintask.StatsIntervalDay},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskStatsRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").Return("", authclient.ErrUnauthorized)
+
+				return mockAuth, domaintask.NewMockTaskStatsRepository(ctrl)
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "invalid timezone",
+			req:  &GetTaskStatsRequest{SessionToken: "token", Timezone: "Mars/Olympus", Interval: domaintask.StatsIntervalDay},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskStatsRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				return mockAuth, domaintask.NewMockTaskStatsRepository(ctrl)
+			},
+			expectedErr: ErrInvalidStatsTimezone,
+		},
+		{
+			name: "invalid bucket count",
+			req:  &GetTaskStatsRequest{SessionToken: "token", Timezone: "UTC", Interval: domaintask.StatsIntervalWeek, BucketCount: 1000},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskStatsRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				return mockAuth, domaintask.NewMockTaskStatsRepository(ctrl)
+			},
+			expectedErr: ErrInvalidStatsBucketCount,
+		},
+		{
+			name: "repository error",
+			req:  &GetTaskStatsRequest{SessionToken: "token", Timezone: "UTC", Interval: domaintask.StatsIntervalDay},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskStatsRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				mockRepo := domaintask.NewMockTaskStatsRepository(ctrl)
+				mockRepo.EXPECT().GetCompletionHistory(gomock.Any(), userID, gomock.Any()).Return(nil, repoErr)
+
+				return mockAuth, mockRepo
+			},
+			expectedErr: repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth, mockRepo := tt.setup(ctrl)
+
+			handler := NewGetTaskStatsHandler(mockAuth, mockRepo)
+
+			_, err := handler.GetTaskStats(context.Background(), tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+)
+
+type SyncTasksRequest struct {
+	SessionToken string
+	// SinceToken is the NextSyncToken of the last applied sync, or empty for a full sync
+	SinceToken string
+	PageSize   int
+}
+
+// SyncTasksResult holds the latest state of every task changed after the since token.
+// Tasks are active tasks to insert or replace; tombstones are tasks to drop. A task is
+// in at most one of the two.
+type SyncTasksResult struct {
+	Tasks         []TaskItem
+	Tombstones    []TombstoneItem
+	NextSyncToken string
+	// HasMore tells the client to sync again from NextSyncToken right away
+	HasMore bool
+}
+
+// TombstoneItem is a task that left the active tasks, either completed or deleted.
+type TombstoneItem struct {
+	TaskID    string
+	Reason    domaintask.ChangeType
+	RemovedAt time.Time
+}
+
+type SyncTasksUseCase interface {
+	SyncTasks(ctx context.Context, req *SyncTasksRequest) (*SyncTasksResult, error)
+}
+
+type syncTasksHandler struct {
+	authClient authclient.AuthClient
+	syncRepo   domaintask.TaskSyncRepository
+	logger     *slog.Logger
+}
+
+func NewSyncTasksHandler(
+	authClient authclient.AuthClient,
+	syncRepo domaintask.TaskSyncRepository,
+) SyncTasksUseCase {
+	return &syncTasksHandler{
+		authClient: authClient,
+		syncRepo:   syncRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("synctasks"),
+	}
+}
+
+func (h *syncTasksHandler) SyncTasks(ctx context.Context, req *SyncTasksRequest) (*SyncTasksResult, error) {
+	if req == nil {
+		return nil, ErrSyncTasksRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	since, err := domaintask.DecodeSyncToken(req.SinceToken)
+	if err != nil {
+		h.logger.Warn("invalid sync token", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	page, err := h.syncRepo.ListTaskChanges(ctx, userID, domaintask.SyncTasksQuery{
+		Since:    since,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		if isSyncQueryError(err) {
+			h.logger.Info("sync rejected", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		h.logger.Error("failed to list task changes", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	result := &SyncTasksResult{
+		Tasks:         make([]TaskItem, 0, len(page.Changes)),
+		Tombstones:    make([]TombstoneItem, 0),
+		NextSyncToken: page.Next.Encode(),
+		HasMore:       page.HasMore,
+	}
+
+	for _, change := range page.Changes {
+		if change.IsTombstone() {
+			result.Tombstones = append(result.Tombstones, TombstoneItem{
+				TaskID:    change.TaskID().String(),
+				Reason:    change.ChangeType(),
+				RemovedAt: change.ChangedAt(),
+			})
+
+			continue
+		}
+
+		result.Tasks = append(result.Tasks, toTaskItem(change.Task()))
+	}
+
+	h.logger.Info("tasks synced",
+		slog.Bool("full_sync", since.Seq() == 0),
+		slog.Int("tasks", len(result.Tasks)),
+		slog.Int("tombstones", len(result.Tombstones)),
+		slog.Bool("has_more", result.HasMore),
+	)
+
+	return result, nil
+}
+
+func isSyncQueryError(err error) bool {
+	return errors.Is(err, domaintask.ErrSyncTokenExpired) || errors.Is(err, domaintask.ErrInvalidSyncPageSize)
+}
@@ -0,0 +1,156 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSyncTasksSuccess(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	task := newTestDeletedTask(t, userID, time.Now().Add(time.Hour)).Task()
+	changedAt := time.Now().UTC()
+
+	upsert, err := domaintask.NewUpsertedTaskChange(task, changedAt)
+	if err != nil {
+		t.Fatalf("failed to create change: %v", err)
+	}
+
+	goneID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	tombstone, err := domaintask.NewTombstone(goneID, domaintask.ChangeTypeCompleted, changedAt)
+	if err != nil {
+		t.Fatalf("failed to create tombstone: %v", err)
+	}
+
+	since := domaintask.NewSyncToken(7)
+	next := domaintask.NewSyncToken(9)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTaskSyncRepository(ctrl)
+	mockRepo.EXPECT().
+		ListTaskChanges(gomock.Any(), userID, domaintask.SyncTasksQuery{Since: since, PageSize: 100}).
+		Return(&domaintask.TaskChangesPage{
+			Changes: []*domaintask.TaskChange{upsert, tombstone},
+			Next:    next,
+			HasMore: true,
+		}, nil)
+
+	handler := NewSyncTasksHandler(mockAuth, mockRepo)
+
+	result, err := handler.SyncTasks(context.Background(), &SyncTasksRequest{
+		SessionToken: "token",
+		SinceToken:   since.Encode(),
+		PageSize:     100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Tasks) != 1 || result.Tasks[0].TaskID != task.ID().String() {
+		t.Errorf("expected the upserted task, got %+v", result.Tasks)
+	}
+
+	if len(result.Tombstones) != 1 {
+		t.Fatalf("expected one tombstone, got %+v", result.Tombstones)
+	}
+
+	if got := result.Tombstones[0]; got.TaskID != goneID.String() || got.Reason != domaintask.ChangeTypeCompleted || !got.RemovedAt.Equal(changedAt) {
+		t.Errorf("unexpected tombstone %+v", got)
+	}
+
+	if result.NextSyncToken != next.Encode() || !result.HasMore {
+		t.Errorf("unexpected next token %q (more: %v)", result.NextSyncToken, result.HasMore)
+	}
+}
+
+func TestSyncTasksError(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		req         *SyncTasksRequest
+		setup       func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskSyncRepository)
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskSyncRepository) {
+				return NewMockAuthClient(ctrl), domaintask.NewMockTaskSyncRepository(ctrl)
+			},
+			expectedErr: ErrSyncTasksRequestRequired,
+		},
+		{
+			name: "unauthorized",
+			req:  &SyncTasksRequest{SessionToken: "bad-token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskSyncRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").Return("", authclient.ErrUnauthorized)
+
+				return mockAuth, domaintask.NewMockTaskSyncRepository(ctrl)
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "invalid sync token",
+			req:  &SyncTasksRequest{SessionToken: "token", SinceToken: "not-a-token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskSyncRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				return mockAuth, domaintask.NewMockTaskSyncRepository(ctrl)
+			},
+			expectedErr: ErrInvalidSyncToken,
+		},
+		{
+			name: "expired sync token",
+			req:  &SyncTasksRequest{SessionToken: "token", SinceToken: domaintask.NewSyncToken(3).Encode()},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *domaintask.MockTaskSyncRepository) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				mockRepo := domaintask.NewMockTaskSyncRepository(ctrl)
+				mockRepo.EXPECT().ListTaskChanges(gomock.Any(), userID, gomock.Any()).Return(nil, domaintask.ErrSyncTokenExpired)
+
+				return mockAuth, mockRepo
+			},
+			expectedErr: ErrSyncTokenExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth, mockRepo := tt.setup(ctrl)
+
+			handler := NewSyncTasksHandler(mockAuth, mockRepo)
+
+			_, err := handler.SyncTasks(context.Background(), tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,509 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+)
+
+type TagItem struct {
+	TagID     string
+	Name      string
+	Color     string
+	CreatedAt time.Time
+}
+
+func toTagItem(tag *domaintask.Tag) TagItem {
+	return TagItem{
+		TagID:     tag.ID().String(),
+		Name:      tag.Name(),
+		Color:     tag.Color().String(),
+		CreatedAt: tag.CreatedAt(),
+	}
+}
+
+func toTagItems(tags []*domaintask.Tag) []TagItem {
+	items := make([]TagItem, 0, len(tags))
+	for _, tag := range tags {
+		items = append(items, toTagItem(tag))
+	}
+
+	return items
+}
+
+// TaskTagsResult lists the tags attached to a task after a change, ordered by name.
+type TaskTagsResult struct {
+	TaskID string
+	Tags   []TagItem
+}
+
+func parseTagID(logger *slog.Logger, tagIDstr string) (domaintask.TagID, error) {
+	if tagIDstr == "" {
+		logger.Warn("tag accessed with empty tag ID")
+
+		return domaintask.TagID{}, ErrTagIDRequired
+	}
+
+	tagID, err := domaintask.NewTagIDFromString(tagIDstr)
+	if err != nil {
+		logger.Warn("invalid tag ID format", slog.String("error", err.Error()))
+
+		return domaintask.TagID{}, err
+	}
+
+	return tagID, nil
+}
+
+// parseTagFilter parses the optional tag ID by which lists and searches are filtered.
+func parseTagFilter(tagIDstr *string) (*domaintask.TagID, error) {
+	if tagIDstr == nil {
+		return nil, nil
+	}
+
+	tagID, err := domaintask.NewTagIDFromString(*tagIDstr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagID, nil
+}
+
+// mapTagError turns repository errors into use case errors, logging unexpected ones.
+func mapTagError(logger *slog.Logger, err error, msg string) error {
+	switch {
+	case errors.Is(err, domaintask.ErrTaskNotFound):
+		logger.Info("task not found")
+
+		return ErrTaskNotFound
+	case errors.Is(err, domaintask.ErrTagNotFound):
+		logger.Info("tag not found")
+
+		return ErrTagNotFound
+	case errors.Is(err, domaintask.ErrTagNameAlreadyExists):
+		logger.Info("tag name already exists")
+
+		return ErrTagNameAlreadyExists
+	case errors.Is(err, domaintask.ErrTooManyTags):
+		logger.Info("task has too many tags")
+
+		return ErrTooManyTags
+	default:
+		logger.Error(msg, slog.String("error", err.Error()))
+
+		return err
+	}
+}
+
+type CreateTagRequest struct {
+	SessionToken string
+	Name         string
+	Color        string
+}
+
+type CreateTagUseCase interface {
+	CreateTag(ctx context.Context, req *CreateTagRequest) (*TagItem, error)
+}
+
+type createTagHandler struct {
+	authClient authclient.AuthClient
+	tagRepo    domaintask.TagRepository
+	logger     *slog.Logger
+}
+
+func NewCreateTagHandler(
+	authClient authclient.AuthClient,
+	tagRepo domaintask.TagRepository,
+) CreateTagUseCase {
+	return &createTagHandler{
+		authClient: authClient,
+		tagRepo:    tagRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("createtag"),
+	}
+}
+
+func (h *createTagHandler) CreateTag(ctx context.Context, req *CreateTagRequest) (*TagItem, error) {
+	if req == nil {
+		return nil, ErrCreateTagRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	color, err := domaintask.NewColor(req.Color)
+	if err != nil {
+		h.logger.Warn("invalid tag color", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	tag, err := domaintask.CreateTag(userID, req.Name, color)
+	if err != nil {
+		h.logger.Warn("invalid tag", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if err := h.tagRepo.SaveTag(ctx, tag); err != nil {
+		return nil, mapTagError(h.logger, err, "failed to save tag")
+	}
+
+	h.logger.Info("tag created", slog.String("tag_id", tag.ID().String()))
+
+	item := toTagItem(tag)
+
+	return &item, nil
+}
+
+type ListTagsRequest struct {
+	SessionToken string
+}
+
+type ListTagsResult struct {
+	Tags []TagItem
+}
+
+type ListTagsUseCase interface {
+	ListTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResult, error)
+}
+
+type listTagsHandler struct {
+	authClient authclient.AuthClient
+	tagRepo    domaintask.TagRepository
+	logger     *slog.Logger
+}
+
+func NewListTagsHandler(
+	authClient authclient.AuthClient,
+	tagRepo domaintask.TagRepository,
+) ListTagsUseCase {
+	return &listTagsHandler{
+		authClient: authClient,
+		tagRepo:    tagRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("listtags"),
+	}
+}
+
+func (h *listTagsHandler) ListTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResult, error) {
+	if req == nil {
+		return nil, ErrListTagsRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := h.tagRepo.ListTagsByUserID(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to list tags", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return &ListTagsResult{
+		Tags: toTagItems(tags),
+	}, nil
+}
+
+type UpdateTagRequest struct {
+	SessionToken string
+	TagID        string
+	Name         *string
+	Color        *string
+}
+
+type UpdateTagUseCase interface {
+	UpdateTag(ctx context.Context, req *UpdateTagRequest) (*TagItem, error)
+}
+
+type updateTagHandler struct {
+	authClient authclient.AuthClient
+	tagRepo    domaintask.TagRepository
+	transactor domaintask.Transactor
+	logger     *slog.Logger
+}
+
+func NewUpdateTagHandler(
+	authClient authclient.AuthClient,
+	tagRepo domaintask.TagRepository,
+	transactor domaintask.Transactor,
+) UpdateTagUseCase {
+	return &updateTagHandler{
+		authClient: authClient,
+		tagRepo:    tagRepo,
+		transactor: transactor,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("updatetag"),
+	}
+}
+
+func (h *updateTagHandler) UpdateTag(ctx context.Context, req *UpdateTagRequest) (*TagItem, error) {
+	if req == nil {
+		return nil, ErrUpdateTagRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tagID, err := parseTagID(h.logger, req.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	var color *domaintask.Color
+
+	if req.Color != nil {
+		c, err := domaintask.NewColor(*req.Color)
+		if err != nil {
+			h.logger.Warn("invalid tag color", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		color = &c
+	}
+
+	var updated *domaintask.Tag
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		tag, err := h.tagRepo.GetTagByID(ctx, tagID, userID)
+		if err != nil {
+			return mapTagError(h.logger, err, "failed to get tag")
+		}
+
+		updated, err = tag.Update(req.Name, color)
+		if err != nil {
+			h.logger.Warn("invalid tag update", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		if err := h.tagRepo.UpdateTag(ctx, updated); err != nil {
+			return mapTagError(h.logger, err, "failed to update tag")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("tag updated", slog.String("tag_id", tagID.String()))
+
+	item := toTagItem(updated)
+
+	return &item, nil
+}
+
+type DeleteTagRequest struct {
+	SessionToken string
+	TagID        string
+}
+
+type DeleteTagUseCase interface {
+	DeleteTag(ctx context.Context, req *DeleteTagRequest) error
+}
+
+type deleteTagHandler struct {
+	authClient authclient.AuthClient
+	tagRepo    domaintask.TagRepository
+	logger     *slog.Logger
+}
+
+func NewDeleteTagHandler(
+	authClient authclient.AuthClient,
+	tagRepo domaintask.TagRepository,
+) DeleteTagUseCase {
+	return &deleteTagHandler{
+		authClient: authClient,
+		tagRepo:    tagRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("deletetag"),
+	}
+}
+
+func (h *deleteTagHandler) DeleteTag(ctx context.Context, req *DeleteTagRequest) error {
+	if req == nil {
+		return ErrDeleteTagRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return err
+	}
+
+	tagID, err := parseTagID(h.logger, req.TagID)
+	if err != nil {
+		return err
+	}
+
+	if err := h.tagRepo.DeleteTag(ctx, tagID, userID); err != nil {
+		return mapTagError(h.logger, err, "failed to delete tag")
+	}
+
+	h.logger.Info("tag deleted", slog.String("tag_id", tagID.String()))
+
+	return nil
+}
+
+type AttachTagRequest struct {
+	SessionToken string
+	TaskID       string
+	TagID        string
+}
+
+type AttachTagUseCase interface {
+	AttachTag(ctx context.Context, req *AttachTagRequest) (*TaskTagsResult, error)
+}
+
+type attachTagHandler struct {
+	authClient authclient.AuthClient
+	tagRepo    domaintask.TagRepository
+	transactor domaintask.Transactor
+	publisher  taskevent.Publisher
+	logger     *slog.Logger
+}
+
+func NewAttachTagHandler(
+	authClient authclient.AuthClient,
+	tagRepo domaintask.TagRepository,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) AttachTagUseCase {
+	return &attachTagHandler{
+		authClient: authClient,
+		tagRepo:    tagRepo,
+		transactor: transactor,
+		publisher:  publisher,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("attachtag"),
+	}
+}
+
+func (h *attachTagHandler) AttachTag(ctx context.Context, req *AttachTagRequest) (*TaskTagsResult, error) {
+	if req == nil {
+		return nil, ErrAttachTagRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagID, err := parseTagID(h.logger, req.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*domaintask.Tag
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.tagRepo.AttachTag(ctx, taskID, tagID, userID); err != nil {
+			return mapTagError(h.logger, err, "failed to attach tag")
+		}
+
+		tags, err = h.tagRepo.ListTaskTags(ctx, taskID, userID)
+		if err != nil {
+			return mapTagError(h.logger, err, "failed to list task tags")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, taskID)
+
+	h.logger.Info("tag attached",
+		slog.String("task_id", taskID.String()),
+		slog.String("tag_id", tagID.String()),
+	)
+
+	return &TaskTagsResult{
+		TaskID: taskID.String(),
+		Tags:   toTagItems(tags),
+	}, nil
+}
+
+type DetachTagRequest struct {
+	SessionToken string
+	TaskID       string
+	TagID        string
+}
+
+type DetachTagUseCase interface {
+	DetachTag(ctx context.Context, req *DetachTagRequest) (*TaskTagsResult, error)
+}
+
+type detachTagHandler struct {
+	authClient authclient.AuthClient
+	tagRepo    domaintask.TagRepository
+	transactor domaintask.Transactor
+	publisher  taskevent.Publisher
+	logger     *slog.Logger
+}
+
+func NewDetachTagHandler(
+	authClient authclient.AuthClient,
+	tagRepo domaintask.TagRepository,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) DetachTagUseCase {
+	return &detachTagHandler{
+		authClient: authClient,
+		tagRepo:    tagRepo,
+		transactor: transactor,
+		publisher:  publisher,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("detachtag"),
+	}
+}
+
+func (h *detachTagHandler) DetachTag(ctx context.Context, req *DetachTagRequest) (*TaskTagsResult, error) {
+	if req == nil {
+		return nil, ErrDetachTagRequestRequired
+	}
+
+	userID, taskID, err := resolveUserTask(ctx, h.authClient, h.logger, req.SessionToken, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagID, err := parseTagID(h.logger, req.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*domaintask.Tag
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.tagRepo.DetachTag(ctx, taskID, tagID, userID); err != nil {
+			return mapTagError(h.logger, err, "failed to detach tag")
+		}
+
+		tags, err = h.tagRepo.ListTaskTags(ctx, taskID, userID)
+		if err != nil {
+			return mapTagError(h.logger, err, "failed to list task tags")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, taskID)
+
+	h.logger.Info("tag detached",
+		slog.String("task_id", taskID.String()),
+		slog.String("tag_id", tagID.String()),
+	)
+
+	return &TaskTagsResult{
+		TaskID: taskID.String(),
+		Tags:   toTagItems(tags),
+	}, nil
+}
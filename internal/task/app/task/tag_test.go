@@ -0,0 +1,324 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateTagSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTagRepository(ctrl)
+	mockRepo.EXPECT().SaveTag(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tag *domaintask.Tag) error {
+			if tag.Name() != "work" || tag.UserID() != userID || tag.Color().String() != "#4ECDC4" {
+				t.Fatalf("unexpected tag %q %q", tag.Name(), tag.Color())
+			}
+
+			return nil
+		})
+
+	handler := NewCreateTagHandler(mockAuth, mockRepo)
+
+	result, err := handler.CreateTag(ctx, &CreateTagRequest{
+		SessionToken: "valid-token",
+		Name:         " work ",
+		Color:        "#4ecdc4",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.TagID == "" || result.Name != "work" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestUpdateTagSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	tag, err := domaintask.CreateTag(userID, "work", domaintask.MustColor("#4ECDC4"))
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTagRepository(ctrl)
+	mockRepo.EXPECT().GetTagByID(gomock.Any(), tag.ID(), userID).Return(tag, nil)
+	mockRepo.EXPECT().UpdateTag(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, updated *domaintask.Tag) error {
+			if updated.Name() != "work" || updated.Color().String() != "#FF6B6B" {
+				t.Fatalf("expected only the color to change, got %q %q", updated.Name(), updated.Color())
+			}
+
+			return nil
+		})
+
+	handler := NewUpdateTagHandler(mockAuth, mockRepo, inlineTransactor{})
+
+	color := "#FF6B6B"
+
+	result, err := handler.UpdateTag(ctx, &UpdateTagRequest{
+		SessionToken: "valid-token",
+		TagID:        tag.ID().String(),
+		Color:        &color,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Color != "#FF6B6B" {
+		t.Errorf("expected updated color, got %q", result.Color)
+	}
+}
+
+func TestAttachTagSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	tag, err := domaintask.CreateTag(userID, "work", domaintask.MustColor("#4ECDC4"))
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTagRepository(ctrl)
+	gomock.InOrder(
+		mockRepo.EXPECT().AttachTag(gomock.Any(), taskID, tag.ID(), userID).Return(nil),
+		mockRepo.EXPECT().ListTaskTags(gomock.Any(), taskID, userID).Return([]*domaintask.Tag{tag}, nil),
+	)
+
+	handler := NewAttachTagHandler(mockAuth, mockRepo, inlineTransactor{}, expectTaskUpdated(t, ctrl, userID, taskID))
+
+	result, err := handler.AttachTag(ctx, &AttachTagRequest{
+		SessionToken: "valid-token",
+		TaskID:       taskID.String(),
+		TagID:        tag.ID().String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.TaskID != taskID.String() || len(result.Tags) != 1 || result.Tags[0].Name != "work" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestTagError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	tagID, err := domaintask.NewTagID()
+	if err != nil {
+		t.Fatalf("failed to generate tag id: %v", err)
+	}
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	tests := []struct {
+		name        string
+		call        func(authclient.AuthClient, domaintask.TagRepository) error
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		setupRepo   func(ctrl *gomock.Controller) domaintask.TagRepository
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewCreateTagHandler(auth, repo).CreateTag(ctx, nil)
+
+				return err
+			},
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrCreateTagRequestRequired,
+		},
+		{
+			name: "unauthorized session",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewListTagsHandler(auth, repo).ListTags(ctx, &ListTagsRequest{SessionToken: "bad-token"})
+
+				return err
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "empty tag name",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewCreateTagHandler(auth, repo).CreateTag(ctx, &CreateTagRequest{
+					SessionToken: "valid-token",
+					Name:         " ",
+					Color:        "#4ECDC4",
+				})
+
+				return err
+			},
+			setupAuth:   validAuth,
+			expectedErr: ErrTagNameEmpty,
+		},
+		{
+			name: "duplicate tag name",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewCreateTagHandler(auth, repo).CreateTag(ctx, &CreateTagRequest{
+					SessionToken: "valid-token",
+					Name:         "work",
+					Color:        "#4ECDC4",
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.TagRepository {
+				mockRepo := domaintask.NewMockTagRepository(ctrl)
+				mockRepo.EXPECT().SaveTag(gomock.Any(), gomock.Any()).Return(domaintask.ErrTagNameAlreadyExists)
+
+				return mockRepo
+			},
+			expectedErr: ErrTagNameAlreadyExists,
+		},
+		{
+			name: "empty tag ID",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				return NewDeleteTagHandler(auth, repo).DeleteTag(ctx, &DeleteTagRequest{SessionToken: "valid-token"})
+			},
+			setupAuth:   validAuth,
+			expectedErr: ErrTagIDRequired,
+		},
+		{
+			name: "too many tags",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewAttachTagHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).AttachTag(ctx, &AttachTagRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+					TagID:        tagID.String(),
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.TagRepository {
+				mockRepo := domaintask.NewMockTagRepository(ctrl)
+				mockRepo.EXPECT().AttachTag(gomock.Any(), taskID, tagID, validUserID).Return(domaintask.ErrTooManyTags)
+
+				return mockRepo
+			},
+			expectedErr: ErrTooManyTags,
+		},
+		{
+			name: "tag not found on detach",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewDetachTagHandler(auth, repo, inlineTransactor{}, taskevent.NewNoopBroker()).DetachTag(ctx, &DetachTagRequest{
+					SessionToken: "valid-token",
+					TaskID:       taskID.String(),
+					TagID:        tagID.String(),
+				})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.TagRepository {
+				mockRepo := domaintask.NewMockTagRepository(ctrl)
+				mockRepo.EXPECT().DetachTag(gomock.Any(), taskID, tagID, validUserID).Return(domaintask.ErrTaskNotFound)
+
+				return mockRepo
+			},
+			expectedErr: ErrTaskNotFound,
+		},
+		{
+			name: "repository error",
+			call: func(auth authclient.AuthClient, repo domaintask.TagRepository) error {
+				_, err := NewListTagsHandler(auth, repo).ListTags(ctx, &ListTagsRequest{SessionToken: "valid-token"})
+
+				return err
+			},
+			setupAuth: validAuth,
+			setupRepo: func(ctrl *gomock.Controller) domaintask.TagRepository {
+				mockRepo := domaintask.NewMockTagRepository(ctrl)
+				mockRepo.EXPECT().ListTagsByUserID(gomock.Any(), validUserID).Return(nil, errors.New("database error"))
+
+				return mockRepo
+			},
+			expectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var repo domaintask.TagRepository
+			if tt.setupRepo != nil {
+				repo = tt.setupRepo(ctrl)
+			}
+
+			err := tt.call(tt.setupAuth(ctrl), repo)
+			if err == nil {
+				t.Fatalf("expected error %v, got nil", tt.expectedErr)
+			}
+
+			if !errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error() {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
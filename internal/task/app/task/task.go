@@ -14,8 +14,34 @@ import (
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 )
 
+// Recurrence is an RRULE evaluated in an IANA timezone (UTC when empty)
+type Recurrence struct {
+	Rule     string
+	Timezone string
+}
+
+func toRecurrence(recurrence *domaintask.Recurrence) *Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &Recurrence{
+		Rule:     recurrence.Rule(),
+		Timezone: recurrence.Timezone(),
+	}
+}
+
+func toDomainRecurrence(recurrence *Recurrence) (*domaintask.Recurrence, error) {
+	if recurrence == nil {
+		return nil, nil
+	}
+
+	return domaintask.NewRecurrence(recurrence.Rule, recurrence.Timezone)
+}
+
 type CreateTaskRequest struct {
 	TaskID       string
 	SessionToken string
@@ -24,6 +50,7 @@ type CreateTaskRequest struct {
 	Description  string
 	ScheduledAt  *time.Time
 	Color        string
+	Recurrence   *Recurrence
 }
 
 type CreateTaskResult struct {
@@ -36,6 +63,8 @@ type CreateTaskResult struct {
 	CreatedAt   time.Time
 	TargetAt    time.Time
 	Color       string
+	Recurrence  *Recurrence
+	Version     int64
 }
 
 type CreateTaskUseCase interface {
@@ -47,7 +76,11 @@ type createTaskHandler struct {
 	deviceClient      deviceclient.DeviceClient
 	taskRepo          domaintask.TaskRepository
 	periodSettingRepo period.PeriodSettingRepository
+	intervalRepo      period.ReminderIntervalSettingRepository
+	quietHoursRepo    period.QuietHoursSettingRepository
 	remindQueue       remindregister.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
 	logger            *slog.Logger
 }
 
@@ -56,14 +89,22 @@ func NewCreateTaskHandler(
 	deviceClient deviceclient.DeviceClient,
 	taskRepo domaintask.TaskRepository,
 	periodSettingRepo period.PeriodSettingRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
 	remindQueue remindregister.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
 ) CreateTaskUseCase {
 	return &createTaskHandler{
 		authClient:        authClient,
 		deviceClient:      deviceClient,
 		taskRepo:          taskRepo,
 		periodSettingRepo: periodSettingRepo,
+		intervalRepo:      intervalRepo,
+		quietHoursRepo:    quietHoursRepo,
 		remindQueue:       remindQueue,
+		transactor:        transactor,
+		publisher:         publisher,
 		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("createtask"),
 	}
 }
@@ -128,22 +169,16 @@ func (h *createTaskHandler) CreateTask(ctx context.Context, req *CreateTaskReque
 		return nil, err
 	}
 
-	// Fetch user period settings for custom period
-	var customPeriod *time.Duration
+	recurrence, err := toDomainRecurrence(req.Recurrence)
+	if err != nil {
+		h.logger.Warn("invalid recurrence", slog.String("error", err.Error()))
 
-	if req.TaskType != domaintask.TypeScheduled && h.periodSettingRepo != nil {
-		periodSettings, err := h.periodSettingRepo.GetByUserID(ctx, userID)
-		if err != nil {
-			h.logger.Warn("failed to get period settings, using defaults", slog.String("error", err.Error()))
-			// Continue with default period on error
-		} else if period, ok := periodSettings.GetPeriod(req.TaskType); ok {
-			customPeriod = &period
-			h.logger.Debug("using custom period for task type",
-				slog.String("task_type", string(req.TaskType)),
-				slog.Duration("period", period))
-		}
+		return nil, err
 	}
 
+	// Fetch user period settings for custom period
+	customPeriod := lookupCustomPeriod(ctx, h.periodSettingRepo, userID, req.TaskType, h.logger)
+
 	task, err := domaintask.CreateTask(
 		taskID,
 		userID,
@@ -153,6 +188,7 @@ func (h *createTaskHandler) CreateTask(ctx context.Context, req *CreateTaskReque
 		req.ScheduledAt,
 		color,
 		customPeriod,
+		domaintask.WithRecurrence(recurrence),
 	)
 	if err != nil {
 		h.logger.Warn("failed to create task entity", slog.String("error", err.Error()))
@@ -160,47 +196,19 @@ func (h *createTaskHandler) CreateTask(ctx context.Context, req *CreateTaskReque
 		return nil, err
 	}
 
-	devices, err := h.deviceClient.GetUserDevicesWithRetry(ctx, req.SessionToken, deviceclient.DefaultRetryConfig())
+	validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, req.SessionToken, h.logger)
 	if err != nil {
-		if errors.Is(err, deviceclient.ErrUnauthorized) {
-			h.logger.Info("device service: unauthorized", slog.String("error", err.Error()))
-
-			return nil, ErrUnauthorized
-		}
-
-		if errors.Is(err, deviceclient.ErrInvalidArgument) {
-			h.logger.Error("device service: invalid argument", slog.String("error", err.Error()))
-
-			return nil, ErrDeviceInvalidArgument
-		}
-
-		h.logger.Warn("device fetch failed after retries, returning create failure",
+		h.logger.Warn("device fetch failed, returning create failure",
 			slog.String("task_id", task.ID().String()),
 			slog.String("error", err.Error()))
 
-		return nil, ErrDeviceServiceUnavailable
-	}
-
-	domainDevices := make([]domaintask.DeviceInfo, 0, len(devices))
-	for _, d := range devices {
-		domainDevices = append(domainDevices, domaintask.DeviceInfo{
-			DeviceID: d.DeviceID,
-			FCMToken: d.FCMToken,
-		})
-	}
-
-	validDevices, filteredCount := filterDevicesWithFCMToken(domainDevices)
-	if filteredCount > 0 {
-		h.logger.Warn("devices filtered out due to missing FCM token",
-			slog.Int("filtered_count", filteredCount),
-			slog.Int("remaining_count", len(validDevices)),
-			slog.Int("total_count", len(domainDevices)),
-		)
+		return nil, err
 	}
 
 	var reminderInfo *domaintask.ReminderInfo
 	if len(validDevices) > 0 {
-		reminderInfo = domaintask.CalculateReminderTimes(task, userIDstr, validDevices)
+		prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, userID, h.logger)
+		reminderInfo = domaintask.CalculateReminderTimes(task, userIDstr, validDevices, prefs)
 	} else if len(domainDevices) > 0 {
 		h.logger.Info("reminder registration skipped: all devices don't have FCM tokens",
 			slog.String("task_id", task.ID().String()),
@@ -211,44 +219,52 @@ func (h *createTaskHandler) CreateTask(ctx context.Context, req *CreateTaskReque
 	var remindReq *remindregister.CreateRemindRequest
 
 	if reminderInfo != nil {
-		h.logReminderInfo(reminderInfo)
+		logReminderInfo(h.logger, reminderInfo)
 
-		remindReq = h.convertToRemindRequest(reminderInfo)
+		remindReq = convertToRemindRequest(reminderInfo)
 	}
 
-	if err := h.taskRepo.SaveTask(ctx, task); err != nil {
-		h.logger.Error("failed to save task", slog.String("error", err.Error()))
+	// The task and its remind request commit together, so a failure leaves neither behind
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.taskRepo.SaveTask(ctx, task); err != nil {
+			if errors.Is(err, domaintask.ErrTaskIDAlreadyExists) {
+				h.logger.Warn("attempted to create task with duplicate ID", slog.String("task_id", task.ID().String()))
 
-		return nil, err
-	}
+				return err
+			}
 
-	if remindReq != nil {
-		if _, err := h.remindQueue.RegisterRemind(ctx, remindReq); err != nil {
-			h.logger.Error("failed to register remind to queue",
-				slog.String("task_id", task.ID().String()),
-				slog.String("error", err.Error()))
+			h.logger.Error("failed to save task", slog.String("error", err.Error()))
+
+			return err
+		}
 
-			if deleteErr := h.taskRepo.DeleteTask(ctx, task.ID(), userID); deleteErr != nil {
-				h.logger.Error("failed to rollback task after queue registration failure",
+		if remindReq != nil {
+			if _, err := h.remindQueue.RegisterRemind(ctx, remindReq); err != nil {
+				h.logger.Error("failed to register remind to queue",
 					slog.String("task_id", task.ID().String()),
-					slog.String("error", deleteErr.Error()),
-				)
-			}
+					slog.String("error", err.Error()))
 
-			return nil, ErrRemindQueueRegistrationFailed
+				return ErrRemindQueueRegistrationFailed
+			}
 		}
-	}
 
-	if err := h.taskRepo.UpdateTaskStatus(ctx, task.ID(), userID, domaintask.StatusActive); err != nil {
-		h.logger.Error("failed to update task status to active",
-			slog.String("task_id", task.ID().String()),
-			slog.String("error", err.Error()))
+		if err := h.taskRepo.UpdateTaskStatus(ctx, task.ID(), userID, domaintask.StatusActive); err != nil {
+			h.logger.Error("failed to update task status to active",
+				slog.String("task_id", task.ID().String()),
+				slog.String("error", err.Error()))
+
+			return err
+		}
 
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
 	h.logger.Info("task created", slog.String("task_id", task.ID().String()))
 
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeCreated, userID, task.ID())
+
 	return &CreateTaskResult{
 		TaskID:      task.ID().String(),
 		Title:       task.Title(),
@@ -259,65 +275,11 @@ func (h *createTaskHandler) CreateTask(ctx context.Context, req *CreateTaskReque
 		CreatedAt:   task.CreatedAt(),
 		TargetAt:    task.TargetAt(),
 		Color:       task.Color().String(),
+		Recurrence:  toRecurrence(task.Recurrence()),
+		Version:     task.Version(),
 	}, nil
 }
 
-func (h *createTaskHandler) logReminderInfo(info *domaintask.ReminderInfo) {
-	reminderTimesStr := make([]string, len(info.ReminderTimes))
-	for i, t := range info.ReminderTimes {
-		reminderTimesStr[i] = t.Format(time.RFC3339)
-	}
-
-	h.logger.Info("reminder schedule prepared",
-		slog.String("task_id", info.TaskID.String()),
-		slog.String("task_type", string(info.TaskType)),
-		slog.Int("reminder_count", len(info.ReminderTimes)),
-		slog.Int("device_count", len(info.Devices)),
-	)
-
-	h.logger.Debug("reminder times calculated",
-		slog.String("task_id", info.TaskID.String()),
-		slog.Any("reminder_times", reminderTimesStr),
-	)
-}
-
-func (h *createTaskHandler) convertToRemindRequest(info *domaintask.ReminderInfo) *remindregister.CreateRemindRequest {
-	devices := make([]remindregister.DeviceRequest, 0, len(info.Devices))
-	for _, d := range info.Devices {
-		fcmToken := ""
-		if d.FCMToken != nil {
-			fcmToken = *d.FCMToken
-		}
-
-		devices = append(devices, remindregister.DeviceRequest{
-			DeviceID: d.DeviceID,
-			FCMToken: fcmToken,
-		})
-	}
-
-	return &remindregister.CreateRemindRequest{
-		Times:    info.ReminderTimes,
-		UserID:   info.UserID,
-		Devices:  devices,
-		TaskID:   info.TaskID.String(),
-		TaskType: string(info.TaskType),
-		Color:    info.Color,
-	}
-}
-
-func filterDevicesWithFCMToken(devices []domaintask.DeviceInfo) ([]domaintask.DeviceInfo, int) {
-	valid := make([]domaintask.DeviceInfo, 0, len(devices))
-	for _, d := range devices {
-		if d.FCMToken != nil && *d.FCMToken != "" {
-			valid = append(valid, d)
-		}
-	}
-
-	filteredCount := len(devices) - len(valid)
-
-	return valid, filteredCount
-}
-
 type GetTaskRequest struct {
 	SessionToken string
 	TaskID       string
@@ -333,6 +295,10 @@ type GetTaskResult struct {
 	CreatedAt   time.Time
 	TargetAt    time.Time
 	Color       string
+	Recurrence  *Recurrence
+	Checklist   ChecklistProgress
+	Tags        []TagItem
+	Version     int64
 }
 
 type GetTaskUseCase interface {
@@ -417,16 +383,29 @@ func (h *getTaskHandler) GetTask(ctx context.Context, req *GetTaskRequest) (*Get
 		CreatedAt:   task.CreatedAt(),
 		TargetAt:    task.TargetAt(),
 		Color:       task.Color().String(),
+		Recurrence:  toRecurrence(task.Recurrence()),
+		Checklist:   toChecklistProgress(task.ChecklistProgress()),
+		Tags:        toTagItems(task.Tags()),
+		Version:     task.Version(),
 	}, nil
 }
 
 type ListActiveTasksRequest struct {
-	SessionToken string
-	SortType     domaintask.SortType
+	SessionToken  string
+	SortType      domaintask.SortType
+	SortDirection domaintask.SortDirection // ascending when empty
+	PageSize      int
+	PageToken     string
+	TaskType      *domaintask.Type
+	Color         *string
+	TargetAtFrom  *time.Time
+	TargetAtTo    *time.Time
+	TagID         *string
 }
 
 type ListActiveTasksResult struct {
-	Tasks []TaskItem
+	Tasks         []TaskItem
+	NextPageToken string
 }
 
 type TaskItem struct {
@@ -439,6 +418,28 @@ type TaskItem struct {
 	CreatedAt   time.Time
 	TargetAt    time.Time
 	Color       string
+	Recurrence  *Recurrence
+	Checklist   ChecklistProgress
+	Tags        []TagItem
+	Version     int64
+}
+
+func toTaskItem(task *domaintask.Task) TaskItem {
+	return TaskItem{
+		TaskID:      task.ID().String(),
+		Title:       task.Title(),
+		TaskType:    task.TaskType(),
+		TaskStatus:  task.TaskStatus(),
+		Description: task.Description(),
+		ScheduledAt: task.ScheduledAt(),
+		CreatedAt:   task.CreatedAt(),
+		TargetAt:    task.TargetAt(),
+		Color:       task.Color().String(),
+		Recurrence:  toRecurrence(task.Recurrence()),
+		Checklist:   toChecklistProgress(task.ChecklistProgress()),
+		Tags:        toTagItems(task.Tags()),
+		Version:     task.Version(),
+	}
 }
 
 type ListActiveTasksUseCase interface {
@@ -487,7 +488,14 @@ func (h *listActiveTasksHandler) ListActiveTasks(ctx context.Context, req *ListA
 		return nil, err
 	}
 
-	tasks, err := h.taskRepo.ListActiveTasksByUserID(ctx, userID, req.SortType)
+	query, err := h.buildQuery(req)
+	if err != nil {
+		h.logger.Warn("invalid list active tasks query", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	tasks, nextCursor, err := h.taskRepo.ListActiveTasksByUserID(ctx, userID, query)
 	if err != nil {
 		h.logger.Error("failed to list active tasks", slog.String("error", err.Error()))
 
@@ -495,28 +503,84 @@ func (h *listActiveTasksHandler) ListActiveTasks(ctx context.Context, req *ListA
 	}
 
 	result := &ListActiveTasksResult{
-		Tasks: make([]TaskItem, 0, len(tasks)),
+		Tasks:         make([]TaskItem, 0, len(tasks)),
+		NextPageToken: "",
+	}
+
+	if nextCursor != nil {
+		result.NextPageToken = nextCursor.Encode()
 	}
 
 	for _, task := range tasks {
-		result.Tasks = append(result.Tasks, TaskItem{
-			TaskID:      task.ID().String(),
-			Title:       task.Title(),
-			TaskType:    task.TaskType(),
-			TaskStatus:  task.TaskStatus(),
-			Description: task.Description(),
-			ScheduledAt: task.ScheduledAt(),
-			CreatedAt:   task.CreatedAt(),
-			TargetAt:    task.TargetAt(),
-			Color:       task.Color().String(),
-		})
+		result.Tasks = append(result.Tasks, toTaskItem(task))
 	}
 
-	h.logger.Info("active tasks listed", slog.Int("count", len(result.Tasks)))
+	h.logger.Info("active tasks listed",
+		slog.Int("count", len(result.Tasks)),
+		slog.Bool("has_next_page", result.NextPageToken != ""),
+	)
 
 	return result, nil
 }
 
+func (h *listActiveTasksHandler) buildQuery(req *ListActiveTasksRequest) (domaintask.ListActiveTasksQuery, error) {
+	query := domaintask.ListActiveTasksQuery{
+		SortType:      req.SortType,
+		SortDirection: req.SortDirection,
+		Filter: domaintask.ActiveTaskFilter{
+			TaskType:     req.TaskType,
+			Color:        nil,
+			TargetAtFrom: req.TargetAtFrom,
+			TargetAtTo:   req.TargetAtTo,
+			TagID:        nil,
+		},
+		PageSize: 0,
+		Cursor:   nil,
+	}
+
+	if _, err := req.SortType.OrderQuery(req.SortDirection); err != nil {
+		return query, err
+	}
+
+	pageSize, err := domaintask.NormalizePageSize(req.PageSize)
+	if err != nil {
+		return query, err
+	}
+
+	query.PageSize = pageSize
+
+	if req.Color != nil {
+		color, err := domaintask.NewColor(*req.Color)
+		if err != nil {
+			return query, err
+		}
+
+		query.Filter.Color = &color
+	}
+
+	tagID, err := parseTagFilter(req.TagID)
+	if err != nil {
+		return query, err
+	}
+
+	query.Filter.TagID = tagID
+
+	if err := query.Filter.Validate(); err != nil {
+		return query, err
+	}
+
+	if req.PageToken != "" {
+		cursor, err := domaintask.DecodePageCursor(req.PageToken, req.SortType, req.SortDirection)
+		if err != nil {
+			return query, err
+		}
+
+		query.Cursor = cursor
+	}
+
+	return query, nil
+}
+
 type UpdateTaskRequest struct {
 	SessionToken     string
 	TaskID           string
@@ -527,6 +591,10 @@ type UpdateTaskRequest struct {
 	ScheduledAt      *time.Time
 	ClearScheduledAt bool
 	Color            *string
+	Recurrence       *Recurrence // cleared when "recurrence" is in the update mask but this is nil
+	// ExpectedVersion rejects the update with a TaskVersionConflictError when the task is
+	// at another version. Without it the update applies to whatever version is stored.
+	ExpectedVersion *int64
 }
 
 type UpdateTaskResult struct {
@@ -539,6 +607,26 @@ type UpdateTaskResult struct {
 	CreatedAt   time.Time
 	TargetAt    time.Time
 	Color       string
+	Recurrence  *Recurrence
+	Checklist   ChecklistProgress
+	Tags        []TagItem
+	Version     int64
+	// NextOccurrence is set when completing a recurring task scheduled its next occurrence
+	NextOccurrence *TaskItem
+}
+
+// TaskVersionConflictError rejects an update made on an outdated version of the task. It
+// carries the current task so that the client can merge its edit and try again.
+type TaskVersionConflictError struct {
+	Current TaskItem
+}
+
+func (e *TaskVersionConflictError) Error() string {
+	return fmt.Sprintf("%s: task %s is at version %d", ErrTaskVersionConflict, e.Current.TaskID, e.Current.Version)
+}
+
+func (e *TaskVersionConflictError) Unwrap() error {
+	return ErrTaskVersionConflict
 }
 
 type UpdateTaskUseCase interface {
@@ -547,23 +635,41 @@ type UpdateTaskUseCase interface {
 
 type updateTaskHandler struct {
 	authClient        authclient.AuthClient
+	deviceClient      deviceclient.DeviceClient
 	taskRepo          domaintask.TaskRepository
 	archiveRepo       domaintask.TaskArchiveRepository
+	intervalRepo      period.ReminderIntervalSettingRepository
+	quietHoursRepo    period.QuietHoursSettingRepository
+	remindQueue       remindregister.Queue
 	cancelRemindQueue remindcancel.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
 	logger            *slog.Logger
 }
 
 func NewUpdateTaskHandler(
 	authClient authclient.AuthClient,
+	deviceClient deviceclient.DeviceClient,
 	taskRepo domaintask.TaskRepository,
 	archiveRepo domaintask.TaskArchiveRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	remindQueue remindregister.Queue,
 	cancelRemindQueue remindcancel.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
 ) UpdateTaskUseCase {
 	return &updateTaskHandler{
 		authClient:        authClient,
+		deviceClient:      deviceClient,
 		taskRepo:          taskRepo,
+		remindQueue:       remindQueue,
 		cancelRemindQueue: cancelRemindQueue,
 		archiveRepo:       archiveRepo,
+		intervalRepo:      intervalRepo,
+		quietHoursRepo:    quietHoursRepo,
+		transactor:        transactor,
+		publisher:         publisher,
 		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("updatetask"),
 	}
 }
@@ -593,6 +699,212 @@ func (h *updateTaskHandler) UpdateTask(ctx context.Context, req *UpdateTaskReque
 		return nil, err
 	}
 
+	update, err := h.prepareUpdate(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTask := update.updated
+
+	var nextTask *domaintask.Task
+
+	if update.completes() {
+		completedAt := time.Now()
+
+		completedTask, err := domaintask.NewCompletedTask(updatedTask, completedAt)
+		if err != nil {
+			h.logger.Error("failed to create completed task", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		nextTask, err = updatedTask.NextOccurrence(completedAt)
+		if err != nil {
+			h.logger.Error("failed to build next occurrence", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			cancelReq := &remindcancel.CancelRemindRequest{
+				TaskID: req.TaskID,
+				UserID: userIDstr,
+			}
+
+			if _, err := h.cancelRemindQueue.CancelRemind(ctx, cancelReq); err != nil {
+				h.logger.Error("failed to cancel remind",
+					slog.String("task_id", req.TaskID),
+					slog.String("error", err.Error()),
+				)
+
+				return ErrCancelRemindFailed
+			}
+
+			if nextTask == nil {
+				if err := h.archiveRepo.ArchiveTask(ctx, completedTask, updatedTask.ID(), userID, update.existing.Version()); err != nil {
+					h.logger.Error("failed to archive task", slog.String("error", err.Error()))
+
+					return err
+				}
+
+				return nil
+			}
+
+			if err := h.archiveRepo.ArchiveTaskWithNextOccurrence(ctx, completedTask, updatedTask.ID(), userID, update.existing.Version(), nextTask); err != nil {
+				h.logger.Error("failed to archive task and schedule next occurrence", slog.String("error", err.Error()))
+
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			if errors.Is(err, domaintask.ErrTaskVersionConflict) {
+				return nil, h.versionConflict(ctx, updatedTask)
+			}
+
+			if errors.Is(err, domaintask.ErrTaskNotFound) {
+				h.logger.Info("task disappeared before archiving", slog.String("task_id", req.TaskID))
+
+				return nil, ErrTaskNotFound
+			}
+
+			return nil, err
+		}
+
+		if nextTask != nil {
+			nextTask = h.activateNextOccurrence(ctx, nextTask, req.SessionToken, userIDstr)
+		}
+
+		h.logger.Info("task completed and archived", slog.String("task_id", updatedTask.ID().String()))
+	} else if update.reschedules() {
+		if err := h.rescheduleReminders(ctx, updatedTask, req.SessionToken, userIDstr); err != nil {
+			if errors.Is(err, domaintask.ErrTaskVersionConflict) {
+				return nil, h.versionConflict(ctx, updatedTask)
+			}
+
+			return nil, err
+		}
+
+		h.logger.Info("task updated and reminders rescheduled",
+			slog.String("task_id", updatedTask.ID().String()),
+			slog.Time("target_at", updatedTask.TargetAt()),
+		)
+	} else {
+		if err := h.taskRepo.UpdateTask(ctx, updatedTask); err != nil {
+			if errors.Is(err, domaintask.ErrTaskVersionConflict) {
+				return nil, h.versionConflict(ctx, updatedTask)
+			}
+
+			h.logger.Error("failed to update task", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		h.logger.Info("task updated successfully", slog.String("task_id", updatedTask.ID().String()))
+	}
+
+	h.publishUpdate(ctx, userID, update, nextTask)
+
+	return toUpdateTaskResult(updatedTask, nextTask), nil
+}
+
+// versionConflict reports that the task changed between reading it and saving the update,
+// along with its current state.
+func (h *updateTaskHandler) versionConflict(ctx context.Context, updatedTask *domaintask.Task) error {
+	current, err := h.taskRepo.GetTaskByID(ctx, updatedTask.ID(), updatedTask.UserID())
+	if err != nil {
+		if errors.Is(err, domaintask.ErrTaskNotFound) {
+			h.logger.Info("task disappeared during update", slog.String("task_id", updatedTask.ID().String()))
+
+			return ErrTaskNotFound
+		}
+
+		h.logger.Error("failed to get conflicting task", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	h.logger.Info("task changed during update",
+		slog.String("task_id", updatedTask.ID().String()),
+		slog.Int64("current_version", current.Version()),
+	)
+
+	return &TaskVersionConflictError{Current: toTaskItem(current)}
+}
+
+// publishUpdate announces a persisted update. Completing a task removes it from the active
+// list, and its next occurrence, if any, appears as a new task.
+func (h *updateTaskHandler) publishUpdate(
+	ctx context.Context,
+	userID domainuser.ID,
+	update *preparedUpdate,
+	nextTask *domaintask.Task,
+) {
+	if !update.completes() {
+		publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, update.updated.ID())
+
+		return
+	}
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeCompleted, userID, update.updated.ID())
+
+	if nextTask != nil {
+		publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeCreated, userID, nextTask.ID())
+	}
+}
+
+func toUpdateTaskResult(task *domaintask.Task, nextTask *domaintask.Task) *UpdateTaskResult {
+	result := &UpdateTaskResult{
+		TaskID:         task.ID().String(),
+		Title:          task.Title(),
+		TaskType:       task.TaskType(),
+		TaskStatus:     task.TaskStatus(),
+		Description:    task.Description(),
+		ScheduledAt:    task.ScheduledAt(),
+		CreatedAt:      task.CreatedAt(),
+		TargetAt:       task.TargetAt(),
+		Color:          task.Color().String(),
+		Recurrence:     toRecurrence(task.Recurrence()),
+		Checklist:      toChecklistProgress(task.ChecklistProgress()),
+		Tags:           toTagItems(task.Tags()),
+		Version:        task.Version(),
+		NextOccurrence: nil,
+	}
+
+	if nextTask != nil {
+		item := toTaskItem(nextTask)
+		result.NextOccurrence = &item
+	}
+
+	return result
+}
+
+// preparedUpdate is an update that has been validated against the stored task and only
+// remains to be persisted.
+type preparedUpdate struct {
+	existing *domaintask.Task
+	updated  *domaintask.Task
+	// recoverReminders is set when the update registers the reminders of a task whose
+	// reminders had failed
+	recoverReminders bool
+}
+
+func (u *preparedUpdate) completes() bool {
+	return u.updated.TaskStatus() == domaintask.StatusCompleted
+}
+
+// reschedules reports whether the update replaces the task's reminders.
+func (u *preparedUpdate) reschedules() bool {
+	return !u.completes() &&
+		(u.recoverReminders || !u.updated.TargetAt().Equal(u.existing.TargetAt()))
+}
+
+// prepareUpdate validates req and applies it to the stored task without persisting anything.
+func (h *updateTaskHandler) prepareUpdate(
+	ctx context.Context,
+	req *UpdateTaskRequest,
+	userID domainuser.ID,
+) (*preparedUpdate, error) {
 	if req.TaskID == "" {
 		return nil, ErrTaskIDRequired
 	}
@@ -608,6 +920,10 @@ func (h *updateTaskHandler) UpdateTask(ctx context.Context, req *UpdateTaskReque
 		return nil, domaintask.ErrNoFieldsToUpdate
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion <= 0 {
+		return nil, ErrInvalidExpectedVersion
+	}
+
 	updateInput, err := h.buildUpdateInput(req)
 	if err != nil {
 		return nil, err
@@ -626,6 +942,16 @@ func (h *updateTaskHandler) UpdateTask(ctx context.Context, req *UpdateTaskReque
 		return nil, err
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != existingTask.Version() {
+		h.logger.Info("update made on an outdated version",
+			slog.String("task_id", req.TaskID),
+			slog.Int64("expected_version", *req.ExpectedVersion),
+			slog.Int64("current_version", existingTask.Version()),
+		)
+
+		return nil, &TaskVersionConflictError{Current: toTaskItem(existingTask)}
+	}
+
 	if err := h.validateScheduledAtConstraint(existingTask, updateInput); err != nil {
 		h.logger.Warn("scheduled_at validation failed", slog.String("error", err.Error()))
 
@@ -639,56 +965,190 @@ func (h *updateTaskHandler) UpdateTask(ctx context.Context, req *UpdateTaskReque
 		return nil, err
 	}
 
-	if updatedTask.TaskStatus() == domaintask.StatusCompleted {
-		cancelReq := &remindcancel.CancelRemindRequest{
-			TaskID: req.TaskID,
-			UserID: userIDstr,
-		}
-
-		if _, err := h.cancelRemindQueue.CancelRemind(ctx, cancelReq); err != nil {
-			h.logger.Error("failed to cancel remind",
-				slog.String("task_id", req.TaskID),
-				slog.String("error", err.Error()),
-			)
-
-			return nil, ErrCancelRemindFailed
-		}
+	// Editing a task whose reminders failed registers them again, which reactivates it
+	recoverReminders := existingTask.TaskStatus() == domaintask.StatusReminderFailed &&
+		updatedTask.TaskStatus() != domaintask.StatusCompleted
 
-		completedTask, err := domaintask.NewCompletedTask(updatedTask, time.Now())
+	if recoverReminders && updatedTask.TaskStatus() == domaintask.StatusReminderFailed {
+		updatedTask, err = updatedTask.Activate()
 		if err != nil {
-			h.logger.Error("failed to create completed task", slog.String("error", err.Error()))
+			h.logger.Error("failed to reactivate task", slog.String("error", err.Error()))
 
 			return nil, err
 		}
+	}
 
-		if err := h.archiveRepo.ArchiveTask(ctx, completedTask, taskID, userID); err != nil {
-			h.logger.Error("failed to archive task", slog.String("error", err.Error()))
+	return &preparedUpdate{
+		existing:         existingTask,
+		updated:          updatedTask,
+		recoverReminders: recoverReminders,
+	}, nil
+}
 
-			return nil, err
-		}
+// activateNextOccurrence registers reminders for the next occurrence of a recurring task and
+// marks it active. The completion itself has already been committed, so failures here are only
+// logged and leave the occurrence in pending_reminders.
+func (h *updateTaskHandler) activateNextOccurrence(
+	ctx context.Context,
+	next *domaintask.Task,
+	sessionToken string,
+	userIDstr string,
+) *domaintask.Task {
+	logger := h.logger.With(slog.String("next_task_id", next.ID().String()))
+
+	validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, sessionToken, logger)
+	if err != nil {
+		logger.Warn("device fetch failed, next occurrence left pending", slog.String("error", err.Error()))
 
-		h.logger.Info("task completed and archived", slog.String("task_id", updatedTask.ID().String()))
-	} else {
-		if err := h.taskRepo.UpdateTask(ctx, updatedTask); err != nil {
-			h.logger.Error("failed to update task", slog.String("error", err.Error()))
+		return next
+	}
 
-			return nil, err
+	return h.activateNextOccurrenceWithDevices(ctx, next, userIDstr, validDevices, domainDevices)
+}
+
+// activateNextOccurrenceWithDevices is activateNextOccurrence for devices that have already
+// been fetched.
+func (h *updateTaskHandler) activateNextOccurrenceWithDevices(
+	ctx context.Context,
+	next *domaintask.Task,
+	userIDstr string,
+	validDevices []domaintask.DeviceInfo,
+	domainDevices []domaintask.DeviceInfo,
+) *domaintask.Task {
+	logger := h.logger.With(slog.String("next_task_id", next.ID().String()))
+
+	var reminderInfo *domaintask.ReminderInfo
+	if len(validDevices) > 0 {
+		prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, next.UserID(), logger)
+		reminderInfo = domaintask.CalculateReminderTimes(next, userIDstr, validDevices, prefs)
+	} else if len(domainDevices) > 0 {
+		logger.Info("reminder registration skipped: all devices don't have FCM tokens",
+			slog.Int("device_count", len(domainDevices)),
+		)
+	}
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if reminderInfo != nil {
+			logReminderInfo(logger, reminderInfo)
+
+			if _, err := h.remindQueue.RegisterRemind(ctx, convertToRemindRequest(reminderInfo)); err != nil {
+				logger.Error("failed to register remind for next occurrence", slog.String("error", err.Error()))
+
+				return err
+			}
 		}
 
-		h.logger.Info("task updated successfully", slog.String("task_id", updatedTask.ID().String()))
+		if err := h.taskRepo.UpdateTaskStatus(ctx, next.ID(), next.UserID(), domaintask.StatusActive); err != nil {
+			logger.Error("failed to update next occurrence status to active", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return next
 	}
 
-	return &UpdateTaskResult{
-		TaskID:      updatedTask.ID().String(),
-		Title:       updatedTask.Title(),
-		TaskType:    updatedTask.TaskType(),
-		TaskStatus:  updatedTask.TaskStatus(),
-		Description: updatedTask.Description(),
-		ScheduledAt: updatedTask.ScheduledAt(),
-		CreatedAt:   updatedTask.CreatedAt(),
-		TargetAt:    updatedTask.TargetAt(),
-		Color:       updatedTask.Color().String(),
-	}, nil
+	active, err := next.Activate()
+	if err != nil {
+		return next
+	}
+
+	logger.Info("next occurrence scheduled", slog.Time("scheduled_at", active.TargetAt()))
+
+	return active
+}
+
+// rescheduleReminders persists an update that moved the task's targetAt and replaces its
+// reminders with ones computed for the new deadline, all in one transaction.
+func (h *updateTaskHandler) rescheduleReminders(
+	ctx context.Context,
+	updatedTask *domaintask.Task,
+	sessionToken string,
+	userIDstr string,
+) error {
+	taskIDstr := updatedTask.ID().String()
+
+	validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, sessionToken, h.logger)
+	if err != nil {
+		h.logger.Warn("device fetch failed, returning update failure",
+			slog.String("task_id", taskIDstr),
+			slog.String("error", err.Error()))
+
+		return err
+	}
+
+	reminderInfo := h.rescheduledReminderInfo(ctx, updatedTask, userIDstr, validDevices, domainDevices)
+
+	return h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		cancelReq := &remindcancel.CancelRemindRequest{
+			TaskID: taskIDstr,
+			UserID: userIDstr,
+		}
+
+		if _, err := h.cancelRemindQueue.CancelRemind(ctx, cancelReq); err != nil {
+			h.logger.Error("failed to cancel remind",
+				slog.String("task_id", taskIDstr),
+				slog.String("error", err.Error()),
+			)
+
+			return ErrCancelRemindFailed
+		}
+
+		if err := h.taskRepo.UpdateTask(ctx, updatedTask); err != nil {
+			if !errors.Is(err, domaintask.ErrTaskVersionConflict) {
+				h.logger.Error("failed to update task", slog.String("error", err.Error()))
+			}
+
+			return err
+		}
+
+		if reminderInfo != nil {
+			logReminderInfo(h.logger, reminderInfo)
+
+			if _, err := h.remindQueue.RegisterRemind(ctx, convertToRemindRequest(reminderInfo)); err != nil {
+				h.logger.Error("failed to register remind to queue",
+					slog.String("task_id", taskIDstr),
+					slog.String("error", err.Error()))
+
+				return ErrRemindQueueRegistrationFailed
+			}
+		}
+
+		return nil
+	})
+}
+
+// rescheduledReminderInfo computes the reminders of an updated task from now on. It returns
+// nil when no reminder can be registered.
+func (h *updateTaskHandler) rescheduledReminderInfo(
+	ctx context.Context,
+	updatedTask *domaintask.Task,
+	userIDstr string,
+	validDevices []domaintask.DeviceInfo,
+	domainDevices []domaintask.DeviceInfo,
+) *domaintask.ReminderInfo {
+	taskIDstr := updatedTask.ID().String()
+	now := time.Now().UTC()
+
+	switch {
+	case !updatedTask.TargetAt().After(now):
+		h.logger.Info("reminder registration skipped: target time has already passed",
+			slog.String("task_id", taskIDstr),
+			slog.Time("target_at", updatedTask.TargetAt()),
+		)
+	case len(validDevices) > 0:
+		prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, updatedTask.UserID(), h.logger)
+
+		return domaintask.CalculateReminderTimesFrom(updatedTask, now, userIDstr, validDevices, prefs)
+	case len(domainDevices) > 0:
+		h.logger.Info("reminder registration skipped: all devices don't have FCM tokens",
+			slog.String("task_id", taskIDstr),
+			slog.Int("device_count", len(domainDevices)),
+		)
+	}
+
+	return nil
 }
 
 func (h *updateTaskHandler) buildUpdateInput(req *UpdateTaskRequest) (*domaintask.TaskUpdateInput, error) {
@@ -700,6 +1160,7 @@ func (h *updateTaskHandler) buildUpdateInput(req *UpdateTaskRequest) (*domaintas
 		"description":  true,
 		"scheduled_at": true,
 		"color":        true,
+		"recurrence":   true,
 	}
 
 	for _, field := range req.UpdateMask {
@@ -735,6 +1196,19 @@ func (h *updateTaskHandler) buildUpdateInput(req *UpdateTaskRequest) (*domaintas
 
 				input.Color = &color
 			}
+		case "recurrence":
+			if req.Recurrence == nil {
+				input.ClearRecurrence = true
+
+				continue
+			}
+
+			recurrence, err := toDomainRecurrence(req.Recurrence)
+			if err != nil {
+				return nil, err
+			}
+
+			input.Recurrence = recurrence
 		}
 	}
 
@@ -766,6 +1240,8 @@ type deleteTaskHandler struct {
 	authClient        authclient.AuthClient
 	taskRepo          domaintask.TaskRepository
 	cancelRemindQueue remindcancel.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
 	logger            *slog.Logger
 }
 
@@ -773,15 +1249,21 @@ func NewDeleteTaskHandler(
 	authClient authclient.AuthClient,
 	taskRepo domaintask.TaskRepository,
 	cancelRemindQueue remindcancel.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
 ) DeleteTaskUseCase {
 	return &deleteTaskHandler{
 		authClient:        authClient,
 		taskRepo:          taskRepo,
 		cancelRemindQueue: cancelRemindQueue,
+		transactor:        transactor,
+		publisher:         publisher,
 		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("deletetask"),
 	}
 }
 
+// DeleteTask moves the task to the trash and cancels its reminders. The task can be restored
+// until it is purged.
 func (h *deleteTaskHandler) DeleteTask(ctx context.Context, req *DeleteTaskRequest) error {
 	if req == nil {
 		return ErrDeleteTaskRequestRequired
@@ -820,34 +1302,498 @@ func (h *deleteTaskHandler) DeleteTask(ctx context.Context, req *DeleteTaskReque
 		return err
 	}
 
-	// Cancel remind before deleting task
-	cancelReq := &remindcancel.CancelRemindRequest{
-		TaskID: req.TaskID,
-		UserID: userIDstr,
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Cancel remind before deleting task
+		cancelReq := &remindcancel.CancelRemindRequest{
+			TaskID: req.TaskID,
+			UserID: userIDstr,
+		}
+
+		if _, err := h.cancelRemindQueue.CancelRemind(ctx, cancelReq); err != nil {
+			h.logger.Error("failed to cancel remind",
+				slog.String("task_id", req.TaskID),
+				slog.String("error", err.Error()),
+			)
+
+			return ErrCancelRemindFailed
+		}
+
+		if err := h.taskRepo.DeleteTask(ctx, taskID, userID); err != nil {
+			if errors.Is(err, domaintask.ErrTaskNotFound) {
+				h.logger.Info("task not found", slog.String("task_id", req.TaskID))
+
+				return ErrTaskNotFound
+			}
+
+			h.logger.Error("failed to delete task", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	h.logger.Info("task moved to trash", slog.String("task_id", req.TaskID))
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeDeleted, userID, taskID)
+
+	return nil
+}
+
+type SnoozeTaskRequest struct {
+	SessionToken string
+	TaskID       string
+	// Exactly one of Duration and Until must be set
+	Duration *time.Duration
+	Until    *time.Time
+}
+
+type SnoozeTaskResult struct {
+	TaskID      string
+	Title       string
+	TaskType    domaintask.Type
+	TaskStatus  domaintask.Status
+	Description string
+	ScheduledAt *time.Time
+	CreatedAt   time.Time
+	TargetAt    time.Time
+	Color       string
+	Recurrence  *Recurrence
+	Checklist   ChecklistProgress
+	Tags        []TagItem
+	Version     int64
+}
+
+type SnoozeTaskUseCase interface {
+	SnoozeTask(ctx context.Context, req *SnoozeTaskRequest) (*SnoozeTaskResult, error)
+}
+
+type snoozeTaskHandler struct {
+	authClient        authclient.AuthClient
+	deviceClient      deviceclient.DeviceClient
+	taskRepo          domaintask.TaskRepository
+	intervalRepo      period.ReminderIntervalSettingRepository
+	quietHoursRepo    period.QuietHoursSettingRepository
+	remindQueue       remindregister.Queue
+	cancelRemindQueue remindcancel.Queue
+	transactor        domaintask.Transactor
+	publisher         taskevent.Publisher
+	logger            *slog.Logger
+}
+
+func NewSnoozeTaskHandler(
+	authClient authclient.AuthClient,
+	deviceClient deviceclient.DeviceClient,
+	taskRepo domaintask.TaskRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	remindQueue remindregister.Queue,
+	cancelRemindQueue remindcancel.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) SnoozeTaskUseCase {
+	return &snoozeTaskHandler{
+		authClient:        authClient,
+		deviceClient:      deviceClient,
+		taskRepo:          taskRepo,
+		intervalRepo:      intervalRepo,
+		quietHoursRepo:    quietHoursRepo,
+		remindQueue:       remindQueue,
+		cancelRemindQueue: cancelRemindQueue,
+		transactor:        transactor,
+		publisher:         publisher,
+		logger:            slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("snoozetask"),
+	}
+}
+
+func (h *snoozeTaskHandler) SnoozeTask(ctx context.Context, req *SnoozeTaskRequest) (*SnoozeTaskResult, error) {
+	if req == nil {
+		return nil, ErrSnoozeTaskRequestRequired
+	}
+
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if req.TaskID == "" {
+		h.logger.Warn("snooze task called with empty task ID")
+
+		return nil, ErrTaskIDRequired
+	}
+
+	taskID, err := domaintask.NewIDFromString(req.TaskID)
+	if err != nil {
+		h.logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if (req.Duration == nil) == (req.Until == nil) {
+		return nil, ErrSnoozeTargetRequired
+	}
+
+	existingTask, err := h.taskRepo.GetTaskByID(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrTaskNotFound) {
+			h.logger.Info("task not found", slog.String("task_id", req.TaskID))
+
+			return nil, ErrTaskNotFound
+		}
+
+		h.logger.Error("failed to get task", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	snoozedAt := time.Now().UTC()
+
+	var until time.Time
+	if req.Duration != nil {
+		until = snoozedAt.Add(*req.Duration)
+	} else {
+		until = *req.Until
+	}
+
+	task, err := existingTask.Snooze(snoozedAt, until)
+	if err != nil {
+		h.logger.Warn("failed to snooze task entity", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, req.SessionToken, h.logger)
+	if err != nil {
+		h.logger.Warn("device fetch failed, returning snooze failure",
+			slog.String("task_id", req.TaskID),
+			slog.String("error", err.Error()))
+
+		return nil, err
 	}
 
-	if _, err := h.cancelRemindQueue.CancelRemind(ctx, cancelReq); err != nil {
-		h.logger.Error("failed to cancel remind",
+	var reminderInfo *domaintask.ReminderInfo
+	if len(validDevices) > 0 {
+		prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, task.UserID(), h.logger)
+		reminderInfo = domaintask.CalculateReminderTimesFrom(task, snoozedAt, userIDstr, validDevices, prefs)
+	} else if len(domainDevices) > 0 {
+		h.logger.Info("reminder registration skipped: all devices don't have FCM tokens",
 			slog.String("task_id", req.TaskID),
-			slog.String("error", err.Error()),
+			slog.Int("device_count", len(domainDevices)),
 		)
+	}
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		cancelReq := &remindcancel.CancelRemindRequest{
+			TaskID: req.TaskID,
+			UserID: userIDstr,
+		}
+
+		if _, err := h.cancelRemindQueue.CancelRemind(ctx, cancelReq); err != nil {
+			h.logger.Error("failed to cancel remind",
+				slog.String("task_id", req.TaskID),
+				slog.String("error", err.Error()),
+			)
+
+			return ErrCancelRemindFailed
+		}
+
+		if err := h.taskRepo.UpdateTask(ctx, task); err != nil {
+			if errors.Is(err, domaintask.ErrTaskNotFound) {
+				h.logger.Info("task disappeared before snooze", slog.String("task_id", req.TaskID))
+
+				return ErrTaskNotFound
+			}
+
+			h.logger.Error("failed to update task", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		if reminderInfo != nil {
+			logReminderInfo(h.logger, reminderInfo)
+
+			if _, err := h.remindQueue.RegisterRemind(ctx, convertToRemindRequest(reminderInfo)); err != nil {
+				h.logger.Error("failed to register remind to queue",
+					slog.String("task_id", req.TaskID),
+					slog.String("error", err.Error()))
+
+				return ErrRemindQueueRegistrationFailed
+			}
+		}
+
+		if err := h.taskRepo.UpdateTaskStatus(ctx, taskID, userID, domaintask.StatusActive); err != nil {
+			h.logger.Error("failed to update task status to active",
+				slog.String("task_id", req.TaskID),
+				slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("task snoozed",
+		slog.String("task_id", req.TaskID),
+		slog.Time("target_at", task.TargetAt()),
+	)
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, task.ID())
+
+	return &SnoozeTaskResult{
+		TaskID:      task.ID().String(),
+		Title:       task.Title(),
+		TaskType:    task.TaskType(),
+		TaskStatus:  domaintask.StatusActive,
+		Description: task.Description(),
+		ScheduledAt: task.ScheduledAt(),
+		CreatedAt:   task.CreatedAt(),
+		TargetAt:    task.TargetAt(),
+		Color:       task.Color().String(),
+		Recurrence:  toRecurrence(task.Recurrence()),
+		Checklist:   toChecklistProgress(task.ChecklistProgress()),
+		Tags:        toTagItems(task.Tags()),
+		Version:     task.Version(),
+	}, nil
+}
+
+type MoveTaskRequest struct {
+	SessionToken string
+	TaskID       string
+	// At least one of BeforeID and AfterID must be set. The task is placed right after
+	// BeforeID and right before AfterID; with only one of them set it is placed next to
+	// that task.
+	BeforeID string
+	AfterID  string
+}
+
+type MoveTaskResult struct {
+	TaskID      string
+	Title       string
+	TaskType    domaintask.Type
+	TaskStatus  domaintask.Status
+	Description string
+	ScheduledAt *time.Time
+	CreatedAt   time.Time
+	TargetAt    time.Time
+	Color       string
+	Recurrence  *Recurrence
+	Checklist   ChecklistProgress
+	Tags        []TagItem
+	Version     int64
+}
+
+type MoveTaskUseCase interface {
+	MoveTask(ctx context.Context, req *MoveTaskRequest) (*MoveTaskResult, error)
+}
+
+type moveTaskHandler struct {
+	authClient authclient.AuthClient
+	taskRepo   domaintask.TaskRepository
+	publisher  taskevent.Publisher
+	logger     *slog.Logger
+}
 
-		return ErrCancelRemindFailed
+func NewMoveTaskHandler(
+	authClient authclient.AuthClient,
+	taskRepo domaintask.TaskRepository,
+	publisher taskevent.Publisher,
+) MoveTaskUseCase {
+	return &moveTaskHandler{
+		authClient: authClient,
+		taskRepo:   taskRepo,
+		publisher:  publisher,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("movetask"),
+	}
+}
+
+func (h *moveTaskHandler) MoveTask(ctx context.Context, req *MoveTaskRequest) (*MoveTaskResult, error) {
+	if req == nil {
+		return nil, ErrMoveTaskRequestRequired
 	}
 
-	if err := h.taskRepo.DeleteTask(ctx, taskID, userID); err != nil {
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if req.TaskID == "" {
+		h.logger.Warn("move task called with empty task ID")
+
+		return nil, ErrTaskIDRequired
+	}
+
+	if req.BeforeID == "" && req.AfterID == "" {
+		return nil, ErrMoveTargetRequired
+	}
+
+	if req.BeforeID == req.TaskID || req.AfterID == req.TaskID {
+		return nil, ErrInvalidMovePosition
+	}
+
+	taskID, err := domaintask.NewIDFromString(req.TaskID)
+	if err != nil {
+		h.logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	existingTask, err := h.getTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	lower, err := h.neighborRank(ctx, req.BeforeID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := h.neighborRank(ctx, req.AfterID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.AfterID == "":
+		upper, err = h.taskRepo.NextRank(ctx, userID, lower, taskID)
+	case req.BeforeID == "":
+		lower, err = h.taskRepo.PreviousRank(ctx, userID, upper, taskID)
+	}
+
+	if err != nil {
+		h.logger.Error("failed to get adjacent rank", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	rank, err := domaintask.RankBetween(lower, upper)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrInvalidRankRange) {
+			h.logger.Info("invalid move position",
+				slog.String("task_id", req.TaskID),
+				slog.String("before_id", req.BeforeID),
+				slog.String("after_id", req.AfterID),
+			)
+
+			return nil, ErrInvalidMovePosition
+		}
+
+		h.logger.Error("failed to calculate rank", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	task, err := existingTask.MoveTo(rank)
+	if err != nil {
+		h.logger.Error("failed to move task entity", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if err := h.taskRepo.UpdateTaskRank(ctx, taskID, userID, task.Rank()); err != nil {
 		if errors.Is(err, domaintask.ErrTaskNotFound) {
-			h.logger.Info("task not found", slog.String("task_id", req.TaskID))
+			h.logger.Info("task disappeared before move", slog.String("task_id", req.TaskID))
 
-			return ErrTaskNotFound
+			return nil, ErrTaskNotFound
 		}
 
-		h.logger.Error("failed to delete task", slog.String("error", err.Error()))
+		h.logger.Error("failed to update task rank", slog.String("error", err.Error()))
 
-		return err
+		return nil, err
 	}
 
-	h.logger.Info("task deleted successfully", slog.String("task_id", req.TaskID))
+	h.logger.Info("task moved",
+		slog.String("task_id", req.TaskID),
+		slog.String("rank", task.Rank()),
+	)
 
-	return nil
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeUpdated, userID, task.ID())
+
+	return &MoveTaskResult{
+		TaskID:      task.ID().String(),
+		Title:       task.Title(),
+		TaskType:    task.TaskType(),
+		TaskStatus:  task.TaskStatus(),
+		Description: task.Description(),
+		ScheduledAt: task.ScheduledAt(),
+		CreatedAt:   task.CreatedAt(),
+		TargetAt:    task.TargetAt(),
+		Color:       task.Color().String(),
+		Recurrence:  toRecurrence(task.Recurrence()),
+		Checklist:   toChecklistProgress(task.ChecklistProgress()),
+		Tags:        toTagItems(task.Tags()),
+		Version:     task.Version(),
+	}, nil
+}
+
+func (h *moveTaskHandler) getTask(ctx context.Context, taskID domaintask.ID, userID domainuser.ID) (*domaintask.Task, error) {
+	task, err := h.taskRepo.GetTaskByID(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrTaskNotFound) {
+			h.logger.Info("task not found", slog.String("task_id", taskID.String()))
+
+			return nil, ErrTaskNotFound
+		}
+
+		h.logger.Error("failed to get task", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// neighborRank returns the rank of the task identified by id, or an empty string when
+// id is empty.
+func (h *moveTaskHandler) neighborRank(ctx context.Context, id string, userID domainuser.ID) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	neighborID, err := domaintask.NewIDFromString(id)
+	if err != nil {
+		h.logger.Warn("invalid neighbor task ID format", slog.String("error", err.Error()))
+
+		return "", err
+	}
+
+	neighbor, err := h.getTask(ctx, neighborID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return neighbor.Rank(), nil
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/repository"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
@@ -172,7 +173,7 @@ func TestCreateTaskSuccess(t *testing.T) {
 				}).
 				Times(1)
 
-			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, mockQueue)
+			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 			resp, err := handler.CreateTask(ctx, &tt.req)
 			if err != nil {
@@ -245,6 +246,8 @@ func TestCreateTaskError(t *testing.T) {
 		t.Fatalf("failed to generate user id: %v", err)
 	}
 
+	futureScheduledAt := time.Now().Add(time.Hour)
+
 	tests := []struct {
 		name        string
 		req         *CreateTaskRequest
@@ -451,6 +454,43 @@ func TestCreateTaskError(t *testing.T) {
 			},
 			expectedErr: domaintask.ErrColorInvalidFormat,
 		},
+		{
+			name: "invalid recurrence rule",
+			req: &CreateTaskRequest{
+				SessionToken: "token",
+				Title:        "Task with invalid recurrence",
+				TaskType:     domaintask.TypeScheduled,
+				ScheduledAt:  &futureScheduledAt,
+				Color:        "#FF6B6B",
+				Recurrence:   &Recurrence{Rule: "FREQ=HOURLY"},
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: domaintask.ErrInvalidRecurrenceRule,
+		},
+		{
+			name: "recurrence on non scheduled task",
+			req: &CreateTaskRequest{
+				SessionToken: "token",
+				Title:        "Near task with recurrence",
+				TaskType:     domaintask.TypeNear,
+				Color:        "#FF6B6B",
+				Recurrence:   &Recurrence{Rule: "FREQ=DAILY"},
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: domaintask.ErrRecurrenceNotAllowed,
+		},
 	}
 
 	for _, tt := range tests {
@@ -460,7 +500,7 @@ func TestCreateTaskError(t *testing.T) {
 			mockAuth := tt.setupAuth(ctrl)
 			mockDevice := NewMockDeviceClient(ctrl)
 			mockQueue := remindregister.NewMockQueue(ctrl)
-			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, mockQueue)
+			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 			_, err := handler.CreateTask(ctx, tt.req)
 			if err == nil {
@@ -500,7 +540,7 @@ func TestCreateTaskReturnsFailureWhenDeviceFetchFailsAfterRetries(t *testing.T)
 
 	mockQueue := remindregister.NewMockQueue(ctrl)
 
-	handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, mockQueue)
+	handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 	_, err = handler.CreateTask(ctx, &CreateTaskRequest{
 		TaskID:       taskID.String(),
@@ -573,7 +613,7 @@ func TestCreateTaskDoesNotPersistTaskWhenDeviceReturnsUnauthorizedOrInvalidArgum
 
 			mockQueue := remindregister.NewMockQueue(ctrl)
 
-			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, mockQueue)
+			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 			_, err = handler.CreateTask(ctx, &CreateTaskRequest{
 				TaskID:       taskID.String(),
@@ -659,7 +699,7 @@ func TestCreateTaskSkipsReminderWhenNoDevicesHaveFCMToken(t *testing.T) {
 
 			mockQueue := remindregister.NewMockQueue(ctrl)
 
-			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, mockQueue)
+			handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 			resp, err := handler.CreateTask(ctx, &CreateTaskRequest{
 				SessionToken: "token",
@@ -741,7 +781,7 @@ func TestCreateTaskFiltersDevicesWithoutFCMToken(t *testing.T) {
 		}).
 		Times(1)
 
-	handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, mockQueue)
+	handler := NewCreateTaskHandler(mockAuth, mockDevice, repo, &MockPeriodSettingRepository{}, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 	resp, err := handler.CreateTask(ctx, &CreateTaskRequest{
 		SessionToken: "token",
@@ -1091,13 +1131,20 @@ func setupTaskRepository(t *testing.T) domaintask.TaskRepository {
 	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
 	t.Cleanup(cleanup)
 
-	if err := db.AutoMigrate(&repository.TaskModel{}); err != nil {
+	if err := db.AutoMigrate(&repository.TaskModel{}, &repository.ChecklistItemModel{}, &repository.TagModel{}, &repository.TaskTagModel{}, &repository.TaskChangeModel{}, &repository.TaskChangeSequenceModel{}); err != nil {
 		t.Fatalf("failed to migrate database: %v", err)
 	}
 
 	return repository.NewTaskRepository(db)
 }
 
+// inlineTransactor runs the callback directly, for tests that do not exercise rollback.
+type inlineTransactor struct{}
+
+func (inlineTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func createPersistedTask(
 	t *testing.T,
 	repo domaintask.TaskRepository,
@@ -1235,6 +1282,84 @@ func TestListActiveTasksSuccess(t *testing.T) {
 	}
 }
 
+func TestListActiveTasksPagination(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	task1 := createPersistedTaskWithStatus(t, repo, userID, "Task 1", domaintask.TypeNear, domaintask.StatusActive, now, now.Add(10*time.Minute), domaintask.MustColor("#FF6B6B"))
+	task2 := createPersistedTaskWithStatus(t, repo, userID, "Task 2", domaintask.TypeShort, domaintask.StatusActive, now, now.Add(20*time.Minute), domaintask.MustColor("#FF6B6B"))
+	task3 := createPersistedTaskWithStatus(t, repo, userID, "Task 3", domaintask.TypeNear, domaintask.StatusActive, now, now.Add(30*time.Minute), domaintask.MustColor("#4ECDC4"))
+
+	ctrl := gomock.NewController(t)
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil).Times(3)
+
+	handler := NewListActiveTasksHandler(mockAuth, repo)
+
+	firstPage, err := handler.ListActiveTasks(ctx, &ListActiveTasksRequest{
+		SessionToken: "valid-token",
+		SortType:     domaintask.SortTypeTargetAt,
+		PageSize:     2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(firstPage.Tasks) != 2 {
+		t.Fatalf("expected 2 tasks on first page, got %d", len(firstPage.Tasks))
+	}
+
+	if firstPage.Tasks[0].TaskID != task1.ID().String() || firstPage.Tasks[1].TaskID != task2.ID().String() {
+		t.Errorf("unexpected first page: %s, %s", firstPage.Tasks[0].TaskID, firstPage.Tasks[1].TaskID)
+	}
+
+	if firstPage.NextPageToken == "" {
+		t.Fatal("expected next page token")
+	}
+
+	secondPage, err := handler.ListActiveTasks(ctx, &ListActiveTasksRequest{
+		SessionToken: "valid-token",
+		SortType:     domaintask.SortTypeTargetAt,
+		PageSize:     2,
+		PageToken:    firstPage.NextPageToken,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(secondPage.Tasks) != 1 || secondPage.Tasks[0].TaskID != task3.ID().String() {
+		t.Fatalf("expected only task3 on second page, got %d tasks", len(secondPage.Tasks))
+	}
+
+	if secondPage.NextPageToken != "" {
+		t.Errorf("expected empty next page token on last page, got %q", secondPage.NextPageToken)
+	}
+
+	color := "#FF6B6B"
+	taskType := domaintask.TypeNear
+
+	filtered, err := handler.ListActiveTasks(ctx, &ListActiveTasksRequest{
+		SessionToken: "valid-token",
+		SortType:     domaintask.SortTypeTargetAt,
+		TaskType:     &taskType,
+		Color:        &color,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(filtered.Tasks) != 1 || filtered.Tasks[0].TaskID != task1.ID().String() {
+		t.Fatalf("expected only task1 for filter, got %d tasks", len(filtered.Tasks))
+	}
+}
+
 func TestListActiveTasksEmpty(t *testing.T) {
 	repo := setupTaskRepository(t)
 	ctx := context.Background()
@@ -1272,6 +1397,10 @@ func TestListActiveTasksError(t *testing.T) {
 		t.Fatalf("failed to generate user id: %v", err)
 	}
 
+	windowStart := time.Now().UTC()
+	windowEnd := windowStart.Add(time.Hour)
+	invalidColor := "red"
+
 	tests := []struct {
 		name        string
 		req         *ListActiveTasksRequest
@@ -1329,6 +1458,71 @@ func TestListActiveTasksError(t *testing.T) {
 			},
 			expectedErr: domaintask.ErrInvalidSortType,
 		},
+		{
+			name: "invalid page size",
+			req: &ListActiveTasksRequest{
+				SessionToken: "valid-token",
+				SortType:     domaintask.SortTypeTargetAt,
+				PageSize:     domaintask.MaxPageSize + 1,
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name: "malformed page token",
+			req: &ListActiveTasksRequest{
+				SessionToken: "valid-token",
+				SortType:     domaintask.SortTypeTargetAt,
+				PageToken:    "not-a-token",
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: ErrInvalidPageToken,
+		},
+		{
+			name: "empty target_at window",
+			req: &ListActiveTasksRequest{
+				SessionToken: "valid-token",
+				SortType:     domaintask.SortTypeTargetAt,
+				TargetAtFrom: &windowEnd,
+				TargetAtTo:   &windowStart,
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: ErrInvalidTargetAtRange,
+		},
+		{
+			name: "invalid color filter",
+			req: &ListActiveTasksRequest{
+				SessionToken: "valid-token",
+				SortType:     domaintask.SortTypeTargetAt,
+				Color:        &invalidColor,
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: domaintask.ErrColorInvalidFormat,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1375,6 +1569,7 @@ func TestUpdateTaskSuccess(t *testing.T) {
 		req           UpdateTaskRequest
 		expectedTitle string
 		expectedDesc  string
+		reschedules   bool
 	}{
 		{
 			name:   "update task_status only",
@@ -1455,6 +1650,7 @@ func TestUpdateTaskSuccess(t *testing.T) {
 			}(),
 			expectedTitle: "Scheduled Task",
 			expectedDesc:  "Scheduled Description",
+			reschedules:   true,
 		},
 		{
 			name:   "update multiple fields",
@@ -1487,9 +1683,17 @@ func TestUpdateTaskSuccess(t *testing.T) {
 				Return(userID.String(), nil)
 
 			mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
+			mockDevice := NewMockDeviceClient(ctrl)
 			mockCancelQueue := remindcancel.NewMockQueue(ctrl)
 
-			handler := NewUpdateTaskHandler(mockAuth, repo, mockArchiveRepo, mockCancelQueue)
+			if tt.reschedules {
+				mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), tt.req.SessionToken, gomock.Any()).
+					Return([]deviceclient.DeviceInfo{}, nil)
+				mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+					Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil)
+			}
+
+			handler := NewUpdateTaskHandler(mockAuth, mockDevice, repo, mockArchiveRepo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 			resp, err := handler.UpdateTask(ctx, &tt.req)
 			if err != nil {
@@ -1595,6 +1799,23 @@ func TestUpdateTaskError(t *testing.T) {
 			},
 			expectedErr: ErrTaskIDRequired,
 		},
+		{
+			name: "non-positive expected version",
+			req: &UpdateTaskRequest{
+				SessionToken:    "token",
+				TaskID:          normalTask.ID().String(),
+				UpdateMask:      []string{"title"},
+				ExpectedVersion: new(int64),
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			expectedErr: ErrInvalidExpectedVersion,
+		},
 		{
 			name: "invalid task id format",
 			req: &UpdateTaskRequest{
@@ -1737,7 +1958,7 @@ func TestUpdateTaskError(t *testing.T) {
 			mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
 			mockCancelQueue := remindcancel.NewMockQueue(ctrl)
 
-			handler := NewUpdateTaskHandler(mockAuth, repo, mockArchiveRepo, mockCancelQueue)
+			handler := NewUpdateTaskHandler(mockAuth, NewMockDeviceClient(ctrl), repo, mockArchiveRepo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 			_, err := handler.UpdateTask(ctx, tt.req)
 			if err == nil {
@@ -1783,10 +2004,10 @@ func TestUpdateTaskToCompleted(t *testing.T) {
 	}).Return(&remindcancel.CancelRemindResponse{}, nil)
 
 	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
-	mockArchiveRepo.EXPECT().ArchiveTask(gomock.Any(), gomock.Any(), task.ID(), userID).
+	mockArchiveRepo.EXPECT().ArchiveTask(gomock.Any(), gomock.Any(), task.ID(), userID, task.Version()).
 		Return(nil)
 
-	handler := NewUpdateTaskHandler(mockAuth, repo, mockArchiveRepo, mockCancelQueue)
+	handler := NewUpdateTaskHandler(mockAuth, NewMockDeviceClient(ctrl), repo, mockArchiveRepo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 	status := domaintask.StatusCompleted
 	req := &UpdateTaskRequest{
@@ -1810,7 +2031,7 @@ func TestUpdateTaskToCompleted(t *testing.T) {
 	}
 }
 
-func TestUpdateTaskToCompletedCancelRemindFailed(t *testing.T) {
+func TestUpdateTaskToCompletedSchedulesNextOccurrence(t *testing.T) {
 	repo := setupTaskRepository(t)
 	ctx := context.Background()
 
@@ -1819,57 +2040,22 @@ func TestUpdateTaskToCompletedCancelRemindFailed(t *testing.T) {
 		t.Fatalf("failed to generate user id: %v", err)
 	}
 
-	now := time.Now().UTC().Truncate(time.Microsecond)
-	validColor := domaintask.MustColor("#FF6B6B")
-
-	task := createPersistedTask(t, repo, userID, "Task to Complete", domaintask.TypeNear, "Description", nil, now, validColor)
-
-	ctrl := gomock.NewController(t)
-
-	mockAuth := NewMockAuthClient(ctrl)
-	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
-		Return(userID.String(), nil)
-
-	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
-	mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
-		Return(nil, errors.New("queue unavailable"))
-
-	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
-	// ArchiveTask should NOT be called when CancelRemind fails
-
-	handler := NewUpdateTaskHandler(mockAuth, repo, mockArchiveRepo, mockCancelQueue)
-
-	status := domaintask.StatusCompleted
-	req := &UpdateTaskRequest{
-		SessionToken: "token",
-		TaskID:       task.ID().String(),
-		UpdateMask:   []string{"task_status"},
-		TaskStatus:   &status,
-	}
-
-	_, err = handler.UpdateTask(ctx, req)
-	if err == nil {
-		t.Fatalf("expected error, got nil")
-	}
-
-	if !errors.Is(err, ErrCancelRemindFailed) {
-		t.Fatalf("expected error %v, got %v", ErrCancelRemindFailed, err)
+	recurrence, err := domaintask.NewRecurrence("FREQ=DAILY;COUNT=5", "")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
 	}
-}
 
-func TestUpdateTaskToCompletedArchiveFailed(t *testing.T) {
-	repo := setupTaskRepository(t)
-	ctx := context.Background()
+	scheduledAt := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
 
-	userID, err := domainuser.NewID()
+	task, err := domaintask.CreateTask(nil, userID, "Daily stretch", domaintask.TypeScheduled, "", &scheduledAt,
+		domaintask.MustColor("#FF6B6B"), nil, domaintask.WithRecurrence(recurrence))
 	if err != nil {
-		t.Fatalf("failed to generate user id: %v", err)
+		t.Fatalf("failed to create task: %v", err)
 	}
 
-	now := time.Now().UTC().Truncate(time.Microsecond)
-	validColor := domaintask.MustColor("#FF6B6B")
-
-	task := createPersistedTask(t, repo, userID, "Task to Complete", domaintask.TypeNear, "Description", nil, now, validColor)
+	if err := repo.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
 
 	ctrl := gomock.NewController(t)
 
@@ -1877,37 +2063,70 @@ func TestUpdateTaskToCompletedArchiveFailed(t *testing.T) {
 	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
 		Return(userID.String(), nil)
 
+	fcmToken := "valid-fcm-token"
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
 	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
 	mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
 		Return(&remindcancel.CancelRemindResponse{}, nil)
 
-	archiveErr := errors.New("archive failed")
 	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
-	mockArchiveRepo.EXPECT().ArchiveTask(gomock.Any(), gomock.Any(), task.ID(), userID).
-		Return(archiveErr)
+	mockArchiveRepo.EXPECT().
+		ArchiveTaskWithNextOccurrence(gomock.Any(), gomock.Any(), task.ID(), userID, task.Version(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *domaintask.CompletedTask, _ domaintask.ID, _ domainuser.ID, next *domaintask.Task) error {
+			return repo.SaveTask(ctx, next)
+		})
+
+	expectedNext := scheduledAt.Add(24 * time.Hour)
 
-	handler := NewUpdateTaskHandler(mockAuth, repo, mockArchiveRepo, mockCancelQueue)
+	mockRegisterQueue := remindregister.NewMockQueue(ctrl)
+	mockRegisterQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+			if req.TaskID == task.ID().String() {
+				t.Fatalf("expected reminders for the next occurrence, got the completed task")
+			}
+
+			if last := req.Times[len(req.Times)-1]; !last.Equal(expectedNext) {
+				t.Fatalf("expected last reminder at %v, got %v", expectedNext, last)
+			}
+
+			return &remindregister.RemindResponse{Name: "next"}, nil
+		})
+
+	handler := NewUpdateTaskHandler(mockAuth, mockDevice, repo, mockArchiveRepo, nil, nil, mockRegisterQueue, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
 
 	status := domaintask.StatusCompleted
-	req := &UpdateTaskRequest{
+
+	resp, err := handler.UpdateTask(ctx, &UpdateTaskRequest{
 		SessionToken: "token",
 		TaskID:       task.ID().String(),
 		UpdateMask:   []string{"task_status"},
 		TaskStatus:   &status,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 
-	_, err = handler.UpdateTask(ctx, req)
-	if err == nil {
-		t.Fatalf("expected error, got nil")
+	if resp.NextOccurrence == nil {
+		t.Fatal("expected next occurrence in response")
 	}
 
-	if !errors.Is(err, archiveErr) {
-		t.Fatalf("expected error %v, got %v", archiveErr, err)
+	if !resp.NextOccurrence.TargetAt.Equal(expectedNext) {
+		t.Errorf("expected next occurrence at %v, got %v", expectedNext, resp.NextOccurrence.TargetAt)
+	}
+
+	if resp.NextOccurrence.TaskStatus != domaintask.StatusActive {
+		t.Errorf("expected next occurrence to be active, got %s", resp.NextOccurrence.TaskStatus)
+	}
+
+	if resp.NextOccurrence.Recurrence == nil || resp.NextOccurrence.Recurrence.Rule != "FREQ=DAILY;COUNT=4" {
+		t.Errorf("unexpected next occurrence recurrence: %v", resp.NextOccurrence.Recurrence)
 	}
 }
 
-func TestDeleteTaskSuccess(t *testing.T) {
-	repo := setupTaskRepository(t)
+func TestUpdateTaskScheduledAtReschedulesReminders(t *testing.T) {
 	ctx := context.Background()
 
 	userID, err := domainuser.NewID()
@@ -1915,28 +2134,466 @@ func TestDeleteTaskSuccess(t *testing.T) {
 		t.Fatalf("failed to generate user id: %v", err)
 	}
 
-	now := time.Now().UTC().Truncate(time.Microsecond)
-	validColor := domaintask.MustColor("#FF6B6B")
-
-	// Create a task to delete
-	task := createPersistedTask(t, repo, userID, "Task to Delete", domaintask.TypeNear, "", nil, now, validColor)
-
-	ctrl := gomock.NewController(t)
-	mockAuth := NewMockAuthClient(ctrl)
-	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
-
-	mockCancelRemindQueue := remindcancel.NewMockQueue(ctrl)
-	mockCancelRemindQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
-		Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil)
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
 
-	handler := NewDeleteTaskHandler(mockAuth, repo, mockCancelRemindQueue)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	scheduled := now.Add(2 * time.Hour)
+	newScheduled := now.Add(4 * time.Hour)
 
-	err = handler.DeleteTask(ctx, &DeleteTaskRequest{
-		SessionToken: "valid-token",
-		TaskID:       task.ID().String(),
-	})
+	existingTask, err := domaintask.NewTask(taskID, userID, "Scheduled Task", domaintask.TypeScheduled, domaintask.StatusActive, "", &scheduled, now, scheduled, domaintask.MustColor("#FF6B6B"))
 	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	fcmToken := "valid-fcm-token"
+
+	tests := []struct {
+		name        string
+		setup       func(repo *domaintask.MockTaskRepository, cancelQueue *remindcancel.MockQueue, registerQueue *remindregister.MockQueue)
+		expectedErr error
+	}{
+		{
+			name: "cancels then registers reminders for the new time",
+			setup: func(repo *domaintask.MockTaskRepository, cancelQueue *remindcancel.MockQueue, registerQueue *remindregister.MockQueue) {
+				gomock.InOrder(
+					cancelQueue.EXPECT().CancelRemind(gomock.Any(), &remindcancel.CancelRemindRequest{
+						TaskID: taskID.String(),
+						UserID: userID.String(),
+					}).Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil),
+					repo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+							if !task.TargetAt().Equal(newScheduled) {
+								t.Errorf("expected task at %v, got %v", newScheduled, task.TargetAt())
+							}
+
+							return nil
+						}),
+					registerQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+							if last := req.Times[len(req.Times)-1]; !last.Equal(newScheduled) {
+								t.Errorf("expected last reminder at %v, got %v", newScheduled, last)
+							}
+
+							return &remindregister.RemindResponse{}, nil
+						}),
+				)
+			},
+		},
+		{
+			name: "cancel failure fails before the task is written",
+			setup: func(_ *domaintask.MockTaskRepository, cancelQueue *remindcancel.MockQueue, _ *remindregister.MockQueue) {
+				cancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("queue unavailable"))
+			},
+			expectedErr: ErrCancelRemindFailed,
+		},
+		{
+			name: "register failure fails the transaction",
+			setup: func(repo *domaintask.MockTaskRepository, cancelQueue *remindcancel.MockQueue, registerQueue *remindregister.MockQueue) {
+				gomock.InOrder(
+					cancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+						Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil),
+					repo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil),
+					registerQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+						Return(nil, errors.New("queue unavailable")),
+				)
+			},
+			expectedErr: ErrRemindQueueRegistrationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth := NewMockAuthClient(ctrl)
+			mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+			mockDevice := NewMockDeviceClient(ctrl)
+			mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+				Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+			mockRepo := domaintask.NewMockTaskRepository(ctrl)
+			mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, userID).Return(existingTask, nil)
+
+			mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+			mockRegisterQueue := remindregister.NewMockQueue(ctrl)
+			tt.setup(mockRepo, mockCancelQueue, mockRegisterQueue)
+
+			handler := NewUpdateTaskHandler(mockAuth, mockDevice, mockRepo, domaintask.NewMockTaskArchiveRepository(ctrl), nil, nil, mockRegisterQueue, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+			result, err := handler.UpdateTask(ctx, &UpdateTaskRequest{
+				SessionToken: "token",
+				TaskID:       taskID.String(),
+				UpdateMask:   []string{"scheduled_at"},
+				ScheduledAt:  &newScheduled,
+			})
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !result.TargetAt.Equal(newScheduled) || result.TaskStatus != domaintask.StatusActive {
+				t.Fatalf("expected active task at %v, got %s at %v", newScheduled, result.TaskStatus, result.TargetAt)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskReminderFailedRegistersRemindersAgain(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	targetAt := now.Add(2 * time.Hour)
+
+	existingTask, err := domaintask.NewTask(taskID, userID, "Failed Task", domaintask.TypeNear, domaintask.StatusReminderFailed, "", nil, now, targetAt, domaintask.MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	fcmToken := "valid-fcm-token"
+	newTitle := "Renamed"
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, userID).Return(existingTask, nil)
+
+	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+	mockRegisterQueue := remindregister.NewMockQueue(ctrl)
+
+	gomock.InOrder(
+		mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+			Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil),
+		mockRepo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+				if task.TaskStatus() != domaintask.StatusActive {
+					t.Errorf("expected task to be saved active, got %s", task.TaskStatus())
+				}
+
+				if task.Version() != existingTask.Version()+1 {
+					t.Errorf("expected task to be saved at version %d, got %d", existingTask.Version()+1, task.Version())
+				}
+
+				return nil
+			}),
+		mockRegisterQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+			Return(&remindregister.RemindResponse{}, nil),
+	)
+
+	handler := NewUpdateTaskHandler(mockAuth, mockDevice, mockRepo, domaintask.NewMockTaskArchiveRepository(ctrl), nil, nil, mockRegisterQueue, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	result, err := handler.UpdateTask(ctx, &UpdateTaskRequest{
+		SessionToken: "token",
+		TaskID:       taskID.String(),
+		UpdateMask:   []string{"title"},
+		Title:        &newTitle,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.TaskStatus != domaintask.StatusActive || result.Title != newTitle {
+		t.Fatalf("expected active task titled %q, got %s %q", newTitle, result.TaskStatus, result.Title)
+	}
+}
+
+func TestUpdateTaskVersionConflict(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	newTitle := "Renamed"
+
+	newTask := func(title string, version int64) *domaintask.Task {
+		t.Helper()
+
+		task, err := domaintask.NewTask(taskID, userID, title, domaintask.TypeNear, domaintask.StatusActive, "", nil, now, now.Add(time.Hour), domaintask.MustColor("#FF6B6B"), domaintask.WithVersion(version))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		return task
+	}
+
+	tests := []struct {
+		name            string
+		expectedVersion int64
+		setupRepo       func(repo *domaintask.MockTaskRepository)
+		currentTitle    string
+		currentVersion  int64
+	}{
+		{
+			name:            "expected version is outdated",
+			expectedVersion: 2,
+			setupRepo: func(repo *domaintask.MockTaskRepository) {
+				repo.EXPECT().GetTaskByID(gomock.Any(), taskID, userID).Return(newTask("Edited elsewhere", 3), nil)
+			},
+			currentTitle:   "Edited elsewhere",
+			currentVersion: 3,
+		},
+		{
+			name:            "task is edited while saving",
+			expectedVersion: 3,
+			setupRepo: func(repo *domaintask.MockTaskRepository) {
+				gomock.InOrder(
+					repo.EXPECT().GetTaskByID(gomock.Any(), taskID, userID).Return(newTask("Original", 3), nil),
+					repo.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(domaintask.ErrTaskVersionConflict),
+					repo.EXPECT().GetTaskByID(gomock.Any(), taskID, userID).Return(newTask("Edited elsewhere", 4), nil),
+				)
+			},
+			currentTitle:   "Edited elsewhere",
+			currentVersion: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth := NewMockAuthClient(ctrl)
+			mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+			mockRepo := domaintask.NewMockTaskRepository(ctrl)
+			tt.setupRepo(mockRepo)
+
+			handler := NewUpdateTaskHandler(mockAuth, NewMockDeviceClient(ctrl), mockRepo, domaintask.NewMockTaskArchiveRepository(ctrl), nil, nil, remindregister.NewMockQueue(ctrl), remindcancel.NewMockQueue(ctrl), inlineTransactor{}, taskevent.NewNoopBroker())
+
+			_, err := handler.UpdateTask(ctx, &UpdateTaskRequest{
+				SessionToken:    "token",
+				TaskID:          taskID.String(),
+				UpdateMask:      []string{"title"},
+				Title:           &newTitle,
+				ExpectedVersion: &tt.expectedVersion,
+			})
+
+			var conflict *TaskVersionConflictError
+			if !errors.As(err, &conflict) || !errors.Is(err, ErrTaskVersionConflict) {
+				t.Fatalf("expected a version conflict, got %v", err)
+			}
+
+			if conflict.Current.Title != tt.currentTitle || conflict.Current.Version != tt.currentVersion {
+				t.Errorf("expected current task %q at version %d, got %q at %d",
+					tt.currentTitle, tt.currentVersion, conflict.Current.Title, conflict.Current.Version)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskToCompletedCancelRemindFailed(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	task := createPersistedTask(t, repo, userID, "Task to Complete", domaintask.TypeNear, "Description", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+		Return(userID.String(), nil)
+
+	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+	mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("queue unavailable"))
+
+	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
+	// ArchiveTask should NOT be called when CancelRemind fails
+
+	handler := NewUpdateTaskHandler(mockAuth, NewMockDeviceClient(ctrl), repo, mockArchiveRepo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	status := domaintask.StatusCompleted
+	req := &UpdateTaskRequest{
+		SessionToken: "token",
+		TaskID:       task.ID().String(),
+		UpdateMask:   []string{"task_status"},
+		TaskStatus:   &status,
+	}
+
+	_, err = handler.UpdateTask(ctx, req)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	if !errors.Is(err, ErrCancelRemindFailed) {
+		t.Fatalf("expected error %v, got %v", ErrCancelRemindFailed, err)
+	}
+}
+
+func TestUpdateTaskToCompletedArchiveFailed(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	task := createPersistedTask(t, repo, userID, "Task to Complete", domaintask.TypeNear, "Description", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+		Return(userID.String(), nil)
+
+	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+	mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+		Return(&remindcancel.CancelRemindResponse{}, nil)
+
+	archiveErr := errors.New("archive failed")
+	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchiveRepo.EXPECT().ArchiveTask(gomock.Any(), gomock.Any(), task.ID(), userID, task.Version()).
+		Return(archiveErr)
+
+	handler := NewUpdateTaskHandler(mockAuth, NewMockDeviceClient(ctrl), repo, mockArchiveRepo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	status := domaintask.StatusCompleted
+	req := &UpdateTaskRequest{
+		SessionToken: "token",
+		TaskID:       task.ID().String(),
+		UpdateMask:   []string{"task_status"},
+		TaskStatus:   &status,
+	}
+
+	_, err = handler.UpdateTask(ctx, req)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	if !errors.Is(err, archiveErr) {
+		t.Fatalf("expected error %v, got %v", archiveErr, err)
+	}
+}
+
+func TestUpdateTaskToCompletedArchiveVersionConflict(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	task := createPersistedTask(t, repo, userID, "Task to Complete", domaintask.TypeNear, "Description", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+		Return(userID.String(), nil)
+
+	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+	mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+		Return(&remindcancel.CancelRemindResponse{}, nil)
+
+	// The task was edited concurrently after it was read for completion
+	mockArchiveRepo := domaintask.NewMockTaskArchiveRepository(ctrl)
+	mockArchiveRepo.EXPECT().ArchiveTask(gomock.Any(), gomock.Any(), task.ID(), userID, task.Version()).
+		Return(domaintask.ErrTaskVersionConflict)
+
+	handler := NewUpdateTaskHandler(mockAuth, NewMockDeviceClient(ctrl), repo, mockArchiveRepo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	status := domaintask.StatusCompleted
+	req := &UpdateTaskRequest{
+		SessionToken: "token",
+		TaskID:       task.ID().String(),
+		UpdateMask:   []string{"task_status"},
+		TaskStatus:   &status,
+	}
+
+	_, err = handler.UpdateTask(ctx, req)
+
+	var conflict *TaskVersionConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected TaskVersionConflictError, got %v", err)
+	}
+
+	if conflict.Current.TaskID != task.ID().String() {
+		t.Errorf("conflict task ID mismatch: got %s, want %s", conflict.Current.TaskID, task.ID())
+	}
+}
+
+func TestDeleteTaskSuccess(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	// Create a task to delete
+	task := createPersistedTask(t, repo, userID, "Task to Delete", domaintask.TypeNear, "", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+	mockCancelRemindQueue := remindcancel.NewMockQueue(ctrl)
+	mockCancelRemindQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+		Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil)
+
+	handler := NewDeleteTaskHandler(mockAuth, repo, mockCancelRemindQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	err = handler.DeleteTask(ctx, &DeleteTaskRequest{
+		SessionToken: "valid-token",
+		TaskID:       task.ID().String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 
 	// Verify task is deleted
@@ -1946,42 +2603,661 @@ func TestDeleteTaskSuccess(t *testing.T) {
 	}
 }
 
-func TestDeleteTaskError(t *testing.T) {
+func TestDeleteTaskError(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	missingID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate id: %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		req               *DeleteTaskRequest
+		setupAuth         func(ctrl *gomock.Controller) authclient.AuthClient
+		setupCancelRemind func(ctrl *gomock.Controller) remindcancel.Queue
+		expectedErr       error
+	}{
+		{
+			name:      "nil request",
+			req:       nil,
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				return remindcancel.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrDeleteTaskRequestRequired,
+		},
+		{
+			name: "unauthorized session",
+			req: &DeleteTaskRequest{
+				SessionToken: "bad-token",
+				TaskID:       missingID.String(),
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				return remindcancel.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "auth service unavailable",
+			req: &DeleteTaskRequest{
+				SessionToken: "token",
+				TaskID:       missingID.String(),
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return("", authclient.ErrAuthServiceUnavailable)
+
+				return mockAuth
+			},
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				return remindcancel.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrAuthServiceUnavailable,
+		},
+		{
+			name: "empty task id",
+			req: &DeleteTaskRequest{
+				SessionToken: "token",
+				TaskID:       "",
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				return remindcancel.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrTaskIDRequired,
+		},
+		{
+			name: "invalid task id format",
+			req: &DeleteTaskRequest{
+				SessionToken: "token",
+				TaskID:       "invalid-uuid",
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				return remindcancel.NewMockQueue(ctrl)
+			},
+			expectedErr: domaintask.ErrIDInvalidFormat,
+		},
+		{
+			name: "cancel remind failed",
+			req: &DeleteTaskRequest{
+				SessionToken: "token",
+				TaskID:       missingID.String(),
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				mockQueue := remindcancel.NewMockQueue(ctrl)
+				mockQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("queue unavailable"))
+
+				return mockQueue
+			},
+			expectedErr: ErrCancelRemindFailed,
+		},
+		{
+			name: "task not found",
+			req: &DeleteTaskRequest{
+				SessionToken: "token",
+				TaskID:       missingID.String(),
+			},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
+					Return(validUserID.String(), nil)
+
+				return mockAuth
+			},
+			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
+				mockQueue := remindcancel.NewMockQueue(ctrl)
+				mockQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+					Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil)
+
+				return mockQueue
+			},
+			expectedErr: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAuth := tt.setupAuth(ctrl)
+			mockCancelRemind := tt.setupCancelRemind(ctrl)
+			handler := NewDeleteTaskHandler(mockAuth, repo, mockCancelRemind, inlineTransactor{}, taskevent.NewNoopBroker())
+
+			err := handler.DeleteTask(ctx, tt.req)
+			if err == nil {
+				t.Fatalf("expected error %v, got nil", tt.expectedErr)
+			}
+
+			if !errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error() {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestSnoozeTaskSuccess(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	task := createPersistedTask(t, repo, userID, "Task to Snooze", domaintask.TypeShort, "", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	fcmToken := "valid-fcm-token"
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+	cancelCall := mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), &remindcancel.CancelRemindRequest{
+		TaskID: task.ID().String(),
+		UserID: userID.String(),
+	}).Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil)
+
+	snooze := 30 * time.Minute
+
+	mockQueue := remindregister.NewMockQueue(ctrl)
+	mockQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+			if len(req.Times) == 0 {
+				t.Fatal("expected reminder times")
+			}
+
+			last := req.Times[len(req.Times)-1]
+			if last.Sub(now) < snooze {
+				t.Errorf("expected last reminder at the snoozed deadline, got %v", last)
+			}
+
+			return &remindregister.RemindResponse{}, nil
+		}).After(cancelCall)
+
+	handler := NewSnoozeTaskHandler(mockAuth, mockDevice, repo, nil, nil, mockQueue, mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	result, err := handler.SnoozeTask(ctx, &SnoozeTaskRequest{
+		SessionToken: "token",
+		TaskID:       task.ID().String(),
+		Duration:     &snooze,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.TaskStatus != domaintask.StatusActive {
+		t.Errorf("expected status %s, got %s", domaintask.StatusActive, result.TaskStatus)
+	}
+
+	if !result.TargetAt.After(task.TargetAt()) {
+		t.Errorf("expected targetAt after %v, got %v", task.TargetAt(), result.TargetAt)
+	}
+
+	stored, err := repo.GetTaskByID(ctx, task.ID(), userID)
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+
+	if !stored.TargetAt().Equal(result.TargetAt) {
+		t.Errorf("expected stored targetAt %v, got %v", result.TargetAt, stored.TargetAt())
+	}
+
+	if stored.TaskStatus() != domaintask.StatusActive {
+		t.Errorf("expected stored status %s, got %s", domaintask.StatusActive, stored.TaskStatus())
+	}
+}
+
+func TestSnoozeTaskCancelRemindFailed(t *testing.T) {
+	repo := setupTaskRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	task := createPersistedTask(t, repo, userID, "Task to Snooze", domaintask.TypeNear, "", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockDevice := NewMockDeviceClient(ctrl)
+	mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+		Return([]deviceclient.DeviceInfo{}, nil)
+
+	mockCancelQueue := remindcancel.NewMockQueue(ctrl)
+	mockCancelQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("queue unavailable"))
+
+	handler := NewSnoozeTaskHandler(mockAuth, mockDevice, repo, nil, nil, remindregister.NewMockQueue(ctrl), mockCancelQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+	until := task.TargetAt().Add(time.Hour)
+
+	_, err = handler.SnoozeTask(ctx, &SnoozeTaskRequest{
+		SessionToken: "token",
+		TaskID:       task.ID().String(),
+		Until:        &until,
+	})
+	if !errors.Is(err, ErrCancelRemindFailed) {
+		t.Fatalf("expected error %v, got %v", ErrCancelRemindFailed, err)
+	}
+
+	stored, err := repo.GetTaskByID(ctx, task.ID(), userID)
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+
+	if !stored.TargetAt().Equal(task.TargetAt()) {
+		t.Errorf("expected targetAt to be unchanged, got %v", stored.TargetAt())
+	}
+}
+
+func TestSnoozeTaskError(t *testing.T) {
+	ctx := context.Background()
+
+	validUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	snooze := 10 * time.Minute
+	until := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	existingTask := func(t *testing.T) *domaintask.Task {
+		t.Helper()
+
+		createdAt := time.Now().UTC().Add(-time.Minute)
+
+		task, err := domaintask.NewTask(taskID, validUserID, "Task", domaintask.TypeNear, domaintask.StatusActive, "", nil, createdAt, createdAt.Add(time.Hour), domaintask.MustColor("#FF6B6B"))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		return task
+	}
+
+	tests := []struct {
+		name        string
+		req         *SnoozeTaskRequest
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		setupRepo   func(t *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository
+		expectedErr error
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrSnoozeTaskRequestRequired,
+		},
+		{
+			name: "unauthorized session",
+			req:  &SnoozeTaskRequest{SessionToken: "bad-token", TaskID: taskID.String(), Duration: &snooze},
+			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
+					Return("", authclient.ErrUnauthorized)
+
+				return mockAuth
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name:        "empty task id",
+			req:         &SnoozeTaskRequest{SessionToken: "valid-token", Duration: &snooze},
+			setupAuth:   validAuth,
+			expectedErr: ErrTaskIDRequired,
+		},
+		{
+			name:        "invalid task id",
+			req:         &SnoozeTaskRequest{SessionToken: "valid-token", TaskID: "invalid", Duration: &snooze},
+			setupAuth:   validAuth,
+			expectedErr: domaintask.ErrIDInvalidFormat,
+		},
+		{
+			name:        "no snooze target",
+			req:         &SnoozeTaskRequest{SessionToken: "valid-token", TaskID: taskID.String()},
+			setupAuth:   validAuth,
+			expectedErr: ErrSnoozeTargetRequired,
+		},
+		{
+			name:        "both duration and until",
+			req:         &SnoozeTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), Duration: &snooze, Until: &until},
+			setupAuth:   validAuth,
+			expectedErr: ErrSnoozeTargetRequired,
+		},
+		{
+			name:      "task not found",
+			req:       &SnoozeTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), Duration: &snooze},
+			setupAuth: validAuth,
+			setupRepo: func(_ *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, validUserID).
+					Return(nil, domaintask.ErrTaskNotFound)
+
+				return mockRepo
+			},
+			expectedErr: ErrTaskNotFound,
+		},
+		{
+			name:      "until in the past",
+			req:       &SnoozeTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), Until: &past},
+			setupAuth: validAuth,
+			setupRepo: func(t *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, validUserID).
+					Return(existingTask(t), nil)
+
+				return mockRepo
+			},
+			expectedErr: ErrSnoozeUntilNotInFuture,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var repo domaintask.TaskRepository
+			if tt.setupRepo != nil {
+				repo = tt.setupRepo(t, ctrl)
+			}
+
+			handler := NewSnoozeTaskHandler(tt.setupAuth(ctrl), NewMockDeviceClient(ctrl), repo, nil, nil, remindregister.NewMockQueue(ctrl), remindcancel.NewMockQueue(ctrl), inlineTransactor{}, taskevent.NewNoopBroker())
+
+			_, err := handler.SnoozeTask(ctx, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestMoveTaskSuccess(t *testing.T) {
 	repo := setupTaskRepository(t)
 	ctx := context.Background()
 
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	validColor := domaintask.MustColor("#FF6B6B")
+
+	task1 := createPersistedTask(t, repo, userID, "Task 1", domaintask.TypeNear, "", nil, now, validColor)
+	task2 := createPersistedTask(t, repo, userID, "Task 2", domaintask.TypeNear, "", nil, now, validColor)
+	task3 := createPersistedTask(t, repo, userID, "Task 3", domaintask.TypeNear, "", nil, now, validColor)
+
+	ctrl := gomock.NewController(t)
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil).Times(3)
+
+	handler := NewMoveTaskHandler(mockAuth, repo, taskevent.NewNoopBroker())
+	listHandler := NewListActiveTasksHandler(mockAuth, repo)
+
+	if _, err := handler.MoveTask(ctx, &MoveTaskRequest{
+		SessionToken: "valid-token",
+		TaskID:       task3.ID().String(),
+		BeforeID:     task1.ID().String(),
+		AfterID:      task2.ID().String(),
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := handler.MoveTask(ctx, &MoveTaskRequest{
+		SessionToken: "valid-token",
+		TaskID:       task1.ID().String(),
+		BeforeID:     task2.ID().String(),
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := listHandler.ListActiveTasks(ctx, &ListActiveTasksRequest{
+		SessionToken: "valid-token",
+		SortType:     domaintask.SortTypeManual,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedOrder := []string{task3.ID().String(), task2.ID().String(), task1.ID().String()}
+	if len(result.Tasks) != len(expectedOrder) {
+		t.Fatalf("expected %d tasks, got %d", len(expectedOrder), len(result.Tasks))
+	}
+
+	for i, task := range result.Tasks {
+		if task.TaskID != expectedOrder[i] {
+			t.Errorf("position %d: expected %s, got %s", i, expectedOrder[i], task.TaskID)
+		}
+	}
+}
+
+func TestMoveTaskRank(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	newRankedTask := func(t *testing.T, rank string) *domaintask.Task {
+		t.Helper()
+
+		taskID, err := domaintask.NewID()
+		if err != nil {
+			t.Fatalf("failed to generate task id: %v", err)
+		}
+
+		createdAt := time.Now().UTC().Add(-time.Minute)
+
+		task, err := domaintask.NewTask(taskID, userID, "Task", domaintask.TypeNear, domaintask.StatusActive, "", nil, createdAt, createdAt.Add(time.Hour), domaintask.MustColor("#FF6B6B"), domaintask.WithRank(rank))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		return task
+	}
+
+	tests := []struct {
+		name         string
+		beforeRank   string
+		afterRank    string
+		adjacentRank string
+	}{
+		{name: "between neighbors", beforeRank: "a", afterRank: "c"},
+		{name: "after a task", beforeRank: "a", adjacentRank: "b"},
+		{name: "after the last task", beforeRank: "a"},
+		{name: "before a task", afterRank: "c", adjacentRank: "b"},
+		{name: "before the first task", afterRank: "c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth := NewMockAuthClient(ctrl)
+			mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").Return(userID.String(), nil)
+
+			moved := newRankedTask(t, "z")
+			mockRepo := domaintask.NewMockTaskRepository(ctrl)
+			mockRepo.EXPECT().GetTaskByID(gomock.Any(), moved.ID(), userID).Return(moved, nil)
+
+			req := &MoveTaskRequest{SessionToken: "valid-token", TaskID: moved.ID().String()}
+
+			if tt.beforeRank != "" {
+				before := newRankedTask(t, tt.beforeRank)
+				req.BeforeID = before.ID().String()
+
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), before.ID(), userID).Return(before, nil)
+			}
+
+			if tt.afterRank != "" {
+				after := newRankedTask(t, tt.afterRank)
+				req.AfterID = after.ID().String()
+
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), after.ID(), userID).Return(after, nil)
+			}
+
+			lower, upper := tt.beforeRank, tt.afterRank
+
+			switch {
+			case tt.afterRank == "":
+				upper = tt.adjacentRank
+				mockRepo.EXPECT().NextRank(gomock.Any(), userID, tt.beforeRank, moved.ID()).Return(tt.adjacentRank, nil)
+			case tt.beforeRank == "":
+				lower = tt.adjacentRank
+				mockRepo.EXPECT().PreviousRank(gomock.Any(), userID, tt.afterRank, moved.ID()).Return(tt.adjacentRank, nil)
+			}
+
+			var savedRank string
+
+			mockRepo.EXPECT().UpdateTaskRank(gomock.Any(), moved.ID(), userID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ domaintask.ID, _ domainuser.ID, rank string) error {
+					savedRank = rank
+
+					return nil
+				})
+
+			handler := NewMoveTaskHandler(mockAuth, mockRepo, taskevent.NewNoopBroker())
+
+			if _, err := handler.MoveTask(ctx, req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if lower != "" && savedRank <= lower {
+				t.Errorf("expected rank after %q, got %q", lower, savedRank)
+			}
+
+			if upper != "" && savedRank >= upper {
+				t.Errorf("expected rank before %q, got %q", upper, savedRank)
+			}
+		})
+	}
+}
+
+func TestMoveTaskError(t *testing.T) {
+	ctx := context.Background()
+
 	validUserID, err := domainuser.NewID()
 	if err != nil {
 		t.Fatalf("failed to generate user id: %v", err)
 	}
 
-	missingID, err := domaintask.NewID()
+	taskID, err := domaintask.NewID()
 	if err != nil {
-		t.Fatalf("failed to generate id: %v", err)
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	beforeID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	afterID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	validAuth := func(ctrl *gomock.Controller) authclient.AuthClient {
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "valid-token").
+			Return(validUserID.String(), nil)
+
+		return mockAuth
+	}
+
+	rankedTask := func(t *testing.T, id domaintask.ID, rank string) *domaintask.Task {
+		t.Helper()
+
+		createdAt := time.Now().UTC().Add(-time.Minute)
+
+		task, err := domaintask.NewTask(id, validUserID, "Task", domaintask.TypeNear, domaintask.StatusActive, "", nil, createdAt, createdAt.Add(time.Hour), domaintask.MustColor("#FF6B6B"), domaintask.WithRank(rank))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		return task
 	}
 
 	tests := []struct {
-		name              string
-		req               *DeleteTaskRequest
-		setupAuth         func(ctrl *gomock.Controller) authclient.AuthClient
-		setupCancelRemind func(ctrl *gomock.Controller) remindcancel.Queue
-		expectedErr       error
+		name        string
+		req         *MoveTaskRequest
+		setupAuth   func(ctrl *gomock.Controller) authclient.AuthClient
+		setupRepo   func(t *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository
+		expectedErr error
 	}{
 		{
-			name:      "nil request",
-			req:       nil,
-			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				return remindcancel.NewMockQueue(ctrl)
-			},
-			expectedErr: ErrDeleteTaskRequestRequired,
+			name:        "nil request",
+			req:         nil,
+			setupAuth:   func(ctrl *gomock.Controller) authclient.AuthClient { return NewMockAuthClient(ctrl) },
+			expectedErr: ErrMoveTaskRequestRequired,
 		},
 		{
 			name: "unauthorized session",
-			req: &DeleteTaskRequest{
-				SessionToken: "bad-token",
-				TaskID:       missingID.String(),
-			},
+			req:  &MoveTaskRequest{SessionToken: "bad-token", TaskID: taskID.String(), BeforeID: beforeID.String()},
 			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
 				mockAuth := NewMockAuthClient(ctrl)
 				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").
@@ -1989,125 +3265,92 @@ func TestDeleteTaskError(t *testing.T) {
 
 				return mockAuth
 			},
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				return remindcancel.NewMockQueue(ctrl)
-			},
 			expectedErr: ErrUnauthorized,
 		},
 		{
-			name: "auth service unavailable",
-			req: &DeleteTaskRequest{
-				SessionToken: "token",
-				TaskID:       missingID.String(),
-			},
-			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
-				mockAuth := NewMockAuthClient(ctrl)
-				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
-					Return("", authclient.ErrAuthServiceUnavailable)
-
-				return mockAuth
-			},
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				return remindcancel.NewMockQueue(ctrl)
-			},
-			expectedErr: ErrAuthServiceUnavailable,
+			name:        "empty task id",
+			req:         &MoveTaskRequest{SessionToken: "valid-token", BeforeID: beforeID.String()},
+			setupAuth:   validAuth,
+			expectedErr: ErrTaskIDRequired,
 		},
 		{
-			name: "empty task id",
-			req: &DeleteTaskRequest{
-				SessionToken: "token",
-				TaskID:       "",
-			},
-			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
-				mockAuth := NewMockAuthClient(ctrl)
-				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
-					Return(validUserID.String(), nil)
-
-				return mockAuth
-			},
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				return remindcancel.NewMockQueue(ctrl)
-			},
-			expectedErr: ErrTaskIDRequired,
+			name:        "no neighbor",
+			req:         &MoveTaskRequest{SessionToken: "valid-token", TaskID: taskID.String()},
+			setupAuth:   validAuth,
+			expectedErr: ErrMoveTargetRequired,
 		},
 		{
-			name: "invalid task id format",
-			req: &DeleteTaskRequest{
-				SessionToken: "token",
-				TaskID:       "invalid-uuid",
-			},
-			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
-				mockAuth := NewMockAuthClient(ctrl)
-				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
-					Return(validUserID.String(), nil)
-
-				return mockAuth
-			},
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				return remindcancel.NewMockQueue(ctrl)
-			},
+			name:        "neighbor is the moved task",
+			req:         &MoveTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), AfterID: taskID.String()},
+			setupAuth:   validAuth,
+			expectedErr: ErrInvalidMovePosition,
+		},
+		{
+			name:        "invalid task id",
+			req:         &MoveTaskRequest{SessionToken: "valid-token", TaskID: "invalid", BeforeID: beforeID.String()},
+			setupAuth:   validAuth,
 			expectedErr: domaintask.ErrIDInvalidFormat,
 		},
 		{
-			name: "cancel remind failed",
-			req: &DeleteTaskRequest{
-				SessionToken: "token",
-				TaskID:       missingID.String(),
-			},
-			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
-				mockAuth := NewMockAuthClient(ctrl)
-				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
-					Return(validUserID.String(), nil)
-
-				return mockAuth
-			},
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				mockQueue := remindcancel.NewMockQueue(ctrl)
-				mockQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
-					Return(nil, errors.New("queue unavailable"))
+			name:      "task not found",
+			req:       &MoveTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), BeforeID: beforeID.String()},
+			setupAuth: validAuth,
+			setupRepo: func(_ *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, validUserID).
+					Return(nil, domaintask.ErrTaskNotFound)
 
-				return mockQueue
+				return mockRepo
 			},
-			expectedErr: ErrCancelRemindFailed,
+			expectedErr: ErrTaskNotFound,
 		},
 		{
-			name: "task not found",
-			req: &DeleteTaskRequest{
-				SessionToken: "token",
-				TaskID:       missingID.String(),
-			},
-			setupAuth: func(ctrl *gomock.Controller) authclient.AuthClient {
-				mockAuth := NewMockAuthClient(ctrl)
-				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").
-					Return(validUserID.String(), nil)
-
-				return mockAuth
-			},
-			setupCancelRemind: func(ctrl *gomock.Controller) remindcancel.Queue {
-				mockQueue := remindcancel.NewMockQueue(ctrl)
-				mockQueue.EXPECT().CancelRemind(gomock.Any(), gomock.Any()).
-					Return(&remindcancel.CancelRemindResponse{Name: "test"}, nil)
-
-				return mockQueue
+			name:      "neighbor not found",
+			req:       &MoveTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), BeforeID: beforeID.String()},
+			setupAuth: validAuth,
+			setupRepo: func(t *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, validUserID).
+					Return(rankedTask(t, taskID, "i"), nil)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), beforeID, validUserID).
+					Return(nil, domaintask.ErrTaskNotFound)
+
+				return mockRepo
 			},
 			expectedErr: ErrTaskNotFound,
 		},
+		{
+			name:      "neighbors out of order",
+			req:       &MoveTaskRequest{SessionToken: "valid-token", TaskID: taskID.String(), BeforeID: beforeID.String(), AfterID: afterID.String()},
+			setupAuth: validAuth,
+			setupRepo: func(t *testing.T, ctrl *gomock.Controller) domaintask.TaskRepository {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), taskID, validUserID).
+					Return(rankedTask(t, taskID, "i"), nil)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), beforeID, validUserID).
+					Return(rankedTask(t, beforeID, "r"), nil)
+				mockRepo.EXPECT().GetTaskByID(gomock.Any(), afterID, validUserID).
+					Return(rankedTask(t, afterID, "c"), nil)
+
+				return mockRepo
+			},
+			expectedErr: ErrInvalidMovePosition,
+		},
 	}
 
 	for _, tt := range tests {
-		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			mockAuth := tt.setupAuth(ctrl)
-			mockCancelRemind := tt.setupCancelRemind(ctrl)
-			handler := NewDeleteTaskHandler(mockAuth, repo, mockCancelRemind)
 
-			err := handler.DeleteTask(ctx, tt.req)
-			if err == nil {
-				t.Fatalf("expected error %v, got nil", tt.expectedErr)
+			var repo domaintask.TaskRepository
+			if tt.setupRepo != nil {
+				repo = tt.setupRepo(t, ctrl)
 			}
 
-			if !errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error() {
+			handler := NewMoveTaskHandler(tt.setupAuth(ctrl), repo, taskevent.NewNoopBroker())
+
+			_, err := handler.MoveTask(ctx, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
 				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
 			}
 		})
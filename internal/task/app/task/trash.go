@@ -0,0 +1,297 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+)
+
+type DeletedTaskItem struct {
+	Task      TaskItem
+	DeletedAt time.Time
+}
+
+type ListDeletedTasksRequest struct {
+	SessionToken string
+	PageSize     int
+	PageToken    string
+}
+
+type ListDeletedTasksResult struct {
+	DeletedTasks  []DeletedTaskItem
+	NextPageToken string
+}
+
+type ListDeletedTasksUseCase interface {
+	ListDeletedTasks(ctx context.Context, req *ListDeletedTasksRequest) (*ListDeletedTasksResult, error)
+}
+
+type listDeletedTasksHandler struct {
+	authClient authclient.AuthClient
+	taskRepo   domaintask.TaskRepository
+	logger     *slog.Logger
+}
+
+func NewListDeletedTasksHandler(
+	authClient authclient.AuthClient,
+	taskRepo domaintask.TaskRepository,
+) ListDeletedTasksUseCase {
+	return &listDeletedTasksHandler{
+		authClient: authClient,
+		taskRepo:   taskRepo,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("listdeletedtasks"),
+	}
+}
+
+func (h *listDeletedTasksHandler) ListDeletedTasks(
+	ctx context.Context,
+	req *ListDeletedTasksRequest,
+) (*ListDeletedTasksResult, error) {
+	if req == nil {
+		return nil, ErrListDeletedTasksRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, err := domaintask.NormalizePageSize(req.PageSize)
+	if err != nil {
+		h.logger.Warn("invalid page size", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	query := domaintask.ListDeletedTasksQuery{PageSize: pageSize, Cursor: nil}
+
+	if req.PageToken != "" {
+		cursor, err := domaintask.DecodeDeletedTaskPageCursor(req.PageToken)
+		if err != nil {
+			h.logger.Warn("invalid page token", slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		query.Cursor = cursor
+	}
+
+	deletedTasks, nextCursor, err := h.taskRepo.ListDeletedTasksByUserID(ctx, userID, query)
+	if err != nil {
+		h.logger.Error("failed to list deleted tasks", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	result := &ListDeletedTasksResult{
+		DeletedTasks:  make([]DeletedTaskItem, 0, len(deletedTasks)),
+		NextPageToken: "",
+	}
+
+	if nextCursor != nil {
+		result.NextPageToken = nextCursor.Encode()
+	}
+
+	for _, deletedTask := range deletedTasks {
+		result.DeletedTasks = append(result.DeletedTasks, DeletedTaskItem{
+			Task:      toTaskItem(deletedTask.Task()),
+			DeletedAt: deletedTask.DeletedAt(),
+		})
+	}
+
+	h.logger.Info("deleted tasks listed",
+		slog.Int("count", len(result.DeletedTasks)),
+		slog.Bool("has_next_page", result.NextPageToken != ""),
+	)
+
+	return result, nil
+}
+
+type RestoreTaskRequest struct {
+	SessionToken string
+	TaskID       string
+}
+
+type RestoreTaskResult struct {
+	Task TaskItem
+}
+
+type RestoreTaskUseCase interface {
+	RestoreTask(ctx context.Context, req *RestoreTaskRequest) (*RestoreTaskResult, error)
+}
+
+type restoreTaskHandler struct {
+	authClient     authclient.AuthClient
+	deviceClient   deviceclient.DeviceClient
+	taskRepo       domaintask.TaskRepository
+	intervalRepo   period.ReminderIntervalSettingRepository
+	quietHoursRepo period.QuietHoursSettingRepository
+	remindQueue    remindregister.Queue
+	transactor     domaintask.Transactor
+	publisher      taskevent.Publisher
+	logger         *slog.Logger
+}
+
+func NewRestoreTaskHandler(
+	authClient authclient.AuthClient,
+	deviceClient deviceclient.DeviceClient,
+	taskRepo domaintask.TaskRepository,
+	intervalRepo period.ReminderIntervalSettingRepository,
+	quietHoursRepo period.QuietHoursSettingRepository,
+	remindQueue remindregister.Queue,
+	transactor domaintask.Transactor,
+	publisher taskevent.Publisher,
+) RestoreTaskUseCase {
+	return &restoreTaskHandler{
+		authClient:     authClient,
+		deviceClient:   deviceClient,
+		taskRepo:       taskRepo,
+		intervalRepo:   intervalRepo,
+		quietHoursRepo: quietHoursRepo,
+		remindQueue:    remindQueue,
+		transactor:     transactor,
+		publisher:      publisher,
+		logger:         slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("restoretask"),
+	}
+}
+
+// RestoreTask takes a task out of the trash. Its reminders were cancelled when it was
+// deleted, so they are registered again if the task's target time is still ahead.
+func (h *restoreTaskHandler) RestoreTask(ctx context.Context, req *RestoreTaskRequest) (*RestoreTaskResult, error) {
+	if req == nil {
+		return nil, ErrRestoreTaskRequestRequired
+	}
+
+	userIDstr, err := h.authClient.ValidateSession(ctx, req.SessionToken)
+	if err != nil {
+		if errors.Is(err, authclient.ErrUnauthorized) {
+			h.logger.Info("session validation failed", slog.String("error", err.Error()))
+
+			return nil, ErrUnauthorized
+		}
+
+		h.logger.Error("session validation failed", slog.String("error", err.Error()))
+
+		return nil, fmt.Errorf("session validation failed: %w", err)
+	}
+
+	userID, err := domainuser.NewIDFromString(userIDstr)
+	if err != nil {
+		h.logger.Warn("invalid user ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if req.TaskID == "" {
+		h.logger.Warn("restore task called with empty task ID")
+
+		return nil, ErrTaskIDRequired
+	}
+
+	taskID, err := domaintask.NewIDFromString(req.TaskID)
+	if err != nil {
+		h.logger.Warn("invalid task ID format", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	deletedTask, err := h.taskRepo.GetDeletedTaskByID(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrDeletedTaskNotFound) {
+			h.logger.Info("deleted task not found", slog.String("task_id", req.TaskID))
+
+			return nil, ErrDeletedTaskNotFound
+		}
+
+		h.logger.Error("failed to get deleted task", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	task, err := deletedTask.Restore()
+	if err != nil {
+		h.logger.Error("failed to restore task entity", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	restoredAt := time.Now().UTC()
+
+	var reminderInfo *domaintask.ReminderInfo
+
+	if task.TargetAt().After(restoredAt) {
+		validDevices, domainDevices, err := fetchReminderDevices(ctx, h.deviceClient, req.SessionToken, h.logger)
+		if err != nil {
+			h.logger.Warn("device fetch failed, returning restore failure",
+				slog.String("task_id", req.TaskID),
+				slog.String("error", err.Error()))
+
+			return nil, err
+		}
+
+		switch {
+		case len(validDevices) > 0:
+			prefs := lookupReminderPreferences(ctx, h.intervalRepo, h.quietHoursRepo, task.UserID(), h.logger)
+			reminderInfo = domaintask.CalculateReminderTimesFrom(task, restoredAt, userIDstr, validDevices, prefs)
+		case len(domainDevices) > 0:
+			h.logger.Info("reminder registration skipped: all devices don't have FCM tokens",
+				slog.String("task_id", req.TaskID),
+				slog.Int("device_count", len(domainDevices)),
+			)
+		}
+	} else {
+		h.logger.Info("reminder registration skipped: target time has already passed",
+			slog.String("task_id", req.TaskID),
+			slog.Time("target_at", task.TargetAt()),
+		)
+	}
+
+	if err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.taskRepo.RestoreTask(ctx, task); err != nil {
+			if errors.Is(err, domaintask.ErrDeletedTaskNotFound) {
+				h.logger.Info("deleted task disappeared before restore", slog.String("task_id", req.TaskID))
+
+				return ErrDeletedTaskNotFound
+			}
+
+			h.logger.Error("failed to restore task", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		if reminderInfo != nil {
+			logReminderInfo(h.logger, reminderInfo)
+
+			if _, err := h.remindQueue.RegisterRemind(ctx, convertToRemindRequest(reminderInfo)); err != nil {
+				h.logger.Error("failed to register remind to queue",
+					slog.String("task_id", req.TaskID),
+					slog.String("error", err.Error()))
+
+				return ErrRemindQueueRegistrationFailed
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("task restored",
+		slog.String("task_id", req.TaskID),
+		slog.Bool("reminders_registered", reminderInfo != nil),
+	)
+
+	publishTaskEvent(ctx, h.publisher, h.logger, taskevent.TypeCreated, userID, task.ID())
+
+	return &RestoreTaskResult{Task: toTaskItem(task)}, nil
+}
@@ -0,0 +1,264 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestDeletedTask(t *testing.T, userID domainuser.ID, targetAt time.Time) *domaintask.DeletedTask {
+	t.Helper()
+
+	taskID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	createdAt := targetAt.Add(-2 * time.Hour)
+
+	task, err := domaintask.NewTask(
+		taskID,
+		userID,
+		"Trashed Task",
+		domaintask.TypeNear,
+		domaintask.StatusActive,
+		"",
+		nil,
+		createdAt,
+		targetAt,
+		domaintask.MustColor("#FF6B6B"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	deletedTask, err := domaintask.NewDeletedTask(task, createdAt.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create deleted task: %v", err)
+	}
+
+	return deletedTask
+}
+
+func TestListDeletedTasksSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	deletedTask := newTestDeletedTask(t, userID, time.Now().Add(time.Hour))
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	nextCursor, err := domaintask.NewDeletedTaskPageCursor(deletedTask)
+	if err != nil {
+		t.Fatalf("failed to create cursor: %v", err)
+	}
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().
+		ListDeletedTasksByUserID(gomock.Any(), userID, domaintask.ListDeletedTasksQuery{PageSize: 1, Cursor: nil}).
+		Return([]*domaintask.DeletedTask{deletedTask}, nextCursor, nil)
+
+	handler := NewListDeletedTasksHandler(mockAuth, mockRepo)
+
+	result, err := handler.ListDeletedTasks(ctx, &ListDeletedTasksRequest{SessionToken: "token", PageSize: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.DeletedTasks) != 1 || result.NextPageToken != nextCursor.Encode() {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	item := result.DeletedTasks[0]
+	if item.Task.TaskID != deletedTask.Task().ID().String() || !item.DeletedAt.Equal(deletedTask.DeletedAt()) {
+		t.Errorf("unexpected deleted task %+v", item)
+	}
+}
+
+func TestRestoreTaskSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	t.Run("registers reminders for a future target", func(t *testing.T) {
+		deletedTask := newTestDeletedTask(t, userID, time.Now().Add(2*time.Hour))
+		taskID := deletedTask.Task().ID()
+
+		ctrl := gomock.NewController(t)
+
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+		fcmToken := "valid-fcm-token"
+		mockDevice := NewMockDeviceClient(ctrl)
+		mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+			Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+		mockRepo := domaintask.NewMockTaskRepository(ctrl)
+		mockQueue := remindregister.NewMockQueue(ctrl)
+
+		gomock.InOrder(
+			mockRepo.EXPECT().GetDeletedTaskByID(gomock.Any(), taskID, userID).Return(deletedTask, nil),
+			mockRepo.EXPECT().RestoreTask(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, task *domaintask.Task) error {
+					if task.ID() != taskID || task.TaskStatus() != domaintask.StatusActive {
+						t.Fatalf("unexpected restored task %s %s", task.ID(), task.TaskStatus())
+					}
+
+					return nil
+				}),
+			mockQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+					if req.TaskID != taskID.String() || len(req.Times) == 0 {
+						t.Fatalf("unexpected remind request %+v", req)
+					}
+
+					return &remindregister.RemindResponse{Name: "test-task"}, nil
+				}),
+		)
+
+		handler := NewRestoreTaskHandler(mockAuth, mockDevice, mockRepo, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+		result, err := handler.RestoreTask(ctx, &RestoreTaskRequest{SessionToken: "token", TaskID: taskID.String()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.Task.TaskID != taskID.String() || result.Task.TaskStatus != domaintask.StatusActive {
+			t.Errorf("unexpected result %+v", result.Task)
+		}
+	})
+
+	t.Run("skips reminders for a past target", func(t *testing.T) {
+		deletedTask := newTestDeletedTask(t, userID, time.Now().Add(-time.Hour))
+		taskID := deletedTask.Task().ID()
+
+		ctrl := gomock.NewController(t)
+
+		mockAuth := NewMockAuthClient(ctrl)
+		mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+		mockRepo := domaintask.NewMockTaskRepository(ctrl)
+		mockRepo.EXPECT().GetDeletedTaskByID(gomock.Any(), taskID, userID).Return(deletedTask, nil)
+		mockRepo.EXPECT().RestoreTask(gomock.Any(), gomock.Any()).Return(nil)
+
+		// Neither the device service nor the remind queue may be called
+		handler := NewRestoreTaskHandler(mockAuth, NewMockDeviceClient(ctrl), mockRepo, nil, nil, remindregister.NewMockQueue(ctrl), inlineTransactor{}, taskevent.NewNoopBroker())
+
+		if _, err := handler.RestoreTask(ctx, &RestoreTaskRequest{SessionToken: "token", TaskID: taskID.String()}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestRestoreTaskError(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	deletedTask := newTestDeletedTask(t, userID, time.Now().Add(2*time.Hour))
+	taskID := deletedTask.Task().ID()
+	fcmToken := "valid-fcm-token"
+
+	tests := []struct {
+		name        string
+		req         *RestoreTaskRequest
+		setup       func(ctrl *gomock.Controller) (*MockDeviceClient, *domaintask.MockTaskRepository, *remindregister.MockQueue)
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			setup: func(ctrl *gomock.Controller) (*MockDeviceClient, *domaintask.MockTaskRepository, *remindregister.MockQueue) {
+				return NewMockDeviceClient(ctrl), domaintask.NewMockTaskRepository(ctrl), remindregister.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrRestoreTaskRequestRequired,
+		},
+		{
+			name: "task not in trash",
+			req:  &RestoreTaskRequest{SessionToken: "token", TaskID: taskID.String()},
+			setup: func(ctrl *gomock.Controller) (*MockDeviceClient, *domaintask.MockTaskRepository, *remindregister.MockQueue) {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetDeletedTaskByID(gomock.Any(), taskID, userID).Return(nil, domaintask.ErrDeletedTaskNotFound)
+
+				return NewMockDeviceClient(ctrl), mockRepo, remindregister.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrDeletedTaskNotFound,
+		},
+		{
+			name: "device service unavailable",
+			req:  &RestoreTaskRequest{SessionToken: "token", TaskID: taskID.String()},
+			setup: func(ctrl *gomock.Controller) (*MockDeviceClient, *domaintask.MockTaskRepository, *remindregister.MockQueue) {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetDeletedTaskByID(gomock.Any(), taskID, userID).Return(deletedTask, nil)
+
+				mockDevice := NewMockDeviceClient(ctrl)
+				mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+
+				return mockDevice, mockRepo, remindregister.NewMockQueue(ctrl)
+			},
+			expectedErr: ErrDeviceServiceUnavailable,
+		},
+		{
+			name: "remind registration failed",
+			req:  &RestoreTaskRequest{SessionToken: "token", TaskID: taskID.String()},
+			setup: func(ctrl *gomock.Controller) (*MockDeviceClient, *domaintask.MockTaskRepository, *remindregister.MockQueue) {
+				mockRepo := domaintask.NewMockTaskRepository(ctrl)
+				mockRepo.EXPECT().GetDeletedTaskByID(gomock.Any(), taskID, userID).Return(deletedTask, nil)
+				mockRepo.EXPECT().RestoreTask(gomock.Any(), gomock.Any()).Return(nil)
+
+				mockDevice := NewMockDeviceClient(ctrl)
+				mockDevice.EXPECT().GetUserDevicesWithRetry(gomock.Any(), "token", gomock.Any()).
+					Return([]deviceclient.DeviceInfo{{DeviceID: "device-1", FCMToken: &fcmToken}}, nil)
+
+				mockQueue := remindregister.NewMockQueue(ctrl)
+				mockQueue.EXPECT().RegisterRemind(gomock.Any(), gomock.Any()).Return(nil, errors.New("queue unavailable"))
+
+				return mockDevice, mockRepo, mockQueue
+			},
+			expectedErr: ErrRemindQueueRegistrationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth := NewMockAuthClient(ctrl)
+			if tt.req != nil {
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+			}
+
+			mockDevice, mockRepo, mockQueue := tt.setup(ctrl)
+
+			handler := NewRestoreTaskHandler(mockAuth, mockDevice, mockRepo, nil, nil, mockQueue, inlineTransactor{}, taskevent.NewNoopBroker())
+
+			_, err := handler.RestoreTask(ctx, tt.req)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
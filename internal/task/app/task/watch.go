@@ -0,0 +1,182 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+)
+
+// publishTaskEvent tells the user's watchers about a committed change. Watchers that miss
+// an event catch up by refetching, so a failed publish is logged rather than returned.
+func publishTaskEvent(
+	ctx context.Context,
+	publisher taskevent.Publisher,
+	logger *slog.Logger,
+	eventType taskevent.Type,
+	userID domainuser.ID,
+	taskID domaintask.ID,
+) {
+	event := taskevent.Event{
+		Type:       eventType,
+		TaskID:     taskID.String(),
+		UserID:     userID.String(),
+		OccurredAt: time.Now().UTC(),
+	}
+
+	if err := publisher.Publish(context.WithoutCancel(ctx), event); err != nil {
+		logger.Warn("failed to publish task event",
+			slog.String("type", string(eventType)),
+			slog.String("task_id", event.TaskID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// MaxWatchDuration bounds a single watch so that the session is validated again when the
+// client reconnects.
+const MaxWatchDuration = 30 * time.Minute
+
+type WatchTasksRequest struct {
+	SessionToken string
+}
+
+type TaskEventItem struct {
+	Type   taskevent.Type
+	TaskID string
+	// Task is the current state of a created or updated task
+	Task       *TaskItem
+	OccurredAt time.Time
+}
+
+type WatchTasksUseCase interface {
+	// WatchTasks passes each of the user's task events to send until ctx is done, send
+	// fails, the event stream ends, or MaxWatchDuration has passed.
+	WatchTasks(ctx context.Context, req *WatchTasksRequest, send func(TaskEventItem) error) error
+}
+
+type watchTasksHandler struct {
+	authClient authclient.AuthClient
+	taskRepo   domaintask.TaskRepository
+	subscriber taskevent.Subscriber
+	logger     *slog.Logger
+}
+
+func NewWatchTasksHandler(
+	authClient authclient.AuthClient,
+	taskRepo domaintask.TaskRepository,
+	subscriber taskevent.Subscriber,
+) WatchTasksUseCase {
+	return &watchTasksHandler{
+		authClient: authClient,
+		taskRepo:   taskRepo,
+		subscriber: subscriber,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("watchtasks"),
+	}
+}
+
+func (h *watchTasksHandler) WatchTasks(
+	ctx context.Context,
+	req *WatchTasksRequest,
+	send func(TaskEventItem) error,
+) error {
+	if req == nil {
+		return ErrWatchTasksRequestRequired
+	}
+
+	userID, err := resolveUser(ctx, h.authClient, h.logger, req.SessionToken)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, MaxWatchDuration)
+	defer cancel()
+
+	events, err := h.subscriber.Subscribe(ctx, userID.String())
+	if err != nil {
+		h.logger.Error("failed to subscribe to task events", slog.String("error", err.Error()))
+
+		return ErrTaskEventStreamClosed
+	}
+
+	h.logger.Info("task watch started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.logger.Info("task watch ended", slog.String("reason", context.Cause(ctx).Error()))
+
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				h.logger.Info("task event stream closed")
+
+				return ErrTaskEventStreamClosed
+			}
+
+			item, ok, err := h.toEventItem(ctx, event, userID)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				continue
+			}
+
+			if err := send(item); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// toEventItem attaches the current task state to created and updated events. It reports
+// false when the task is already gone; the event that removed it follows.
+func (h *watchTasksHandler) toEventItem(
+	ctx context.Context,
+	event taskevent.Event,
+	userID domainuser.ID,
+) (TaskEventItem, bool, error) {
+	item := TaskEventItem{
+		Type:       event.Type,
+		TaskID:     event.TaskID,
+		Task:       nil,
+		OccurredAt: event.OccurredAt,
+	}
+
+	if event.Type != taskevent.TypeCreated && event.Type != taskevent.TypeUpdated {
+		return item, true, nil
+	}
+
+	taskID, err := domaintask.NewIDFromString(event.TaskID)
+	if err != nil {
+		h.logger.Warn("dropping task event with invalid task ID", slog.String("error", err.Error()))
+
+		return item, false, nil
+	}
+
+	task, err := h.taskRepo.GetTaskByID(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, domaintask.ErrTaskNotFound) {
+			return item, false, nil
+		}
+
+		h.logger.Error("failed to load task for event", slog.String("error", err.Error()))
+
+		return item, false, err
+	}
+
+	taskItem := toTaskItem(task)
+	item.Task = &taskItem
+
+	return item, true, nil
+}
@@ -0,0 +1,232 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWatchTasksSuccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	task := newTestDeletedTask(t, userID, time.Now().Add(time.Hour)).Task()
+
+	goneID, err := domaintask.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task id: %v", err)
+	}
+
+	occurredAt := time.Now().UTC()
+
+	events := make(chan taskevent.Event, 3)
+	events <- taskevent.Event{Type: taskevent.TypeUpdated, TaskID: task.ID().String(), UserID: userID.String(), OccurredAt: occurredAt}
+	events <- taskevent.Event{Type: taskevent.TypeCreated, TaskID: goneID.String(), UserID: userID.String(), OccurredAt: occurredAt}
+	events <- taskevent.Event{Type: taskevent.TypeDeleted, TaskID: goneID.String(), UserID: userID.String(), OccurredAt: occurredAt}
+	close(events)
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockSubscriber := taskevent.NewMockSubscriber(ctrl)
+	mockSubscriber.EXPECT().Subscribe(gomock.Any(), userID.String()).Return(events, nil)
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), task.ID(), userID).Return(task, nil)
+	mockRepo.EXPECT().GetTaskByID(gomock.Any(), goneID, userID).Return(nil, domaintask.ErrTaskNotFound)
+
+	handler := NewWatchTasksHandler(mockAuth, mockRepo, mockSubscriber)
+
+	var sent []TaskEventItem
+
+	err = handler.WatchTasks(ctx, &WatchTasksRequest{SessionToken: "token"}, func(item TaskEventItem) error {
+		sent = append(sent, item)
+
+		return nil
+	})
+	if !errors.Is(err, ErrTaskEventStreamClosed) {
+		t.Fatalf("expected ErrTaskEventStreamClosed once the events end, got %v", err)
+	}
+
+	// The created event for the task that is already gone is skipped
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 events, got %+v", sent)
+	}
+
+	if sent[0].Type != taskevent.TypeUpdated || sent[0].Task == nil || sent[0].Task.TaskID != task.ID().String() {
+		t.Errorf("expected updated event with the task, got %+v", sent[0])
+	}
+
+	if sent[1].Type != taskevent.TypeDeleted || sent[1].Task != nil || sent[1].TaskID != goneID.String() {
+		t.Errorf("expected deleted event without a task, got %+v", sent[1])
+	}
+}
+
+func TestWatchTasksError(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	errSend := errors.New("client gone")
+
+	tests := []struct {
+		name        string
+		req         *WatchTasksRequest
+		setup       func(ctrl *gomock.Controller) (*MockAuthClient, *taskevent.MockSubscriber)
+		expectedErr error
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *taskevent.MockSubscriber) {
+				return NewMockAuthClient(ctrl), taskevent.NewMockSubscriber(ctrl)
+			},
+			expectedErr: ErrWatchTasksRequestRequired,
+		},
+		{
+			name: "unauthorized",
+			req:  &WatchTasksRequest{SessionToken: "bad-token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *taskevent.MockSubscriber) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "bad-token").Return("", authclient.ErrUnauthorized)
+
+				return mockAuth, taskevent.NewMockSubscriber(ctrl)
+			},
+			expectedErr: ErrUnauthorized,
+		},
+		{
+			name: "broker closed",
+			req:  &WatchTasksRequest{SessionToken: "token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *taskevent.MockSubscriber) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				mockSubscriber := taskevent.NewMockSubscriber(ctrl)
+				mockSubscriber.EXPECT().Subscribe(gomock.Any(), userID.String()).Return(nil, taskevent.ErrBrokerClosed)
+
+				return mockAuth, mockSubscriber
+			},
+			expectedErr: ErrTaskEventStreamClosed,
+		},
+		{
+			name: "send fails",
+			req:  &WatchTasksRequest{SessionToken: "token"},
+			setup: func(ctrl *gomock.Controller) (*MockAuthClient, *taskevent.MockSubscriber) {
+				mockAuth := NewMockAuthClient(ctrl)
+				mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+				events := make(chan taskevent.Event, 1)
+				events <- taskevent.Event{Type: taskevent.TypeCompleted, TaskID: "task-1", UserID: userID.String()}
+
+				mockSubscriber := taskevent.NewMockSubscriber(ctrl)
+				mockSubscriber.EXPECT().Subscribe(gomock.Any(), userID.String()).Return(events, nil)
+
+				return mockAuth, mockSubscriber
+			},
+			expectedErr: errSend,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAuth, mockSubscriber := tt.setup(ctrl)
+
+			handler := NewWatchTasksHandler(mockAuth, domaintask.NewMockTaskRepository(ctrl), mockSubscriber)
+
+			err := handler.WatchTasks(context.Background(), tt.req, func(TaskEventItem) error {
+				return errSend
+			})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestWatchTasksEndsWithContext(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	handler := NewWatchTasksHandler(mockAuth, domaintask.NewMockTaskRepository(ctrl), taskevent.NewNoopBroker())
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- handler.WatchTasks(ctx, &WatchTasksRequest{SessionToken: "token"}, func(TaskEventItem) error {
+			return nil
+		})
+	}()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected a clean end when the client leaves, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not end with its context")
+	}
+}
+
+func TestRestoreTaskPublishesEvent(t *testing.T) {
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	deletedTask := newTestDeletedTask(t, userID, time.Now().Add(-time.Hour))
+	taskID := deletedTask.Task().ID()
+
+	ctrl := gomock.NewController(t)
+
+	mockAuth := NewMockAuthClient(ctrl)
+	mockAuth.EXPECT().ValidateSession(gomock.Any(), "token").Return(userID.String(), nil)
+
+	mockRepo := domaintask.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().GetDeletedTaskByID(gomock.Any(), taskID, userID).Return(deletedTask, nil)
+	mockRepo.EXPECT().RestoreTask(gomock.Any(), gomock.Any()).Return(nil)
+
+	// A failed publish does not fail the restore
+	mockPublisher := taskevent.NewMockPublisher(ctrl)
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event taskevent.Event) error {
+			if event.Type != taskevent.TypeCreated || event.TaskID != taskID.String() || event.UserID != userID.String() {
+				t.Fatalf("unexpected event %+v", event)
+			}
+
+			return errors.New("redis unavailable")
+		})
+
+	handler := NewRestoreTaskHandler(mockAuth, NewMockDeviceClient(ctrl), mockRepo, nil, nil, remindregister.NewMockQueue(ctrl), inlineTransactor{}, mockPublisher)
+
+	if _, err := handler.RestoreTask(context.Background(), &RestoreTaskRequest{SessionToken: "token", TaskID: taskID.String()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
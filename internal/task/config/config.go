@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -28,12 +29,33 @@ const (
 	defaultRemindRegisterQueueName = "remind-register"
 	defaultRemindCancelQueueName   = "remind-cancel"
 	defaultMaxRetries              = 3
+
+	remindOutboxPollIntervalEnv = "REMIND_OUTBOX_POLL_INTERVAL"
+	remindOutboxMaxAttemptsEnv  = "REMIND_OUTBOX_MAX_ATTEMPTS"
+
+	defaultRemindOutboxPollInterval = time.Second
+	defaultRemindOutboxMaxAttempts  = 10
+
+	pendingReminderReconcileIntervalEnv   = "PENDING_REMINDER_RECONCILE_INTERVAL"
+	pendingReminderReconcileStaleAfterEnv = "PENDING_REMINDER_RECONCILE_STALE_AFTER"
+
+	defaultPendingReminderReconcileInterval   = time.Minute
+	defaultPendingReminderReconcileStaleAfter = 5 * time.Minute
+
+	trashPurgeIntervalEnv = "TRASH_PURGE_INTERVAL"
+	trashRetentionEnv     = "TRASH_RETENTION"
+
+	defaultTrashPurgeInterval = time.Hour
+	defaultTrashRetention     = 30 * 24 * time.Hour
 )
 
 type Config struct {
 	AuthServiceURL   string
 	DeviceServiceURL string
 	TaskQueue        TaskQueueConfig
+	RemindOutbox     RemindOutboxConfig
+	PendingReminder  PendingReminderConfig
+	Trash            TrashConfig
 }
 
 type TaskQueueConfig struct {
@@ -50,6 +72,23 @@ type TaskQueueConfig struct {
 	MaxRetries int
 }
 
+type RemindOutboxConfig struct {
+	PollInterval time.Duration
+	MaxAttempts  int
+}
+
+// PendingReminderConfig controls the reconciler for tasks stuck in pending_reminders.
+type PendingReminderConfig struct {
+	ReconcileInterval time.Duration
+	StaleAfter        time.Duration
+}
+
+// TrashConfig controls how long deleted tasks can be restored before they are purged.
+type TrashConfig struct {
+	PurgeInterval time.Duration
+	Retention     time.Duration
+}
+
 func Load() (*Config, error) {
 	authServiceURL := getEnv(authServiceURLEnv, defaultAuthServiceURL)
 	deviceServiceURL := getEnv(deviceServiceURLEnv, defaultDeviceServiceURL)
@@ -65,6 +104,22 @@ func Load() (*Config, error) {
 		}
 	}
 
+	outboxPollInterval := getPositiveDurationEnv(remindOutboxPollIntervalEnv, defaultRemindOutboxPollInterval)
+
+	outboxMaxAttempts := defaultRemindOutboxMaxAttempts
+
+	if v := os.Getenv(remindOutboxMaxAttemptsEnv); v != "" {
+		if parsed, err := strconv.Atoi(v); err == nil && parsed > 0 {
+			outboxMaxAttempts = parsed
+		}
+	}
+
+	reconcileInterval := getPositiveDurationEnv(pendingReminderReconcileIntervalEnv, defaultPendingReminderReconcileInterval)
+	reconcileStaleAfter := getPositiveDurationEnv(pendingReminderReconcileStaleAfterEnv, defaultPendingReminderReconcileStaleAfter)
+
+	trashPurgeInterval := getPositiveDurationEnv(trashPurgeIntervalEnv, defaultTrashPurgeInterval)
+	trashRetention := getPositiveDurationEnv(trashRetentionEnv, defaultTrashRetention)
+
 	cfg := &Config{
 		AuthServiceURL:   authServiceURL,
 		DeviceServiceURL: deviceServiceURL,
@@ -81,6 +136,18 @@ func Load() (*Config, error) {
 
 			MaxRetries: maxRetries,
 		},
+		RemindOutbox: RemindOutboxConfig{
+			PollInterval: outboxPollInterval,
+			MaxAttempts:  outboxMaxAttempts,
+		},
+		PendingReminder: PendingReminderConfig{
+			ReconcileInterval: reconcileInterval,
+			StaleAfter:        reconcileStaleAfter,
+		},
+		Trash: TrashConfig{
+			PurgeInterval: trashPurgeInterval,
+			Retention:     trashRetention,
+		},
 	}
 
 	return cfg, cfg.Validate()
@@ -143,3 +210,19 @@ func getEnv(key, defaultVal string) string {
 
 	return defaultVal
 }
+
+// getPositiveDurationEnv parses key as a duration, falling back to defaultVal when it is unset,
+// malformed or not positive.
+func getPositiveDurationEnv(key string, defaultVal time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultVal
+	}
+
+	parsed, err := time.ParseDuration(strings.TrimSpace(v))
+	if err != nil || parsed <= 0 {
+		return defaultVal
+	}
+
+	return parsed
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"testing"
+	"time"
 )
 
 func TestLoad(t *testing.T) {
@@ -254,3 +255,114 @@ func TestGetEnvHelpter(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadRemindOutbox(t *testing.T) {
+	tests := []struct {
+		name                string
+		envPollInterval     string
+		envMaxAttempts      string
+		expectedInterval    time.Duration
+		expectedMaxAttempts int
+	}{
+		{"defaults", "", "", time.Second, 10},
+		{"custom values", "250ms", "5", 250 * time.Millisecond, 5},
+		{"invalid values fall back to defaults", "soon", "-1", time.Second, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AUTH_SERVICE_URL", "https://auth.example.com")
+			t.Setenv("DEVICE_SERVICE_URL", "https://device.example.com")
+			t.Setenv("PRIMIND_TASKS_URL", "https://tasks.example.com")
+			t.Setenv("REMIND_OUTBOX_POLL_INTERVAL", tt.envPollInterval)
+			t.Setenv("REMIND_OUTBOX_MAX_ATTEMPTS", tt.envMaxAttempts)
+
+			got, err := Load()
+			if err != nil {
+				t.Fatalf("Load() error = %v, want nil", err)
+			}
+
+			if got.RemindOutbox.PollInterval != tt.expectedInterval {
+				t.Fatalf("Load() RemindOutbox.PollInterval = %v, want %v", got.RemindOutbox.PollInterval, tt.expectedInterval)
+			}
+
+			if got.RemindOutbox.MaxAttempts != tt.expectedMaxAttempts {
+				t.Fatalf("Load() RemindOutbox.MaxAttempts = %d, want %d", got.RemindOutbox.MaxAttempts, tt.expectedMaxAttempts)
+			}
+		})
+	}
+}
+
+func TestLoadPendingReminder(t *testing.T) {
+	tests := []struct {
+		name               string
+		envInterval        string
+		envStaleAfter      string
+		expectedInterval   time.Duration
+		expectedStaleAfter time.Duration
+	}{
+		{"defaults", "", "", time.Minute, 5 * time.Minute},
+		{"custom values", "30s", "15m", 30 * time.Second, 15 * time.Minute},
+		{"invalid values fall back to defaults", "later", "0s", time.Minute, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AUTH_SERVICE_URL", "https://auth.example.com")
+			t.Setenv("DEVICE_SERVICE_URL", "https://device.example.com")
+			t.Setenv("PRIMIND_TASKS_URL", "https://tasks.example.com")
+			t.Setenv("PENDING_REMINDER_RECONCILE_INTERVAL", tt.envInterval)
+			t.Setenv("PENDING_REMINDER_RECONCILE_STALE_AFTER", tt.envStaleAfter)
+
+			got, err := Load()
+			if err != nil {
+				t.Fatalf("Load() error = %v, want nil", err)
+			}
+
+			if got.PendingReminder.ReconcileInterval != tt.expectedInterval {
+				t.Fatalf("Load() PendingReminder.ReconcileInterval = %v, want %v", got.PendingReminder.ReconcileInterval, tt.expectedInterval)
+			}
+
+			if got.PendingReminder.StaleAfter != tt.expectedStaleAfter {
+				t.Fatalf("Load() PendingReminder.StaleAfter = %v, want %v", got.PendingReminder.StaleAfter, tt.expectedStaleAfter)
+			}
+		})
+	}
+}
+
+func TestLoadTrash(t *testing.T) {
+	tests := []struct {
+		name              string
+		envInterval       string
+		envRetention      string
+		expectedInterval  time.Duration
+		expectedRetention time.Duration
+	}{
+		{"defaults", "", "", time.Hour, 720 * time.Hour},
+		{"custom values", "15m", "168h", 15 * time.Minute, 168 * time.Hour},
+		{"invalid values fall back to defaults", "often", "-1h", time.Hour, 720 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AUTH_SERVICE_URL", "https://auth.example.com")
+			t.Setenv("DEVICE_SERVICE_URL", "https://device.example.com")
+			t.Setenv("PRIMIND_TASKS_URL", "https://tasks.example.com")
+			t.Setenv("TRASH_PURGE_INTERVAL", tt.envInterval)
+			t.Setenv("TRASH_RETENTION", tt.envRetention)
+
+			got, err := Load()
+			if err != nil {
+				t.Fatalf("Load() error = %v, want nil", err)
+			}
+
+			if got.Trash.PurgeInterval != tt.expectedInterval {
+				t.Fatalf("Load() Trash.PurgeInterval = %v, want %v", got.Trash.PurgeInterval, tt.expectedInterval)
+			}
+
+			if got.Trash.Retention != tt.expectedRetention {
+				t.Fatalf("Load() Trash.Retention = %v, want %v", got.Trash.Retention, tt.expectedRetention)
+			}
+		})
+	}
+}
@@ -6,4 +6,8 @@ var (
 	ErrScheduledTypeNotAllowed = errors.New("scheduled task type is not allowed for period settings")
 	ErrInvalidPeriodMinutes    = errors.New("period minutes must be between 1 and 10080")
 	ErrInvalidTaskType         = errors.New("invalid task type for period setting")
+
+	ErrInvalidReminderInterval   = errors.New("reminder interval must be greater than 0 and less than 1")
+	ErrTooManyReminderIntervals  = errors.New("at most 10 reminder intervals can be set per task type")
+	ErrDuplicateReminderInterval = errors.New("reminder intervals must not contain duplicates")
 )
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: quiet_hours_setting_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=quiet_hours_setting_repository.go -destination=mock_quiet_hours_setting_repository.go -package=period
+//
+
+// Package period is a generated GoMock package.
+package period
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuietHoursSettingRepository is a mock of QuietHoursSettingRepository interface.
+type MockQuietHoursSettingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuietHoursSettingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQuietHoursSettingRepositoryMockRecorder is the mock recorder for MockQuietHoursSettingRepository.
+type MockQuietHoursSettingRepositoryMockRecorder struct {
+	mock *MockQuietHoursSettingRepository
+}
+
+// NewMockQuietHoursSettingRepository creates a new mock instance.
+func NewMockQuietHoursSettingRepository(ctrl *gomock.Controller) *MockQuietHoursSettingRepository {
+	mock := &MockQuietHoursSettingRepository{ctrl: ctrl}
+	mock.recorder = &MockQuietHoursSettingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuietHoursSettingRepository) EXPECT() *MockQuietHoursSettingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockQuietHoursSettingRepository) GetByUserID(ctx context.Context, userID user.ID) (*UserQuietHoursSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*UserQuietHoursSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockQuietHoursSettingRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockQuietHoursSettingRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockQuietHoursSettingRepository) Save(ctx context.Context, settings *UserQuietHoursSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockQuietHoursSettingRepositoryMockRecorder) Save(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockQuietHoursSettingRepository)(nil).Save), ctx, settings)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder_interval_setting_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminder_interval_setting_repository.go -destination=mock_reminder_interval_setting_repository.go -package=period
+//
+
+// Package period is a generated GoMock package.
+package period
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderIntervalSettingRepository is a mock of ReminderIntervalSettingRepository interface.
+type MockReminderIntervalSettingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderIntervalSettingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderIntervalSettingRepositoryMockRecorder is the mock recorder for MockReminderIntervalSettingRepository.
+type MockReminderIntervalSettingRepositoryMockRecorder struct {
+	mock *MockReminderIntervalSettingRepository
+}
+
+// NewMockReminderIntervalSettingRepository creates a new mock instance.
+func NewMockReminderIntervalSettingRepository(ctrl *gomock.Controller) *MockReminderIntervalSettingRepository {
+	mock := &MockReminderIntervalSettingRepository{ctrl: ctrl}
+	mock.recorder = &MockReminderIntervalSettingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderIntervalSettingRepository) EXPECT() *MockReminderIntervalSettingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockReminderIntervalSettingRepository) GetByUserID(ctx context.Context, userID user.ID) (*UserReminderIntervalSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*UserReminderIntervalSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockReminderIntervalSettingRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReminderIntervalSettingRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// Save mocks base method.
+func (m *MockReminderIntervalSettingRepository) Save(ctx context.Context, settings *UserReminderIntervalSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReminderIntervalSettingRepositoryMockRecorder) Save(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReminderIntervalSettingRepository)(nil).Save), ctx, settings)
+}
@@ -0,0 +1,37 @@
+package period
+
+import (
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+// UserQuietHoursSettings holds the quiet hours a user set. A user without quiet hours
+// gets every reminder at the calculated time.
+type UserQuietHoursSettings struct {
+	userID     user.ID
+	quietHours *task.QuietHours
+}
+
+// NewUserQuietHoursSettings creates a new UserQuietHoursSettings instance.
+// A nil quietHours clears the setting.
+func NewUserQuietHoursSettings(userID user.ID, quietHours *task.QuietHours) *UserQuietHoursSettings {
+	return &UserQuietHoursSettings{
+		userID:     userID,
+		quietHours: quietHours,
+	}
+}
+
+// UserID returns the user ID
+func (s *UserQuietHoursSettings) UserID() user.ID {
+	return s.userID
+}
+
+// QuietHours returns the quiet hours, or nil if none are set
+func (s *UserQuietHoursSettings) QuietHours() *task.QuietHours {
+	return s.quietHours
+}
+
+// IsEmpty returns true if no quiet hours are set
+func (s *UserQuietHoursSettings) IsEmpty() bool {
+	return s.quietHours == nil
+}
@@ -0,0 +1,19 @@
+package period
+
+//go:generate mockgen -source=quiet_hours_setting_repository.go -destination=mock_quiet_hours_setting_repository.go -package=period
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+// QuietHoursSettingRepository defines the interface for quiet hours setting persistence
+type QuietHoursSettingRepository interface {
+	// GetByUserID retrieves the quiet hours settings for a user
+	// Returns an empty UserQuietHoursSettings if no settings exist
+	GetByUserID(ctx context.Context, userID user.ID) (*UserQuietHoursSettings, error)
+
+	// Save creates or replaces the quiet hours settings for a user
+	Save(ctx context.Context, settings *UserQuietHoursSettings) error
+}
@@ -0,0 +1,108 @@
+package period
+
+import (
+	"slices"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+// MaxReminderIntervals is the most reminders a user can place before a task's targetAt
+const MaxReminderIntervals = 10
+
+// UserReminderIntervalSettings holds the reminder intervals a user set per task type.
+// Each interval is the fraction of the reminder window after which a reminder fires.
+type UserReminderIntervalSettings struct {
+	userID    user.ID
+	intervals map[task.Type][]task.ReminderInterval
+}
+
+// NewUserReminderIntervalSettings creates a new UserReminderIntervalSettings instance.
+// The intervals of every type are sorted; an empty list is kept and means that only the
+// reminder at targetAt is sent.
+func NewUserReminderIntervalSettings(
+	userID user.ID,
+	intervals map[task.Type][]task.ReminderInterval,
+) (*UserReminderIntervalSettings, error) {
+	normalized := make(map[task.Type][]task.ReminderInterval, len(intervals))
+
+	for taskType, values := range intervals {
+		if !isValidReminderTaskType(taskType) {
+			return nil, ErrInvalidTaskType
+		}
+
+		if len(values) > MaxReminderIntervals {
+			return nil, ErrTooManyReminderIntervals
+		}
+
+		sorted := slices.Clone(values)
+		if sorted == nil {
+			sorted = []task.ReminderInterval{}
+		}
+
+		slices.Sort(sorted)
+
+		for i, value := range sorted {
+			// The negated form also rejects NaN
+			if !(value > 0 && value < 1) {
+				return nil, ErrInvalidReminderInterval
+			}
+
+			if i > 0 && sorted[i-1] == value {
+				return nil, ErrDuplicateReminderInterval
+			}
+		}
+
+		normalized[taskType] = sorted
+	}
+
+	return &UserReminderIntervalSettings{
+		userID:    userID,
+		intervals: normalized,
+	}, nil
+}
+
+// UserID returns the user ID
+func (s *UserReminderIntervalSettings) UserID() user.ID {
+	return s.userID
+}
+
+// Intervals returns a copy of the intervals map
+func (s *UserReminderIntervalSettings) Intervals() map[task.Type][]task.ReminderInterval {
+	result := make(map[task.Type][]task.ReminderInterval, len(s.intervals))
+	for k, v := range s.intervals {
+		result[k] = slices.Clone(v)
+	}
+
+	return result
+}
+
+// Overrides returns the intervals in the form used to calculate reminder times
+func (s *UserReminderIntervalSettings) Overrides() task.ReminderIntervalOverrides {
+	return task.ReminderIntervalOverrides(s.Intervals())
+}
+
+// IsEmpty returns true if no custom intervals are set
+func (s *UserReminderIntervalSettings) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// DefaultReminderIntervalSettings returns the default intervals. Scheduled tasks have no
+// default of their own; they use one of these depending on the length of the window.
+func DefaultReminderIntervalSettings() map[task.Type][]task.ReminderInterval {
+	result := make(map[task.Type][]task.ReminderInterval, len(task.DefaultReminderIntervals))
+	for k, v := range task.DefaultReminderIntervals {
+		result[k] = slices.Clone(v)
+	}
+
+	return result
+}
+
+func isValidReminderTaskType(t task.Type) bool {
+	switch t {
+	case task.TypeShort, task.TypeNear, task.TypeRelaxed, task.TypeScheduled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,19 @@
+package period
+
+//go:generate mockgen -source=reminder_interval_setting_repository.go -destination=mock_reminder_interval_setting_repository.go -package=period
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+// ReminderIntervalSettingRepository defines the interface for reminder interval setting persistence
+type ReminderIntervalSettingRepository interface {
+	// GetByUserID retrieves the reminder interval settings for a user
+	// Returns an empty UserReminderIntervalSettings if no settings exist
+	GetByUserID(ctx context.Context, userID user.ID) (*UserReminderIntervalSettings, error)
+
+	// Save creates or replaces the reminder interval settings for a user
+	Save(ctx context.Context, settings *UserReminderIntervalSettings) error
+}
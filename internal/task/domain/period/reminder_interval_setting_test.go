@@ -0,0 +1,111 @@
+package period
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func TestNewUserReminderIntervalSettings(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	settings, err := NewUserReminderIntervalSettings(userID, map[task.Type][]task.ReminderInterval{
+		task.TypeShort:     {0.9, 0.5},
+		task.TypeNear:      {},
+		task.TypeScheduled: nil,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	intervals := settings.Intervals()
+
+	if got := intervals[task.TypeShort]; !slices.Equal(got, []task.ReminderInterval{0.5, 0.9}) {
+		t.Errorf("expected sorted short intervals, got %v", got)
+	}
+
+	for _, taskType := range []task.Type{task.TypeNear, task.TypeScheduled} {
+		if got, ok := intervals[taskType]; !ok || len(got) != 0 {
+			t.Errorf("expected an empty override for %s, got %v (present %v)", taskType, got, ok)
+		}
+	}
+
+	if _, ok := settings.Overrides()[task.TypeRelaxed]; ok {
+		t.Error("expected no override for relaxed tasks")
+	}
+
+	intervals[task.TypeShort][0] = 0.1
+	if got := settings.Intervals()[task.TypeShort][0]; got != 0.5 {
+		t.Errorf("expected Intervals to return a copy, got %v", got)
+	}
+}
+
+func TestNewUserReminderIntervalSettingsError(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	tooMany := make([]task.ReminderInterval, 0, MaxReminderIntervals+1)
+	for i := range MaxReminderIntervals + 1 {
+		tooMany = append(tooMany, task.ReminderInterval(float64(i+1)/100))
+	}
+
+	tests := []struct {
+		name      string
+		intervals map[task.Type][]task.ReminderInterval
+		wantErr   error
+	}{
+		{
+			name:      "unknown task type",
+			intervals: map[task.Type][]task.ReminderInterval{task.Type("unknown"): {0.5}},
+			wantErr:   ErrInvalidTaskType,
+		},
+		{
+			name:      "zero",
+			intervals: map[task.Type][]task.ReminderInterval{task.TypeShort: {0}},
+			wantErr:   ErrInvalidReminderInterval,
+		},
+		{
+			name:      "one",
+			intervals: map[task.Type][]task.ReminderInterval{task.TypeShort: {0.5, 1}},
+			wantErr:   ErrInvalidReminderInterval,
+		},
+		{
+			name:      "NaN",
+			intervals: map[task.Type][]task.ReminderInterval{task.TypeShort: {task.ReminderInterval(math.NaN())}},
+			wantErr:   ErrInvalidReminderInterval,
+		},
+		{
+			name:      "duplicate",
+			intervals: map[task.Type][]task.ReminderInterval{task.TypeNear: {0.5, 0.25, 0.5}},
+			wantErr:   ErrDuplicateReminderInterval,
+		},
+		{
+			name:      "too many",
+			intervals: map[task.Type][]task.ReminderInterval{task.TypeRelaxed: tooMany},
+			wantErr:   ErrTooManyReminderIntervals,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewUserReminderIntervalSettings(userID, tt.intervals); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
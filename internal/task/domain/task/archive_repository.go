@@ -8,6 +8,15 @@ import (
 
 //go:generate mockgen -source=archive_repository.go -destination=mock_archive_repository.go -package=task
 
+// TaskArchiveRepository moves tasks between the active and the completed tables. Archiving
+// keeps a snapshot of the task's checklist on the completed task, and unarchiving restores it.
+// The next occurrence of a recurring task starts with an unchecked copy of the checklist.
+// A task is only archived while it is still at the version it was read at; otherwise
+// archiving fails with ErrTaskVersionConflict.
 type TaskArchiveRepository interface {
-	ArchiveTask(ctx context.Context, completedTask *CompletedTask, taskID ID, userID user.ID) error
+	ArchiveTask(ctx context.Context, completedTask *CompletedTask, taskID ID, userID user.ID, version int64) error
+	ArchiveTaskWithNextOccurrence(ctx context.Context, completedTask *CompletedTask, taskID ID, userID user.ID, version int64, next *Task) error
+	UnarchiveTask(ctx context.Context, task *Task) error
+	GetCompletedTaskByID(ctx context.Context, id ID, userID user.ID) (*CompletedTask, error)
+	ListCompletedTasksByUserID(ctx context.Context, userID user.ID, query ListCompletedTasksQuery) ([]*CompletedTask, *PageCursor, error)
 }
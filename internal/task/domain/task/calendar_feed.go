@@ -0,0 +1,73 @@
+package task
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+const (
+	// CalendarFeedCompletedWithin is how long a completed task stays in the calendar feed.
+	CalendarFeedCompletedWithin = 30 * 24 * time.Hour
+
+	calendarFeedTokenBytes = 32
+)
+
+// CalendarFeedToken lets calendar apps read a user's calendar feed, since they cannot
+// send a session token. A user has at most one. Only the hash of the token is kept; the
+// token itself is handed out once, when it is created.
+type CalendarFeedToken struct {
+	userID    user.ID
+	hash      string
+	createdAt time.Time
+}
+
+// NewCalendarFeedToken generates a token for the user. It returns the token to hand out
+// along with the value to store.
+func NewCalendarFeedToken(userID user.ID, createdAt time.Time) (*CalendarFeedToken, string, error) {
+	buf := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return &CalendarFeedToken{
+		userID:    userID,
+		hash:      hashCalendarFeedToken(buf),
+		createdAt: createdAt.UTC().Truncate(time.Microsecond),
+	}, token, nil
+}
+
+// HashCalendarFeedToken returns the stored form of a token handed out by
+// NewCalendarFeedToken.
+func HashCalendarFeedToken(token string) (string, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) != calendarFeedTokenBytes {
+		return "", ErrInvalidCalendarFeedToken
+	}
+
+	return hashCalendarFeedToken(buf), nil
+}
+
+func hashCalendarFeedToken(buf []byte) string {
+	sum := sha256.Sum256(buf)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *CalendarFeedToken) UserID() user.ID {
+	return t.userID
+}
+
+func (t *CalendarFeedToken) Hash() string {
+	return t.hash
+}
+
+func (t *CalendarFeedToken) CreatedAt() time.Time {
+	return t.createdAt
+}
@@ -0,0 +1,20 @@
+package task
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+//go:generate mockgen -source=calendar_feed_repository.go -destination=mock_calendar_feed_repository.go -package=task
+
+type CalendarFeedTokenRepository interface {
+	// CreateCalendarFeedToken returns ErrCalendarFeedTokenAlreadyExists when the user already has a token.
+	CreateCalendarFeedToken(ctx context.Context, token *CalendarFeedToken) error
+	// RotateCalendarFeedToken replaces the user's token, which stops working right away.
+	// It returns ErrCalendarFeedTokenNotFound when the user has no token.
+	RotateCalendarFeedToken(ctx context.Context, token *CalendarFeedToken) error
+	DeleteCalendarFeedToken(ctx context.Context, userID user.ID) error
+	// GetUserIDByCalendarFeedToken finds the owner of the token with the given hash.
+	GetUserIDByCalendarFeedToken(ctx context.Context, hash string) (user.ID, error)
+}
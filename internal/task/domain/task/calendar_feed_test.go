@@ -0,0 +1,63 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func TestNewCalendarFeedToken(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user ID: %v", err)
+	}
+
+	feedToken, token, err := NewCalendarFeedToken(userID, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if feedToken.UserID() != userID {
+		t.Errorf("expected user %v, got %v", userID, feedToken.UserID())
+	}
+
+	hash, err := HashCalendarFeedToken(token)
+	if err != nil {
+		t.Fatalf("failed to hash token: %v", err)
+	}
+
+	if hash != feedToken.Hash() || hash == token {
+		t.Errorf("expected the token to hash to the stored value")
+	}
+
+	_, other, err := NewCalendarFeedToken(userID, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if other == token {
+		t.Errorf("expected a new token every time")
+	}
+}
+
+func TestHashCalendarFeedTokenInvalid(t *testing.T) {
+	t.Parallel()
+
+	for name, token := range map[string]string{
+		"empty":      "",
+		"not base64": "not a token!",
+		"too short":  "c2hvcnQ",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := HashCalendarFeedToken(token); !errors.Is(err, ErrInvalidCalendarFeedToken) {
+				t.Fatalf("expected ErrInvalidCalendarFeedToken, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package task
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxChecklistItems          = 100
+	MaxChecklistItemTextLength = 500
+)
+
+type ChecklistItemID uuid.UUID
+
+func NewChecklistItemID() (ChecklistItemID, error) {
+	v7, err := uuid.NewV7()
+	if err != nil {
+		return ChecklistItemID{}, fmt.Errorf("%w: %v", ErrIDGeneration, err)
+	}
+
+	return ChecklistItemID(v7), nil
+}
+
+func NewChecklistItemIDFromString(idStr string) (ChecklistItemID, error) {
+	uuidVal, err := uuid.Parse(idStr)
+	if err != nil {
+		return ChecklistItemID{}, fmt.Errorf("%w: %v", ErrChecklistItemIDInvalidFormat, err)
+	}
+
+	if uuidVal.Version() != 7 {
+		return ChecklistItemID{}, ErrChecklistItemIDInvalidV7
+	}
+
+	return ChecklistItemID(uuidVal), nil
+}
+
+func (id ChecklistItemID) String() string {
+	return uuid.UUID(id).String()
+}
+
+// ChecklistItem is a single entry of a task's checklist. Items are ordered by position,
+// which starts at 0 and has no gaps within a checklist.
+type ChecklistItem struct {
+	id       ChecklistItemID
+	taskID   ID
+	text     string
+	done     bool
+	position int
+}
+
+func NewChecklistItem(id ChecklistItemID, taskID ID, text string, done bool, position int) (*ChecklistItem, error) {
+	normalizedText := strings.TrimSpace(text)
+
+	if normalizedText == "" {
+		return nil, ErrChecklistItemTextEmpty
+	}
+
+	if utf8.RuneCountInString(normalizedText) > MaxChecklistItemTextLength {
+		return nil, ErrChecklistItemTextTooLong
+	}
+
+	if position < 0 {
+		return nil, ErrInvalidChecklistPosition
+	}
+
+	return &ChecklistItem{
+		id:       id,
+		taskID:   taskID,
+		text:     normalizedText,
+		done:     done,
+		position: position,
+	}, nil
+}
+
+func (i *ChecklistItem) ID() ChecklistItemID {
+	return i.id
+}
+
+func (i *ChecklistItem) TaskID() ID {
+	return i.taskID
+}
+
+func (i *ChecklistItem) Text() string {
+	return i.text
+}
+
+func (i *ChecklistItem) Done() bool {
+	return i.done
+}
+
+func (i *ChecklistItem) Position() int {
+	return i.position
+}
+
+// SetDone returns a copy of the item with its done flag set to done.
+func (i *ChecklistItem) SetDone(done bool) *ChecklistItem {
+	item := *i
+	item.done = done
+
+	return &item
+}
+
+// CopyTo returns an unchecked copy of the item with a new ID for the checklist of taskID,
+// as used when a recurring task moves on to its next occurrence.
+func (i *ChecklistItem) CopyTo(taskID ID) (*ChecklistItem, error) {
+	id, err := NewChecklistItemID()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChecklistItem(id, taskID, i.text, false, i.position)
+}
+
+// ReorderChecklist returns items placed in the order given by order, which must list
+// every item exactly once.
+func ReorderChecklist(items []*ChecklistItem, order []ChecklistItemID) ([]*ChecklistItem, error) {
+	if len(order) != len(items) {
+		return nil, ErrChecklistOrderMismatch
+	}
+
+	byID := make(map[ChecklistItemID]*ChecklistItem, len(items))
+	for _, item := range items {
+		byID[item.id] = item
+	}
+
+	reordered := make([]*ChecklistItem, 0, len(order))
+
+	for position, id := range order {
+		item, ok := byID[id]
+		if !ok {
+			return nil, ErrChecklistOrderMismatch
+		}
+
+		// Drop the entry so that a repeated ID is rejected
+		delete(byID, id)
+
+		moved := *item
+		moved.position = position
+		reordered = append(reordered, &moved)
+	}
+
+	return reordered, nil
+}
+
+// ChecklistProgress counts the items of a task's checklist and how many of them are done.
+type ChecklistProgress struct {
+	total int
+	done  int
+}
+
+func NewChecklistProgress(total, done int) ChecklistProgress {
+	return ChecklistProgress{
+		total: total,
+		done:  done,
+	}
+}
+
+func ChecklistProgressOf(items []*ChecklistItem) ChecklistProgress {
+	done := 0
+
+	for _, item := range items {
+		if item.done {
+			done++
+		}
+	}
+
+	return NewChecklistProgress(len(items), done)
+}
+
+func (p ChecklistProgress) Total() int {
+	return p.total
+}
+
+func (p ChecklistProgress) Done() int {
+	return p.done
+}
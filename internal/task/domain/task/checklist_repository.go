@@ -0,0 +1,24 @@
+package task
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+//go:generate mockgen -source=checklist_repository.go -destination=mock_checklist_repository.go -package=task
+
+// ChecklistRepository stores the checklist items of active tasks. Every method is scoped
+// to tasks owned by userID and returns ErrTaskNotFound for any other task.
+type ChecklistRepository interface {
+	// ListChecklistItems returns the task's checklist ordered by position. Inside a
+	// transaction the task stays locked until the transaction ends, so that the checklist
+	// can be changed based on what was read.
+	ListChecklistItems(ctx context.Context, taskID ID, userID user.ID) ([]*ChecklistItem, error)
+	SaveChecklistItem(ctx context.Context, item *ChecklistItem, userID user.ID) error
+	UpdateChecklistItemDone(ctx context.Context, item *ChecklistItem, userID user.ID) error
+	// UpdateChecklistPositions stores the positions of the given items of one task.
+	UpdateChecklistPositions(ctx context.Context, taskID ID, userID user.ID, items []*ChecklistItem) error
+	// DeleteChecklistItem removes the item and closes the gap it leaves in the positions.
+	DeleteChecklistItem(ctx context.Context, taskID ID, itemID ChecklistItemID, userID user.ID) error
+}
@@ -0,0 +1,219 @@
+package task
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustChecklistItem(t *testing.T, taskID ID, text string, done bool, position int) *ChecklistItem {
+	t.Helper()
+
+	id, err := NewChecklistItemID()
+	if err != nil {
+		t.Fatalf("failed to generate checklist item ID: %v", err)
+	}
+
+	item, err := NewChecklistItem(id, taskID, text, done, position)
+	if err != nil {
+		t.Fatalf("failed to create checklist item: %v", err)
+	}
+
+	return item
+}
+
+func TestNewChecklistItem(t *testing.T) {
+	t.Parallel()
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	itemID, err := NewChecklistItemID()
+	if err != nil {
+		t.Fatalf("failed to generate checklist item ID: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		position int
+		want     string
+		wantErr  error
+	}{
+		{
+			name: "trims surrounding spaces",
+			text: "  buy milk ",
+			want: "buy milk",
+		},
+		{
+			name: "counts characters rather than bytes",
+			text: strings.Repeat("あ", MaxChecklistItemTextLength),
+			want: strings.Repeat("あ", MaxChecklistItemTextLength),
+		},
+		{
+			name:    "empty text",
+			text:    "   ",
+			wantErr: ErrChecklistItemTextEmpty,
+		},
+		{
+			name:    "text too long",
+			text:    strings.Repeat("a", MaxChecklistItemTextLength+1),
+			wantErr: ErrChecklistItemTextTooLong,
+		},
+		{
+			name:     "negative position",
+			text:     "buy milk",
+			position: -1,
+			wantErr:  ErrInvalidChecklistPosition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			item, err := NewChecklistItem(itemID, taskID, tt.text, false, tt.position)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if item.Text() != tt.want {
+				t.Fatalf("expected text %q, got %q", tt.want, item.Text())
+			}
+		})
+	}
+}
+
+func TestNewChecklistItemIDFromStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewChecklistItemIDFromString("not-a-uuid"); !errors.Is(err, ErrChecklistItemIDInvalidFormat) {
+		t.Fatalf("expected ErrChecklistItemIDInvalidFormat, got %v", err)
+	}
+
+	if _, err := NewChecklistItemIDFromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"); !errors.Is(err, ErrChecklistItemIDInvalidV7) {
+		t.Fatalf("expected ErrChecklistItemIDInvalidV7, got %v", err)
+	}
+}
+
+func TestReorderChecklist(t *testing.T) {
+	t.Parallel()
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	first := mustChecklistItem(t, taskID, "first", false, 0)
+	second := mustChecklistItem(t, taskID, "second", true, 1)
+	third := mustChecklistItem(t, taskID, "third", false, 2)
+	items := []*ChecklistItem{first, second, third}
+
+	t.Run("places items in the given order", func(t *testing.T) {
+		t.Parallel()
+
+		reordered, err := ReorderChecklist(items, []ChecklistItemID{third.ID(), first.ID(), second.ID()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for position, want := range []*ChecklistItem{third, first, second} {
+			if reordered[position].ID() != want.ID() || reordered[position].Position() != position {
+				t.Errorf("expected %s at position %d, got %s at %d",
+					want.Text(), position, reordered[position].Text(), reordered[position].Position())
+			}
+		}
+
+		if first.Position() != 0 {
+			t.Errorf("expected the original item to be left unchanged")
+		}
+	})
+
+	otherID, err := NewChecklistItemID()
+	if err != nil {
+		t.Fatalf("failed to generate checklist item ID: %v", err)
+	}
+
+	invalid := []struct {
+		name  string
+		order []ChecklistItemID
+	}{
+		{name: "missing item", order: []ChecklistItemID{first.ID(), second.ID()}},
+		{name: "repeated item", order: []ChecklistItemID{first.ID(), first.ID(), second.ID()}},
+		{name: "unknown item", order: []ChecklistItemID{first.ID(), second.ID(), otherID}},
+	}
+
+	for _, tt := range invalid {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ReorderChecklist(items, tt.order); !errors.Is(err, ErrChecklistOrderMismatch) {
+				t.Fatalf("expected ErrChecklistOrderMismatch, got %v", err)
+			}
+		})
+	}
+}
+
+func TestChecklistItemCopyTo(t *testing.T) {
+	t.Parallel()
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	nextTaskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	item := mustChecklistItem(t, taskID, "pack lunch", true, 3)
+
+	copied, err := item.CopyTo(nextTaskID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if copied.ID() == item.ID() {
+		t.Errorf("expected a new item ID")
+	}
+
+	if copied.TaskID() != nextTaskID || copied.Text() != item.Text() || copied.Position() != item.Position() {
+		t.Errorf("expected the copy to keep text and position on the next task")
+	}
+
+	if copied.Done() {
+		t.Errorf("expected the copy to be unchecked")
+	}
+}
+
+func TestChecklistProgressOf(t *testing.T) {
+	t.Parallel()
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	progress := ChecklistProgressOf([]*ChecklistItem{
+		mustChecklistItem(t, taskID, "first", true, 0),
+		mustChecklistItem(t, taskID, "second", false, 1),
+		mustChecklistItem(t, taskID, "third", true, 2),
+	})
+
+	if progress.Total() != 3 || progress.Done() != 2 {
+		t.Fatalf("expected 2 of 3 done, got %d of %d", progress.Done(), progress.Total())
+	}
+}
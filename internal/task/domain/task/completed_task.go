@@ -17,6 +17,11 @@ type CompletedTask struct {
 	targetAt    time.Time
 	color       Color
 	completedAt time.Time
+	checklist   []*ChecklistItem
+	tags        []*Tag
+	recurrence  *Recurrence
+	// nextOccurrenceID is the occurrence created when this task was completed, if any
+	nextOccurrenceID *ID
 }
 
 func NewCompletedTask(task *Task, completedAt time.Time) (*CompletedTask, error) {
@@ -35,9 +40,108 @@ func NewCompletedTask(task *Task, completedAt time.Time) (*CompletedTask, error)
 		targetAt:    task.TargetAt(),
 		color:       task.Color(),
 		completedAt: completedAt.UTC().Truncate(time.Microsecond),
+		checklist:   nil,
+		tags:        task.Tags(),
+		recurrence:  task.Recurrence(),
+		// Recorded by ArchiveTaskWithNextOccurrence along with the next occurrence
+		nextOccurrenceID: nil,
 	}, nil
 }
 
+// ReconstructCompletedTask rebuilds an archived task from its persisted fields. checklist
+// is the snapshot of the task's checklist taken when it was archived; tags stay attached
+// to the task across archiving. nextOccurrenceID is the occurrence its completion created,
+// nil when it did not recur or its series had ended.
+func ReconstructCompletedTask(
+	id ID,
+	userID user.ID,
+	title string,
+	taskType Type,
+	description string,
+	scheduledAt *time.Time,
+	createdAt time.Time,
+	targetAt time.Time,
+	color Color,
+	completedAt time.Time,
+	checklist []*ChecklistItem,
+	tags []*Tag,
+	recurrence *Recurrence,
+	nextOccurrenceID *ID,
+) (*CompletedTask, error) {
+	if err := color.Validate(); err != nil {
+		return nil, err
+	}
+
+	normalizedScheduledAt := scheduledAt
+	if scheduledAt != nil {
+		t := scheduledAt.UTC().Truncate(time.Microsecond)
+		normalizedScheduledAt = &t
+	}
+
+	return &CompletedTask{
+		id:               id,
+		userID:           userID,
+		title:            title,
+		taskType:         taskType,
+		description:      description,
+		scheduledAt:      normalizedScheduledAt,
+		createdAt:        createdAt.UTC().Truncate(time.Microsecond),
+		targetAt:         targetAt.UTC().Truncate(time.Microsecond),
+		color:            color,
+		completedAt:      completedAt.UTC().Truncate(time.Microsecond),
+		checklist:        checklist,
+		tags:             tags,
+		recurrence:       recurrence,
+		nextOccurrenceID: nextOccurrenceID,
+	}, nil
+}
+
+// Reopen turns the completed task back into an active task awaiting reminder registration.
+// Scheduled tasks keep their scheduledAt as targetAt; other types get a fresh active period
+// starting at reopenedAt, using customPeriod when the user has configured one. The
+// checklist snapshot and tags are restored along with the task.
+//
+// The recurrence is restored as well, unless completing the task already created the next
+// occurrence: the series carries on with that occurrence, so this one comes back as a
+// one-off instead of starting a second copy of the series.
+func (ct *CompletedTask) Reopen(reopenedAt time.Time, customPeriod *time.Duration) (*Task, error) {
+	targetAt := ct.targetAt
+
+	recurrence := ct.recurrence
+	if ct.nextOccurrenceID != nil {
+		recurrence = nil
+	}
+
+	if ct.taskType == TypeScheduled {
+		if ct.scheduledAt != nil {
+			targetAt = *ct.scheduledAt
+		}
+	} else {
+		period := time.Duration(GetActivePeriodForType(ct.taskType))
+		if customPeriod != nil {
+			period = *customPeriod
+		}
+
+		targetAt = reopenedAt.Add(period)
+	}
+
+	return NewTask(
+		ct.id,
+		ct.userID,
+		ct.title,
+		ct.taskType,
+		StatusPendingReminders,
+		ct.description,
+		ct.scheduledAt,
+		ct.createdAt,
+		targetAt,
+		ct.color,
+		WithChecklistProgress(ChecklistProgressOf(ct.checklist)),
+		WithTags(ct.tags),
+		WithRecurrence(recurrence),
+	)
+}
+
 func (ct *CompletedTask) ID() ID {
 	return ct.id
 }
@@ -77,3 +181,20 @@ func (ct *CompletedTask) Color() Color {
 func (ct *CompletedTask) CompletedAt() time.Time {
 	return ct.completedAt
 }
+
+func (ct *CompletedTask) Checklist() []*ChecklistItem {
+	return ct.checklist
+}
+
+func (ct *CompletedTask) Tags() []*Tag {
+	return ct.tags
+}
+
+func (ct *CompletedTask) Recurrence() *Recurrence {
+	return ct.recurrence
+}
+
+// NextOccurrenceID returns the occurrence created when the task was completed, or nil.
+func (ct *CompletedTask) NextOccurrenceID() *ID {
+	return ct.nextOccurrenceID
+}
@@ -175,3 +175,191 @@ func TestNewCompletedTaskWithNilTask(t *testing.T) {
 		t.Errorf("expected ErrTaskNil, got %v", err)
 	}
 }
+
+func TestCompletedTaskReopen(t *testing.T) {
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	reopenedAt := createdAt.Add(24 * time.Hour)
+	scheduledAt := createdAt.Add(48 * time.Hour)
+	customPeriod := 30 * time.Minute
+
+	tests := []struct {
+		name         string
+		taskType     Type
+		scheduledAt  *time.Time
+		customPeriod *time.Duration
+		wantTargetAt time.Time
+	}{
+		{
+			name:         "scheduled task keeps its scheduled time",
+			taskType:     TypeScheduled,
+			scheduledAt:  &scheduledAt,
+			wantTargetAt: scheduledAt,
+		},
+		{
+			name:         "near task restarts default period from reopen time",
+			taskType:     TypeNear,
+			wantTargetAt: reopenedAt.Add(time.Duration(GetActivePeriodForType(TypeNear))),
+		},
+		{
+			name:         "custom period is used when provided",
+			taskType:     TypeShort,
+			customPeriod: &customPeriod,
+			wantTargetAt: reopenedAt.Add(customPeriod),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskID, err := NewID()
+			if err != nil {
+				t.Fatalf("failed to create task ID: %v", err)
+			}
+
+			task, err := NewTask(taskID, userID, "Task", tt.taskType, StatusCompleted, "desc", tt.scheduledAt, createdAt, createdAt.Add(time.Hour), MustColor("#FF6B6B"))
+			if err != nil {
+				t.Fatalf("failed to create task: %v", err)
+			}
+
+			completed, err := NewCompletedTask(task, createdAt.Add(2*time.Hour))
+			if err != nil {
+				t.Fatalf("failed to create completed task: %v", err)
+			}
+
+			reopened, err := completed.Reopen(reopenedAt, tt.customPeriod)
+			if err != nil {
+				t.Fatalf("Reopen returned error: %v", err)
+			}
+
+			if reopened.ID() != taskID {
+				t.Errorf("ID mismatch: got %s, want %s", reopened.ID(), taskID)
+			}
+
+			if reopened.TaskStatus() != StatusPendingReminders {
+				t.Errorf("expected status %s, got %s", StatusPendingReminders, reopened.TaskStatus())
+			}
+
+			if !reopened.CreatedAt().Equal(createdAt) {
+				t.Errorf("expected original createdAt %v, got %v", createdAt, reopened.CreatedAt())
+			}
+
+			if !reopened.TargetAt().Equal(tt.wantTargetAt) {
+				t.Errorf("expected targetAt %v, got %v", tt.wantTargetAt, reopened.TargetAt())
+			}
+		})
+	}
+}
+
+func TestCompletedTaskReopenRecurrence(t *testing.T) {
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	recurrence, err := NewRecurrence("FREQ=WEEKLY;BYDAY=MO", "Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	scheduledAt := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	completedAt := scheduledAt.Add(time.Hour)
+
+	nextID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create next occurrence ID: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		nextOccurrenceID *ID
+		wantRecurrence   bool
+	}{
+		{
+			name:           "recurrence is restored when the series did not continue",
+			wantRecurrence: true,
+		},
+		{
+			name:             "reopened as a one-off when the next occurrence was created",
+			nextOccurrenceID: &nextID,
+			wantRecurrence:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskID, err := NewID()
+			if err != nil {
+				t.Fatalf("failed to create task ID: %v", err)
+			}
+
+			completed, err := ReconstructCompletedTask(
+				taskID, userID, "Weekly review", TypeScheduled, "", &scheduledAt,
+				createdAt, scheduledAt, MustColor("#FF6B6B"), completedAt,
+				nil, nil, recurrence, tt.nextOccurrenceID,
+			)
+			if err != nil {
+				t.Fatalf("failed to reconstruct completed task: %v", err)
+			}
+
+			reopened, err := completed.Reopen(completedAt.Add(time.Hour), nil)
+			if err != nil {
+				t.Fatalf("Reopen returned error: %v", err)
+			}
+
+			if tt.wantRecurrence {
+				if reopened.Recurrence() == nil || reopened.Recurrence().Rule() != recurrence.Rule() {
+					t.Errorf("expected recurrence %q to be restored, got %v", recurrence.Rule(), reopened.Recurrence())
+				}
+
+				return
+			}
+
+			if reopened.Recurrence() != nil {
+				t.Errorf("expected no recurrence, got %q", reopened.Recurrence().Rule())
+			}
+		})
+	}
+}
+
+func TestNewCompletedTaskKeepsRecurrence(t *testing.T) {
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	recurrence, err := NewRecurrence("FREQ=DAILY;COUNT=3", "")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	scheduledAt := createdAt.Add(time.Hour)
+
+	task, err := NewTask(taskID, userID, "Daily", TypeScheduled, StatusActive, "", &scheduledAt, createdAt, scheduledAt, MustColor("#FF6B6B"), WithRecurrence(recurrence))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	completed, err := NewCompletedTask(task, scheduledAt)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	if completed.Recurrence() != recurrence {
+		t.Errorf("expected recurrence to be kept, got %v", completed.Recurrence())
+	}
+
+	if completed.NextOccurrenceID() != nil {
+		t.Errorf("expected no next occurrence, got %v", completed.NextOccurrenceID())
+	}
+}
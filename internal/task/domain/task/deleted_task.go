@@ -0,0 +1,53 @@
+package task
+
+import "time"
+
+// DeletedTask is a task in the trash. The task keeps every field it had when it was
+// deleted, along with its checklist and tags, until it is restored or purged.
+type DeletedTask struct {
+	task      *Task
+	deletedAt time.Time
+}
+
+func NewDeletedTask(task *Task, deletedAt time.Time) (*DeletedTask, error) {
+	if task == nil {
+		return nil, ErrTaskNil
+	}
+
+	return &DeletedTask{
+		task:      task,
+		deletedAt: deletedAt.UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+func (d *DeletedTask) Task() *Task {
+	return d.task
+}
+
+func (d *DeletedTask) DeletedAt() time.Time {
+	return d.deletedAt
+}
+
+// Restore returns the task as it goes back to the active tasks. Its reminders were
+// cancelled when it was deleted, so it comes back active and the caller registers them again.
+func (d *DeletedTask) Restore() (*Task, error) {
+	t := d.task
+
+	return NewTask(
+		t.id,
+		t.userID,
+		t.title,
+		t.taskType,
+		StatusActive,
+		t.description,
+		t.scheduledAt,
+		t.createdAt,
+		t.targetAt,
+		t.color,
+		WithRecurrence(t.recurrence),
+		WithRank(t.rank),
+		WithChecklistProgress(t.checklist),
+		WithTags(t.tags),
+		WithVersion(t.version),
+	)
+}
@@ -0,0 +1,110 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func newTestDeletedTask(t *testing.T, status Status, deletedAt time.Time) *DeletedTask {
+	t.Helper()
+
+	id, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	tag, err := CreateTag(userID, "work", MustColor("#4ECDC4"))
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	task, err := NewTask(id, userID, "Task", TypeNear, status, "", nil, createdAt, createdAt.Add(time.Hour), MustColor("#FF6B6B"),
+		WithRank("n"),
+		WithChecklistProgress(NewChecklistProgress(3, 1)),
+		WithTags([]*Tag{tag}),
+	)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	deletedTask, err := NewDeletedTask(task, deletedAt)
+	if err != nil {
+		t.Fatalf("failed to create deleted task: %v", err)
+	}
+
+	return deletedTask
+}
+
+func TestDeletedTaskRestore(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2026, 10, 2, 9, 0, 0, 123, time.FixedZone("JST", 9*60*60))
+	deletedTask := newTestDeletedTask(t, StatusReminderFailed, deletedAt)
+
+	if !deletedTask.DeletedAt().Equal(deletedAt.Truncate(time.Microsecond)) || deletedTask.DeletedAt().Location() != time.UTC {
+		t.Errorf("expected deletedAt to be normalized, got %v", deletedTask.DeletedAt())
+	}
+
+	restored, err := deletedTask.Restore()
+	if err != nil {
+		t.Fatalf("failed to restore task: %v", err)
+	}
+
+	original := deletedTask.Task()
+
+	if restored.TaskStatus() != StatusActive {
+		t.Errorf("expected restored task to be active, got %s", restored.TaskStatus())
+	}
+
+	if restored.ID() != original.ID() || restored.Rank() != "n" || !restored.TargetAt().Equal(original.TargetAt()) {
+		t.Errorf("expected restored task to keep its fields, got %s %q %v", restored.ID(), restored.Rank(), restored.TargetAt())
+	}
+
+	if restored.ChecklistProgress().Total() != 3 || len(restored.Tags()) != 1 {
+		t.Errorf("expected restored task to keep its checklist and tags, got %+v %d", restored.ChecklistProgress(), len(restored.Tags()))
+	}
+
+	if _, err := NewDeletedTask(nil, deletedAt); !errors.Is(err, ErrTaskNil) {
+		t.Errorf("expected ErrTaskNil, got %v", err)
+	}
+}
+
+func TestDeletedTaskPageCursor(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC)
+	deletedTask := newTestDeletedTask(t, StatusActive, deletedAt)
+
+	cursor, err := NewDeletedTaskPageCursor(deletedTask)
+	if err != nil {
+		t.Fatalf("failed to create cursor: %v", err)
+	}
+
+	decoded, err := DecodeDeletedTaskPageCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("failed to decode cursor: %v", err)
+	}
+
+	got, err := decoded.SortKeyTime()
+	if err != nil {
+		t.Fatalf("failed to read sort key: %v", err)
+	}
+
+	if !got.Equal(deletedAt) || decoded.ID() != deletedTask.Task().ID() {
+		t.Errorf("unexpected cursor position: %v %s", got, decoded.ID())
+	}
+
+	if _, err := DecodeCompletedTaskPageCursor(cursor.Encode()); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected deleted task cursor to be rejected for completed tasks, got %v", err)
+	}
+}
@@ -18,8 +18,59 @@ var (
 	ErrColorEmpty                 = errors.New("color must be specified")
 	ErrColorInvalidFormat         = errors.New("color must be in #RRGGBB hex format")
 	ErrTaskNotFound               = errors.New("task not found")
+	ErrTaskVersionConflict        = errors.New("task has been modified since the expected version")
+	ErrInvalidExpectedVersion     = errors.New("expected version must be positive")
 	ErrInvalidSortType            = errors.New("invalid sort type")
+	ErrInvalidSortDirection       = errors.New("invalid sort direction")
+	ErrInvalidRank                = errors.New("invalid task rank")
+	ErrInvalidRankRange           = errors.New("lower rank must sort before upper rank")
 	ErrNoFieldsToUpdate           = errors.New("at least one field must be specified for update")
 	ErrInvalidUpdateField         = errors.New("invalid field in update mask")
 	ErrTaskNil                    = errors.New("task cannot be nil")
+	ErrInvalidPageSize            = errors.New("page size must be between 0 and 200")
+	ErrInvalidPageToken           = errors.New("invalid page token")
+	ErrInvalidTargetAtRange       = errors.New("target_at_from must be before target_at_to")
+	ErrCompletedTaskNotFound      = errors.New("completed task not found")
+	ErrDeletedTaskNotFound        = errors.New("deleted task not found")
+	ErrInvalidCompletedAtRange    = errors.New("completed_at_from must be before completed_at_to")
+	ErrRecurrenceRuleEmpty        = errors.New("recurrence rule cannot be empty")
+	ErrInvalidRecurrenceRule      = errors.New("invalid recurrence rule")
+	ErrInvalidRecurrenceTimezone  = errors.New("invalid recurrence timezone")
+	ErrRecurrenceNotAllowed       = errors.New("recurrence is only allowed for tasks with type SCHEDULED")
+	ErrSnoozeUntilNotInFuture     = errors.New("snooze time must be in the future")
+	ErrSnoozeCompletedTask        = errors.New("completed task cannot be snoozed")
+	ErrSearchTextEmpty            = errors.New("search text cannot be empty")
+	ErrSearchTextTooLong          = errors.New("search text cannot exceed 200 characters")
+	ErrInvalidSyncPageSize        = errors.New("sync page size must be between 0 and 1000")
+	ErrInvalidSyncToken           = errors.New("invalid sync token")
+	ErrSyncTokenExpired           = errors.New("sync token is no longer valid, sync again from the start")
+	ErrInvalidChangeType          = errors.New("invalid task change type")
+	ErrInvalidStatsInterval       = errors.New("invalid stats interval")
+	ErrInvalidStatsTimezone       = errors.New("invalid stats timezone")
+	ErrInvalidStatsBucketCount    = errors.New("stats bucket count must be between 0 and 366")
+	ErrInvalidQuietHoursTimezone  = errors.New("invalid quiet hours timezone")
+	ErrInvalidQuietHoursPolicy    = errors.New("invalid quiet hours policy")
+	ErrInvalidQuietWindow         = errors.New("quiet window must start and end on a minute of the day and not be empty")
+	ErrTooManyQuietWindows        = errors.New("at most 14 quiet windows can be set")
+
+	ErrInvalidCalendarFeedToken       = errors.New("invalid calendar feed token")
+	ErrCalendarFeedTokenNotFound      = errors.New("calendar feed token not found")
+	ErrCalendarFeedTokenAlreadyExists = errors.New("calendar feed token already exists, rotate it instead")
+
+	ErrChecklistItemIDInvalidFormat = errors.New("checklist item ID must be a valid UUID")
+	ErrChecklistItemIDInvalidV7     = errors.New("checklist item ID must be a UUIDv7")
+	ErrChecklistItemTextEmpty       = errors.New("checklist item text cannot be empty")
+	ErrChecklistItemTextTooLong     = errors.New("checklist item text cannot exceed 500 characters")
+	ErrInvalidChecklistPosition     = errors.New("checklist item position cannot be negative")
+	ErrChecklistItemNotFound        = errors.New("checklist item not found")
+	ErrChecklistFull                = errors.New("task checklist cannot exceed 100 items")
+	ErrChecklistOrderMismatch       = errors.New("checklist order must list every item exactly once")
+
+	ErrTagIDInvalidFormat   = errors.New("tag ID must be a valid UUID")
+	ErrTagIDInvalidV7       = errors.New("tag ID must be a UUIDv7")
+	ErrTagNameEmpty         = errors.New("tag name cannot be empty")
+	ErrTagNameTooLong       = errors.New("tag name cannot exceed 50 characters")
+	ErrTagNameAlreadyExists = errors.New("tag name already exists")
+	ErrTagNotFound          = errors.New("tag not found")
+	ErrTooManyTags          = errors.New("task cannot have more than 20 tags")
 )
@@ -42,15 +42,74 @@ func (m *MockTaskArchiveRepository) EXPECT() *MockTaskArchiveRepositoryMockRecor
 }
 
 // ArchiveTask mocks base method.
-func (m *MockTaskArchiveRepository) ArchiveTask(ctx context.Context, completedTask *CompletedTask, taskID ID, userID user.ID) error {
+func (m *MockTaskArchiveRepository) ArchiveTask(ctx context.Context, completedTask *CompletedTask, taskID ID, userID user.ID, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArchiveTask", ctx, completedTask, taskID, userID)
+	ret := m.ctrl.Call(m, "ArchiveTask", ctx, completedTask, taskID, userID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ArchiveTask indicates an expected call of ArchiveTask.
-func (mr *MockTaskArchiveRepositoryMockRecorder) ArchiveTask(ctx, completedTask, taskID, userID any) *gomock.Call {
+func (mr *MockTaskArchiveRepositoryMockRecorder) ArchiveTask(ctx, completedTask, taskID, userID, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTask", reflect.TypeOf((*MockTaskArchiveRepository)(nil).ArchiveTask), ctx, completedTask, taskID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTask", reflect.TypeOf((*MockTaskArchiveRepository)(nil).ArchiveTask), ctx, completedTask, taskID, userID, version)
+}
+
+// ArchiveTaskWithNextOccurrence mocks base method.
+func (m *MockTaskArchiveRepository) ArchiveTaskWithNextOccurrence(ctx context.Context, completedTask *CompletedTask, taskID ID, userID user.ID, version int64, next *Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTaskWithNextOccurrence", ctx, completedTask, taskID, userID, version, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveTaskWithNextOccurrence indicates an expected call of ArchiveTaskWithNextOccurrence.
+func (mr *MockTaskArchiveRepositoryMockRecorder) ArchiveTaskWithNextOccurrence(ctx, completedTask, taskID, userID, version, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTaskWithNextOccurrence", reflect.TypeOf((*MockTaskArchiveRepository)(nil).ArchiveTaskWithNextOccurrence), ctx, completedTask, taskID, userID, version, next)
+}
+
+// GetCompletedTaskByID mocks base method.
+func (m *MockTaskArchiveRepository) GetCompletedTaskByID(ctx context.Context, id ID, userID user.ID) (*CompletedTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedTaskByID", ctx, id, userID)
+	ret0, _ := ret[0].(*CompletedTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletedTaskByID indicates an expected call of GetCompletedTaskByID.
+func (mr *MockTaskArchiveRepositoryMockRecorder) GetCompletedTaskByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedTaskByID", reflect.TypeOf((*MockTaskArchiveRepository)(nil).GetCompletedTaskByID), ctx, id, userID)
+}
+
+// ListCompletedTasksByUserID mocks base method.
+func (m *MockTaskArchiveRepository) ListCompletedTasksByUserID(ctx context.Context, userID user.ID, query ListCompletedTasksQuery) ([]*CompletedTask, *PageCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompletedTasksByUserID", ctx, userID, query)
+	ret0, _ := ret[0].([]*CompletedTask)
+	ret1, _ := ret[1].(*PageCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCompletedTasksByUserID indicates an expected call of ListCompletedTasksByUserID.
+func (mr *MockTaskArchiveRepositoryMockRecorder) ListCompletedTasksByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletedTasksByUserID", reflect.TypeOf((*MockTaskArchiveRepository)(nil).ListCompletedTasksByUserID), ctx, userID, query)
+}
+
+// UnarchiveTask mocks base method.
+func (m *MockTaskArchiveRepository) UnarchiveTask(ctx context.Context, task *Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveTask indicates an expected call of UnarchiveTask.
+func (mr *MockTaskArchiveRepositoryMockRecorder) UnarchiveTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveTask", reflect.TypeOf((*MockTaskArchiveRepository)(nil).UnarchiveTask), ctx, task)
 }
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: calendar_feed_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=calendar_feed_repository.go -destination=mock_calendar_feed_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarFeedTokenRepository is a mock of CalendarFeedTokenRepository interface.
+type MockCalendarFeedTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarFeedTokenRepositoryMockRecorder is the mock recorder for MockCalendarFeedTokenRepository.
+type MockCalendarFeedTokenRepositoryMockRecorder struct {
+	mock *MockCalendarFeedTokenRepository
+}
+
+// NewMockCalendarFeedTokenRepository creates a new mock instance.
+func NewMockCalendarFeedTokenRepository(ctrl *gomock.Controller) *MockCalendarFeedTokenRepository {
+	mock := &MockCalendarFeedTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeedTokenRepository) EXPECT() *MockCalendarFeedTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendarFeedToken mocks base method.
+func (m *MockCalendarFeedTokenRepository) CreateCalendarFeedToken(ctx context.Context, token *CalendarFeedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendarFeedToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCalendarFeedToken indicates an expected call of CreateCalendarFeedToken.
+func (mr *MockCalendarFeedTokenRepositoryMockRecorder) CreateCalendarFeedToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarFeedToken", reflect.TypeOf((*MockCalendarFeedTokenRepository)(nil).CreateCalendarFeedToken), ctx, token)
+}
+
+// DeleteCalendarFeedToken mocks base method.
+func (m *MockCalendarFeedTokenRepository) DeleteCalendarFeedToken(ctx context.Context, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendarFeedToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendarFeedToken indicates an expected call of DeleteCalendarFeedToken.
+func (mr *MockCalendarFeedTokenRepositoryMockRecorder) DeleteCalendarFeedToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendarFeedToken", reflect.TypeOf((*MockCalendarFeedTokenRepository)(nil).DeleteCalendarFeedToken), ctx, userID)
+}
+
+// GetUserIDByCalendarFeedToken mocks base method.
+func (m *MockCalendarFeedTokenRepository) GetUserIDByCalendarFeedToken(ctx context.Context, hash string) (user.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByCalendarFeedToken", ctx, hash)
+	ret0, _ := ret[0].(user.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByCalendarFeedToken indicates an expected call of GetUserIDByCalendarFeedToken.
+func (mr *MockCalendarFeedTokenRepositoryMockRecorder) GetUserIDByCalendarFeedToken(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByCalendarFeedToken", reflect.TypeOf((*MockCalendarFeedTokenRepository)(nil).GetUserIDByCalendarFeedToken), ctx, hash)
+}
+
+// RotateCalendarFeedToken mocks base method.
+func (m *MockCalendarFeedTokenRepository) RotateCalendarFeedToken(ctx context.Context, token *CalendarFeedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCalendarFeedToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateCalendarFeedToken indicates an expected call of RotateCalendarFeedToken.
+func (mr *MockCalendarFeedTokenRepositoryMockRecorder) RotateCalendarFeedToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCalendarFeedToken", reflect.TypeOf((*MockCalendarFeedTokenRepository)(nil).RotateCalendarFeedToken), ctx, token)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checklist_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=checklist_repository.go -destination=mock_checklist_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChecklistRepository is a mock of ChecklistRepository interface.
+type MockChecklistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistRepositoryMockRecorder is the mock recorder for MockChecklistRepository.
+type MockChecklistRepositoryMockRecorder struct {
+	mock *MockChecklistRepository
+}
+
+// NewMockChecklistRepository creates a new mock instance.
+func NewMockChecklistRepository(ctrl *gomock.Controller) *MockChecklistRepository {
+	mock := &MockChecklistRepository{ctrl: ctrl}
+	mock.recorder = &MockChecklistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistRepository) EXPECT() *MockChecklistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockChecklistRepository) DeleteChecklistItem(ctx context.Context, taskID ID, itemID ChecklistItemID, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", ctx, taskID, itemID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockChecklistRepositoryMockRecorder) DeleteChecklistItem(ctx, taskID, itemID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockChecklistRepository)(nil).DeleteChecklistItem), ctx, taskID, itemID, userID)
+}
+
+// ListChecklistItems mocks base method.
+func (m *MockChecklistRepository) ListChecklistItems(ctx context.Context, taskID ID, userID user.ID) ([]*ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklistItems", ctx, taskID, userID)
+	ret0, _ := ret[0].([]*ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklistItems indicates an expected call of ListChecklistItems.
+func (mr *MockChecklistRepositoryMockRecorder) ListChecklistItems(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklistItems", reflect.TypeOf((*MockChecklistRepository)(nil).ListChecklistItems), ctx, taskID, userID)
+}
+
+// SaveChecklistItem mocks base method.
+func (m *MockChecklistRepository) SaveChecklistItem(ctx context.Context, item *ChecklistItem, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveChecklistItem", ctx, item, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveChecklistItem indicates an expected call of SaveChecklistItem.
+func (mr *MockChecklistRepositoryMockRecorder) SaveChecklistItem(ctx, item, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChecklistItem", reflect.TypeOf((*MockChecklistRepository)(nil).SaveChecklistItem), ctx, item, userID)
+}
+
+// UpdateChecklistItemDone mocks base method.
+func (m *MockChecklistRepository) UpdateChecklistItemDone(ctx context.Context, item *ChecklistItem, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecklistItemDone", ctx, item, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChecklistItemDone indicates an expected call of UpdateChecklistItemDone.
+func (mr *MockChecklistRepositoryMockRecorder) UpdateChecklistItemDone(ctx, item, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklistItemDone", reflect.TypeOf((*MockChecklistRepository)(nil).UpdateChecklistItemDone), ctx, item, userID)
+}
+
+// UpdateChecklistPositions mocks base method.
+func (m *MockChecklistRepository) UpdateChecklistPositions(ctx context.Context, taskID ID, userID user.ID, items []*ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecklistPositions", ctx, taskID, userID, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChecklistPositions indicates an expected call of UpdateChecklistPositions.
+func (mr *MockChecklistRepositoryMockRecorder) UpdateChecklistPositions(ctx, taskID, userID, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklistPositions", reflect.TypeOf((*MockChecklistRepository)(nil).UpdateChecklistPositions), ctx, taskID, userID, items)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: search_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=search_repository.go -destination=mock_search_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskSearchRepository is a mock of TaskSearchRepository interface.
+type MockTaskSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSearchRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskSearchRepositoryMockRecorder is the mock recorder for MockTaskSearchRepository.
+type MockTaskSearchRepositoryMockRecorder struct {
+	mock *MockTaskSearchRepository
+}
+
+// NewMockTaskSearchRepository creates a new mock instance.
+func NewMockTaskSearchRepository(ctrl *gomock.Controller) *MockTaskSearchRepository {
+	mock := &MockTaskSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSearchRepository) EXPECT() *MockTaskSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SearchTasks mocks base method.
+func (m *MockTaskSearchRepository) SearchTasks(ctx context.Context, userID user.ID, query SearchTasksQuery) ([]*SearchHit, *SearchCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTasks", ctx, userID, query)
+	ret0, _ := ret[0].([]*SearchHit)
+	ret1, _ := ret[1].(*SearchCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchTasks indicates an expected call of SearchTasks.
+func (mr *MockTaskSearchRepositoryMockRecorder) SearchTasks(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTasks", reflect.TypeOf((*MockTaskSearchRepository)(nil).SearchTasks), ctx, userID, query)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stats_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=stats_repository.go -destination=mock_stats_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskStatsRepository is a mock of TaskStatsRepository interface.
+type MockTaskStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskStatsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskStatsRepositoryMockRecorder is the mock recorder for MockTaskStatsRepository.
+type MockTaskStatsRepositoryMockRecorder struct {
+	mock *MockTaskStatsRepository
+}
+
+// NewMockTaskStatsRepository creates a new mock instance.
+func NewMockTaskStatsRepository(ctrl *gomock.Controller) *MockTaskStatsRepository {
+	mock := &MockTaskStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskStatsRepository) EXPECT() *MockTaskStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCompletionHistory mocks base method.
+func (m *MockTaskStatsRepository) GetCompletionHistory(ctx context.Context, userID user.ID, window *StatsWindow) (*CompletionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletionHistory", ctx, userID, window)
+	ret0, _ := ret[0].(*CompletionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletionHistory indicates an expected call of GetCompletionHistory.
+func (mr *MockTaskStatsRepositoryMockRecorder) GetCompletionHistory(ctx, userID, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletionHistory", reflect.TypeOf((*MockTaskStatsRepository)(nil).GetCompletionHistory), ctx, userID, window)
+}
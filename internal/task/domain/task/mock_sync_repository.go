@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sync_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sync_repository.go -destination=mock_sync_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskSyncRepository is a mock of TaskSyncRepository interface.
+type MockTaskSyncRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSyncRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskSyncRepositoryMockRecorder is the mock recorder for MockTaskSyncRepository.
+type MockTaskSyncRepositoryMockRecorder struct {
+	mock *MockTaskSyncRepository
+}
+
+// NewMockTaskSyncRepository creates a new mock instance.
+func NewMockTaskSyncRepository(ctrl *gomock.Controller) *MockTaskSyncRepository {
+	mock := &MockTaskSyncRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskSyncRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSyncRepository) EXPECT() *MockTaskSyncRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListTaskChanges mocks base method.
+func (m *MockTaskSyncRepository) ListTaskChanges(ctx context.Context, userID user.ID, query SyncTasksQuery) (*TaskChangesPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskChanges", ctx, userID, query)
+	ret0, _ := ret[0].(*TaskChangesPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskChanges indicates an expected call of ListTaskChanges.
+func (mr *MockTaskSyncRepositoryMockRecorder) ListTaskChanges(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskChanges", reflect.TypeOf((*MockTaskSyncRepository)(nil).ListTaskChanges), ctx, userID, query)
+}
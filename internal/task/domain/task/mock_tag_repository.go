@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag_repository.go -destination=mock_tag_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttachTag mocks base method.
+func (m *MockTagRepository) AttachTag(ctx context.Context, taskID ID, tagID TagID, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTag", ctx, taskID, tagID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachTag indicates an expected call of AttachTag.
+func (mr *MockTagRepositoryMockRecorder) AttachTag(ctx, taskID, tagID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockTagRepository)(nil).AttachTag), ctx, taskID, tagID, userID)
+}
+
+// DeleteTag mocks base method.
+func (m *MockTagRepository) DeleteTag(ctx context.Context, id TagID, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockTagRepositoryMockRecorder) DeleteTag(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockTagRepository)(nil).DeleteTag), ctx, id, userID)
+}
+
+// DetachTag mocks base method.
+func (m *MockTagRepository) DetachTag(ctx context.Context, taskID ID, tagID TagID, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", ctx, taskID, tagID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockTagRepositoryMockRecorder) DetachTag(ctx, taskID, tagID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockTagRepository)(nil).DetachTag), ctx, taskID, tagID, userID)
+}
+
+// GetTagByID mocks base method.
+func (m *MockTagRepository) GetTagByID(ctx context.Context, id TagID, userID user.ID) (*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagByID", ctx, id, userID)
+	ret0, _ := ret[0].(*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagByID indicates an expected call of GetTagByID.
+func (mr *MockTagRepositoryMockRecorder) GetTagByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagByID", reflect.TypeOf((*MockTagRepository)(nil).GetTagByID), ctx, id, userID)
+}
+
+// ListTagsByUserID mocks base method.
+func (m *MockTagRepository) ListTagsByUserID(ctx context.Context, userID user.ID) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsByUserID indicates an expected call of ListTagsByUserID.
+func (mr *MockTagRepositoryMockRecorder) ListTagsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsByUserID", reflect.TypeOf((*MockTagRepository)(nil).ListTagsByUserID), ctx, userID)
+}
+
+// ListTaskTags mocks base method.
+func (m *MockTagRepository) ListTaskTags(ctx context.Context, taskID ID, userID user.ID) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskTags", ctx, taskID, userID)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskTags indicates an expected call of ListTaskTags.
+func (mr *MockTagRepositoryMockRecorder) ListTaskTags(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskTags", reflect.TypeOf((*MockTagRepository)(nil).ListTaskTags), ctx, taskID, userID)
+}
+
+// SaveTag mocks base method.
+func (m *MockTagRepository) SaveTag(ctx context.Context, tag *Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTag indicates an expected call of SaveTag.
+func (mr *MockTagRepositoryMockRecorder) SaveTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTag", reflect.TypeOf((*MockTagRepository)(nil).SaveTag), ctx, tag)
+}
+
+// UpdateTag mocks base method.
+func (m *MockTagRepository) UpdateTag(ctx context.Context, tag *Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockTagRepositoryMockRecorder) UpdateTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockTagRepository)(nil).UpdateTag), ctx, tag)
+}
@@ -0,0 +1,233 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_repository.go -destination=mock_task_repository.go -package=task
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskRepository is a mock of TaskRepository interface.
+type MockTaskRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskRepositoryMockRecorder is the mock recorder for MockTaskRepository.
+type MockTaskRepositoryMockRecorder struct {
+	mock *MockTaskRepository
+}
+
+// NewMockTaskRepository creates a new mock instance.
+func NewMockTaskRepository(ctrl *gomock.Controller) *MockTaskRepository {
+	mock := &MockTaskRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskRepository) EXPECT() *MockTaskRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteTask mocks base method.
+func (m *MockTaskRepository) DeleteTask(ctx context.Context, id ID, userID user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockTaskRepositoryMockRecorder) DeleteTask(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskRepository)(nil).DeleteTask), ctx, id, userID)
+}
+
+// ExistsTaskByID mocks base method.
+func (m *MockTaskRepository) ExistsTaskByID(ctx context.Context, id ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsTaskByID", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsTaskByID indicates an expected call of ExistsTaskByID.
+func (mr *MockTaskRepositoryMockRecorder) ExistsTaskByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsTaskByID", reflect.TypeOf((*MockTaskRepository)(nil).ExistsTaskByID), ctx, id)
+}
+
+// GetDeletedTaskByID mocks base method.
+func (m *MockTaskRepository) GetDeletedTaskByID(ctx context.Context, id ID, userID user.ID) (*DeletedTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedTaskByID", ctx, id, userID)
+	ret0, _ := ret[0].(*DeletedTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedTaskByID indicates an expected call of GetDeletedTaskByID.
+func (mr *MockTaskRepositoryMockRecorder) GetDeletedTaskByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedTaskByID", reflect.TypeOf((*MockTaskRepository)(nil).GetDeletedTaskByID), ctx, id, userID)
+}
+
+// GetTaskByID mocks base method.
+func (m *MockTaskRepository) GetTaskByID(ctx context.Context, id ID, userID user.ID) (*Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskByID", ctx, id, userID)
+	ret0, _ := ret[0].(*Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskByID indicates an expected call of GetTaskByID.
+func (mr *MockTaskRepositoryMockRecorder) GetTaskByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByID", reflect.TypeOf((*MockTaskRepository)(nil).GetTaskByID), ctx, id, userID)
+}
+
+// ListActiveTasksByUserID mocks base method.
+func (m *MockTaskRepository) ListActiveTasksByUserID(ctx context.Context, userID user.ID, query ListActiveTasksQuery) ([]*Task, *PageCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveTasksByUserID", ctx, userID, query)
+	ret0, _ := ret[0].([]*Task)
+	ret1, _ := ret[1].(*PageCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListActiveTasksByUserID indicates an expected call of ListActiveTasksByUserID.
+func (mr *MockTaskRepositoryMockRecorder) ListActiveTasksByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveTasksByUserID", reflect.TypeOf((*MockTaskRepository)(nil).ListActiveTasksByUserID), ctx, userID, query)
+}
+
+// ListDeletedTasksByUserID mocks base method.
+func (m *MockTaskRepository) ListDeletedTasksByUserID(ctx context.Context, userID user.ID, query ListDeletedTasksQuery) ([]*DeletedTask, *PageCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedTasksByUserID", ctx, userID, query)
+	ret0, _ := ret[0].([]*DeletedTask)
+	ret1, _ := ret[1].(*PageCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeletedTasksByUserID indicates an expected call of ListDeletedTasksByUserID.
+func (mr *MockTaskRepositoryMockRecorder) ListDeletedTasksByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedTasksByUserID", reflect.TypeOf((*MockTaskRepository)(nil).ListDeletedTasksByUserID), ctx, userID, query)
+}
+
+// NextRank mocks base method.
+func (m *MockTaskRepository) NextRank(ctx context.Context, userID user.ID, rank string, excludeID ID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextRank", ctx, userID, rank, excludeID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextRank indicates an expected call of NextRank.
+func (mr *MockTaskRepositoryMockRecorder) NextRank(ctx, userID, rank, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextRank", reflect.TypeOf((*MockTaskRepository)(nil).NextRank), ctx, userID, rank, excludeID)
+}
+
+// PreviousRank mocks base method.
+func (m *MockTaskRepository) PreviousRank(ctx context.Context, userID user.ID, rank string, excludeID ID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviousRank", ctx, userID, rank, excludeID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviousRank indicates an expected call of PreviousRank.
+func (mr *MockTaskRepositoryMockRecorder) PreviousRank(ctx, userID, rank, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviousRank", reflect.TypeOf((*MockTaskRepository)(nil).PreviousRank), ctx, userID, rank, excludeID)
+}
+
+// RestoreTask mocks base method.
+func (m *MockTaskRepository) RestoreTask(ctx context.Context, task *Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTask indicates an expected call of RestoreTask.
+func (mr *MockTaskRepositoryMockRecorder) RestoreTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTask", reflect.TypeOf((*MockTaskRepository)(nil).RestoreTask), ctx, task)
+}
+
+// SaveTask mocks base method.
+func (m *MockTaskRepository) SaveTask(ctx context.Context, task *Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTask indicates an expected call of SaveTask.
+func (mr *MockTaskRepositoryMockRecorder) SaveTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTask", reflect.TypeOf((*MockTaskRepository)(nil).SaveTask), ctx, task)
+}
+
+// UpdateTask mocks base method.
+func (m *MockTaskRepository) UpdateTask(ctx context.Context, task *Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockTaskRepositoryMockRecorder) UpdateTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockTaskRepository)(nil).UpdateTask), ctx, task)
+}
+
+// UpdateTaskRank mocks base method.
+func (m *MockTaskRepository) UpdateTaskRank(ctx context.Context, id ID, userID user.ID, rank string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskRank", ctx, id, userID, rank)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskRank indicates an expected call of UpdateTaskRank.
+func (mr *MockTaskRepositoryMockRecorder) UpdateTaskRank(ctx, id, userID, rank any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskRank", reflect.TypeOf((*MockTaskRepository)(nil).UpdateTaskRank), ctx, id, userID, rank)
+}
+
+// UpdateTaskStatus mocks base method.
+func (m *MockTaskRepository) UpdateTaskStatus(ctx context.Context, taskID ID, userID user.ID, status Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskStatus", ctx, taskID, userID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskStatus indicates an expected call of UpdateTaskStatus.
+func (mr *MockTaskRepositoryMockRecorder) UpdateTaskStatus(ctx, taskID, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskStatus", reflect.TypeOf((*MockTaskRepository)(nil).UpdateTaskStatus), ctx, taskID, userID, status)
+}
@@ -0,0 +1,271 @@
+package task
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+// NormalizePageSize applies the default page size when size is zero and
+// rejects sizes outside of [0, MaxPageSize].
+func NormalizePageSize(size int) (int, error) {
+	switch {
+	case size == 0:
+		return DefaultPageSize, nil
+	case size < 0 || size > MaxPageSize:
+		return 0, ErrInvalidPageSize
+	default:
+		return size, nil
+	}
+}
+
+// PageCursor points at the last task of a page returned by a keyset-paginated query.
+type PageCursor struct {
+	sortType  SortType
+	direction SortDirection
+	sortKey   string
+	id        ID
+}
+
+type pageCursorPayload struct {
+	SortType  string `json:"s"`
+	Direction string `json:"d,omitempty"`
+	SortKey   string `json:"k"`
+	ID        string `json:"i"`
+}
+
+// NewPageCursor creates a cursor positioned at the given task for the sort type and direction.
+func NewPageCursor(sortType SortType, direction SortDirection, task *Task) (*PageCursor, error) {
+	direction, err := direction.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	sortKey, err := sortType.SortKeyOf(task)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PageCursor{
+		sortType:  sortType,
+		direction: direction,
+		sortKey:   sortKey,
+		id:        task.ID(),
+	}, nil
+}
+
+// completedTasksCursorKind tags cursors issued for the completed task history so that
+// they cannot be replayed against the active task list and vice versa.
+const completedTasksCursorKind SortType = "completed_at"
+
+// NewCompletedTaskPageCursor creates a cursor positioned at the given completed task.
+func NewCompletedTaskPageCursor(completedTask *CompletedTask) (*PageCursor, error) {
+	if completedTask == nil {
+		return nil, ErrTaskNil
+	}
+
+	return &PageCursor{
+		sortType:  completedTasksCursorKind,
+		direction: SortDirectionAsc,
+		sortKey:   completedTask.CompletedAt().Format(time.RFC3339Nano),
+		id:        completedTask.ID(),
+	}, nil
+}
+
+// DecodeCompletedTaskPageCursor parses an opaque page token issued by NewCompletedTaskPageCursor.
+func DecodeCompletedTaskPageCursor(token string) (*PageCursor, error) {
+	return decodePageCursor(token, completedTasksCursorKind, SortDirectionAsc, func(key string) error {
+		_, err := time.Parse(time.RFC3339Nano, key)
+
+		return err
+	})
+}
+
+// deletedTasksCursorKind tags cursors issued for the trash.
+const deletedTasksCursorKind SortType = "deleted_at"
+
+// NewDeletedTaskPageCursor creates a cursor positioned at the given deleted task.
+func NewDeletedTaskPageCursor(deletedTask *DeletedTask) (*PageCursor, error) {
+	if deletedTask == nil {
+		return nil, ErrTaskNil
+	}
+
+	return &PageCursor{
+		sortType:  deletedTasksCursorKind,
+		direction: SortDirectionAsc,
+		sortKey:   deletedTask.DeletedAt().Format(time.RFC3339Nano),
+		id:        deletedTask.Task().ID(),
+	}, nil
+}
+
+// DecodeDeletedTaskPageCursor parses an opaque page token issued by NewDeletedTaskPageCursor.
+func DecodeDeletedTaskPageCursor(token string) (*PageCursor, error) {
+	return decodePageCursor(token, deletedTasksCursorKind, SortDirectionAsc, func(key string) error {
+		_, err := time.Parse(time.RFC3339Nano, key)
+
+		return err
+	})
+}
+
+// DecodePageCursor parses an opaque page token. The token must have been issued
+// for the same sort type and direction it is used with.
+func DecodePageCursor(token string, sortType SortType, direction SortDirection) (*PageCursor, error) {
+	direction, err := direction.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePageCursor(token, sortType, direction, func(key string) error {
+		_, err := sortType.ParseSortKey(key)
+
+		return err
+	})
+}
+
+func decodePageCursor(
+	token string,
+	sortType SortType,
+	direction SortDirection,
+	validateSortKey func(string) error,
+) (*PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var payload pageCursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if SortType(payload.SortType) != sortType {
+		return nil, ErrInvalidPageToken
+	}
+
+	// Tokens issued before sort directions existed carry none and were ascending
+	payloadDirection, err := NewSortDirection(payload.Direction)
+	if err != nil || payloadDirection != direction {
+		return nil, ErrInvalidPageToken
+	}
+
+	id, err := NewIDFromString(payload.ID)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if err := validateSortKey(payload.SortKey); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &PageCursor{
+		sortType:  sortType,
+		direction: direction,
+		sortKey:   payload.SortKey,
+		id:        id,
+	}, nil
+}
+
+// Encode returns the opaque page token for the cursor.
+func (c *PageCursor) Encode() string {
+	raw, err := json.Marshal(pageCursorPayload{
+		SortType:  string(c.sortType),
+		Direction: string(c.direction),
+		SortKey:   c.sortKey,
+		ID:        c.id.String(),
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (c *PageCursor) SortType() SortType {
+	return c.sortType
+}
+
+func (c *PageCursor) Direction() SortDirection {
+	return c.direction
+}
+
+func (c *PageCursor) SortKey() string {
+	return c.sortKey
+}
+
+func (c *PageCursor) ID() ID {
+	return c.id
+}
+
+// SortKeyTime returns the sort key of a cursor whose sort column is a timestamp.
+func (c *PageCursor) SortKeyTime() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.sortKey)
+	if err != nil {
+		return time.Time{}, ErrInvalidPageToken
+	}
+
+	return t, nil
+}
+
+// ActiveTaskFilter narrows down the tasks returned by ListActiveTasksByUserID.
+// Nil fields are not applied.
+type ActiveTaskFilter struct {
+	TaskType     *Type
+	Color        *Color
+	TargetAtFrom *time.Time // inclusive
+	TargetAtTo   *time.Time // exclusive
+	TagID        *TagID
+}
+
+func (f ActiveTaskFilter) Validate() error {
+	if f.TargetAtFrom != nil && f.TargetAtTo != nil && !f.TargetAtFrom.Before(*f.TargetAtTo) {
+		return ErrInvalidTargetAtRange
+	}
+
+	return nil
+}
+
+// ListActiveTasksQuery describes one page of a user's active tasks.
+type ListActiveTasksQuery struct {
+	SortType      SortType
+	SortDirection SortDirection
+	Filter        ActiveTaskFilter
+	PageSize      int
+	Cursor        *PageCursor
+}
+
+// CompletedTaskFilter narrows down the tasks returned by ListCompletedTasksByUserID.
+// Nil fields are not applied.
+type CompletedTaskFilter struct {
+	TaskType        *Type
+	CompletedAtFrom *time.Time // inclusive
+	CompletedAtTo   *time.Time // exclusive
+	TagID           *TagID
+}
+
+func (f CompletedTaskFilter) Validate() error {
+	if f.CompletedAtFrom != nil && f.CompletedAtTo != nil && !f.CompletedAtFrom.Before(*f.CompletedAtTo) {
+		return ErrInvalidCompletedAtRange
+	}
+
+	return nil
+}
+
+// ListCompletedTasksQuery describes one page of a user's completed tasks,
+// ordered from the most recently completed.
+type ListCompletedTasksQuery struct {
+	Filter   CompletedTaskFilter
+	PageSize int
+	Cursor   *PageCursor
+}
+
+// ListDeletedTasksQuery describes one page of the user's trash, ordered from the most
+// recently deleted.
+type ListDeletedTasksQuery struct {
+	PageSize int
+	Cursor   *PageCursor
+}
@@ -0,0 +1,266 @@
+package task
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func TestNormalizePageSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   int
+		want    int
+		wantErr error
+	}{
+		{
+			name:  "zero uses default",
+			input: 0,
+			want:  DefaultPageSize,
+		},
+		{
+			name:  "within range",
+			input: 25,
+			want:  25,
+		},
+		{
+			name:  "max",
+			input: MaxPageSize,
+			want:  MaxPageSize,
+		},
+		{
+			name:    "negative",
+			input:   -1,
+			wantErr: ErrInvalidPageSize,
+		},
+		{
+			name:    "over max",
+			input:   MaxPageSize + 1,
+			wantErr: ErrInvalidPageSize,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizePageSize(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPageCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	targetAt := createdAt.Add(90*time.Minute + 123*time.Microsecond)
+
+	task, err := NewTask(id, userID, "Task", TypeNear, StatusActive, "", nil, createdAt, targetAt, MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	cursor, err := NewPageCursor(SortTypeTargetAt, SortDirectionAsc, task)
+	if err != nil {
+		t.Fatalf("failed to create cursor: %v", err)
+	}
+
+	decoded, err := DecodePageCursor(cursor.Encode(), SortTypeTargetAt, SortDirectionAsc)
+	if err != nil {
+		t.Fatalf("failed to decode cursor: %v", err)
+	}
+
+	if decoded.ID() != id {
+		t.Errorf("expected id %s, got %s", id, decoded.ID())
+	}
+
+	sortKey, err := SortTypeTargetAt.ParseSortKey(decoded.SortKey())
+	if err != nil {
+		t.Fatalf("failed to parse sort key: %v", err)
+	}
+
+	if got, ok := sortKey.(time.Time); !ok || !got.Equal(targetAt) {
+		t.Errorf("expected sort key %v, got %v", targetAt, sortKey)
+	}
+}
+
+func TestDecodePageCursorInvalid(t *testing.T) {
+	t.Parallel()
+
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "not base64",
+			token: "!!!",
+		},
+		{
+			name:  "not json",
+			token: encode("cursor"),
+		},
+		{
+			name:  "different sort type",
+			token: encode(`{"s":"created_at","k":"2025-01-01T00:00:00Z","i":"0194a3b2-7c00-7000-8000-000000000000"}`),
+		},
+		{
+			name:  "invalid id",
+			token: encode(`{"s":"target_at","k":"2025-01-01T00:00:00Z","i":"not-a-uuid"}`),
+		},
+		{
+			name:  "invalid sort key",
+			token: encode(`{"s":"target_at","k":"yesterday","i":"0194a3b2-7c00-7000-8000-000000000000"}`),
+		},
+		{
+			name:  "different sort direction",
+			token: encode(`{"s":"target_at","d":"desc","k":"2025-01-01T00:00:00Z","i":"0194a3b2-7c00-7000-8000-000000000000"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := DecodePageCursor(tt.token, SortTypeTargetAt, SortDirectionAsc); !errors.Is(err, ErrInvalidPageToken) {
+				t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCompletedTaskPageCursor(t *testing.T) {
+	t.Parallel()
+
+	id, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	completedAt := createdAt.Add(3 * time.Hour)
+
+	task, err := NewTask(id, userID, "Task", TypeNear, StatusCompleted, "", nil, createdAt, createdAt.Add(time.Hour), MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	completedTask, err := NewCompletedTask(task, completedAt)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	cursor, err := NewCompletedTaskPageCursor(completedTask)
+	if err != nil {
+		t.Fatalf("failed to create cursor: %v", err)
+	}
+
+	decoded, err := DecodeCompletedTaskPageCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("failed to decode cursor: %v", err)
+	}
+
+	got, err := decoded.SortKeyTime()
+	if err != nil {
+		t.Fatalf("failed to read sort key: %v", err)
+	}
+
+	if !got.Equal(completedAt) || decoded.ID() != id {
+		t.Errorf("unexpected cursor position: %v %s", got, decoded.ID())
+	}
+
+	if _, err := DecodePageCursor(cursor.Encode(), SortTypeTargetAt, SortDirectionAsc); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected completed task cursor to be rejected for active tasks, got %v", err)
+	}
+
+	activeCursor, err := NewPageCursor(SortTypeTargetAt, SortDirectionAsc, task)
+	if err != nil {
+		t.Fatalf("failed to create active cursor: %v", err)
+	}
+
+	if _, err := DecodeCompletedTaskPageCursor(activeCursor.Encode()); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected active task cursor to be rejected for completed tasks, got %v", err)
+	}
+}
+
+func TestActiveTaskFilterValidate(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	if err := (ActiveTaskFilter{TargetAtFrom: &from, TargetAtTo: &to}).Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := (ActiveTaskFilter{TargetAtFrom: &from}).Validate(); err != nil {
+		t.Fatalf("unexpected error for open range: %v", err)
+	}
+
+	if err := (ActiveTaskFilter{TargetAtFrom: &to, TargetAtTo: &from}).Validate(); !errors.Is(err, ErrInvalidTargetAtRange) {
+		t.Fatalf("expected ErrInvalidTargetAtRange, got %v", err)
+	}
+
+	if err := (ActiveTaskFilter{TargetAtFrom: &from, TargetAtTo: &from}).Validate(); !errors.Is(err, ErrInvalidTargetAtRange) {
+		t.Fatalf("expected ErrInvalidTargetAtRange for empty range, got %v", err)
+	}
+}
+
+func TestDecodePageCursorWithoutDirectionIsAscending(t *testing.T) {
+	t.Parallel()
+
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"s":"target_at","k":"2025-01-01T00:00:00Z","i":"0194a3b2-7c00-7000-8000-000000000000"}`))
+
+	cursor, err := DecodePageCursor(token, SortTypeTargetAt, SortDirectionAsc)
+	if err != nil {
+		t.Fatalf("expected legacy token to decode as ascending, got %v", err)
+	}
+
+	if cursor.Direction() != SortDirectionAsc {
+		t.Errorf("expected ascending cursor, got %s", cursor.Direction())
+	}
+
+	if _, err := DecodePageCursor(token, SortTypeTargetAt, SortDirectionDesc); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected legacy token to be rejected for descending order, got %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package task
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+type QuietHoursPolicy string
+
+const (
+	// QuietHoursPolicyShift moves a reminder that falls in a quiet window to the window's end
+	QuietHoursPolicyShift QuietHoursPolicy = "shift"
+	// QuietHoursPolicyDrop removes a reminder that falls in a quiet window
+	QuietHoursPolicyDrop QuietHoursPolicy = "drop"
+)
+
+const (
+	MaxQuietWindows = 14
+
+	minutesPerDay = 24 * 60
+)
+
+func NewQuietHoursPolicy(policy string) (QuietHoursPolicy, error) {
+	switch QuietHoursPolicy(policy) {
+	case QuietHoursPolicyShift, QuietHoursPolicyDrop:
+		return QuietHoursPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidQuietHoursPolicy, policy)
+	}
+}
+
+// QuietWindow is a do-not-disturb window that starts on a weekday. Start and end are
+// minutes after local midnight; a window whose end is not after its start ends on the
+// following day, so 22:00-07:00 on Monday covers Monday night.
+type QuietWindow struct {
+	weekday     time.Weekday
+	startMinute int
+	endMinute   int
+}
+
+func NewQuietWindow(weekday time.Weekday, startMinute, endMinute int) (QuietWindow, error) {
+	if weekday < time.Sunday || weekday > time.Saturday ||
+		startMinute < 0 || startMinute >= minutesPerDay ||
+		endMinute < 0 || endMinute >= minutesPerDay ||
+		startMinute == endMinute {
+		return QuietWindow{}, ErrInvalidQuietWindow
+	}
+
+	return QuietWindow{
+		weekday:     weekday,
+		startMinute: startMinute,
+		endMinute:   endMinute,
+	}, nil
+}
+
+func (w QuietWindow) Weekday() time.Weekday {
+	return w.weekday
+}
+
+func (w QuietWindow) StartMinute() int {
+	return w.startMinute
+}
+
+func (w QuietWindow) EndMinute() int {
+	return w.endMinute
+}
+
+// bounds returns the window as it occurs on the local day of dayStart.
+func (w QuietWindow) bounds(dayStart time.Time) (time.Time, time.Time) {
+	year, month, day := dayStart.Date()
+	loc := dayStart.Location()
+
+	endDay := day
+	if w.endMinute <= w.startMinute {
+		endDay++
+	}
+
+	// Built from the wall clock so that windows keep their local times across DST changes
+	start := time.Date(year, month, day, w.startMinute/60, w.startMinute%60, 0, 0, loc)
+	end := time.Date(year, month, endDay, w.endMinute/60, w.endMinute%60, 0, 0, loc)
+
+	return start, end
+}
+
+// QuietHours are a user's weekly quiet windows in an IANA timezone together with what
+// happens to the reminders that fall in them. The windows are kept ordered by weekday and
+// start; they may overlap.
+type QuietHours struct {
+	timezone            string
+	location            *time.Location
+	windows             []QuietWindow
+	policy              QuietHoursPolicy
+	deadlineAlwaysFires bool
+}
+
+func NewQuietHours(
+	timezone string,
+	windows []QuietWindow,
+	policy QuietHoursPolicy,
+	deadlineAlwaysFires bool,
+) (*QuietHours, error) {
+	location, ok := LoadUserLocation(timezone)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidQuietHoursTimezone, timezone)
+	}
+
+	if _, err := NewQuietHoursPolicy(string(policy)); err != nil {
+		return nil, err
+	}
+
+	if len(windows) > MaxQuietWindows {
+		return nil, ErrTooManyQuietWindows
+	}
+
+	for _, w := range windows {
+		if _, err := NewQuietWindow(w.weekday, w.startMinute, w.endMinute); err != nil {
+			return nil, err
+		}
+	}
+
+	sorted := slices.Clone(windows)
+	slices.SortFunc(sorted, func(a, b QuietWindow) int {
+		if a.weekday != b.weekday {
+			return int(a.weekday) - int(b.weekday)
+		}
+
+		return a.startMinute - b.startMinute
+	})
+
+	return &QuietHours{
+		timezone:            timezone,
+		location:            location,
+		windows:             sorted,
+		policy:              policy,
+		deadlineAlwaysFires: deadlineAlwaysFires,
+	}, nil
+}
+
+func (q *QuietHours) Timezone() string {
+	return q.timezone
+}
+
+func (q *QuietHours) Windows() []QuietWindow {
+	return slices.Clone(q.windows)
+}
+
+func (q *QuietHours) Policy() QuietHoursPolicy {
+	return q.policy
+}
+
+// DeadlineAlwaysFires reports whether the reminder at a task's targetAt is sent even when
+// it falls in a quiet window.
+func (q *QuietHours) DeadlineAlwaysFires() bool {
+	return q.deadlineAlwaysFires
+}
+
+// Apply moves or drops the reminder times that fall in a quiet window according to the
+// policy and returns them sorted without duplicates. A reminder before targetAt that would
+// be moved to targetAt or later is dropped, since the deadline reminder covers it. The
+// reminder at targetAt itself is kept unchanged when the deadline always fires.
+func (q *QuietHours) Apply(times []time.Time, targetAt time.Time) []time.Time {
+	result := make([]time.Time, 0, len(times))
+
+	for _, t := range times {
+		isDeadline := t.Equal(targetAt)
+
+		if isDeadline && q.deadlineAlwaysFires {
+			result = append(result, t)
+
+			continue
+		}
+
+		end, quiet := q.quietUntil(t)
+		if !quiet {
+			result = append(result, t)
+
+			continue
+		}
+
+		if q.policy == QuietHoursPolicyDrop || (!isDeadline && !end.Before(targetAt)) {
+			continue
+		}
+
+		result = append(result, end.In(t.Location()))
+	}
+
+	slices.SortFunc(result, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	return slices.CompactFunc(result, func(a, b time.Time) bool {
+		return a.Equal(b)
+	})
+}
+
+// quietUntil returns the time t's quiet period ends at. Windows that overlap or follow
+// one another are treated as one, so the result is never itself in a quiet window.
+func (q *QuietHours) quietUntil(t time.Time) (time.Time, bool) {
+	quiet := false
+
+	// Every shift moves past at least one window, so the loop ends
+	for range len(q.windows) {
+		end, ok := q.windowEnd(t)
+		if !ok {
+			break
+		}
+
+		t = end
+		quiet = true
+	}
+
+	return t, quiet
+}
+
+// windowEnd returns the end of a window containing t. A window that started the day
+// before can still be open, so both days are checked.
+func (q *QuietHours) windowEnd(t time.Time) (time.Time, bool) {
+	local := t.In(q.location)
+
+	for _, offset := range []int{0, -1} {
+		year, month, day := local.Date()
+		dayStart := time.Date(year, month, day+offset, 0, 0, 0, 0, q.location)
+
+		for _, w := range q.windows {
+			if w.weekday != dayStart.Weekday() {
+				continue
+			}
+
+			start, end := w.bounds(dayStart)
+			if !t.Before(start) && t.Before(end) {
+				return end, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
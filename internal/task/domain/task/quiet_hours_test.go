@@ -0,0 +1,135 @@
+package task
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func mustQuietWindow(t *testing.T, weekday time.Weekday, start, end int) QuietWindow {
+	t.Helper()
+
+	w, err := NewQuietWindow(weekday, start, end)
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	return w
+}
+
+func TestNewQuietHoursError(t *testing.T) {
+	t.Parallel()
+
+	valid := []QuietWindow{mustQuietWindow(t, time.Monday, 22*60, 7*60)}
+
+	tests := []struct {
+		name     string
+		timezone string
+		windows  []QuietWindow
+		policy   QuietHoursPolicy
+		wantErr  error
+	}{
+		{name: "missing timezone", timezone: "", windows: valid, policy: QuietHoursPolicyShift, wantErr: ErrInvalidQuietHoursTimezone},
+		{name: "unknown timezone", timezone: "Mars/Base", windows: valid, policy: QuietHoursPolicyShift, wantErr: ErrInvalidQuietHoursTimezone},
+		{name: "server local timezone", timezone: "Local", windows: valid, policy: QuietHoursPolicyShift, wantErr: ErrInvalidQuietHoursTimezone},
+		{name: "unknown policy", timezone: "Asia/Tokyo", windows: valid, policy: QuietHoursPolicy("mute"), wantErr: ErrInvalidQuietHoursPolicy},
+		{name: "zero window", timezone: "Asia/Tokyo", windows: []QuietWindow{{}}, policy: QuietHoursPolicyDrop, wantErr: ErrInvalidQuietWindow},
+		{
+			name:     "too many windows",
+			timezone: "Asia/Tokyo",
+			windows:  slices.Repeat(valid, MaxQuietWindows+1),
+			policy:   QuietHoursPolicyDrop,
+			wantErr:  ErrTooManyQuietWindows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewQuietHours(tt.timezone, tt.windows, tt.policy, false); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	for _, bounds := range [][2]int{{-1, 60}, {0, minutesPerDay}, {60, 60}} {
+		if _, err := NewQuietWindow(time.Monday, bounds[0], bounds[1]); !errors.Is(err, ErrInvalidQuietWindow) {
+			t.Errorf("NewQuietWindow(%v) = %v, want ErrInvalidQuietWindow", bounds, err)
+		}
+	}
+}
+
+func TestQuietHoursApply(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	// 2026-10-19 is a Monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, tokyo).UTC()
+	}
+
+	windows := []QuietWindow{
+		// Monday night until Tuesday 07:00, then Tuesday 07:00-08:00 right after it
+		mustQuietWindow(t, time.Monday, 22*60, 7*60),
+		mustQuietWindow(t, time.Tuesday, 7*60, 8*60),
+		mustQuietWindow(t, time.Wednesday, 12*60, 13*60),
+	}
+
+	targetAt := at(20, 3, 0)
+
+	tests := []struct {
+		name                string
+		policy              QuietHoursPolicy
+		deadlineAlwaysFires bool
+		times               []time.Time
+		want                []time.Time
+	}{
+		{
+			name:   "shift moves reminders past consecutive windows",
+			policy: QuietHoursPolicyShift,
+			times:  []time.Time{at(19, 21, 0), at(19, 23, 0), targetAt},
+			want:   []time.Time{at(19, 21, 0), at(20, 8, 0)},
+		},
+		{
+			name:                "deadline always fires",
+			policy:              QuietHoursPolicyShift,
+			deadlineAlwaysFires: true,
+			times:               []time.Time{at(19, 21, 0), at(19, 23, 0), targetAt},
+			want:                []time.Time{at(19, 21, 0), targetAt},
+		},
+		{
+			name:   "drop removes reminders in a window",
+			policy: QuietHoursPolicyDrop,
+			times:  []time.Time{at(19, 21, 0), at(19, 23, 0), targetAt},
+			want:   []time.Time{at(19, 21, 0)},
+		},
+		{
+			name:   "window on another weekday does not apply",
+			policy: QuietHoursPolicyDrop,
+			times:  []time.Time{at(25, 23, 0), at(22, 12, 30)},
+			want:   []time.Time{at(22, 12, 30), at(25, 23, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			quietHours, err := NewQuietHours("Asia/Tokyo", windows, tt.policy, tt.deadlineAlwaysFires)
+			if err != nil {
+				t.Fatalf("setup failed: %v", err)
+			}
+
+			got := quietHours.Apply(tt.times, targetAt)
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package task
+
+import "strings"
+
+// rankDigits is the ordered alphabet of rank keys. Ranks compare byte-wise, so the
+// column they are stored in must use the "C" collation.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// ValidateRank reports whether rank is a well-formed rank key. A key never ends in the
+// smallest digit, which guarantees that another key can always be placed before it.
+func ValidateRank(rank string) error {
+	if rank == "" || rank[len(rank)-1] == rankDigits[0] {
+		return ErrInvalidRank
+	}
+
+	for i := range len(rank) {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return ErrInvalidRank
+		}
+	}
+
+	return nil
+}
+
+// RankBetween returns a rank that sorts strictly between lower and upper. An empty lower
+// means the start of the list and an empty upper means its end.
+func RankBetween(lower, upper string) (string, error) {
+	if lower != "" {
+		if err := ValidateRank(lower); err != nil {
+			return "", err
+		}
+	}
+
+	if upper != "" {
+		if err := ValidateRank(upper); err != nil {
+			return "", err
+		}
+	}
+
+	if lower != "" && upper != "" && lower >= upper {
+		return "", ErrInvalidRankRange
+	}
+
+	return rankMidpoint(lower, upper), nil
+}
+
+// RankAfter returns a rank that sorts after last, or the first rank of an empty list
+// when last is empty.
+func RankAfter(last string) (string, error) {
+	return RankBetween(last, "")
+}
+
+func rankMidpoint(lower, upper string) string {
+	if upper != "" {
+		// Shared leading digits are kept as is; lower is treated as padded with the
+		// smallest digit.
+		n := 0
+		for n < len(upper) && rankDigitAt(lower, n) == strings.IndexByte(rankDigits, upper[n]) {
+			n++
+		}
+
+		if n > 0 {
+			rest := ""
+			if n < len(lower) {
+				rest = lower[n:]
+			}
+
+			return upper[:n] + rankMidpoint(rest, upper[n:])
+		}
+	}
+
+	digitLower := rankDigitAt(lower, 0)
+
+	digitUpper := len(rankDigits)
+	if upper != "" {
+		digitUpper = strings.IndexByte(rankDigits, upper[0])
+	}
+
+	if digitUpper-digitLower > 1 {
+		return string(rankDigits[(digitLower+digitUpper+1)/2])
+	}
+
+	// The leading digits are adjacent
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+
+	rest := ""
+	if len(lower) > 1 {
+		rest = lower[1:]
+	}
+
+	return string(rankDigits[digitLower]) + rankMidpoint(rest, "")
+}
+
+func rankDigitAt(rank string, i int) int {
+	if i >= len(rank) {
+		return 0
+	}
+
+	return strings.IndexByte(rankDigits, rank[i])
+}
@@ -0,0 +1,110 @@
+package task
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		lower string
+		upper string
+	}{
+		{"empty list", "", ""},
+		{"before first", "", "i"},
+		{"before smallest single digit", "", "1"},
+		{"after last", "i", ""},
+		{"after largest digit", "z", ""},
+		{"between distant digits", "1", "z"},
+		{"between adjacent digits", "h", "i"},
+		{"between shared prefix", "0i", "0j"},
+		{"between prefix and extension", "a", "a1"},
+		{"between legacy backfill ranks", "00000001i", "00000002i"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := RankBetween(tt.lower, tt.upper)
+			if err != nil {
+				t.Fatalf("RankBetween(%q, %q) unexpected error: %v", tt.lower, tt.upper, err)
+			}
+
+			if err := ValidateRank(got); err != nil {
+				t.Fatalf("RankBetween(%q, %q) = %q is not a valid rank", tt.lower, tt.upper, got)
+			}
+
+			if tt.lower != "" && got <= tt.lower {
+				t.Errorf("RankBetween(%q, %q) = %q, want greater than lower", tt.lower, tt.upper, got)
+			}
+
+			if tt.upper != "" && got >= tt.upper {
+				t.Errorf("RankBetween(%q, %q) = %q, want less than upper", tt.lower, tt.upper, got)
+			}
+		})
+	}
+}
+
+func TestRankBetweenRepeatedInsertions(t *testing.T) {
+	t.Parallel()
+
+	lower, upper := "h", "i"
+
+	// Always inserting right after lower narrows the gap fastest
+	for range 200 {
+		mid, err := RankBetween(lower, upper)
+		if err != nil {
+			t.Fatalf("RankBetween(%q, %q) unexpected error: %v", lower, upper, err)
+		}
+
+		if mid <= lower || mid >= upper {
+			t.Fatalf("RankBetween(%q, %q) = %q is out of range", lower, upper, mid)
+		}
+
+		upper = mid
+	}
+
+	// And the same towards the start of the list
+	for range 200 {
+		rank, err := RankBetween("", upper)
+		if err != nil {
+			t.Fatalf("RankBetween(\"\", %q) unexpected error: %v", upper, err)
+		}
+
+		if rank >= upper {
+			t.Fatalf("RankBetween(\"\", %q) = %q is not before upper", upper, rank)
+		}
+
+		upper = rank
+	}
+}
+
+func TestRankBetweenErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		lower    string
+		upper    string
+		expected error
+	}{
+		{"lower after upper", "j", "i", ErrInvalidRankRange},
+		{"equal bounds", "i", "i", ErrInvalidRankRange},
+		{"trailing zero", "i0", "", ErrInvalidRank},
+		{"uppercase digit", "", "I", ErrInvalidRank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := RankBetween(tt.lower, tt.upper); !errors.Is(err, tt.expected) {
+				t.Fatalf("RankBetween(%q, %q) error = %v, want %v", tt.lower, tt.upper, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,480 @@
+package task
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+const (
+	untilDateTimeLayout = "20060102T150405Z"
+	untilLocalLayout    = "20060102T150405"
+	untilDateLayout     = "20060102"
+
+	// maxRecurrenceSteps bounds the search for the next occurrence so that a rule that
+	// never matches (or a task completed decades late) cannot loop forever.
+	maxRecurrenceSteps = 10000
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum is a BYDAY entry. Ordinal is only used with MONTHLY rules, where 1 means
+// the first such weekday of the month and -1 the last. Zero means every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+func (w WeekdayNum) String() string {
+	code := weekdayCode(w.Weekday)
+	if w.Ordinal == 0 {
+		return code
+	}
+
+	return strconv.Itoa(w.Ordinal) + code
+}
+
+// Recurrence is a subset of an RFC 5545 RRULE (FREQ, INTERVAL, BYDAY, COUNT, UNTIL and
+// WKST) evaluated in an IANA timezone.
+// COUNT is the number of occurrences left including the current one, so it shrinks as
+// occurrences are completed.
+type Recurrence struct {
+	freq     Frequency
+	interval int
+	byDay    []WeekdayNum
+	count    *int
+	until    *time.Time
+	wkst     time.Weekday
+	location *time.Location
+}
+
+func NewRecurrence(rule string, timezone string) (*Recurrence, error) {
+	location, ok := LoadUserLocationOrUTC(timezone)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRecurrenceTimezone, timezone)
+	}
+
+	r := &Recurrence{
+		freq:     "",
+		interval: 1,
+		byDay:    nil,
+		count:    nil,
+		until:    nil,
+		wkst:     time.Monday,
+		location: location,
+	}
+
+	body := strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if body == "" {
+		return nil, ErrRecurrenceRuleEmpty
+	}
+
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(body, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRecurrenceRule, part)
+		}
+
+		key = strings.ToUpper(key)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate %s", ErrInvalidRecurrenceRule, key)
+		}
+
+		seen[key] = true
+
+		if err := r.parsePart(key, strings.ToUpper(value)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Recurrence) parsePart(key, value string) error {
+	switch key {
+	case "FREQ":
+		switch Frequency(value) {
+		case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+			r.freq = Frequency(value)
+		default:
+			return fmt.Errorf("%w: unsupported FREQ %s", ErrInvalidRecurrenceRule, value)
+		}
+	case "INTERVAL":
+		interval, err := strconv.Atoi(value)
+		if err != nil || interval < 1 {
+			return fmt.Errorf("%w: INTERVAL must be a positive integer", ErrInvalidRecurrenceRule)
+		}
+
+		r.interval = interval
+	case "COUNT":
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 1 {
+			return fmt.Errorf("%w: COUNT must be a positive integer", ErrInvalidRecurrenceRule)
+		}
+
+		r.count = &count
+	case "UNTIL":
+		until, err := parseUntil(value, r.location)
+		if err != nil {
+			return err
+		}
+
+		r.until = &until
+	case "BYDAY":
+		for _, entry := range strings.Split(value, ",") {
+			day, err := parseWeekdayNum(entry)
+			if err != nil {
+				return err
+			}
+
+			r.byDay = append(r.byDay, day)
+		}
+	case "WKST":
+		weekday, ok := weekdayCodes[value]
+		if !ok {
+			return fmt.Errorf("%w: invalid WKST %s", ErrInvalidRecurrenceRule, value)
+		}
+
+		r.wkst = weekday
+	default:
+		return fmt.Errorf("%w: unsupported part %s", ErrInvalidRecurrenceRule, key)
+	}
+
+	return nil
+}
+
+func (r *Recurrence) validate() error {
+	if r.freq == "" {
+		return fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrenceRule)
+	}
+
+	if r.count != nil && r.until != nil {
+		return fmt.Errorf("%w: COUNT and UNTIL cannot be combined", ErrInvalidRecurrenceRule)
+	}
+
+	if r.freq != FrequencyMonthly {
+		for _, day := range r.byDay {
+			if day.Ordinal != 0 {
+				return fmt.Errorf("%w: ordinal BYDAY is only allowed with FREQ=MONTHLY", ErrInvalidRecurrenceRule)
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseWeekdayNum(entry string) (WeekdayNum, error) {
+	if len(entry) < 2 {
+		return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRecurrenceRule, entry)
+	}
+
+	weekday, ok := weekdayCodes[entry[len(entry)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRecurrenceRule, entry)
+	}
+
+	ordinal := 0
+
+	if prefix := entry[:len(entry)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY %q", ErrInvalidRecurrenceRule, entry)
+		}
+
+		ordinal = n
+	}
+
+	return WeekdayNum{Weekday: weekday, Ordinal: ordinal}, nil
+}
+
+func parseUntil(value string, location *time.Location) (time.Time, error) {
+	if t, err := time.Parse(untilDateTimeLayout, value); err == nil {
+		return t.UTC(), nil
+	}
+
+	if t, err := time.ParseInLocation(untilLocalLayout, value, location); err == nil {
+		return t.UTC(), nil
+	}
+
+	// A date-only UNTIL includes every occurrence on that day.
+	if t, err := time.ParseInLocation(untilDateLayout, value, location); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Microsecond).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: invalid UNTIL %s", ErrInvalidRecurrenceRule, value)
+}
+
+func weekdayCode(weekday time.Weekday) string {
+	for code, w := range weekdayCodes {
+		if w == weekday {
+			return code
+		}
+	}
+
+	return ""
+}
+
+// Rule returns the rule in canonical RRULE form (without the "RRULE:" prefix).
+func (r *Recurrence) Rule() string {
+	parts := []string{"FREQ=" + string(r.freq)}
+
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+
+	if len(r.byDay) > 0 {
+		days := make([]string, 0, len(r.byDay))
+		for _, day := range r.byDay {
+			days = append(days, day.String())
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.count != nil {
+		parts = append(parts, "COUNT="+strconv.Itoa(*r.count))
+	}
+
+	if r.until != nil {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format(untilDateTimeLayout))
+	}
+
+	if r.wkst != time.Monday {
+		parts = append(parts, "WKST="+weekdayCode(r.wkst))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func (r *Recurrence) Timezone() string {
+	if r.location == time.UTC {
+		return ""
+	}
+
+	return r.location.String()
+}
+
+func (r *Recurrence) Frequency() Frequency {
+	return r.freq
+}
+
+func (r *Recurrence) Count() *int {
+	return r.count
+}
+
+func (r *Recurrence) Until() *time.Time {
+	return r.until
+}
+
+// Next returns the first occurrence after both the given occurrence and now, together
+// with the recurrence to attach to it. Occurrences skipped because they already passed
+// still consume COUNT. ok is false when the series has ended.
+func (r *Recurrence) Next(occurrence time.Time, now time.Time) (time.Time, *Recurrence, bool) {
+	current := occurrence.In(r.location)
+
+	var remaining int
+	if r.count != nil {
+		remaining = *r.count
+	}
+
+	for range maxRecurrenceSteps {
+		if r.count != nil {
+			if remaining <= 1 {
+				return time.Time{}, nil, false
+			}
+
+			remaining--
+		}
+
+		next, ok := r.step(current)
+		if !ok {
+			return time.Time{}, nil, false
+		}
+
+		if r.until != nil && next.After(*r.until) {
+			return time.Time{}, nil, false
+		}
+
+		if next.After(now) {
+			following := *r
+			if r.count != nil {
+				following.count = &remaining
+			}
+
+			return next.UTC(), &following, true
+		}
+
+		current = next
+	}
+
+	return time.Time{}, nil, false
+}
+
+// step returns the occurrence following current, keeping its local wall-clock time.
+func (r *Recurrence) step(current time.Time) (time.Time, bool) {
+	switch r.freq {
+	case FrequencyDaily:
+		return r.stepDaily(current)
+	case FrequencyWeekly:
+		return r.stepWeekly(current), true
+	case FrequencyMonthly:
+		return r.stepMonthly(current)
+	default:
+		return time.Time{}, false
+	}
+}
+
+func (r *Recurrence) stepDaily(current time.Time) (time.Time, bool) {
+	for i := 1; i <= 7; i++ {
+		next := addDays(current, r.interval*i)
+		if len(r.byDay) == 0 || r.matchesWeekday(next.Weekday()) {
+			return next, true
+		}
+	}
+
+	// With an interval that is a multiple of 7 the weekday never changes.
+	return time.Time{}, false
+}
+
+func (r *Recurrence) stepWeekly(current time.Time) time.Time {
+	if len(r.byDay) == 0 {
+		return addDays(current, 7*r.interval)
+	}
+
+	currentOffset := r.weekOffset(current.Weekday())
+
+	offsets := make([]int, 0, len(r.byDay))
+	for _, day := range r.byDay {
+		offsets = append(offsets, r.weekOffset(day.Weekday))
+	}
+
+	slices.Sort(offsets)
+
+	for _, offset := range offsets {
+		if offset > currentOffset {
+			return addDays(current, offset-currentOffset)
+		}
+	}
+
+	weekStart := addDays(current, -currentOffset)
+
+	return addDays(weekStart, 7*r.interval+offsets[0])
+}
+
+func (r *Recurrence) stepMonthly(current time.Time) (time.Time, bool) {
+	year, month, day := current.Date()
+
+	if len(r.byDay) == 0 {
+		for i := 1; i <= 12; i++ {
+			y, m := addMonths(year, month, r.interval*i)
+			if day <= daysIn(y, m) {
+				return withDate(current, y, m, day), true
+			}
+		}
+
+		return time.Time{}, false
+	}
+
+	for _, candidate := range r.monthlyDays(year, month) {
+		if candidate > day {
+			return withDate(current, year, month, candidate), true
+		}
+	}
+
+	for i := 1; i <= 12; i++ {
+		y, m := addMonths(year, month, r.interval*i)
+		if days := r.monthlyDays(y, m); len(days) > 0 {
+			return withDate(current, y, m, days[0]), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// monthlyDays returns the days of the month matching BYDAY in ascending order.
+func (r *Recurrence) monthlyDays(year int, month time.Month) []int {
+	total := daysIn(year, month)
+	firstWeekday := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+
+	var days []int
+
+	for _, spec := range r.byDay {
+		first := 1 + (int(spec.Weekday)-int(firstWeekday)+7)%7
+
+		var matches []int
+		for d := first; d <= total; d += 7 {
+			matches = append(matches, d)
+		}
+
+		switch {
+		case spec.Ordinal == 0:
+			days = append(days, matches...)
+		case spec.Ordinal > 0 && spec.Ordinal <= len(matches):
+			days = append(days, matches[spec.Ordinal-1])
+		case spec.Ordinal < 0 && -spec.Ordinal <= len(matches):
+			days = append(days, matches[len(matches)+spec.Ordinal])
+		}
+	}
+
+	slices.Sort(days)
+
+	return slices.Compact(days)
+}
+
+func (r *Recurrence) matchesWeekday(weekday time.Weekday) bool {
+	for _, day := range r.byDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Recurrence) weekOffset(weekday time.Weekday) int {
+	return (int(weekday) - int(r.wkst) + 7) % 7
+}
+
+func addDays(t time.Time, days int) time.Time {
+	year, month, day := t.Date()
+
+	return withDate(t, year, month, day+days)
+}
+
+func addMonths(year int, month time.Month, months int) (int, time.Month) {
+	total := int(month) - 1 + months
+
+	return year + total/12, time.Month(total%12 + 1)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func withDate(t time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
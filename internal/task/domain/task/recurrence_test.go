@@ -0,0 +1,291 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewRecurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rule     string
+		timezone string
+		wantRule string
+		wantErr  error
+	}{
+		{
+			name:     "daily",
+			rule:     "FREQ=DAILY",
+			wantRule: "FREQ=DAILY",
+		},
+		{
+			name:     "accepts RRULE prefix and lower case",
+			rule:     "RRULE:freq=weekly;byday=mo,we",
+			wantRule: "FREQ=WEEKLY;BYDAY=MO,WE",
+		},
+		{
+			name:     "canonical order and default interval omitted",
+			rule:     "COUNT=3;INTERVAL=1;FREQ=WEEKLY",
+			wantRule: "FREQ=WEEKLY;COUNT=3",
+		},
+		{
+			name:     "monthly with ordinal byday",
+			rule:     "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR",
+			wantRule: "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR",
+		},
+		{
+			name:     "date-only until covers the whole day",
+			rule:     "FREQ=DAILY;UNTIL=20250131",
+			timezone: "Asia/Tokyo",
+			wantRule: "FREQ=DAILY;UNTIL=20250131T145959Z",
+		},
+		{
+			name:     "non-default week start",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;WKST=SU",
+			wantRule: "FREQ=WEEKLY;INTERVAL=2;WKST=SU",
+		},
+		{
+			name:    "empty",
+			rule:    " ",
+			wantErr: ErrRecurrenceRuleEmpty,
+		},
+		{
+			name:    "missing freq",
+			rule:    "INTERVAL=2",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "unsupported freq",
+			rule:    "FREQ=YEARLY",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "zero interval",
+			rule:    "FREQ=DAILY;INTERVAL=0",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "count and until together",
+			rule:    "FREQ=DAILY;COUNT=2;UNTIL=20250101T000000Z",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "ordinal byday on weekly",
+			rule:    "FREQ=WEEKLY;BYDAY=1MO",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "unknown part",
+			rule:    "FREQ=DAILY;BYHOUR=9",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:    "duplicate part",
+			rule:    "FREQ=DAILY;FREQ=WEEKLY",
+			wantErr: ErrInvalidRecurrenceRule,
+		},
+		{
+			name:     "unknown timezone",
+			rule:     "FREQ=DAILY",
+			timezone: "Mars/Olympus",
+			wantErr:  ErrInvalidRecurrenceTimezone,
+		},
+		{
+			name:     "server local timezone",
+			rule:     "FREQ=DAILY",
+			timezone: "Local",
+			wantErr:  ErrInvalidRecurrenceTimezone,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewRecurrence(tt.rule, tt.timezone)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Rule() != tt.wantRule {
+				t.Errorf("expected rule %q, got %q", tt.wantRule, got.Rule())
+			}
+
+			if got.Timezone() != tt.timezone {
+				t.Errorf("expected timezone %q, got %q", tt.timezone, got.Timezone())
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	// Monday 2025-01-06 09:00 in Tokyo
+	monday := time.Date(2025, 1, 6, 9, 0, 0, 0, tokyo)
+
+	tests := []struct {
+		name       string
+		rule       string
+		timezone   string
+		occurrence time.Time
+		now        time.Time
+		want       time.Time
+		wantEnded  bool
+		wantRule   string
+	}{
+		{
+			name:       "daily",
+			rule:       "FREQ=DAILY",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 6, 9, 30, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 7, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=DAILY",
+		},
+		{
+			name:       "daily skips past occurrences",
+			rule:       "FREQ=DAILY;INTERVAL=2",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 12, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=DAILY;INTERVAL=2",
+		},
+		{
+			name:       "daily filtered by byday",
+			rule:       "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			occurrence: time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC), // Friday
+			now:        time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+		},
+		{
+			name:       "weekly byday evaluated in local timezone",
+			rule:       "FREQ=WEEKLY;BYDAY=MO,TH",
+			timezone:   "Asia/Tokyo",
+			occurrence: monday,
+			now:        monday.Add(time.Hour),
+			want:       time.Date(2025, 1, 9, 9, 0, 0, 0, tokyo),
+			wantRule:   "FREQ=WEEKLY;BYDAY=MO,TH",
+		},
+		{
+			name:       "weekly byday wraps to next interval week",
+			rule:       "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			timezone:   "Asia/Tokyo",
+			occurrence: time.Date(2025, 1, 9, 9, 0, 0, 0, tokyo),
+			now:        time.Date(2025, 1, 9, 10, 0, 0, 0, tokyo),
+			want:       time.Date(2025, 1, 20, 9, 0, 0, 0, tokyo),
+			wantRule:   "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+		},
+		{
+			name:       "weekly without byday",
+			rule:       "FREQ=WEEKLY",
+			occurrence: time.Date(2025, 1, 8, 18, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 8, 19, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 15, 18, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=WEEKLY",
+		},
+		{
+			name:       "monthly skips months without the day",
+			rule:       "FREQ=MONTHLY",
+			occurrence: time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=MONTHLY",
+		},
+		{
+			name:       "monthly last friday",
+			rule:       "FREQ=MONTHLY;BYDAY=-1FR",
+			occurrence: time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=MONTHLY;BYDAY=-1FR",
+		},
+		{
+			name:       "monthly first and third monday",
+			rule:       "FREQ=MONTHLY;BYDAY=1MO,3MO",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=MONTHLY;BYDAY=1MO,3MO",
+		},
+		{
+			name:       "count decrements",
+			rule:       "FREQ=DAILY;COUNT=3",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+			want:       time.Date(2025, 1, 7, 9, 0, 0, 0, time.UTC),
+			wantRule:   "FREQ=DAILY;COUNT=2",
+		},
+		{
+			name:       "count exhausted",
+			rule:       "FREQ=DAILY;COUNT=1",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+			wantEnded:  true,
+		},
+		{
+			name:       "skipped occurrences consume count",
+			rule:       "FREQ=DAILY;COUNT=3",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 8, 10, 0, 0, 0, time.UTC),
+			wantEnded:  true,
+		},
+		{
+			name:       "until reached",
+			rule:       "FREQ=WEEKLY;UNTIL=20250110T000000Z",
+			occurrence: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			now:        time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+			wantEnded:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurrence, err := NewRecurrence(tt.rule, tt.timezone)
+			if err != nil {
+				t.Fatalf("failed to parse rule: %v", err)
+			}
+
+			got, next, ok := recurrence.Next(tt.occurrence, tt.now)
+			if tt.wantEnded {
+				if ok {
+					t.Fatalf("expected series to end, got %v", got)
+				}
+
+				return
+			}
+
+			if !ok {
+				t.Fatal("expected a next occurrence")
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want.UTC(), got)
+			}
+
+			if next.Rule() != tt.wantRule {
+				t.Errorf("expected next rule %q, got %q", tt.wantRule, next.Rule())
+			}
+		})
+	}
+}
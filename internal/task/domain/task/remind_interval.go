@@ -28,6 +28,11 @@ var DefaultReminderIntervals = map[Type][]ReminderInterval{
 	TypeRelaxed: DefaultReminderIntervalsRelaxed,
 }
 
+// ReminderIntervalOverrides holds a user's reminder intervals by task type. A type
+// present with an empty list gets only the reminder at its targetAt; a type that is
+// absent keeps the default intervals.
+type ReminderIntervalOverrides map[Type][]ReminderInterval
+
 func GetReminderIntervalsForType(taskType Type) []ReminderInterval {
 	if intervals, ok := DefaultReminderIntervals[taskType]; ok {
 		return intervals
@@ -16,20 +16,46 @@ type ReminderInfo struct {
 	Color         string
 }
 
-func CalculateReminderTimes(task *Task, userID string, devices []DeviceInfo) *ReminderInfo {
+// ReminderPreferences are the user settings that shape reminder times. The zero value
+// uses the default intervals and has no quiet hours.
+type ReminderPreferences struct {
+	// Intervals replace the default intervals of the task types they contain
+	Intervals ReminderIntervalOverrides
+	// QuietHours, when set, move or drop the reminders that fall in a quiet window
+	QuietHours *QuietHours
+}
+
+// CalculateReminderTimes spreads the reminders over the window between the task's
+// creation and its targetAt according to the user's preferences. It returns nil when
+// quiet hours leave no reminder to send.
+func CalculateReminderTimes(task *Task, userID string, devices []DeviceInfo, prefs ReminderPreferences) *ReminderInfo {
+	if task == nil {
+		return nil
+	}
+
+	return CalculateReminderTimesFrom(task, task.CreatedAt(), userID, devices, prefs)
+}
+
+// CalculateReminderTimesFrom spreads the reminders over the window between from and the
+// task's targetAt. It is used when a task's reminders restart after creation, e.g. on reopen.
+func CalculateReminderTimesFrom(
+	task *Task,
+	from time.Time,
+	userID string,
+	devices []DeviceInfo,
+	prefs ReminderPreferences,
+) *ReminderInfo {
 	if task == nil {
 		return nil
 	}
 
-	createdAt := task.CreatedAt()
+	start := from.UTC().Truncate(time.Microsecond)
 	targetAt := task.TargetAt()
-	totalDuration := targetAt.Sub(createdAt)
+	totalDuration := targetAt.Sub(start)
 
-	var percentages []ReminderInterval
-	if task.TaskType() == TypeScheduled {
-		percentages = GetReminderIntervalsForDuration(totalDuration)
-	} else {
-		percentages = GetReminderIntervalsForType(task.TaskType())
+	percentages, ok := prefs.Intervals[task.TaskType()]
+	if !ok {
+		percentages = defaultReminderIntervals(task.TaskType(), totalDuration)
 	}
 
 	reminderTimes := make([]time.Time, 0, len(percentages)+1)
@@ -38,7 +64,7 @@ func CalculateReminderTimes(task *Task, userID string, devices []DeviceInfo) *Re
 		offset := time.Duration(float64(totalDuration) * float64(percentage))
 
 		offset = offset.Round(time.Minute)
-		reminderTime := createdAt.Add(offset)
+		reminderTime := start.Add(offset)
 
 		if !reminderTime.After(targetAt) {
 			reminderTimes = append(reminderTimes, reminderTime)
@@ -49,6 +75,13 @@ func CalculateReminderTimes(task *Task, userID string, devices []DeviceInfo) *Re
 		reminderTimes = append(reminderTimes, targetAt)
 	}
 
+	if prefs.QuietHours != nil {
+		reminderTimes = prefs.QuietHours.Apply(reminderTimes, targetAt)
+		if len(reminderTimes) == 0 {
+			return nil
+		}
+	}
+
 	return &ReminderInfo{
 		TaskID:        task.ID(),
 		TaskType:      task.TaskType(),
@@ -58,3 +91,11 @@ func CalculateReminderTimes(task *Task, userID string, devices []DeviceInfo) *Re
 		Color:         task.Color().String(),
 	}
 }
+
+func defaultReminderIntervals(taskType Type, totalDuration time.Duration) []ReminderInterval {
+	if taskType == TypeScheduled {
+		return GetReminderIntervalsForDuration(totalDuration)
+	}
+
+	return GetReminderIntervalsForType(taskType)
+}
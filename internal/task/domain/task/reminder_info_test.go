@@ -44,7 +44,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -94,7 +94,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -144,7 +144,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -195,7 +195,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -223,7 +223,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 	})
 
 	t.Run("nil task returns nil", func(t *testing.T) {
-		info := CalculateReminderTimes(nil, "", nil)
+		info := CalculateReminderTimes(nil, "", nil, ReminderPreferences{})
 		if info != nil {
 			t.Error("expected nil for nil task")
 		}
@@ -254,7 +254,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -304,7 +304,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -354,7 +354,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -396,7 +396,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -446,7 +446,7 @@ func TestCalculateReminderTimes(t *testing.T) {
 			t.Fatalf("NewTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info.TaskID != task.ID() {
 			t.Errorf("TaskID = %v, want %v", info.TaskID, task.ID())
@@ -483,7 +483,7 @@ func TestCalculateReminderTimesWithCreateTask(t *testing.T) {
 			t.Fatalf("CreateTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -533,7 +533,7 @@ func TestCalculateReminderTimesWithCreateTask(t *testing.T) {
 			t.Fatalf("CreateTask() unexpected error: %v", err)
 		}
 
-		info := CalculateReminderTimes(task, "test-user-id", nil)
+		info := CalculateReminderTimes(task, "test-user-id", nil, ReminderPreferences{})
 
 		if info == nil {
 			t.Fatal("CalculateReminderTimes returned nil")
@@ -551,3 +551,237 @@ func TestCalculateReminderTimesWithCreateTask(t *testing.T) {
 		}
 	})
 }
+
+func TestCalculateReminderTimesFrom(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	from := createdAt.Add(24 * time.Hour)
+	targetAt := from.Add(time.Hour)
+
+	task, err := NewTask(taskID, userID, "Reopened", TypeNear, StatusPendingReminders, "", nil, createdAt, targetAt, MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	info := CalculateReminderTimesFrom(task, from, userID.String(), nil, ReminderPreferences{})
+	if info == nil {
+		t.Fatal("CalculateReminderTimesFrom returned nil")
+	}
+
+	if len(info.ReminderTimes) == 0 {
+		t.Fatal("expected reminder times to be set")
+	}
+
+	for i, reminderTime := range info.ReminderTimes {
+		if !reminderTime.After(from) {
+			t.Errorf("ReminderTimes[%d] = %v is not after start %v", i, reminderTime, from)
+		}
+	}
+
+	if last := info.ReminderTimes[len(info.ReminderTimes)-1]; !last.Equal(targetAt) {
+		t.Errorf("last reminder time = %v, want %v (targetAt)", last, targetAt)
+	}
+
+	if got := CalculateReminderTimesFrom(nil, from, userID.String(), nil, ReminderPreferences{}); got != nil {
+		t.Errorf("expected nil for nil task, got %v", got)
+	}
+}
+
+func TestCalculateReminderTimesWithOverrides(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	newTask := func(t *testing.T, taskType Type, targetAt time.Time) *Task {
+		t.Helper()
+
+		taskID, err := NewID()
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+
+		var scheduledAt *time.Time
+		if taskType == TypeScheduled {
+			scheduledAt = &targetAt
+		}
+
+		task, err := NewTask(taskID, userID, "Override", taskType, StatusActive, "", scheduledAt, createdAt, targetAt, MustColor("#FF6B6B"))
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+
+		return task
+	}
+
+	tests := []struct {
+		name      string
+		taskType  Type
+		window    time.Duration
+		overrides ReminderIntervalOverrides
+		want      []time.Duration
+	}{
+		{
+			name:      "override replaces the defaults for the type",
+			taskType:  TypeShort,
+			window:    30 * time.Minute,
+			overrides: ReminderIntervalOverrides{TypeShort: {0.5}},
+			want:      []time.Duration{15 * time.Minute, 30 * time.Minute},
+		},
+		{
+			name:      "empty override leaves only the targetAt reminder",
+			taskType:  TypeNear,
+			window:    time.Hour,
+			overrides: ReminderIntervalOverrides{TypeNear: {}},
+			want:      []time.Duration{time.Hour},
+		},
+		{
+			name:      "other types keep the defaults",
+			taskType:  TypeShort,
+			window:    30 * time.Minute,
+			overrides: ReminderIntervalOverrides{TypeNear: {0.5}},
+			want:      []time.Duration{21 * time.Minute, 29 * time.Minute, 30 * time.Minute},
+		},
+		{
+			name:      "scheduled override ignores the window length",
+			taskType:  TypeScheduled,
+			window:    24 * time.Hour,
+			overrides: ReminderIntervalOverrides{TypeScheduled: {0.25, 0.75}},
+			want:      []time.Duration{6 * time.Hour, 18 * time.Hour, 24 * time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := newTask(t, tt.taskType, createdAt.Add(tt.window))
+
+			info := CalculateReminderTimes(task, userID.String(), nil, ReminderPreferences{Intervals: tt.overrides})
+			if info == nil {
+				t.Fatal("CalculateReminderTimes returned nil")
+			}
+
+			if len(info.ReminderTimes) != len(tt.want) {
+				t.Fatalf("got %d reminder times, want %d: %v", len(info.ReminderTimes), len(tt.want), info.ReminderTimes)
+			}
+
+			for i, offset := range tt.want {
+				if want := createdAt.Add(offset); !info.ReminderTimes[i].Equal(want) {
+					t.Errorf("ReminderTimes[%d] = %v, want %v", i, info.ReminderTimes[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateReminderTimesWithQuietHours(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	// 2025-01-01 is a Wednesday
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	task, err := NewTask(taskID, userID, "Quiet", TypeShort, StatusActive, "", nil, createdAt, createdAt.Add(30*time.Minute), MustColor("#FF6B6B"))
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+
+	tests := []struct {
+		name                string
+		endMinute           int
+		policy              QuietHoursPolicy
+		deadlineAlwaysFires bool
+		want                []time.Duration
+	}{
+		{
+			name:      "shift moves the reminder to the window's end",
+			endMinute: 9*60 + 20,
+			policy:    QuietHoursPolicyShift,
+			want:      []time.Duration{20 * time.Minute, 30 * time.Minute},
+		},
+		{
+			name:      "drop removes the reminder",
+			endMinute: 9*60 + 20,
+			policy:    QuietHoursPolicyDrop,
+			want:      []time.Duration{30 * time.Minute},
+		},
+		{
+			name:      "no reminder left",
+			endMinute: 9*60 + 40,
+			policy:    QuietHoursPolicyDrop,
+			want:      nil,
+		},
+		{
+			name:                "deadline always fires",
+			endMinute:           9*60 + 40,
+			policy:              QuietHoursPolicyDrop,
+			deadlineAlwaysFires: true,
+			want:                []time.Duration{30 * time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := NewQuietWindow(time.Wednesday, 9*60+10, tt.endMinute)
+			if err != nil {
+				t.Fatalf("setup failed: %v", err)
+			}
+
+			quietHours, err := NewQuietHours("UTC", []QuietWindow{window}, tt.policy, tt.deadlineAlwaysFires)
+			if err != nil {
+				t.Fatalf("setup failed: %v", err)
+			}
+
+			prefs := ReminderPreferences{
+				Intervals:  ReminderIntervalOverrides{TypeShort: {0.5}},
+				QuietHours: quietHours,
+			}
+
+			info := CalculateReminderTimes(task, userID.String(), nil, prefs)
+			if tt.want == nil {
+				if info != nil {
+					t.Fatalf("expected no reminders, got %v", info.ReminderTimes)
+				}
+
+				return
+			}
+
+			if info == nil || len(info.ReminderTimes) != len(tt.want) {
+				t.Fatalf("got %v, want offsets %v", info, tt.want)
+			}
+
+			for i, offset := range tt.want {
+				if want := createdAt.Add(offset); !info.ReminderTimes[i].Equal(want) {
+					t.Errorf("ReminderTimes[%d] = %v, want %v", i, info.ReminderTimes[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package task
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxSearchTextLength = 200
+
+	// SearchHighlightStart and SearchHighlightStop enclose the matched terms of a
+	// highlighted fragment. The rest of a fragment is HTML-escaped text, so the markers
+	// are its only markup.
+	SearchHighlightStart = "<mark>"
+	SearchHighlightStop  = "</mark>"
+)
+
+// NewSearchText normalizes the text of a search query.
+func NewSearchText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		return "", ErrSearchTextEmpty
+	}
+
+	if utf8.RuneCountInString(text) > MaxSearchTextLength {
+		return "", ErrSearchTextTooLong
+	}
+
+	return text, nil
+}
+
+// SearchHit is a task matching a search. Exactly one of Task and CompletedTask is set,
+// depending on whether the match is an active or an archived task.
+type SearchHit struct {
+	task                 *Task
+	completedTask        *CompletedTask
+	score                float64
+	titleHighlight       string
+	descriptionHighlight string
+}
+
+func NewActiveSearchHit(task *Task, score float64, titleHighlight, descriptionHighlight string) (*SearchHit, error) {
+	if task == nil {
+		return nil, ErrTaskNil
+	}
+
+	return &SearchHit{
+		task:                 task,
+		completedTask:        nil,
+		score:                score,
+		titleHighlight:       titleHighlight,
+		descriptionHighlight: descriptionHighlight,
+	}, nil
+}
+
+func NewCompletedSearchHit(
+	completedTask *CompletedTask,
+	score float64,
+	titleHighlight, descriptionHighlight string,
+) (*SearchHit, error) {
+	if completedTask == nil {
+		return nil, ErrTaskNil
+	}
+
+	return &SearchHit{
+		task:                 nil,
+		completedTask:        completedTask,
+		score:                score,
+		titleHighlight:       titleHighlight,
+		descriptionHighlight: descriptionHighlight,
+	}, nil
+}
+
+func (h *SearchHit) Task() *Task {
+	return h.task
+}
+
+func (h *SearchHit) CompletedTask() *CompletedTask {
+	return h.completedTask
+}
+
+// Score is the relevance of the hit. Hits are returned from the most relevant.
+func (h *SearchHit) Score() float64 {
+	return h.score
+}
+
+func (h *SearchHit) TitleHighlight() string {
+	return h.titleHighlight
+}
+
+func (h *SearchHit) DescriptionHighlight() string {
+	return h.descriptionHighlight
+}
+
+// SearchCursor points at the position following a page of search results.
+// Relevance scores depend on the query, so search results are paginated by offset
+// rather than by keyset, and the cursor is bound to the text it was issued for.
+type SearchCursor struct {
+	text   string
+	offset int
+}
+
+type searchCursorPayload struct {
+	Text   string `json:"q"`
+	Offset int    `json:"o"`
+}
+
+func NewSearchCursor(text string, offset int) *SearchCursor {
+	return &SearchCursor{
+		text:   text,
+		offset: offset,
+	}
+}
+
+// DecodeSearchCursor parses an opaque page token. The token must have been issued
+// for the same search text it is used with.
+func DecodeSearchCursor(token string, text string) (*SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var payload searchCursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if payload.Text != text || payload.Offset <= 0 {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &SearchCursor{
+		text:   payload.Text,
+		offset: payload.Offset,
+	}, nil
+}
+
+// Encode returns the opaque page token for the cursor.
+func (c *SearchCursor) Encode() string {
+	raw, err := json.Marshal(searchCursorPayload{
+		Text:   c.text,
+		Offset: c.offset,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (c *SearchCursor) Offset() int {
+	return c.offset
+}
+
+// SearchTasksQuery describes one page of a search over a user's active and archived tasks.
+type SearchTasksQuery struct {
+	Text     string
+	TagID    *TagID // only tasks with this tag attached, when set
+	PageSize int
+	Cursor   *SearchCursor
+}
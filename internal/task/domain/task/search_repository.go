@@ -0,0 +1,13 @@
+package task
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+//go:generate mockgen -source=search_repository.go -destination=mock_search_repository.go -package=task
+
+type TaskSearchRepository interface {
+	SearchTasks(ctx context.Context, userID user.ID, query SearchTasksQuery) ([]*SearchHit, *SearchCursor, error)
+}
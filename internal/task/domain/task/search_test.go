@@ -0,0 +1,133 @@
+package task
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewSearchText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "trims surrounding spaces",
+			input: "  buy milk ",
+			want:  "buy milk",
+		},
+		{
+			name:  "counts characters rather than bytes",
+			input: strings.Repeat("あ", MaxSearchTextLength),
+			want:  strings.Repeat("あ", MaxSearchTextLength),
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: ErrSearchTextEmpty,
+		},
+		{
+			name:    "only spaces",
+			input:   "   ",
+			wantErr: ErrSearchTextEmpty,
+		},
+		{
+			name:    "too long",
+			input:   strings.Repeat("a", MaxSearchTextLength+1),
+			wantErr: ErrSearchTextTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewSearchText(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSearchCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	token := NewSearchCursor("milk", 20).Encode()
+
+	decoded, err := DecodeSearchCursor(token, "milk")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if decoded.Offset() != 20 {
+		t.Fatalf("expected offset 20, got %d", decoded.Offset())
+	}
+}
+
+func TestDecodeSearchCursorInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "different text",
+			token: NewSearchCursor("bread", 20).Encode(),
+		},
+		{
+			name:  "non-positive offset",
+			token: NewSearchCursor("milk", 0).Encode(),
+		},
+		{
+			name:  "not base64",
+			token: "!!!",
+		},
+		{
+			name:  "not json",
+			token: base64.RawURLEncoding.EncodeToString([]byte("milk")),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := DecodeSearchCursor(tt.token, "milk"); !errors.Is(err, ErrInvalidPageToken) {
+				t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewSearchHitRequiresTask(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewActiveSearchHit(nil, 1, "", ""); !errors.Is(err, ErrTaskNil) {
+		t.Fatalf("expected ErrTaskNil, got %v", err)
+	}
+
+	if _, err := NewCompletedSearchHit(nil, 1, "", ""); !errors.Is(err, ErrTaskNil) {
+		t.Fatalf("expected ErrTaskNil, got %v", err)
+	}
+}
@@ -1,25 +1,176 @@
 package task
 
+import (
+	"fmt"
+	"time"
+)
+
 type SortType string
 
 const (
-	SortTypeTargetAt SortType = "target_at"
+	SortTypeTargetAt  SortType = "target_at"
+	SortTypeCreatedAt SortType = "created_at"
+	SortTypeTitle     SortType = "title"
+	SortTypeTaskType  SortType = "task_type"
+	SortTypeColor     SortType = "color"
+	// SortTypeManual orders tasks by the rank users assign by moving them
+	SortTypeManual SortType = "manual"
 )
 
 func NewSortType(s string) (SortType, error) {
 	switch s {
-	case string(SortTypeTargetAt):
+	case string(SortTypeTargetAt),
+		string(SortTypeCreatedAt),
+		string(SortTypeTitle),
+		string(SortTypeTaskType),
+		string(SortTypeColor),
+		string(SortTypeManual):
 		return SortType(s), nil
 	default:
 		return "", ErrInvalidSortType
 	}
 }
 
-func (s SortType) OrderQuery() (string, error) {
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+// NewSortDirection parses a sort direction. An empty string means ascending.
+func NewSortDirection(s string) (SortDirection, error) {
+	switch s {
+	case "", string(SortDirectionAsc):
+		return SortDirectionAsc, nil
+	case string(SortDirectionDesc):
+		return SortDirectionDesc, nil
+	default:
+		return "", ErrInvalidSortDirection
+	}
+}
+
+func (d SortDirection) normalize() (SortDirection, error) {
+	return NewSortDirection(string(d))
+}
+
+// column returns the tasks column the sort type orders by.
+func (s SortType) column() (string, error) {
 	switch s {
 	case SortTypeTargetAt:
-		return "target_at ASC, created_at DESC", nil
+		return "target_at", nil
+	case SortTypeCreatedAt:
+		return "created_at", nil
+	case SortTypeTitle:
+		return "title", nil
+	case SortTypeTaskType:
+		return "task_type", nil
+	case SortTypeColor:
+		return "color", nil
+	case SortTypeManual:
+		return "rank", nil
 	default:
 		return "", ErrInvalidSortType
 	}
 }
+
+// OrderQuery returns the ORDER BY clause for the sort type in the given direction.
+// The UUIDv7 id is always the final tiebreaker so that the order is total
+// and can be resumed from a page cursor.
+func (s SortType) OrderQuery(direction SortDirection) (string, error) {
+	column, err := s.column()
+	if err != nil {
+		return "", err
+	}
+
+	direction, err = direction.normalize()
+	if err != nil {
+		return "", err
+	}
+
+	if direction == SortDirectionDesc {
+		return fmt.Sprintf("%s DESC, id ASC", column), nil
+	}
+
+	return fmt.Sprintf("%s ASC, id DESC", column), nil
+}
+
+// KeysetQuery returns the WHERE clause selecting rows positioned after a cursor
+// in OrderQuery order. Its placeholders are bound to (sort key, sort key, id).
+func (s SortType) KeysetQuery(direction SortDirection) (string, error) {
+	column, err := s.column()
+	if err != nil {
+		return "", err
+	}
+
+	direction, err = direction.normalize()
+	if err != nil {
+		return "", err
+	}
+
+	if direction == SortDirectionDesc {
+		return fmt.Sprintf("(%[1]s < ? OR (%[1]s = ? AND id > ?))", column), nil
+	}
+
+	return fmt.Sprintf("(%[1]s > ? OR (%[1]s = ? AND id < ?))", column), nil
+}
+
+// SortKeyOf returns the value of the sort column for the task, encoded as a string.
+func (s SortType) SortKeyOf(task *Task) (string, error) {
+	if task == nil {
+		return "", ErrTaskNil
+	}
+
+	switch s {
+	case SortTypeTargetAt:
+		return task.TargetAt().Format(time.RFC3339Nano), nil
+	case SortTypeCreatedAt:
+		return task.CreatedAt().Format(time.RFC3339Nano), nil
+	case SortTypeTitle:
+		return task.Title(), nil
+	case SortTypeTaskType:
+		return string(task.TaskType()), nil
+	case SortTypeColor:
+		return task.Color().String(), nil
+	case SortTypeManual:
+		return task.Rank(), nil
+	default:
+		return "", ErrInvalidSortType
+	}
+}
+
+// ParseSortKey decodes a sort key produced by SortKeyOf into a value that can be
+// bound to the placeholders of KeysetQuery.
+func (s SortType) ParseSortKey(key string) (any, error) {
+	switch s {
+	case SortTypeTargetAt, SortTypeCreatedAt:
+		t, err := time.Parse(time.RFC3339Nano, key)
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+
+		return t, nil
+	case SortTypeTitle:
+		return key, nil
+	case SortTypeTaskType:
+		if _, err := NewType(key); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+
+		return key, nil
+	case SortTypeColor:
+		if _, err := NewColor(key); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+
+		return key, nil
+	case SortTypeManual:
+		if err := ValidateRank(key); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+
+		return key, nil
+	default:
+		return nil, ErrInvalidSortType
+	}
+}
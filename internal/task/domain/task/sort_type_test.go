@@ -0,0 +1,97 @@
+package task
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSortTypeOrderQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		sortType      SortType
+		direction     SortDirection
+		expectedOrder string
+		expectedWhere string
+	}{
+		{"target_at default", SortTypeTargetAt, "", "target_at ASC, id DESC", "(target_at > ? OR (target_at = ? AND id < ?))"},
+		{"created_at desc", SortTypeCreatedAt, SortDirectionDesc, "created_at DESC, id ASC", "(created_at < ? OR (created_at = ? AND id > ?))"},
+		{"title asc", SortTypeTitle, SortDirectionAsc, "title ASC, id DESC", "(title > ? OR (title = ? AND id < ?))"},
+		{"task_type desc", SortTypeTaskType, SortDirectionDesc, "task_type DESC, id ASC", "(task_type < ? OR (task_type = ? AND id > ?))"},
+		{"color asc", SortTypeColor, SortDirectionAsc, "color ASC, id DESC", "(color > ? OR (color = ? AND id < ?))"},
+		{"manual asc", SortTypeManual, SortDirectionAsc, "rank ASC, id DESC", "(rank > ? OR (rank = ? AND id < ?))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := tt.sortType.OrderQuery(tt.direction)
+			if err != nil {
+				t.Fatalf("OrderQuery() unexpected error: %v", err)
+			}
+
+			if order != tt.expectedOrder {
+				t.Errorf("OrderQuery() = %q, want %q", order, tt.expectedOrder)
+			}
+
+			where, err := tt.sortType.KeysetQuery(tt.direction)
+			if err != nil {
+				t.Fatalf("KeysetQuery() unexpected error: %v", err)
+			}
+
+			if where != tt.expectedWhere {
+				t.Errorf("KeysetQuery() = %q, want %q", where, tt.expectedWhere)
+			}
+		})
+	}
+}
+
+func TestSortTypeOrderQueryErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := SortType("priority").OrderQuery(SortDirectionAsc); !errors.Is(err, ErrInvalidSortType) {
+		t.Errorf("expected ErrInvalidSortType, got %v", err)
+	}
+
+	if _, err := SortTypeTitle.OrderQuery("sideways"); !errors.Is(err, ErrInvalidSortDirection) {
+		t.Errorf("expected ErrInvalidSortDirection, got %v", err)
+	}
+}
+
+func TestSortTypeParseSortKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		sortType SortType
+		key      string
+		valid    bool
+	}{
+		{"created_at", SortTypeCreatedAt, "2025-01-01T00:00:00Z", true},
+		{"created_at malformed", SortTypeCreatedAt, "yesterday", false},
+		{"title", SortTypeTitle, "anything goes", true},
+		{"task_type", SortTypeTaskType, string(TypeNear), true},
+		{"task_type unknown", SortTypeTaskType, "urgent", false},
+		{"color", SortTypeColor, "#FF6B6B", true},
+		{"color malformed", SortTypeColor, "red", false},
+		{"rank", SortTypeManual, "i", true},
+		{"rank malformed", SortTypeManual, "I0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.sortType.ParseSortKey(tt.key)
+			if tt.valid && err != nil {
+				t.Fatalf("ParseSortKey(%q) unexpected error: %v", tt.key, err)
+			}
+
+			if !tt.valid && !errors.Is(err, ErrInvalidPageToken) {
+				t.Fatalf("ParseSortKey(%q) expected ErrInvalidPageToken, got %v", tt.key, err)
+			}
+		})
+	}
+}
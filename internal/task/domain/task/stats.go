@@ -0,0 +1,272 @@
+package task
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultStatsBucketCount = 30
+	MaxStatsBucketCount     = 366
+)
+
+// StatsInterval is the length of the calendar buckets completions are counted in.
+type StatsInterval string
+
+const (
+	StatsIntervalDay StatsInterval = "day"
+	// StatsIntervalWeek buckets start on Monday
+	StatsIntervalWeek StatsInterval = "week"
+)
+
+func NewStatsInterval(s string) (StatsInterval, error) {
+	switch StatsInterval(s) {
+	case StatsIntervalDay, StatsIntervalWeek:
+		return StatsInterval(s), nil
+	default:
+		return "", ErrInvalidStatsInterval
+	}
+}
+
+// StatsWindow is the run of buckets stats are reported over. Buckets are calendar days or
+// weeks in the user's timezone, and the last one contains the time the window was made at.
+type StatsWindow struct {
+	interval StatsInterval
+	location *time.Location
+	starts   []time.Time
+	end      time.Time
+}
+
+// NewStatsWindow returns the count buckets ending with the one containing now, in the IANA
+// timezone of the user. A count of zero uses DefaultStatsBucketCount.
+func NewStatsWindow(interval StatsInterval, timezone string, count int, now time.Time) (*StatsWindow, error) {
+	if _, err := NewStatsInterval(string(interval)); err != nil {
+		return nil, err
+	}
+
+	location, ok := LoadUserLocation(timezone)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidStatsTimezone, timezone)
+	}
+
+	switch {
+	case count == 0:
+		count = DefaultStatsBucketCount
+	case count < 0 || count > MaxStatsBucketCount:
+		return nil, ErrInvalidStatsBucketCount
+	}
+
+	w := &StatsWindow{
+		interval: interval,
+		location: location,
+		starts:   make([]time.Time, count),
+		end:      time.Time{},
+	}
+
+	current := w.bucketStart(now)
+	for i := range count {
+		w.starts[i] = w.shift(current, i-count+1)
+	}
+
+	w.end = w.shift(current, 1)
+
+	return w, nil
+}
+
+func (w *StatsWindow) Interval() StatsInterval {
+	return w.interval
+}
+
+func (w *StatsWindow) Location() *time.Location {
+	return w.location
+}
+
+// Start is the start of the first bucket.
+func (w *StatsWindow) Start() time.Time {
+	return w.starts[0]
+}
+
+// End is the end of the last bucket, exclusive.
+func (w *StatsWindow) End() time.Time {
+	return w.end
+}
+
+// BucketStarts returns the local midnight starting each bucket, oldest first.
+func (w *StatsWindow) BucketStarts() []time.Time {
+	starts := make([]time.Time, len(w.starts))
+	copy(starts, w.starts)
+
+	return starts
+}
+
+// bucketStart returns the local midnight starting the bucket that contains t.
+func (w *StatsWindow) bucketStart(t time.Time) time.Time {
+	local := t.In(w.location)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, w.location)
+
+	if w.interval == StatsIntervalWeek {
+		// Go weeks start on Sunday; these start on Monday
+		start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	}
+
+	return start
+}
+
+// shift moves a bucket start by n buckets. Dates are shifted on the calendar, so a bucket
+// spanning a DST change is still a whole day or week.
+func (w *StatsWindow) shift(start time.Time, n int) time.Time {
+	if w.interval == StatsIntervalWeek {
+		return start.AddDate(0, 0, 7*n)
+	}
+
+	return start.AddDate(0, 0, n)
+}
+
+// DailyCompletions is the number of tasks completed on a calendar day.
+type DailyCompletions struct {
+	Date      time.Time // local midnight in the stats timezone
+	Completed int
+}
+
+// TypeCompletions summarizes the completions of one task type.
+type TypeCompletions struct {
+	TaskType  Type
+	Completed int
+	// OnTime counts the tasks completed no later than their target time
+	OnTime int
+	// MedianTimeToComplete is the median time from creation to completion
+	MedianTimeToComplete time.Duration
+}
+
+// CompletionHistory is what task stats are computed from.
+type CompletionHistory struct {
+	// Days holds every day of the user's history with a completion, in date order
+	Days []DailyCompletions
+	// Types holds the task types completed within the stats window
+	Types []TypeCompletions
+}
+
+// CompletionBucket is the number of tasks completed in a bucket of a stats window.
+type CompletionBucket struct {
+	Start     time.Time
+	Completed int
+}
+
+type TaskStats struct {
+	buckets       []CompletionBucket
+	types         []TypeCompletions
+	currentStreak int
+	longestStreak int
+}
+
+// NewTaskStats buckets the completion history into the window. Streaks count consecutive
+// buckets with a completion over the whole history; the current streak still runs while
+// the last bucket of the window has no completion yet.
+func NewTaskStats(window *StatsWindow, history CompletionHistory) *TaskStats {
+	starts := window.BucketStarts()
+	buckets := make([]CompletionBucket, len(starts))
+
+	for i, start := range starts {
+		buckets[i] = CompletionBucket{Start: start, Completed: 0}
+	}
+
+	index := make(map[time.Time]int, len(starts))
+	for i, start := range starts {
+		index[start] = i
+	}
+
+	var (
+		current, longest, run int
+		last                  time.Time
+	)
+
+	for _, day := range history.Days {
+		start := window.bucketStart(day.Date)
+
+		if i, ok := index[start]; ok {
+			buckets[i].Completed += day.Completed
+		}
+
+		if start.Equal(last) {
+			continue
+		}
+
+		if !last.IsZero() && start.Equal(window.shift(last, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+
+		last = start
+		longest = max(longest, run)
+	}
+
+	currentStart := starts[len(starts)-1]
+	if last.Equal(currentStart) || last.Equal(window.shift(currentStart, -1)) {
+		current = run
+	}
+
+	types := make([]TypeCompletions, len(history.Types))
+	copy(types, history.Types)
+
+	return &TaskStats{
+		buckets:       buckets,
+		types:         types,
+		currentStreak: current,
+		longestStreak: longest,
+	}
+}
+
+// Buckets returns the completions in each bucket of the window, oldest first.
+func (s *TaskStats) Buckets() []CompletionBucket {
+	buckets := make([]CompletionBucket, len(s.buckets))
+	copy(buckets, s.buckets)
+
+	return buckets
+}
+
+// Types returns the task types completed within the window.
+func (s *TaskStats) Types() []TypeCompletions {
+	types := make([]TypeCompletions, len(s.types))
+	copy(types, s.types)
+
+	return types
+}
+
+// Completed is the number of tasks completed within the window.
+func (s *TaskStats) Completed() int {
+	completed := 0
+	for _, t := range s.types {
+		completed += t.Completed
+	}
+
+	return completed
+}
+
+// OnTime is the number of tasks completed within the window no later than their target time.
+func (s *TaskStats) OnTime() int {
+	onTime := 0
+	for _, t := range s.types {
+		onTime += t.OnTime
+	}
+
+	return onTime
+}
+
+// OnTimeRatio is the share of tasks completed on time, or 0 when none were completed.
+func (s *TaskStats) OnTimeRatio() float64 {
+	completed := s.Completed()
+	if completed == 0 {
+		return 0
+	}
+
+	return float64(s.OnTime()) / float64(completed)
+}
+
+func (s *TaskStats) CurrentStreak() int {
+	return s.currentStreak
+}
+
+func (s *TaskStats) LongestStreak() int {
+	return s.longestStreak
+}
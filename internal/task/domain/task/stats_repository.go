@@ -0,0 +1,15 @@
+package task
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+//go:generate mockgen -source=stats_repository.go -destination=mock_stats_repository.go -package=task
+
+type TaskStatsRepository interface {
+	// GetCompletionHistory counts the user's completions per day in the window's timezone
+	// over their whole history, and summarizes the completions within the window by type.
+	GetCompletionHistory(ctx context.Context, userID user.ID, window *StatsWindow) (*CompletionHistory, error)
+}
@@ -0,0 +1,246 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewStatsWindow(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		interval  StatsInterval
+		timezone  string
+		count     int
+		now       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantCount int
+	}{
+		{
+			name:     "days in the client timezone",
+			interval: StatsIntervalDay,
+			timezone: "Asia/Tokyo",
+			count:    3,
+			// 2026-03-10 01:30 in Tokyo
+			now:       time.Date(2026, 3, 9, 16, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 8, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 11, 0, 0, 0, 0, tokyo),
+			wantCount: 3,
+		},
+		{
+			name:      "weeks start on monday",
+			interval:  StatsIntervalWeek,
+			timezone:  "Asia/Tokyo",
+			count:     2,
+			now:       time.Date(2026, 3, 15, 12, 0, 0, 0, tokyo), // a Sunday
+			wantStart: time.Date(2026, 3, 2, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 16, 0, 0, 0, 0, tokyo),
+			wantCount: 2,
+		},
+		{
+			name:      "days across a DST change",
+			interval:  StatsIntervalDay,
+			timezone:  "America/New_York",
+			count:     2,
+			now:       time.Date(2026, 3, 8, 12, 0, 0, 0, newYork),
+			wantStart: time.Date(2026, 3, 7, 0, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2026, 3, 9, 0, 0, 0, 0, newYork),
+			wantCount: 2,
+		},
+		{
+			name:      "default bucket count",
+			interval:  StatsIntervalDay,
+			timezone:  "UTC",
+			count:     0,
+			now:       time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantCount: DefaultStatsBucketCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := NewStatsWindow(tt.interval, tt.timezone, tt.count, tt.now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !window.Start().Equal(tt.wantStart) || !window.End().Equal(tt.wantEnd) {
+				t.Errorf("expected [%v, %v), got [%v, %v)", tt.wantStart, tt.wantEnd, window.Start(), window.End())
+			}
+
+			if got := len(window.BucketStarts()); got != tt.wantCount {
+				t.Errorf("expected %d buckets, got %d", tt.wantCount, got)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name     string
+		interval StatsInterval
+		timezone string
+		count    int
+		wantErr  error
+	}{
+		{name: "unknown interval", interval: "month", timezone: "UTC", count: 1, wantErr: ErrInvalidStatsInterval},
+		{name: "empty timezone", interval: StatsIntervalDay, timezone: "", count: 1, wantErr: ErrInvalidStatsTimezone},
+		{name: "unknown timezone", interval: StatsIntervalDay, timezone: "Mars/Olympus", count: 1, wantErr: ErrInvalidStatsTimezone},
+		{name: "server local timezone", interval: StatsIntervalDay, timezone: "Local", count: 1, wantErr: ErrInvalidStatsTimezone},
+		{name: "negative count", interval: StatsIntervalDay, timezone: "UTC", count: -1, wantErr: ErrInvalidStatsBucketCount},
+		{name: "too many buckets", interval: StatsIntervalDay, timezone: "UTC", count: MaxStatsBucketCount + 1, wantErr: ErrInvalidStatsBucketCount},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewStatsWindow(tt.interval, tt.timezone, tt.count, time.Now()); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewTaskStats(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time {
+		return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	history := func(days ...int) CompletionHistory {
+		completions := make([]DailyCompletions, 0, len(days))
+		for _, d := range days {
+			completions = append(completions, DailyCompletions{Date: day(d), Completed: 2})
+		}
+
+		return CompletionHistory{Days: completions, Types: nil}
+	}
+
+	tests := []struct {
+		name        string
+		interval    StatsInterval
+		now         time.Time
+		history     CompletionHistory
+		wantBuckets []int
+		wantCurrent int
+		wantLongest int
+	}{
+		{
+			name:        "streak running through today",
+			interval:    StatsIntervalDay,
+			now:         day(10),
+			history:     history(1, 2, 3, 8, 9, 10),
+			wantBuckets: []int{0, 2, 2, 2},
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name:        "streak still running before today's completion",
+			interval:    StatsIntervalDay,
+			now:         day(10),
+			history:     history(2, 3, 4, 5, 8, 9),
+			wantBuckets: []int{0, 2, 2, 0},
+			wantCurrent: 2,
+			wantLongest: 4,
+		},
+		{
+			name:        "broken streak",
+			interval:    StatsIntervalDay,
+			now:         day(10),
+			history:     history(6, 7, 8),
+			wantBuckets: []int{2, 2, 0, 0},
+			wantCurrent: 0,
+			wantLongest: 3,
+		},
+		{
+			name:        "weekly streak",
+			interval:    StatsIntervalWeek,
+			now:         day(18),
+			history:     history(2, 4, 10, 16),
+			wantBuckets: []int{0, 4, 2, 2},
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name:        "no completions",
+			interval:    StatsIntervalDay,
+			now:         day(10),
+			history:     CompletionHistory{Days: nil, Types: nil},
+			wantBuckets: []int{0, 0, 0, 0},
+			wantCurrent: 0,
+			wantLongest: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := NewStatsWindow(tt.interval, "UTC", 4, tt.now)
+			if err != nil {
+				t.Fatalf("failed to create window: %v", err)
+			}
+
+			stats := NewTaskStats(window, tt.history)
+
+			buckets := stats.Buckets()
+			if len(buckets) != len(tt.wantBuckets) {
+				t.Fatalf("expected %d buckets, got %d", len(tt.wantBuckets), len(buckets))
+			}
+
+			for i, want := range tt.wantBuckets {
+				if buckets[i].Completed != want {
+					t.Errorf("bucket %d (%v): expected %d, got %d", i, buckets[i].Start, want, buckets[i].Completed)
+				}
+			}
+
+			if stats.CurrentStreak() != tt.wantCurrent || stats.LongestStreak() != tt.wantLongest {
+				t.Errorf("expected streaks %d/%d, got %d/%d",
+					tt.wantCurrent, tt.wantLongest, stats.CurrentStreak(), stats.LongestStreak())
+			}
+		})
+	}
+
+	t.Run("on-time ratio", func(t *testing.T) {
+		t.Parallel()
+
+		window, err := NewStatsWindow(StatsIntervalDay, "UTC", 1, day(10))
+		if err != nil {
+			t.Fatalf("failed to create window: %v", err)
+		}
+
+		stats := NewTaskStats(window, CompletionHistory{
+			Days: nil,
+			Types: []TypeCompletions{
+				{TaskType: TypeShort, Completed: 3, OnTime: 3, MedianTimeToComplete: time.Hour},
+				{TaskType: TypeNear, Completed: 1, OnTime: 0, MedianTimeToComplete: 2 * time.Hour},
+			},
+		})
+
+		if stats.Completed() != 4 || stats.OnTime() != 3 || stats.OnTimeRatio() != 0.75 {
+			t.Fatalf("unexpected totals %d/%d (%v)", stats.OnTime(), stats.Completed(), stats.OnTimeRatio())
+		}
+
+		empty := NewTaskStats(window, CompletionHistory{Days: nil, Types: nil})
+		if empty.OnTimeRatio() != 0 {
+			t.Fatalf("expected a zero ratio without completions, got %v", empty.OnTimeRatio())
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package task
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+const (
+	DefaultSyncPageSize = 200
+	MaxSyncPageSize     = 1000
+)
+
+// NormalizeSyncPageSize applies the default sync page size when size is zero and
+// rejects sizes outside of [0, MaxSyncPageSize].
+func NormalizeSyncPageSize(size int) (int, error) {
+	switch {
+	case size == 0:
+		return DefaultSyncPageSize, nil
+	case size < 0 || size > MaxSyncPageSize:
+		return 0, ErrInvalidSyncPageSize
+	default:
+		return size, nil
+	}
+}
+
+// ChangeType tells what the latest change to a task left behind.
+type ChangeType string
+
+const (
+	// ChangeTypeUpserted means the task was created or changed and is still active
+	ChangeTypeUpserted ChangeType = "upserted"
+	// ChangeTypeCompleted means the task was completed and left the active tasks
+	ChangeTypeCompleted ChangeType = "completed"
+	// ChangeTypeDeleted means the task was moved to the trash
+	ChangeTypeDeleted ChangeType = "deleted"
+)
+
+func NewChangeType(s string) (ChangeType, error) {
+	switch ChangeType(s) {
+	case ChangeTypeUpserted, ChangeTypeCompleted, ChangeTypeDeleted:
+		return ChangeType(s), nil
+	default:
+		return "", ErrInvalidChangeType
+	}
+}
+
+// TaskChange is the latest change to one of a user's tasks. An upserted change carries the
+// current task; completed and deleted changes are tombstones that only carry the task ID.
+type TaskChange struct {
+	taskID     ID
+	changeType ChangeType
+	changedAt  time.Time
+	task       *Task
+}
+
+func NewUpsertedTaskChange(task *Task, changedAt time.Time) (*TaskChange, error) {
+	if task == nil {
+		return nil, ErrTaskNil
+	}
+
+	return &TaskChange{
+		taskID:     task.ID(),
+		changeType: ChangeTypeUpserted,
+		changedAt:  changedAt,
+		task:       task,
+	}, nil
+}
+
+func NewTombstone(taskID ID, changeType ChangeType, changedAt time.Time) (*TaskChange, error) {
+	if changeType != ChangeTypeCompleted && changeType != ChangeTypeDeleted {
+		return nil, ErrInvalidChangeType
+	}
+
+	return &TaskChange{
+		taskID:     taskID,
+		changeType: changeType,
+		changedAt:  changedAt,
+		task:       nil,
+	}, nil
+}
+
+func (c *TaskChange) TaskID() ID {
+	return c.taskID
+}
+
+func (c *TaskChange) ChangeType() ChangeType {
+	return c.changeType
+}
+
+func (c *TaskChange) ChangedAt() time.Time {
+	return c.changedAt
+}
+
+// Task is the current state of an upserted task, and nil for a tombstone.
+func (c *TaskChange) Task() *Task {
+	return c.task
+}
+
+func (c *TaskChange) IsTombstone() bool {
+	return c.changeType != ChangeTypeUpserted
+}
+
+// SyncToken is a position in a user's change log. The zero token is the start of the log,
+// from which a sync returns every task the user has.
+type SyncToken struct {
+	seq int64
+}
+
+type syncTokenPayload struct {
+	Seq int64 `json:"q"`
+}
+
+func NewSyncToken(seq int64) SyncToken {
+	return SyncToken{seq: seq}
+}
+
+// DecodeSyncToken parses an opaque sync token. An empty token is the start of the log.
+func DecodeSyncToken(token string) (SyncToken, error) {
+	if token == "" {
+		return SyncToken{seq: 0}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	var payload syncTokenPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	if payload.Seq <= 0 {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	return SyncToken{seq: payload.Seq}, nil
+}
+
+// Encode returns the opaque sync token. The start of the log encodes as an empty string.
+func (t SyncToken) Encode() string {
+	if t.seq == 0 {
+		return ""
+	}
+
+	raw, err := json.Marshal(syncTokenPayload{Seq: t.seq})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (t SyncToken) Seq() int64 {
+	return t.seq
+}
+
+// SyncTasksQuery describes one page of the changes to a user's tasks after a sync token.
+type SyncTasksQuery struct {
+	Since    SyncToken
+	PageSize int
+}
+
+// TaskChangesPage is one page of a user's task changes, in the order they were made. A
+// task appears at most once, with its latest change.
+type TaskChangesPage struct {
+	Changes []*TaskChange
+	// Next is the token to sync from once the page is applied
+	Next    SyncToken
+	HasMore bool
+}
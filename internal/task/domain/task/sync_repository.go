@@ -0,0 +1,18 @@
+package task
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+//go:generate mockgen -source=sync_repository.go -destination=mock_sync_repository.go -package=task
+
+// TaskSyncRepository reads the change log that every write to a user's tasks appends to.
+// Each user's changes are numbered in the order they commit, so a page read after a token
+// never misses a change that committed before it.
+type TaskSyncRepository interface {
+	// ListTaskChanges returns ErrSyncTokenExpired when the token is ahead of the user's
+	// log, as happens when it was issued to another user or before the log was reset.
+	ListTaskChanges(ctx context.Context, userID user.ID, query SyncTasksQuery) (*TaskChangesPage, error)
+}
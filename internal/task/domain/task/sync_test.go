@@ -0,0 +1,100 @@
+package task
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSyncToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trips", func(t *testing.T) {
+		t.Parallel()
+
+		token := NewSyncToken(42)
+
+		decoded, err := DecodeSyncToken(token.Encode())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if decoded != token {
+			t.Fatalf("expected %v, got %v", token, decoded)
+		}
+	})
+
+	t.Run("empty token is the start of the log", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DecodeSyncToken("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if decoded.Seq() != 0 || decoded.Encode() != "" {
+			t.Fatalf("expected the zero token, got %v", decoded)
+		}
+	})
+
+	invalid := map[string]string{
+		"not base64":    "%%%",
+		"not json":      base64.RawURLEncoding.EncodeToString([]byte("seq")),
+		"zero sequence": base64.RawURLEncoding.EncodeToString([]byte(`{"q":0}`)),
+		"negative":      base64.RawURLEncoding.EncodeToString([]byte(`{"q":-3}`)),
+	}
+
+	for name, token := range invalid {
+		token := token
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := DecodeSyncToken(token); !errors.Is(err, ErrInvalidSyncToken) {
+				t.Fatalf("expected ErrInvalidSyncToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNormalizeSyncPageSize(t *testing.T) {
+	t.Parallel()
+
+	if size, err := NormalizeSyncPageSize(0); err != nil || size != DefaultSyncPageSize {
+		t.Errorf("expected the default page size, got %d %v", size, err)
+	}
+
+	if size, err := NormalizeSyncPageSize(MaxSyncPageSize); err != nil || size != MaxSyncPageSize {
+		t.Errorf("expected the maximum page size, got %d %v", size, err)
+	}
+
+	for _, size := range []int{-1, MaxSyncPageSize + 1} {
+		if _, err := NormalizeSyncPageSize(size); !errors.Is(err, ErrInvalidSyncPageSize) {
+			t.Errorf("expected ErrInvalidSyncPageSize for %d, got %v", size, err)
+		}
+	}
+}
+
+func TestNewTombstone(t *testing.T) {
+	t.Parallel()
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	changedAt := time.Now().UTC()
+
+	tombstone, err := NewTombstone(taskID, ChangeTypeDeleted, changedAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !tombstone.IsTombstone() || tombstone.Task() != nil || tombstone.TaskID() != taskID || !tombstone.ChangedAt().Equal(changedAt) {
+		t.Fatalf("unexpected tombstone %+v", tombstone)
+	}
+
+	if _, err := NewTombstone(taskID, ChangeTypeUpserted, changedAt); !errors.Is(err, ErrInvalidChangeType) {
+		t.Fatalf("expected ErrInvalidChangeType for an upsert, got %v", err)
+	}
+}
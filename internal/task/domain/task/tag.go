@@ -0,0 +1,137 @@
+package task
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/google/uuid"
+)
+
+const (
+	MaxTagNameLength = 50
+	MaxTagsPerTask   = 20
+)
+
+type TagID uuid.UUID
+
+func NewTagID() (TagID, error) {
+	v7, err := uuid.NewV7()
+	if err != nil {
+		return TagID{}, fmt.Errorf("%w: %v", ErrIDGeneration, err)
+	}
+
+	return TagID(v7), nil
+}
+
+func NewTagIDFromString(idStr string) (TagID, error) {
+	uuidVal, err := uuid.Parse(idStr)
+	if err != nil {
+		return TagID{}, fmt.Errorf("%w: %v", ErrTagIDInvalidFormat, err)
+	}
+
+	if uuidVal.Version() != 7 {
+		return TagID{}, ErrTagIDInvalidV7
+	}
+
+	return TagID(uuidVal), nil
+}
+
+func (id TagID) String() string {
+	return uuid.UUID(id).String()
+}
+
+// Tag is a user-defined label that can be attached to any number of the user's tasks.
+// Tag names are unique per user.
+type Tag struct {
+	id        TagID
+	userID    user.ID
+	name      string
+	color     Color
+	createdAt time.Time
+}
+
+func NewTag(id TagID, userID user.ID, name string, color Color, createdAt time.Time) (*Tag, error) {
+	normalizedName, err := normalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := color.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Tag{
+		id:        id,
+		userID:    userID,
+		name:      normalizedName,
+		color:     color,
+		createdAt: createdAt.UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+// CreateTag builds a new tag with a generated ID.
+func CreateTag(userID user.ID, name string, color Color) (*Tag, error) {
+	id, err := NewTagID()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTag(id, userID, name, color, time.Now())
+}
+
+func normalizeTagName(name string) (string, error) {
+	normalized := strings.TrimSpace(name)
+
+	if normalized == "" {
+		return "", ErrTagNameEmpty
+	}
+
+	if utf8.RuneCountInString(normalized) > MaxTagNameLength {
+		return "", ErrTagNameTooLong
+	}
+
+	return normalized, nil
+}
+
+// Update returns a copy of the tag with the given attributes changed. Nil arguments are
+// left as they are.
+func (t *Tag) Update(name *string, color *Color) (*Tag, error) {
+	if name == nil && color == nil {
+		return nil, ErrNoFieldsToUpdate
+	}
+
+	newName := t.name
+	if name != nil {
+		newName = *name
+	}
+
+	newColor := t.color
+	if color != nil {
+		newColor = *color
+	}
+
+	return NewTag(t.id, t.userID, newName, newColor, t.createdAt)
+}
+
+func (t *Tag) ID() TagID {
+	return t.id
+}
+
+func (t *Tag) UserID() user.ID {
+	return t.userID
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+func (t *Tag) Color() Color {
+	return t.color
+}
+
+func (t *Tag) CreatedAt() time.Time {
+	return t.createdAt
+}
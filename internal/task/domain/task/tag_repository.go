@@ -0,0 +1,29 @@
+package task
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+//go:generate mockgen -source=tag_repository.go -destination=mock_tag_repository.go -package=task
+
+// TagRepository stores the user's tags and which tasks they are attached to. Attached
+// tags stay with a task when it is archived or reopened.
+type TagRepository interface {
+	// SaveTag returns ErrTagNameAlreadyExists when the user already has a tag of that name.
+	SaveTag(ctx context.Context, tag *Tag) error
+	GetTagByID(ctx context.Context, id TagID, userID user.ID) (*Tag, error)
+	// ListTagsByUserID returns the user's tags ordered by name.
+	ListTagsByUserID(ctx context.Context, userID user.ID) ([]*Tag, error)
+	UpdateTag(ctx context.Context, tag *Tag) error
+	// DeleteTag removes the tag and detaches it from every task.
+	DeleteTag(ctx context.Context, id TagID, userID user.ID) error
+	// ListTaskTags returns the tags attached to an active task, ordered by name.
+	ListTaskTags(ctx context.Context, taskID ID, userID user.ID) ([]*Tag, error)
+	// AttachTag attaches the tag to an active task. Attaching a tag twice has no effect.
+	AttachTag(ctx context.Context, taskID ID, tagID TagID, userID user.ID) error
+	// DetachTag detaches the tag from an active task. Detaching a tag that is not
+	// attached has no effect.
+	DetachTag(ctx context.Context, taskID ID, tagID TagID, userID user.ID) error
+}
@@ -0,0 +1,181 @@
+package task
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func TestNewTag(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user ID: %v", err)
+	}
+
+	tagID, err := NewTagID()
+	if err != nil {
+		t.Fatalf("failed to generate tag ID: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		tagName string
+		color   Color
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "trims surrounding spaces",
+			tagName: "  work ",
+			color:   MustColor("#4ECDC4"),
+			want:    "work",
+		},
+		{
+			name:    "counts characters rather than bytes",
+			tagName: strings.Repeat("あ", MaxTagNameLength),
+			color:   MustColor("#4ECDC4"),
+			want:    strings.Repeat("あ", MaxTagNameLength),
+		},
+		{
+			name:    "empty name",
+			tagName: "  ",
+			color:   MustColor("#4ECDC4"),
+			wantErr: ErrTagNameEmpty,
+		},
+		{
+			name:    "name too long",
+			tagName: strings.Repeat("a", MaxTagNameLength+1),
+			color:   MustColor("#4ECDC4"),
+			wantErr: ErrTagNameTooLong,
+		},
+		{
+			name:    "missing color",
+			tagName: "work",
+			color:   Color{},
+			wantErr: ErrColorEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tag, err := NewTag(tagID, userID, tt.tagName, tt.color, time.Now())
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tag.Name() != tt.want {
+				t.Fatalf("expected name %q, got %q", tt.want, tag.Name())
+			}
+		})
+	}
+}
+
+func TestNewTagIDFromStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewTagIDFromString("not-a-uuid"); !errors.Is(err, ErrTagIDInvalidFormat) {
+		t.Fatalf("expected ErrTagIDInvalidFormat, got %v", err)
+	}
+
+	if _, err := NewTagIDFromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"); !errors.Is(err, ErrTagIDInvalidV7) {
+		t.Fatalf("expected ErrTagIDInvalidV7, got %v", err)
+	}
+}
+
+func TestTagUpdate(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user ID: %v", err)
+	}
+
+	tag, err := CreateTag(userID, "work", MustColor("#4ECDC4"))
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	if _, err := tag.Update(nil, nil); !errors.Is(err, ErrNoFieldsToUpdate) {
+		t.Fatalf("expected ErrNoFieldsToUpdate, got %v", err)
+	}
+
+	color := MustColor("#FF6B6B")
+
+	updated, err := tag.Update(nil, &color)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updated.Name() != "work" || updated.Color() != color || updated.ID() != tag.ID() {
+		t.Fatalf("expected only the color to change, got %q %q", updated.Name(), updated.Color())
+	}
+
+	if tag.Color() != MustColor("#4ECDC4") {
+		t.Fatalf("expected the original tag to be unchanged")
+	}
+
+	empty := " "
+	if _, err := tag.Update(&empty, nil); !errors.Is(err, ErrTagNameEmpty) {
+		t.Fatalf("expected ErrTagNameEmpty, got %v", err)
+	}
+}
+
+func TestCompletedTaskKeepsTags(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user ID: %v", err)
+	}
+
+	tag, err := CreateTag(userID, "work", MustColor("#4ECDC4"))
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to generate task ID: %v", err)
+	}
+
+	now := time.Now()
+
+	task, err := NewTask(taskID, userID, "Write report", TypeNear, StatusActive, "", nil, now, now.Add(time.Hour), MustColor("#FF6B6B"), WithTags([]*Tag{tag}))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	completedTask, err := NewCompletedTask(task, now)
+	if err != nil {
+		t.Fatalf("failed to complete task: %v", err)
+	}
+
+	if len(completedTask.Tags()) != 1 || completedTask.Tags()[0].ID() != tag.ID() {
+		t.Fatalf("expected completed task to keep the tag")
+	}
+
+	reopened, err := completedTask.Reopen(now, nil)
+	if err != nil {
+		t.Fatalf("failed to reopen task: %v", err)
+	}
+
+	if len(reopened.Tags()) != 1 {
+		t.Fatalf("expected reopened task to keep the tag")
+	}
+}
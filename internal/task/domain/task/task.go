@@ -61,11 +61,13 @@ const (
 	StatusActive           Status = "active"
 	StatusCompleted        Status = "completed"
 	StatusPendingReminders Status = "pending_reminders"
+	// StatusReminderFailed marks a task whose reminders could not be registered
+	StatusReminderFailed Status = "reminder_failed"
 )
 
 func NewStatus(s string) (Status, error) {
 	switch s {
-	case string(StatusActive), string(StatusCompleted), string(StatusPendingReminders):
+	case string(StatusActive), string(StatusCompleted), string(StatusPendingReminders), string(StatusReminderFailed):
 		return Status(s), nil
 	default:
 		return "", fmt.Errorf("%w: %s", ErrInvalidTaskStatus, s)
@@ -83,6 +85,52 @@ type Task struct {
 	createdAt   time.Time
 	targetAt    time.Time
 	color       Color
+	recurrence  *Recurrence
+	rank        string
+	checklist   ChecklistProgress
+	tags        []*Tag
+	version     int64
+}
+
+// TaskOption sets an optional attribute on a task being constructed.
+type TaskOption func(*Task)
+
+func WithRecurrence(recurrence *Recurrence) TaskOption {
+	return func(t *Task) {
+		t.recurrence = recurrence
+	}
+}
+
+// WithChecklistProgress attaches the progress of the task's checklist. It is read along
+// with the task and never written through it.
+func WithChecklistProgress(progress ChecklistProgress) TaskOption {
+	return func(t *Task) {
+		t.checklist = progress
+	}
+}
+
+// WithTags attaches the tags of the task. Like the checklist progress they are read along
+// with the task and changed through the TagRepository.
+func WithTags(tags []*Tag) TaskOption {
+	return func(t *Task) {
+		t.tags = tags
+	}
+}
+
+// WithVersion sets the version the task was stored at. Tasks constructed without one are at
+// the first version.
+func WithVersion(version int64) TaskOption {
+	return func(t *Task) {
+		t.version = version
+	}
+}
+
+// WithRank sets the task's position in the user's manual order. Tasks saved without a
+// rank are placed at the end of that order.
+func WithRank(rank string) TaskOption {
+	return func(t *Task) {
+		t.rank = rank
+	}
 }
 
 func NewTask(
@@ -96,6 +144,7 @@ func NewTask(
 	createdAt time.Time,
 	targetAt time.Time,
 	color Color,
+	opts ...TaskOption,
 ) (*Task, error) {
 	normalizedCreatedAt := createdAt.UTC().Truncate(time.Microsecond)
 	normalizedTargetAt := targetAt.UTC().Truncate(time.Microsecond)
@@ -128,7 +177,7 @@ func NewTask(
 		return nil, err
 	}
 
-	return &Task{
+	task := &Task{
 		id:          id,
 		userID:      userID,
 		title:       title,
@@ -139,7 +188,22 @@ func NewTask(
 		createdAt:   normalizedCreatedAt,
 		targetAt:    normalizedTargetAt,
 		color:       color,
-	}, nil
+		recurrence:  nil,
+		rank:        "",
+		checklist:   ChecklistProgress{total: 0, done: 0},
+		tags:        nil,
+		version:     1,
+	}
+
+	for _, opt := range opts {
+		opt(task)
+	}
+
+	if task.recurrence != nil && taskType != TypeScheduled {
+		return nil, ErrRecurrenceNotAllowed
+	}
+
+	return task, nil
 }
 
 func CreateTask(
@@ -151,6 +215,7 @@ func CreateTask(
 	scheduledAt *time.Time,
 	color Color,
 	customPeriod *time.Duration,
+	opts ...TaskOption,
 ) (*Task, error) {
 	var id ID
 
@@ -195,6 +260,7 @@ func CreateTask(
 		createdAt,
 		targetAt,
 		color,
+		opts...,
 	)
 }
 
@@ -238,6 +304,143 @@ func (t *Task) Color() Color {
 	return t.color
 }
 
+func (t *Task) Recurrence() *Recurrence {
+	return t.recurrence
+}
+
+func (t *Task) Rank() string {
+	return t.rank
+}
+
+func (t *Task) ChecklistProgress() ChecklistProgress {
+	return t.checklist
+}
+
+func (t *Task) Tags() []*Tag {
+	return t.tags
+}
+
+// Version increases with every edit of the task. Clients send the version they last saw
+// with an update so that it is rejected when the task has changed since.
+func (t *Task) Version() int64 {
+	return t.version
+}
+
+// NextOccurrence builds the next task of a recurring series, scheduled after both the
+// current occurrence and now. It returns nil when the task does not recur or the series
+// has ended.
+func (t *Task) NextOccurrence(now time.Time) (*Task, error) {
+	if t.recurrence == nil || t.scheduledAt == nil {
+		return nil, nil
+	}
+
+	next, recurrence, ok := t.recurrence.Next(*t.scheduledAt, now)
+	if !ok {
+		return nil, nil
+	}
+
+	id, err := NewID()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(
+		id,
+		t.userID,
+		t.title,
+		t.taskType,
+		StatusPendingReminders,
+		t.description,
+		&next,
+		now,
+		next,
+		t.color,
+		WithRecurrence(recurrence),
+		WithRank(t.rank),
+		// The checklist carries over unchecked
+		WithChecklistProgress(NewChecklistProgress(t.checklist.total, 0)),
+		WithTags(t.tags),
+	)
+}
+
+// Snooze pushes the task's deadline out to until. The returned task is pending reminders
+// because its reminders have to be re-registered for the new deadline. scheduledAt is kept
+// so that a recurring series continues from its original slot.
+func (t *Task) Snooze(now, until time.Time) (*Task, error) {
+	if t.taskStatus == StatusCompleted {
+		return nil, ErrSnoozeCompletedTask
+	}
+
+	if !until.After(now) {
+		return nil, ErrSnoozeUntilNotInFuture
+	}
+
+	return NewTask(
+		t.id,
+		t.userID,
+		t.title,
+		t.taskType,
+		StatusPendingReminders,
+		t.description,
+		t.scheduledAt,
+		t.createdAt,
+		until,
+		t.color,
+		WithRecurrence(t.recurrence),
+		WithRank(t.rank),
+		WithChecklistProgress(t.checklist),
+		WithTags(t.tags),
+		WithVersion(t.version+1),
+	)
+}
+
+// Activate marks the task active once its reminders are registered. Reminder bookkeeping
+// is not an edit, so the version stays the same.
+func (t *Task) Activate() (*Task, error) {
+	return NewTask(
+		t.id,
+		t.userID,
+		t.title,
+		t.taskType,
+		StatusActive,
+		t.description,
+		t.scheduledAt,
+		t.createdAt,
+		t.targetAt,
+		t.color,
+		WithRecurrence(t.recurrence),
+		WithRank(t.rank),
+		WithChecklistProgress(t.checklist),
+		WithTags(t.tags),
+		WithVersion(t.version),
+	)
+}
+
+// MoveTo places the task at rank in the user's manual order.
+func (t *Task) MoveTo(rank string) (*Task, error) {
+	if err := ValidateRank(rank); err != nil {
+		return nil, err
+	}
+
+	return NewTask(
+		t.id,
+		t.userID,
+		t.title,
+		t.taskType,
+		t.taskStatus,
+		t.description,
+		t.scheduledAt,
+		t.createdAt,
+		t.targetAt,
+		t.color,
+		WithRecurrence(t.recurrence),
+		WithRank(rank),
+		WithChecklistProgress(t.checklist),
+		WithTags(t.tags),
+		WithVersion(t.version+1),
+	)
+}
+
 type TaskUpdateInput struct {
 	TaskStatus       *Status
 	Title            *string
@@ -245,6 +448,8 @@ type TaskUpdateInput struct {
 	ScheduledAt      *time.Time
 	ClearScheduledAt bool
 	Color            *Color
+	Recurrence       *Recurrence
+	ClearRecurrence  bool
 }
 
 func (u *TaskUpdateInput) HasUpdates() bool {
@@ -253,7 +458,9 @@ func (u *TaskUpdateInput) HasUpdates() bool {
 		u.Description != nil ||
 		u.ScheduledAt != nil ||
 		u.ClearScheduledAt ||
-		u.Color != nil
+		u.Color != nil ||
+		u.Recurrence != nil ||
+		u.ClearRecurrence
 }
 
 func (t *Task) ApplyUpdate(input *TaskUpdateInput) (*Task, error) {
@@ -267,6 +474,7 @@ func (t *Task) ApplyUpdate(input *TaskUpdateInput) (*Task, error) {
 	newScheduledAt := t.scheduledAt
 	newColor := t.color
 	newTargetAt := t.targetAt
+	newRecurrence := t.recurrence
 
 	if input.TaskStatus != nil {
 		newStatus = *input.TaskStatus
@@ -290,8 +498,16 @@ func (t *Task) ApplyUpdate(input *TaskUpdateInput) (*Task, error) {
 		newColor = *input.Color
 	}
 
-	if t.taskType == TypeScheduled && newScheduledAt != nil {
-		newTargetAt = *newScheduledAt
+	if input.ClearRecurrence {
+		newRecurrence = nil
+	} else if input.Recurrence != nil {
+		newRecurrence = input.Recurrence
+	}
+
+	// targetAt only follows scheduledAt when it is rescheduled so that a snoozed
+	// deadline survives unrelated edits.
+	if t.taskType == TypeScheduled && input.ScheduledAt != nil {
+		newTargetAt = *input.ScheduledAt
 	}
 
 	return NewTask(
@@ -305,5 +521,10 @@ func (t *Task) ApplyUpdate(input *TaskUpdateInput) (*Task, error) {
 		t.createdAt,
 		newTargetAt,
 		newColor,
+		WithRecurrence(newRecurrence),
+		WithRank(t.rank),
+		WithChecklistProgress(t.checklist),
+		WithTags(t.tags),
+		WithVersion(t.version+1),
 	)
 }
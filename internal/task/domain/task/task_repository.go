@@ -6,12 +6,30 @@ import (
 	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
 )
 
+//go:generate mockgen -source=task_repository.go -destination=mock_task_repository.go -package=task
+
 type TaskRepository interface {
 	SaveTask(ctx context.Context, task *Task) error
 	GetTaskByID(ctx context.Context, id ID, userID user.ID) (*Task, error)
 	ExistsTaskByID(ctx context.Context, id ID) (bool, error)
-	ListActiveTasksByUserID(ctx context.Context, userID user.ID, sortType SortType) ([]*Task, error)
+	ListActiveTasksByUserID(ctx context.Context, userID user.ID, query ListActiveTasksQuery) ([]*Task, *PageCursor, error)
+	// UpdateTask saves an edit of the task. It returns ErrTaskVersionConflict when the stored
+	// task is no longer the version the edit was made on.
 	UpdateTask(ctx context.Context, task *Task) error
 	UpdateTaskStatus(ctx context.Context, taskID ID, userID user.ID, status Status) error
+	// DeleteTask moves the task to the trash, where it is left out of every other method
+	// until it is restored or purged.
 	DeleteTask(ctx context.Context, id ID, userID user.ID) error
+	GetDeletedTaskByID(ctx context.Context, id ID, userID user.ID) (*DeletedTask, error)
+	ListDeletedTasksByUserID(ctx context.Context, userID user.ID, query ListDeletedTasksQuery) ([]*DeletedTask, *PageCursor, error)
+	// RestoreTask takes the task out of the trash and saves the status it is restored with.
+	RestoreTask(ctx context.Context, task *Task) error
+	// UpdateTaskRank moves the task and bumps its version.
+	UpdateTaskRank(ctx context.Context, id ID, userID user.ID, rank string) error
+	// PreviousRank returns the highest rank below rank among the user's tasks other than
+	// excludeID, or an empty string when there is none.
+	PreviousRank(ctx context.Context, userID user.ID, rank string, excludeID ID) (string, error)
+	// NextRank returns the lowest rank above rank among the user's tasks other than
+	// excludeID, or an empty string when there is none.
+	NextRank(ctx context.Context, userID user.ID, rank string, excludeID ID) (string, error)
 }
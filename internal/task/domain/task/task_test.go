@@ -268,6 +268,11 @@ func TestNewStatusSuccess(t *testing.T) {
 			statusStr: "completed",
 			expected:  StatusCompleted,
 		},
+		{
+			name:      "reminder failed",
+			statusStr: "reminder_failed",
+			expected:  StatusReminderFailed,
+		},
 	}
 
 	for _, tt := range tests {
@@ -397,6 +402,7 @@ func TestNewTaskSuccess(t *testing.T) {
 				createdAt:   createTime,
 				targetAt:    targetTime,
 				color:       validColor,
+				version:     1,
 			},
 		},
 		{
@@ -432,6 +438,7 @@ func TestNewTaskSuccess(t *testing.T) {
 				createdAt: createTime,
 				targetAt:  scheduledTargetTime,
 				color:     validColor,
+				version:   1,
 			},
 		},
 		{
@@ -459,6 +466,7 @@ func TestNewTaskSuccess(t *testing.T) {
 				createdAt:   createTime,
 				targetAt:    targetTime,
 				color:       validColor,
+				version:     1,
 			},
 		},
 		{
@@ -494,6 +502,7 @@ func TestNewTaskSuccess(t *testing.T) {
 				createdAt: createTime,
 				targetAt:  scheduledTargetTime24h,
 				color:     validColor,
+				version:   1,
 			},
 		},
 		{
@@ -521,6 +530,7 @@ func TestNewTaskSuccess(t *testing.T) {
 				createdAt:   createTime,
 				targetAt:    targetTime,
 				color:       validColor,
+				version:     1,
 			},
 		},
 		{
@@ -548,6 +558,7 @@ func TestNewTaskSuccess(t *testing.T) {
 				createdAt:   createTime,
 				targetAt:    targetTime,
 				color:       validColor,
+				version:     1,
 			},
 		},
 	}
@@ -571,7 +582,7 @@ func TestNewTaskSuccess(t *testing.T) {
 			}
 
 			opts := cmp.Options{
-				cmp.AllowUnexported(Task{}, Color{}),
+				cmp.AllowUnexported(Task{}, Color{}, ChecklistProgress{}),
 			}
 			if diff := cmp.Diff(tt.expected, task, opts); diff != "" {
 				t.Errorf("NewTask() mismatch (-want +got):\n%s", diff)
@@ -1179,3 +1190,259 @@ func TestNewTaskTargetAtNormalization(t *testing.T) {
 		}
 	})
 }
+
+func TestTaskRecurrence(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	weekly, err := NewRecurrence("FREQ=WEEKLY;COUNT=2", "")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	scheduledAt := createdAt.Add(24 * time.Hour)
+	color := MustColor("#FF6B6B")
+
+	t.Run("not allowed for non scheduled tasks", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTask(taskID, userID, "Task", TypeNear, StatusActive, "", nil, createdAt, createdAt.Add(time.Hour), color, WithRecurrence(weekly))
+		if !errors.Is(err, ErrRecurrenceNotAllowed) {
+			t.Fatalf("expected ErrRecurrenceNotAllowed, got %v", err)
+		}
+	})
+
+	t.Run("next occurrence carries the remaining rule", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := NewTask(taskID, userID, "Weekly review", TypeScheduled, StatusCompleted, "desc", &scheduledAt, createdAt, scheduledAt, color, WithRecurrence(weekly))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		now := scheduledAt.Add(time.Hour)
+
+		next, err := task.NextOccurrence(now)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if next == nil {
+			t.Fatal("expected next occurrence")
+		}
+
+		if next.ID() == task.ID() {
+			t.Error("expected next occurrence to have a new ID")
+		}
+
+		want := scheduledAt.Add(7 * 24 * time.Hour)
+		if next.ScheduledAt() == nil || !next.ScheduledAt().Equal(want) || !next.TargetAt().Equal(want) {
+			t.Errorf("expected next occurrence at %v, got scheduled %v target %v", want, next.ScheduledAt(), next.TargetAt())
+		}
+
+		if next.TaskStatus() != StatusPendingReminders {
+			t.Errorf("expected status %s, got %s", StatusPendingReminders, next.TaskStatus())
+		}
+
+		if next.Title() != task.Title() || next.Description() != task.Description() || next.Color() != task.Color() {
+			t.Error("expected next occurrence to copy title, description and color")
+		}
+
+		if next.Recurrence() == nil || next.Recurrence().Rule() != "FREQ=WEEKLY;COUNT=1" {
+			t.Errorf("expected remaining rule FREQ=WEEKLY;COUNT=1, got %v", next.Recurrence())
+		}
+
+		last, err := next.NextOccurrence(want.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if last != nil {
+			t.Errorf("expected series to end, got %v", last.ScheduledAt())
+		}
+	})
+
+	t.Run("non recurring task has no next occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := NewTask(taskID, userID, "Once", TypeScheduled, StatusCompleted, "", &scheduledAt, createdAt, scheduledAt, color)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		next, err := task.NextOccurrence(scheduledAt)
+		if err != nil || next != nil {
+			t.Fatalf("expected no next occurrence, got %v (%v)", next, err)
+		}
+	})
+
+	t.Run("update can clear recurrence", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := NewTask(taskID, userID, "Weekly review", TypeScheduled, StatusActive, "", &scheduledAt, createdAt, scheduledAt, color, WithRecurrence(weekly))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		updated, err := task.ApplyUpdate(&TaskUpdateInput{ClearRecurrence: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if updated.Recurrence() != nil {
+			t.Errorf("expected recurrence to be cleared, got %v", updated.Recurrence().Rule())
+		}
+	})
+}
+
+func TestTaskSnooze(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	scheduledAt := createdAt.Add(2 * time.Hour)
+	now := createdAt.Add(time.Hour)
+	color := MustColor("#FF6B6B")
+
+	t.Run("moves targetAt and keeps scheduledAt", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := NewTask(taskID, userID, "Task", TypeScheduled, StatusActive, "", &scheduledAt, createdAt, scheduledAt, color)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		until := scheduledAt.Add(30 * time.Minute)
+
+		snoozed, err := task.Snooze(now, until)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !snoozed.TargetAt().Equal(until) {
+			t.Errorf("expected targetAt %v, got %v", until, snoozed.TargetAt())
+		}
+
+		if snoozed.ScheduledAt() == nil || !snoozed.ScheduledAt().Equal(scheduledAt) {
+			t.Errorf("expected scheduledAt %v, got %v", scheduledAt, snoozed.ScheduledAt())
+		}
+
+		if snoozed.TaskStatus() != StatusPendingReminders {
+			t.Errorf("expected status %s, got %s", StatusPendingReminders, snoozed.TaskStatus())
+		}
+
+		title := "Renamed"
+
+		renamed, err := snoozed.ApplyUpdate(&TaskUpdateInput{Title: &title})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !renamed.TargetAt().Equal(until) {
+			t.Errorf("expected snoozed targetAt to survive update, got %v", renamed.TargetAt())
+		}
+	})
+
+	t.Run("until not in the future", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := NewTask(taskID, userID, "Task", TypeNear, StatusActive, "", nil, createdAt, createdAt.Add(3*time.Hour), color)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		if _, err := task.Snooze(now, now); !errors.Is(err, ErrSnoozeUntilNotInFuture) {
+			t.Fatalf("expected ErrSnoozeUntilNotInFuture, got %v", err)
+		}
+	})
+
+	t.Run("completed task", func(t *testing.T) {
+		t.Parallel()
+
+		task, err := NewTask(taskID, userID, "Task", TypeNear, StatusCompleted, "", nil, createdAt, createdAt.Add(3*time.Hour), color)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		if _, err := task.Snooze(now, now.Add(time.Hour)); !errors.Is(err, ErrSnoozeCompletedTask) {
+			t.Fatalf("expected ErrSnoozeCompletedTask, got %v", err)
+		}
+	})
+}
+
+func TestTaskVersion(t *testing.T) {
+	t.Parallel()
+
+	userID, err := user.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	taskID, err := NewID()
+	if err != nil {
+		t.Fatalf("failed to create task ID: %v", err)
+	}
+
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+
+	task, err := NewTask(taskID, userID, "Task", TypeNear, StatusReminderFailed, "", nil, createdAt, createdAt.Add(3*time.Hour), MustColor("#FF6B6B"), WithVersion(4))
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	title := "Renamed"
+
+	edits := map[string]func() (*Task, error){
+		"update": func() (*Task, error) { return task.ApplyUpdate(&TaskUpdateInput{Title: &title}) },
+		"snooze": func() (*Task, error) { return task.Snooze(now, now.Add(time.Hour)) },
+		"move":   func() (*Task, error) { return task.MoveTo("m") },
+	}
+
+	for name, edit := range edits {
+		t.Run(name+" bumps the version", func(t *testing.T) {
+			t.Parallel()
+
+			edited, err := edit()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if edited.Version() != 5 {
+				t.Errorf("expected version 5, got %d", edited.Version())
+			}
+		})
+	}
+
+	t.Run("activate keeps the version", func(t *testing.T) {
+		t.Parallel()
+
+		active, err := task.Activate()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if active.TaskStatus() != StatusActive || active.Version() != 4 {
+			t.Errorf("expected an active task at version 4, got %s at %d", active.TaskStatus(), active.Version())
+		}
+	})
+}
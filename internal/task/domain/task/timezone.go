@@ -0,0 +1,29 @@
+package task
+
+import "time"
+
+// LoadUserLocation loads the IANA timezone a user gave. time.LoadLocation maps "" to UTC
+// and "Local" to the server's own timezone; neither is a timezone of the user, so both are
+// rejected.
+func LoadUserLocation(timezone string) (*time.Location, bool) {
+	if timezone == "" || timezone == "Local" {
+		return nil, false
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, false
+	}
+
+	return location, true
+}
+
+// LoadUserLocationOrUTC is LoadUserLocation for options where leaving the timezone out
+// means UTC.
+func LoadUserLocationOrUTC(timezone string) (*time.Location, bool) {
+	if timezone == "" {
+		return time.UTC, true
+	}
+
+	return LoadUserLocation(timezone)
+}
@@ -0,0 +1,13 @@
+package task
+
+import "context"
+
+//go:generate mockgen -source=transactor.go -destination=mock_transactor.go -package=task
+
+// Transactor runs fn inside a single database transaction. Repository calls made with the
+// context handed to fn join that transaction, and it is rolled back when fn returns an error.
+// A nested call joins the outer transaction in a savepoint: its error only rolls back the
+// changes made within it, leaving the caller free to carry on with the outer transaction.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
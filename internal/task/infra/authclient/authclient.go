@@ -54,6 +54,11 @@ func (c *authClient) ValidateSession(ctx context.Context, sessionToken string) (
 		return "", ErrUnauthorized
 	}
 
+	cache := sessionCacheFrom(ctx)
+	if userID, ok := cache.lookup(sessionToken); ok {
+		return userID, nil
+	}
+
 	req := &authv1.ValidateSessionRequest{
 		SessionToken: sessionToken,
 	}
@@ -88,5 +93,7 @@ func (c *authClient) ValidateSession(ctx context.Context, sessionToken string) (
 		return "", ErrUnauthorized
 	}
 
+	cache.store(sessionToken, userID)
+
 	return userID, nil
 }
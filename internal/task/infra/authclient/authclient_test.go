@@ -0,0 +1,96 @@
+package authclient
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	authv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/auth/v1"
+	authv1connect "github.com/KasumiMercury/primind-central-backend/internal/gen/auth/v1/authv1connect"
+)
+
+type countingAuthService struct {
+	authv1connect.UnimplementedAuthServiceHandler
+
+	calls atomic.Int32
+}
+
+func (s *countingAuthService) ValidateSession(
+	_ context.Context,
+	req *authv1.ValidateSessionRequest,
+) (*authv1.ValidateSessionResponse, error) {
+	s.calls.Add(1)
+
+	return &authv1.ValidateSessionResponse{UserId: "user-of-" + req.GetSessionToken()}, nil
+}
+
+func TestValidateSessionReusesCachedSession(t *testing.T) {
+	service := &countingAuthService{}
+
+	_, handler := authv1connect.NewAuthServiceHandler(service)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewAuthClient(server.URL)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantCalls int32
+	}{
+		{
+			name:      "validated once per cached context",
+			ctx:       WithSessionCache(context.Background()),
+			wantCalls: 1,
+		},
+		{
+			name:      "validated every time without a cache",
+			ctx:       context.Background(),
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.calls.Store(0)
+
+			for range 2 {
+				userID, err := client.ValidateSession(tt.ctx, "token")
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if userID != "user-of-token" {
+					t.Errorf("expected user-of-token, got %q", userID)
+				}
+			}
+
+			if got := service.calls.Load(); got != tt.wantCalls {
+				t.Errorf("expected %d auth service calls, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+
+	t.Run("other tokens are validated separately", func(t *testing.T) {
+		service.calls.Store(0)
+
+		ctx := WithSessionCache(context.Background())
+
+		for _, token := range []string{"first", "second"} {
+			userID, err := client.ValidateSession(ctx, token)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if userID != "user-of-"+token {
+				t.Errorf("expected user-of-%s, got %q", token, userID)
+			}
+		}
+
+		if got := service.calls.Load(); got != 2 {
+			t.Errorf("expected 2 auth service calls, got %d", got)
+		}
+	})
+}
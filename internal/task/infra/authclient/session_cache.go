@@ -0,0 +1,53 @@
+package authclient
+
+import (
+	"context"
+	"sync"
+)
+
+type sessionCacheKey struct{}
+
+// sessionCache remembers the sessions validated while handling a single request.
+type sessionCache struct {
+	mu    sync.Mutex
+	users map[string]string
+}
+
+// WithSessionCache returns a context that remembers the sessions validated with it. A
+// request that validates its session more than once, such as in an interceptor and then in
+// its handler, reuses the user resolved the first time instead of asking the auth service
+// again. Failed validations are not remembered.
+func WithSessionCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionCacheKey{}, &sessionCache{mu: sync.Mutex{}, users: make(map[string]string)})
+}
+
+// sessionCacheFrom returns the cache of ctx, or nil when it has none.
+func sessionCacheFrom(ctx context.Context) *sessionCache {
+	cache, _ := ctx.Value(sessionCacheKey{}).(*sessionCache)
+
+	return cache
+}
+
+func (c *sessionCache) lookup(sessionToken string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	userID, ok := c.users[sessionToken]
+
+	return userID, ok
+}
+
+func (c *sessionCache) store(sessionToken, userID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.users[sessionToken] = userID
+}
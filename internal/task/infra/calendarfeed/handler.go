@@ -0,0 +1,72 @@
+package calendarfeed
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+)
+
+const (
+	// Pattern is the ServeMux pattern the feed handler is registered under.
+	Pattern = "GET " + pathPrefix + "{token}"
+
+	pathPrefix = "/calendar/v1/feeds/"
+	fileSuffix = ".ics"
+)
+
+// FeedPath returns the path of the feed readable with the token.
+func FeedPath(token string) string {
+	return pathPrefix + token + fileSuffix
+}
+
+type handler struct {
+	getCalendarFeed apptask.GetCalendarFeedUseCase
+	logger          *slog.Logger
+}
+
+// NewHandler serves the calendar feed of the user the token in the path belongs to.
+func NewHandler(getCalendarFeedUseCase apptask.GetCalendarFeedUseCase) http.Handler {
+	return &handler{
+		getCalendarFeed: getCalendarFeedUseCase,
+		logger:          slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("calendarfeed"),
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSuffix(r.PathValue("token"), fileSuffix)
+
+	feed, err := h.getCalendarFeed.GetCalendarFeed(r.Context(), &apptask.GetCalendarFeedRequest{FeedToken: token})
+	if err != nil {
+		if errors.Is(err, apptask.ErrCalendarFeedTokenNotFound) {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		h.logger.Error("failed to load calendar feed", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	var body bytes.Buffer
+	if err := WriteCalendar(&body, feed, time.Now()); err != nil {
+		h.logger.Error("failed to encode calendar feed", slog.String("error", err.Error()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := body.WriteTo(w); err != nil {
+		h.logger.Warn("failed to write calendar feed", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,74 @@
+package calendarfeed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+)
+
+type getCalendarFeedFunc func(ctx context.Context, req *apptask.GetCalendarFeedRequest) (*apptask.GetCalendarFeedResult, error)
+
+func (f getCalendarFeedFunc) GetCalendarFeed(ctx context.Context, req *apptask.GetCalendarFeedRequest) (*apptask.GetCalendarFeedResult, error) {
+	return f(ctx, req)
+}
+
+func serveFeed(t *testing.T, useCase apptask.GetCalendarFeedUseCase, path string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(Pattern, NewHandler(useCase))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("serves the feed of the token", func(t *testing.T) {
+		rec := serveFeed(t, getCalendarFeedFunc(func(_ context.Context, req *apptask.GetCalendarFeedRequest) (*apptask.GetCalendarFeedResult, error) {
+			if req.FeedToken != "feed-token" {
+				t.Errorf("expected the token without the file suffix, got %q", req.FeedToken)
+			}
+
+			return &apptask.GetCalendarFeedResult{Tasks: nil, CompletedTasks: nil}, nil
+		}), FeedPath("feed-token"))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", rec.Code)
+		}
+
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/calendar") {
+			t.Errorf("expected a calendar content type, got %q", ct)
+		}
+
+		if !strings.HasPrefix(rec.Body.String(), "BEGIN:VCALENDAR\r\n") {
+			t.Errorf("expected a calendar body, got %q", rec.Body.String())
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		rec := serveFeed(t, getCalendarFeedFunc(func(context.Context, *apptask.GetCalendarFeedRequest) (*apptask.GetCalendarFeedResult, error) {
+			return nil, apptask.ErrCalendarFeedTokenNotFound
+		}), FeedPath("revoked"))
+
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("load failure", func(t *testing.T) {
+		rec := serveFeed(t, getCalendarFeedFunc(func(context.Context, *apptask.GetCalendarFeedRequest) (*apptask.GetCalendarFeedResult, error) {
+			return nil, errors.New("db down")
+		}), FeedPath("feed-token"))
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected 500, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package calendarfeed
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+)
+
+const (
+	productID = "-//primind//primind-central-backend//EN"
+	uidDomain = "primind"
+
+	// maxLineOctets is the longest content line RFC 5545 allows, without the CRLF.
+	maxLineOctets = 75
+
+	dateTimeFormat = "20060102T150405Z"
+)
+
+// WriteCalendar writes the feed as an iCalendar object. Scheduled tasks are events
+// starting at their scheduled time; other tasks are to-dos due at their target time.
+// Completed tasks are completed to-dos.
+func WriteCalendar(w io.Writer, feed *apptask.GetCalendarFeedResult, now time.Time) error {
+	e := &encoder{w: w, err: nil}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", productID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	e.line("X-WR-CALNAME", "Primind")
+
+	stamp := formatTime(now)
+
+	for _, task := range feed.Tasks {
+		if task.ScheduledAt != nil {
+			e.line("BEGIN", "VEVENT")
+			e.common(task.TaskID, task.Title, task.Description, task.Tags, stamp)
+			e.line("DTSTART", formatTime(*task.ScheduledAt))
+			e.line("END", "VEVENT")
+
+			continue
+		}
+
+		e.line("BEGIN", "VTODO")
+		e.common(task.TaskID, task.Title, task.Description, task.Tags, stamp)
+		e.line("DUE", formatTime(task.TargetAt))
+		e.line("STATUS", "NEEDS-ACTION")
+		e.line("END", "VTODO")
+	}
+
+	for _, task := range feed.CompletedTasks {
+		e.line("BEGIN", "VTODO")
+		e.common(task.TaskID, task.Title, task.Description, task.Tags, stamp)
+
+		if task.ScheduledAt != nil {
+			e.line("DTSTART", formatTime(*task.ScheduledAt))
+		} else {
+			e.line("DUE", formatTime(task.TargetAt))
+		}
+
+		e.line("STATUS", "COMPLETED")
+		e.line("COMPLETED", formatTime(task.CompletedAt))
+		e.line("END", "VTODO")
+	}
+
+	e.line("END", "VCALENDAR")
+
+	return e.err
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) common(taskID, title, description string, tags []apptask.TagItem, stamp string) {
+	e.line("UID", taskID+"@"+uidDomain)
+	e.line("DTSTAMP", stamp)
+	e.line("SUMMARY", escapeText(title))
+
+	if description != "" {
+		e.line("DESCRIPTION", escapeText(description))
+	}
+
+	if len(tags) > 0 {
+		names := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			names = append(names, escapeText(tag.Name))
+		}
+
+		e.line("CATEGORIES", strings.Join(names, ","))
+	}
+}
+
+// line writes a content line, folded so that no line is longer than maxLineOctets.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	_, e.err = io.WriteString(e.w, fold(name+":"+value)+"\r\n")
+}
+
+// fold splits a content line into lines of at most maxLineOctets octets, continuing each
+// with a space. It never splits a UTF-8 sequence.
+func fold(line string) string {
+	if len(line) <= maxLineOctets {
+		return line
+	}
+
+	var b strings.Builder
+
+	limit := maxLineOctets
+	size := 0
+
+	for _, r := range line {
+		n := len(string(r))
+		if size+n > limit {
+			b.WriteString("\r\n ")
+
+			// the leading space counts towards the continuation line
+			limit = maxLineOctets - 1
+			size = 0
+		}
+
+		b.WriteRune(r)
+
+		size += n
+	}
+
+	return b.String()
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
@@ -0,0 +1,112 @@
+package calendarfeed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+)
+
+func TestWriteCalendar(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	scheduledAt := time.Date(2026, 10, 18, 10, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	feed := &apptask.GetCalendarFeedResult{
+		Tasks: []apptask.TaskItem{
+			{
+				TaskID:      "scheduled",
+				Title:       "Dentist",
+				TaskType:    domaintask.TypeScheduled,
+				ScheduledAt: &scheduledAt,
+				TargetAt:    scheduledAt,
+			},
+			{
+				TaskID:      "near",
+				Title:       "Buy milk, eggs; bread",
+				TaskType:    domaintask.TypeNear,
+				Description: "line one\nline two",
+				TargetAt:    now.Add(time.Hour),
+				Tags:        []apptask.TagItem{{Name: "errands"}, {Name: "home"}},
+			},
+		},
+		CompletedTasks: []apptask.CompletedTaskItem{
+			{
+				TaskID:      "done",
+				Title:       "Pay rent",
+				TaskType:    domaintask.TypeRelaxed,
+				TargetAt:    now.Add(-time.Hour),
+				CompletedAt: now.Add(-2 * time.Hour),
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := WriteCalendar(&b, feed, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := b.String()
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + productID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Primind",
+		"BEGIN:VEVENT",
+		"UID:scheduled@primind",
+		"DTSTAMP:20261017T090000Z",
+		"SUMMARY:Dentist",
+		"DTSTART:20261018T013000Z",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"UID:near@primind",
+		"DTSTAMP:20261017T090000Z",
+		`SUMMARY:Buy milk\, eggs\; bread`,
+		`DESCRIPTION:line one\nline two`,
+		"CATEGORIES:errands,home",
+		"DUE:20261017T100000Z",
+		"STATUS:NEEDS-ACTION",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"UID:done@primind",
+		"DTSTAMP:20261017T090000Z",
+		"SUMMARY:Pay rent",
+		"DUE:20261017T080000Z",
+		"STATUS:COMPLETED",
+		"COMPLETED:20261017T070000Z",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n") + "\r\n"
+
+	if got != want {
+		t.Fatalf("unexpected calendar:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFold(t *testing.T) {
+	t.Run("short lines are kept", func(t *testing.T) {
+		if got := fold("SUMMARY:short"); got != "SUMMARY:short" {
+			t.Fatalf("expected the line unchanged, got %q", got)
+		}
+	})
+
+	t.Run("long lines are folded within the octet limit", func(t *testing.T) {
+		line := "SUMMARY:" + strings.Repeat("あ", 60)
+
+		folded := fold(line)
+
+		for _, part := range strings.Split(folded, "\r\n") {
+			if len(part) > maxLineOctets {
+				t.Errorf("line of %d octets exceeds the limit: %q", len(part), part)
+			}
+		}
+
+		if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != line {
+			t.Fatalf("expected unfolding to restore the line, got %q", unfolded)
+		}
+	})
+}
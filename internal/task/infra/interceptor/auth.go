@@ -2,9 +2,11 @@ package interceptor
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
 	connect "connectrpc.com/connect"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
 )
 
 type contextKey string
@@ -15,30 +17,64 @@ const (
 )
 const sessionTokenKey contextKey = "session_token"
 
-func AuthInterceptor() connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if req.Spec().IsClient {
-				return next(ctx, req)
-			}
+// AuthInterceptor stores the session token of incoming unary and streaming requests in the
+// context. Unary requests also get a session cache, so that interceptors checking the
+// session ahead of the handler do not cost another call to the auth service. Outgoing
+// client calls pass through untouched.
+func AuthInterceptor() connect.Interceptor {
+	return &authInterceptor{}
+}
 
-			rawToken := strings.TrimSpace(req.Header().Get(tokenHeader))
-			if rawToken == "" {
-				return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenHeaderNotFound)
-			}
+type authInterceptor struct{}
 
-			token := rawToken
-			if len(rawToken) >= len(bearerPrefix) && strings.EqualFold(rawToken[:len(bearerPrefix)], bearerPrefix) {
-				token = strings.TrimSpace(rawToken[len(bearerPrefix):])
-			}
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
 
-			ctx = context.WithValue(ctx, sessionTokenKey, token)
+		token, err := sessionTokenFromHeader(req.Header())
+		if err != nil {
+			return nil, err
+		}
 
-			return next(ctx, req)
+		ctx = authclient.WithSessionCache(context.WithValue(ctx, sessionTokenKey, token))
+
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		token, err := sessionTokenFromHeader(conn.RequestHeader())
+		if err != nil {
+			return err
 		}
+
+		ctx = context.WithValue(ctx, sessionTokenKey, token)
+
+		return next(ctx, conn)
 	}
 }
 
+func sessionTokenFromHeader(header http.Header) (string, error) {
+	rawToken := strings.TrimSpace(header.Get(tokenHeader))
+	if rawToken == "" {
+		return "", connect.NewError(connect.CodeUnauthenticated, ErrTokenHeaderNotFound)
+	}
+
+	token := rawToken
+	if len(rawToken) >= len(bearerPrefix) && strings.EqualFold(rawToken[:len(bearerPrefix)], bearerPrefix) {
+		token = strings.TrimSpace(rawToken[len(bearerPrefix):])
+	}
+
+	return token, nil
+}
+
 func ExtractSessionToken(ctx context.Context) string {
 	token, ok := ctx.Value(sessionTokenKey).(string)
 	if !ok {
@@ -3,6 +3,7 @@ package interceptor
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	connect "connectrpc.com/connect"
@@ -57,7 +58,7 @@ func TestAuthInterceptorSuccess(t *testing.T) {
 				return connect.NewResponse(&struct{}{}), nil
 			}
 
-			if _, err := interceptor(next)(context.Background(), req); err != nil {
+			if _, err := interceptor.WrapUnary(next)(context.Background(), req); err != nil {
 				t.Fatalf("AuthInterceptor returned error: %v", err)
 			}
 
@@ -118,7 +119,7 @@ func TestAuthInterceptorError(t *testing.T) {
 			}
 
 			if tt.callNext {
-				if _, err := interceptor(next)(context.Background(), req); !errors.Is(err, errNext) {
+				if _, err := interceptor.WrapUnary(next)(context.Background(), req); !errors.Is(err, errNext) {
 					t.Fatalf("expected error %v, got %v", errNext, err)
 				}
 
@@ -126,10 +127,77 @@ func TestAuthInterceptorError(t *testing.T) {
 					t.Fatalf("next handler was not called")
 				}
 			} else {
-				if _, err := interceptor(next)(context.Background(), req); !errors.Is(err, ErrTokenHeaderNotFound) {
+				if _, err := interceptor.WrapUnary(next)(context.Background(), req); !errors.Is(err, ErrTokenHeaderNotFound) {
 					t.Fatalf("expected error %v, got %v", ErrTokenHeaderNotFound, err)
 				}
 			}
 		})
 	}
 }
+
+type fakeStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+
+	header http.Header
+}
+
+func (c *fakeStreamingHandlerConn) RequestHeader() http.Header {
+	return c.header
+}
+
+func TestAuthInterceptorStreamingHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		headerValue   string
+		expectedToken string
+		expectedErr   error
+	}{
+		{
+			name:          "with bearer prefix",
+			headerValue:   "Bearer stream-token",
+			expectedToken: "stream-token",
+			expectedErr:   nil,
+		},
+		{
+			name:          "without token",
+			headerValue:   "",
+			expectedToken: "",
+			expectedErr:   ErrTokenHeaderNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &fakeStreamingHandlerConn{header: http.Header{}}
+			if tt.headerValue != "" {
+				conn.header.Set(tokenHeader, tt.headerValue)
+			}
+
+			var called bool
+
+			next := func(ctx context.Context, _ connect.StreamingHandlerConn) error {
+				called = true
+
+				if got := ExtractSessionToken(ctx); got != tt.expectedToken {
+					t.Fatalf("ExtractSessionToken() = %q, want %q", got, tt.expectedToken)
+				}
+
+				return nil
+			}
+
+			err := AuthInterceptor().WrapStreamingHandler(next)(context.Background(), conn)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+
+			if called != (tt.expectedErr == nil) {
+				t.Fatalf("next handler called = %v", called)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+)
+
+type Config struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long a claimed message is hidden from other dispatchers
+	Lease time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		PollInterval: time.Second,
+		BatchSize:    50,
+		MaxAttempts:  10,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   5 * time.Minute,
+		Lease:        time.Minute,
+	}
+}
+
+// Dispatcher drains the remind outbox into the remind queues.
+type Dispatcher struct {
+	store         Store
+	registerQueue remindregister.Queue
+	cancelQueue   remindcancel.Queue
+	cfg           Config
+	now           func() time.Time
+	logger        *slog.Logger
+}
+
+func NewDispatcher(
+	store Store,
+	registerQueue remindregister.Queue,
+	cancelQueue remindcancel.Queue,
+	cfg Config,
+) *Dispatcher {
+	return &Dispatcher{
+		store:         store,
+		registerQueue: registerQueue,
+		cancelQueue:   cancelQueue,
+		cfg:           cfg,
+		now:           time.Now,
+		logger:        slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("outbox"),
+	}
+}
+
+// Run dispatches due messages every poll interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ctx = logging.WithModule(ctx, logging.Module("task"))
+
+	d.logger.Info("remind outbox dispatcher started", slog.Duration("poll_interval", d.cfg.PollInterval))
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("remind outbox dispatcher stopped")
+
+			return
+		case <-ticker.C:
+			// Keep draining while full batches come back
+			for {
+				claimed, err := d.DispatchOnce(ctx)
+				if err != nil {
+					d.logger.Error("failed to dispatch remind outbox", slog.String("error", err.Error()))
+
+					break
+				}
+
+				if claimed < d.cfg.BatchSize || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// DispatchOnce claims one batch of due messages and sends them. It returns the number of
+// messages claimed.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	now := d.now().UTC()
+
+	messages, err := d.store.ClaimDue(ctx, now, d.cfg.Lease, d.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+
+	for _, msg := range messages {
+		if err := d.send(ctx, msg); err != nil {
+			d.handleFailure(ctx, msg, err)
+
+			continue
+		}
+
+		if err := d.store.MarkDelivered(ctx, msg.ID); err != nil {
+			// Delivery is at-least-once: the message is sent again once its lease expires
+			d.logger.Error("failed to mark outbox message delivered",
+				slog.Int64("message_id", msg.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return len(messages), nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, msg Message) error {
+	switch msg.Kind {
+	case KindRemindRegister:
+		var req remindregister.CreateRemindRequest
+		if err := json.Unmarshal(msg.Payload, &req); err != nil {
+			return fmt.Errorf("failed to decode remind register payload: %w", err)
+		}
+
+		_, err := d.registerQueue.RegisterRemind(ctx, &req)
+
+		return err
+	case KindRemindCancel:
+		var req remindcancel.CancelRemindRequest
+		if err := json.Unmarshal(msg.Payload, &req); err != nil {
+			return fmt.Errorf("failed to decode remind cancel payload: %w", err)
+		}
+
+		_, err := d.cancelQueue.CancelRemind(ctx, &req)
+
+		return err
+	default:
+		return fmt.Errorf("unknown outbox message kind: %s", msg.Kind)
+	}
+}
+
+func (d *Dispatcher) handleFailure(ctx context.Context, msg Message, sendErr error) {
+	attempts := msg.Attempts + 1
+	now := d.now().UTC()
+
+	logger := d.logger.With(
+		slog.Int64("message_id", msg.ID),
+		slog.String("kind", string(msg.Kind)),
+		slog.String("task_id", msg.TaskID),
+		slog.Int("attempts", attempts),
+	)
+
+	if attempts >= d.cfg.MaxAttempts {
+		logger.Error("outbox message exhausted retries", slog.String("error", sendErr.Error()))
+
+		if err := d.store.MarkDead(ctx, msg.ID, attempts, now, sendErr.Error()); err != nil {
+			logger.Error("failed to mark outbox message dead", slog.String("error", err.Error()))
+		}
+
+		return
+	}
+
+	nextAttemptAt := now.Add(d.backoff(attempts))
+
+	logger.Warn("outbox message delivery failed, will retry",
+		slog.Time("next_attempt_at", nextAttemptAt),
+		slog.String("error", sendErr.Error()),
+	)
+
+	if err := d.store.MarkRetry(ctx, msg.ID, attempts, nextAttemptAt, sendErr.Error()); err != nil {
+		logger.Error("failed to schedule outbox message retry", slog.String("error", err.Error()))
+	}
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff := d.cfg.BaseBackoff
+	for i := 1; i < attempts && backoff < d.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, d.cfg.MaxBackoff)
+}
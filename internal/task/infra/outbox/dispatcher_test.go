@@ -0,0 +1,185 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestDispatcher(t *testing.T, now time.Time) (*Dispatcher, *MockStore, *remindregister.MockQueue, *remindcancel.MockQueue) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := NewMockStore(ctrl)
+	registerQueue := remindregister.NewMockQueue(ctrl)
+	cancelQueue := remindcancel.NewMockQueue(ctrl)
+
+	cfg := DefaultConfig()
+	cfg.MaxAttempts = 3
+
+	dispatcher := NewDispatcher(store, registerQueue, cancelQueue, cfg)
+	dispatcher.now = func() time.Time { return now }
+
+	return dispatcher, store, registerQueue, cancelQueue
+}
+
+func mustPayload(t *testing.T, v any) []byte {
+	t.Helper()
+
+	payload, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal payload: %v", err)
+	}
+
+	return payload
+}
+
+func TestDispatchOnceDeliversMessages(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	dispatcher, store, registerQueue, cancelQueue := newTestDispatcher(t, now)
+
+	registerReq := remindregister.CreateRemindRequest{
+		Times:    []time.Time{now.Add(time.Hour)},
+		UserID:   "user-1",
+		TaskID:   "task-1",
+		TaskType: "NORMAL",
+		Color:    "#FFFFFF",
+	}
+	cancelReq := remindcancel.CancelRemindRequest{TaskID: "task-2", UserID: "user-1"}
+
+	gomock.InOrder(
+		store.EXPECT().
+			ClaimDue(gomock.Any(), now, dispatcher.cfg.Lease, dispatcher.cfg.BatchSize).
+			Return([]Message{
+				{ID: 1, Kind: KindRemindCancel, TaskID: "task-2", Payload: mustPayload(t, cancelReq)},
+				{ID: 2, Kind: KindRemindRegister, TaskID: "task-1", Payload: mustPayload(t, registerReq)},
+			}, nil),
+		cancelQueue.EXPECT().
+			CancelRemind(gomock.Any(), &cancelReq).
+			Return(&remindcancel.CancelRemindResponse{Name: "cancel"}, nil),
+		store.EXPECT().MarkDelivered(gomock.Any(), int64(1)).Return(nil),
+		registerQueue.EXPECT().
+			RegisterRemind(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+				if req.TaskID != registerReq.TaskID {
+					t.Fatalf("expected task id %s, got %s", registerReq.TaskID, req.TaskID)
+				}
+
+				if len(req.Times) != 1 || !req.Times[0].Equal(registerReq.Times[0]) {
+					t.Fatalf("expected times %v, got %v", registerReq.Times, req.Times)
+				}
+
+				return &remindregister.RemindResponse{Name: "register"}, nil
+			}),
+		store.EXPECT().MarkDelivered(gomock.Any(), int64(2)).Return(nil),
+	)
+
+	claimed, err := dispatcher.DispatchOnce(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if claimed != 2 {
+		t.Fatalf("expected 2 claimed messages, got %d", claimed)
+	}
+}
+
+func TestDispatchOnceSchedulesRetryWithBackoff(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	dispatcher, store, _, cancelQueue := newTestDispatcher(t, now)
+
+	cancelReq := remindcancel.CancelRemindRequest{TaskID: "task-1", UserID: "user-1"}
+
+	store.EXPECT().
+		ClaimDue(gomock.Any(), now, gomock.Any(), gomock.Any()).
+		Return([]Message{{ID: 1, Kind: KindRemindCancel, TaskID: "task-1", Payload: mustPayload(t, cancelReq), Attempts: 1}}, nil)
+	cancelQueue.EXPECT().
+		CancelRemind(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("queue unavailable"))
+	store.EXPECT().
+		MarkRetry(gomock.Any(), int64(1), 2, now.Add(2*time.Second), "queue unavailable").
+		Return(nil)
+
+	if _, err := dispatcher.DispatchOnce(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestDispatchOnceMarksDeadAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	dispatcher, store, registerQueue, _ := newTestDispatcher(t, now)
+
+	registerReq := remindregister.CreateRemindRequest{TaskID: "task-1", UserID: "user-1"}
+
+	store.EXPECT().
+		ClaimDue(gomock.Any(), now, gomock.Any(), gomock.Any()).
+		Return([]Message{{ID: 1, Kind: KindRemindRegister, TaskID: "task-1", Payload: mustPayload(t, registerReq), Attempts: 2}}, nil)
+	registerQueue.EXPECT().
+		RegisterRemind(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("queue unavailable"))
+	store.EXPECT().
+		MarkDead(gomock.Any(), int64(1), 3, now, "queue unavailable").
+		Return(nil)
+
+	if _, err := dispatcher.DispatchOnce(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestDispatchOnceUnknownKindIsRetried(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	dispatcher, store, _, _ := newTestDispatcher(t, now)
+
+	store.EXPECT().
+		ClaimDue(gomock.Any(), now, gomock.Any(), gomock.Any()).
+		Return([]Message{{ID: 1, Kind: Kind("unknown"), TaskID: "task-1", Payload: []byte(`{}`)}}, nil)
+	store.EXPECT().
+		MarkRetry(gomock.Any(), int64(1), 1, now.Add(time.Second), gomock.Any()).
+		Return(nil)
+
+	if _, err := dispatcher.DispatchOnce(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestDispatchOnceClaimError(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	dispatcher, store, _, _ := newTestDispatcher(t, now)
+
+	store.EXPECT().
+		ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("db down"))
+
+	if _, err := dispatcher.DispatchOnce(context.Background()); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil, nil, Config{BaseBackoff: time.Second, MaxBackoff: 10 * time.Second})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 30, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := dispatcher.backoff(tt.attempts); got != tt.want {
+			t.Fatalf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
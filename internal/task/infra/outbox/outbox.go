@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=outbox.go -destination=mock_store.go -package=outbox
+
+type Kind string
+
+const (
+	KindRemindRegister Kind = "remind.register"
+	KindRemindCancel   Kind = "remind.cancel"
+)
+
+// Message is a pending remind queue request recorded in the same transaction as the task
+// change that produced it. Payload holds the JSON encoded queue request.
+type Message struct {
+	ID       int64
+	Kind     Kind
+	TaskID   string
+	Payload  []byte
+	Attempts int
+}
+
+type Store interface {
+	// ClaimDue leases up to limit messages that are due at now, pushing their next attempt out
+	// by lease so that other dispatchers skip them. Only the oldest pending message of each
+	// task is returned so that a cancel and the register that follows it keep their order.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error
+	MarkDead(ctx context.Context, id int64, attempts int, failedAt time.Time, lastError string) error
+}
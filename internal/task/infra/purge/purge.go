@@ -0,0 +1,15 @@
+package purge
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=purge.go -destination=mock_store.go -package=purge
+
+type Store interface {
+	// PurgeDeletedTasks permanently removes up to limit tasks that were moved to the trash
+	// before deletedBefore, along with their checklist items and tags. It returns how many
+	// tasks it removed. Tasks another replica is purging at the same time are skipped.
+	PurgeDeletedTasks(ctx context.Context, deletedBefore time.Time, limit int) (int, error)
+}
@@ -0,0 +1,139 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/KasumiMercury/primind-central-backend/internal/task/infra/purge"
+
+type Config struct {
+	Interval time.Duration
+	// Retention is how long a deleted task stays in the trash before it is purged
+	Retention time.Duration
+	BatchSize int
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval:  time.Hour,
+		Retention: 30 * 24 * time.Hour,
+		BatchSize: 100,
+	}
+}
+
+// Purger permanently removes tasks that have been in the trash for longer than the
+// retention period.
+type Purger struct {
+	store   Store
+	cfg     Config
+	now     func() time.Time
+	logger  *slog.Logger
+	metrics *purgerMetrics
+}
+
+type purgerMetrics struct {
+	runs   metric.Int64Counter
+	purged metric.Int64Counter
+}
+
+func NewPurger(store Store, cfg Config) (*Purger, error) {
+	m, err := newPurgerMetrics(otel.Meter(meterName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Purger{
+		store:   store,
+		cfg:     cfg,
+		now:     time.Now,
+		logger:  slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("purge"),
+		metrics: m,
+	}, nil
+}
+
+func newPurgerMetrics(meter metric.Meter) (*purgerMetrics, error) {
+	runs, err := meter.Int64Counter("task.trash.purge.runs",
+		metric.WithDescription("Purge passes over the task trash, by result"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purge runs counter: %w", err)
+	}
+
+	purged, err := meter.Int64Counter("task.trash.purged",
+		metric.WithDescription("Deleted tasks permanently removed after the retention period"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purged tasks counter: %w", err)
+	}
+
+	return &purgerMetrics{runs: runs, purged: purged}, nil
+}
+
+// Run purges expired tasks every interval until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	ctx = logging.WithModule(ctx, logging.Module("task"))
+
+	p.logger.Info("trash purger started",
+		slog.Duration("interval", p.cfg.Interval),
+		slog.Duration("retention", p.cfg.Retention),
+	)
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logger.Info("trash purger stopped")
+
+			return
+		case <-ticker.C:
+			if _, err := p.PurgeOnce(ctx); err != nil {
+				p.logger.Error("failed to purge trash", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// PurgeOnce removes every task whose retention period has ended, one batch per transaction,
+// and returns how many it removed.
+func (p *Purger) PurgeOnce(ctx context.Context) (int, error) {
+	deletedBefore := p.now().UTC().Add(-p.cfg.Retention)
+	total := 0
+
+	for {
+		purged, err := p.store.PurgeDeletedTasks(ctx, deletedBefore, p.cfg.BatchSize)
+		if err != nil {
+			p.metrics.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "error")))
+
+			return total, fmt.Errorf("failed to purge deleted tasks: %w", err)
+		}
+
+		total += purged
+		p.metrics.purged.Add(ctx, int64(purged))
+
+		// A short batch means nothing expired is left
+		if purged < p.cfg.BatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	p.metrics.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "completed")))
+
+	if total > 0 {
+		p.logger.Info("trash purged",
+			slog.Int("purged", total),
+			slog.Time("deleted_before", deletedBefore),
+		)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,74 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newTestPurger(t *testing.T, now time.Time) (*Purger, *MockStore) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := NewMockStore(ctrl)
+
+	cfg := DefaultConfig()
+	cfg.BatchSize = 2
+
+	purger, err := NewPurger(store, cfg)
+	if err != nil {
+		t.Fatalf("failed to create purger: %v", err)
+	}
+
+	purger.now = func() time.Time { return now }
+
+	return purger, store
+}
+
+func TestPurgeOnceDrainsExpiredTasks(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	purger, store := newTestPurger(t, now)
+
+	deletedBefore := now.Add(-purger.cfg.Retention)
+
+	gomock.InOrder(
+		store.EXPECT().PurgeDeletedTasks(gomock.Any(), deletedBefore, 2).Return(2, nil),
+		store.EXPECT().PurgeDeletedTasks(gomock.Any(), deletedBefore, 2).Return(2, nil),
+		store.EXPECT().PurgeDeletedTasks(gomock.Any(), deletedBefore, 2).Return(1, nil),
+	)
+
+	purged, err := purger.PurgeOnce(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if purged != 5 {
+		t.Fatalf("expected 5 purged tasks, got %d", purged)
+	}
+}
+
+func TestPurgeOnceStoreError(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	purger, store := newTestPurger(t, now)
+
+	errStore := errors.New("db down")
+
+	gomock.InOrder(
+		store.EXPECT().PurgeDeletedTasks(gomock.Any(), gomock.Any(), gomock.Any()).Return(2, nil),
+		store.EXPECT().PurgeDeletedTasks(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errStore),
+	)
+
+	purged, err := purger.PurgeOnce(context.Background())
+	if !errors.Is(err, errStore) {
+		t.Fatalf("expected store error, got %v", err)
+	}
+
+	if purged != 2 {
+		t.Fatalf("expected the first batch to be counted, got %d", purged)
+	}
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reconcile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reconcile.go -destination=mock_store.go -package=reconcile
+//
+
+// Package reconcile is a generated GoMock package.
+package reconcile
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// ListStalePending mocks base method.
+func (m *MockStore) ListStalePending(ctx context.Context, updatedBefore time.Time, limit int) ([]PendingTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStalePending", ctx, updatedBefore, limit)
+	ret0, _ := ret[0].([]PendingTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStalePending indicates an expected call of ListStalePending.
+func (mr *MockStoreMockRecorder) ListStalePending(ctx, updatedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStalePending", reflect.TypeOf((*MockStore)(nil).ListStalePending), ctx, updatedBefore, limit)
+}
+
+// MarkActive mocks base method.
+func (m *MockStore) MarkActive(ctx context.Context, taskID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkActive", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkActive indicates an expected call of MarkActive.
+func (mr *MockStoreMockRecorder) MarkActive(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockStore)(nil).MarkActive), ctx, taskID)
+}
+
+// MarkReminderFailed mocks base method.
+func (m *MockStore) MarkReminderFailed(ctx context.Context, taskID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderFailed", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReminderFailed indicates an expected call of MarkReminderFailed.
+func (mr *MockStoreMockRecorder) MarkReminderFailed(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderFailed", reflect.TypeOf((*MockStore)(nil).MarkReminderFailed), ctx, taskID)
+}
+
+// RequeueRegister mocks base method.
+func (m *MockStore) RequeueRegister(ctx context.Context, taskID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueRegister", ctx, taskID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueRegister indicates an expected call of RequeueRegister.
+func (mr *MockStoreMockRecorder) RequeueRegister(ctx, taskID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueRegister", reflect.TypeOf((*MockStore)(nil).RequeueRegister), ctx, taskID, now)
+}
+
+// WithLock mocks base method.
+func (m *MockStore) WithLock(ctx context.Context, fn func(context.Context) error) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithLock", ctx, fn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithLock indicates an expected call of WithLock.
+func (mr *MockStoreMockRecorder) WithLock(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithLock", reflect.TypeOf((*MockStore)(nil).WithLock), ctx, fn)
+}
@@ -0,0 +1,41 @@
+package reconcile
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=reconcile.go -destination=mock_store.go -package=reconcile
+
+// RegisterState describes the remind outbox state of a stale task's register request.
+type RegisterState string
+
+const (
+	// RegisterStateNone means no register request was ever recorded for the task
+	RegisterStateNone RegisterState = "none"
+	// RegisterStatePending means a register request is still waiting to be delivered
+	RegisterStatePending RegisterState = "pending"
+	// RegisterStateDead means the register request, or a cancel request ahead of it,
+	// exhausted its delivery attempts
+	RegisterStateDead RegisterState = "dead"
+)
+
+// PendingTask is a task that has stayed in pending_reminders past the stale threshold.
+type PendingTask struct {
+	TaskID        string
+	UserID        string
+	TargetAt      time.Time
+	RegisterState RegisterState
+}
+
+type Store interface {
+	// WithLock runs fn in a transaction holding the reconciler lock. It reports false without
+	// calling fn when another replica holds the lock.
+	WithLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+	ListStalePending(ctx context.Context, updatedBefore time.Time, limit int) ([]PendingTask, error)
+	// RequeueRegister makes the task's latest dead register request deliverable again, along
+	// with any dead cancel request that would hold it back.
+	RequeueRegister(ctx context.Context, taskID string, now time.Time) error
+	MarkActive(ctx context.Context, taskID string) error
+	MarkReminderFailed(ctx context.Context, taskID string) error
+}
@@ -0,0 +1,241 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/KasumiMercury/primind-central-backend/internal/task/infra/reconcile"
+
+type Config struct {
+	Interval time.Duration
+	// StaleAfter is how long a task may stay in pending_reminders before it is reconciled
+	StaleAfter time.Duration
+	BatchSize  int
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval:   time.Minute,
+		StaleAfter: 5 * time.Minute,
+		BatchSize:  100,
+	}
+}
+
+type Outcome string
+
+const (
+	// OutcomeActivated means a register request was still in flight, so only the status was stuck
+	OutcomeActivated Outcome = "activated"
+	// OutcomeReregistered means a dead register request was queued for delivery again
+	OutcomeReregistered Outcome = "reregistered"
+	// OutcomeFailed means the reminders cannot be registered and the task was marked reminder_failed
+	OutcomeFailed Outcome = "failed"
+)
+
+// Result summarizes one reconciliation pass.
+type Result struct {
+	// Skipped is true when another replica held the lock
+	Skipped  bool
+	Stale    int
+	Outcomes map[Outcome]int
+}
+
+// Reconciler resolves tasks left in pending_reminders, either by getting their reminders
+// registered again or by marking them reminder_failed.
+type Reconciler struct {
+	store   Store
+	cfg     Config
+	now     func() time.Time
+	logger  *slog.Logger
+	metrics *reconcilerMetrics
+}
+
+type reconcilerMetrics struct {
+	runs  metric.Int64Counter
+	tasks metric.Int64Counter
+	stale metric.Int64Gauge
+}
+
+func NewReconciler(store Store, cfg Config) (*Reconciler, error) {
+	m, err := newReconcilerMetrics(otel.Meter(meterName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reconciler{
+		store:   store,
+		cfg:     cfg,
+		now:     time.Now,
+		logger:  slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("reconcile"),
+		metrics: m,
+	}, nil
+}
+
+func newReconcilerMetrics(meter metric.Meter) (*reconcilerMetrics, error) {
+	runs, err := meter.Int64Counter("task.pending_reminders.reconcile.runs",
+		metric.WithDescription("Reconciliation passes over tasks stuck in pending_reminders, by result"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reconcile runs counter: %w", err)
+	}
+
+	tasks, err := meter.Int64Counter("task.pending_reminders.reconcile.tasks",
+		metric.WithDescription("Tasks resolved by the pending_reminders reconciler, by outcome"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reconcile tasks counter: %w", err)
+	}
+
+	stale, err := meter.Int64Gauge("task.pending_reminders.stale",
+		metric.WithDescription("Stale pending_reminders tasks found by the last reconciliation pass"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stale tasks gauge: %w", err)
+	}
+
+	return &reconcilerMetrics{runs: runs, tasks: tasks, stale: stale}, nil
+}
+
+// Run reconciles stale tasks every interval until ctx is cancelled.
+func (r *Reconciler) Run(ctx context.Context) {
+	ctx = logging.WithModule(ctx, logging.Module("task"))
+
+	r.logger.Info("pending reminders reconciler started",
+		slog.Duration("interval", r.cfg.Interval),
+		slog.Duration("stale_after", r.cfg.StaleAfter),
+	)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("pending reminders reconciler stopped")
+
+			return
+		case <-ticker.C:
+			if _, err := r.ReconcileOnce(ctx); err != nil {
+				r.logger.Error("failed to reconcile pending reminders", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// ReconcileOnce resolves one batch of stale tasks. The batch is handled in a single
+// transaction, so either every decision in it is applied or none is.
+func (r *Reconciler) ReconcileOnce(ctx context.Context) (*Result, error) {
+	now := r.now().UTC()
+	result := &Result{Skipped: false, Stale: 0, Outcomes: map[Outcome]int{}}
+
+	acquired, err := r.store.WithLock(ctx, func(ctx context.Context) error {
+		tasks, err := r.store.ListStalePending(ctx, now.Add(-r.cfg.StaleAfter), r.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list stale pending tasks: %w", err)
+		}
+
+		result.Stale = len(tasks)
+
+		for _, task := range tasks {
+			outcome, err := r.resolve(ctx, task, now)
+			if err != nil {
+				return fmt.Errorf("failed to reconcile task %s: %w", task.TaskID, err)
+			}
+
+			result.Outcomes[outcome]++
+		}
+
+		return nil
+	})
+	if err != nil {
+		r.metrics.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "error")))
+
+		return nil, err
+	}
+
+	if !acquired {
+		r.metrics.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "skipped")))
+		r.logger.Debug("pending reminders reconcile skipped: lock held by another instance")
+
+		return &Result{Skipped: true, Stale: 0, Outcomes: map[Outcome]int{}}, nil
+	}
+
+	r.metrics.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "completed")))
+	r.metrics.stale.Record(ctx, int64(result.Stale))
+
+	for outcome, count := range result.Outcomes {
+		r.metrics.tasks.Add(ctx, int64(count), metric.WithAttributes(attribute.String("outcome", string(outcome))))
+	}
+
+	if result.Stale > 0 {
+		r.logger.Info("pending reminders reconciled",
+			slog.Int("stale", result.Stale),
+			slog.Int(string(OutcomeActivated), result.Outcomes[OutcomeActivated]),
+			slog.Int(string(OutcomeReregistered), result.Outcomes[OutcomeReregistered]),
+			slog.Int(string(OutcomeFailed), result.Outcomes[OutcomeFailed]),
+		)
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) resolve(ctx context.Context, task PendingTask, now time.Time) (Outcome, error) {
+	logger := r.logger.With(
+		slog.String("task_id", task.TaskID),
+		slog.String("register_state", string(task.RegisterState)),
+	)
+
+	outcome := decide(task, now)
+
+	switch outcome {
+	case OutcomeActivated:
+		if err := r.store.MarkActive(ctx, task.TaskID); err != nil {
+			return "", err
+		}
+
+		logger.Info("stale pending task activated: register request still in flight")
+	case OutcomeReregistered:
+		if err := r.store.RequeueRegister(ctx, task.TaskID, now); err != nil {
+			return "", err
+		}
+
+		if err := r.store.MarkActive(ctx, task.TaskID); err != nil {
+			return "", err
+		}
+
+		logger.Info("stale pending task reminders registered again")
+	case OutcomeFailed:
+		if err := r.store.MarkReminderFailed(ctx, task.TaskID); err != nil {
+			return "", err
+		}
+
+		logger.Warn("stale pending task marked reminder_failed", slog.Time("target_at", task.TargetAt))
+	}
+
+	return outcome, nil
+}
+
+// decide picks how to resolve a stale task. A register request can only be replayed from the
+// outbox; the task service cannot rebuild one on its own because the device tokens it needs
+// are only reachable with the user's session.
+func decide(task PendingTask, now time.Time) Outcome {
+	switch task.RegisterState {
+	case RegisterStatePending:
+		return OutcomeActivated
+	case RegisterStateDead:
+		if task.TargetAt.After(now) {
+			return OutcomeReregistered
+		}
+	case RegisterStateNone:
+	}
+
+	return OutcomeFailed
+}
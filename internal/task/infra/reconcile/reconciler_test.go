@@ -0,0 +1,138 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newTestReconciler(t *testing.T, now time.Time) (*Reconciler, *MockStore) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	store := NewMockStore(ctrl)
+
+	reconciler, err := NewReconciler(store, DefaultConfig())
+	if err != nil {
+		t.Fatalf("failed to create reconciler: %v", err)
+	}
+
+	reconciler.now = func() time.Time { return now }
+
+	return reconciler, store
+}
+
+func runWithLock(store *MockStore) {
+	store.EXPECT().
+		WithLock(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+			return true, fn(ctx)
+		})
+}
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		state    RegisterState
+		targetAt time.Time
+		expected Outcome
+	}{
+		{"register still in flight", RegisterStatePending, now.Add(-time.Hour), OutcomeActivated},
+		{"dead register with future target", RegisterStateDead, now.Add(time.Hour), OutcomeReregistered},
+		{"dead register with past target", RegisterStateDead, now.Add(-time.Hour), OutcomeFailed},
+		{"no register request", RegisterStateNone, now.Add(time.Hour), OutcomeFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := decide(PendingTask{TaskID: "task", UserID: "user", TargetAt: tt.targetAt, RegisterState: tt.state}, now)
+			if got != tt.expected {
+				t.Fatalf("decide() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReconcileOnceResolvesStaleTasks(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	reconciler, store := newTestReconciler(t, now)
+
+	runWithLock(store)
+	store.EXPECT().
+		ListStalePending(gomock.Any(), now.Add(-reconciler.cfg.StaleAfter), reconciler.cfg.BatchSize).
+		Return([]PendingTask{
+			{TaskID: "in-flight", TargetAt: now.Add(time.Hour), RegisterState: RegisterStatePending},
+			{TaskID: "dead", TargetAt: now.Add(time.Hour), RegisterState: RegisterStateDead},
+			{TaskID: "never-registered", TargetAt: now.Add(time.Hour), RegisterState: RegisterStateNone},
+		}, nil)
+
+	gomock.InOrder(
+		store.EXPECT().MarkActive(gomock.Any(), "in-flight").Return(nil),
+		store.EXPECT().RequeueRegister(gomock.Any(), "dead", now).Return(nil),
+		store.EXPECT().MarkActive(gomock.Any(), "dead").Return(nil),
+		store.EXPECT().MarkReminderFailed(gomock.Any(), "never-registered").Return(nil),
+	)
+
+	result, err := reconciler.ReconcileOnce(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Skipped {
+		t.Fatalf("expected pass not to be skipped")
+	}
+
+	if result.Stale != 3 {
+		t.Fatalf("expected 3 stale tasks, got %d", result.Stale)
+	}
+
+	for _, outcome := range []Outcome{OutcomeActivated, OutcomeReregistered, OutcomeFailed} {
+		if result.Outcomes[outcome] != 1 {
+			t.Fatalf("expected 1 %s task, got %d", outcome, result.Outcomes[outcome])
+		}
+	}
+}
+
+func TestReconcileOnceSkipsWhenLockHeld(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	reconciler, store := newTestReconciler(t, now)
+
+	store.EXPECT().WithLock(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	result, err := reconciler.ReconcileOnce(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !result.Skipped {
+		t.Fatalf("expected pass to be skipped")
+	}
+}
+
+func TestReconcileOnceStoreError(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	reconciler, store := newTestReconciler(t, now)
+
+	errStore := errors.New("db down")
+
+	runWithLock(store)
+	store.EXPECT().
+		ListStalePending(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]PendingTask{{TaskID: "task", TargetAt: now.Add(time.Hour), RegisterState: RegisterStateNone}}, nil)
+	store.EXPECT().MarkReminderFailed(gomock.Any(), "task").Return(errStore)
+
+	if _, err := reconciler.ReconcileOnce(context.Background()); !errors.Is(err, errStore) {
+		t.Fatalf("expected store error, got %v", err)
+	}
+}
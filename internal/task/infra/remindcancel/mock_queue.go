@@ -54,3 +54,41 @@ func (mr *MockQueueMockRecorder) CancelRemind(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRemind", reflect.TypeOf((*MockQueue)(nil).CancelRemind), ctx, req)
 }
+
+// MockBatchQueue is a mock of BatchQueue interface.
+type MockBatchQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchQueueMockRecorder is the mock recorder for MockBatchQueue.
+type MockBatchQueueMockRecorder struct {
+	mock *MockBatchQueue
+}
+
+// NewMockBatchQueue creates a new mock instance.
+func NewMockBatchQueue(ctrl *gomock.Controller) *MockBatchQueue {
+	mock := &MockBatchQueue{ctrl: ctrl}
+	mock.recorder = &MockBatchQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchQueue) EXPECT() *MockBatchQueueMockRecorder {
+	return m.recorder
+}
+
+// CancelReminds mocks base method.
+func (m *MockBatchQueue) CancelReminds(ctx context.Context, reqs []*CancelRemindRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReminds", ctx, reqs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelReminds indicates an expected call of CancelReminds.
+func (mr *MockBatchQueueMockRecorder) CancelReminds(ctx, reqs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReminds", reflect.TypeOf((*MockBatchQueue)(nil).CancelReminds), ctx, reqs)
+}
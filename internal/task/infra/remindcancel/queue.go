@@ -7,3 +7,28 @@ import "context"
 type Queue interface {
 	CancelRemind(ctx context.Context, req *CancelRemindRequest) (*CancelRemindResponse, error)
 }
+
+// BatchQueue is implemented by queues that can record several cancellations in one call.
+type BatchQueue interface {
+	CancelReminds(ctx context.Context, reqs []*CancelRemindRequest) error
+}
+
+// CancelAll cancels the reminders of every request, in a single call when q is a BatchQueue
+// and one request at a time otherwise.
+func CancelAll(ctx context.Context, q Queue, reqs []*CancelRemindRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	if batchQueue, ok := q.(BatchQueue); ok {
+		return batchQueue.CancelReminds(ctx, reqs)
+	}
+
+	for _, req := range reqs {
+		if _, err := q.CancelRemind(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -54,3 +54,41 @@ func (mr *MockQueueMockRecorder) RegisterRemind(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRemind", reflect.TypeOf((*MockQueue)(nil).RegisterRemind), ctx, req)
 }
+
+// MockBatchQueue is a mock of BatchQueue interface.
+type MockBatchQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchQueueMockRecorder is the mock recorder for MockBatchQueue.
+type MockBatchQueueMockRecorder struct {
+	mock *MockBatchQueue
+}
+
+// NewMockBatchQueue creates a new mock instance.
+func NewMockBatchQueue(ctrl *gomock.Controller) *MockBatchQueue {
+	mock := &MockBatchQueue{ctrl: ctrl}
+	mock.recorder = &MockBatchQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchQueue) EXPECT() *MockBatchQueueMockRecorder {
+	return m.recorder
+}
+
+// RegisterReminds mocks base method.
+func (m *MockBatchQueue) RegisterReminds(ctx context.Context, reqs []*CreateRemindRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterReminds", ctx, reqs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterReminds indicates an expected call of RegisterReminds.
+func (mr *MockBatchQueueMockRecorder) RegisterReminds(ctx, reqs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterReminds", reflect.TypeOf((*MockBatchQueue)(nil).RegisterReminds), ctx, reqs)
+}
@@ -7,3 +7,28 @@ import "context"
 type Queue interface {
 	RegisterRemind(ctx context.Context, req *CreateRemindRequest) (*RemindResponse, error)
 }
+
+// BatchQueue is implemented by queues that can record several registrations in one call.
+type BatchQueue interface {
+	RegisterReminds(ctx context.Context, reqs []*CreateRemindRequest) error
+}
+
+// RegisterAll registers the reminders of every request, in a single call when q is a
+// BatchQueue and one request at a time otherwise.
+func RegisterAll(ctx context.Context, q Queue, reqs []*CreateRemindRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	if batchQueue, ok := q.(BatchQueue); ok {
+		return batchQueue.RegisterReminds(ctx, reqs)
+	}
+
+	for _, req := range reqs {
+		if _, err := q.RegisterRemind(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
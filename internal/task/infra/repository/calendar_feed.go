@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CalendarFeedTokenModel struct {
+	UserID    string    `gorm:"type:uuid;primaryKey"`
+	TokenHash string    `gorm:"type:char(64);not null;uniqueIndex:idx_calendar_feed_tokens_token_hash"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null"`
+}
+
+func (CalendarFeedTokenModel) TableName() string {
+	return "calendar_feed_tokens"
+}
+
+type calendarFeedTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarFeedTokenRepository(db *gorm.DB) domaintask.CalendarFeedTokenRepository {
+	return &calendarFeedTokenRepository{db: db}
+}
+
+func (r *calendarFeedTokenRepository) CreateCalendarFeedToken(ctx context.Context, token *domaintask.CalendarFeedToken) error {
+	if token == nil {
+		return ErrCalendarFeedTokenRequired
+	}
+
+	record := calendarFeedTokenToRecord(token)
+
+	result := conn(ctx, r.db).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "user_id"}}, DoNothing: true}).
+		Create(&record)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domaintask.ErrCalendarFeedTokenAlreadyExists
+	}
+
+	return nil
+}
+
+func (r *calendarFeedTokenRepository) RotateCalendarFeedToken(ctx context.Context, token *domaintask.CalendarFeedToken) error {
+	if token == nil {
+		return ErrCalendarFeedTokenRequired
+	}
+
+	result := conn(ctx, r.db).
+		Model(&CalendarFeedTokenModel{}).
+		Where("user_id = ?", token.UserID().String()).
+		Updates(map[string]any{
+			"token_hash": token.Hash(),
+			"created_at": token.CreatedAt(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domaintask.ErrCalendarFeedTokenNotFound
+	}
+
+	return nil
+}
+
+func (r *calendarFeedTokenRepository) DeleteCalendarFeedToken(ctx context.Context, userID domainuser.ID) error {
+	result := conn(ctx, r.db).
+		Where("user_id = ?", userID.String()).
+		Delete(&CalendarFeedTokenModel{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domaintask.ErrCalendarFeedTokenNotFound
+	}
+
+	return nil
+}
+
+func (r *calendarFeedTokenRepository) GetUserIDByCalendarFeedToken(ctx context.Context, hash string) (domainuser.ID, error) {
+	var record CalendarFeedTokenModel
+	if err := conn(ctx, r.db).
+		Where("token_hash = ?", hash).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainuser.ID{}, domaintask.ErrCalendarFeedTokenNotFound
+		}
+
+		return domainuser.ID{}, err
+	}
+
+	return domainuser.NewIDFromString(record.UserID)
+}
+
+func calendarFeedTokenToRecord(token *domaintask.CalendarFeedToken) CalendarFeedTokenModel {
+	return CalendarFeedTokenModel{
+		UserID:    token.UserID().String(),
+		TokenHash: token.Hash(),
+		CreatedAt: token.CreatedAt(),
+	}
+}
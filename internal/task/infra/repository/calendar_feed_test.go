@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+)
+
+func setupCalendarFeedTokenRepository(t *testing.T) domaintask.CalendarFeedTokenRepository {
+	t.Helper()
+
+	ctx := context.Background()
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(&CalendarFeedTokenModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return NewCalendarFeedTokenRepository(db)
+}
+
+func newTestCalendarFeedToken(t *testing.T, userID domainuser.ID) (*domaintask.CalendarFeedToken, string) {
+	t.Helper()
+
+	feedToken, token, err := domaintask.NewCalendarFeedToken(userID, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create feed token: %v", err)
+	}
+
+	return feedToken, token
+}
+
+func TestCalendarFeedTokenRepository(t *testing.T) {
+	repo := setupCalendarFeedTokenRepository(t)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	first, _ := newTestCalendarFeedToken(t, userID)
+
+	if err := repo.RotateCalendarFeedToken(ctx, first); !errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound) {
+		t.Fatalf("expected ErrCalendarFeedTokenNotFound before creating, got %v", err)
+	}
+
+	if err := repo.CreateCalendarFeedToken(ctx, first); err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	got, err := repo.GetUserIDByCalendarFeedToken(ctx, first.Hash())
+	if err != nil || got != userID {
+		t.Fatalf("expected user %v, got %v (%v)", userID, got, err)
+	}
+
+	second, _ := newTestCalendarFeedToken(t, userID)
+
+	if err := repo.CreateCalendarFeedToken(ctx, second); !errors.Is(err, domaintask.ErrCalendarFeedTokenAlreadyExists) {
+		t.Fatalf("expected ErrCalendarFeedTokenAlreadyExists, got %v", err)
+	}
+
+	if err := repo.RotateCalendarFeedToken(ctx, second); err != nil {
+		t.Fatalf("failed to rotate token: %v", err)
+	}
+
+	if _, err := repo.GetUserIDByCalendarFeedToken(ctx, first.Hash()); !errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound) {
+		t.Fatalf("expected the rotated token to stop working, got %v", err)
+	}
+
+	if err := repo.DeleteCalendarFeedToken(ctx, userID); err != nil {
+		t.Fatalf("failed to delete token: %v", err)
+	}
+
+	if _, err := repo.GetUserIDByCalendarFeedToken(ctx, second.Hash()); !errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound) {
+		t.Fatalf("expected the revoked token to stop working, got %v", err)
+	}
+
+	if err := repo.DeleteCalendarFeedToken(ctx, userID); !errors.Is(err, domaintask.ErrCalendarFeedTokenNotFound) {
+		t.Fatalf("expected ErrCalendarFeedTokenNotFound after revoking, got %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/gorm"
+)
+
+// TaskChangeModel is the latest change to a task in its user's change log. A task has a
+// single row, which moves to the end of the log with a new sequence number every time
+// the task changes, so a sync returns each task once with its latest state.
+type TaskChangeModel struct {
+	UserID     string    `gorm:"type:uuid;primaryKey;uniqueIndex:idx_task_changes_user_id_seq,priority:1"`
+	TaskID     string    `gorm:"type:uuid;primaryKey"`
+	Seq        int64     `gorm:"not null;uniqueIndex:idx_task_changes_user_id_seq,priority:2"`
+	ChangeType string    `gorm:"type:varchar(20);not null"`
+	ChangedAt  time.Time `gorm:"type:timestamptz;not null"`
+}
+
+func (TaskChangeModel) TableName() string {
+	return "task_changes"
+}
+
+// TaskChangeSequenceModel holds the last sequence number handed out in a user's change log.
+type TaskChangeSequenceModel struct {
+	UserID  string `gorm:"type:uuid;primaryKey"`
+	LastSeq int64  `gorm:"not null"`
+}
+
+func (TaskChangeSequenceModel) TableName() string {
+	return "task_change_sequences"
+}
+
+// taskChange is a change to record in the log.
+type taskChange struct {
+	taskID     string
+	changeType domaintask.ChangeType
+}
+
+func upserted(taskIDs ...string) []taskChange {
+	changes := make([]taskChange, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		changes = append(changes, taskChange{taskID: id, changeType: domaintask.ChangeTypeUpserted})
+	}
+
+	return changes
+}
+
+// recordTaskChanges moves the tasks to the end of the user's change log. It must run in
+// the transaction that makes the changes. Taking the next sequence number locks the
+// user's sequence row until that transaction ends, so the user's changes commit in
+// sequence order and a reader never sees a number before the ones below it.
+func recordTaskChanges(db *gorm.DB, userID string, changes ...taskChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var lastSeq int64
+
+	if err := db.Raw(`
+		INSERT INTO task_change_sequences (user_id, last_seq) VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET last_seq = task_change_sequences.last_seq + EXCLUDED.last_seq
+		RETURNING last_seq`,
+		userID, len(changes),
+	).Scan(&lastSeq).Error; err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	seq := lastSeq - int64(len(changes))
+
+	for _, change := range changes {
+		seq++
+
+		if err := db.Exec(`
+			INSERT INTO task_changes (user_id, task_id, seq, change_type, changed_at) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (user_id, task_id) DO UPDATE
+			SET seq = EXCLUDED.seq, change_type = EXCLUDED.change_type, changed_at = EXCLUDED.changed_at`,
+			userID, change.taskID, seq, string(change.changeType), now,
+		).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordTaggedTaskChanges records an upsert for every active task the tag is attached to,
+// since the tags they carry have changed.
+func recordTaggedTaskChanges(db *gorm.DB, tagID domaintask.TagID, userID domainuser.ID) error {
+	var taskIDs []string
+
+	if err := whereTagged(db.Model(&TaskModel{}).Where("user_id = ?", userID.String()), tagID).
+		Order("id ASC").
+		Pluck("id", &taskIDs).Error; err != nil {
+		return err
+	}
+
+	return recordTaskChanges(db, userID.String(), upserted(taskIDs...)...)
+}
+
+type taskSyncRepository struct {
+	db    *gorm.DB
+	tasks *taskRepository
+}
+
+func NewTaskSyncRepository(db *gorm.DB) domaintask.TaskSyncRepository {
+	return &taskSyncRepository{
+		db:    db,
+		tasks: &taskRepository{db: db},
+	}
+}
+
+func (r *taskSyncRepository) ListTaskChanges(
+	ctx context.Context,
+	userID domainuser.ID,
+	query domaintask.SyncTasksQuery,
+) (*domaintask.TaskChangesPage, error) {
+	pageSize, err := domaintask.NormalizeSyncPageSize(query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var page *domaintask.TaskChangesPage
+
+	// The log and the tasks it points at are read from one snapshot, so an upsert is
+	// never paired with a task state from after a later change.
+	err = conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var lastSeq int64
+		if err := tx.
+			Model(&TaskChangeSequenceModel{}).
+			Where("user_id = ?", userID.String()).
+			Pluck("last_seq", &lastSeq).Error; err != nil {
+			return err
+		}
+
+		if query.Since.Seq() > lastSeq {
+			return domaintask.ErrSyncTokenExpired
+		}
+
+		var records []TaskChangeModel
+
+		// Fetch one extra row to find out whether another page exists.
+		if err := tx.
+			Where("user_id = ? AND seq > ?", userID.String(), query.Since.Seq()).
+			Order("seq ASC").
+			Limit(pageSize + 1).
+			Find(&records).Error; err != nil {
+			return err
+		}
+
+		hasMore := len(records) > pageSize
+		if hasMore {
+			records = records[:pageSize]
+		}
+
+		changes, err := r.recordsToChanges(tx, userID, records)
+		if err != nil {
+			return err
+		}
+
+		next := query.Since
+		if len(records) > 0 {
+			next = domaintask.NewSyncToken(records[len(records)-1].Seq)
+		}
+
+		page = &domaintask.TaskChangesPage{
+			Changes: changes,
+			Next:    next,
+			HasMore: hasMore,
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (r *taskSyncRepository) recordsToChanges(
+	db *gorm.DB,
+	userID domainuser.ID,
+	records []TaskChangeModel,
+) ([]*domaintask.TaskChange, error) {
+	var upsertedIDs []string
+
+	for _, record := range records {
+		if record.ChangeType == string(domaintask.ChangeTypeUpserted) {
+			upsertedIDs = append(upsertedIDs, record.TaskID)
+		}
+	}
+
+	tasks := make(map[string]*domaintask.Task, len(upsertedIDs))
+
+	if len(upsertedIDs) > 0 {
+		var taskRecords []TaskModel
+		if err := db.
+			Where("id IN ? AND user_id = ?", upsertedIDs, userID.String()).
+			Find(&taskRecords).Error; err != nil {
+			return nil, err
+		}
+
+		progress, err := checklistProgressByTaskID(db, taskRecordIDs(taskRecords))
+		if err != nil {
+			return nil, err
+		}
+
+		tags, err := tagsByTaskID(db, taskRecordIDs(taskRecords))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range taskRecords {
+			task, err := r.tasks.recordToTask(record, progress[record.ID], tags[record.ID])
+			if err != nil {
+				return nil, err
+			}
+
+			tasks[record.ID] = task
+		}
+	}
+
+	changes := make([]*domaintask.TaskChange, 0, len(records))
+
+	for _, record := range records {
+		change, err := recordToTaskChange(record, tasks)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func recordToTaskChange(record TaskChangeModel, tasks map[string]*domaintask.Task) (*domaintask.TaskChange, error) {
+	changeType, err := domaintask.NewChangeType(record.ChangeType)
+	if err != nil {
+		return nil, err
+	}
+
+	if changeType == domaintask.ChangeTypeUpserted {
+		task, ok := tasks[record.TaskID]
+		if !ok {
+			// Every write that removes a task records a tombstone in the same transaction
+			return nil, fmt.Errorf("change log points at missing task %s", record.TaskID)
+		}
+
+		return domaintask.NewUpsertedTaskChange(task, record.ChangedAt)
+	}
+
+	taskID, err := domaintask.NewIDFromString(record.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domaintask.NewTombstone(taskID, changeType, record.ChangedAt)
+}
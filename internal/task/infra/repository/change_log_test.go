@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func syncTestChanges(
+	t *testing.T,
+	repo domaintask.TaskSyncRepository,
+	userID domainuser.ID,
+	since domaintask.SyncToken,
+) *domaintask.TaskChangesPage {
+	t.Helper()
+
+	page, err := repo.ListTaskChanges(context.Background(), userID, domaintask.SyncTasksQuery{Since: since, PageSize: 0})
+	if err != nil {
+		t.Fatalf("failed to list task changes: %v", err)
+	}
+
+	return page
+}
+
+func TestTaskSyncRepository(t *testing.T) {
+	db := setupArchiveDB(t)
+	ctx := context.Background()
+
+	repo := NewTaskRepository(db)
+	archiveRepo := NewTaskArchiveRepository(db)
+	tagRepo := NewTagRepository(db)
+	syncRepo := NewTaskSyncRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	updated := createTestTask(t, db, userID)
+	deleted := createTestTask(t, db, userID)
+	completed := createTestTask(t, db, userID)
+
+	var afterCreate domaintask.SyncToken
+
+	t.Run("starts with every task", func(t *testing.T) {
+		page := syncTestChanges(t, syncRepo, userID, domaintask.SyncToken{})
+
+		if len(page.Changes) != 3 || page.HasMore {
+			t.Fatalf("expected 3 changes on a single page, got %d (more: %v)", len(page.Changes), page.HasMore)
+		}
+
+		for i, want := range []*domaintask.Task{updated, deleted, completed} {
+			change := page.Changes[i]
+			if change.ChangeType() != domaintask.ChangeTypeUpserted || change.Task() == nil || change.TaskID() != want.ID() {
+				t.Fatalf("unexpected change %d: %s %s", i, change.ChangeType(), change.TaskID())
+			}
+		}
+
+		afterCreate = page.Next
+
+		if again := syncTestChanges(t, syncRepo, userID, afterCreate); len(again.Changes) != 0 || again.Next != afterCreate {
+			t.Fatalf("expected no changes after the latest token, got %d", len(again.Changes))
+		}
+	})
+
+	t.Run("returns the latest change of each task in order", func(t *testing.T) {
+		updatedTask, err := domaintask.NewTask(
+			updated.ID(),
+			userID,
+			"Renamed",
+			updated.TaskType(),
+			updated.TaskStatus(),
+			updated.Description(),
+			nil,
+			updated.CreatedAt(),
+			updated.TargetAt(),
+			updated.Color(),
+			domaintask.WithVersion(updated.Version()+1),
+		)
+		if err != nil {
+			t.Fatalf("failed to update task: %v", err)
+		}
+
+		if err := repo.UpdateTask(ctx, updatedTask); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+
+		if err := repo.DeleteTask(ctx, deleted.ID(), userID); err != nil {
+			t.Fatalf("failed to delete task: %v", err)
+		}
+
+		completedTask, err := domaintask.NewCompletedTask(completed, time.Now().UTC())
+		if err != nil {
+			t.Fatalf("failed to create completed task: %v", err)
+		}
+
+		if err := archiveRepo.ArchiveTask(ctx, completedTask, completed.ID(), userID, completed.Version()); err != nil {
+			t.Fatalf("failed to archive task: %v", err)
+		}
+
+		page := syncTestChanges(t, syncRepo, userID, afterCreate)
+
+		if len(page.Changes) != 3 {
+			t.Fatalf("expected 3 changes, got %d", len(page.Changes))
+		}
+
+		if change := page.Changes[0]; change.IsTombstone() || change.Task().Title() != "Renamed" {
+			t.Errorf("expected the updated task first, got %s", change.ChangeType())
+		}
+
+		if change := page.Changes[1]; change.ChangeType() != domaintask.ChangeTypeDeleted || change.TaskID() != deleted.ID() || change.Task() != nil {
+			t.Errorf("expected a deleted tombstone second, got %s %s", change.ChangeType(), change.TaskID())
+		}
+
+		if change := page.Changes[2]; change.ChangeType() != domaintask.ChangeTypeCompleted || change.TaskID() != completed.ID() {
+			t.Errorf("expected a completed tombstone third, got %s %s", change.ChangeType(), change.TaskID())
+		}
+	})
+
+	t.Run("records the tasks a changed tag is attached to", func(t *testing.T) {
+		tag := createTestTag(t, tagRepo, userID, "errands")
+
+		if err := tagRepo.AttachTag(ctx, updated.ID(), tag.ID(), userID); err != nil {
+			t.Fatalf("failed to attach tag: %v", err)
+		}
+
+		before := syncTestChanges(t, syncRepo, userID, afterCreate).Next
+
+		if err := tagRepo.DeleteTag(ctx, tag.ID(), userID); err != nil {
+			t.Fatalf("failed to delete tag: %v", err)
+		}
+
+		page := syncTestChanges(t, syncRepo, userID, before)
+		if len(page.Changes) != 1 || page.Changes[0].TaskID() != updated.ID() || len(page.Changes[0].Task().Tags()) != 0 {
+			t.Fatalf("expected the untagged task, got %d changes", len(page.Changes))
+		}
+	})
+
+	t.Run("pages through the log", func(t *testing.T) {
+		first, err := syncRepo.ListTaskChanges(ctx, userID, domaintask.SyncTasksQuery{Since: domaintask.SyncToken{}, PageSize: 2})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(first.Changes) != 2 || !first.HasMore {
+			t.Fatalf("expected a full first page, got %d (more: %v)", len(first.Changes), first.HasMore)
+		}
+
+		second := syncTestChanges(t, syncRepo, userID, first.Next)
+		if len(second.Changes) != 1 || second.HasMore {
+			t.Fatalf("expected the rest on the second page, got %d (more: %v)", len(second.Changes), second.HasMore)
+		}
+	})
+
+	t.Run("rejects a token ahead of the log", func(t *testing.T) {
+		otherUserID, err := domainuser.NewID()
+		if err != nil {
+			t.Fatalf("failed to create user ID: %v", err)
+		}
+
+		_, err = syncRepo.ListTaskChanges(ctx, otherUserID, domaintask.SyncTasksQuery{Since: afterCreate, PageSize: 0})
+		if !errors.Is(err, domaintask.ErrSyncTokenExpired) {
+			t.Fatalf("expected ErrSyncTokenExpired, got %v", err)
+		}
+	})
+}
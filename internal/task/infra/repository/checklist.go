@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ChecklistItemModel struct {
+	ID        string    `gorm:"type:uuid;primaryKey"`
+	TaskID    string    `gorm:"type:uuid;not null;index:idx_task_checklist_items_task_id_position,priority:1"`
+	Task      TaskModel `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;foreignKey:TaskID;references:ID"`
+	Text      string    `gorm:"type:varchar(500);not null"`
+	Done      bool      `gorm:"not null;default:false"`
+	Position  int       `gorm:"not null;index:idx_task_checklist_items_task_id_position,priority:2"`
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+}
+
+func (ChecklistItemModel) TableName() string {
+	return "task_checklist_items"
+}
+
+// checklistSnapshotItem is the form in which a checklist item is kept on a completed task.
+type checklistSnapshotItem struct {
+	ID       string `json:"id"`
+	Text     string `json:"text"`
+	Done     bool   `json:"done"`
+	Position int    `json:"position"`
+}
+
+type checklistRepository struct {
+	db *gorm.DB
+}
+
+func NewChecklistRepository(db *gorm.DB) domaintask.ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+func (r *checklistRepository) ListChecklistItems(
+	ctx context.Context,
+	taskID domaintask.ID,
+	userID domainuser.ID,
+) ([]*domaintask.ChecklistItem, error) {
+	db := conn(ctx, r.db)
+
+	if err := lockOwnedTask(db, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	return listChecklistItems(db, taskID)
+}
+
+func (r *checklistRepository) SaveChecklistItem(
+	ctx context.Context,
+	item *domaintask.ChecklistItem,
+	userID domainuser.ID,
+) error {
+	if item == nil {
+		return ErrChecklistItemRequired
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedTask(tx, item.TaskID(), userID); err != nil {
+			return err
+		}
+
+		record := checklistItemToRecord(item)
+
+		if err := tx.Create(&record).Error; err != nil {
+			return err
+		}
+
+		// The task's checklist progress has changed
+		return recordTaskChanges(tx, userID.String(), upserted(item.TaskID().String())...)
+	})
+}
+
+func (r *checklistRepository) UpdateChecklistItemDone(
+	ctx context.Context,
+	item *domaintask.ChecklistItem,
+	userID domainuser.ID,
+) error {
+	if item == nil {
+		return ErrChecklistItemRequired
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedTask(tx, item.TaskID(), userID); err != nil {
+			return err
+		}
+
+		result := tx.
+			Model(&ChecklistItemModel{}).
+			Where("id = ? AND task_id = ?", item.ID().String(), item.TaskID().String()).
+			Update("done", item.Done())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domaintask.ErrChecklistItemNotFound
+		}
+
+		return recordTaskChanges(tx, userID.String(), upserted(item.TaskID().String())...)
+	})
+}
+
+func (r *checklistRepository) UpdateChecklistPositions(
+	ctx context.Context,
+	taskID domaintask.ID,
+	userID domainuser.ID,
+	items []*domaintask.ChecklistItem,
+) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedTask(tx, taskID, userID); err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			result := tx.
+				Model(&ChecklistItemModel{}).
+				Where("id = ? AND task_id = ?", item.ID().String(), taskID.String()).
+				Update("position", item.Position())
+
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected == 0 {
+				return domaintask.ErrChecklistItemNotFound
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *checklistRepository) DeleteChecklistItem(
+	ctx context.Context,
+	taskID domaintask.ID,
+	itemID domaintask.ChecklistItemID,
+	userID domainuser.ID,
+) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedTask(tx, taskID, userID); err != nil {
+			return err
+		}
+
+		var deleted ChecklistItemModel
+
+		result := tx.
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "position"}}}).
+			Where("id = ? AND task_id = ?", itemID.String(), taskID.String()).
+			Delete(&deleted)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domaintask.ErrChecklistItemNotFound
+		}
+
+		// Close the gap left by the deleted item
+		if err := tx.
+			Model(&ChecklistItemModel{}).
+			Where("task_id = ? AND position > ?", taskID.String(), deleted.Position).
+			Update("position", gorm.Expr("position - 1")).
+			Error; err != nil {
+			return err
+		}
+
+		return recordTaskChanges(tx, userID.String(), upserted(taskID.String())...)
+	})
+}
+
+// lockOwnedTask checks that the task exists and belongs to the user, and locks its row
+// until the transaction ends. Checklist changes made from what was read in the same
+// transaction are therefore serialized per task.
+func lockOwnedTask(db *gorm.DB, taskID domaintask.ID, userID domainuser.ID) error {
+	var ids []string
+
+	if err := db.
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Model(&TaskModel{}).
+		Where("id = ? AND user_id = ?", taskID.String(), userID.String()).
+		Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return domaintask.ErrTaskNotFound
+	}
+
+	return nil
+}
+
+func listChecklistItems(db *gorm.DB, taskID domaintask.ID) ([]*domaintask.ChecklistItem, error) {
+	var records []ChecklistItemModel
+
+	if err := db.
+		Where("task_id = ?", taskID.String()).
+		Order("position ASC, id ASC").
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	items := make([]*domaintask.ChecklistItem, 0, len(records))
+
+	for _, record := range records {
+		item, err := recordToChecklistItem(record)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func createChecklistItemRecords(db *gorm.DB, items []*domaintask.ChecklistItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	records := make([]ChecklistItemModel, 0, len(items))
+	for _, item := range items {
+		records = append(records, checklistItemToRecord(item))
+	}
+
+	return db.Create(&records).Error
+}
+
+// checklistProgressByTaskID counts the checklist items of the given tasks. Tasks without a
+// checklist are absent from the result.
+func checklistProgressByTaskID(db *gorm.DB, taskIDs []string) (map[string]domaintask.ChecklistProgress, error) {
+	progress := make(map[string]domaintask.ChecklistProgress, len(taskIDs))
+
+	if len(taskIDs) == 0 {
+		return progress, nil
+	}
+
+	var rows []struct {
+		TaskID string
+		Total  int
+		Done   int
+	}
+
+	if err := db.
+		Model(&ChecklistItemModel{}).
+		Select("task_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE done) AS done").
+		Where("task_id IN ?", taskIDs).
+		Group("task_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		progress[row.TaskID] = domaintask.NewChecklistProgress(row.Total, row.Done)
+	}
+
+	return progress, nil
+}
+
+func checklistItemToRecord(item *domaintask.ChecklistItem) ChecklistItemModel {
+	return ChecklistItemModel{
+		ID:        item.ID().String(),
+		TaskID:    item.TaskID().String(),
+		Task:      TaskModel{}, // only declares the foreign key
+		Text:      item.Text(),
+		Done:      item.Done(),
+		Position:  item.Position(),
+		CreatedAt: time.Time{}, // set by GORM
+	}
+}
+
+func recordToChecklistItem(record ChecklistItemModel) (*domaintask.ChecklistItem, error) {
+	id, err := domaintask.NewChecklistItemIDFromString(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskID, err := domaintask.NewIDFromString(record.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domaintask.NewChecklistItem(id, taskID, record.Text, record.Done, record.Position)
+}
+
+func checklistToSnapshot(items []*domaintask.ChecklistItem) []checklistSnapshotItem {
+	snapshot := make([]checklistSnapshotItem, 0, len(items))
+
+	for _, item := range items {
+		snapshot = append(snapshot, checklistSnapshotItem{
+			ID:       item.ID().String(),
+			Text:     item.Text(),
+			Done:     item.Done(),
+			Position: item.Position(),
+		})
+	}
+
+	return snapshot
+}
+
+func snapshotToChecklist(taskID domaintask.ID, snapshot []checklistSnapshotItem) ([]*domaintask.ChecklistItem, error) {
+	items := make([]*domaintask.ChecklistItem, 0, len(snapshot))
+
+	for _, entry := range snapshot {
+		id, err := domaintask.NewChecklistItemIDFromString(entry.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := domaintask.NewChecklistItem(id, taskID, entry.Text, entry.Done, entry.Position)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func addTestChecklistItem(
+	t *testing.T,
+	repo domaintask.ChecklistRepository,
+	task *domaintask.Task,
+	text string,
+	position int,
+) *domaintask.ChecklistItem {
+	t.Helper()
+
+	id, err := domaintask.NewChecklistItemID()
+	if err != nil {
+		t.Fatalf("failed to generate checklist item ID: %v", err)
+	}
+
+	item, err := domaintask.NewChecklistItem(id, task.ID(), text, false, position)
+	if err != nil {
+		t.Fatalf("failed to create checklist item: %v", err)
+	}
+
+	if err := repo.SaveChecklistItem(context.Background(), item, task.UserID()); err != nil {
+		t.Fatalf("failed to save checklist item: %v", err)
+	}
+
+	return item
+}
+
+func checklistTexts(items []*domaintask.ChecklistItem) []string {
+	texts := make([]string, 0, len(items))
+	for _, item := range items {
+		texts = append(texts, item.Text())
+	}
+
+	return texts
+}
+
+func TestChecklistRepository(t *testing.T) {
+	db := setupTaskDB(t)
+	ctx := context.Background()
+
+	repo := NewChecklistRepository(db)
+	taskRepo := NewTaskRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	otherUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	task := createTestTask(t, db, userID)
+
+	first := addTestChecklistItem(t, repo, task, "first", 0)
+	second := addTestChecklistItem(t, repo, task, "second", 1)
+	third := addTestChecklistItem(t, repo, task, "third", 2)
+
+	t.Run("toggles an item and reports progress on the task", func(t *testing.T) {
+		if err := repo.UpdateChecklistItemDone(ctx, second.SetDone(true), userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := taskRepo.GetTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.ChecklistProgress().Total() != 3 || got.ChecklistProgress().Done() != 1 {
+			t.Fatalf("expected 1 of 3 done, got %d of %d",
+				got.ChecklistProgress().Done(), got.ChecklistProgress().Total())
+		}
+
+		tasks, _, err := taskRepo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{SortType: domaintask.SortTypeTargetAt})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tasks) != 1 || tasks[0].ChecklistProgress().Done() != 1 {
+			t.Fatalf("expected listed task to carry checklist progress")
+		}
+	})
+
+	t.Run("reorders items", func(t *testing.T) {
+		items, err := repo.ListChecklistItems(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		reordered, err := domaintask.ReorderChecklist(items, []domaintask.ChecklistItemID{third.ID(), first.ID(), second.ID()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := repo.UpdateChecklistPositions(ctx, task.ID(), userID, reordered); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		items, err = repo.ListChecklistItems(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := checklistTexts(items); got[0] != "third" || got[1] != "first" || got[2] != "second" {
+			t.Fatalf("unexpected order %v", got)
+		}
+	})
+
+	t.Run("deletes an item and closes the gap", func(t *testing.T) {
+		if err := repo.DeleteChecklistItem(ctx, task.ID(), first.ID(), userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		items, err := repo.ListChecklistItems(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(items) != 2 || items[0].Position() != 0 || items[1].Position() != 1 {
+			t.Fatalf("expected 2 items at positions 0 and 1, got %v", checklistTexts(items))
+		}
+
+		if err := repo.DeleteChecklistItem(ctx, task.ID(), first.ID(), userID); !errors.Is(err, domaintask.ErrChecklistItemNotFound) {
+			t.Fatalf("expected ErrChecklistItemNotFound, got %v", err)
+		}
+	})
+
+	t.Run("hides the checklist from other users", func(t *testing.T) {
+		if _, err := repo.ListChecklistItems(ctx, task.ID(), otherUserID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected ErrTaskNotFound, got %v", err)
+		}
+
+		if err := repo.UpdateChecklistItemDone(ctx, second.SetDone(false), otherUserID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected ErrTaskNotFound, got %v", err)
+		}
+	})
+}
+
+func TestChecklistArchive(t *testing.T) {
+	db := setupArchiveDB(t)
+	ctx := context.Background()
+
+	repo := NewChecklistRepository(db)
+	archiveRepo := NewTaskArchiveRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	t.Run("keeps a snapshot when archiving and restores it when unarchiving", func(t *testing.T) {
+		task := createTestTask(t, db, userID)
+		addTestChecklistItem(t, repo, task, "first", 0)
+		second := addTestChecklistItem(t, repo, task, "second", 1)
+
+		if err := repo.UpdateChecklistItemDone(ctx, second.SetDone(true), userID); err != nil {
+			t.Fatalf("failed to toggle item: %v", err)
+		}
+
+		completedTask, err := domaintask.NewCompletedTask(task, time.Now())
+		if err != nil {
+			t.Fatalf("failed to create completed task: %v", err)
+		}
+
+		if err := archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID, task.Version()); err != nil {
+			t.Fatalf("failed to archive task: %v", err)
+		}
+
+		var remaining int64
+		if err := db.Model(&ChecklistItemModel{}).Where("task_id = ?", task.ID().String()).Count(&remaining).Error; err != nil {
+			t.Fatalf("failed to count checklist items: %v", err)
+		}
+
+		if remaining != 0 {
+			t.Fatalf("expected checklist items to be removed with the task, got %d", remaining)
+		}
+
+		archived, err := archiveRepo.GetCompletedTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("failed to get completed task: %v", err)
+		}
+
+		if got := checklistTexts(archived.Checklist()); len(got) != 2 || got[0] != "first" || !archived.Checklist()[1].Done() {
+			t.Fatalf("unexpected checklist snapshot %v", got)
+		}
+
+		reopened, err := archived.Reopen(time.Now(), nil)
+		if err != nil {
+			t.Fatalf("failed to reopen task: %v", err)
+		}
+
+		if err := archiveRepo.UnarchiveTask(ctx, reopened); err != nil {
+			t.Fatalf("failed to unarchive task: %v", err)
+		}
+
+		items, err := repo.ListChecklistItems(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(items) != 2 || items[1].ID() != second.ID() || !items[1].Done() {
+			t.Fatalf("expected the checklist to be restored, got %v", checklistTexts(items))
+		}
+	})
+
+	t.Run("copies the checklist unchecked to the next occurrence", func(t *testing.T) {
+		task := createTestTask(t, db, userID)
+		item := addTestChecklistItem(t, repo, task, "water plants", 0)
+
+		if err := repo.UpdateChecklistItemDone(ctx, item.SetDone(true), userID); err != nil {
+			t.Fatalf("failed to toggle item: %v", err)
+		}
+
+		completedTask, err := domaintask.NewCompletedTask(task, time.Now())
+		if err != nil {
+			t.Fatalf("failed to create completed task: %v", err)
+		}
+
+		nextID, err := domaintask.NewID()
+		if err != nil {
+			t.Fatalf("failed to generate task ID: %v", err)
+		}
+
+		now := time.Now()
+
+		next, err := domaintask.NewTask(
+			nextID,
+			userID,
+			task.Title(),
+			task.TaskType(),
+			domaintask.StatusPendingReminders,
+			task.Description(),
+			nil,
+			now,
+			now.Add(time.Hour),
+			task.Color(),
+		)
+		if err != nil {
+			t.Fatalf("failed to create next occurrence: %v", err)
+		}
+
+		if err := archiveRepo.ArchiveTaskWithNextOccurrence(ctx, completedTask, task.ID(), userID, task.Version(), next); err != nil {
+			t.Fatalf("failed to archive task: %v", err)
+		}
+
+		items, err := repo.ListChecklistItems(ctx, next.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(items) != 1 || items[0].Text() != "water plants" || items[0].Done() || items[0].ID() == item.ID() {
+			t.Fatalf("expected an unchecked copy of the checklist, got %v", checklistTexts(items))
+		}
+	})
+}
@@ -2,24 +2,34 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CompletedTaskModel struct {
 	ID          string     `gorm:"type:uuid;primaryKey"`
 	UserID      string     `gorm:"type:uuid;not null;index:idx_completed_tasks_user_id"`
-	Title       string     `gorm:"type:varchar(500);not null"`
+	Title       string     `gorm:"type:varchar(500);not null;index:idx_completed_tasks_title_trgm,type:gin,expression:title gin_trgm_ops"`
 	TaskType    string     `gorm:"type:varchar(50);not null"`
-	Description string     `gorm:"type:text"`
+	Description string     `gorm:"type:text;index:idx_completed_tasks_description_trgm,type:gin,expression:description gin_trgm_ops"`
 	ScheduledAt *time.Time `gorm:"type:timestamptz"`
 	CreatedAt   time.Time  `gorm:"type:timestamptz;not null"`
 	TargetAt    time.Time  `gorm:"type:timestamptz;not null"`
 	Color       string     `gorm:"type:varchar(7);not null"`
 	CompletedAt time.Time  `gorm:"type:timestamptz;not null;index:idx_completed_tasks_completed_at"`
+	// Checklist is a snapshot of the task's checklist at the time it was archived
+	Checklist          []checklistSnapshotItem `gorm:"type:jsonb;not null;default:'[]';serializer:json"`
+	RecurrenceRule     *string                 `gorm:"type:varchar(256)"`
+	RecurrenceTimezone *string                 `gorm:"type:varchar(64)"`
+	// NextOccurrenceID is the occurrence created when a recurring task was completed
+	NextOccurrenceID *string `gorm:"type:uuid"`
+	// SearchVector is maintained by the database and only used for searching
+	SearchVector string `gorm:"->:false;<-:false;type:tsvector GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, coalesce(title, '')), 'A') || setweight(to_tsvector('simple'::regconfig, coalesce(description, '')), 'B')) STORED;index:idx_completed_tasks_search_vector,type:gin"`
 }
 
 func (CompletedTaskModel) TableName() string {
@@ -39,48 +49,351 @@ func (r *taskArchiveRepository) ArchiveTask(
 	completedTask *domaintask.CompletedTask,
 	taskID domaintask.ID,
 	userID domainuser.ID,
+	version int64,
 ) error {
 	if completedTask == nil {
 		return ErrTaskRequired
 	}
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Insert into completed_tasks
-		var scheduledAt *time.Time
-		if completedTask.ScheduledAt() != nil {
-			scheduledAt = completedTask.ScheduledAt()
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		_, err := archiveTaskInTx(tx, completedTask, taskID, userID, version, nil)
+
+		return err
+	})
+}
+
+func (r *taskArchiveRepository) ArchiveTaskWithNextOccurrence(
+	ctx context.Context,
+	completedTask *domaintask.CompletedTask,
+	taskID domaintask.ID,
+	userID domainuser.ID,
+	version int64,
+	next *domaintask.Task,
+) error {
+	if completedTask == nil || next == nil {
+		return ErrTaskRequired
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		nextID := next.ID()
+
+		checklist, err := archiveTaskInTx(tx, completedTask, taskID, userID, version, &nextID)
+		if err != nil {
+			return err
 		}
 
-		record := CompletedTaskModel{
-			ID:          completedTask.ID().String(),
-			UserID:      completedTask.UserID().String(),
-			Title:       completedTask.Title(),
-			TaskType:    string(completedTask.TaskType()),
-			Description: completedTask.Description(),
-			ScheduledAt: scheduledAt,
-			CreatedAt:   completedTask.CreatedAt(),
-			TargetAt:    completedTask.TargetAt(),
-			Color:       completedTask.Color().String(),
-			CompletedAt: completedTask.CompletedAt(),
+		// Insert the next occurrence into tasks
+		if err := createTaskRecord(tx, next); err != nil {
+			return err
 		}
 
-		if err := tx.Create(&record).Error; err != nil {
+		// The next occurrence keeps the tags and starts with the same checklist, unchecked
+		if err := copyTaskTags(tx, taskID, next.ID()); err != nil {
 			return err
 		}
 
-		// Delete from tasks table
+		nextChecklist := make([]*domaintask.ChecklistItem, 0, len(checklist))
+
+		for _, item := range checklist {
+			copied, err := item.CopyTo(next.ID())
+			if err != nil {
+				return err
+			}
+
+			nextChecklist = append(nextChecklist, copied)
+		}
+
+		return createChecklistItemRecords(tx, nextChecklist)
+	})
+}
+
+// archiveTaskInTx moves the task into completed_tasks and returns the checklist it had.
+// The task is only removed at the given version, so a snapshot read before a concurrent
+// edit is never archived over it. nextOccurrenceID is recorded so that reopening the task
+// knows its series has moved on.
+func archiveTaskInTx(
+	tx *gorm.DB,
+	completedTask *domaintask.CompletedTask,
+	taskID domaintask.ID,
+	userID domainuser.ID,
+	version int64,
+	nextOccurrenceID *domaintask.ID,
+) ([]*domaintask.ChecklistItem, error) {
+	checklist, err := listChecklistItems(tx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert into completed_tasks
+	var scheduledAt *time.Time
+	if completedTask.ScheduledAt() != nil {
+		scheduledAt = completedTask.ScheduledAt()
+	}
+
+	recurrenceRule, recurrenceTimezone := recurrenceToColumns(completedTask.Recurrence())
+
+	var nextID *string
+	if nextOccurrenceID != nil {
+		id := nextOccurrenceID.String()
+		nextID = &id
+	}
+
+	record := CompletedTaskModel{
+		ID:                 completedTask.ID().String(),
+		UserID:             completedTask.UserID().String(),
+		Title:              completedTask.Title(),
+		TaskType:           string(completedTask.TaskType()),
+		Description:        completedTask.Description(),
+		ScheduledAt:        scheduledAt,
+		CreatedAt:          completedTask.CreatedAt(),
+		TargetAt:           completedTask.TargetAt(),
+		Color:              completedTask.Color().String(),
+		CompletedAt:        completedTask.CompletedAt(),
+		Checklist:          checklistToSnapshot(checklist),
+		RecurrenceRule:     recurrenceRule,
+		RecurrenceTimezone: recurrenceTimezone,
+		NextOccurrenceID:   nextID,
+		SearchVector:       "",
+	}
+
+	if err := tx.Create(&record).Error; err != nil {
+		return nil, err
+	}
+
+	// Delete from tasks table, which also deletes its checklist items. Unscoped makes it a
+	// hard delete; trashed tasks cannot be archived.
+	result := tx.
+		Unscoped().
+		Where("id = ? AND user_id = ? AND deleted_at IS NULL AND version = ?", taskID.String(), userID.String(), version).
+		Delete(&TaskModel{})
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, missingOrConflicting(tx, taskID, userID)
+	}
+
+	completed := taskChange{taskID: taskID.String(), changeType: domaintask.ChangeTypeCompleted}
+	if err := recordTaskChanges(tx, userID.String(), completed); err != nil {
+		return nil, err
+	}
+
+	return checklist, nil
+}
+
+func (r *taskArchiveRepository) UnarchiveTask(ctx context.Context, task *domaintask.Task) error {
+	if task == nil {
+		return ErrTaskRequired
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Delete from completed_tasks
+		var deleted []CompletedTaskModel
+
 		result := tx.
-			Where("id = ? AND user_id = ?", taskID.String(), userID.String()).
-			Delete(&TaskModel{})
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "checklist"}}}).
+			Where("id = ? AND user_id = ?", task.ID().String(), task.UserID().String()).
+			Delete(&deleted)
 
 		if result.Error != nil {
 			return result.Error
 		}
 
 		if result.RowsAffected == 0 {
-			return domaintask.ErrTaskNotFound
+			return domaintask.ErrCompletedTaskNotFound
+		}
+
+		// Insert into tasks
+		if err := createTaskRecord(tx, task); err != nil {
+			return err
+		}
+
+		checklist, err := snapshotToChecklist(task.ID(), deleted[0].Checklist)
+		if err != nil {
+			return err
 		}
 
-		return nil
+		return createChecklistItemRecords(tx, checklist)
 	})
 }
+
+func (r *taskArchiveRepository) GetCompletedTaskByID(
+	ctx context.Context,
+	id domaintask.ID,
+	userID domainuser.ID,
+) (*domaintask.CompletedTask, error) {
+	var record CompletedTaskModel
+	if err := conn(ctx, r.db).
+		Where("id = ? AND user_id = ?", id.String(), userID.String()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domaintask.ErrCompletedTaskNotFound
+		}
+
+		return nil, err
+	}
+
+	tags, err := tagsByTaskID(conn(ctx, r.db), []string{record.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return recordToCompletedTask(record, tags[record.ID])
+}
+
+func (r *taskArchiveRepository) ListCompletedTasksByUserID(
+	ctx context.Context,
+	userID domainuser.ID,
+	query domaintask.ListCompletedTasksQuery,
+) ([]*domaintask.CompletedTask, *domaintask.PageCursor, error) {
+	if err := query.Filter.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	pageSize, err := domaintask.NormalizePageSize(query.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := conn(ctx, r.db).Where("user_id = ?", userID.String())
+
+	if query.Filter.TaskType != nil {
+		db = db.Where("task_type = ?", string(*query.Filter.TaskType))
+	}
+
+	if query.Filter.CompletedAtFrom != nil {
+		db = db.Where("completed_at >= ?", query.Filter.CompletedAtFrom.UTC())
+	}
+
+	if query.Filter.CompletedAtTo != nil {
+		db = db.Where("completed_at < ?", query.Filter.CompletedAtTo.UTC())
+	}
+
+	if query.Filter.TagID != nil {
+		db = whereTagged(db, *query.Filter.TagID)
+	}
+
+	if query.Cursor != nil {
+		completedAt, err := query.Cursor.SortKeyTime()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		db = db.Where(
+			"(completed_at < ? OR (completed_at = ? AND id < ?))",
+			completedAt, completedAt, query.Cursor.ID().String(),
+		)
+	}
+
+	var records []CompletedTaskModel
+
+	// Fetch one extra row to find out whether another page exists.
+	if err := db.
+		Order("completed_at DESC, id DESC").
+		Limit(pageSize + 1).
+		Find(&records).Error; err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(records) > pageSize
+	if hasMore {
+		records = records[:pageSize]
+	}
+
+	tags, err := tagsByTaskID(conn(ctx, r.db), completedTaskRecordIDs(records))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	completedTasks := make([]*domaintask.CompletedTask, 0, len(records))
+	for _, record := range records {
+		completedTask, err := recordToCompletedTask(record, tags[record.ID])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		completedTasks = append(completedTasks, completedTask)
+	}
+
+	if !hasMore {
+		return completedTasks, nil, nil
+	}
+
+	nextCursor, err := domaintask.NewCompletedTaskPageCursor(completedTasks[len(completedTasks)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return completedTasks, nextCursor, nil
+}
+
+func completedTaskRecordIDs(records []CompletedTaskModel) []string {
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	return ids
+}
+
+func recordToCompletedTask(record CompletedTaskModel, tags []*domaintask.Tag) (*domaintask.CompletedTask, error) {
+	recordTaskID, err := domaintask.NewIDFromString(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordUserID, err := domainuser.NewIDFromString(record.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskType, err := domaintask.NewType(record.TaskType)
+	if err != nil {
+		return nil, err
+	}
+
+	color, err := domaintask.NewColor(record.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	checklist, err := snapshotToChecklist(recordTaskID, record.Checklist)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence, err := recordToRecurrence(record.RecurrenceRule, record.RecurrenceTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextOccurrenceID *domaintask.ID
+
+	if record.NextOccurrenceID != nil {
+		id, err := domaintask.NewIDFromString(*record.NextOccurrenceID)
+		if err != nil {
+			return nil, err
+		}
+
+		nextOccurrenceID = &id
+	}
+
+	return domaintask.ReconstructCompletedTask(
+		recordTaskID,
+		recordUserID,
+		record.Title,
+		taskType,
+		record.Description,
+		record.ScheduledAt,
+		record.CreatedAt,
+		record.TargetAt,
+		color,
+		record.CompletedAt,
+		checklist,
+		tags,
+		recurrence,
+		nextOccurrenceID,
+	)
+}
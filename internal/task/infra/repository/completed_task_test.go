@@ -20,7 +20,7 @@ func setupArchiveDB(t *testing.T) *gorm.DB {
 	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
 	t.Cleanup(cleanup)
 
-	if err := db.AutoMigrate(&TaskModel{}, &CompletedTaskModel{}); err != nil {
+	if err := db.AutoMigrate(&TaskModel{}, &ChecklistItemModel{}, &CompletedTaskModel{}, &TagModel{}, &TaskTagModel{}, &TaskChangeModel{}, &TaskChangeSequenceModel{}); err != nil {
 		t.Fatalf("failed to migrate database: %v", err)
 	}
 
@@ -81,7 +81,7 @@ func TestArchiveTaskSuccess(t *testing.T) {
 	}
 
 	// Archive the task
-	err = archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID)
+	err = archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID, task.Version())
 	if err != nil {
 		t.Fatalf("ArchiveTask failed: %v", err)
 	}
@@ -126,7 +126,7 @@ func TestArchiveTaskNilTask(t *testing.T) {
 
 	taskID := domaintask.ID(uuid.Must(uuid.NewV7()))
 
-	err = archiveRepo.ArchiveTask(ctx, nil, taskID, userID)
+	err = archiveRepo.ArchiveTask(ctx, nil, taskID, userID, 1)
 	if !errors.Is(err, ErrTaskRequired) {
 		t.Errorf("expected ErrTaskRequired, got %v", err)
 	}
@@ -169,7 +169,7 @@ func TestArchiveTaskNotFound(t *testing.T) {
 	}
 
 	// Try to archive a task that doesn't exist in the database
-	err = archiveRepo.ArchiveTask(ctx, completedTask, taskID, userID)
+	err = archiveRepo.ArchiveTask(ctx, completedTask, taskID, userID, task.Version())
 	if !errors.Is(err, domaintask.ErrTaskNotFound) {
 		t.Errorf("expected ErrTaskNotFound, got %v", err)
 	}
@@ -184,6 +184,47 @@ func TestArchiveTaskNotFound(t *testing.T) {
 	}
 }
 
+func TestArchiveTaskVersionConflict(t *testing.T) {
+	db := setupArchiveDB(t)
+	archiveRepo := NewTaskArchiveRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	task := createTestTask(t, db, userID)
+
+	completedTask, err := domaintask.NewCompletedTask(task, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	// Archive with a version the task was never at (a concurrent update won)
+	err = archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID, task.Version()+1)
+	if !errors.Is(err, domaintask.ErrTaskVersionConflict) {
+		t.Errorf("expected ErrTaskVersionConflict, got %v", err)
+	}
+
+	// Verify the task is still active and nothing was archived
+	var taskCount int64
+
+	db.Model(&TaskModel{}).Where("id = ? AND deleted_at IS NULL", task.ID().String()).Count(&taskCount)
+
+	if taskCount != 1 {
+		t.Errorf("expected task to remain in tasks, got %d", taskCount)
+	}
+
+	var completedCount int64
+
+	db.Model(&CompletedTaskModel{}).Where("id = ?", task.ID().String()).Count(&completedCount)
+
+	if completedCount != 0 {
+		t.Errorf("expected no record in completed_tasks, got %d", completedCount)
+	}
+}
+
 func TestArchiveTaskTransactionRollback(t *testing.T) {
 	db := setupArchiveDB(t)
 	archiveRepo := NewTaskArchiveRepository(db)
@@ -208,7 +249,7 @@ func TestArchiveTaskTransactionRollback(t *testing.T) {
 		t.Fatalf("failed to create wrong user ID: %v", err)
 	}
 
-	err = archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), wrongUserID)
+	err = archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), wrongUserID, task.Version())
 	if !errors.Is(err, domaintask.ErrTaskNotFound) {
 		t.Errorf("expected ErrTaskNotFound, got %v", err)
 	}
@@ -228,3 +269,478 @@ func TestArchiveTaskTransactionRollback(t *testing.T) {
 		t.Errorf("expected no record in completed_tasks after rollback, got %d", count)
 	}
 }
+
+func archiveTestTask(
+	t *testing.T,
+	db *gorm.DB,
+	userID domainuser.ID,
+	taskType domaintask.Type,
+	completedAt time.Time,
+) *domaintask.CompletedTask {
+	t.Helper()
+
+	taskID := domaintask.ID(uuid.Must(uuid.NewV7()))
+	createdAt := completedAt.Add(-2 * time.Hour)
+
+	var scheduledAt *time.Time
+	if taskType == domaintask.TypeScheduled {
+		s := createdAt.Add(time.Hour)
+		scheduledAt = &s
+	}
+
+	task, err := domaintask.NewTask(
+		taskID,
+		userID,
+		"Archived Task",
+		taskType,
+		domaintask.StatusCompleted,
+		"",
+		scheduledAt,
+		createdAt,
+		createdAt.Add(time.Hour),
+		domaintask.MustColor("#FF6B6B"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	if err := NewTaskRepository(db).SaveTask(context.Background(), task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	completedTask, err := domaintask.NewCompletedTask(task, completedAt)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	if err := NewTaskArchiveRepository(db).ArchiveTask(context.Background(), completedTask, taskID, userID, task.Version()); err != nil {
+		t.Fatalf("failed to archive task: %v", err)
+	}
+
+	return completedTask
+}
+
+func TestGetCompletedTaskByID(t *testing.T) {
+	db := setupArchiveDB(t)
+	archiveRepo := NewTaskArchiveRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	completedAt := time.Now().UTC().Truncate(time.Microsecond)
+	archived := archiveTestTask(t, db, userID, domaintask.TypeScheduled, completedAt)
+
+	t.Run("returns archived task", func(t *testing.T) {
+		got, err := archiveRepo.GetCompletedTaskByID(ctx, archived.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.ID() != archived.ID() {
+			t.Errorf("ID mismatch: got %s, want %s", got.ID(), archived.ID())
+		}
+
+		if got.TaskType() != domaintask.TypeScheduled {
+			t.Errorf("TaskType mismatch: got %s", got.TaskType())
+		}
+
+		if got.ScheduledAt() == nil || !got.ScheduledAt().Equal(*archived.ScheduledAt()) {
+			t.Errorf("ScheduledAt mismatch: got %v, want %v", got.ScheduledAt(), archived.ScheduledAt())
+		}
+
+		if !got.CompletedAt().Equal(completedAt) {
+			t.Errorf("CompletedAt mismatch: got %v, want %v", got.CompletedAt(), completedAt)
+		}
+	})
+
+	t.Run("other user cannot read archived task", func(t *testing.T) {
+		otherUserID, err := domainuser.NewID()
+		if err != nil {
+			t.Fatalf("failed to create user ID: %v", err)
+		}
+
+		_, err = archiveRepo.GetCompletedTaskByID(ctx, archived.ID(), otherUserID)
+		if !errors.Is(err, domaintask.ErrCompletedTaskNotFound) {
+			t.Fatalf("expected ErrCompletedTaskNotFound, got %v", err)
+		}
+	})
+}
+
+func TestListCompletedTasksByUserID(t *testing.T) {
+	db := setupArchiveDB(t)
+	archiveRepo := NewTaskArchiveRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	otherUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create other user ID: %v", err)
+	}
+
+	base := time.Now().UTC().Truncate(time.Microsecond)
+
+	oldest := archiveTestTask(t, db, userID, domaintask.TypeNear, base.Add(-72*time.Hour))
+	middle := archiveTestTask(t, db, userID, domaintask.TypeShort, base.Add(-24*time.Hour))
+	newest := archiveTestTask(t, db, userID, domaintask.TypeNear, base.Add(-1*time.Hour))
+	_ = archiveTestTask(t, db, otherUserID, domaintask.TypeNear, base)
+
+	t.Run("orders by completed_at descending and paginates", func(t *testing.T) {
+		firstPage, cursor, err := archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			PageSize: 2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(firstPage) != 2 || firstPage[0].ID() != newest.ID() || firstPage[1].ID() != middle.ID() {
+			t.Fatalf("unexpected first page: %d tasks", len(firstPage))
+		}
+
+		if cursor == nil {
+			t.Fatal("expected next cursor, got nil")
+		}
+
+		decoded, err := domaintask.DecodeCompletedTaskPageCursor(cursor.Encode())
+		if err != nil {
+			t.Fatalf("failed to decode cursor: %v", err)
+		}
+
+		secondPage, nextCursor, err := archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			PageSize: 2,
+			Cursor:   decoded,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(secondPage) != 1 || secondPage[0].ID() != oldest.ID() {
+			t.Fatalf("expected only the oldest task on second page, got %d tasks", len(secondPage))
+		}
+
+		if nextCursor != nil {
+			t.Errorf("expected no next cursor on last page")
+		}
+	})
+
+	t.Run("filters by completed_at window", func(t *testing.T) {
+		from := base.Add(-48 * time.Hour)
+		to := base
+
+		tasks, _, err := archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			Filter: domaintask.CompletedTaskFilter{CompletedAtFrom: &from, CompletedAtTo: &to},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tasks) != 2 || tasks[0].ID() != newest.ID() || tasks[1].ID() != middle.ID() {
+			t.Fatalf("expected newest and middle tasks, got %d tasks", len(tasks))
+		}
+	})
+
+	t.Run("filters by task type", func(t *testing.T) {
+		taskType := domaintask.TypeShort
+
+		tasks, _, err := archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			Filter: domaintask.CompletedTaskFilter{TaskType: &taskType},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tasks) != 1 || tasks[0].ID() != middle.ID() {
+			t.Fatalf("expected only the short task, got %d tasks", len(tasks))
+		}
+	})
+
+	t.Run("returns error for inverted window", func(t *testing.T) {
+		from := base
+		to := base.Add(-time.Hour)
+
+		_, _, err := archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			Filter: domaintask.CompletedTaskFilter{CompletedAtFrom: &from, CompletedAtTo: &to},
+		})
+		if !errors.Is(err, domaintask.ErrInvalidCompletedAtRange) {
+			t.Fatalf("expected ErrInvalidCompletedAtRange, got %v", err)
+		}
+	})
+}
+
+func TestUnarchiveTask(t *testing.T) {
+	db := setupArchiveDB(t)
+	archiveRepo := NewTaskArchiveRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	completedAt := time.Now().UTC().Truncate(time.Microsecond)
+	archived := archiveTestTask(t, db, userID, domaintask.TypeNear, completedAt)
+
+	reopened, err := archived.Reopen(completedAt.Add(time.Minute), nil)
+	if err != nil {
+		t.Fatalf("failed to reopen task: %v", err)
+	}
+
+	t.Run("moves task back to active tasks", func(t *testing.T) {
+		if err := archiveRepo.UnarchiveTask(ctx, reopened); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := archiveRepo.GetCompletedTaskByID(ctx, archived.ID(), userID); !errors.Is(err, domaintask.ErrCompletedTaskNotFound) {
+			t.Fatalf("expected completed task to be removed, got %v", err)
+		}
+
+		got, err := taskRepo.GetTaskByID(ctx, archived.ID(), userID)
+		if err != nil {
+			t.Fatalf("expected task to be restored: %v", err)
+		}
+
+		if got.TaskStatus() != domaintask.StatusPendingReminders {
+			t.Errorf("TaskStatus mismatch: got %s", got.TaskStatus())
+		}
+
+		if !got.TargetAt().Equal(reopened.TargetAt()) {
+			t.Errorf("TargetAt mismatch: got %v, want %v", got.TargetAt(), reopened.TargetAt())
+		}
+	})
+
+	t.Run("returns not found when task is not archived", func(t *testing.T) {
+		if err := archiveRepo.UnarchiveTask(ctx, reopened); !errors.Is(err, domaintask.ErrCompletedTaskNotFound) {
+			t.Fatalf("expected ErrCompletedTaskNotFound, got %v", err)
+		}
+	})
+
+	t.Run("nil task", func(t *testing.T) {
+		if err := archiveRepo.UnarchiveTask(ctx, nil); !errors.Is(err, ErrTaskRequired) {
+			t.Fatalf("expected ErrTaskRequired, got %v", err)
+		}
+	})
+}
+
+func TestArchiveTaskWithNextOccurrence(t *testing.T) {
+	db := setupArchiveDB(t)
+	archiveRepo := NewTaskArchiveRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	recurrence, err := domaintask.NewRecurrence("FREQ=WEEKLY;BYDAY=MO", "Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	scheduledAt := createdAt.Add(time.Hour)
+
+	task, err := domaintask.NewTask(
+		domaintask.ID(uuid.Must(uuid.NewV7())),
+		userID,
+		"Weekly Review",
+		domaintask.TypeScheduled,
+		domaintask.StatusActive,
+		"",
+		&scheduledAt,
+		createdAt,
+		scheduledAt,
+		domaintask.MustColor("#FF6B6B"),
+		domaintask.WithRecurrence(recurrence),
+	)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	if err := taskRepo.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	stored, err := taskRepo.GetTaskByID(ctx, task.ID(), userID)
+	if err != nil {
+		t.Fatalf("failed to load task: %v", err)
+	}
+
+	if stored.Recurrence() == nil ||
+		stored.Recurrence().Rule() != recurrence.Rule() ||
+		stored.Recurrence().Timezone() != "Asia/Tokyo" {
+		t.Fatalf("recurrence not persisted: %v", stored.Recurrence())
+	}
+
+	completedAt := scheduledAt.Add(time.Minute)
+
+	completedTask, err := domaintask.NewCompletedTask(stored, completedAt)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	next, err := stored.NextOccurrence(completedAt)
+	if err != nil || next == nil {
+		t.Fatalf("failed to build next occurrence: %v", err)
+	}
+
+	t.Run("archives and inserts next occurrence", func(t *testing.T) {
+		if err := archiveRepo.ArchiveTaskWithNextOccurrence(ctx, completedTask, task.ID(), userID, task.Version(), next); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := taskRepo.GetTaskByID(ctx, task.ID(), userID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected original task to be removed, got %v", err)
+		}
+
+		archived, err := archiveRepo.GetCompletedTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("expected completed task to exist: %v", err)
+		}
+
+		if archived.Recurrence() == nil || archived.Recurrence().Rule() != recurrence.Rule() {
+			t.Errorf("completed task recurrence mismatch: %v", archived.Recurrence())
+		}
+
+		if archived.NextOccurrenceID() == nil || *archived.NextOccurrenceID() != next.ID() {
+			t.Errorf("NextOccurrenceID mismatch: got %v, want %s", archived.NextOccurrenceID(), next.ID())
+		}
+
+		got, err := taskRepo.GetTaskByID(ctx, next.ID(), userID)
+		if err != nil {
+			t.Fatalf("expected next occurrence to exist: %v", err)
+		}
+
+		if got.Recurrence() == nil || got.Recurrence().Rule() != next.Recurrence().Rule() {
+			t.Errorf("next occurrence recurrence mismatch: %v", got.Recurrence())
+		}
+	})
+
+	t.Run("rolls back when task is already archived", func(t *testing.T) {
+		other, err := stored.NextOccurrence(completedAt)
+		if err != nil {
+			t.Fatalf("failed to build next occurrence: %v", err)
+		}
+
+		if err := archiveRepo.ArchiveTaskWithNextOccurrence(ctx, completedTask, task.ID(), userID, task.Version(), other); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+
+		if _, err := taskRepo.GetTaskByID(ctx, other.ID(), userID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected next occurrence not to be saved, got %v", err)
+		}
+	})
+	t.Run("reopens the completed occurrence as a one-off", func(t *testing.T) {
+		archived, err := archiveRepo.GetCompletedTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("failed to load completed task: %v", err)
+		}
+
+		reopened, err := archived.Reopen(completedAt.Add(time.Hour), nil)
+		if err != nil {
+			t.Fatalf("failed to reopen task: %v", err)
+		}
+
+		if err := archiveRepo.UnarchiveTask(ctx, reopened); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := taskRepo.GetTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("expected task to be restored: %v", err)
+		}
+
+		if got.Recurrence() != nil {
+			t.Errorf("expected reopened occurrence not to recur, got %q", got.Recurrence().Rule())
+		}
+
+		if _, err := taskRepo.GetTaskByID(ctx, next.ID(), userID); err != nil {
+			t.Errorf("expected next occurrence to be kept: %v", err)
+		}
+	})
+}
+
+func TestUnarchiveTaskRestoresRecurrence(t *testing.T) {
+	db := setupArchiveDB(t)
+	archiveRepo := NewTaskArchiveRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	recurrence, err := domaintask.NewRecurrence("FREQ=DAILY;COUNT=1", "")
+	if err != nil {
+		t.Fatalf("failed to create recurrence: %v", err)
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	scheduledAt := createdAt.Add(time.Hour)
+
+	task, err := domaintask.NewTask(
+		domaintask.ID(uuid.Must(uuid.NewV7())),
+		userID,
+		"Last Occurrence",
+		domaintask.TypeScheduled,
+		domaintask.StatusActive,
+		"",
+		&scheduledAt,
+		createdAt,
+		scheduledAt,
+		domaintask.MustColor("#FF6B6B"),
+		domaintask.WithRecurrence(recurrence),
+	)
+	if err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	if err := taskRepo.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	// The series has ended, so completing it archives without a next occurrence
+	completedTask, err := domaintask.NewCompletedTask(task, scheduledAt)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	if err := archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID, task.Version()); err != nil {
+		t.Fatalf("ArchiveTask failed: %v", err)
+	}
+
+	archived, err := archiveRepo.GetCompletedTaskByID(ctx, task.ID(), userID)
+	if err != nil {
+		t.Fatalf("failed to load completed task: %v", err)
+	}
+
+	if archived.NextOccurrenceID() != nil {
+		t.Errorf("expected no next occurrence, got %v", archived.NextOccurrenceID())
+	}
+
+	reopened, err := archived.Reopen(scheduledAt.Add(time.Minute), nil)
+	if err != nil {
+		t.Fatalf("failed to reopen task: %v", err)
+	}
+
+	if err := archiveRepo.UnarchiveTask(ctx, reopened); err != nil {
+		t.Fatalf("UnarchiveTask failed: %v", err)
+	}
+
+	got, err := taskRepo.GetTaskByID(ctx, task.ID(), userID)
+	if err != nil {
+		t.Fatalf("expected task to be restored: %v", err)
+	}
+
+	if got.Recurrence() == nil || got.Recurrence().Rule() != recurrence.Rule() {
+		t.Errorf("expected recurrence %q to be restored, got %v", recurrence.Rule(), got.Recurrence())
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/dataerasure"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"gorm.io/gorm"
+)
+
+type reminderEraser struct {
+	db          *gorm.DB
+	cancelQueue remindcancel.Queue
+}
+
+// NewReminderEraser cancels the reminders of every task a user has outside the trash;
+// trashed tasks had theirs cancelled when they were deleted. Registrations still
+// waiting in the outbox are dropped so they cannot land after the cancellation.
+func NewReminderEraser(db *gorm.DB, cancelQueue remindcancel.Queue) dataerasure.Eraser {
+	return &reminderEraser{
+		db:          db,
+		cancelQueue: cancelQueue,
+	}
+}
+
+func (e *reminderEraser) EraseUserData(ctx context.Context, userID string) error {
+	return conn(ctx, e.db).Transaction(func(tx *gorm.DB) error {
+		var taskIDs []string
+		if err := tx.
+			Model(&TaskModel{}).
+			Where("user_id = ?", userID).
+			Pluck("id", &taskIDs).Error; err != nil {
+			return err
+		}
+
+		if len(taskIDs) == 0 {
+			return nil
+		}
+
+		if err := tx.
+			Where("kind = ? AND task_id IN ?", string(outbox.KindRemindRegister), taskIDs).
+			Delete(&RemindOutboxModel{}).Error; err != nil {
+			return err
+		}
+
+		reqs := make([]*remindcancel.CancelRemindRequest, 0, len(taskIDs))
+		for _, taskID := range taskIDs {
+			reqs = append(reqs, &remindcancel.CancelRemindRequest{
+				TaskID: taskID,
+				UserID: userID,
+			})
+		}
+
+		// The outbox queue joins the transaction through the context
+		return remindcancel.CancelAll(context.WithValue(ctx, txContextKey{}, tx), e.cancelQueue, reqs)
+	})
+}
+
+type dataEraser struct {
+	db *gorm.DB
+}
+
+// NewDataEraser deletes the tasks of a user, trashed ones included, along with their
+// checklists and tags, the completed tasks, the change log, the period settings and
+// the calendar feed token. Cancellations queued in the outbox are kept so they are
+// still delivered.
+func NewDataEraser(db *gorm.DB) dataerasure.Eraser {
+	return &dataEraser{db: db}
+}
+
+func (e *dataEraser) EraseUserData(ctx context.Context, userID string) error {
+	return conn(ctx, e.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("task_id IN (?)", tx.Unscoped().Model(&TaskModel{}).Select("id").Where("user_id = ?", userID)).
+			Delete(&TaskTagModel{}).Error; err != nil {
+			return err
+		}
+
+		// Checklist items go with the task through their foreign key
+		for _, model := range []any{
+			&TagModel{},
+			&TaskModel{},
+			&CompletedTaskModel{},
+			&TaskChangeModel{},
+			&TaskChangeSequenceModel{},
+			&PeriodSettingModel{},
+			&CalendarFeedTokenModel{},
+		} {
+			if err := tx.Unscoped().Where("user_id = ?", userID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
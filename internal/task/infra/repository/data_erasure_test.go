@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func setupErasureDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(
+		&TaskModel{}, &ChecklistItemModel{}, &CompletedTaskModel{}, &TagModel{}, &TaskTagModel{},
+		&TaskChangeModel{}, &TaskChangeSequenceModel{}, &PeriodSettingModel{}, &CalendarFeedTokenModel{},
+		&RemindOutboxModel{},
+	); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return db
+}
+
+func countRows(t *testing.T, db *gorm.DB, model any, query string, args ...any) int64 {
+	t.Helper()
+
+	var count int64
+	if err := db.Unscoped().Model(model).Where(query, args...).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+
+	return count
+}
+
+func TestReminderEraserIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := setupErasureDB(t)
+
+	userID := domainuser.ID(uuid.Must(uuid.NewV7()))
+	task := createTestTask(t, db, userID)
+
+	queue := NewOutboxRemindRegisterQueue(db)
+	if _, err := queue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: task.ID().String(), UserID: userID.String()}); err != nil {
+		t.Fatalf("failed to queue registration: %v", err)
+	}
+
+	eraser := NewReminderEraser(db, NewOutboxRemindCancelQueue(db))
+	if err := eraser.EraseUserData(ctx, userID.String()); err != nil {
+		t.Fatalf("EraseUserData returned error: %v", err)
+	}
+
+	if n := countRows(t, db, &RemindOutboxModel{}, "kind = ?", string(outbox.KindRemindRegister)); n != 0 {
+		t.Errorf("expected queued registrations to be dropped, got %d", n)
+	}
+
+	if n := countRows(t, db, &RemindOutboxModel{}, "kind = ? AND task_id = ?", string(outbox.KindRemindCancel), task.ID().String()); n != 1 {
+		t.Errorf("expected one queued cancellation, got %d", n)
+	}
+}
+
+func TestDataEraserIntegration(t *testing.T) {
+	ctx := context.Background()
+	db := setupErasureDB(t)
+
+	userID := domainuser.ID(uuid.Must(uuid.NewV7()))
+	otherUserID := domainuser.ID(uuid.Must(uuid.NewV7()))
+
+	task := createTestTask(t, db, userID)
+	otherTask := createTestTask(t, db, otherUserID)
+
+	tag, err := domaintask.NewTag(domaintask.TagID(uuid.Must(uuid.NewV7())), userID, "home", domaintask.MustColor("#FF6B6B"), time.Now())
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	if err := NewTagRepository(db).SaveTag(ctx, tag); err != nil {
+		t.Fatalf("failed to save tag: %v", err)
+	}
+
+	if err := db.Create(&TaskTagModel{TaskID: task.ID().String(), TagID: tag.ID().String()}).Error; err != nil {
+		t.Fatalf("failed to tag task: %v", err)
+	}
+
+	if err := db.Create(&CalendarFeedTokenModel{UserID: userID.String(), TokenHash: "hash", CreatedAt: time.Now()}).Error; err != nil {
+		t.Fatalf("failed to create feed token: %v", err)
+	}
+
+	eraser := NewDataEraser(db)
+	for range 2 {
+		if err := eraser.EraseUserData(ctx, userID.String()); err != nil {
+			t.Fatalf("EraseUserData returned error: %v", err)
+		}
+	}
+
+	for _, model := range []any{&TaskModel{}, &TagModel{}, &TaskChangeModel{}, &TaskChangeSequenceModel{}, &CalendarFeedTokenModel{}} {
+		if n := countRows(t, db, model, "user_id = ?", userID.String()); n != 0 {
+			t.Errorf("expected no %T rows left, got %d", model, n)
+		}
+	}
+
+	if n := countRows(t, db, &TaskTagModel{}, "task_id = ?", task.ID().String()); n != 0 {
+		t.Errorf("expected task tags to be deleted, got %d", n)
+	}
+
+	if n := countRows(t, db, &TaskModel{}, "id = ?", otherTask.ID().String()); n != 1 {
+		t.Errorf("expected the other user's task to survive, got %d", n)
+	}
+}
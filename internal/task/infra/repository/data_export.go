@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/dataexport"
+	"gorm.io/gorm"
+)
+
+type taskExportRecord struct {
+	ID                 string                  `json:"id"`
+	Title              string                  `json:"title"`
+	TaskType           string                  `json:"task_type"`
+	TaskStatus         string                  `json:"task_status"`
+	Description        string                  `json:"description"`
+	ScheduledAt        *time.Time              `json:"scheduled_at"`
+	TargetAt           time.Time               `json:"target_at"`
+	Color              string                  `json:"color"`
+	RecurrenceRule     *string                 `json:"recurrence_rule"`
+	RecurrenceTimezone *string                 `json:"recurrence_timezone"`
+	Checklist          []checklistSnapshotItem `json:"checklist"`
+	TagIDs             []string                `json:"tag_ids"`
+	Version            int64                   `json:"version"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+	// DeletedAt is set for tasks that are in the trash
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type completedTaskExportRecord struct {
+	ID                 string                  `json:"id"`
+	Title              string                  `json:"title"`
+	TaskType           string                  `json:"task_type"`
+	Description        string                  `json:"description"`
+	ScheduledAt        *time.Time              `json:"scheduled_at"`
+	TargetAt           time.Time               `json:"target_at"`
+	Color              string                  `json:"color"`
+	RecurrenceRule     *string                 `json:"recurrence_rule"`
+	RecurrenceTimezone *string                 `json:"recurrence_timezone"`
+	Checklist          []checklistSnapshotItem `json:"checklist"`
+	TagIDs             []string                `json:"tag_ids"`
+	CreatedAt          time.Time               `json:"created_at"`
+	CompletedAt        time.Time               `json:"completed_at"`
+}
+
+type tagExportRecord struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type periodSettingExportRecord struct {
+	Periods           map[string]int       `json:"periods"`
+	ReminderIntervals map[string][]float64 `json:"reminder_intervals"`
+	QuietHours        quietHoursRecord     `json:"quiet_hours"`
+	CreatedAt         time.Time            `json:"created_at"`
+	UpdatedAt         time.Time            `json:"updated_at"`
+}
+
+type dataExporter struct {
+	db *gorm.DB
+}
+
+// NewDataExporter exports the tasks of a user, trashed ones included, together with
+// their checklists and tags, the completed tasks and the period, reminder interval and
+// quiet hours settings.
+func NewDataExporter(db *gorm.DB) dataexport.Exporter {
+	return &dataExporter{db: db}
+}
+
+func (e *dataExporter) ExportUserData(ctx context.Context, userID string) ([]dataexport.Section, error) {
+	tasks, err := e.exportTasks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	completedTasks, err := e.exportCompletedTasks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := e.exportTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	periodSettings, err := e.exportPeriodSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []dataexport.Section{
+		{Name: "tasks", Records: tasks},
+		{Name: "completed_tasks", Records: completedTasks},
+		{Name: "tags", Records: tags},
+		{Name: "user_period_settings", Records: periodSettings},
+	}, nil
+}
+
+func (e *dataExporter) exportTasks(ctx context.Context, userID string) ([]taskExportRecord, error) {
+	var tasks []TaskModel
+	if err := conn(ctx, e.db).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+
+	var items []ChecklistItemModel
+	if err := conn(ctx, e.db).
+		Joins("JOIN tasks ON tasks.id = task_checklist_items.task_id").
+		Where("tasks.user_id = ?", userID).
+		Order("task_checklist_items.task_id ASC, task_checklist_items.position ASC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	checklists := make(map[string][]checklistSnapshotItem)
+	for _, item := range items {
+		checklists[item.TaskID] = append(checklists[item.TaskID], checklistSnapshotItem{
+			ID:       item.ID,
+			Text:     item.Text,
+			Done:     item.Done,
+			Position: item.Position,
+		})
+	}
+
+	var taskTags []TaskTagModel
+	if err := conn(ctx, e.db).
+		Joins("JOIN tags ON tags.id = task_tags.tag_id").
+		Where("tags.user_id = ?", userID).
+		Order("task_tags.task_id ASC, task_tags.created_at ASC").
+		Find(&taskTags).Error; err != nil {
+		return nil, err
+	}
+
+	tagIDs := make(map[string][]string)
+	for _, taskTag := range taskTags {
+		tagIDs[taskTag.TaskID] = append(tagIDs[taskTag.TaskID], taskTag.TagID)
+	}
+
+	records := make([]taskExportRecord, 0, len(tasks))
+	for _, task := range tasks {
+		var deletedAt *time.Time
+		if task.DeletedAt.Valid {
+			deletedAt = &task.DeletedAt.Time
+		}
+
+		checklist := checklists[task.ID]
+		if checklist == nil {
+			checklist = []checklistSnapshotItem{}
+		}
+
+		ids := tagIDs[task.ID]
+		if ids == nil {
+			ids = []string{}
+		}
+
+		records = append(records, taskExportRecord{
+			ID:                 task.ID,
+			Title:              task.Title,
+			TaskType:           task.TaskType,
+			TaskStatus:         task.TaskStatus,
+			Description:        task.Description,
+			ScheduledAt:        task.ScheduledAt,
+			TargetAt:           task.TargetAt,
+			Color:              task.Color,
+			RecurrenceRule:     task.RecurrenceRule,
+			RecurrenceTimezone: task.RecurrenceTimezone,
+			Checklist:          checklist,
+			TagIDs:             ids,
+			Version:            task.Version,
+			CreatedAt:          task.CreatedAt,
+			UpdatedAt:          task.UpdatedAt,
+			DeletedAt:          deletedAt,
+		})
+	}
+
+	return records, nil
+}
+
+func (e *dataExporter) exportCompletedTasks(ctx context.Context, userID string) ([]completedTaskExportRecord, error) {
+	var completedTasks []CompletedTaskModel
+	if err := conn(ctx, e.db).
+		Where("user_id = ?", userID).
+		Order("completed_at ASC, id ASC").
+		Find(&completedTasks).Error; err != nil {
+		return nil, err
+	}
+
+	tags, err := tagsByTaskID(conn(ctx, e.db), completedTaskRecordIDs(completedTasks))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]completedTaskExportRecord, 0, len(completedTasks))
+	for _, task := range completedTasks {
+		checklist := task.Checklist
+		if checklist == nil {
+			checklist = []checklistSnapshotItem{}
+		}
+
+		ids := make([]string, 0, len(tags[task.ID]))
+		for _, tag := range tags[task.ID] {
+			ids = append(ids, tag.ID().String())
+		}
+
+		records = append(records, completedTaskExportRecord{
+			ID:                 task.ID,
+			Title:              task.Title,
+			TaskType:           task.TaskType,
+			Description:        task.Description,
+			ScheduledAt:        task.ScheduledAt,
+			TargetAt:           task.TargetAt,
+			Color:              task.Color,
+			RecurrenceRule:     task.RecurrenceRule,
+			RecurrenceTimezone: task.RecurrenceTimezone,
+			Checklist:          checklist,
+			TagIDs:             ids,
+			CreatedAt:          task.CreatedAt,
+			CompletedAt:        task.CompletedAt,
+		})
+	}
+
+	return records, nil
+}
+
+func (e *dataExporter) exportTags(ctx context.Context, userID string) ([]tagExportRecord, error) {
+	var tags []TagModel
+	if err := conn(ctx, e.db).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	records := make([]tagExportRecord, 0, len(tags))
+	for _, tag := range tags {
+		records = append(records, tagExportRecord{
+			ID:        tag.ID,
+			Name:      tag.Name,
+			Color:     tag.Color,
+			CreatedAt: tag.CreatedAt,
+		})
+	}
+
+	return records, nil
+}
+
+func (e *dataExporter) exportPeriodSettings(ctx context.Context, userID string) ([]periodSettingExportRecord, error) {
+	var settings []PeriodSettingModel
+	if err := conn(ctx, e.db).
+		Where("user_id = ?", userID).
+		Find(&settings).Error; err != nil {
+		return nil, err
+	}
+
+	records := make([]periodSettingExportRecord, 0, len(settings))
+	for _, setting := range settings {
+		records = append(records, periodSettingExportRecord{
+			Periods:           setting.Periods.Data(),
+			ReminderIntervals: setting.ReminderIntervals.Data(),
+			QuietHours:        setting.QuietHours.Data(),
+			CreatedAt:         setting.CreatedAt,
+			UpdatedAt:         setting.UpdatedAt,
+		})
+	}
+
+	return records, nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+func TestDataExporterIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(&TaskModel{}, &ChecklistItemModel{}, &CompletedTaskModel{}, &TagModel{}, &TaskTagModel{}, &TaskChangeModel{}, &TaskChangeSequenceModel{}, &PeriodSettingModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	userID := domainuser.ID(uuid.Must(uuid.NewV7()))
+	otherUserID := domainuser.ID(uuid.Must(uuid.NewV7()))
+
+	task := createTestTask(t, db, userID)
+	createTestTask(t, db, otherUserID)
+
+	if err := db.Create(&ChecklistItemModel{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		TaskID:   task.ID().String(),
+		Text:     "buy milk",
+		Position: 0,
+	}).Error; err != nil {
+		t.Fatalf("failed to create checklist item: %v", err)
+	}
+
+	if err := db.Create(&PeriodSettingModel{
+		UserID:            userID.String(),
+		Periods:           datatypes.NewJSONType(map[string]int{"NEAR": 30}),
+		ReminderIntervals: datatypes.NewJSONType(map[string][]float64{"short": {0.5}}),
+		QuietHours: datatypes.NewJSONType(quietHoursRecord{
+			Timezone:            "Asia/Tokyo",
+			Windows:             []quietWindowRecord{{Weekday: 1, StartMinute: 22 * 60, EndMinute: 7 * 60}},
+			Policy:              "shift",
+			DeadlineAlwaysFires: false,
+		}),
+	}).Error; err != nil {
+		t.Fatalf("failed to create period settings: %v", err)
+	}
+
+	now := time.Now().UTC()
+	rule := "FREQ=WEEKLY;BYDAY=MO"
+	timezone := "Asia/Tokyo"
+	completedID := uuid.Must(uuid.NewV7()).String()
+	tagID := uuid.Must(uuid.NewV7()).String()
+
+	if err := db.Create(&CompletedTaskModel{
+		ID:                 completedID,
+		UserID:             userID.String(),
+		Title:              "weekly review",
+		TaskType:           "scheduled",
+		ScheduledAt:        &now,
+		CreatedAt:          now,
+		TargetAt:           now,
+		Color:              "#FF6B6B",
+		CompletedAt:        now,
+		Checklist:          []checklistSnapshotItem{},
+		RecurrenceRule:     &rule,
+		RecurrenceTimezone: &timezone,
+	}).Error; err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	if err := db.Create(&TagModel{ID: tagID, UserID: userID.String(), Name: "work", Color: "#4ECDC4", CreatedAt: now}).Error; err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	if err := db.Create(&TaskTagModel{TaskID: completedID, TagID: tagID}).Error; err != nil {
+		t.Fatalf("failed to tag completed task: %v", err)
+	}
+
+	if err := db.Delete(&TaskModel{}, "id = ?", task.ID().String()).Error; err != nil {
+		t.Fatalf("failed to trash task: %v", err)
+	}
+
+	sections, err := NewDataExporter(db).ExportUserData(ctx, userID.String())
+	if err != nil {
+		t.Fatalf("ExportUserData returned error: %v", err)
+	}
+
+	byName := make(map[string]any, len(sections))
+	for _, section := range sections {
+		byName[section.Name] = section.Records
+	}
+
+	tasks, ok := byName["tasks"].([]taskExportRecord)
+	if !ok || len(tasks) != 1 {
+		t.Fatalf("expected the user's single task, got %+v", byName["tasks"])
+	}
+
+	if tasks[0].DeletedAt == nil || !tasks[0].DeletedAt.Before(time.Now().Add(time.Minute)) {
+		t.Errorf("expected the trashed task to carry deleted_at, got %v", tasks[0].DeletedAt)
+	}
+
+	if len(tasks[0].Checklist) != 1 || tasks[0].Checklist[0].Text != "buy milk" {
+		t.Errorf("expected the checklist to be exported, got %+v", tasks[0].Checklist)
+	}
+
+	completed, ok := byName["completed_tasks"].([]completedTaskExportRecord)
+	if !ok || len(completed) != 1 {
+		t.Fatalf("expected the user's single completed task, got %+v", byName["completed_tasks"])
+	}
+
+	if c := completed[0]; c.RecurrenceRule == nil || *c.RecurrenceRule != rule ||
+		c.RecurrenceTimezone == nil || *c.RecurrenceTimezone != timezone ||
+		len(c.TagIDs) != 1 || c.TagIDs[0] != tagID {
+		t.Errorf("expected the completed task's recurrence and tags to be exported, got %+v", c)
+	}
+
+	settings, ok := byName["user_period_settings"].([]periodSettingExportRecord)
+	if !ok || len(settings) != 1 || settings[0].Periods["NEAR"] != 30 || len(settings[0].ReminderIntervals["short"]) != 1 ||
+		settings[0].QuietHours.Timezone != "Asia/Tokyo" || len(settings[0].QuietHours.Windows) != 1 {
+		t.Errorf("unexpected period settings %+v", byName["user_period_settings"])
+	}
+}
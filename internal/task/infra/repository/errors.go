@@ -1,8 +1,30 @@
 package repository
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
 
 var (
-	ErrTaskRequired          = errors.New("task is required")
-	ErrPeriodSettingRequired = errors.New("period setting is required")
+	ErrTaskRequired              = errors.New("task is required")
+	ErrPeriodSettingRequired     = errors.New("period setting is required")
+	ErrReminderIntervalsRequired = errors.New("reminder interval settings are required")
+	ErrQuietHoursRequired        = errors.New("quiet hours settings are required")
+	ErrChecklistItemRequired     = errors.New("checklist item is required")
+	ErrTagRequired               = errors.New("tag is required")
+	ErrCalendarFeedTokenRequired = errors.New("calendar feed token is required")
+)
+
+const (
+	uniqueViolationCode = "23505"
+	tasksPrimaryKey     = "tasks_pkey"
 )
+
+// isUniqueViolation reports whether err is PostgreSQL rejecting a duplicate value of the
+// given unique constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/reconcile"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// pendingReminderLockName identifies the advisory lock shared by every replica's reconciler.
+const pendingReminderLockName = "task.pending_reminders.reconcile"
+
+type pendingReminderStore struct {
+	db *gorm.DB
+}
+
+func NewPendingReminderStore(db *gorm.DB) reconcile.Store {
+	return &pendingReminderStore{db: db}
+}
+
+func (s *pendingReminderStore) WithLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	acquired := false
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Transaction-scoped, so the lock is released on commit or rollback even if the
+		// connection goes back to the pool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(hashtext(?))", pendingReminderLockName).
+			Scan(&acquired).Error; err != nil {
+			return err
+		}
+
+		if !acquired {
+			return nil
+		}
+
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return acquired, nil
+}
+
+type pendingTaskRow struct {
+	ID            string
+	UserID        string
+	TargetAt      time.Time
+	RegisterState string
+}
+
+func (s *pendingReminderStore) ListStalePending(ctx context.Context, updatedBefore time.Time, limit int) ([]reconcile.PendingTask, error) {
+	var rows []pendingTaskRow
+
+	// Rows a user request is currently changing are left for the next pass. A register held
+	// back by a dead cancel ahead of it is as stuck as a dead one.
+	if err := conn(ctx, s.db).Raw(`
+		SELECT t.id, t.user_id, t.target_at,
+			CASE
+				WHEN EXISTS (
+					SELECT 1 FROM remind_outbox c
+					JOIN remind_outbox o ON o.task_id = c.task_id AND o.kind = @kind AND o.id > c.id
+					WHERE c.task_id = t.id AND c.kind = @cancel_kind AND c.failed_at IS NOT NULL
+				) THEN @dead
+				WHEN EXISTS (
+					SELECT 1 FROM remind_outbox o
+					WHERE o.task_id = t.id AND o.kind = @kind AND o.failed_at IS NULL
+				) THEN @pending
+				WHEN EXISTS (
+					SELECT 1 FROM remind_outbox o
+					WHERE o.task_id = t.id AND o.kind = @kind AND o.failed_at IS NOT NULL
+				) THEN @dead
+				ELSE @none
+			END AS register_state
+		FROM tasks t
+		WHERE t.task_status = @status AND t.updated_at < @updated_before AND t.deleted_at IS NULL
+		ORDER BY t.updated_at ASC
+		LIMIT @limit
+		FOR UPDATE OF t SKIP LOCKED`,
+		map[string]any{
+			"kind":           string(outbox.KindRemindRegister),
+			"cancel_kind":    string(outbox.KindRemindCancel),
+			"pending":        string(reconcile.RegisterStatePending),
+			"dead":           string(reconcile.RegisterStateDead),
+			"none":           string(reconcile.RegisterStateNone),
+			"status":         string(domaintask.StatusPendingReminders),
+			"updated_before": updatedBefore,
+			"limit":          limit,
+		},
+	).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	tasks := make([]reconcile.PendingTask, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, reconcile.PendingTask{
+			TaskID:        row.ID,
+			UserID:        row.UserID,
+			TargetAt:      row.TargetAt,
+			RegisterState: reconcile.RegisterState(row.RegisterState),
+		})
+	}
+
+	return tasks, nil
+}
+
+func (s *pendingReminderStore) RequeueRegister(ctx context.Context, taskID string, now time.Time) error {
+	// The dead cancels go out again too, in their original order ahead of the register
+	return conn(ctx, s.db).Exec(`
+		UPDATE remind_outbox
+		SET attempts = 0, next_attempt_at = @now, failed_at = NULL, last_error = ''
+		WHERE task_id = @task_id AND failed_at IS NOT NULL AND (
+			kind = @cancel_kind OR id = (
+				SELECT max(id) FROM remind_outbox
+				WHERE task_id = @task_id AND kind = @kind AND failed_at IS NOT NULL
+			)
+		)`,
+		map[string]any{
+			"now":         now,
+			"task_id":     taskID,
+			"kind":        string(outbox.KindRemindRegister),
+			"cancel_kind": string(outbox.KindRemindCancel),
+		},
+	).Error
+}
+
+func (s *pendingReminderStore) MarkActive(ctx context.Context, taskID string) error {
+	return s.resolveStatus(ctx, taskID, domaintask.StatusActive)
+}
+
+func (s *pendingReminderStore) MarkReminderFailed(ctx context.Context, taskID string) error {
+	return s.resolveStatus(ctx, taskID, domaintask.StatusReminderFailed)
+}
+
+func (s *pendingReminderStore) resolveStatus(ctx context.Context, taskID string, status domaintask.Status) error {
+	return conn(ctx, s.db).Transaction(func(tx *gorm.DB) error {
+		var resolved []TaskModel
+
+		result := tx.
+			Model(&resolved).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "user_id"}}}).
+			Where("id = ? AND task_status = ?", taskID, string(domaintask.StatusPendingReminders)).
+			Update("task_status", string(status))
+
+		if result.Error != nil || len(resolved) == 0 {
+			return result.Error
+		}
+
+		return recordTaskChanges(tx, resolved[0].UserID, upserted(taskID)...)
+	})
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/reconcile"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"gorm.io/gorm"
+)
+
+func createStalePendingTask(t *testing.T, db *gorm.DB, staleAt time.Time) *domaintask.Task {
+	t.Helper()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to generate user id: %v", err)
+	}
+
+	task := createTestTask(t, db, userID)
+
+	if err := db.Model(&TaskModel{}).
+		Where("id = ?", task.ID().String()).
+		UpdateColumns(map[string]any{
+			"task_status": string(domaintask.StatusPendingReminders),
+			"updated_at":  staleAt,
+		}).Error; err != nil {
+		t.Fatalf("failed to make task stale: %v", err)
+	}
+
+	return task
+}
+
+func TestPendingReminderStoreReconcilesStaleTasks(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	store := NewPendingReminderStore(db)
+	outboxStore := NewRemindOutboxStore(db)
+
+	withDeadRegister := createStalePendingTask(t, db, now.Add(-time.Hour))
+	withoutRegister := createStalePendingTask(t, db, now.Add(-time.Hour))
+	recent := createStalePendingTask(t, db, now)
+
+	if _, err := NewOutboxRemindRegisterQueue(db).RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: withDeadRegister.ID().String()}); err != nil {
+		t.Fatalf("failed to enqueue register: %v", err)
+	}
+
+	claimed, err := outboxStore.ClaimDue(ctx, now.Add(time.Second), time.Minute, 10)
+	if err != nil || len(claimed) != 1 {
+		t.Fatalf("failed to claim register: %v (%d claimed)", err, len(claimed))
+	}
+
+	if err := outboxStore.MarkDead(ctx, claimed[0].ID, 10, now, "queue unavailable"); err != nil {
+		t.Fatalf("failed to mark register dead: %v", err)
+	}
+
+	acquired, err := store.WithLock(ctx, func(ctx context.Context) error {
+		tasks, err := store.ListStalePending(ctx, now.Add(-time.Minute), 10)
+		if err != nil {
+			return err
+		}
+
+		states := map[string]reconcile.RegisterState{}
+		for _, task := range tasks {
+			states[task.TaskID] = task.RegisterState
+		}
+
+		if len(states) != 2 {
+			t.Fatalf("expected 2 stale tasks, got %v", states)
+		}
+
+		if _, ok := states[recent.ID().String()]; ok {
+			t.Fatalf("expected recent pending task not to be stale")
+		}
+
+		if states[withDeadRegister.ID().String()] != reconcile.RegisterStateDead {
+			t.Fatalf("expected dead register state, got %s", states[withDeadRegister.ID().String()])
+		}
+
+		if states[withoutRegister.ID().String()] != reconcile.RegisterStateNone {
+			t.Fatalf("expected no register state, got %s", states[withoutRegister.ID().String()])
+		}
+
+		if err := store.RequeueRegister(ctx, withDeadRegister.ID().String(), now); err != nil {
+			return err
+		}
+
+		if err := store.MarkActive(ctx, withDeadRegister.ID().String()); err != nil {
+			return err
+		}
+
+		return store.MarkReminderFailed(ctx, withoutRegister.ID().String())
+	})
+	if err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+
+	if !acquired {
+		t.Fatalf("expected lock to be acquired")
+	}
+
+	requeued, err := outboxStore.ClaimDue(ctx, now.Add(time.Second), time.Minute, 10)
+	if err != nil || len(requeued) != 1 || requeued[0].Attempts != 0 {
+		t.Fatalf("expected requeued register with reset attempts, got %+v (%v)", requeued, err)
+	}
+
+	taskRepo := NewTaskRepository(db)
+
+	for task, expected := range map[*domaintask.Task]domaintask.Status{
+		withDeadRegister: domaintask.StatusActive,
+		withoutRegister:  domaintask.StatusReminderFailed,
+		recent:           domaintask.StatusPendingReminders,
+	} {
+		got, err := taskRepo.GetTaskByID(ctx, task.ID(), task.UserID())
+		if err != nil {
+			t.Fatalf("failed to get task: %v", err)
+		}
+
+		if got.TaskStatus() != expected {
+			t.Fatalf("expected status %s, got %s", expected, got.TaskStatus())
+		}
+	}
+}
+
+func TestPendingReminderStoreReplaysDeadCancelBeforeRegister(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	store := NewPendingReminderStore(db)
+	outboxStore := NewRemindOutboxStore(db)
+
+	task := createStalePendingTask(t, db, now.Add(-time.Hour))
+	taskID := task.ID().String()
+
+	if _, err := NewOutboxRemindCancelQueue(db).CancelRemind(ctx, &remindcancel.CancelRemindRequest{TaskID: taskID}); err != nil {
+		t.Fatalf("failed to enqueue cancel: %v", err)
+	}
+
+	if _, err := NewOutboxRemindRegisterQueue(db).RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: taskID}); err != nil {
+		t.Fatalf("failed to enqueue register: %v", err)
+	}
+
+	claimed, err := outboxStore.ClaimDue(ctx, now.Add(time.Second), time.Minute, 10)
+	if err != nil || len(claimed) != 1 || claimed[0].Kind != outbox.KindRemindCancel {
+		t.Fatalf("failed to claim cancel: %+v (%v)", claimed, err)
+	}
+
+	if err := outboxStore.MarkDead(ctx, claimed[0].ID, 10, now, "queue unavailable"); err != nil {
+		t.Fatalf("failed to mark cancel dead: %v", err)
+	}
+
+	if _, err := store.WithLock(ctx, func(ctx context.Context) error {
+		tasks, err := store.ListStalePending(ctx, now.Add(-time.Minute), 10)
+		if err != nil {
+			return err
+		}
+
+		// The register is still pending, but it cannot go out behind the dead cancel
+		if len(tasks) != 1 || tasks[0].RegisterState != reconcile.RegisterStateDead {
+			t.Fatalf("expected the held register to count as dead, got %+v", tasks)
+		}
+
+		return store.RequeueRegister(ctx, taskID, now)
+	}); err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+
+	replayed, err := outboxStore.ClaimDue(ctx, now.Add(time.Second), time.Minute, 10)
+	if err != nil || len(replayed) != 1 || replayed[0].Kind != outbox.KindRemindCancel || replayed[0].Attempts != 0 {
+		t.Fatalf("expected the dead cancel to be replayed first, got %+v (%v)", replayed, err)
+	}
+
+	if err := outboxStore.MarkDelivered(ctx, replayed[0].ID); err != nil {
+		t.Fatalf("failed to mark delivered: %v", err)
+	}
+
+	next, err := outboxStore.ClaimDue(ctx, now.Add(time.Second), time.Minute, 10)
+	if err != nil || len(next) != 1 || next[0].Kind != outbox.KindRemindRegister {
+		t.Fatalf("expected the register after the cancel, got %+v (%v)", next, err)
+	}
+}
+
+func TestPendingReminderStoreLockIsExclusive(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	store := NewPendingReminderStore(db)
+
+	acquired, err := store.WithLock(ctx, func(context.Context) error {
+		inner, err := NewPendingReminderStore(db).WithLock(ctx, func(context.Context) error {
+			t.Fatalf("expected second holder not to run")
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if inner {
+			t.Fatalf("expected lock to be held by the outer pass")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !acquired {
+		t.Fatalf("expected outer lock to be acquired")
+	}
+}
@@ -15,10 +15,12 @@ import (
 
 // PeriodSettingModel is the GORM model for user period settings
 type PeriodSettingModel struct {
-	UserID    string                             `gorm:"type:uuid;primaryKey"`
-	Periods   datatypes.JSONType[map[string]int] `gorm:"type:jsonb;not null;default:'{}'"`
-	CreatedAt time.Time                          `gorm:"not null;autoCreateTime"`
-	UpdatedAt time.Time                          `gorm:"not null;autoUpdateTime"`
+	UserID            string                                   `gorm:"type:uuid;primaryKey"`
+	Periods           datatypes.JSONType[map[string]int]       `gorm:"type:jsonb;not null;default:'{}'"`
+	ReminderIntervals datatypes.JSONType[map[string][]float64] `gorm:"type:jsonb;not null;default:'{}'"`
+	QuietHours        datatypes.JSONType[quietHoursRecord]     `gorm:"type:jsonb;not null;default:'{}'"`
+	CreatedAt         time.Time                                `gorm:"not null;autoCreateTime"`
+	UpdatedAt         time.Time                                `gorm:"not null;autoUpdateTime"`
 }
 
 // TableName returns the table name for the model
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// quietHoursRecord is the stored form of a user's quiet hours. An empty timezone means
+// that no quiet hours are set.
+type quietHoursRecord struct {
+	Timezone            string              `json:"timezone,omitempty"`
+	Windows             []quietWindowRecord `json:"windows,omitempty"`
+	Policy              string              `json:"policy,omitempty"`
+	DeadlineAlwaysFires bool                `json:"deadline_always_fires,omitempty"`
+}
+
+type quietWindowRecord struct {
+	Weekday     int `json:"weekday"`
+	StartMinute int `json:"start_minute"`
+	EndMinute   int `json:"end_minute"`
+}
+
+// quietHoursSettingRepository stores the quiet hours in the quiet_hours column of
+// user_period_settings, next to the periods.
+type quietHoursSettingRepository struct {
+	db *gorm.DB
+}
+
+// NewQuietHoursSettingRepository creates a new quiet hours setting repository
+func NewQuietHoursSettingRepository(db *gorm.DB) period.QuietHoursSettingRepository {
+	return &quietHoursSettingRepository{db: db}
+}
+
+// GetByUserID retrieves the quiet hours settings for a user
+func (r *quietHoursSettingRepository) GetByUserID(ctx context.Context, userID user.ID) (*period.UserQuietHoursSettings, error) {
+	var record PeriodSettingModel
+	if err := r.db.WithContext(ctx).
+		Select("user_id", "quiet_hours").
+		Where("user_id = ?", userID.String()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Return empty settings if not found
+			return period.NewUserQuietHoursSettings(userID, nil), nil
+		}
+
+		return nil, err
+	}
+
+	stored := record.QuietHours.Data()
+	if stored.Timezone == "" {
+		return period.NewUserQuietHoursSettings(userID, nil), nil
+	}
+
+	windows := make([]task.QuietWindow, 0, len(stored.Windows))
+
+	for _, w := range stored.Windows {
+		window, err := task.NewQuietWindow(time.Weekday(w.Weekday), w.StartMinute, w.EndMinute)
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, window)
+	}
+
+	quietHours, err := task.NewQuietHours(stored.Timezone, windows, task.QuietHoursPolicy(stored.Policy), stored.DeadlineAlwaysFires)
+	if err != nil {
+		return nil, err
+	}
+
+	return period.NewUserQuietHoursSettings(userID, quietHours), nil
+}
+
+// Save replaces the quiet hours settings for a user. The periods stored in the same row
+// are left untouched.
+func (r *quietHoursSettingRepository) Save(ctx context.Context, settings *period.UserQuietHoursSettings) error {
+	if settings == nil {
+		return ErrQuietHoursRequired
+	}
+
+	var stored quietHoursRecord
+
+	if quietHours := settings.QuietHours(); quietHours != nil {
+		windows := make([]quietWindowRecord, 0, len(quietHours.Windows()))
+		for _, w := range quietHours.Windows() {
+			windows = append(windows, quietWindowRecord{
+				Weekday:     int(w.Weekday()),
+				StartMinute: w.StartMinute(),
+				EndMinute:   w.EndMinute(),
+			})
+		}
+
+		stored = quietHoursRecord{
+			Timezone:            quietHours.Timezone(),
+			Windows:             windows,
+			Policy:              string(quietHours.Policy()),
+			DeadlineAlwaysFires: quietHours.DeadlineAlwaysFires(),
+		}
+	}
+
+	record := PeriodSettingModel{
+		UserID:     settings.UserID().String(),
+		QuietHours: datatypes.NewJSONType(stored),
+	}
+
+	// Upsert: create if not exists, update if exists
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"quiet_hours", "updated_at"}),
+		}).
+		Create(&record).Error
+}
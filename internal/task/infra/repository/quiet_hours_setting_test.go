@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+)
+
+func TestQuietHoursSettingRepository(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(&PeriodSettingModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	repo := NewQuietHoursSettingRepository(db)
+	intervalRepo := NewReminderIntervalSettingRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	empty, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get settings: %v", err)
+	}
+
+	if !empty.IsEmpty() {
+		t.Fatal("expected no quiet hours before saving")
+	}
+
+	intervals, err := period.NewUserReminderIntervalSettings(userID, map[domaintask.Type][]domaintask.ReminderInterval{
+		domaintask.TypeShort: {0.5},
+	})
+	if err != nil {
+		t.Fatalf("failed to create reminder interval settings: %v", err)
+	}
+
+	if err := intervalRepo.Save(ctx, intervals); err != nil {
+		t.Fatalf("failed to save reminder interval settings: %v", err)
+	}
+
+	window, err := domaintask.NewQuietWindow(time.Friday, 23*60, 8*60)
+	if err != nil {
+		t.Fatalf("failed to create quiet window: %v", err)
+	}
+
+	quietHours, err := domaintask.NewQuietHours("Asia/Tokyo", []domaintask.QuietWindow{window}, domaintask.QuietHoursPolicyDrop, true)
+	if err != nil {
+		t.Fatalf("failed to create quiet hours: %v", err)
+	}
+
+	if err := repo.Save(ctx, period.NewUserQuietHoursSettings(userID, quietHours)); err != nil {
+		t.Fatalf("failed to save settings: %v", err)
+	}
+
+	got, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get settings: %v", err)
+	}
+
+	if got.IsEmpty() {
+		t.Fatal("expected the saved quiet hours")
+	}
+
+	saved := got.QuietHours()
+	if saved.Timezone() != "Asia/Tokyo" || saved.Policy() != domaintask.QuietHoursPolicyDrop || !saved.DeadlineAlwaysFires() {
+		t.Errorf("unexpected quiet hours %s %s %v", saved.Timezone(), saved.Policy(), saved.DeadlineAlwaysFires())
+	}
+
+	if windows := saved.Windows(); len(windows) != 1 || windows[0] != window {
+		t.Errorf("unexpected windows %v", windows)
+	}
+
+	gotIntervals, err := intervalRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get reminder interval settings: %v", err)
+	}
+
+	if len(gotIntervals.Intervals()[domaintask.TypeShort]) != 1 {
+		t.Errorf("expected saving quiet hours to keep the intervals, got %v", gotIntervals.Intervals())
+	}
+
+	// Saving without quiet hours clears them
+	if err := repo.Save(ctx, period.NewUserQuietHoursSettings(userID, nil)); err != nil {
+		t.Fatalf("failed to clear settings: %v", err)
+	}
+
+	cleared, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get settings: %v", err)
+	}
+
+	if !cleared.IsEmpty() {
+		t.Error("expected the quiet hours to be cleared")
+	}
+}
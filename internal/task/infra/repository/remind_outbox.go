@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RemindOutboxModel struct {
+	ID            int64      `gorm:"primaryKey;autoIncrement"`
+	Kind          string     `gorm:"type:varchar(32);not null"`
+	TaskID        string     `gorm:"type:uuid;not null;index:idx_remind_outbox_task_id"`
+	Payload       []byte     `gorm:"type:jsonb;not null"`
+	Attempts      int        `gorm:"not null;default:0"`
+	NextAttemptAt time.Time  `gorm:"type:timestamptz;not null;index:idx_remind_outbox_next_attempt_at"`
+	LastError     string     `gorm:"type:text"`
+	FailedAt      *time.Time `gorm:"type:timestamptz"`
+	CreatedAt     time.Time  `gorm:"type:timestamptz;not null"`
+}
+
+func (RemindOutboxModel) TableName() string {
+	return "remind_outbox"
+}
+
+// outboxRemindQueue records remind queue requests in the outbox instead of sending them, so
+// they commit or roll back together with the task change made in the same transaction.
+type outboxRemindQueue struct {
+	db *gorm.DB
+}
+
+func NewOutboxRemindRegisterQueue(db *gorm.DB) remindregister.Queue {
+	return &outboxRemindQueue{db: db}
+}
+
+func NewOutboxRemindCancelQueue(db *gorm.DB) remindcancel.Queue {
+	return &outboxRemindQueue{db: db}
+}
+
+func (q *outboxRemindQueue) RegisterRemind(ctx context.Context, req *remindregister.CreateRemindRequest) (*remindregister.RemindResponse, error) {
+	record, err := q.enqueue(ctx, outbox.KindRemindRegister, req.TaskID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remindregister.RemindResponse{
+		Name:       outboxMessageName(record.ID),
+		CreateTime: record.CreatedAt,
+	}, nil
+}
+
+func (q *outboxRemindQueue) CancelRemind(ctx context.Context, req *remindcancel.CancelRemindRequest) (*remindcancel.CancelRemindResponse, error) {
+	record, err := q.enqueue(ctx, outbox.KindRemindCancel, req.TaskID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remindcancel.CancelRemindResponse{
+		Name:       outboxMessageName(record.ID),
+		CreateTime: record.CreatedAt,
+	}, nil
+}
+
+// RegisterReminds records the registrations in a single insert. They are still delivered one
+// message per task, which keeps each registration ordered with the other messages of its task.
+func (q *outboxRemindQueue) RegisterReminds(ctx context.Context, reqs []*remindregister.CreateRemindRequest) error {
+	records := make([]RemindOutboxModel, 0, len(reqs))
+
+	for _, req := range reqs {
+		record, err := newOutboxRecord(outbox.KindRemindRegister, req.TaskID, req)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+	}
+
+	return q.enqueueAll(ctx, records)
+}
+
+// CancelReminds records the cancellations in a single insert. They are still delivered one
+// message per task, which keeps each cancel ordered with the other messages of its task.
+func (q *outboxRemindQueue) CancelReminds(ctx context.Context, reqs []*remindcancel.CancelRemindRequest) error {
+	records := make([]RemindOutboxModel, 0, len(reqs))
+
+	for _, req := range reqs {
+		record, err := newOutboxRecord(outbox.KindRemindCancel, req.TaskID, req)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+	}
+
+	return q.enqueueAll(ctx, records)
+}
+
+func (q *outboxRemindQueue) enqueueAll(ctx context.Context, records []RemindOutboxModel) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	return conn(ctx, q.db).Create(&records).Error
+}
+
+func (q *outboxRemindQueue) enqueue(ctx context.Context, kind outbox.Kind, taskID string, req any) (RemindOutboxModel, error) {
+	record, err := newOutboxRecord(kind, taskID, req)
+	if err != nil {
+		return RemindOutboxModel{}, err
+	}
+
+	if err := conn(ctx, q.db).Create(&record).Error; err != nil {
+		return RemindOutboxModel{}, err
+	}
+
+	return record, nil
+}
+
+func newOutboxRecord(kind outbox.Kind, taskID string, req any) (RemindOutboxModel, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return RemindOutboxModel{}, fmt.Errorf("failed to marshal %s payload: %w", kind, err)
+	}
+
+	now := time.Now().UTC()
+
+	return RemindOutboxModel{
+		Kind:          string(kind),
+		TaskID:        taskID,
+		Payload:       payload,
+		Attempts:      0,
+		NextAttemptAt: now,
+		LastError:     "",
+		FailedAt:      nil,
+		CreatedAt:     now,
+	}, nil
+}
+
+func outboxMessageName(id int64) string {
+	return fmt.Sprintf("outbox/%d", id)
+}
+
+type remindOutboxStore struct {
+	db *gorm.DB
+}
+
+func NewRemindOutboxStore(db *gorm.DB) outbox.Store {
+	return &remindOutboxStore{db: db}
+}
+
+// ClaimDue leases the oldest due message of each task. A dead cancel keeps holding back the
+// later messages of its task, since delivering them would leave the cancelled reminders in
+// place; the reconciler replays it before they go out.
+func (s *remindOutboxStore) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]outbox.Message, error) {
+	var records []RemindOutboxModel
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("failed_at IS NULL AND next_attempt_at <= ?", now).
+			Where(`NOT EXISTS (
+				SELECT 1 FROM remind_outbox AS earlier
+				WHERE earlier.task_id = remind_outbox.task_id
+				AND earlier.id < remind_outbox.id
+				AND (earlier.failed_at IS NULL OR earlier.kind = ?)
+			)`, string(outbox.KindRemindCancel)).
+			Order("id ASC").
+			Limit(limit).
+			Find(&records).Error; err != nil {
+			return err
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(records))
+		for _, record := range records {
+			ids = append(ids, record.ID)
+		}
+
+		return tx.Model(&RemindOutboxModel{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]outbox.Message, 0, len(records))
+	for _, record := range records {
+		messages = append(messages, outbox.Message{
+			ID:       record.ID,
+			Kind:     outbox.Kind(record.Kind),
+			TaskID:   record.TaskID,
+			Payload:  record.Payload,
+			Attempts: record.Attempts,
+		})
+	}
+
+	return messages, nil
+}
+
+func (s *remindOutboxStore) MarkDelivered(ctx context.Context, id int64) error {
+	return s.db.WithContext(ctx).Delete(&RemindOutboxModel{}, id).Error
+}
+
+func (s *remindOutboxStore) MarkRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	return s.db.WithContext(ctx).
+		Model(&RemindOutboxModel{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":        attempts,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		}).Error
+}
+
+func (s *remindOutboxStore) MarkDead(ctx context.Context, id int64, attempts int, failedAt time.Time, lastError string) error {
+	return s.db.WithContext(ctx).
+		Model(&RemindOutboxModel{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":   attempts,
+			"failed_at":  failedAt,
+			"last_error": lastError,
+		}).Error
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func setupOutboxDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(&TaskModel{}, &ChecklistItemModel{}, &RemindOutboxModel{}, &TagModel{}, &TaskTagModel{}, &TaskChangeModel{}, &TaskChangeSequenceModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return db
+}
+
+func TestOutboxRemindQueueRollsBackWithTransaction(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	transactor := NewTransactor(db)
+	registerQueue := NewOutboxRemindRegisterQueue(db)
+	taskID := uuid.Must(uuid.NewV7()).String()
+
+	errRollback := errors.New("rollback")
+
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := registerQueue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: taskID}); err != nil {
+			t.Fatalf("failed to enqueue register: %v", err)
+		}
+
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("expected rollback error, got %v", err)
+	}
+
+	var count int64
+	if err := db.Model(&RemindOutboxModel{}).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count outbox rows: %v", err)
+	}
+
+	if count != 0 {
+		t.Fatalf("expected no outbox rows after rollback, got %d", count)
+	}
+}
+
+func TestOutboxRemindQueueCancelsBatch(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	cancelQueue := NewOutboxRemindCancelQueue(db)
+
+	reqs := make([]*remindcancel.CancelRemindRequest, 0, 3)
+	for range 3 {
+		reqs = append(reqs, &remindcancel.CancelRemindRequest{TaskID: uuid.Must(uuid.NewV7()).String()})
+	}
+
+	if err := remindcancel.CancelAll(ctx, cancelQueue, reqs); err != nil {
+		t.Fatalf("failed to enqueue cancels: %v", err)
+	}
+
+	var records []RemindOutboxModel
+	if err := db.Order("id ASC").Find(&records).Error; err != nil {
+		t.Fatalf("failed to load outbox rows: %v", err)
+	}
+
+	if len(records) != len(reqs) {
+		t.Fatalf("expected %d outbox rows, got %d", len(reqs), len(records))
+	}
+
+	for i, record := range records {
+		if record.Kind != string(outbox.KindRemindCancel) || record.TaskID != reqs[i].TaskID {
+			t.Errorf("unexpected outbox row %d: kind %s, task %s", i, record.Kind, record.TaskID)
+		}
+	}
+}
+
+func TestOutboxRemindQueueRegistersBatch(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	registerQueue := NewOutboxRemindRegisterQueue(db)
+
+	reqs := make([]*remindregister.CreateRemindRequest, 0, 3)
+	for range 3 {
+		reqs = append(reqs, &remindregister.CreateRemindRequest{TaskID: uuid.Must(uuid.NewV7()).String()})
+	}
+
+	if err := remindregister.RegisterAll(ctx, registerQueue, reqs); err != nil {
+		t.Fatalf("failed to enqueue registrations: %v", err)
+	}
+
+	var records []RemindOutboxModel
+	if err := db.Order("id ASC").Find(&records).Error; err != nil {
+		t.Fatalf("failed to load outbox rows: %v", err)
+	}
+
+	if len(records) != len(reqs) {
+		t.Fatalf("expected %d outbox rows, got %d", len(reqs), len(records))
+	}
+
+	for i, record := range records {
+		if record.Kind != string(outbox.KindRemindRegister) || record.TaskID != reqs[i].TaskID {
+			t.Errorf("unexpected outbox row %d: kind %s, task %s", i, record.Kind, record.TaskID)
+		}
+	}
+}
+
+func TestRemindOutboxStoreClaimsOldestMessagePerTask(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	registerQueue := NewOutboxRemindRegisterQueue(db)
+	cancelQueue := NewOutboxRemindCancelQueue(db)
+	store := NewRemindOutboxStore(db)
+
+	taskA := uuid.Must(uuid.NewV7()).String()
+	taskB := uuid.Must(uuid.NewV7()).String()
+
+	if _, err := cancelQueue.CancelRemind(ctx, &remindcancel.CancelRemindRequest{TaskID: taskA}); err != nil {
+		t.Fatalf("failed to enqueue cancel: %v", err)
+	}
+
+	if _, err := registerQueue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: taskA}); err != nil {
+		t.Fatalf("failed to enqueue register: %v", err)
+	}
+
+	if _, err := registerQueue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: taskB}); err != nil {
+		t.Fatalf("failed to enqueue register: %v", err)
+	}
+
+	now := time.Now().UTC().Add(time.Second)
+
+	messages, err := store.ClaimDue(ctx, now, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to claim: %v", err)
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 claimed messages, got %d", len(messages))
+	}
+
+	if messages[0].TaskID != taskA || messages[0].Kind != outbox.KindRemindCancel {
+		t.Fatalf("expected cancel for task A first, got %+v", messages[0])
+	}
+
+	if messages[1].TaskID != taskB {
+		t.Fatalf("expected register for task B second, got %+v", messages[1])
+	}
+
+	// Claimed messages are leased
+	leased, err := store.ClaimDue(ctx, now, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to claim: %v", err)
+	}
+
+	if len(leased) != 0 {
+		t.Fatalf("expected leased messages to be hidden, got %d", len(leased))
+	}
+
+	if err := store.MarkDelivered(ctx, messages[0].ID); err != nil {
+		t.Fatalf("failed to mark delivered: %v", err)
+	}
+
+	next, err := store.ClaimDue(ctx, now, time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to claim: %v", err)
+	}
+
+	if len(next) != 1 || next[0].TaskID != taskA || next[0].Kind != outbox.KindRemindRegister {
+		t.Fatalf("expected register for task A after cancel delivered, got %+v", next)
+	}
+}
+
+func TestRemindOutboxStoreHoldsMessagesBehindDeadCancel(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	registerQueue := NewOutboxRemindRegisterQueue(db)
+	cancelQueue := NewOutboxRemindCancelQueue(db)
+	store := NewRemindOutboxStore(db)
+
+	taskID := uuid.Must(uuid.NewV7()).String()
+
+	if _, err := cancelQueue.CancelRemind(ctx, &remindcancel.CancelRemindRequest{TaskID: taskID}); err != nil {
+		t.Fatalf("failed to enqueue cancel: %v", err)
+	}
+
+	if _, err := registerQueue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: taskID}); err != nil {
+		t.Fatalf("failed to enqueue register: %v", err)
+	}
+
+	now := time.Now().UTC().Add(time.Second)
+
+	claimed, err := store.ClaimDue(ctx, now, time.Minute, 10)
+	if err != nil || len(claimed) != 1 || claimed[0].Kind != outbox.KindRemindCancel {
+		t.Fatalf("expected the cancel to be claimed, got %+v (%v)", claimed, err)
+	}
+
+	if err := store.MarkDead(ctx, claimed[0].ID, 10, now, "queue unavailable"); err != nil {
+		t.Fatalf("failed to mark cancel dead: %v", err)
+	}
+
+	// The register would leave the old reminders in place if it went out without the cancel
+	held, err := store.ClaimDue(ctx, now.Add(2*time.Minute), time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to claim: %v", err)
+	}
+
+	if len(held) != 0 {
+		t.Fatalf("expected the register to be held behind the dead cancel, got %+v", held)
+	}
+}
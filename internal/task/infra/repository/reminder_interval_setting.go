@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reminderIntervalSettingRepository stores the reminder intervals in the
+// reminder_intervals column of user_period_settings, next to the periods.
+type reminderIntervalSettingRepository struct {
+	db *gorm.DB
+}
+
+// NewReminderIntervalSettingRepository creates a new reminder interval setting repository
+func NewReminderIntervalSettingRepository(db *gorm.DB) period.ReminderIntervalSettingRepository {
+	return &reminderIntervalSettingRepository{db: db}
+}
+
+// GetByUserID retrieves the reminder interval settings for a user
+func (r *reminderIntervalSettingRepository) GetByUserID(ctx context.Context, userID user.ID) (*period.UserReminderIntervalSettings, error) {
+	var record PeriodSettingModel
+	if err := r.db.WithContext(ctx).
+		Select("user_id", "reminder_intervals").
+		Where("user_id = ?", userID.String()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Return empty settings if not found
+			return period.NewUserReminderIntervalSettings(userID, nil)
+		}
+
+		return nil, err
+	}
+
+	intervals := make(map[task.Type][]task.ReminderInterval)
+
+	for key, values := range record.ReminderIntervals.Data() {
+		taskType, err := task.NewType(key)
+		if err != nil {
+			continue // Skip invalid task types
+		}
+
+		converted := make([]task.ReminderInterval, 0, len(values))
+		for _, value := range values {
+			converted = append(converted, task.ReminderInterval(value))
+		}
+
+		intervals[taskType] = converted
+	}
+
+	return period.NewUserReminderIntervalSettings(userID, intervals)
+}
+
+// Save replaces the reminder interval settings for a user. The periods stored in the
+// same row are left untouched.
+func (r *reminderIntervalSettingRepository) Save(ctx context.Context, settings *period.UserReminderIntervalSettings) error {
+	if settings == nil {
+		return ErrReminderIntervalsRequired
+	}
+
+	intervals := make(map[string][]float64)
+
+	for taskType, values := range settings.Intervals() {
+		converted := make([]float64, 0, len(values))
+		for _, value := range values {
+			converted = append(converted, float64(value))
+		}
+
+		intervals[string(taskType)] = converted
+	}
+
+	record := PeriodSettingModel{
+		UserID:            settings.UserID().String(),
+		ReminderIntervals: datatypes.NewJSONType(intervals),
+	}
+
+	// Upsert: create if not exists, update if exists
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reminder_intervals", "updated_at"}),
+		}).
+		Create(&record).Error
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+)
+
+func TestReminderIntervalSettingRepository(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
+	t.Cleanup(cleanup)
+
+	if err := db.AutoMigrate(&PeriodSettingModel{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	repo := NewReminderIntervalSettingRepository(db)
+	periodRepo := NewPeriodSettingRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	empty, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get settings: %v", err)
+	}
+
+	if !empty.IsEmpty() {
+		t.Fatalf("expected no settings before saving, got %v", empty.Intervals())
+	}
+
+	periods, err := period.NewUserPeriodSettings(userID, map[domaintask.Type]int{domaintask.TypeNear: 90})
+	if err != nil {
+		t.Fatalf("failed to create period settings: %v", err)
+	}
+
+	if err := periodRepo.Save(ctx, periods); err != nil {
+		t.Fatalf("failed to save period settings: %v", err)
+	}
+
+	settings, err := period.NewUserReminderIntervalSettings(userID, map[domaintask.Type][]domaintask.ReminderInterval{
+		domaintask.TypeShort: {0.5, 0.8},
+		domaintask.TypeNear:  {},
+	})
+	if err != nil {
+		t.Fatalf("failed to create settings: %v", err)
+	}
+
+	if err := repo.Save(ctx, settings); err != nil {
+		t.Fatalf("failed to save settings: %v", err)
+	}
+
+	got, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get settings: %v", err)
+	}
+
+	intervals := got.Intervals()
+	if !slices.Equal(intervals[domaintask.TypeShort], []domaintask.ReminderInterval{0.5, 0.8}) {
+		t.Errorf("unexpected short intervals %v", intervals[domaintask.TypeShort])
+	}
+
+	if near, ok := intervals[domaintask.TypeNear]; !ok || len(near) != 0 {
+		t.Errorf("expected the empty near override to be kept, got %v (present %v)", near, ok)
+	}
+
+	gotPeriods, err := periodRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("failed to get period settings: %v", err)
+	}
+
+	if minutes := gotPeriods.Periods()[domaintask.TypeNear]; minutes != 90 {
+		t.Errorf("expected saving intervals to keep the periods, got %d", minutes)
+	}
+}
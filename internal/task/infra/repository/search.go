@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/gorm"
+)
+
+const (
+	searchSourceActive    = "active"
+	searchSourceCompleted = "completed"
+)
+
+// searchTasksSQL ranks the user's active and archived tasks against the search text.
+// Whole words are matched through the search_vector column and partial words through
+// trigram word similarity, which also covers text without word boundaries.
+// Highlights are only computed for the rows of the requested page, from HTML-escaped text
+// so that the markup in a highlight is only ever the highlight markers.
+var searchTasksSQL = fmt.Sprintf(`
+WITH search AS (
+	SELECT websearch_to_tsquery('simple', @text) AS query
+), hits AS (
+	%s
+	UNION ALL
+	%s
+)
+SELECT
+	page.source,
+	page.id,
+	page.score,
+	ts_headline('simple', %s, search.query, @title_options) AS title_highlight,
+	ts_headline('simple', %s, search.query, @description_options) AS description_highlight
+FROM (
+	SELECT * FROM hits ORDER BY score DESC, id DESC LIMIT @limit OFFSET @offset
+) AS page, search
+ORDER BY page.score DESC, page.id DESC`,
+	searchHitsSQL("tasks", searchSourceActive, " AND t.task_status IN @active_statuses AND t.deleted_at IS NULL"),
+	searchHitsSQL("completed_tasks", searchSourceCompleted, ""),
+	escapeHTMLSQL("page.title"),
+	escapeHTMLSQL("page.description"),
+)
+
+// escapeHTMLSQL returns an SQL expression escaping the HTML special characters of column,
+// & first so that the entities added after it are not escaped again. The text search
+// parser reads entities as single tokens, so a headline never cuts one apart.
+func escapeHTMLSQL(column string) string {
+	return fmt.Sprintf(
+		`replace(replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`,
+		column,
+	)
+}
+
+func searchHitsSQL(table, source, condition string) string {
+	return fmt.Sprintf(`SELECT
+		'%[2]s' AS source,
+		t.id,
+		t.title,
+		t.description,
+		ts_rank(t.search_vector, search.query) + word_similarity(@text, t.title) AS score
+	FROM %[1]s AS t, search
+	WHERE t.user_id = @user_id%[3]s
+		AND (CAST(@tag_id AS uuid) IS NULL OR t.id IN (SELECT task_id FROM task_tags WHERE tag_id = CAST(@tag_id AS uuid)))
+		AND (t.search_vector @@ search.query OR @text <%% t.title OR @text <%% t.description)`,
+		table, source, condition)
+}
+
+var (
+	searchTitleHeadlineOptions = fmt.Sprintf(
+		`StartSel="%s", StopSel="%s", HighlightAll=true`,
+		domaintask.SearchHighlightStart, domaintask.SearchHighlightStop,
+	)
+	searchDescriptionHeadlineOptions = fmt.Sprintf(
+		`StartSel="%s", StopSel="%s", MaxFragments=2, MaxWords=20, MinWords=5`,
+		domaintask.SearchHighlightStart, domaintask.SearchHighlightStop,
+	)
+)
+
+type searchHitRow struct {
+	Source               string
+	ID                   string
+	Score                float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+type taskSearchRepository struct {
+	db    *gorm.DB
+	tasks *taskRepository
+}
+
+func NewTaskSearchRepository(db *gorm.DB) domaintask.TaskSearchRepository {
+	return &taskSearchRepository{
+		db:    db,
+		tasks: &taskRepository{db: db},
+	}
+}
+
+func (r *taskSearchRepository) SearchTasks(
+	ctx context.Context,
+	userID domainuser.ID,
+	query domaintask.SearchTasksQuery,
+) ([]*domaintask.SearchHit, *domaintask.SearchCursor, error) {
+	text, err := domaintask.NewSearchText(query.Text)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pageSize, err := domaintask.NormalizePageSize(query.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := 0
+	if query.Cursor != nil {
+		offset = query.Cursor.Offset()
+	}
+
+	var tagID *string
+	if query.TagID != nil {
+		id := query.TagID.String()
+		tagID = &id
+	}
+
+	db := conn(ctx, r.db)
+
+	var rows []searchHitRow
+
+	// Fetch one extra row to find out whether another page exists.
+	if err := db.Raw(searchTasksSQL, map[string]any{
+		"text":                text,
+		"user_id":             userID.String(),
+		"active_statuses":     activeTaskStatuses,
+		"tag_id":              tagID,
+		"title_options":       searchTitleHeadlineOptions,
+		"description_options": searchDescriptionHeadlineOptions,
+		"limit":               pageSize + 1,
+		"offset":              offset,
+	}).Scan(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(rows) > pageSize
+	if hasMore {
+		rows = rows[:pageSize]
+	}
+
+	tasks, completedTasks, err := r.loadHitTasks(db, userID, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hits := make([]*domaintask.SearchHit, 0, len(rows))
+
+	for _, row := range rows {
+		var (
+			hit *domaintask.SearchHit
+			err error
+		)
+
+		switch row.Source {
+		case searchSourceActive:
+			task, ok := tasks[row.ID]
+			if !ok {
+				// Completed or deleted since the search ran
+				continue
+			}
+
+			hit, err = domaintask.NewActiveSearchHit(task, row.Score, row.TitleHighlight, row.DescriptionHighlight)
+		case searchSourceCompleted:
+			completedTask, ok := completedTasks[row.ID]
+			if !ok {
+				continue
+			}
+
+			hit, err = domaintask.NewCompletedSearchHit(completedTask, row.Score, row.TitleHighlight, row.DescriptionHighlight)
+		default:
+			return nil, nil, fmt.Errorf("unknown search hit source %q", row.Source)
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		hits = append(hits, hit)
+	}
+
+	if !hasMore {
+		return hits, nil, nil
+	}
+
+	return hits, domaintask.NewSearchCursor(text, offset+pageSize), nil
+}
+
+// loadHitTasks loads the tasks referenced by the hits, keyed by task ID.
+func (r *taskSearchRepository) loadHitTasks(
+	db *gorm.DB,
+	userID domainuser.ID,
+	rows []searchHitRow,
+) (map[string]*domaintask.Task, map[string]*domaintask.CompletedTask, error) {
+	var activeIDs, completedIDs []string
+
+	for _, row := range rows {
+		if row.Source == searchSourceActive {
+			activeIDs = append(activeIDs, row.ID)
+		} else {
+			completedIDs = append(completedIDs, row.ID)
+		}
+	}
+
+	tasks := make(map[string]*domaintask.Task, len(activeIDs))
+
+	if len(activeIDs) > 0 {
+		var records []TaskModel
+		if err := db.
+			Where("id IN ? AND user_id = ?", activeIDs, userID.String()).
+			Find(&records).Error; err != nil {
+			return nil, nil, err
+		}
+
+		progress, err := checklistProgressByTaskID(db, taskRecordIDs(records))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tags, err := tagsByTaskID(db, taskRecordIDs(records))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, record := range records {
+			task, err := r.tasks.recordToTask(record, progress[record.ID], tags[record.ID])
+			if err != nil {
+				return nil, nil, err
+			}
+
+			tasks[record.ID] = task
+		}
+	}
+
+	completedTasks := make(map[string]*domaintask.CompletedTask, len(completedIDs))
+
+	if len(completedIDs) > 0 {
+		var records []CompletedTaskModel
+		if err := db.
+			Where("id IN ? AND user_id = ?", completedIDs, userID.String()).
+			Find(&records).Error; err != nil {
+			return nil, nil, err
+		}
+
+		tags, err := tagsByTaskID(db, completedTaskRecordIDs(records))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, record := range records {
+			completedTask, err := recordToCompletedTask(record, tags[record.ID])
+			if err != nil {
+				return nil, nil, err
+			}
+
+			completedTasks[record.ID] = completedTask
+		}
+	}
+
+	return tasks, completedTasks, nil
+}
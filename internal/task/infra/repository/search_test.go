@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/google/uuid"
+)
+
+func TestSearchTasks(t *testing.T) {
+	db := setupArchiveDB(t)
+	ctx := context.Background()
+
+	taskRepo := NewTaskRepository(db)
+	archiveRepo := NewTaskArchiveRepository(db)
+	searchRepo := NewTaskSearchRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	otherUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	saveTask := func(t *testing.T, owner domainuser.ID, title, description string) *domaintask.Task {
+		t.Helper()
+
+		task, err := domaintask.NewTask(
+			domaintask.ID(uuid.Must(uuid.NewV7())),
+			owner,
+			title,
+			domaintask.TypeNear,
+			domaintask.StatusActive,
+			description,
+			nil,
+			now,
+			now.Add(time.Hour),
+			domaintask.MustColor("#FF6B6B"),
+		)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+
+		return task
+	}
+
+	titleMatch := saveTask(t, userID, "Buy groceries", "")
+	descriptionMatch := saveTask(t, userID, "Weekend errands", "pick up groceries and bread")
+	saveTask(t, userID, "Call the dentist", "")
+	saveTask(t, otherUserID, "Buy groceries for the party", "")
+
+	archived := saveTask(t, userID, "Groceries last week", "")
+
+	completedTask, err := domaintask.NewCompletedTask(archived, now)
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	if err := archiveRepo.ArchiveTask(ctx, completedTask, archived.ID(), userID, archived.Version()); err != nil {
+		t.Fatalf("failed to archive task: %v", err)
+	}
+
+	t.Run("matches active and archived tasks of the user", func(t *testing.T) {
+		hits, cursor, err := searchRepo.SearchTasks(ctx, userID, domaintask.SearchTasksQuery{Text: "groceries"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cursor != nil {
+			t.Errorf("expected no next cursor, got %v", cursor.Encode())
+		}
+
+		found := map[string]*domaintask.SearchHit{}
+
+		for _, hit := range hits {
+			if hit.Task() != nil {
+				found[hit.Task().ID().String()] = hit
+			} else {
+				found[hit.CompletedTask().ID().String()] = hit
+			}
+		}
+
+		if len(found) != 3 {
+			t.Fatalf("expected 3 hits, got %d", len(found))
+		}
+
+		for _, id := range []domaintask.ID{titleMatch.ID(), descriptionMatch.ID(), archived.ID()} {
+			if _, ok := found[id.String()]; !ok {
+				t.Errorf("expected hit for %s", id.String())
+			}
+		}
+
+		if hit := found[archived.ID().String()]; hit != nil && hit.CompletedTask() == nil {
+			t.Errorf("expected archived task to be returned as a completed task")
+		}
+
+		if hit := found[titleMatch.ID().String()]; hit != nil && !strings.Contains(hit.TitleHighlight(), domaintask.SearchHighlightStart+"groceries"+domaintask.SearchHighlightStop) {
+			t.Errorf("expected highlighted title, got %q", hit.TitleHighlight())
+		}
+
+		if found[titleMatch.ID().String()].Score() <= found[descriptionMatch.ID().String()].Score() {
+			t.Errorf("expected title match to rank above description match")
+		}
+	})
+
+	t.Run("matches partial words", func(t *testing.T) {
+		hits, _, err := searchRepo.SearchTasks(ctx, userID, domaintask.SearchTasksQuery{Text: "dentis"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(hits) != 1 || hits[0].Task() == nil || hits[0].Task().Title() != "Call the dentist" {
+			t.Fatalf("expected only the dentist task, got %d hits", len(hits))
+		}
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		firstPage, cursor, err := searchRepo.SearchTasks(ctx, userID, domaintask.SearchTasksQuery{Text: "groceries", PageSize: 2})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(firstPage) != 2 || cursor == nil {
+			t.Fatalf("expected 2 hits and a next cursor, got %d hits", len(firstPage))
+		}
+
+		secondPage, nextCursor, err := searchRepo.SearchTasks(ctx, userID, domaintask.SearchTasksQuery{Text: "groceries", PageSize: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(secondPage) != 1 || nextCursor != nil {
+			t.Fatalf("expected 1 hit on the last page, got %d", len(secondPage))
+		}
+	})
+
+	t.Run("rejects empty text", func(t *testing.T) {
+		_, _, err := searchRepo.SearchTasks(ctx, userID, domaintask.SearchTasksQuery{Text: " "})
+		if !errors.Is(err, domaintask.ErrSearchTextEmpty) {
+			t.Fatalf("expected ErrSearchTextEmpty, got %v", err)
+		}
+	})
+
+	t.Run("escapes HTML in highlights", func(t *testing.T) {
+		saveTask(t, userID, `<img src=x onerror=alert(1)> invoice`, `Tom & Jerry's <b>invoice</b> is "due"`)
+
+		hits, _, err := searchRepo.SearchTasks(ctx, userID, domaintask.SearchTasksQuery{Text: "invoice"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(hits) != 1 {
+			t.Fatalf("expected 1 hit, got %d", len(hits))
+		}
+
+		title := hits[0].TitleHighlight()
+		if strings.Contains(title, "<img") || !strings.Contains(title, "&lt;img src=x onerror=alert(1)&gt;") {
+			t.Errorf("expected escaped title markup, got %q", title)
+		}
+
+		if !strings.Contains(title, domaintask.SearchHighlightStart+"invoice"+domaintask.SearchHighlightStop) {
+			t.Errorf("expected highlighted title, got %q", title)
+		}
+
+		description := hits[0].DescriptionHighlight()
+		for _, escaped := range []string{"Tom &amp; Jerry&#39;s", "&lt;b&gt;", "&quot;due&quot;"} {
+			if !strings.Contains(description, escaped) {
+				t.Errorf("expected %q in description highlight, got %q", escaped, description)
+			}
+		}
+
+		if strings.Contains(description, "<b>") {
+			t.Errorf("expected no raw markup in description highlight, got %q", description)
+		}
+	})
+}
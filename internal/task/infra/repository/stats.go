@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/gorm"
+)
+
+// completionDaysSQL counts completions per calendar day in the given timezone. Days are
+// returned as text so the driver cannot shift them into another timezone.
+const completionDaysSQL = `
+SELECT to_char(completed_at AT TIME ZONE @timezone, 'YYYY-MM-DD') AS day, count(*) AS completed
+FROM completed_tasks
+WHERE user_id = @user_id
+GROUP BY day
+ORDER BY day`
+
+const completionTypesSQL = `
+SELECT
+	task_type,
+	count(*) AS completed,
+	count(*) FILTER (WHERE completed_at <= target_at) AS on_time,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM completed_at - created_at)) AS median_seconds
+FROM completed_tasks
+WHERE user_id = @user_id AND completed_at >= @from AND completed_at < @to
+GROUP BY task_type
+ORDER BY task_type`
+
+type completionDayRow struct {
+	Day       string
+	Completed int
+}
+
+type completionTypeRow struct {
+	TaskType      string
+	Completed     int
+	OnTime        int
+	MedianSeconds float64
+}
+
+type taskStatsRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskStatsRepository(db *gorm.DB) domaintask.TaskStatsRepository {
+	return &taskStatsRepository{db: db}
+}
+
+func (r *taskStatsRepository) GetCompletionHistory(
+	ctx context.Context,
+	userID domainuser.ID,
+	window *domaintask.StatsWindow,
+) (*domaintask.CompletionHistory, error) {
+	var history *domaintask.CompletionHistory
+
+	// Both queries read one snapshot, so a task completed in between is counted in both or neither
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		days, err := completionDays(tx, userID, window.Location())
+		if err != nil {
+			return err
+		}
+
+		types, err := completionTypes(tx, userID, window)
+		if err != nil {
+			return err
+		}
+
+		history = &domaintask.CompletionHistory{
+			Days:  days,
+			Types: types,
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func completionDays(db *gorm.DB, userID domainuser.ID, location *time.Location) ([]domaintask.DailyCompletions, error) {
+	var rows []completionDayRow
+
+	if err := db.Raw(completionDaysSQL, map[string]any{
+		"timezone": location.String(),
+		"user_id":  userID.String(),
+	}).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	days := make([]domaintask.DailyCompletions, 0, len(rows))
+
+	for _, row := range rows {
+		date, err := time.ParseInLocation(time.DateOnly, row.Day, location)
+		if err != nil {
+			return nil, err
+		}
+
+		days = append(days, domaintask.DailyCompletions{
+			Date:      date,
+			Completed: row.Completed,
+		})
+	}
+
+	return days, nil
+}
+
+func completionTypes(db *gorm.DB, userID domainuser.ID, window *domaintask.StatsWindow) ([]domaintask.TypeCompletions, error) {
+	var rows []completionTypeRow
+
+	if err := db.Raw(completionTypesSQL, map[string]any{
+		"user_id": userID.String(),
+		"from":    window.Start().UTC(),
+		"to":      window.End().UTC(),
+	}).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	types := make([]domaintask.TypeCompletions, 0, len(rows))
+
+	for _, row := range rows {
+		taskType, err := domaintask.NewType(row.TaskType)
+		if err != nil {
+			return nil, err
+		}
+
+		types = append(types, domaintask.TypeCompletions{
+			TaskType:             taskType,
+			Completed:            row.Completed,
+			OnTime:               row.OnTime,
+			MedianTimeToComplete: time.Duration(math.Round(row.MedianSeconds * float64(time.Second))),
+		})
+	}
+
+	return types, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func TestGetCompletionHistory(t *testing.T) {
+	db := setupArchiveDB(t)
+	ctx := context.Background()
+
+	archiveRepo := NewTaskArchiveRepository(db)
+	statsRepo := NewTaskStatsRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	// Both tasks target an hour after creation; the first is completed on time, the second late
+	for _, after := range []time.Duration{30 * time.Minute, 90 * time.Minute} {
+		task := createTestTask(t, db, userID)
+
+		completedTask, err := domaintask.NewCompletedTask(task, task.CreatedAt().Add(after))
+		if err != nil {
+			t.Fatalf("failed to create completed task: %v", err)
+		}
+
+		if err := archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID, task.Version()); err != nil {
+			t.Fatalf("failed to archive task: %v", err)
+		}
+	}
+
+	window, err := domaintask.NewStatsWindow(domaintask.StatsIntervalDay, "Asia/Tokyo", 7, time.Now().Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("failed to create window: %v", err)
+	}
+
+	history, err := statsRepo.GetCompletionHistory(ctx, userID, window)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	completed := 0
+	for _, day := range history.Days {
+		if day.Date.Location() != window.Location() || day.Date.Hour() != 0 {
+			t.Errorf("expected local midnight, got %v", day.Date)
+		}
+
+		completed += day.Completed
+	}
+
+	if completed != 2 {
+		t.Errorf("expected 2 completions across the days, got %d", completed)
+	}
+
+	if len(history.Types) != 1 {
+		t.Fatalf("expected a single task type, got %+v", history.Types)
+	}
+
+	got := history.Types[0]
+	if got.TaskType != domaintask.TypeNear || got.Completed != 2 || got.OnTime != 1 {
+		t.Errorf("unexpected type summary %+v", got)
+	}
+
+	if got.MedianTimeToComplete != time.Hour {
+		t.Errorf("expected a median of an hour, got %v", got.MedianTimeToComplete)
+	}
+
+	otherUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	empty, err := statsRepo.GetCompletionHistory(ctx, otherUserID, window)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(empty.Days) != 0 || len(empty.Types) != 0 {
+		t.Errorf("expected no history for another user, got %+v", empty)
+	}
+}
@@ -0,0 +1,352 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagModel struct {
+	ID        string    `gorm:"type:uuid;primaryKey"`
+	UserID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_tags_user_id_name,priority:1"`
+	Name      string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_tags_user_id_name,priority:2"`
+	Color     string    `gorm:"type:varchar(7);not null"`
+	CreatedAt time.Time `gorm:"type:timestamptz;not null"`
+}
+
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// TaskTagModel attaches a tag to a task. TaskID refers to either an active or a completed
+// task, which keep the same ID across archiving, so it has no foreign key.
+type TaskTagModel struct {
+	TaskID    string    `gorm:"type:uuid;primaryKey"`
+	TagID     string    `gorm:"type:uuid;primaryKey;index:idx_task_tags_tag_id"`
+	Tag       TagModel  `gorm:"constraint:OnDelete:CASCADE,OnUpdate:CASCADE;foreignKey:TagID;references:ID"`
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+}
+
+func (TaskTagModel) TableName() string {
+	return "task_tags"
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) domaintask.TagRepository {
+	return &tagRepository{db: db}
+}
+
+func (r *tagRepository) SaveTag(ctx context.Context, tag *domaintask.Tag) error {
+	if tag == nil {
+		return ErrTagRequired
+	}
+
+	record := tagToRecord(tag)
+
+	result := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&record)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domaintask.ErrTagNameAlreadyExists
+	}
+
+	return nil
+}
+
+func (r *tagRepository) GetTagByID(ctx context.Context, id domaintask.TagID, userID domainuser.ID) (*domaintask.Tag, error) {
+	var record TagModel
+	if err := conn(ctx, r.db).
+		Where("id = ? AND user_id = ?", id.String(), userID.String()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domaintask.ErrTagNotFound
+		}
+
+		return nil, err
+	}
+
+	return recordToTag(record)
+}
+
+func (r *tagRepository) ListTagsByUserID(ctx context.Context, userID domainuser.ID) ([]*domaintask.Tag, error) {
+	var records []TagModel
+	if err := conn(ctx, r.db).
+		Where("user_id = ?", userID.String()).
+		Order("name ASC, id ASC").
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	return recordsToTags(records)
+}
+
+func (r *tagRepository) UpdateTag(ctx context.Context, tag *domaintask.Tag) error {
+	if tag == nil {
+		return ErrTagRequired
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.
+			Model(&TagModel{}).
+			Where("user_id = ? AND name = ? AND id <> ?", tag.UserID().String(), tag.Name(), tag.ID().String()).
+			Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return domaintask.ErrTagNameAlreadyExists
+		}
+
+		result := tx.
+			Model(&TagModel{}).
+			Where("id = ? AND user_id = ?", tag.ID().String(), tag.UserID().String()).
+			Updates(map[string]any{
+				"name":  tag.Name(),
+				"color": tag.Color().String(),
+			})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domaintask.ErrTagNotFound
+		}
+
+		return recordTaggedTaskChanges(tx, tag.ID(), tag.UserID())
+	})
+}
+
+func (r *tagRepository) DeleteTag(ctx context.Context, id domaintask.TagID, userID domainuser.ID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Recorded first, while the tasks still have the tag attached
+		if err := recordTaggedTaskChanges(tx, id, userID); err != nil {
+			return err
+		}
+
+		// task_tags rows are removed by the foreign key
+		result := tx.
+			Where("id = ? AND user_id = ?", id.String(), userID.String()).
+			Delete(&TagModel{})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domaintask.ErrTagNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *tagRepository) ListTaskTags(
+	ctx context.Context,
+	taskID domaintask.ID,
+	userID domainuser.ID,
+) ([]*domaintask.Tag, error) {
+	db := conn(ctx, r.db)
+
+	if err := lockOwnedTask(db, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	tags, err := tagsByTaskID(db, []string{taskID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags[taskID.String()], nil
+}
+
+func (r *tagRepository) AttachTag(
+	ctx context.Context,
+	taskID domaintask.ID,
+	tagID domaintask.TagID,
+	userID domainuser.ID,
+) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedTask(tx, taskID, userID); err != nil {
+			return err
+		}
+
+		var tagCount int64
+		if err := tx.
+			Model(&TagModel{}).
+			Where("id = ? AND user_id = ?", tagID.String(), userID.String()).
+			Count(&tagCount).Error; err != nil {
+			return err
+		}
+
+		if tagCount == 0 {
+			return domaintask.ErrTagNotFound
+		}
+
+		var attached []string
+		if err := tx.
+			Model(&TaskTagModel{}).
+			Where("task_id = ?", taskID.String()).
+			Pluck("tag_id", &attached).Error; err != nil {
+			return err
+		}
+
+		for _, id := range attached {
+			if id == tagID.String() {
+				return nil
+			}
+		}
+
+		if len(attached) >= domaintask.MaxTagsPerTask {
+			return domaintask.ErrTooManyTags
+		}
+
+		record := TaskTagModel{
+			TaskID:    taskID.String(),
+			TagID:     tagID.String(),
+			Tag:       TagModel{},  // only declares the foreign key
+			CreatedAt: time.Time{}, // set by GORM
+		}
+
+		if err := tx.Create(&record).Error; err != nil {
+			return err
+		}
+
+		return recordTaskChanges(tx, userID.String(), upserted(taskID.String())...)
+	})
+}
+
+func (r *tagRepository) DetachTag(
+	ctx context.Context,
+	taskID domaintask.ID,
+	tagID domaintask.TagID,
+	userID domainuser.ID,
+) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedTask(tx, taskID, userID); err != nil {
+			return err
+		}
+
+		result := tx.
+			Where("task_id = ? AND tag_id = ?", taskID.String(), tagID.String()).
+			Delete(&TaskTagModel{})
+
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return recordTaskChanges(tx, userID.String(), upserted(taskID.String())...)
+	})
+}
+
+// tagsByTaskID loads the tags attached to the given tasks, ordered by name. Tasks without
+// tags are absent from the result.
+func tagsByTaskID(db *gorm.DB, taskIDs []string) (map[string][]*domaintask.Tag, error) {
+	tags := make(map[string][]*domaintask.Tag, len(taskIDs))
+
+	if len(taskIDs) == 0 {
+		return tags, nil
+	}
+
+	var rows []struct {
+		TaskID string
+		TagModel
+	}
+
+	if err := db.
+		Table("task_tags").
+		Select("task_tags.task_id, tags.*").
+		Joins("JOIN tags ON tags.id = task_tags.tag_id").
+		Where("task_tags.task_id IN ?", taskIDs).
+		Order("tags.name ASC, tags.id ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tag, err := recordToTag(row.TagModel)
+		if err != nil {
+			return nil, err
+		}
+
+		tags[row.TaskID] = append(tags[row.TaskID], tag)
+	}
+
+	return tags, nil
+}
+
+// copyTaskTags attaches the tags of one task to another, as used when a recurring task
+// moves on to its next occurrence.
+func copyTaskTags(db *gorm.DB, fromTaskID, toTaskID domaintask.ID) error {
+	return db.Exec(
+		"INSERT INTO task_tags (task_id, tag_id, created_at) SELECT ?, tag_id, now() FROM task_tags WHERE task_id = ?",
+		toTaskID.String(), fromTaskID.String(),
+	).Error
+}
+
+// deleteTaskTags detaches every tag from the tasks. It is needed when tasks are purged
+// because task_tags has no foreign key to the task.
+func deleteTaskTags(db *gorm.DB, taskIDs []string) error {
+	return db.
+		Where("task_id IN ?", taskIDs).
+		Delete(&TaskTagModel{}).
+		Error
+}
+
+func tagToRecord(tag *domaintask.Tag) TagModel {
+	return TagModel{
+		ID:        tag.ID().String(),
+		UserID:    tag.UserID().String(),
+		Name:      tag.Name(),
+		Color:     tag.Color().String(),
+		CreatedAt: tag.CreatedAt(),
+	}
+}
+
+func recordToTag(record TagModel) (*domaintask.Tag, error) {
+	id, err := domaintask.NewTagIDFromString(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := domainuser.NewIDFromString(record.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	color, err := domaintask.NewColor(record.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	return domaintask.NewTag(id, userID, record.Name, color, record.CreatedAt)
+}
+
+func recordsToTags(records []TagModel) ([]*domaintask.Tag, error) {
+	tags := make([]*domaintask.Tag, 0, len(records))
+
+	for _, record := range records {
+		tag, err := recordToTag(record)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func createTestTag(t *testing.T, repo domaintask.TagRepository, userID domainuser.ID, name string) *domaintask.Tag {
+	t.Helper()
+
+	tag, err := domaintask.CreateTag(userID, name, domaintask.MustColor("#4ECDC4"))
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	if err := repo.SaveTag(context.Background(), tag); err != nil {
+		t.Fatalf("failed to save tag: %v", err)
+	}
+
+	return tag
+}
+
+func tagNames(tags []*domaintask.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name())
+	}
+
+	return names
+}
+
+func TestTagRepository(t *testing.T) {
+	db := setupTaskDB(t)
+	ctx := context.Background()
+
+	repo := NewTagRepository(db)
+	taskRepo := NewTaskRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	otherUserID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	work := createTestTag(t, repo, userID, "work")
+	home := createTestTag(t, repo, userID, "home")
+	task := createTestTask(t, db, userID)
+	untagged := createTestTask(t, db, userID)
+
+	t.Run("rejects a duplicate name", func(t *testing.T) {
+		duplicate, err := domaintask.CreateTag(userID, "work", domaintask.MustColor("#000000"))
+		if err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+
+		if err := repo.SaveTag(ctx, duplicate); !errors.Is(err, domaintask.ErrTagNameAlreadyExists) {
+			t.Fatalf("expected ErrTagNameAlreadyExists, got %v", err)
+		}
+
+		// Names are only unique per user
+		createTestTag(t, repo, otherUserID, "work")
+	})
+
+	t.Run("attaches tags idempotently", func(t *testing.T) {
+		for _, tagID := range []domaintask.TagID{work.ID(), home.ID(), work.ID()} {
+			if err := repo.AttachTag(ctx, task.ID(), tagID, userID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		tags, err := repo.ListTaskTags(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := tagNames(tags); len(got) != 2 || got[0] != "home" || got[1] != "work" {
+			t.Fatalf("unexpected tags %v", got)
+		}
+
+		got, err := taskRepo.GetTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(got.Tags()) != 2 {
+			t.Fatalf("expected task to carry 2 tags, got %d", len(got.Tags()))
+		}
+	})
+
+	t.Run("filters active tasks by tag", func(t *testing.T) {
+		tagID := work.ID()
+
+		tasks, _, err := taskRepo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			Filter:   domaintask.ActiveTaskFilter{TagID: &tagID},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tasks) != 1 || tasks[0].ID() != task.ID() {
+			t.Fatalf("expected only the tagged task, got %d tasks", len(tasks))
+		}
+	})
+
+	t.Run("rejects tags and tasks of other users", func(t *testing.T) {
+		foreign := createTestTag(t, repo, otherUserID, "foreign")
+
+		if err := repo.AttachTag(ctx, untagged.ID(), foreign.ID(), userID); !errors.Is(err, domaintask.ErrTagNotFound) {
+			t.Fatalf("expected ErrTagNotFound, got %v", err)
+		}
+
+		if err := repo.AttachTag(ctx, untagged.ID(), foreign.ID(), otherUserID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected ErrTaskNotFound, got %v", err)
+		}
+	})
+
+	t.Run("renames a tag", func(t *testing.T) {
+		name := "home"
+
+		renamed, err := work.Update(&name, nil)
+		if err != nil {
+			t.Fatalf("failed to update tag: %v", err)
+		}
+
+		if err := repo.UpdateTag(ctx, renamed); !errors.Is(err, domaintask.ErrTagNameAlreadyExists) {
+			t.Fatalf("expected ErrTagNameAlreadyExists, got %v", err)
+		}
+
+		name = "office"
+
+		renamed, err = work.Update(&name, nil)
+		if err != nil {
+			t.Fatalf("failed to update tag: %v", err)
+		}
+
+		if err := repo.UpdateTag(ctx, renamed); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := repo.GetTagByID(ctx, work.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Name() != "office" {
+			t.Fatalf("expected renamed tag, got %q", got.Name())
+		}
+	})
+
+	t.Run("detaches tags and deletes them from tasks", func(t *testing.T) {
+		if err := repo.DetachTag(ctx, task.ID(), home.ID(), userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := repo.DeleteTag(ctx, work.ID(), userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		tags, err := repo.ListTaskTags(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tags) != 0 {
+			t.Fatalf("expected no tags, got %v", tagNames(tags))
+		}
+
+		if err := repo.DeleteTag(ctx, work.ID(), userID); !errors.Is(err, domaintask.ErrTagNotFound) {
+			t.Fatalf("expected ErrTagNotFound, got %v", err)
+		}
+	})
+}
+
+func TestTagArchive(t *testing.T) {
+	db := setupArchiveDB(t)
+	ctx := context.Background()
+
+	repo := NewTagRepository(db)
+	archiveRepo := NewTaskArchiveRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	tag := createTestTag(t, repo, userID, "errands")
+	task := createTestTask(t, db, userID)
+
+	if err := repo.AttachTag(ctx, task.ID(), tag.ID(), userID); err != nil {
+		t.Fatalf("failed to attach tag: %v", err)
+	}
+
+	withTags, err := NewTaskRepository(db).GetTaskByID(ctx, task.ID(), userID)
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+
+	completedTask, err := domaintask.NewCompletedTask(withTags, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create completed task: %v", err)
+	}
+
+	if err := archiveRepo.ArchiveTask(ctx, completedTask, task.ID(), userID, withTags.Version()); err != nil {
+		t.Fatalf("failed to archive task: %v", err)
+	}
+
+	t.Run("keeps tags on the completed task", func(t *testing.T) {
+		archived, err := archiveRepo.GetCompletedTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := tagNames(archived.Tags()); len(got) != 1 || got[0] != "errands" {
+			t.Fatalf("unexpected tags %v", got)
+		}
+
+		tagID := tag.ID()
+
+		completedTasks, _, err := archiveRepo.ListCompletedTasksByUserID(ctx, userID, domaintask.ListCompletedTasksQuery{
+			Filter: domaintask.CompletedTaskFilter{TagID: &tagID},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(completedTasks) != 1 {
+			t.Fatalf("expected the tagged completed task, got %d", len(completedTasks))
+		}
+	})
+
+	t.Run("restores tags when unarchiving", func(t *testing.T) {
+		archived, err := archiveRepo.GetCompletedTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		reopened, err := archived.Reopen(time.Now(), nil)
+		if err != nil {
+			t.Fatalf("failed to reopen task: %v", err)
+		}
+
+		if err := archiveRepo.UnarchiveTask(ctx, reopened); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		tags, err := repo.ListTaskTags(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := tagNames(tags); len(got) != 1 || got[0] != "errands" {
+			t.Fatalf("unexpected tags %v", got)
+		}
+	})
+}
@@ -11,16 +11,28 @@ import (
 )
 
 type TaskModel struct {
-	ID          string     `gorm:"type:uuid;primaryKey"`
-	UserID      string     `gorm:"type:uuid;not null;index:idx_tasks_user_id"`
-	Title       string     `gorm:"type:varchar(500);not null"`
-	TaskType    string     `gorm:"type:varchar(50);not null;index:idx_tasks_task_type"`
-	TaskStatus  string     `gorm:"type:varchar(50);not null;index:idx_tasks_task_status"`
-	Description string     `gorm:"type:text"`
-	ScheduledAt *time.Time `gorm:"type:timestamptz"`
-	CreatedAt   time.Time  `gorm:"not null;autoCreateTime"`
-	TargetAt    time.Time  `gorm:"type:timestamptz;not null;index:idx_tasks_target_at"`
-	Color       string     `gorm:"type:varchar(7);not null"`
+	ID                 string     `gorm:"type:uuid;primaryKey;index:idx_tasks_user_id_target_at,priority:3"`
+	UserID             string     `gorm:"type:uuid;not null;index:idx_tasks_user_id;index:idx_tasks_user_id_target_at,priority:1;index:idx_tasks_user_id_rank,priority:1"`
+	Title              string     `gorm:"type:varchar(500);not null;index:idx_tasks_title_trgm,type:gin,expression:title gin_trgm_ops"`
+	TaskType           string     `gorm:"type:varchar(50);not null;index:idx_tasks_task_type"`
+	TaskStatus         string     `gorm:"type:varchar(50);not null;index:idx_tasks_task_status;index:idx_tasks_task_status_updated_at,priority:1"`
+	Description        string     `gorm:"type:text;index:idx_tasks_description_trgm,type:gin,expression:description gin_trgm_ops"`
+	ScheduledAt        *time.Time `gorm:"type:timestamptz"`
+	CreatedAt          time.Time  `gorm:"not null;autoCreateTime"`
+	UpdatedAt          time.Time  `gorm:"type:timestamptz;not null;default:now();autoUpdateTime;index:idx_tasks_task_status_updated_at,priority:2"`
+	TargetAt           time.Time  `gorm:"type:timestamptz;not null;index:idx_tasks_target_at;index:idx_tasks_user_id_target_at,priority:2"`
+	Color              string     `gorm:"type:varchar(7);not null"`
+	RecurrenceRule     *string    `gorm:"type:varchar(256)"`
+	RecurrenceTimezone *string    `gorm:"type:varchar(64)"`
+	// Rank orders tasks manually and compares byte-wise, hence the "C" collation
+	Rank string `gorm:"type:text COLLATE \"C\";not null;index:idx_tasks_user_id_rank,priority:2"`
+	// Version is bumped by every edit and guards UpdateTask against lost updates
+	Version int64 `gorm:"not null;default:1"`
+	// SearchVector is maintained by the database and only used for searching
+	SearchVector string `gorm:"->:false;<-:false;type:tsvector GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, coalesce(title, '')), 'A') || setweight(to_tsvector('simple'::regconfig, coalesce(description, '')), 'B')) STORED;index:idx_tasks_search_vector,type:gin"`
+	// DeletedAt is set while the task is in the trash. GORM leaves trashed tasks out of
+	// every query that is not Unscoped.
+	DeletedAt gorm.DeletedAt `gorm:"type:timestamptz;index:idx_tasks_deleted_at"`
 }
 
 func (TaskModel) TableName() string {
@@ -40,30 +52,119 @@ func (r *taskRepository) SaveTask(ctx context.Context, task *domaintask.Task) er
 		return ErrTaskRequired
 	}
 
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		return createTaskRecord(tx, task)
+	})
+}
+
+// createTaskRecord inserts the task, appending it to the end of the user's manual order
+// when it has no rank yet, and records it in the change log.
+func createTaskRecord(db *gorm.DB, task *domaintask.Task) error {
+	record := taskToRecord(task)
+
+	if record.Rank == "" {
+		rank, err := nextRank(db, record.UserID)
+		if err != nil {
+			return err
+		}
+
+		record.Rank = rank
+	}
+
+	if err := db.Create(&record).Error; err != nil {
+		// Losing the race against a concurrent create with the same ID
+		if isUniqueViolation(err, tasksPrimaryKey) {
+			return domaintask.ErrTaskIDAlreadyExists
+		}
+
+		return err
+	}
+
+	return recordTaskChanges(db, record.UserID, upserted(record.ID)...)
+}
+
+// taskRankLockPrefix namespaces the per-user advisory lock taken while appending a task.
+const taskRankLockPrefix = "task.rank."
+
+// nextRank returns the rank after the user's last task. It must run in a transaction: the
+// user's advisory lock is held until it ends, so concurrent creates for the same user wait
+// for each other instead of reading the same last rank.
+func nextRank(db *gorm.DB, userID string) (string, error) {
+	if err := db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", taskRankLockPrefix+userID).Error; err != nil {
+		return "", err
+	}
+
+	var last string
+
+	// Trashed tasks keep their rank, so new tasks go after them as well
+	if err := db.
+		Unscoped().
+		Model(&TaskModel{}).
+		Where("user_id = ?", userID).
+		Select("COALESCE(MAX(rank), '')").
+		Scan(&last).Error; err != nil {
+		return "", err
+	}
+
+	return domaintask.RankAfter(last)
+}
+
+func taskToRecord(task *domaintask.Task) TaskModel {
 	var scheduledAt *time.Time
 	if task.ScheduledAt() != nil {
 		scheduledAt = task.ScheduledAt()
 	}
 
-	record := TaskModel{
-		ID:          task.ID().String(),
-		UserID:      task.UserID().String(),
-		Title:       task.Title(),
-		TaskType:    string(task.TaskType()),
-		TaskStatus:  string(task.TaskStatus()),
-		Description: task.Description(),
-		ScheduledAt: scheduledAt,
-		CreatedAt:   task.CreatedAt(),
-		TargetAt:    task.TargetAt(),
-		Color:       task.Color().String(),
+	recurrenceRule, recurrenceTimezone := recurrenceToColumns(task.Recurrence())
+
+	return TaskModel{
+		ID:                 task.ID().String(),
+		UserID:             task.UserID().String(),
+		Title:              task.Title(),
+		TaskType:           string(task.TaskType()),
+		TaskStatus:         string(task.TaskStatus()),
+		Description:        task.Description(),
+		ScheduledAt:        scheduledAt,
+		CreatedAt:          task.CreatedAt(),
+		UpdatedAt:          time.Time{}, // set by GORM
+		TargetAt:           task.TargetAt(),
+		Color:              task.Color().String(),
+		RecurrenceRule:     recurrenceRule,
+		RecurrenceTimezone: recurrenceTimezone,
+		Rank:               task.Rank(),
+		Version:            task.Version(),
+		SearchVector:       "",
+		DeletedAt:          gorm.DeletedAt{}, // set by DeleteTask
+	}
+}
+
+func recurrenceToColumns(recurrence *domaintask.Recurrence) (*string, *string) {
+	if recurrence == nil {
+		return nil, nil
+	}
+
+	rule := recurrence.Rule()
+	timezone := recurrence.Timezone()
+
+	return &rule, &timezone
+}
+
+func recordToRecurrence(rule, timezone *string) (*domaintask.Recurrence, error) {
+	if rule == nil {
+		return nil, nil
+	}
+
+	var tz string
+	if timezone != nil {
+		tz = *timezone
 	}
 
-	return r.db.WithContext(ctx).Create(&record).Error
+	return domaintask.NewRecurrence(*rule, tz)
 }
 
 func (r *taskRepository) GetTaskByID(ctx context.Context, id domaintask.ID, userID domainuser.ID) (*domaintask.Task, error) {
 	var record TaskModel
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("id = ? AND user_id = ?", id.String(), userID).
 		First(&record).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -73,10 +174,24 @@ func (r *taskRepository) GetTaskByID(ctx context.Context, id domaintask.ID, user
 		return nil, err
 	}
 
-	return r.recordToTask(record)
+	progress, err := checklistProgressByTaskID(conn(ctx, r.db), []string{record.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := tagsByTaskID(conn(ctx, r.db), []string{record.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.recordToTask(record, progress[record.ID], tags[record.ID])
 }
 
-func (r *taskRepository) recordToTask(record TaskModel) (*domaintask.Task, error) {
+func (r *taskRepository) recordToTask(
+	record TaskModel,
+	checklist domaintask.ChecklistProgress,
+	tags []*domaintask.Tag,
+) (*domaintask.Task, error) {
 	recordTaskID, err := domaintask.NewIDFromString(record.ID)
 	if err != nil {
 		return nil, err
@@ -102,6 +217,11 @@ func (r *taskRepository) recordToTask(record TaskModel) (*domaintask.Task, error
 		return nil, err
 	}
 
+	recurrence, err := recordToRecurrence(record.RecurrenceRule, record.RecurrenceTimezone)
+	if err != nil {
+		return nil, err
+	}
+
 	return domaintask.NewTask(
 		recordTaskID,
 		recordUserID,
@@ -113,13 +233,20 @@ func (r *taskRepository) recordToTask(record TaskModel) (*domaintask.Task, error
 		record.CreatedAt,
 		record.TargetAt,
 		color,
+		domaintask.WithRecurrence(recurrence),
+		domaintask.WithRank(record.Rank),
+		domaintask.WithChecklistProgress(checklist),
+		domaintask.WithTags(tags),
+		domaintask.WithVersion(record.Version),
 	)
 }
 
 func (r *taskRepository) ExistsTaskByID(ctx context.Context, id domaintask.ID) (bool, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).
+	// The IDs of trashed tasks are still taken
+	err := conn(ctx, r.db).
+		Unscoped().
 		Model(&TaskModel{}).
 		Where("id = ?", id.String()).
 		Count(&count).Error
@@ -130,35 +257,134 @@ func (r *taskRepository) ExistsTaskByID(ctx context.Context, id domaintask.ID) (
 	return count > 0, nil
 }
 
-func (r *taskRepository) ListActiveTasksByUserID(ctx context.Context, userID domainuser.ID, sortType domaintask.SortType) ([]*domaintask.Task, error) {
-	orderQuery, err := sortType.OrderQuery()
+// activeTaskStatuses are the statuses of tasks that are listed and searched as active.
+var activeTaskStatuses = []string{
+	string(domaintask.StatusActive),
+	string(domaintask.StatusPendingReminders),
+	string(domaintask.StatusReminderFailed),
+}
+
+func (r *taskRepository) ListActiveTasksByUserID(
+	ctx context.Context,
+	userID domainuser.ID,
+	query domaintask.ListActiveTasksQuery,
+) ([]*domaintask.Task, *domaintask.PageCursor, error) {
+	orderQuery, err := query.SortType.OrderQuery(query.SortDirection)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if err := query.Filter.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	pageSize, err := domaintask.NormalizePageSize(query.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := conn(ctx, r.db).
+		Where("user_id = ? AND task_status IN ?", userID.String(), activeTaskStatuses)
+
+	db = applyActiveTaskFilter(db, query.Filter)
+
+	if query.Cursor != nil {
+		keysetQuery, err := query.SortType.KeysetQuery(query.SortDirection)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sortKey, err := query.SortType.ParseSortKey(query.Cursor.SortKey())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		db = db.Where(keysetQuery, sortKey, sortKey, query.Cursor.ID().String())
 	}
 
 	var records []TaskModel
 
-	if err := r.db.WithContext(ctx).
-		Where("user_id = ? AND task_status IN ?", userID.String(), []string{
-			string(domaintask.StatusActive),
-			string(domaintask.StatusPendingReminders),
-		}).
+	// Fetch one extra row to find out whether another page exists.
+	if err := db.
 		Order(orderQuery).
+		Limit(pageSize + 1).
 		Find(&records).Error; err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	hasMore := len(records) > pageSize
+	if hasMore {
+		records = records[:pageSize]
+	}
+
+	progress, err := checklistProgressByTaskID(conn(ctx, r.db), taskRecordIDs(records))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags, err := tagsByTaskID(conn(ctx, r.db), taskRecordIDs(records))
+	if err != nil {
+		return nil, nil, err
 	}
 
 	tasks := make([]*domaintask.Task, 0, len(records))
 	for _, record := range records {
-		task, err := r.recordToTask(record)
+		task, err := r.recordToTask(record, progress[record.ID], tags[record.ID])
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		tasks = append(tasks, task)
 	}
 
-	return tasks, nil
+	if !hasMore {
+		return tasks, nil, nil
+	}
+
+	nextCursor, err := domaintask.NewPageCursor(query.SortType, query.SortDirection, tasks[len(tasks)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tasks, nextCursor, nil
+}
+
+func taskRecordIDs(records []TaskModel) []string {
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+
+	return ids
+}
+
+func applyActiveTaskFilter(db *gorm.DB, filter domaintask.ActiveTaskFilter) *gorm.DB {
+	if filter.TaskType != nil {
+		db = db.Where("task_type = ?", string(*filter.TaskType))
+	}
+
+	if filter.Color != nil {
+		db = db.Where("color = ?", filter.Color.String())
+	}
+
+	if filter.TargetAtFrom != nil {
+		db = db.Where("target_at >= ?", filter.TargetAtFrom.UTC())
+	}
+
+	if filter.TargetAtTo != nil {
+		db = db.Where("target_at < ?", filter.TargetAtTo.UTC())
+	}
+
+	if filter.TagID != nil {
+		db = whereTagged(db, *filter.TagID)
+	}
+
+	return db
+}
+
+// whereTagged keeps the tasks that have the tag attached.
+func whereTagged(db *gorm.DB, tagID domaintask.TagID) *gorm.DB {
+	return db.Where("id IN (SELECT task_id FROM task_tags WHERE tag_id = ?)", tagID.String())
 }
 
 func (r *taskRepository) UpdateTask(ctx context.Context, task *domaintask.Task) error {
@@ -171,58 +397,144 @@ func (r *taskRepository) UpdateTask(ctx context.Context, task *domaintask.Task)
 		scheduledAt = task.ScheduledAt()
 	}
 
-	result := r.db.WithContext(ctx).
-		Model(&TaskModel{}).
-		Where("id = ? AND user_id = ?", task.ID().String(), task.UserID().String()).
-		Updates(map[string]any{
-			"task_status":  string(task.TaskStatus()),
-			"title":        task.Title(),
-			"description":  task.Description(),
-			"scheduled_at": scheduledAt,
-			"target_at":    task.TargetAt(),
-			"color":        task.Color().String(),
-		})
+	recurrenceRule, recurrenceTimezone := recurrenceToColumns(task.Recurrence())
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// The task is the next version of the one it was read at, and it is only written
+		// over that version so that a concurrent edit is never overwritten.
+		result := tx.
+			Model(&TaskModel{}).
+			Where("id = ? AND user_id = ? AND version = ?", task.ID().String(), task.UserID().String(), task.Version()-1).
+			Updates(map[string]any{
+				"task_status":         string(task.TaskStatus()),
+				"title":               task.Title(),
+				"description":         task.Description(),
+				"scheduled_at":        scheduledAt,
+				"target_at":           task.TargetAt(),
+				"color":               task.Color().String(),
+				"recurrence_rule":     recurrenceRule,
+				"recurrence_timezone": recurrenceTimezone,
+				"version":             task.Version(),
+			})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return missingOrConflicting(tx, task.ID(), task.UserID())
+		}
+
+		return recordTaskChanges(tx, task.UserID().String(), upserted(task.ID().String())...)
+	})
+}
 
-	if result.Error != nil {
-		return result.Error
+// missingOrConflicting tells apart why a versioned update of the task matched no row.
+func missingOrConflicting(db *gorm.DB, id domaintask.ID, userID domainuser.ID) error {
+	var count int64
+	if err := db.
+		Model(&TaskModel{}).
+		Where("id = ? AND user_id = ?", id.String(), userID.String()).
+		Count(&count).Error; err != nil {
+		return err
 	}
 
-	if result.RowsAffected == 0 {
+	if count == 0 {
 		return domaintask.ErrTaskNotFound
 	}
 
-	return nil
+	return domaintask.ErrTaskVersionConflict
 }
 
+// DeleteTask only sets deleted_at. The checklist items and tags of the task are kept so
+// that restoring it brings them back.
 func (r *taskRepository) DeleteTask(ctx context.Context, id domaintask.ID, userID domainuser.ID) error {
-	result := r.db.WithContext(ctx).
-		Where("id = ? AND user_id = ?", id.String(), userID.String()).
-		Delete(&TaskModel{})
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Where("id = ? AND user_id = ?", id.String(), userID.String()).
+			Delete(&TaskModel{})
 
-	if result.Error != nil {
-		return result.Error
-	}
+		if result.Error != nil {
+			return result.Error
+		}
 
-	if result.RowsAffected == 0 {
-		return domaintask.ErrTaskNotFound
-	}
+		if result.RowsAffected == 0 {
+			return domaintask.ErrTaskNotFound
+		}
 
-	return nil
+		return recordTaskChanges(tx, userID.String(), taskChange{taskID: id.String(), changeType: domaintask.ChangeTypeDeleted})
+	})
 }
 
 func (r *taskRepository) UpdateTaskStatus(ctx context.Context, taskID domaintask.ID, userID domainuser.ID, status domaintask.Status) error {
-	result := r.db.WithContext(ctx).
-		Model(&TaskModel{}).
-		Where("id = ? AND user_id = ?", taskID.String(), userID.String()).
-		Update("task_status", string(status))
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&TaskModel{}).
+			Where("id = ? AND user_id = ?", taskID.String(), userID.String()).
+			Update("task_status", string(status))
+
+		if result.Error != nil {
+			return result.Error
+		}
 
-	if result.Error != nil {
-		return result.Error
-	}
+		if result.RowsAffected == 0 {
+			return domaintask.ErrTaskNotFound
+		}
 
-	if result.RowsAffected == 0 {
-		return domaintask.ErrTaskNotFound
+		return recordTaskChanges(tx, userID.String(), upserted(taskID.String())...)
+	})
+}
+
+func (r *taskRepository) UpdateTaskRank(ctx context.Context, id domaintask.ID, userID domainuser.ID, rank string) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&TaskModel{}).
+			Where("id = ? AND user_id = ?", id.String(), userID.String()).
+			Updates(map[string]any{
+				"rank":    rank,
+				"version": gorm.Expr("version + 1"),
+			})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domaintask.ErrTaskNotFound
+		}
+
+		return recordTaskChanges(tx, userID.String(), upserted(id.String())...)
+	})
+}
+
+func (r *taskRepository) PreviousRank(ctx context.Context, userID domainuser.ID, rank string, excludeID domaintask.ID) (string, error) {
+	return r.adjacentRank(ctx, userID, excludeID, "MAX(rank)", "rank < ?", rank)
+}
+
+func (r *taskRepository) NextRank(ctx context.Context, userID domainuser.ID, rank string, excludeID domaintask.ID) (string, error) {
+	return r.adjacentRank(ctx, userID, excludeID, "MIN(rank)", "rank > ?", rank)
+}
+
+func (r *taskRepository) adjacentRank(
+	ctx context.Context,
+	userID domainuser.ID,
+	excludeID domaintask.ID,
+	aggregate string,
+	condition string,
+	rank string,
+) (string, error) {
+	var adjacent string
+
+	// Trashed tasks are included so that a restored task does not share its rank
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Model(&TaskModel{}).
+		Where("user_id = ? AND id <> ?", userID.String(), excludeID.String()).
+		Where(condition, rank).
+		Select("COALESCE(" + aggregate + ", '')").
+		Scan(&adjacent).Error; err != nil {
+		return "", err
 	}
 
-	return nil
+	return adjacent, nil
 }
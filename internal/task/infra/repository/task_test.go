@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,7 +21,7 @@ func setupTaskDB(t *testing.T) *gorm.DB {
 	db, cleanup := testutil.SetupPostgresContainer(ctx, t)
 	t.Cleanup(cleanup)
 
-	if err := db.AutoMigrate(&TaskModel{}); err != nil {
+	if err := db.AutoMigrate(&TaskModel{}, &ChecklistItemModel{}, &TagModel{}, &TaskTagModel{}, &TaskChangeModel{}, &TaskChangeSequenceModel{}); err != nil {
 		t.Fatalf("failed to migrate database: %v", err)
 	}
 
@@ -423,8 +424,8 @@ func TestSaveTaskDuplicateID(t *testing.T) {
 	}
 
 	err = repo.SaveTask(context.Background(), task2)
-	if err == nil {
-		t.Fatal("SaveTask for duplicate ID should have failed, but succeeded")
+	if !errors.Is(err, domaintask.ErrTaskIDAlreadyExists) {
+		t.Fatalf("expected ErrTaskIDAlreadyExists for duplicate ID, got %v", err)
 	}
 }
 
@@ -491,7 +492,7 @@ func TestListActiveTasksByUserID(t *testing.T) {
 		t.Fatalf("failed to save task2: %v", err)
 	}
 
-	// Task 3: target_at = now + 30min, created_at = now + 1s (same target, newer created - should come first for ties)
+	// Task 3: target_at = now + 30min, created_at = now + 1s (same target, newer id - should come first for ties)
 	task3ID := domaintask.ID(uuid.Must(uuid.NewV7()))
 
 	task3, err := domaintask.NewTask(
@@ -560,8 +561,8 @@ func TestListActiveTasksByUserID(t *testing.T) {
 		t.Fatalf("failed to save task5: %v", err)
 	}
 
-	t.Run("sort by target_at ascending with created_at descending for ties", func(t *testing.T) {
-		tasks, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.SortTypeTargetAt)
+	t.Run("sort by target_at ascending with newer id first for ties", func(t *testing.T) {
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{SortType: domaintask.SortTypeTargetAt})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -585,7 +586,7 @@ func TestListActiveTasksByUserID(t *testing.T) {
 			t.Fatalf("failed to create empty user ID: %v", err)
 		}
 
-		tasks, err := repo.ListActiveTasksByUserID(ctx, emptyUserID, domaintask.SortTypeTargetAt)
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, emptyUserID, domaintask.ListActiveTasksQuery{SortType: domaintask.SortTypeTargetAt})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -596,14 +597,14 @@ func TestListActiveTasksByUserID(t *testing.T) {
 	})
 
 	t.Run("returns error for invalid sort type", func(t *testing.T) {
-		_, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.SortType("invalid"))
+		_, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{SortType: domaintask.SortType("invalid")})
 		if !errors.Is(err, domaintask.ErrInvalidSortType) {
 			t.Fatalf("expected ErrInvalidSortType, got %v", err)
 		}
 	})
 
 	t.Run("user isolation - does not return other users tasks", func(t *testing.T) {
-		tasks, err := repo.ListActiveTasksByUserID(ctx, otherUserID, domaintask.SortTypeTargetAt)
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, otherUserID, domaintask.ListActiveTasksQuery{SortType: domaintask.SortTypeTargetAt})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -616,6 +617,292 @@ func TestListActiveTasksByUserID(t *testing.T) {
 			t.Errorf("expected task5, got %s", tasks[0].ID().String())
 		}
 	})
+
+	t.Run("paginates with next cursor", func(t *testing.T) {
+		firstPage, cursor, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			PageSize: 2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(firstPage) != 2 {
+			t.Fatalf("expected 2 tasks on first page, got %d", len(firstPage))
+		}
+
+		if cursor == nil {
+			t.Fatal("expected next cursor, got nil")
+		}
+
+		if firstPage[0].ID().String() != task3ID.String() || firstPage[1].ID().String() != task2ID.String() {
+			t.Errorf("unexpected first page order: %s, %s", firstPage[0].ID().String(), firstPage[1].ID().String())
+		}
+
+		decoded, err := domaintask.DecodePageCursor(cursor.Encode(), domaintask.SortTypeTargetAt, domaintask.SortDirectionAsc)
+		if err != nil {
+			t.Fatalf("failed to decode cursor: %v", err)
+		}
+
+		secondPage, nextCursor, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			PageSize: 2,
+			Cursor:   decoded,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(secondPage) != 1 {
+			t.Fatalf("expected 1 task on second page, got %d", len(secondPage))
+		}
+
+		if secondPage[0].ID().String() != task1ID.String() {
+			t.Errorf("expected task1 on second page, got %s", secondPage[0].ID().String())
+		}
+
+		if nextCursor != nil {
+			t.Errorf("expected no next cursor on last page, got %v", nextCursor.Encode())
+		}
+	})
+
+	t.Run("sorts descending", func(t *testing.T) {
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType:      domaintask.SortTypeTargetAt,
+			SortDirection: domaintask.SortDirectionDesc,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectedOrder := []string{task1ID.String(), task2ID.String(), task3ID.String()}
+		if len(tasks) != len(expectedOrder) {
+			t.Fatalf("expected %d tasks, got %d", len(expectedOrder), len(tasks))
+		}
+
+		for i, task := range tasks {
+			if task.ID().String() != expectedOrder[i] {
+				t.Errorf("position %d: expected %s, got %s", i, expectedOrder[i], task.ID().String())
+			}
+		}
+	})
+
+	t.Run("filters by task type", func(t *testing.T) {
+		taskType := domaintask.TypeNear
+
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			Filter:   domaintask.ActiveTaskFilter{TaskType: &taskType},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectedOrder := []string{task3ID.String(), task1ID.String()}
+		if len(tasks) != len(expectedOrder) {
+			t.Fatalf("expected %d tasks, got %d", len(expectedOrder), len(tasks))
+		}
+
+		for i, task := range tasks {
+			if task.ID().String() != expectedOrder[i] {
+				t.Errorf("position %d: expected %s, got %s", i, expectedOrder[i], task.ID().String())
+			}
+		}
+	})
+
+	t.Run("filters by color", func(t *testing.T) {
+		color := domaintask.MustColor("#4ECDC4")
+
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			Filter:   domaintask.ActiveTaskFilter{Color: &color},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tasks) != 1 || tasks[0].ID().String() != task2ID.String() {
+			t.Fatalf("expected only task2, got %d tasks", len(tasks))
+		}
+	})
+
+	t.Run("filters by target_at window", func(t *testing.T) {
+		from := now.Add(45 * time.Minute)
+		to := now.Add(2 * time.Hour)
+
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			Filter:   domaintask.ActiveTaskFilter{TargetAtFrom: &from, TargetAtTo: &to},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(tasks) != 1 || tasks[0].ID().String() != task1ID.String() {
+			t.Fatalf("expected only task1, got %d tasks", len(tasks))
+		}
+	})
+
+	t.Run("returns error for invalid page size", func(t *testing.T) {
+		_, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{
+			SortType: domaintask.SortTypeTargetAt,
+			PageSize: domaintask.MaxPageSize + 1,
+		})
+		if !errors.Is(err, domaintask.ErrInvalidPageSize) {
+			t.Fatalf("expected ErrInvalidPageSize, got %v", err)
+		}
+	})
+}
+
+func TestTaskRank(t *testing.T) {
+	db := setupTaskDB(t)
+	repo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	first := createTestTask(t, db, userID)
+	second := createTestTask(t, db, userID)
+	third := createTestTask(t, db, userID)
+
+	ranks := make([]string, 0, 3)
+
+	for _, task := range []*domaintask.Task{first, second, third} {
+		saved, err := repo.GetTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("failed to get task: %v", err)
+		}
+
+		ranks = append(ranks, saved.Rank())
+	}
+
+	if ranks[0] >= ranks[1] || ranks[1] >= ranks[2] {
+		t.Fatalf("expected new tasks to be ranked in creation order, got %v", ranks)
+	}
+
+	t.Run("adjacent ranks exclude the given task", func(t *testing.T) {
+		next, err := repo.NextRank(ctx, userID, ranks[0], second.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if next != ranks[2] {
+			t.Errorf("expected next rank %q, got %q", ranks[2], next)
+		}
+
+		previous, err := repo.PreviousRank(ctx, userID, ranks[0], second.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if previous != "" {
+			t.Errorf("expected no previous rank, got %q", previous)
+		}
+	})
+
+	t.Run("manual sort follows updated rank", func(t *testing.T) {
+		rank, err := domaintask.RankBetween("", ranks[0])
+		if err != nil {
+			t.Fatalf("failed to calculate rank: %v", err)
+		}
+
+		if err := repo.UpdateTaskRank(ctx, third.ID(), userID, rank); err != nil {
+			t.Fatalf("failed to update rank: %v", err)
+		}
+
+		tasks, _, err := repo.ListActiveTasksByUserID(ctx, userID, domaintask.ListActiveTasksQuery{SortType: domaintask.SortTypeManual})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectedOrder := []string{third.ID().String(), first.ID().String(), second.ID().String()}
+		if len(tasks) != len(expectedOrder) {
+			t.Fatalf("expected %d tasks, got %d", len(expectedOrder), len(tasks))
+		}
+
+		for i, task := range tasks {
+			if task.ID().String() != expectedOrder[i] {
+				t.Errorf("position %d: expected %s, got %s", i, expectedOrder[i], task.ID().String())
+			}
+		}
+	})
+
+	t.Run("update rank of missing task", func(t *testing.T) {
+		err := repo.UpdateTaskRank(ctx, domaintask.ID(uuid.Must(uuid.NewV7())), userID, "i")
+		if !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected ErrTaskNotFound, got %v", err)
+		}
+	})
+}
+
+func TestConcurrentCreatesGetDistinctRanks(t *testing.T) {
+	db := setupTaskDB(t)
+	repo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	const creates = 10
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	errs := make(chan error, creates)
+
+	var wg sync.WaitGroup
+
+	for range creates {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			task, err := domaintask.NewTask(
+				domaintask.ID(uuid.Must(uuid.NewV7())),
+				userID,
+				"Concurrent Task",
+				domaintask.TypeNear,
+				domaintask.StatusActive,
+				"",
+				nil,
+				createdAt,
+				createdAt.Add(time.Hour),
+				domaintask.MustColor("#FF6B6B"),
+			)
+			if err != nil {
+				errs <- err
+
+				return
+			}
+
+			errs <- repo.SaveTask(ctx, task)
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("SaveTask failed: %v", err)
+		}
+	}
+
+	var distinct int64
+	if err := db.Model(&TaskModel{}).
+		Where("user_id = ?", userID.String()).
+		Distinct("rank").
+		Count(&distinct).Error; err != nil {
+		t.Fatalf("failed to count ranks: %v", err)
+	}
+
+	if distinct != creates {
+		t.Errorf("expected %d distinct ranks, got %d", creates, distinct)
+	}
 }
 
 func TestUpdateTask(t *testing.T) {
@@ -666,6 +953,7 @@ func TestUpdateTask(t *testing.T) {
 			now,
 			targetAt,
 			domaintask.MustColor("#4ECDC4"),
+			domaintask.WithVersion(2),
 		)
 		if err != nil {
 			t.Fatalf("failed to create updated task: %v", err)
@@ -696,6 +984,53 @@ func TestUpdateTask(t *testing.T) {
 		if retrieved.Color().String() != "#4ECDC4" {
 			t.Errorf("expected color '#4ECDC4', got '%s'", retrieved.Color().String())
 		}
+
+		if retrieved.Version() != 2 {
+			t.Errorf("expected version 2, got %d", retrieved.Version())
+		}
+	})
+
+	t.Run("update of an outdated version returns ErrTaskVersionConflict", func(t *testing.T) {
+		taskID := domaintask.ID(uuid.Must(uuid.NewV7()))
+
+		task, err := domaintask.NewTask(taskID, userID, "Original Title", "near", "active", "", nil, now, targetAt, domaintask.MustColor("#FF6B6B"))
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+
+		if err := repo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+
+		first := "First Edit"
+		second := "Second Edit"
+
+		firstEdit, err := task.ApplyUpdate(&domaintask.TaskUpdateInput{Title: &first})
+		if err != nil {
+			t.Fatalf("failed to apply update: %v", err)
+		}
+
+		secondEdit, err := task.ApplyUpdate(&domaintask.TaskUpdateInput{Title: &second})
+		if err != nil {
+			t.Fatalf("failed to apply update: %v", err)
+		}
+
+		if err := repo.UpdateTask(ctx, firstEdit); err != nil {
+			t.Fatalf("failed to update task: %v", err)
+		}
+
+		if err := repo.UpdateTask(ctx, secondEdit); !errors.Is(err, domaintask.ErrTaskVersionConflict) {
+			t.Fatalf("expected ErrTaskVersionConflict, got %v", err)
+		}
+
+		retrieved, err := repo.GetTaskByID(ctx, taskID, userID)
+		if err != nil {
+			t.Fatalf("failed to retrieve task: %v", err)
+		}
+
+		if retrieved.Title() != first {
+			t.Errorf("expected the first edit to be kept, got %q", retrieved.Title())
+		}
 	})
 
 	t.Run("update non-existent task returns ErrTaskNotFound", func(t *testing.T) {
@@ -832,6 +1167,7 @@ func TestUpdateTask(t *testing.T) {
 			now,
 			newTargetAt,
 			domaintask.MustColor("#FF6B6B"),
+			domaintask.WithVersion(2),
 		)
 		if err != nil {
 			t.Fatalf("failed to create updated scheduled task: %v", err)
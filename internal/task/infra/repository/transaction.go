@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+type transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) domaintask.Transactor {
+	return &transactor{db: db}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	db := t.db
+
+	// Nested calls join the outer transaction in a savepoint, so that their failure only
+	// rolls back their own changes
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		db = tx
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// conn returns the transaction bound to ctx by a Transactor, or db when there is none.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return db.WithContext(ctx)
+}
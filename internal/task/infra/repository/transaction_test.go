@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
+	"github.com/google/uuid"
+)
+
+func TestTransactorNestedCallRollsBackToSavepoint(t *testing.T) {
+	db := setupOutboxDB(t)
+	ctx := context.Background()
+
+	transactor := NewTransactor(db)
+	registerQueue := NewOutboxRemindRegisterQueue(db)
+	keptTaskID := uuid.Must(uuid.NewV7()).String()
+	droppedTaskID := uuid.Must(uuid.NewV7()).String()
+
+	errNested := errors.New("nested failure")
+
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := registerQueue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: keptTaskID}); err != nil {
+			t.Fatalf("failed to enqueue register: %v", err)
+		}
+
+		nestedErr := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if _, err := registerQueue.RegisterRemind(ctx, &remindregister.CreateRemindRequest{TaskID: droppedTaskID}); err != nil {
+				t.Fatalf("failed to enqueue register: %v", err)
+			}
+
+			return errNested
+		})
+		if !errors.Is(nestedErr, errNested) {
+			t.Fatalf("expected nested error, got %v", nestedErr)
+		}
+
+		// The outer transaction carries on after the nested failure
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var rows []RemindOutboxModel
+	if err := db.Find(&rows).Error; err != nil {
+		t.Fatalf("failed to list outbox rows: %v", err)
+	}
+
+	if len(rows) != 1 {
+		t.Fatalf("expected only the outer outbox row to be committed, got %d rows", len(rows))
+	}
+
+	if rows[0].TaskID != keptTaskID {
+		t.Errorf("expected outbox row of task %s, got %s", keptTaskID, rows[0].TaskID)
+	}
+}
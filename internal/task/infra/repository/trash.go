@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/purge"
+	"gorm.io/gorm"
+)
+
+func (r *taskRepository) GetDeletedTaskByID(
+	ctx context.Context,
+	id domaintask.ID,
+	userID domainuser.ID,
+) (*domaintask.DeletedTask, error) {
+	var record TaskModel
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id.String(), userID.String()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domaintask.ErrDeletedTaskNotFound
+		}
+
+		return nil, err
+	}
+
+	deletedTasks, err := r.recordsToDeletedTasks(conn(ctx, r.db), []TaskModel{record})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedTasks[0], nil
+}
+
+func (r *taskRepository) ListDeletedTasksByUserID(
+	ctx context.Context,
+	userID domainuser.ID,
+	query domaintask.ListDeletedTasksQuery,
+) ([]*domaintask.DeletedTask, *domaintask.PageCursor, error) {
+	pageSize, err := domaintask.NormalizePageSize(query.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := conn(ctx, r.db).
+		Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID.String())
+
+	if query.Cursor != nil {
+		deletedAt, err := query.Cursor.SortKeyTime()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		db = db.Where(
+			"(deleted_at < ? OR (deleted_at = ? AND id < ?))",
+			deletedAt, deletedAt, query.Cursor.ID().String(),
+		)
+	}
+
+	var records []TaskModel
+
+	// Fetch one extra row to find out whether another page exists.
+	if err := db.
+		Order("deleted_at DESC, id DESC").
+		Limit(pageSize + 1).
+		Find(&records).Error; err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(records) > pageSize
+	if hasMore {
+		records = records[:pageSize]
+	}
+
+	deletedTasks, err := r.recordsToDeletedTasks(conn(ctx, r.db), records)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !hasMore {
+		return deletedTasks, nil, nil
+	}
+
+	nextCursor, err := domaintask.NewDeletedTaskPageCursor(deletedTasks[len(deletedTasks)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deletedTasks, nextCursor, nil
+}
+
+func (r *taskRepository) recordsToDeletedTasks(db *gorm.DB, records []TaskModel) ([]*domaintask.DeletedTask, error) {
+	progress, err := checklistProgressByTaskID(db, taskRecordIDs(records))
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := tagsByTaskID(db, taskRecordIDs(records))
+	if err != nil {
+		return nil, err
+	}
+
+	deletedTasks := make([]*domaintask.DeletedTask, 0, len(records))
+	for _, record := range records {
+		task, err := r.recordToTask(record, progress[record.ID], tags[record.ID])
+		if err != nil {
+			return nil, err
+		}
+
+		deletedTask, err := domaintask.NewDeletedTask(task, record.DeletedAt.Time)
+		if err != nil {
+			return nil, err
+		}
+
+		deletedTasks = append(deletedTasks, deletedTask)
+	}
+
+	return deletedTasks, nil
+}
+
+func (r *taskRepository) RestoreTask(ctx context.Context, task *domaintask.Task) error {
+	if task == nil {
+		return ErrTaskRequired
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Unscoped().
+			Model(&TaskModel{}).
+			Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", task.ID().String(), task.UserID().String()).
+			Updates(map[string]any{
+				"deleted_at":  nil,
+				"task_status": string(task.TaskStatus()),
+			})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return domaintask.ErrDeletedTaskNotFound
+		}
+
+		return recordTaskChanges(tx, task.UserID().String(), upserted(task.ID().String())...)
+	})
+}
+
+type trashPurgeStore struct {
+	db *gorm.DB
+}
+
+func NewTrashPurgeStore(db *gorm.DB) purge.Store {
+	return &trashPurgeStore{db: db}
+}
+
+func (s *trashPurgeStore) PurgeDeletedTasks(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	var purged []string
+
+	err := conn(ctx, s.db).Transaction(func(tx *gorm.DB) error {
+		// Checklist items go with the task through their foreign key
+		if err := tx.Raw(`
+			DELETE FROM tasks
+			WHERE id IN (
+				SELECT id FROM tasks
+				WHERE deleted_at < ?
+				ORDER BY deleted_at ASC
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id`,
+			deletedBefore, limit,
+		).Scan(&purged).Error; err != nil {
+			return err
+		}
+
+		if len(purged) == 0 {
+			return nil
+		}
+
+		return deleteTaskTags(tx, purged)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(purged), nil
+}
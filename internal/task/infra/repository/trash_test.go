@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	domainuser "github.com/KasumiMercury/primind-central-backend/internal/task/domain/user"
+)
+
+func TestTaskTrash(t *testing.T) {
+	db := setupTaskDB(t)
+	ctx := context.Background()
+
+	repo := NewTaskRepository(db)
+	tagRepo := NewTagRepository(db)
+	checklistRepo := NewChecklistRepository(db)
+
+	userID, err := domainuser.NewID()
+	if err != nil {
+		t.Fatalf("failed to create user ID: %v", err)
+	}
+
+	task := createTestTask(t, db, userID)
+	tag := createTestTag(t, tagRepo, userID, "errands")
+	addTestChecklistItem(t, checklistRepo, task, "first", 0)
+
+	if err := tagRepo.AttachTag(ctx, task.ID(), tag.ID(), userID); err != nil {
+		t.Fatalf("failed to attach tag: %v", err)
+	}
+
+	if err := repo.DeleteTask(ctx, task.ID(), userID); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+
+	t.Run("hides the task from active queries", func(t *testing.T) {
+		if _, err := repo.GetTaskByID(ctx, task.ID(), userID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected ErrTaskNotFound, got %v", err)
+		}
+
+		if err := repo.DeleteTask(ctx, task.ID(), userID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected deleting twice to fail with ErrTaskNotFound, got %v", err)
+		}
+
+		if _, err := checklistRepo.ListChecklistItems(ctx, task.ID(), userID); !errors.Is(err, domaintask.ErrTaskNotFound) {
+			t.Fatalf("expected checklist of a trashed task to be unreachable, got %v", err)
+		}
+	})
+
+	t.Run("lists the task in the trash", func(t *testing.T) {
+		deletedTasks, next, err := repo.ListDeletedTasksByUserID(ctx, userID, domaintask.ListDeletedTasksQuery{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(deletedTasks) != 1 || next != nil {
+			t.Fatalf("expected one deleted task and no next page, got %d %v", len(deletedTasks), next)
+		}
+
+		deleted := deletedTasks[0]
+		if deleted.Task().ID() != task.ID() || deleted.DeletedAt().IsZero() {
+			t.Fatalf("unexpected deleted task %s %v", deleted.Task().ID(), deleted.DeletedAt())
+		}
+
+		if deleted.Task().ChecklistProgress().Total() != 1 || len(deleted.Task().Tags()) != 1 {
+			t.Fatalf("expected checklist and tags to be kept, got %+v %d", deleted.Task().ChecklistProgress(), len(deleted.Task().Tags()))
+		}
+	})
+
+	t.Run("restores the task", func(t *testing.T) {
+		deleted, err := repo.GetDeletedTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		restored, err := deleted.Restore()
+		if err != nil {
+			t.Fatalf("failed to restore task entity: %v", err)
+		}
+
+		if err := repo.RestoreTask(ctx, restored); err != nil {
+			t.Fatalf("failed to restore task: %v", err)
+		}
+
+		got, err := repo.GetTaskByID(ctx, task.ID(), userID)
+		if err != nil {
+			t.Fatalf("expected restored task to be found, got %v", err)
+		}
+
+		if got.TaskStatus() != domaintask.StatusActive || len(got.Tags()) != 1 || got.ChecklistProgress().Total() != 1 {
+			t.Fatalf("unexpected restored task %s %d %+v", got.TaskStatus(), len(got.Tags()), got.ChecklistProgress())
+		}
+
+		if err := repo.RestoreTask(ctx, restored); !errors.Is(err, domaintask.ErrDeletedTaskNotFound) {
+			t.Fatalf("expected restoring twice to fail with ErrDeletedTaskNotFound, got %v", err)
+		}
+	})
+
+	t.Run("purges tasks past the retention period", func(t *testing.T) {
+		if err := repo.DeleteTask(ctx, task.ID(), userID); err != nil {
+			t.Fatalf("failed to delete task: %v", err)
+		}
+
+		store := NewTrashPurgeStore(db)
+
+		purged, err := store.PurgeDeletedTasks(ctx, time.Now().Add(-time.Hour), 10)
+		if err != nil || purged != 0 {
+			t.Fatalf("expected recently deleted task to be kept, got %d %v", purged, err)
+		}
+
+		purged, err = store.PurgeDeletedTasks(ctx, time.Now().Add(time.Hour), 10)
+		if err != nil || purged != 1 {
+			t.Fatalf("expected one purged task, got %d %v", purged, err)
+		}
+
+		if _, err := repo.GetDeletedTaskByID(ctx, task.ID(), userID); !errors.Is(err, domaintask.ErrDeletedTaskNotFound) {
+			t.Fatalf("expected purged task to be gone, got %v", err)
+		}
+
+		var taskTags int64
+		if err := db.Model(&TaskTagModel{}).Where("task_id = ?", task.ID().String()).Count(&taskTags).Error; err != nil {
+			t.Fatalf("failed to count task tags: %v", err)
+		}
+
+		if taskTags != 0 {
+			t.Fatalf("expected task tags to be purged, got %d", taskTags)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/calendarfeed"
+)
+
+// CalendarFeedService implements the CalendarFeedService
+type CalendarFeedService struct {
+	createCalendarFeedToken apptask.CreateCalendarFeedTokenUseCase
+	rotateCalendarFeedToken apptask.RotateCalendarFeedTokenUseCase
+	revokeCalendarFeedToken apptask.RevokeCalendarFeedTokenUseCase
+	logger                  *slog.Logger
+}
+
+var _ taskv1connect.CalendarFeedServiceHandler = (*CalendarFeedService)(nil)
+
+// NewCalendarFeedService creates a new CalendarFeedService
+func NewCalendarFeedService(
+	createCalendarFeedTokenUseCase apptask.CreateCalendarFeedTokenUseCase,
+	rotateCalendarFeedTokenUseCase apptask.RotateCalendarFeedTokenUseCase,
+	revokeCalendarFeedTokenUseCase apptask.RevokeCalendarFeedTokenUseCase,
+) *CalendarFeedService {
+	return &CalendarFeedService{
+		createCalendarFeedToken: createCalendarFeedTokenUseCase,
+		rotateCalendarFeedToken: rotateCalendarFeedTokenUseCase,
+		revokeCalendarFeedToken: revokeCalendarFeedTokenUseCase,
+		logger:                  slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("calendarfeed"),
+	}
+}
+
+// CreateCalendarFeedToken issues the user's first calendar feed token
+func (s *CalendarFeedService) CreateCalendarFeedToken(
+	ctx context.Context,
+	_ *taskv1.CreateCalendarFeedTokenRequest,
+) (*taskv1.CreateCalendarFeedTokenResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("create calendar feed token called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.createCalendarFeedToken.CreateCalendarFeedToken(ctx, &apptask.CalendarFeedTokenRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		return nil, s.calendarFeedError(err, "create calendar feed token")
+	}
+
+	return &taskv1.CreateCalendarFeedTokenResponse{
+		FeedToken: result.FeedToken,
+		FeedPath:  calendarfeed.FeedPath(result.FeedToken),
+	}, nil
+}
+
+// RotateCalendarFeedToken replaces the user's calendar feed token; the old feed URL stops working
+func (s *CalendarFeedService) RotateCalendarFeedToken(
+	ctx context.Context,
+	_ *taskv1.RotateCalendarFeedTokenRequest,
+) (*taskv1.RotateCalendarFeedTokenResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("rotate calendar feed token called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.rotateCalendarFeedToken.RotateCalendarFeedToken(ctx, &apptask.CalendarFeedTokenRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		return nil, s.calendarFeedError(err, "rotate calendar feed token")
+	}
+
+	return &taskv1.RotateCalendarFeedTokenResponse{
+		FeedToken: result.FeedToken,
+		FeedPath:  calendarfeed.FeedPath(result.FeedToken),
+	}, nil
+}
+
+// RevokeCalendarFeedToken removes the user's calendar feed token
+func (s *CalendarFeedService) RevokeCalendarFeedToken(
+	ctx context.Context,
+	_ *taskv1.RevokeCalendarFeedTokenRequest,
+) (*taskv1.RevokeCalendarFeedTokenResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("revoke calendar feed token called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	if err := s.revokeCalendarFeedToken.RevokeCalendarFeedToken(ctx, &apptask.CalendarFeedTokenRequest{
+		SessionToken: token,
+	}); err != nil {
+		return nil, s.calendarFeedError(err, "revoke calendar feed token")
+	}
+
+	return &taskv1.RevokeCalendarFeedTokenResponse{}, nil
+}
+
+// calendarFeedError maps a calendar feed use case error to a Connect error.
+func (s *CalendarFeedService) calendarFeedError(err error, operation string) error {
+	switch {
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized " + operation + " attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrCalendarFeedTokenNotFound):
+		s.logger.Info("calendar feed token not found during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, apptask.ErrCalendarFeedTokenAlreadyExists):
+		s.logger.Info("calendar feed token already exists during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, apptask.ErrCalendarFeedTokenRequestRequired):
+		s.logger.Warn("invalid "+operation+" request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected "+operation+" error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,108 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/calendarfeed"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCalendarFeedTokenSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := NewMockCreateCalendarFeedTokenUseCase(ctrl)
+	mockUseCase.EXPECT().
+		CreateCalendarFeedToken(gomock.Any(), &apptask.CalendarFeedTokenRequest{SessionToken: "valid-token"}).
+		Return(&apptask.CalendarFeedTokenResult{FeedToken: "feed-token"}, nil)
+
+	svc := NewCalendarFeedService(mockUseCase, nil, nil)
+
+	resp, err := svc.CreateCalendarFeedToken(ctxWithSessionToken(t, "valid-token"), &taskv1.CreateCalendarFeedTokenRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.GetFeedToken() != "feed-token" || resp.GetFeedPath() != calendarfeed.FeedPath("feed-token") {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
+
+func TestCalendarFeedServiceError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "unauthorized",
+			useCaseErr:   apptask.ErrUnauthorized,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "no token to rotate",
+			useCaseErr:   apptask.ErrCalendarFeedTokenNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "token already exists",
+			useCaseErr:   apptask.ErrCalendarFeedTokenAlreadyExists,
+			expectedCode: connect.CodeAlreadyExists,
+		},
+		{
+			name:         "unexpected error",
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockRotateCalendarFeedTokenUseCase(ctrl)
+			mockUseCase.EXPECT().
+				RotateCalendarFeedToken(gomock.Any(), gomock.Any()).
+				Return(nil, tt.useCaseErr)
+
+			svc := NewCalendarFeedService(nil, mockUseCase, nil)
+
+			_, err := svc.RotateCalendarFeedToken(ctxWithSessionToken(t, "valid-token"), &taskv1.RotateCalendarFeedTokenRequest{})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Errorf("expected code %v, got %v", tt.expectedCode, err)
+			}
+		})
+	}
+
+	t.Run("revoke", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockRevokeCalendarFeedTokenUseCase(ctrl)
+		mockUseCase.EXPECT().
+			RevokeCalendarFeedToken(gomock.Any(), gomock.Any()).
+			Return(apptask.ErrCalendarFeedTokenNotFound)
+
+		svc := NewCalendarFeedService(nil, nil, mockUseCase)
+
+		_, err := svc.RevokeCalendarFeedToken(ctxWithSessionToken(t, "valid-token"), &taskv1.RevokeCalendarFeedTokenRequest{})
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+
+	t.Run("missing session token", func(t *testing.T) {
+		svc := NewCalendarFeedService(nil, nil, nil)
+
+		_, err := svc.CreateCalendarFeedToken(context.Background(), &taskv1.CreateCalendarFeedTokenRequest{})
+		if connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", err)
+		}
+	})
+}
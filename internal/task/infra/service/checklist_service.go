@@ -0,0 +1,253 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+)
+
+// ChecklistService implements the TaskChecklistService
+type ChecklistService struct {
+	listChecklistItems    apptask.ListChecklistItemsUseCase
+	addChecklistItem      apptask.AddChecklistItemUseCase
+	toggleChecklistItem   apptask.ToggleChecklistItemUseCase
+	reorderChecklistItems apptask.ReorderChecklistItemsUseCase
+	deleteChecklistItem   apptask.DeleteChecklistItemUseCase
+	logger                *slog.Logger
+}
+
+var _ taskv1connect.TaskChecklistServiceHandler = (*ChecklistService)(nil)
+
+// NewChecklistService creates a new ChecklistService
+func NewChecklistService(
+	listChecklistItemsUseCase apptask.ListChecklistItemsUseCase,
+	addChecklistItemUseCase apptask.AddChecklistItemUseCase,
+	toggleChecklistItemUseCase apptask.ToggleChecklistItemUseCase,
+	reorderChecklistItemsUseCase apptask.ReorderChecklistItemsUseCase,
+	deleteChecklistItemUseCase apptask.DeleteChecklistItemUseCase,
+) *ChecklistService {
+	return &ChecklistService{
+		listChecklistItems:    listChecklistItemsUseCase,
+		addChecklistItem:      addChecklistItemUseCase,
+		toggleChecklistItem:   toggleChecklistItemUseCase,
+		reorderChecklistItems: reorderChecklistItemsUseCase,
+		deleteChecklistItem:   deleteChecklistItemUseCase,
+		logger:                slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("checklist"),
+	}
+}
+
+// ListChecklistItems returns the checklist of an active task
+func (s *ChecklistService) ListChecklistItems(
+	ctx context.Context,
+	req *taskv1.ListChecklistItemsRequest,
+) (*taskv1.ListChecklistItemsResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("list checklist items called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.listChecklistItems.ListChecklistItems(ctx, &apptask.ListChecklistItemsRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+	})
+	if err != nil {
+		return nil, s.checklistError(err, "list checklist items")
+	}
+
+	return &taskv1.ListChecklistItemsResponse{
+		Items:    checklistItemsToProto(result.Items),
+		Progress: checklistProgressToProto(result.Progress),
+	}, nil
+}
+
+// AddChecklistItem appends an item to the end of a task's checklist
+func (s *ChecklistService) AddChecklistItem(
+	ctx context.Context,
+	req *taskv1.AddChecklistItemRequest,
+) (*taskv1.AddChecklistItemResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("add checklist item called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.addChecklistItem.AddChecklistItem(ctx, &apptask.AddChecklistItemRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		Text:         req.GetText(),
+	})
+	if err != nil {
+		return nil, s.checklistError(err, "add checklist item")
+	}
+
+	s.logger.Info("checklist item added", slog.String("task_id", result.TaskID))
+
+	return &taskv1.AddChecklistItemResponse{
+		Items:    checklistItemsToProto(result.Items),
+		Progress: checklistProgressToProto(result.Progress),
+	}, nil
+}
+
+// ToggleChecklistItem marks a checklist item as done or not done
+func (s *ChecklistService) ToggleChecklistItem(
+	ctx context.Context,
+	req *taskv1.ToggleChecklistItemRequest,
+) (*taskv1.ToggleChecklistItemResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("toggle checklist item called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.toggleChecklistItem.ToggleChecklistItem(ctx, &apptask.ToggleChecklistItemRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		ItemID:       req.GetItemId(),
+		Done:         req.GetDone(),
+	})
+	if err != nil {
+		return nil, s.checklistError(err, "toggle checklist item")
+	}
+
+	s.logger.Info("checklist item toggled", slog.String("task_id", result.TaskID))
+
+	return &taskv1.ToggleChecklistItemResponse{
+		Items:    checklistItemsToProto(result.Items),
+		Progress: checklistProgressToProto(result.Progress),
+	}, nil
+}
+
+// ReorderChecklistItems puts a task's checklist into the given order
+func (s *ChecklistService) ReorderChecklistItems(
+	ctx context.Context,
+	req *taskv1.ReorderChecklistItemsRequest,
+) (*taskv1.ReorderChecklistItemsResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("reorder checklist items called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.reorderChecklistItems.ReorderChecklistItems(ctx, &apptask.ReorderChecklistItemsRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		ItemIDs:      req.GetItemIds(),
+	})
+	if err != nil {
+		return nil, s.checklistError(err, "reorder checklist items")
+	}
+
+	s.logger.Info("checklist reordered", slog.String("task_id", result.TaskID))
+
+	return &taskv1.ReorderChecklistItemsResponse{
+		Items:    checklistItemsToProto(result.Items),
+		Progress: checklistProgressToProto(result.Progress),
+	}, nil
+}
+
+// DeleteChecklistItem removes an item from a task's checklist
+func (s *ChecklistService) DeleteChecklistItem(
+	ctx context.Context,
+	req *taskv1.DeleteChecklistItemRequest,
+) (*taskv1.DeleteChecklistItemResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("delete checklist item called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.deleteChecklistItem.DeleteChecklistItem(ctx, &apptask.DeleteChecklistItemRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		ItemID:       req.GetItemId(),
+	})
+	if err != nil {
+		return nil, s.checklistError(err, "delete checklist item")
+	}
+
+	s.logger.Info("checklist item deleted", slog.String("task_id", result.TaskID))
+
+	return &taskv1.DeleteChecklistItemResponse{
+		Items:    checklistItemsToProto(result.Items),
+		Progress: checklistProgressToProto(result.Progress),
+	}, nil
+}
+
+// checklistError maps a checklist use case error to a Connect error. All checklist RPCs
+// fail the same way, so they share the mapping.
+func (s *ChecklistService) checklistError(err error, operation string) error {
+	switch {
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized " + operation + " attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrTaskNotFound),
+		errors.Is(err, apptask.ErrChecklistItemNotFound):
+		s.logger.Info("not found during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, apptask.ErrChecklistFull):
+		s.logger.Info("checklist is full during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, apptask.ErrListChecklistItemsRequestRequired),
+		errors.Is(err, apptask.ErrAddChecklistItemRequestRequired),
+		errors.Is(err, apptask.ErrToggleChecklistItemRequestRequired),
+		errors.Is(err, apptask.ErrReorderChecklistItemsRequestRequired),
+		errors.Is(err, apptask.ErrDeleteChecklistItemRequestRequired),
+		errors.Is(err, apptask.ErrTaskIDRequired),
+		errors.Is(err, apptask.ErrChecklistItemIDRequired),
+		errors.Is(err, apptask.ErrChecklistItemTextEmpty),
+		errors.Is(err, apptask.ErrChecklistItemTextTooLong),
+		errors.Is(err, apptask.ErrChecklistOrderMismatch),
+		errors.Is(err, domaintask.ErrIDInvalidFormat),
+		errors.Is(err, domaintask.ErrIDInvalidV7),
+		errors.Is(err, domaintask.ErrChecklistItemIDInvalidFormat),
+		errors.Is(err, domaintask.ErrChecklistItemIDInvalidV7):
+		s.logger.Warn("invalid "+operation+" request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected "+operation+" error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func checklistItemsToProto(items []apptask.ChecklistItem) []*taskv1.ChecklistItem {
+	protoItems := make([]*taskv1.ChecklistItem, 0, len(items))
+
+	for _, item := range items {
+		protoItems = append(protoItems, &taskv1.ChecklistItem{
+			ItemId:   item.ItemID,
+			Text:     item.Text,
+			Done:     item.Done,
+			Position: int32(item.Position),
+		})
+	}
+
+	return protoItems
+}
+
+func checklistProgressToProto(progress apptask.ChecklistProgress) *taskv1.ChecklistProgress {
+	return &taskv1.ChecklistProgress{
+		Total: int32(progress.Total),
+		Done:  int32(progress.Done),
+	}
+}
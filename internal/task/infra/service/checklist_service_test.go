@@ -0,0 +1,134 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddChecklistItemSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := NewMockAddChecklistItemUseCase(ctrl)
+	mockUseCase.EXPECT().
+		AddChecklistItem(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.AddChecklistItemRequest) (*apptask.ChecklistResult, error) {
+			if req.SessionToken != "valid-token" || req.TaskID != "task-1" || req.Text != "pack lunch" {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.ChecklistResult{
+				TaskID: "task-1",
+				Items: []apptask.ChecklistItem{
+					{ItemID: "item-1", Text: "water plants", Done: true, Position: 0},
+					{ItemID: "item-2", Text: "pack lunch", Done: false, Position: 1},
+				},
+				Progress: apptask.ChecklistProgress{Total: 2, Done: 1},
+			}, nil
+		})
+
+	svc := NewChecklistService(nil, mockUseCase, nil, nil, nil)
+	ctx := ctxWithSessionToken(t, "valid-token")
+
+	resp, err := svc.AddChecklistItem(ctx, &taskv1.AddChecklistItemRequest{
+		TaskId: "task-1",
+		Text:   "pack lunch",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetItems()) != 2 || resp.GetItems()[1].GetText() != "pack lunch" || resp.GetItems()[1].GetPosition() != 1 {
+		t.Fatalf("unexpected items: %v", resp.GetItems())
+	}
+
+	if resp.GetProgress().GetTotal() != 2 || resp.GetProgress().GetDone() != 1 {
+		t.Errorf("unexpected progress: %v", resp.GetProgress())
+	}
+}
+
+func TestChecklistServiceError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "unauthorized",
+			useCaseErr:   apptask.ErrUnauthorized,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "task not found",
+			useCaseErr:   apptask.ErrTaskNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "item not found",
+			useCaseErr:   apptask.ErrChecklistItemNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "checklist full",
+			useCaseErr:   apptask.ErrChecklistFull,
+			expectedCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name:         "order mismatch",
+			useCaseErr:   apptask.ErrChecklistOrderMismatch,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "invalid item ID",
+			useCaseErr:   domaintask.ErrChecklistItemIDInvalidFormat,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "unexpected error",
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockReorderChecklistItemsUseCase(ctrl)
+			mockUseCase.EXPECT().
+				ReorderChecklistItems(gomock.Any(), gomock.Any()).
+				Return(nil, tt.useCaseErr)
+
+			svc := NewChecklistService(nil, nil, nil, mockUseCase, nil)
+			ctx := ctxWithSessionToken(t, "valid-token")
+
+			_, err := svc.ReorderChecklistItems(ctx, &taskv1.ReorderChecklistItemsRequest{TaskId: "task-1"})
+
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("expected connect error, got %v", err)
+			}
+
+			if connectErr.Code() != tt.expectedCode {
+				t.Errorf("expected code %v, got %v", tt.expectedCode, connectErr.Code())
+			}
+		})
+	}
+
+	t.Run("missing session token", func(t *testing.T) {
+		svc := NewChecklistService(nil, nil, nil, nil, nil)
+
+		_, err := svc.DeleteChecklistItem(context.Background(), &taskv1.DeleteChecklistItemRequest{TaskId: "task-1"})
+		if connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", err)
+		}
+	})
+}
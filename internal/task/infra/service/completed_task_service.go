@@ -0,0 +1,374 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CompletedTaskService implements the CompletedTaskService
+type CompletedTaskService struct {
+	listCompletedTasks apptask.ListCompletedTasksUseCase
+	getCompletedTask   apptask.GetCompletedTaskUseCase
+	reopenTask         apptask.ReopenTaskUseCase
+	getTaskStats       apptask.GetTaskStatsUseCase
+	logger             *slog.Logger
+}
+
+var _ taskv1connect.CompletedTaskServiceHandler = (*CompletedTaskService)(nil)
+
+// NewCompletedTaskService creates a new CompletedTaskService
+func NewCompletedTaskService(
+	listCompletedTasksUseCase apptask.ListCompletedTasksUseCase,
+	getCompletedTaskUseCase apptask.GetCompletedTaskUseCase,
+	reopenTaskUseCase apptask.ReopenTaskUseCase,
+	getTaskStatsUseCase apptask.GetTaskStatsUseCase,
+) *CompletedTaskService {
+	return &CompletedTaskService{
+		listCompletedTasks: listCompletedTasksUseCase,
+		getCompletedTask:   getCompletedTaskUseCase,
+		reopenTask:         reopenTaskUseCase,
+		getTaskStats:       getTaskStatsUseCase,
+		logger:             slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("completedtask"),
+	}
+}
+
+// ListCompletedTasks lists the user's completed tasks, most recently completed first
+func (s *CompletedTaskService) ListCompletedTasks(
+	ctx context.Context,
+	req *taskv1.ListCompletedTasksRequest,
+) (*taskv1.ListCompletedTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("list completed tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	var taskType *domaintask.Type
+
+	if req.TaskType != nil {
+		parsed, err := protoTaskTypeToString(req.GetTaskType())
+		if err != nil {
+			s.logger.Warn("invalid task type filter", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		taskType = &parsed
+	}
+
+	var completedAtFrom *time.Time
+
+	if req.GetCompletedAtFrom() != nil {
+		t := req.GetCompletedAtFrom().AsTime()
+		completedAtFrom = &t
+	}
+
+	var completedAtTo *time.Time
+
+	if req.GetCompletedAtTo() != nil {
+		t := req.GetCompletedAtTo().AsTime()
+		completedAtTo = &t
+	}
+
+	result, err := s.listCompletedTasks.ListCompletedTasks(ctx, &apptask.ListCompletedTasksRequest{
+		SessionToken:    token,
+		PageSize:        int(req.GetPageSize()),
+		PageToken:       req.GetPageToken(),
+		TaskType:        taskType,
+		CompletedAtFrom: completedAtFrom,
+		CompletedAtTo:   completedAtTo,
+		TagID:           req.TagId,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized list completed tasks attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during list completed tasks", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrListCompletedTasksRequestRequired),
+			errors.Is(err, apptask.ErrInvalidPageSize),
+			errors.Is(err, apptask.ErrInvalidPageToken),
+			errors.Is(err, apptask.ErrInvalidCompletedAtRange),
+			errors.Is(err, domaintask.ErrTagIDInvalidFormat),
+			errors.Is(err, domaintask.ErrTagIDInvalidV7):
+			s.logger.Warn("invalid list completed tasks request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected list completed tasks error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	protoCompletedTasks := make([]*taskv1.CompletedTask, 0, len(result.CompletedTasks))
+	for _, completedTask := range result.CompletedTasks {
+		protoCompletedTasks = append(protoCompletedTasks, toProtoCompletedTask(completedTask))
+	}
+
+	s.logger.Info("completed tasks listed", slog.Int("count", len(protoCompletedTasks)))
+
+	return &taskv1.ListCompletedTasksResponse{
+		CompletedTasks: protoCompletedTasks,
+		NextPageToken:  result.NextPageToken,
+	}, nil
+}
+
+// GetCompletedTask retrieves a single completed task
+func (s *CompletedTaskService) GetCompletedTask(
+	ctx context.Context,
+	req *taskv1.GetCompletedTaskRequest,
+) (*taskv1.GetCompletedTaskResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("get completed task called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.getCompletedTask.GetCompletedTask(ctx, &apptask.GetCompletedTaskRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized get completed task attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during get completed task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrCompletedTaskNotFound):
+			s.logger.Info("completed task not found", slog.String("task_id", req.GetTaskId()))
+
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, apptask.ErrGetCompletedTaskRequestRequired),
+			errors.Is(err, apptask.ErrTaskIDRequired),
+			errors.Is(err, domaintask.ErrIDInvalidFormat),
+			errors.Is(err, domaintask.ErrIDInvalidV7):
+			s.logger.Warn("invalid get completed task request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected get completed task error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("completed task retrieved", slog.String("task_id", result.CompletedTask.TaskID))
+
+	return &taskv1.GetCompletedTaskResponse{
+		CompletedTask: toProtoCompletedTask(result.CompletedTask),
+	}, nil
+}
+
+// ReopenTask moves a completed task back to the active tasks and registers its reminders again
+func (s *CompletedTaskService) ReopenTask(
+	ctx context.Context,
+	req *taskv1.ReopenTaskRequest,
+) (*taskv1.ReopenTaskResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("reopen task called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.reopenTask.ReopenTask(ctx, &apptask.ReopenTaskRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized reopen task attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during reopen task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrDeviceServiceUnavailable):
+			s.logger.Error("device service unavailable during reopen task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrDeviceInvalidArgument):
+			s.logger.Error("device service invalid argument during reopen task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, apptask.ErrRemindQueueRegistrationFailed):
+			s.logger.Error("remind queue registration failed during reopen task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrCompletedTaskNotFound):
+			s.logger.Info("completed task not found", slog.String("task_id", req.GetTaskId()))
+
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, apptask.ErrReopenTaskRequestRequired),
+			errors.Is(err, apptask.ErrTaskIDRequired),
+			errors.Is(err, domaintask.ErrIDInvalidFormat),
+			errors.Is(err, domaintask.ErrIDInvalidV7):
+			s.logger.Warn("invalid reopen task request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected reopen task error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("task reopened", slog.String("task_id", result.TaskID))
+
+	var scheduledAt *timestamppb.Timestamp
+	if result.ScheduledAt != nil {
+		scheduledAt = timestamppb.New(*result.ScheduledAt)
+	}
+
+	return &taskv1.ReopenTaskResponse{
+		Task: &taskv1.Task{
+			TaskId:      result.TaskID,
+			Title:       result.Title,
+			TaskType:    stringToProtoTaskType(string(result.TaskType)),
+			TaskStatus:  stringToProtoTaskStatus(string(result.TaskStatus)),
+			Description: result.Description,
+			ScheduledAt: scheduledAt,
+			CreatedAt:   timestamppb.New(result.CreatedAt),
+			TargetAt:    timestamppb.New(result.TargetAt),
+			Color:       result.Color,
+			Version:     result.Version,
+		},
+	}, nil
+}
+
+// GetTaskStats reports completion statistics bucketed in the client's timezone
+func (s *CompletedTaskService) GetTaskStats(
+	ctx context.Context,
+	req *taskv1.GetTaskStatsRequest,
+) (*taskv1.GetTaskStatsResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("get task stats called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	interval, err := protoStatsIntervalToString(req.GetInterval())
+	if err != nil {
+		s.logger.Warn("invalid stats interval", slog.String("error", err.Error()))
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.getTaskStats.GetTaskStats(ctx, &apptask.GetTaskStatsRequest{
+		SessionToken: token,
+		Timezone:     req.GetTimezone(),
+		Interval:     interval,
+		BucketCount:  int(req.GetBucketCount()),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized get task stats attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during get task stats", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrGetTaskStatsRequestRequired),
+			errors.Is(err, apptask.ErrInvalidStatsInterval),
+			errors.Is(err, apptask.ErrInvalidStatsTimezone),
+			errors.Is(err, apptask.ErrInvalidStatsBucketCount):
+			s.logger.Warn("invalid get task stats request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected get task stats error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	buckets := make([]*taskv1.CompletionBucket, 0, len(result.Buckets))
+	for _, bucket := range result.Buckets {
+		buckets = append(buckets, &taskv1.CompletionBucket{
+			Start:     timestamppb.New(bucket.Start),
+			Completed: int32(bucket.Completed),
+		})
+	}
+
+	taskTypes := make([]*taskv1.TaskTypeStats, 0, len(result.TaskTypes))
+	for _, taskType := range result.TaskTypes {
+		taskTypes = append(taskTypes, &taskv1.TaskTypeStats{
+			TaskType:             stringToProtoTaskType(string(taskType.TaskType)),
+			Completed:            int32(taskType.Completed),
+			OnTime:               int32(taskType.OnTime),
+			MedianTimeToComplete: durationpb.New(taskType.MedianTimeToComplete),
+		})
+	}
+
+	s.logger.Info("task stats retrieved", slog.Int("completed", result.Completed))
+
+	return &taskv1.GetTaskStatsResponse{
+		Buckets:       buckets,
+		Completed:     int32(result.Completed),
+		OnTime:        int32(result.OnTime),
+		OnTimeRatio:   result.OnTimeRatio,
+		TaskTypes:     taskTypes,
+		CurrentStreak: int32(result.CurrentStreak),
+		LongestStreak: int32(result.LongestStreak),
+	}, nil
+}
+
+func protoStatsIntervalToString(interval taskv1.StatsInterval) (domaintask.StatsInterval, error) {
+	switch interval {
+	case taskv1.StatsInterval_STATS_INTERVAL_DAY:
+		return domaintask.StatsIntervalDay, nil
+	case taskv1.StatsInterval_STATS_INTERVAL_WEEK:
+		return domaintask.StatsIntervalWeek, nil
+	case taskv1.StatsInterval_STATS_INTERVAL_UNSPECIFIED:
+		return "", errors.New("stats interval is required")
+	default:
+		return "", errors.New("unsupported stats interval")
+	}
+}
+
+func toProtoCompletedTask(completedTask apptask.CompletedTaskItem) *taskv1.CompletedTask {
+	var scheduledAt *timestamppb.Timestamp
+	if completedTask.ScheduledAt != nil {
+		scheduledAt = timestamppb.New(*completedTask.ScheduledAt)
+	}
+
+	return &taskv1.CompletedTask{
+		TaskId:      completedTask.TaskID,
+		TaskType:    stringToProtoTaskType(string(completedTask.TaskType)),
+		Title:       completedTask.Title,
+		Description: completedTask.Description,
+		ScheduledAt: scheduledAt,
+		CreatedAt:   timestamppb.New(completedTask.CreatedAt),
+		TargetAt:    timestamppb.New(completedTask.TargetAt),
+		Color:       completedTask.Color,
+		CompletedAt: timestamppb.New(completedTask.CompletedAt),
+		Checklist:   checklistItemsToProto(completedTask.Checklist),
+		Tags:        tagsToProto(completedTask.Tags),
+	}
+}
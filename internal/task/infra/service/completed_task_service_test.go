@@ -0,0 +1,510 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListCompletedTasksSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	from := now.Add(-7 * 24 * time.Hour)
+
+	mockUseCase := NewMockListCompletedTasksUseCase(ctrl)
+	mockUseCase.EXPECT().
+		ListCompletedTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.ListCompletedTasksRequest) (*apptask.ListCompletedTasksResult, error) {
+			if req.SessionToken != "valid-token" {
+				t.Fatalf("expected session token valid-token, got %s", req.SessionToken)
+			}
+
+			if req.PageSize != 20 || req.PageToken != "page-token" {
+				t.Fatalf("unexpected pagination: size=%d token=%s", req.PageSize, req.PageToken)
+			}
+
+			if req.TaskType == nil || *req.TaskType != domaintask.TypeScheduled {
+				t.Fatalf("expected task type filter scheduled, got %v", req.TaskType)
+			}
+
+			if req.CompletedAtFrom == nil || !req.CompletedAtFrom.Equal(from) {
+				t.Fatalf("expected completed_at_from %v, got %v", from, req.CompletedAtFrom)
+			}
+
+			if req.CompletedAtTo != nil {
+				t.Fatalf("expected no completed_at_to, got %v", req.CompletedAtTo)
+			}
+
+			scheduledAt := now.Add(-time.Hour)
+
+			return &apptask.ListCompletedTasksResult{
+				CompletedTasks: []apptask.CompletedTaskItem{
+					{
+						TaskID:      "task-1",
+						Title:       "Task 1",
+						TaskType:    domaintask.TypeScheduled,
+						ScheduledAt: &scheduledAt,
+						CreatedAt:   now.Add(-2 * time.Hour),
+						TargetAt:    scheduledAt,
+						Color:       "#FF6B6B",
+						CompletedAt: now,
+					},
+				},
+				NextPageToken: "next-token",
+			}, nil
+		})
+
+	svc := NewCompletedTaskService(mockUseCase, nil, nil, nil)
+	ctx := ctxWithSessionToken(t, "valid-token")
+
+	resp, err := svc.ListCompletedTasks(ctx, &taskv1.ListCompletedTasksRequest{
+		PageSize:        20,
+		PageToken:       "page-token",
+		TaskType:        taskv1.TaskType_TASK_TYPE_SCHEDULED.Enum(),
+		CompletedAtFrom: timestamppb.New(from),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetCompletedTasks()) != 1 {
+		t.Fatalf("expected 1 completed task, got %d", len(resp.GetCompletedTasks()))
+	}
+
+	got := resp.GetCompletedTasks()[0]
+	if got.GetTaskId() != "task-1" || got.GetTaskType() != taskv1.TaskType_TASK_TYPE_SCHEDULED {
+		t.Fatalf("unexpected completed task: %v", got)
+	}
+
+	if !got.GetCompletedAt().AsTime().Equal(now) {
+		t.Fatalf("expected completed_at %v, got %v", now, got.GetCompletedAt().AsTime())
+	}
+
+	if got.GetScheduledAt() == nil {
+		t.Fatal("expected scheduled_at to be set")
+	}
+
+	if resp.GetNextPageToken() != "next-token" {
+		t.Fatalf("expected next page token next-token, got %s", resp.GetNextPageToken())
+	}
+}
+
+func TestListCompletedTasksError(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		service      func(ctrl *gomock.Controller) *CompletedTaskService
+		req          *taskv1.ListCompletedTasksRequest
+		expectedCode connect.Code
+	}{
+		{
+			name:         "missing session token",
+			ctx:          context.Background(),
+			service:      func(_ *gomock.Controller) *CompletedTaskService { return NewCompletedTaskService(nil, nil, nil, nil) },
+			req:          &taskv1.ListCompletedTasksRequest{},
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "unspecified task type filter",
+			ctx:          ctxWithSessionToken(t, "token"),
+			service:      func(_ *gomock.Controller) *CompletedTaskService { return NewCompletedTaskService(nil, nil, nil, nil) },
+			req:          &taskv1.ListCompletedTasksRequest{TaskType: taskv1.TaskType_TASK_TYPE_UNSPECIFIED.Enum()},
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "unauthorized",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(ctrl *gomock.Controller) *CompletedTaskService {
+				mockUseCase := NewMockListCompletedTasksUseCase(ctrl)
+				mockUseCase.EXPECT().
+					ListCompletedTasks(gomock.Any(), gomock.Any()).
+					Return(nil, apptask.ErrUnauthorized)
+
+				return NewCompletedTaskService(mockUseCase, nil, nil, nil)
+			},
+			req:          &taskv1.ListCompletedTasksRequest{},
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "invalid completed_at range",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(ctrl *gomock.Controller) *CompletedTaskService {
+				mockUseCase := NewMockListCompletedTasksUseCase(ctrl)
+				mockUseCase.EXPECT().
+					ListCompletedTasks(gomock.Any(), gomock.Any()).
+					Return(nil, apptask.ErrInvalidCompletedAtRange)
+
+				return NewCompletedTaskService(mockUseCase, nil, nil, nil)
+			},
+			req:          &taskv1.ListCompletedTasksRequest{},
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "internal error",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(ctrl *gomock.Controller) *CompletedTaskService {
+				mockUseCase := NewMockListCompletedTasksUseCase(ctrl)
+				mockUseCase.EXPECT().
+					ListCompletedTasks(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
+
+				return NewCompletedTaskService(mockUseCase, nil, nil, nil)
+			},
+			req:          &taskv1.ListCompletedTasksRequest{},
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := tt.service(ctrl).ListCompletedTasks(tt.ctx, tt.req)
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestGetCompletedTaskSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockGetCompletedTaskUseCase(ctrl)
+	mockUseCase.EXPECT().
+		GetCompletedTask(gomock.Any(), &apptask.GetCompletedTaskRequest{
+			SessionToken: "valid-token",
+			TaskID:       "task-1",
+		}).
+		Return(&apptask.GetCompletedTaskResult{
+			CompletedTask: apptask.CompletedTaskItem{
+				TaskID:      "task-1",
+				Title:       "Task 1",
+				TaskType:    domaintask.TypeNear,
+				CreatedAt:   now.Add(-time.Hour),
+				TargetAt:    now,
+				Color:       "#FF6B6B",
+				CompletedAt: now,
+			},
+		}, nil)
+
+	svc := NewCompletedTaskService(nil, mockUseCase, nil, nil)
+	ctx := ctxWithSessionToken(t, "valid-token")
+
+	resp, err := svc.GetCompletedTask(ctx, &taskv1.GetCompletedTaskRequest{TaskId: "task-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.GetCompletedTask().GetTaskId() != "task-1" {
+		t.Fatalf("expected task id task-1, got %s", resp.GetCompletedTask().GetTaskId())
+	}
+
+	if resp.GetCompletedTask().GetScheduledAt() != nil {
+		t.Fatalf("expected scheduled_at to be unset")
+	}
+}
+
+func TestGetCompletedTaskError(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "missing session token",
+			ctx:          context.Background(),
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "not found",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   apptask.ErrCompletedTaskNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "invalid task id",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   domaintask.ErrIDInvalidFormat,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "auth service unavailable",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   apptask.ErrAuthServiceUnavailable,
+			expectedCode: connect.CodeUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockGetCompletedTaskUseCase(ctrl)
+			if tt.useCaseErr != nil {
+				mockUseCase.EXPECT().
+					GetCompletedTask(gomock.Any(), gomock.Any()).
+					Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewCompletedTaskService(nil, mockUseCase, nil, nil)
+
+			_, err := svc.GetCompletedTask(tt.ctx, &taskv1.GetCompletedTaskRequest{TaskId: "task-1"})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestReopenTaskSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockReopenTaskUseCase(ctrl)
+	mockUseCase.EXPECT().
+		ReopenTask(gomock.Any(), &apptask.ReopenTaskRequest{
+			SessionToken: "valid-token",
+			TaskID:       "task-1",
+		}).
+		Return(&apptask.ReopenTaskResult{
+			TaskID:     "task-1",
+			Title:      "Task 1",
+			TaskType:   domaintask.TypeNear,
+			TaskStatus: domaintask.StatusActive,
+			CreatedAt:  now.Add(-time.Hour),
+			TargetAt:   now.Add(time.Hour),
+			Color:      "#FF6B6B",
+		}, nil)
+
+	svc := NewCompletedTaskService(nil, nil, mockUseCase, nil)
+	ctx := ctxWithSessionToken(t, "valid-token")
+
+	resp, err := svc.ReopenTask(ctx, &taskv1.ReopenTaskRequest{TaskId: "task-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.GetTask().GetTaskId() != "task-1" {
+		t.Fatalf("expected task id task-1, got %s", resp.GetTask().GetTaskId())
+	}
+
+	if resp.GetTask().GetTaskStatus() != taskv1.TaskStatus_TASK_STATUS_ACTIVE {
+		t.Fatalf("expected active status, got %v", resp.GetTask().GetTaskStatus())
+	}
+
+	if !resp.GetTask().GetTargetAt().AsTime().Equal(now.Add(time.Hour)) {
+		t.Fatalf("unexpected target_at: %v", resp.GetTask().GetTargetAt().AsTime())
+	}
+}
+
+func TestReopenTaskError(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "missing session token",
+			ctx:          context.Background(),
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "not found",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   apptask.ErrCompletedTaskNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "invalid task id",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   domaintask.ErrIDInvalidFormat,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "remind queue registration failed",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   apptask.ErrRemindQueueRegistrationFailed,
+			expectedCode: connect.CodeUnavailable,
+		},
+		{
+			name:         "device service unavailable",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   apptask.ErrDeviceServiceUnavailable,
+			expectedCode: connect.CodeUnavailable,
+		},
+		{
+			name:         "internal error",
+			ctx:          ctxWithSessionToken(t, "token"),
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockReopenTaskUseCase(ctrl)
+			if tt.useCaseErr != nil {
+				mockUseCase.EXPECT().
+					ReopenTask(gomock.Any(), gomock.Any()).
+					Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewCompletedTaskService(nil, nil, mockUseCase, nil)
+
+			_, err := svc.ReopenTask(tt.ctx, &taskv1.ReopenTaskRequest{TaskId: "task-1"})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestGetTaskStatsSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 9, 15, 0, 0, 0, time.UTC)
+
+	mockUseCase := NewMockGetTaskStatsUseCase(ctrl)
+	mockUseCase.EXPECT().
+		GetTaskStats(gomock.Any(), &apptask.GetTaskStatsRequest{
+			SessionToken: "valid-token",
+			Timezone:     "Asia/Tokyo",
+			Interval:     domaintask.StatsIntervalWeek,
+			BucketCount:  4,
+		}).
+		Return(&apptask.GetTaskStatsResult{
+			Buckets:     []apptask.CompletionBucketItem{{Start: start, Completed: 4}},
+			Completed:   4,
+			OnTime:      3,
+			OnTimeRatio: 0.75,
+			TaskTypes: []apptask.TaskTypeStatsItem{
+				{TaskType: domaintask.TypeShort, Completed: 4, OnTime: 3, MedianTimeToComplete: 90 * time.Minute},
+			},
+			CurrentStreak: 2,
+			LongestStreak: 5,
+		}, nil)
+
+	svc := NewCompletedTaskService(nil, nil, nil, mockUseCase)
+
+	resp, err := svc.GetTaskStats(ctxWithSessionToken(t, "valid-token"), &taskv1.GetTaskStatsRequest{
+		Timezone:    "Asia/Tokyo",
+		Interval:    taskv1.StatsInterval_STATS_INTERVAL_WEEK,
+		BucketCount: 4,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetBuckets()) != 1 || !resp.GetBuckets()[0].GetStart().AsTime().Equal(start) || resp.GetBuckets()[0].GetCompleted() != 4 {
+		t.Errorf("unexpected buckets %v", resp.GetBuckets())
+	}
+
+	if resp.GetCompleted() != 4 || resp.GetOnTime() != 3 || resp.GetOnTimeRatio() != 0.75 {
+		t.Errorf("unexpected totals %v", resp)
+	}
+
+	if len(resp.GetTaskTypes()) != 1 {
+		t.Fatalf("expected one task type, got %d", len(resp.GetTaskTypes()))
+	}
+
+	if got := resp.GetTaskTypes()[0]; got.GetTaskType() != taskv1.TaskType_TASK_TYPE_SHORT || got.GetMedianTimeToComplete().AsDuration() != 90*time.Minute {
+		t.Errorf("unexpected task type stats %v", got)
+	}
+
+	if resp.GetCurrentStreak() != 2 || resp.GetLongestStreak() != 5 {
+		t.Errorf("expected streaks 2/5, got %d/%d", resp.GetCurrentStreak(), resp.GetLongestStreak())
+	}
+}
+
+func TestGetTaskStatsError(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		interval     taskv1.StatsInterval
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "missing session token",
+			ctx:          context.Background(),
+			interval:     taskv1.StatsInterval_STATS_INTERVAL_DAY,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "unspecified interval",
+			ctx:          ctxWithSessionToken(t, "token"),
+			interval:     taskv1.StatsInterval_STATS_INTERVAL_UNSPECIFIED,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "unauthorized",
+			ctx:          ctxWithSessionToken(t, "token"),
+			interval:     taskv1.StatsInterval_STATS_INTERVAL_DAY,
+			useCaseErr:   apptask.ErrUnauthorized,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "invalid timezone",
+			ctx:          ctxWithSessionToken(t, "token"),
+			interval:     taskv1.StatsInterval_STATS_INTERVAL_DAY,
+			useCaseErr:   apptask.ErrInvalidStatsTimezone,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "internal error",
+			ctx:          ctxWithSessionToken(t, "token"),
+			interval:     taskv1.StatsInterval_STATS_INTERVAL_DAY,
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockGetTaskStatsUseCase(ctrl)
+			if tt.useCaseErr != nil {
+				mockUseCase.EXPECT().
+					GetTaskStats(gomock.Any(), gomock.Any()).
+					Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewCompletedTaskService(nil, nil, nil, mockUseCase)
+
+			_, err := svc.GetTaskStats(tt.ctx, &taskv1.GetTaskStatsRequest{Timezone: "UTC", Interval: tt.interval})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
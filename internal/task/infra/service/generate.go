@@ -1,3 +1,4 @@
 package task
 
-//go:generate mockgen -destination=mock_service_task.go -package=task github.com/KasumiMercury/primind-central-backend/internal/task/app/task CreateTaskUseCase,GetTaskUseCase,ListActiveTasksUseCase,UpdateTaskUseCase,DeleteTaskUseCase
+//go:generate mockgen -destination=mock_service_task.go -package=task github.com/KasumiMercury/primind-central-backend/internal/task/app/task CreateTaskUseCase,GetTaskUseCase,ListActiveTasksUseCase,UpdateTaskUseCase,DeleteTaskUseCase,SnoozeTaskUseCase,MoveTaskUseCase,SearchTasksUseCase,BatchUpdateTasksUseCase,BatchDeleteTasksUseCase,WatchTasksUseCase,SyncTasksUseCase,ImportTasksUseCase,ListCompletedTasksUseCase,GetCompletedTaskUseCase,ReopenTaskUseCase,GetTaskStatsUseCase,ListDeletedTasksUseCase,RestoreTaskUseCase,ListChecklistItemsUseCase,AddChecklistItemUseCase,ToggleChecklistItemUseCase,ReorderChecklistItemsUseCase,DeleteChecklistItemUseCase,CreateTagUseCase,ListTagsUseCase,UpdateTagUseCase,DeleteTagUseCase,AttachTagUseCase,DetachTagUseCase,CreateCalendarFeedTokenUseCase,RotateCalendarFeedTokenUseCase,RevokeCalendarFeedTokenUseCase
+//go:generate mockgen -destination=mock_service_period.go -package=task github.com/KasumiMercury/primind-central-backend/internal/task/app/period GetPeriodSettingsUseCase,UpdatePeriodSettingsUseCase,GetReminderIntervalSettingsUseCase,UpdateReminderIntervalSettingsUseCase,GetQuietHoursSettingsUseCase,UpdateQuietHoursSettingsUseCase
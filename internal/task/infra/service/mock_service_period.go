@@ -0,0 +1,252 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/KasumiMercury/primind-central-backend/internal/task/app/period (interfaces: GetPeriodSettingsUseCase,UpdatePeriodSettingsUseCase,GetReminderIntervalSettingsUseCase,UpdateReminderIntervalSettingsUseCase,GetQuietHoursSettingsUseCase,UpdateQuietHoursSettingsUseCase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_service_period.go -package=task github.com/KasumiMercury/primind-central-backend/internal/task/app/period GetPeriodSettingsUseCase,UpdatePeriodSettingsUseCase,GetReminderIntervalSettingsUseCase,UpdateReminderIntervalSettingsUseCase,GetQuietHoursSettingsUseCase,UpdateQuietHoursSettingsUseCase
+//
+
+// Package task is a generated GoMock package.
+package task
+
+import (
+	context "context"
+	reflect "reflect"
+
+	periodsetting "github.com/KasumiMercury/primind-central-backend/internal/task/app/period"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetPeriodSettingsUseCase is a mock of GetPeriodSettingsUseCase interface.
+type MockGetPeriodSettingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPeriodSettingsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetPeriodSettingsUseCaseMockRecorder is the mock recorder for MockGetPeriodSettingsUseCase.
+type MockGetPeriodSettingsUseCaseMockRecorder struct {
+	mock *MockGetPeriodSettingsUseCase
+}
+
+// NewMockGetPeriodSettingsUseCase creates a new mock instance.
+func NewMockGetPeriodSettingsUseCase(ctrl *gomock.Controller) *MockGetPeriodSettingsUseCase {
+	mock := &MockGetPeriodSettingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetPeriodSettingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPeriodSettingsUseCase) EXPECT() *MockGetPeriodSettingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetPeriodSettings mocks base method.
+func (m *MockGetPeriodSettingsUseCase) GetPeriodSettings(ctx context.Context, req *periodsetting.GetPeriodSettingsRequest) (*periodsetting.GetPeriodSettingsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeriodSettings", ctx, req)
+	ret0, _ := ret[0].(*periodsetting.GetPeriodSettingsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeriodSettings indicates an expected call of GetPeriodSettings.
+func (mr *MockGetPeriodSettingsUseCaseMockRecorder) GetPeriodSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeriodSettings", reflect.TypeOf((*MockGetPeriodSettingsUseCase)(nil).GetPeriodSettings), ctx, req)
+}
+
+// MockUpdatePeriodSettingsUseCase is a mock of UpdatePeriodSettingsUseCase interface.
+type MockUpdatePeriodSettingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePeriodSettingsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdatePeriodSettingsUseCaseMockRecorder is the mock recorder for MockUpdatePeriodSettingsUseCase.
+type MockUpdatePeriodSettingsUseCaseMockRecorder struct {
+	mock *MockUpdatePeriodSettingsUseCase
+}
+
+// NewMockUpdatePeriodSettingsUseCase creates a new mock instance.
+func NewMockUpdatePeriodSettingsUseCase(ctrl *gomock.Controller) *MockUpdatePeriodSettingsUseCase {
+	mock := &MockUpdatePeriodSettingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdatePeriodSettingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePeriodSettingsUseCase) EXPECT() *MockUpdatePeriodSettingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UpdatePeriodSettings mocks base method.
+func (m *MockUpdatePeriodSettingsUseCase) UpdatePeriodSettings(ctx context.Context, req *periodsetting.UpdatePeriodSettingsRequest) (*periodsetting.UpdatePeriodSettingsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePeriodSettings", ctx, req)
+	ret0, _ := ret[0].(*periodsetting.UpdatePeriodSettingsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePeriodSettings indicates an expected call of UpdatePeriodSettings.
+func (mr *MockUpdatePeriodSettingsUseCaseMockRecorder) UpdatePeriodSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePeriodSettings", reflect.TypeOf((*MockUpdatePeriodSettingsUseCase)(nil).UpdatePeriodSettings), ctx, req)
+}
+
+// MockGetReminderIntervalSettingsUseCase is a mock of GetReminderIntervalSettingsUseCase interface.
+type MockGetReminderIntervalSettingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetReminderIntervalSettingsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetReminderIntervalSettingsUseCaseMockRecorder is the mock recorder for MockGetReminderIntervalSettingsUseCase.
+type MockGetReminderIntervalSettingsUseCaseMockRecorder struct {
+	mock *MockGetReminderIntervalSettingsUseCase
+}
+
+// NewMockGetReminderIntervalSettingsUseCase creates a new mock instance.
+func NewMockGetReminderIntervalSettingsUseCase(ctrl *gomock.Controller) *MockGetReminderIntervalSettingsUseCase {
+	mock := &MockGetReminderIntervalSettingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetReminderIntervalSettingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetReminderIntervalSettingsUseCase) EXPECT() *MockGetReminderIntervalSettingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetReminderIntervalSettings mocks base method.
+func (m *MockGetReminderIntervalSettingsUseCase) GetReminderIntervalSettings(ctx context.Context, req *periodsetting.GetReminderIntervalSettingsRequest) (*periodsetting.GetReminderIntervalSettingsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderIntervalSettings", ctx, req)
+	ret0, _ := ret[0].(*periodsetting.GetReminderIntervalSettingsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderIntervalSettings indicates an expected call of GetReminderIntervalSettings.
+func (mr *MockGetReminderIntervalSettingsUseCaseMockRecorder) GetReminderIntervalSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderIntervalSettings", reflect.TypeOf((*MockGetReminderIntervalSettingsUseCase)(nil).GetReminderIntervalSettings), ctx, req)
+}
+
+// MockUpdateReminderIntervalSettingsUseCase is a mock of UpdateReminderIntervalSettingsUseCase interface.
+type MockUpdateReminderIntervalSettingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateReminderIntervalSettingsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateReminderIntervalSettingsUseCaseMockRecorder is the mock recorder for MockUpdateReminderIntervalSettingsUseCase.
+type MockUpdateReminderIntervalSettingsUseCaseMockRecorder struct {
+	mock *MockUpdateReminderIntervalSettingsUseCase
+}
+
+// NewMockUpdateReminderIntervalSettingsUseCase creates a new mock instance.
+func NewMockUpdateReminderIntervalSettingsUseCase(ctrl *gomock.Controller) *MockUpdateReminderIntervalSettingsUseCase {
+	mock := &MockUpdateReminderIntervalSettingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateReminderIntervalSettingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateReminderIntervalSettingsUseCase) EXPECT() *MockUpdateReminderIntervalSettingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateReminderIntervalSettings mocks base method.
+func (m *MockUpdateReminderIntervalSettingsUseCase) UpdateReminderIntervalSettings(ctx context.Context, req *periodsetting.UpdateReminderIntervalSettingsRequest) (*periodsetting.UpdateReminderIntervalSettingsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReminderIntervalSettings", ctx, req)
+	ret0, _ := ret[0].(*periodsetting.UpdateReminderIntervalSettingsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReminderIntervalSettings indicates an expected call of UpdateReminderIntervalSettings.
+func (mr *MockUpdateReminderIntervalSettingsUseCaseMockRecorder) UpdateReminderIntervalSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminderIntervalSettings", reflect.TypeOf((*MockUpdateReminderIntervalSettingsUseCase)(nil).UpdateReminderIntervalSettings), ctx, req)
+}
+
+// MockGetQuietHoursSettingsUseCase is a mock of GetQuietHoursSettingsUseCase interface.
+type MockGetQuietHoursSettingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetQuietHoursSettingsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetQuietHoursSettingsUseCaseMockRecorder is the mock recorder for MockGetQuietHoursSettingsUseCase.
+type MockGetQuietHoursSettingsUseCaseMockRecorder struct {
+	mock *MockGetQuietHoursSettingsUseCase
+}
+
+// NewMockGetQuietHoursSettingsUseCase creates a new mock instance.
+func NewMockGetQuietHoursSettingsUseCase(ctrl *gomock.Controller) *MockGetQuietHoursSettingsUseCase {
+	mock := &MockGetQuietHoursSettingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetQuietHoursSettingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetQuietHoursSettingsUseCase) EXPECT() *MockGetQuietHoursSettingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetQuietHoursSettings mocks base method.
+func (m *MockGetQuietHoursSettingsUseCase) GetQuietHoursSettings(ctx context.Context, req *periodsetting.GetQuietHoursSettingsRequest) (*periodsetting.GetQuietHoursSettingsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuietHoursSettings", ctx, req)
+	ret0, _ := ret[0].(*periodsetting.GetQuietHoursSettingsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuietHoursSettings indicates an expected call of GetQuietHoursSettings.
+func (mr *MockGetQuietHoursSettingsUseCaseMockRecorder) GetQuietHoursSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuietHoursSettings", reflect.TypeOf((*MockGetQuietHoursSettingsUseCase)(nil).GetQuietHoursSettings), ctx, req)
+}
+
+// MockUpdateQuietHoursSettingsUseCase is a mock of UpdateQuietHoursSettingsUseCase interface.
+type MockUpdateQuietHoursSettingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateQuietHoursSettingsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateQuietHoursSettingsUseCaseMockRecorder is the mock recorder for MockUpdateQuietHoursSettingsUseCase.
+type MockUpdateQuietHoursSettingsUseCaseMockRecorder struct {
+	mock *MockUpdateQuietHoursSettingsUseCase
+}
+
+// NewMockUpdateQuietHoursSettingsUseCase creates a new mock instance.
+func NewMockUpdateQuietHoursSettingsUseCase(ctrl *gomock.Controller) *MockUpdateQuietHoursSettingsUseCase {
+	mock := &MockUpdateQuietHoursSettingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateQuietHoursSettingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateQuietHoursSettingsUseCase) EXPECT() *MockUpdateQuietHoursSettingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateQuietHoursSettings mocks base method.
+func (m *MockUpdateQuietHoursSettingsUseCase) UpdateQuietHoursSettings(ctx context.Context, req *periodsetting.UpdateQuietHoursSettingsRequest) (*periodsetting.UpdateQuietHoursSettingsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuietHoursSettings", ctx, req)
+	ret0, _ := ret[0].(*periodsetting.UpdateQuietHoursSettingsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuietHoursSettings indicates an expected call of UpdateQuietHoursSettings.
+func (mr *MockUpdateQuietHoursSettingsUseCaseMockRecorder) UpdateQuietHoursSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuietHoursSettings", reflect.TypeOf((*MockUpdateQuietHoursSettingsUseCase)(nil).UpdateQuietHoursSettings), ctx, req)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/KasumiMercury/primind-central-backend/internal/task/app/task (interfaces: CreateTaskUseCase,GetTaskUseCase,ListActiveTasksUseCase,UpdateTaskUseCase,DeleteTaskUseCase)
+// Source: github.com/KasumiMercury/primind-central-backend/internal/task/app/task (interfaces: CreateTaskUseCase,GetTaskUseCase,ListActiveTasksUseCase,UpdateTaskUseCase,DeleteTaskUseCase,SnoozeTaskUseCase,MoveTaskUseCase,SearchTasksUseCase,BatchUpdateTasksUseCase,BatchDeleteTasksUseCase,WatchTasksUseCase,SyncTasksUseCase,ImportTasksUseCase,ListCompletedTasksUseCase,GetCompletedTaskUseCase,ReopenTaskUseCase,GetTaskStatsUseCase,ListDeletedTasksUseCase,RestoreTaskUseCase,ListChecklistItemsUseCase,AddChecklistItemUseCase,ToggleChecklistItemUseCase,ReorderChecklistItemsUseCase,DeleteChecklistItemUseCase,CreateTagUseCase,ListTagsUseCase,UpdateTagUseCase,DeleteTagUseCase,AttachTagUseCase,DetachTagUseCase,CreateCalendarFeedTokenUseCase,RotateCalendarFeedTokenUseCase,RevokeCalendarFeedTokenUseCase)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mock_service_task.go -package=task github.com/KasumiMercury/primind-central-backend/internal/task/app/task CreateTaskUseCase,GetTaskUseCase,ListActiveTasksUseCase,UpdateTaskUseCase,DeleteTaskUseCase
+//	mockgen -destination=mock_service_task.go -package=task github.com/KasumiMercury/primind-central-backend/internal/task/app/task CreateTaskUseCase,GetTaskUseCase,ListActiveTasksUseCase,UpdateTaskUseCase,DeleteTaskUseCase,SnoozeTaskUseCase,MoveTaskUseCase,SearchTasksUseCase,BatchUpdateTasksUseCase,BatchDeleteTasksUseCase,WatchTasksUseCase,SyncTasksUseCase,ImportTasksUseCase,ListCompletedTasksUseCase,GetCompletedTaskUseCase,ReopenTaskUseCase,GetTaskStatsUseCase,ListDeletedTasksUseCase,RestoreTaskUseCase,ListChecklistItemsUseCase,AddChecklistItemUseCase,ToggleChecklistItemUseCase,ReorderChecklistItemsUseCase,DeleteChecklistItemUseCase,CreateTagUseCase,ListTagsUseCase,UpdateTagUseCase,DeleteTagUseCase,AttachTagUseCase,DetachTagUseCase,CreateCalendarFeedTokenUseCase,RotateCalendarFeedTokenUseCase,RevokeCalendarFeedTokenUseCase
 //
 
 // Package task is a generated GoMock package.
@@ -210,3 +210,1092 @@ func (mr *MockDeleteTaskUseCaseMockRecorder) DeleteTask(ctx, req any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockDeleteTaskUseCase)(nil).DeleteTask), ctx, req)
 }
+
+// MockSnoozeTaskUseCase is a mock of SnoozeTaskUseCase interface.
+type MockSnoozeTaskUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnoozeTaskUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSnoozeTaskUseCaseMockRecorder is the mock recorder for MockSnoozeTaskUseCase.
+type MockSnoozeTaskUseCaseMockRecorder struct {
+	mock *MockSnoozeTaskUseCase
+}
+
+// NewMockSnoozeTaskUseCase creates a new mock instance.
+func NewMockSnoozeTaskUseCase(ctrl *gomock.Controller) *MockSnoozeTaskUseCase {
+	mock := &MockSnoozeTaskUseCase{ctrl: ctrl}
+	mock.recorder = &MockSnoozeTaskUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnoozeTaskUseCase) EXPECT() *MockSnoozeTaskUseCaseMockRecorder {
+	return m.recorder
+}
+
+// SnoozeTask mocks base method.
+func (m *MockSnoozeTaskUseCase) SnoozeTask(ctx context.Context, req *task.SnoozeTaskRequest) (*task.SnoozeTaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnoozeTask", ctx, req)
+	ret0, _ := ret[0].(*task.SnoozeTaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnoozeTask indicates an expected call of SnoozeTask.
+func (mr *MockSnoozeTaskUseCaseMockRecorder) SnoozeTask(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnoozeTask", reflect.TypeOf((*MockSnoozeTaskUseCase)(nil).SnoozeTask), ctx, req)
+}
+
+// MockMoveTaskUseCase is a mock of MoveTaskUseCase interface.
+type MockMoveTaskUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMoveTaskUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockMoveTaskUseCaseMockRecorder is the mock recorder for MockMoveTaskUseCase.
+type MockMoveTaskUseCaseMockRecorder struct {
+	mock *MockMoveTaskUseCase
+}
+
+// NewMockMoveTaskUseCase creates a new mock instance.
+func NewMockMoveTaskUseCase(ctrl *gomock.Controller) *MockMoveTaskUseCase {
+	mock := &MockMoveTaskUseCase{ctrl: ctrl}
+	mock.recorder = &MockMoveTaskUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMoveTaskUseCase) EXPECT() *MockMoveTaskUseCaseMockRecorder {
+	return m.recorder
+}
+
+// MoveTask mocks base method.
+func (m *MockMoveTaskUseCase) MoveTask(ctx context.Context, req *task.MoveTaskRequest) (*task.MoveTaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTask", ctx, req)
+	ret0, _ := ret[0].(*task.MoveTaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTask indicates an expected call of MoveTask.
+func (mr *MockMoveTaskUseCaseMockRecorder) MoveTask(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTask", reflect.TypeOf((*MockMoveTaskUseCase)(nil).MoveTask), ctx, req)
+}
+
+// MockSearchTasksUseCase is a mock of SearchTasksUseCase interface.
+type MockSearchTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchTasksUseCaseMockRecorder is the mock recorder for MockSearchTasksUseCase.
+type MockSearchTasksUseCaseMockRecorder struct {
+	mock *MockSearchTasksUseCase
+}
+
+// NewMockSearchTasksUseCase creates a new mock instance.
+func NewMockSearchTasksUseCase(ctrl *gomock.Controller) *MockSearchTasksUseCase {
+	mock := &MockSearchTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchTasksUseCase) EXPECT() *MockSearchTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// SearchTasks mocks base method.
+func (m *MockSearchTasksUseCase) SearchTasks(ctx context.Context, req *task.SearchTasksRequest) (*task.SearchTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTasks", ctx, req)
+	ret0, _ := ret[0].(*task.SearchTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTasks indicates an expected call of SearchTasks.
+func (mr *MockSearchTasksUseCaseMockRecorder) SearchTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTasks", reflect.TypeOf((*MockSearchTasksUseCase)(nil).SearchTasks), ctx, req)
+}
+
+// MockBatchUpdateTasksUseCase is a mock of BatchUpdateTasksUseCase interface.
+type MockBatchUpdateTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchUpdateTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchUpdateTasksUseCaseMockRecorder is the mock recorder for MockBatchUpdateTasksUseCase.
+type MockBatchUpdateTasksUseCaseMockRecorder struct {
+	mock *MockBatchUpdateTasksUseCase
+}
+
+// NewMockBatchUpdateTasksUseCase creates a new mock instance.
+func NewMockBatchUpdateTasksUseCase(ctrl *gomock.Controller) *MockBatchUpdateTasksUseCase {
+	mock := &MockBatchUpdateTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockBatchUpdateTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchUpdateTasksUseCase) EXPECT() *MockBatchUpdateTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// BatchUpdateTasks mocks base method.
+func (m *MockBatchUpdateTasksUseCase) BatchUpdateTasks(ctx context.Context, req *task.BatchUpdateTasksRequest) (*task.BatchUpdateTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateTasks", ctx, req)
+	ret0, _ := ret[0].(*task.BatchUpdateTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateTasks indicates an expected call of BatchUpdateTasks.
+func (mr *MockBatchUpdateTasksUseCaseMockRecorder) BatchUpdateTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateTasks", reflect.TypeOf((*MockBatchUpdateTasksUseCase)(nil).BatchUpdateTasks), ctx, req)
+}
+
+// MockBatchDeleteTasksUseCase is a mock of BatchDeleteTasksUseCase interface.
+type MockBatchDeleteTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchDeleteTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchDeleteTasksUseCaseMockRecorder is the mock recorder for MockBatchDeleteTasksUseCase.
+type MockBatchDeleteTasksUseCaseMockRecorder struct {
+	mock *MockBatchDeleteTasksUseCase
+}
+
+// NewMockBatchDeleteTasksUseCase creates a new mock instance.
+func NewMockBatchDeleteTasksUseCase(ctrl *gomock.Controller) *MockBatchDeleteTasksUseCase {
+	mock := &MockBatchDeleteTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockBatchDeleteTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchDeleteTasksUseCase) EXPECT() *MockBatchDeleteTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// BatchDeleteTasks mocks base method.
+func (m *MockBatchDeleteTasksUseCase) BatchDeleteTasks(ctx context.Context, req *task.BatchDeleteTasksRequest) (*task.BatchDeleteTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteTasks", ctx, req)
+	ret0, _ := ret[0].(*task.BatchDeleteTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDeleteTasks indicates an expected call of BatchDeleteTasks.
+func (mr *MockBatchDeleteTasksUseCaseMockRecorder) BatchDeleteTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteTasks", reflect.TypeOf((*MockBatchDeleteTasksUseCase)(nil).BatchDeleteTasks), ctx, req)
+}
+
+// MockWatchTasksUseCase is a mock of WatchTasksUseCase interface.
+type MockWatchTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockWatchTasksUseCaseMockRecorder is the mock recorder for MockWatchTasksUseCase.
+type MockWatchTasksUseCaseMockRecorder struct {
+	mock *MockWatchTasksUseCase
+}
+
+// NewMockWatchTasksUseCase creates a new mock instance.
+func NewMockWatchTasksUseCase(ctrl *gomock.Controller) *MockWatchTasksUseCase {
+	mock := &MockWatchTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockWatchTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchTasksUseCase) EXPECT() *MockWatchTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// WatchTasks mocks base method.
+func (m *MockWatchTasksUseCase) WatchTasks(ctx context.Context, req *task.WatchTasksRequest, send func(task.TaskEventItem) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTasks", ctx, req, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTasks indicates an expected call of WatchTasks.
+func (mr *MockWatchTasksUseCaseMockRecorder) WatchTasks(ctx, req, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTasks", reflect.TypeOf((*MockWatchTasksUseCase)(nil).WatchTasks), ctx, req, send)
+}
+
+// MockSyncTasksUseCase is a mock of SyncTasksUseCase interface.
+type MockSyncTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncTasksUseCaseMockRecorder is the mock recorder for MockSyncTasksUseCase.
+type MockSyncTasksUseCaseMockRecorder struct {
+	mock *MockSyncTasksUseCase
+}
+
+// NewMockSyncTasksUseCase creates a new mock instance.
+func NewMockSyncTasksUseCase(ctrl *gomock.Controller) *MockSyncTasksUseCase {
+	mock := &MockSyncTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockSyncTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncTasksUseCase) EXPECT() *MockSyncTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// SyncTasks mocks base method.
+func (m *MockSyncTasksUseCase) SyncTasks(ctx context.Context, req *task.SyncTasksRequest) (*task.SyncTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncTasks", ctx, req)
+	ret0, _ := ret[0].(*task.SyncTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncTasks indicates an expected call of SyncTasks.
+func (mr *MockSyncTasksUseCaseMockRecorder) SyncTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncTasks", reflect.TypeOf((*MockSyncTasksUseCase)(nil).SyncTasks), ctx, req)
+}
+
+// MockImportTasksUseCase is a mock of ImportTasksUseCase interface.
+type MockImportTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockImportTasksUseCaseMockRecorder is the mock recorder for MockImportTasksUseCase.
+type MockImportTasksUseCaseMockRecorder struct {
+	mock *MockImportTasksUseCase
+}
+
+// NewMockImportTasksUseCase creates a new mock instance.
+func NewMockImportTasksUseCase(ctrl *gomock.Controller) *MockImportTasksUseCase {
+	mock := &MockImportTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockImportTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportTasksUseCase) EXPECT() *MockImportTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ImportTasks mocks base method.
+func (m *MockImportTasksUseCase) ImportTasks(ctx context.Context, req *task.ImportTasksRequest) (*task.ImportTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTasks", ctx, req)
+	ret0, _ := ret[0].(*task.ImportTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTasks indicates an expected call of ImportTasks.
+func (mr *MockImportTasksUseCaseMockRecorder) ImportTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTasks", reflect.TypeOf((*MockImportTasksUseCase)(nil).ImportTasks), ctx, req)
+}
+
+// MockListCompletedTasksUseCase is a mock of ListCompletedTasksUseCase interface.
+type MockListCompletedTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListCompletedTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListCompletedTasksUseCaseMockRecorder is the mock recorder for MockListCompletedTasksUseCase.
+type MockListCompletedTasksUseCaseMockRecorder struct {
+	mock *MockListCompletedTasksUseCase
+}
+
+// NewMockListCompletedTasksUseCase creates a new mock instance.
+func NewMockListCompletedTasksUseCase(ctrl *gomock.Controller) *MockListCompletedTasksUseCase {
+	mock := &MockListCompletedTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockListCompletedTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListCompletedTasksUseCase) EXPECT() *MockListCompletedTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListCompletedTasks mocks base method.
+func (m *MockListCompletedTasksUseCase) ListCompletedTasks(ctx context.Context, req *task.ListCompletedTasksRequest) (*task.ListCompletedTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompletedTasks", ctx, req)
+	ret0, _ := ret[0].(*task.ListCompletedTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompletedTasks indicates an expected call of ListCompletedTasks.
+func (mr *MockListCompletedTasksUseCaseMockRecorder) ListCompletedTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletedTasks", reflect.TypeOf((*MockListCompletedTasksUseCase)(nil).ListCompletedTasks), ctx, req)
+}
+
+// MockGetCompletedTaskUseCase is a mock of GetCompletedTaskUseCase interface.
+type MockGetCompletedTaskUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCompletedTaskUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetCompletedTaskUseCaseMockRecorder is the mock recorder for MockGetCompletedTaskUseCase.
+type MockGetCompletedTaskUseCaseMockRecorder struct {
+	mock *MockGetCompletedTaskUseCase
+}
+
+// NewMockGetCompletedTaskUseCase creates a new mock instance.
+func NewMockGetCompletedTaskUseCase(ctrl *gomock.Controller) *MockGetCompletedTaskUseCase {
+	mock := &MockGetCompletedTaskUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetCompletedTaskUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCompletedTaskUseCase) EXPECT() *MockGetCompletedTaskUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetCompletedTask mocks base method.
+func (m *MockGetCompletedTaskUseCase) GetCompletedTask(ctx context.Context, req *task.GetCompletedTaskRequest) (*task.GetCompletedTaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedTask", ctx, req)
+	ret0, _ := ret[0].(*task.GetCompletedTaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletedTask indicates an expected call of GetCompletedTask.
+func (mr *MockGetCompletedTaskUseCaseMockRecorder) GetCompletedTask(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedTask", reflect.TypeOf((*MockGetCompletedTaskUseCase)(nil).GetCompletedTask), ctx, req)
+}
+
+// MockReopenTaskUseCase is a mock of ReopenTaskUseCase interface.
+type MockReopenTaskUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReopenTaskUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReopenTaskUseCaseMockRecorder is the mock recorder for MockReopenTaskUseCase.
+type MockReopenTaskUseCaseMockRecorder struct {
+	mock *MockReopenTaskUseCase
+}
+
+// NewMockReopenTaskUseCase creates a new mock instance.
+func NewMockReopenTaskUseCase(ctrl *gomock.Controller) *MockReopenTaskUseCase {
+	mock := &MockReopenTaskUseCase{ctrl: ctrl}
+	mock.recorder = &MockReopenTaskUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReopenTaskUseCase) EXPECT() *MockReopenTaskUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ReopenTask mocks base method.
+func (m *MockReopenTaskUseCase) ReopenTask(ctx context.Context, req *task.ReopenTaskRequest) (*task.ReopenTaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenTask", ctx, req)
+	ret0, _ := ret[0].(*task.ReopenTaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenTask indicates an expected call of ReopenTask.
+func (mr *MockReopenTaskUseCaseMockRecorder) ReopenTask(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenTask", reflect.TypeOf((*MockReopenTaskUseCase)(nil).ReopenTask), ctx, req)
+}
+
+// MockGetTaskStatsUseCase is a mock of GetTaskStatsUseCase interface.
+type MockGetTaskStatsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTaskStatsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetTaskStatsUseCaseMockRecorder is the mock recorder for MockGetTaskStatsUseCase.
+type MockGetTaskStatsUseCaseMockRecorder struct {
+	mock *MockGetTaskStatsUseCase
+}
+
+// NewMockGetTaskStatsUseCase creates a new mock instance.
+func NewMockGetTaskStatsUseCase(ctrl *gomock.Controller) *MockGetTaskStatsUseCase {
+	mock := &MockGetTaskStatsUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetTaskStatsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTaskStatsUseCase) EXPECT() *MockGetTaskStatsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetTaskStats mocks base method.
+func (m *MockGetTaskStatsUseCase) GetTaskStats(ctx context.Context, req *task.GetTaskStatsRequest) (*task.GetTaskStatsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskStats", ctx, req)
+	ret0, _ := ret[0].(*task.GetTaskStatsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskStats indicates an expected call of GetTaskStats.
+func (mr *MockGetTaskStatsUseCaseMockRecorder) GetTaskStats(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskStats", reflect.TypeOf((*MockGetTaskStatsUseCase)(nil).GetTaskStats), ctx, req)
+}
+
+// MockListDeletedTasksUseCase is a mock of ListDeletedTasksUseCase interface.
+type MockListDeletedTasksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDeletedTasksUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListDeletedTasksUseCaseMockRecorder is the mock recorder for MockListDeletedTasksUseCase.
+type MockListDeletedTasksUseCaseMockRecorder struct {
+	mock *MockListDeletedTasksUseCase
+}
+
+// NewMockListDeletedTasksUseCase creates a new mock instance.
+func NewMockListDeletedTasksUseCase(ctrl *gomock.Controller) *MockListDeletedTasksUseCase {
+	mock := &MockListDeletedTasksUseCase{ctrl: ctrl}
+	mock.recorder = &MockListDeletedTasksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDeletedTasksUseCase) EXPECT() *MockListDeletedTasksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListDeletedTasks mocks base method.
+func (m *MockListDeletedTasksUseCase) ListDeletedTasks(ctx context.Context, req *task.ListDeletedTasksRequest) (*task.ListDeletedTasksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedTasks", ctx, req)
+	ret0, _ := ret[0].(*task.ListDeletedTasksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedTasks indicates an expected call of ListDeletedTasks.
+func (mr *MockListDeletedTasksUseCaseMockRecorder) ListDeletedTasks(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedTasks", reflect.TypeOf((*MockListDeletedTasksUseCase)(nil).ListDeletedTasks), ctx, req)
+}
+
+// MockRestoreTaskUseCase is a mock of RestoreTaskUseCase interface.
+type MockRestoreTaskUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreTaskUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRestoreTaskUseCaseMockRecorder is the mock recorder for MockRestoreTaskUseCase.
+type MockRestoreTaskUseCaseMockRecorder struct {
+	mock *MockRestoreTaskUseCase
+}
+
+// NewMockRestoreTaskUseCase creates a new mock instance.
+func NewMockRestoreTaskUseCase(ctrl *gomock.Controller) *MockRestoreTaskUseCase {
+	mock := &MockRestoreTaskUseCase{ctrl: ctrl}
+	mock.recorder = &MockRestoreTaskUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestoreTaskUseCase) EXPECT() *MockRestoreTaskUseCaseMockRecorder {
+	return m.recorder
+}
+
+// RestoreTask mocks base method.
+func (m *MockRestoreTaskUseCase) RestoreTask(ctx context.Context, req *task.RestoreTaskRequest) (*task.RestoreTaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTask", ctx, req)
+	ret0, _ := ret[0].(*task.RestoreTaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTask indicates an expected call of RestoreTask.
+func (mr *MockRestoreTaskUseCaseMockRecorder) RestoreTask(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTask", reflect.TypeOf((*MockRestoreTaskUseCase)(nil).RestoreTask), ctx, req)
+}
+
+// MockListChecklistItemsUseCase is a mock of ListChecklistItemsUseCase interface.
+type MockListChecklistItemsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListChecklistItemsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListChecklistItemsUseCaseMockRecorder is the mock recorder for MockListChecklistItemsUseCase.
+type MockListChecklistItemsUseCaseMockRecorder struct {
+	mock *MockListChecklistItemsUseCase
+}
+
+// NewMockListChecklistItemsUseCase creates a new mock instance.
+func NewMockListChecklistItemsUseCase(ctrl *gomock.Controller) *MockListChecklistItemsUseCase {
+	mock := &MockListChecklistItemsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListChecklistItemsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListChecklistItemsUseCase) EXPECT() *MockListChecklistItemsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListChecklistItems mocks base method.
+func (m *MockListChecklistItemsUseCase) ListChecklistItems(ctx context.Context, req *task.ListChecklistItemsRequest) (*task.ChecklistResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklistItems", ctx, req)
+	ret0, _ := ret[0].(*task.ChecklistResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklistItems indicates an expected call of ListChecklistItems.
+func (mr *MockListChecklistItemsUseCaseMockRecorder) ListChecklistItems(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklistItems", reflect.TypeOf((*MockListChecklistItemsUseCase)(nil).ListChecklistItems), ctx, req)
+}
+
+// MockAddChecklistItemUseCase is a mock of AddChecklistItemUseCase interface.
+type MockAddChecklistItemUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddChecklistItemUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAddChecklistItemUseCaseMockRecorder is the mock recorder for MockAddChecklistItemUseCase.
+type MockAddChecklistItemUseCaseMockRecorder struct {
+	mock *MockAddChecklistItemUseCase
+}
+
+// NewMockAddChecklistItemUseCase creates a new mock instance.
+func NewMockAddChecklistItemUseCase(ctrl *gomock.Controller) *MockAddChecklistItemUseCase {
+	mock := &MockAddChecklistItemUseCase{ctrl: ctrl}
+	mock.recorder = &MockAddChecklistItemUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddChecklistItemUseCase) EXPECT() *MockAddChecklistItemUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddChecklistItem mocks base method.
+func (m *MockAddChecklistItemUseCase) AddChecklistItem(ctx context.Context, req *task.AddChecklistItemRequest) (*task.ChecklistResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChecklistItem", ctx, req)
+	ret0, _ := ret[0].(*task.ChecklistResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChecklistItem indicates an expected call of AddChecklistItem.
+func (mr *MockAddChecklistItemUseCaseMockRecorder) AddChecklistItem(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChecklistItem", reflect.TypeOf((*MockAddChecklistItemUseCase)(nil).AddChecklistItem), ctx, req)
+}
+
+// MockToggleChecklistItemUseCase is a mock of ToggleChecklistItemUseCase interface.
+type MockToggleChecklistItemUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockToggleChecklistItemUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockToggleChecklistItemUseCaseMockRecorder is the mock recorder for MockToggleChecklistItemUseCase.
+type MockToggleChecklistItemUseCaseMockRecorder struct {
+	mock *MockToggleChecklistItemUseCase
+}
+
+// NewMockToggleChecklistItemUseCase creates a new mock instance.
+func NewMockToggleChecklistItemUseCase(ctrl *gomock.Controller) *MockToggleChecklistItemUseCase {
+	mock := &MockToggleChecklistItemUseCase{ctrl: ctrl}
+	mock.recorder = &MockToggleChecklistItemUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToggleChecklistItemUseCase) EXPECT() *MockToggleChecklistItemUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ToggleChecklistItem mocks base method.
+func (m *MockToggleChecklistItemUseCase) ToggleChecklistItem(ctx context.Context, req *task.ToggleChecklistItemRequest) (*task.ChecklistResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleChecklistItem", ctx, req)
+	ret0, _ := ret[0].(*task.ChecklistResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToggleChecklistItem indicates an expected call of ToggleChecklistItem.
+func (mr *MockToggleChecklistItemUseCaseMockRecorder) ToggleChecklistItem(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleChecklistItem", reflect.TypeOf((*MockToggleChecklistItemUseCase)(nil).ToggleChecklistItem), ctx, req)
+}
+
+// MockReorderChecklistItemsUseCase is a mock of ReorderChecklistItemsUseCase interface.
+type MockReorderChecklistItemsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderChecklistItemsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReorderChecklistItemsUseCaseMockRecorder is the mock recorder for MockReorderChecklistItemsUseCase.
+type MockReorderChecklistItemsUseCaseMockRecorder struct {
+	mock *MockReorderChecklistItemsUseCase
+}
+
+// NewMockReorderChecklistItemsUseCase creates a new mock instance.
+func NewMockReorderChecklistItemsUseCase(ctrl *gomock.Controller) *MockReorderChecklistItemsUseCase {
+	mock := &MockReorderChecklistItemsUseCase{ctrl: ctrl}
+	mock.recorder = &MockReorderChecklistItemsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderChecklistItemsUseCase) EXPECT() *MockReorderChecklistItemsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ReorderChecklistItems mocks base method.
+func (m *MockReorderChecklistItemsUseCase) ReorderChecklistItems(ctx context.Context, req *task.ReorderChecklistItemsRequest) (*task.ChecklistResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderChecklistItems", ctx, req)
+	ret0, _ := ret[0].(*task.ChecklistResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderChecklistItems indicates an expected call of ReorderChecklistItems.
+func (mr *MockReorderChecklistItemsUseCaseMockRecorder) ReorderChecklistItems(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderChecklistItems", reflect.TypeOf((*MockReorderChecklistItemsUseCase)(nil).ReorderChecklistItems), ctx, req)
+}
+
+// MockDeleteChecklistItemUseCase is a mock of DeleteChecklistItemUseCase interface.
+type MockDeleteChecklistItemUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteChecklistItemUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteChecklistItemUseCaseMockRecorder is the mock recorder for MockDeleteChecklistItemUseCase.
+type MockDeleteChecklistItemUseCaseMockRecorder struct {
+	mock *MockDeleteChecklistItemUseCase
+}
+
+// NewMockDeleteChecklistItemUseCase creates a new mock instance.
+func NewMockDeleteChecklistItemUseCase(ctrl *gomock.Controller) *MockDeleteChecklistItemUseCase {
+	mock := &MockDeleteChecklistItemUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteChecklistItemUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteChecklistItemUseCase) EXPECT() *MockDeleteChecklistItemUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockDeleteChecklistItemUseCase) DeleteChecklistItem(ctx context.Context, req *task.DeleteChecklistItemRequest) (*task.ChecklistResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", ctx, req)
+	ret0, _ := ret[0].(*task.ChecklistResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockDeleteChecklistItemUseCaseMockRecorder) DeleteChecklistItem(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockDeleteChecklistItemUseCase)(nil).DeleteChecklistItem), ctx, req)
+}
+
+// MockCreateTagUseCase is a mock of CreateTagUseCase interface.
+type MockCreateTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateTagUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateTagUseCaseMockRecorder is the mock recorder for MockCreateTagUseCase.
+type MockCreateTagUseCaseMockRecorder struct {
+	mock *MockCreateTagUseCase
+}
+
+// NewMockCreateTagUseCase creates a new mock instance.
+func NewMockCreateTagUseCase(ctrl *gomock.Controller) *MockCreateTagUseCase {
+	mock := &MockCreateTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateTagUseCase) EXPECT() *MockCreateTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateTag mocks base method.
+func (m *MockCreateTagUseCase) CreateTag(ctx context.Context, req *task.CreateTagRequest) (*task.TagItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, req)
+	ret0, _ := ret[0].(*task.TagItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockCreateTagUseCaseMockRecorder) CreateTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockCreateTagUseCase)(nil).CreateTag), ctx, req)
+}
+
+// MockListTagsUseCase is a mock of ListTagsUseCase interface.
+type MockListTagsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTagsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockListTagsUseCaseMockRecorder is the mock recorder for MockListTagsUseCase.
+type MockListTagsUseCaseMockRecorder struct {
+	mock *MockListTagsUseCase
+}
+
+// NewMockListTagsUseCase creates a new mock instance.
+func NewMockListTagsUseCase(ctrl *gomock.Controller) *MockListTagsUseCase {
+	mock := &MockListTagsUseCase{ctrl: ctrl}
+	mock.recorder = &MockListTagsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTagsUseCase) EXPECT() *MockListTagsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListTags mocks base method.
+func (m *MockListTagsUseCase) ListTags(ctx context.Context, req *task.ListTagsRequest) (*task.ListTagsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, req)
+	ret0, _ := ret[0].(*task.ListTagsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockListTagsUseCaseMockRecorder) ListTags(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockListTagsUseCase)(nil).ListTags), ctx, req)
+}
+
+// MockUpdateTagUseCase is a mock of UpdateTagUseCase interface.
+type MockUpdateTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateTagUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateTagUseCaseMockRecorder is the mock recorder for MockUpdateTagUseCase.
+type MockUpdateTagUseCaseMockRecorder struct {
+	mock *MockUpdateTagUseCase
+}
+
+// NewMockUpdateTagUseCase creates a new mock instance.
+func NewMockUpdateTagUseCase(ctrl *gomock.Controller) *MockUpdateTagUseCase {
+	mock := &MockUpdateTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateTagUseCase) EXPECT() *MockUpdateTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateTag mocks base method.
+func (m *MockUpdateTagUseCase) UpdateTag(ctx context.Context, req *task.UpdateTagRequest) (*task.TagItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, req)
+	ret0, _ := ret[0].(*task.TagItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockUpdateTagUseCaseMockRecorder) UpdateTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockUpdateTagUseCase)(nil).UpdateTag), ctx, req)
+}
+
+// MockDeleteTagUseCase is a mock of DeleteTagUseCase interface.
+type MockDeleteTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteTagUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteTagUseCaseMockRecorder is the mock recorder for MockDeleteTagUseCase.
+type MockDeleteTagUseCaseMockRecorder struct {
+	mock *MockDeleteTagUseCase
+}
+
+// NewMockDeleteTagUseCase creates a new mock instance.
+func NewMockDeleteTagUseCase(ctrl *gomock.Controller) *MockDeleteTagUseCase {
+	mock := &MockDeleteTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteTagUseCase) EXPECT() *MockDeleteTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteTag mocks base method.
+func (m *MockDeleteTagUseCase) DeleteTag(ctx context.Context, req *task.DeleteTagRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockDeleteTagUseCaseMockRecorder) DeleteTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockDeleteTagUseCase)(nil).DeleteTag), ctx, req)
+}
+
+// MockAttachTagUseCase is a mock of AttachTagUseCase interface.
+type MockAttachTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachTagUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachTagUseCaseMockRecorder is the mock recorder for MockAttachTagUseCase.
+type MockAttachTagUseCaseMockRecorder struct {
+	mock *MockAttachTagUseCase
+}
+
+// NewMockAttachTagUseCase creates a new mock instance.
+func NewMockAttachTagUseCase(ctrl *gomock.Controller) *MockAttachTagUseCase {
+	mock := &MockAttachTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockAttachTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachTagUseCase) EXPECT() *MockAttachTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AttachTag mocks base method.
+func (m *MockAttachTagUseCase) AttachTag(ctx context.Context, req *task.AttachTagRequest) (*task.TaskTagsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTag", ctx, req)
+	ret0, _ := ret[0].(*task.TaskTagsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTag indicates an expected call of AttachTag.
+func (mr *MockAttachTagUseCaseMockRecorder) AttachTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockAttachTagUseCase)(nil).AttachTag), ctx, req)
+}
+
+// MockDetachTagUseCase is a mock of DetachTagUseCase interface.
+type MockDetachTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDetachTagUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDetachTagUseCaseMockRecorder is the mock recorder for MockDetachTagUseCase.
+type MockDetachTagUseCaseMockRecorder struct {
+	mock *MockDetachTagUseCase
+}
+
+// NewMockDetachTagUseCase creates a new mock instance.
+func NewMockDetachTagUseCase(ctrl *gomock.Controller) *MockDetachTagUseCase {
+	mock := &MockDetachTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockDetachTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDetachTagUseCase) EXPECT() *MockDetachTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DetachTag mocks base method.
+func (m *MockDetachTagUseCase) DetachTag(ctx context.Context, req *task.DetachTagRequest) (*task.TaskTagsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", ctx, req)
+	ret0, _ := ret[0].(*task.TaskTagsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockDetachTagUseCaseMockRecorder) DetachTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockDetachTagUseCase)(nil).DetachTag), ctx, req)
+}
+
+// MockCreateCalendarFeedTokenUseCase is a mock of CreateCalendarFeedTokenUseCase interface.
+type MockCreateCalendarFeedTokenUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateCalendarFeedTokenUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateCalendarFeedTokenUseCaseMockRecorder is the mock recorder for MockCreateCalendarFeedTokenUseCase.
+type MockCreateCalendarFeedTokenUseCaseMockRecorder struct {
+	mock *MockCreateCalendarFeedTokenUseCase
+}
+
+// NewMockCreateCalendarFeedTokenUseCase creates a new mock instance.
+func NewMockCreateCalendarFeedTokenUseCase(ctrl *gomock.Controller) *MockCreateCalendarFeedTokenUseCase {
+	mock := &MockCreateCalendarFeedTokenUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateCalendarFeedTokenUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateCalendarFeedTokenUseCase) EXPECT() *MockCreateCalendarFeedTokenUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendarFeedToken mocks base method.
+func (m *MockCreateCalendarFeedTokenUseCase) CreateCalendarFeedToken(ctx context.Context, req *task.CalendarFeedTokenRequest) (*task.CalendarFeedTokenResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendarFeedToken", ctx, req)
+	ret0, _ := ret[0].(*task.CalendarFeedTokenResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendarFeedToken indicates an expected call of CreateCalendarFeedToken.
+func (mr *MockCreateCalendarFeedTokenUseCaseMockRecorder) CreateCalendarFeedToken(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarFeedToken", reflect.TypeOf((*MockCreateCalendarFeedTokenUseCase)(nil).CreateCalendarFeedToken), ctx, req)
+}
+
+// MockRotateCalendarFeedTokenUseCase is a mock of RotateCalendarFeedTokenUseCase interface.
+type MockRotateCalendarFeedTokenUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateCalendarFeedTokenUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRotateCalendarFeedTokenUseCaseMockRecorder is the mock recorder for MockRotateCalendarFeedTokenUseCase.
+type MockRotateCalendarFeedTokenUseCaseMockRecorder struct {
+	mock *MockRotateCalendarFeedTokenUseCase
+}
+
+// NewMockRotateCalendarFeedTokenUseCase creates a new mock instance.
+func NewMockRotateCalendarFeedTokenUseCase(ctrl *gomock.Controller) *MockRotateCalendarFeedTokenUseCase {
+	mock := &MockRotateCalendarFeedTokenUseCase{ctrl: ctrl}
+	mock.recorder = &MockRotateCalendarFeedTokenUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRotateCalendarFeedTokenUseCase) EXPECT() *MockRotateCalendarFeedTokenUseCaseMockRecorder {
+	return m.recorder
+}
+
+// RotateCalendarFeedToken mocks base method.
+func (m *MockRotateCalendarFeedTokenUseCase) RotateCalendarFeedToken(ctx context.Context, req *task.CalendarFeedTokenRequest) (*task.CalendarFeedTokenResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCalendarFeedToken", ctx, req)
+	ret0, _ := ret[0].(*task.CalendarFeedTokenResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCalendarFeedToken indicates an expected call of RotateCalendarFeedToken.
+func (mr *MockRotateCalendarFeedTokenUseCaseMockRecorder) RotateCalendarFeedToken(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCalendarFeedToken", reflect.TypeOf((*MockRotateCalendarFeedTokenUseCase)(nil).RotateCalendarFeedToken), ctx, req)
+}
+
+// MockRevokeCalendarFeedTokenUseCase is a mock of RevokeCalendarFeedTokenUseCase interface.
+type MockRevokeCalendarFeedTokenUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeCalendarFeedTokenUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeCalendarFeedTokenUseCaseMockRecorder is the mock recorder for MockRevokeCalendarFeedTokenUseCase.
+type MockRevokeCalendarFeedTokenUseCaseMockRecorder struct {
+	mock *MockRevokeCalendarFeedTokenUseCase
+}
+
+// NewMockRevokeCalendarFeedTokenUseCase creates a new mock instance.
+func NewMockRevokeCalendarFeedTokenUseCase(ctrl *gomock.Controller) *MockRevokeCalendarFeedTokenUseCase {
+	mock := &MockRevokeCalendarFeedTokenUseCase{ctrl: ctrl}
+	mock.recorder = &MockRevokeCalendarFeedTokenUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeCalendarFeedTokenUseCase) EXPECT() *MockRevokeCalendarFeedTokenUseCaseMockRecorder {
+	return m.recorder
+}
+
+// RevokeCalendarFeedToken mocks base method.
+func (m *MockRevokeCalendarFeedTokenUseCase) RevokeCalendarFeedToken(ctx context.Context, req *task.CalendarFeedTokenRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCalendarFeedToken", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCalendarFeedToken indicates an expected call of RevokeCalendarFeedToken.
+func (mr *MockRevokeCalendarFeedTokenUseCaseMockRecorder) RevokeCalendarFeedToken(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCalendarFeedToken", reflect.TypeOf((*MockRevokeCalendarFeedTokenUseCase)(nil).RevokeCalendarFeedToken), ctx, req)
+}
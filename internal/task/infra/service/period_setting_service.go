@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	connect "connectrpc.com/connect"
 	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
@@ -15,9 +16,13 @@ import (
 
 // PeriodSettingService implements the UserPeriodSettingsService
 type PeriodSettingService struct {
-	getPeriodSettings    appperiod.GetPeriodSettingsUseCase
-	updatePeriodSettings appperiod.UpdatePeriodSettingsUseCase
-	logger               *slog.Logger
+	getPeriodSettings       appperiod.GetPeriodSettingsUseCase
+	updatePeriodSettings    appperiod.UpdatePeriodSettingsUseCase
+	getReminderIntervals    appperiod.GetReminderIntervalSettingsUseCase
+	updateReminderIntervals appperiod.UpdateReminderIntervalSettingsUseCase
+	getQuietHours           appperiod.GetQuietHoursSettingsUseCase
+	updateQuietHours        appperiod.UpdateQuietHoursSettingsUseCase
+	logger                  *slog.Logger
 }
 
 var _ taskv1connect.UserPeriodSettingsServiceHandler = (*PeriodSettingService)(nil)
@@ -26,11 +31,19 @@ var _ taskv1connect.UserPeriodSettingsServiceHandler = (*PeriodSettingService)(n
 func NewPeriodSettingService(
 	getPeriodSettingsUseCase appperiod.GetPeriodSettingsUseCase,
 	updatePeriodSettingsUseCase appperiod.UpdatePeriodSettingsUseCase,
+	getReminderIntervalsUseCase appperiod.GetReminderIntervalSettingsUseCase,
+	updateReminderIntervalsUseCase appperiod.UpdateReminderIntervalSettingsUseCase,
+	getQuietHoursUseCase appperiod.GetQuietHoursSettingsUseCase,
+	updateQuietHoursUseCase appperiod.UpdateQuietHoursSettingsUseCase,
 ) *PeriodSettingService {
 	return &PeriodSettingService{
-		getPeriodSettings:    getPeriodSettingsUseCase,
-		updatePeriodSettings: updatePeriodSettingsUseCase,
-		logger:               slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("periodsetting"),
+		getPeriodSettings:       getPeriodSettingsUseCase,
+		updatePeriodSettings:    updatePeriodSettingsUseCase,
+		getReminderIntervals:    getReminderIntervalsUseCase,
+		updateReminderIntervals: updateReminderIntervalsUseCase,
+		getQuietHours:           getQuietHoursUseCase,
+		updateQuietHours:        updateQuietHoursUseCase,
+		logger:                  slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("periodsetting"),
 	}
 }
 
@@ -136,6 +149,226 @@ func (s *PeriodSettingService) UpdateUserPeriodSettings(
 	}, nil
 }
 
+// GetUserReminderIntervalSettings retrieves user reminder interval settings
+func (s *PeriodSettingService) GetUserReminderIntervalSettings(
+	ctx context.Context,
+	req *taskv1.GetUserReminderIntervalSettingsRequest,
+) (*taskv1.GetUserReminderIntervalSettingsResponse, error) {
+	token := interceptor.ExtractSessionToken(ctx)
+	if token == "" {
+		s.logger.Warn("get reminder interval settings called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.getReminderIntervals.GetReminderIntervalSettings(ctx, &appperiod.GetReminderIntervalSettingsRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appperiod.ErrUnauthorized):
+			s.logger.Info("unauthorized get reminder interval settings attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, appperiod.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during get reminder interval settings", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		default:
+			s.logger.Error("unexpected get reminder interval settings error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("reminder interval settings retrieved", slog.Int("custom_count", len(result.Settings)))
+
+	return &taskv1.GetUserReminderIntervalSettingsResponse{
+		Settings: convertToProtoReminderIntervalSettings(result.Settings),
+		Defaults: convertToProtoReminderIntervalSettings(result.Defaults),
+	}, nil
+}
+
+// UpdateUserReminderIntervalSettings replaces user reminder interval settings
+func (s *PeriodSettingService) UpdateUserReminderIntervalSettings(
+	ctx context.Context,
+	req *taskv1.UpdateUserReminderIntervalSettingsRequest,
+) (*taskv1.UpdateUserReminderIntervalSettingsResponse, error) {
+	token := interceptor.ExtractSessionToken(ctx)
+	if token == "" {
+		s.logger.Warn("update reminder interval settings called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	settings := make([]appperiod.ReminderIntervalSettingItem, 0, len(req.GetSettings()))
+	for _, rs := range req.GetSettings() {
+		taskType, err := protoTaskTypeToString(rs.GetTaskType())
+		if err != nil {
+			s.logger.Warn("invalid task type in reminder interval settings", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		intervals := make([]domaintask.ReminderInterval, 0, len(rs.GetIntervals()))
+		for _, interval := range rs.GetIntervals() {
+			intervals = append(intervals, domaintask.ReminderInterval(interval))
+		}
+
+		settings = append(settings, appperiod.ReminderIntervalSettingItem{
+			TaskType:  taskType,
+			Intervals: intervals,
+		})
+	}
+
+	result, err := s.updateReminderIntervals.UpdateReminderIntervalSettings(ctx, &appperiod.UpdateReminderIntervalSettingsRequest{
+		SessionToken: token,
+		Settings:     settings,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appperiod.ErrUnauthorized):
+			s.logger.Info("unauthorized update reminder interval settings attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, appperiod.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during update reminder interval settings", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, appperiod.ErrInvalidReminderInterval),
+			errors.Is(err, appperiod.ErrTooManyReminderIntervals),
+			errors.Is(err, appperiod.ErrDuplicateReminderInterval),
+			errors.Is(err, appperiod.ErrInvalidTaskType):
+			s.logger.Warn("invalid update reminder interval settings request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected update reminder interval settings error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("reminder interval settings updated", slog.Int("count", len(result.Settings)))
+
+	return &taskv1.UpdateUserReminderIntervalSettingsResponse{
+		Settings: convertToProtoReminderIntervalSettings(result.Settings),
+	}, nil
+}
+
+// GetUserQuietHoursSettings retrieves user quiet hours settings
+func (s *PeriodSettingService) GetUserQuietHoursSettings(
+	ctx context.Context,
+	req *taskv1.GetUserQuietHoursSettingsRequest,
+) (*taskv1.GetUserQuietHoursSettingsResponse, error) {
+	token := interceptor.ExtractSessionToken(ctx)
+	if token == "" {
+		s.logger.Warn("get quiet hours settings called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.getQuietHours.GetQuietHoursSettings(ctx, &appperiod.GetQuietHoursSettingsRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appperiod.ErrUnauthorized):
+			s.logger.Info("unauthorized get quiet hours settings attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, appperiod.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during get quiet hours settings", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		default:
+			s.logger.Error("unexpected get quiet hours settings error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("quiet hours settings retrieved", slog.Bool("set", result.Settings != nil))
+
+	return &taskv1.GetUserQuietHoursSettingsResponse{
+		Settings: convertToProtoQuietHoursSettings(result.Settings),
+	}, nil
+}
+
+// UpdateUserQuietHoursSettings replaces user quiet hours settings
+func (s *PeriodSettingService) UpdateUserQuietHoursSettings(
+	ctx context.Context,
+	req *taskv1.UpdateUserQuietHoursSettingsRequest,
+) (*taskv1.UpdateUserQuietHoursSettingsResponse, error) {
+	token := interceptor.ExtractSessionToken(ctx)
+	if token == "" {
+		s.logger.Warn("update quiet hours settings called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	var settings *appperiod.QuietHoursSettings
+
+	if qs := req.GetSettings(); qs != nil {
+		policy, err := protoQuietHoursPolicyToDomain(qs.GetPolicy())
+		if err != nil {
+			s.logger.Warn("invalid policy in quiet hours settings", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		windows := make([]appperiod.QuietWindowItem, 0, len(qs.GetWindows()))
+		for _, w := range qs.GetWindows() {
+			windows = append(windows, appperiod.QuietWindowItem{
+				Weekday:     time.Weekday(w.GetWeekday()),
+				StartMinute: int(w.GetStartMinute()),
+				EndMinute:   int(w.GetEndMinute()),
+			})
+		}
+
+		settings = &appperiod.QuietHoursSettings{
+			Timezone:            qs.GetTimezone(),
+			Windows:             windows,
+			Policy:              policy,
+			DeadlineAlwaysFires: qs.GetDeadlineAlwaysFires(),
+		}
+	}
+
+	result, err := s.updateQuietHours.UpdateQuietHoursSettings(ctx, &appperiod.UpdateQuietHoursSettingsRequest{
+		SessionToken: token,
+		Settings:     settings,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, appperiod.ErrUnauthorized):
+			s.logger.Info("unauthorized update quiet hours settings attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, appperiod.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during update quiet hours settings", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, appperiod.ErrInvalidQuietHoursTimezone),
+			errors.Is(err, appperiod.ErrInvalidQuietHoursPolicy),
+			errors.Is(err, appperiod.ErrInvalidQuietWindow),
+			errors.Is(err, appperiod.ErrTooManyQuietWindows):
+			s.logger.Warn("invalid update quiet hours settings request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected update quiet hours settings error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("quiet hours settings updated", slog.Bool("set", result.Settings != nil))
+
+	return &taskv1.UpdateUserQuietHoursSettingsResponse{
+		Settings: convertToProtoQuietHoursSettings(result.Settings),
+	}, nil
+}
+
 func convertToProtoPeriodSettings(items []appperiod.PeriodSettingItem) []*taskv1.PeriodSetting {
 	result := make([]*taskv1.PeriodSetting, 0, len(items))
 	for _, item := range items {
@@ -148,6 +381,69 @@ func convertToProtoPeriodSettings(items []appperiod.PeriodSettingItem) []*taskv1
 	return result
 }
 
+func convertToProtoReminderIntervalSettings(items []appperiod.ReminderIntervalSettingItem) []*taskv1.ReminderIntervalSetting {
+	result := make([]*taskv1.ReminderIntervalSetting, 0, len(items))
+	for _, item := range items {
+		intervals := make([]float64, 0, len(item.Intervals))
+		for _, interval := range item.Intervals {
+			intervals = append(intervals, float64(interval))
+		}
+
+		result = append(result, &taskv1.ReminderIntervalSetting{
+			TaskType:  domainTaskTypeToProto(item.TaskType),
+			Intervals: intervals,
+		})
+	}
+
+	return result
+}
+
+func convertToProtoQuietHoursSettings(settings *appperiod.QuietHoursSettings) *taskv1.QuietHoursSettings {
+	if settings == nil {
+		return nil
+	}
+
+	windows := make([]*taskv1.QuietWindow, 0, len(settings.Windows))
+	for _, w := range settings.Windows {
+		windows = append(windows, &taskv1.QuietWindow{
+			Weekday:     int32(w.Weekday),
+			StartMinute: int32(w.StartMinute),
+			EndMinute:   int32(w.EndMinute),
+		})
+	}
+
+	return &taskv1.QuietHoursSettings{
+		Timezone:            settings.Timezone,
+		Windows:             windows,
+		Policy:              domainQuietHoursPolicyToProto(settings.Policy),
+		DeadlineAlwaysFires: settings.DeadlineAlwaysFires,
+	}
+}
+
+func protoQuietHoursPolicyToDomain(policy taskv1.QuietHoursPolicy) (domaintask.QuietHoursPolicy, error) {
+	switch policy {
+	case taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_SHIFT:
+		return domaintask.QuietHoursPolicyShift, nil
+	case taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_DROP:
+		return domaintask.QuietHoursPolicyDrop, nil
+	case taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_UNSPECIFIED:
+		return "", errors.New("quiet hours policy is required")
+	default:
+		return "", errors.New("unsupported quiet hours policy")
+	}
+}
+
+func domainQuietHoursPolicyToProto(policy domaintask.QuietHoursPolicy) taskv1.QuietHoursPolicy {
+	switch policy {
+	case domaintask.QuietHoursPolicyShift:
+		return taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_SHIFT
+	case domaintask.QuietHoursPolicyDrop:
+		return taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_DROP
+	default:
+		return taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_UNSPECIFIED
+	}
+}
+
 func protoTaskTypeToDomain(taskType taskv1.TaskType) (domaintask.Type, error) {
 	switch taskType {
 	case taskv1.TaskType_TASK_TYPE_SHORT:
@@ -0,0 +1,288 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	appperiod "github.com/KasumiMercury/primind-central-backend/internal/task/app/period"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserReminderIntervalSettingsSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := NewMockGetReminderIntervalSettingsUseCase(ctrl)
+	mockUseCase.EXPECT().
+		GetReminderIntervalSettings(gomock.Any(), &appperiod.GetReminderIntervalSettingsRequest{SessionToken: "valid-token"}).
+		Return(&appperiod.GetReminderIntervalSettingsResult{
+			Settings: []appperiod.ReminderIntervalSettingItem{
+				{TaskType: domaintask.TypeScheduled, Intervals: []domaintask.ReminderInterval{}},
+			},
+			Defaults: []appperiod.ReminderIntervalSettingItem{
+				{TaskType: domaintask.TypeShort, Intervals: domaintask.DefaultReminderIntervalsShort},
+			},
+		}, nil)
+
+	svc := NewPeriodSettingService(nil, nil, mockUseCase, nil, nil, nil)
+
+	resp, err := svc.GetUserReminderIntervalSettings(ctxWithSessionToken(t, "valid-token"), &taskv1.GetUserReminderIntervalSettingsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetSettings()) != 1 || resp.GetSettings()[0].GetTaskType() != taskv1.TaskType_TASK_TYPE_SCHEDULED ||
+		len(resp.GetSettings()[0].GetIntervals()) != 0 {
+		t.Errorf("unexpected settings: %v", resp.GetSettings())
+	}
+
+	if len(resp.GetDefaults()) != 1 || !slices.Equal(resp.GetDefaults()[0].GetIntervals(), []float64{0.70, 0.96}) {
+		t.Errorf("unexpected defaults: %v", resp.GetDefaults())
+	}
+}
+
+func TestUpdateUserReminderIntervalSettingsSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	items := []appperiod.ReminderIntervalSettingItem{
+		{TaskType: domaintask.TypeNear, Intervals: []domaintask.ReminderInterval{0.25, 0.75}},
+		{TaskType: domaintask.TypeScheduled, Intervals: []domaintask.ReminderInterval{}},
+	}
+
+	mockUseCase := NewMockUpdateReminderIntervalSettingsUseCase(ctrl)
+	mockUseCase.EXPECT().
+		UpdateReminderIntervalSettings(gomock.Any(), &appperiod.UpdateReminderIntervalSettingsRequest{
+			SessionToken: "valid-token",
+			Settings:     items,
+		}).
+		Return(&appperiod.UpdateReminderIntervalSettingsResult{Settings: items}, nil)
+
+	svc := NewPeriodSettingService(nil, nil, nil, mockUseCase, nil, nil)
+
+	resp, err := svc.UpdateUserReminderIntervalSettings(ctxWithSessionToken(t, "valid-token"), &taskv1.UpdateUserReminderIntervalSettingsRequest{
+		Settings: []*taskv1.ReminderIntervalSetting{
+			{TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Intervals: []float64{0.25, 0.75}},
+			{TaskType: taskv1.TaskType_TASK_TYPE_SCHEDULED, Intervals: nil},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetSettings()) != 2 || !slices.Equal(resp.GetSettings()[0].GetIntervals(), []float64{0.25, 0.75}) {
+		t.Errorf("unexpected settings: %v", resp.GetSettings())
+	}
+}
+
+func TestUpdateUserReminderIntervalSettingsError(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          func(t *testing.T) context.Context
+		req          *taskv1.UpdateUserReminderIntervalSettingsRequest
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "missing session token",
+			ctx:          func(*testing.T) context.Context { return context.Background() },
+			req:          &taskv1.UpdateUserReminderIntervalSettingsRequest{},
+			useCaseErr:   nil,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "unspecified task type",
+			ctx:  func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req: &taskv1.UpdateUserReminderIntervalSettingsRequest{
+				Settings: []*taskv1.ReminderIntervalSetting{{TaskType: taskv1.TaskType_TASK_TYPE_UNSPECIFIED}},
+			},
+			useCaseErr:   nil,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "interval out of range",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserReminderIntervalSettingsRequest{},
+			useCaseErr:   appperiod.ErrInvalidReminderInterval,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "duplicate interval",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserReminderIntervalSettingsRequest{},
+			useCaseErr:   appperiod.ErrDuplicateReminderInterval,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "unauthorized",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserReminderIntervalSettingsRequest{},
+			useCaseErr:   appperiod.ErrUnauthorized,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "unexpected error",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserReminderIntervalSettingsRequest{},
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockUpdateReminderIntervalSettingsUseCase(ctrl)
+			if tt.useCaseErr != nil {
+				mockUseCase.EXPECT().
+					UpdateReminderIntervalSettings(gomock.Any(), gomock.Any()).
+					Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewPeriodSettingService(nil, nil, nil, mockUseCase, nil, nil)
+
+			_, err := svc.UpdateUserReminderIntervalSettings(tt.ctx(t), tt.req)
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Errorf("expected code %v, got %v", tt.expectedCode, err)
+			}
+		})
+	}
+}
+
+func TestUpdateUserQuietHoursSettingsSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	settings := &appperiod.QuietHoursSettings{
+		Timezone:            "Asia/Tokyo",
+		Windows:             []appperiod.QuietWindowItem{{Weekday: time.Sunday, StartMinute: 22 * 60, EndMinute: 7 * 60}},
+		Policy:              domaintask.QuietHoursPolicyShift,
+		DeadlineAlwaysFires: true,
+	}
+
+	mockUseCase := NewMockUpdateQuietHoursSettingsUseCase(ctrl)
+	mockUseCase.EXPECT().
+		UpdateQuietHoursSettings(gomock.Any(), &appperiod.UpdateQuietHoursSettingsRequest{
+			SessionToken: "valid-token",
+			Settings:     settings,
+		}).
+		Return(&appperiod.UpdateQuietHoursSettingsResult{Settings: settings}, nil)
+
+	svc := NewPeriodSettingService(nil, nil, nil, nil, nil, mockUseCase)
+
+	resp, err := svc.UpdateUserQuietHoursSettings(ctxWithSessionToken(t, "valid-token"), &taskv1.UpdateUserQuietHoursSettingsRequest{
+		Settings: &taskv1.QuietHoursSettings{
+			Timezone:            "Asia/Tokyo",
+			Windows:             []*taskv1.QuietWindow{{Weekday: 0, StartMinute: 22 * 60, EndMinute: 7 * 60}},
+			Policy:              taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_SHIFT,
+			DeadlineAlwaysFires: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := resp.GetSettings()
+	if got.GetPolicy() != taskv1.QuietHoursPolicy_QUIET_HOURS_POLICY_SHIFT || !got.GetDeadlineAlwaysFires() ||
+		len(got.GetWindows()) != 1 || got.GetWindows()[0].GetEndMinute() != 7*60 {
+		t.Errorf("unexpected settings: %v", got)
+	}
+}
+
+func TestGetUserQuietHoursSettingsUnset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := NewMockGetQuietHoursSettingsUseCase(ctrl)
+	mockUseCase.EXPECT().
+		GetQuietHoursSettings(gomock.Any(), &appperiod.GetQuietHoursSettingsRequest{SessionToken: "valid-token"}).
+		Return(&appperiod.GetQuietHoursSettingsResult{Settings: nil}, nil)
+
+	svc := NewPeriodSettingService(nil, nil, nil, nil, mockUseCase, nil)
+
+	resp, err := svc.GetUserQuietHoursSettings(ctxWithSessionToken(t, "valid-token"), &taskv1.GetUserQuietHoursSettingsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Settings != nil {
+		t.Errorf("expected no quiet hours, got %v", resp.GetSettings())
+	}
+}
+
+func TestUpdateUserQuietHoursSettingsError(t *testing.T) {
+	tests := []struct {
+		name         string
+		ctx          func(t *testing.T) context.Context
+		req          *taskv1.UpdateUserQuietHoursSettingsRequest
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "missing session token",
+			ctx:          func(*testing.T) context.Context { return context.Background() },
+			req:          &taskv1.UpdateUserQuietHoursSettingsRequest{},
+			useCaseErr:   nil,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "unspecified policy",
+			ctx:  func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req: &taskv1.UpdateUserQuietHoursSettingsRequest{
+				Settings: &taskv1.QuietHoursSettings{Timezone: "Asia/Tokyo"},
+			},
+			useCaseErr:   nil,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "invalid timezone",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserQuietHoursSettingsRequest{},
+			useCaseErr:   appperiod.ErrInvalidQuietHoursTimezone,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "empty window",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserQuietHoursSettingsRequest{},
+			useCaseErr:   appperiod.ErrInvalidQuietWindow,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "unexpected error",
+			ctx:          func(t *testing.T) context.Context { return ctxWithSessionToken(t, "valid-token") },
+			req:          &taskv1.UpdateUserQuietHoursSettingsRequest{},
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockUpdateQuietHoursSettingsUseCase(ctrl)
+			if tt.useCaseErr != nil {
+				mockUseCase.EXPECT().
+					UpdateQuietHoursSettings(gomock.Any(), gomock.Any()).
+					Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewPeriodSettingService(nil, nil, nil, nil, nil, mockUseCase)
+
+			_, err := svc.UpdateUserQuietHoursSettings(tt.ctx(t), tt.req)
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Errorf("expected code %v, got %v", tt.expectedCode, err)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
 	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/interceptor"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -21,6 +22,14 @@ type Service struct {
 	listActiveTasks apptask.ListActiveTasksUseCase
 	updateTask      apptask.UpdateTaskUseCase
 	deleteTask      apptask.DeleteTaskUseCase
+	snoozeTask      apptask.SnoozeTaskUseCase
+	moveTask        apptask.MoveTaskUseCase
+	searchTasks     apptask.SearchTasksUseCase
+	batchUpdate     apptask.BatchUpdateTasksUseCase
+	batchDelete     apptask.BatchDeleteTasksUseCase
+	watchTasks      apptask.WatchTasksUseCase
+	syncTasks       apptask.SyncTasksUseCase
+	importTasks     apptask.ImportTasksUseCase
 	logger          *slog.Logger
 }
 
@@ -32,6 +41,14 @@ func NewService(
 	listActiveTasksUseCase apptask.ListActiveTasksUseCase,
 	updateTaskUseCase apptask.UpdateTaskUseCase,
 	deleteTaskUseCase apptask.DeleteTaskUseCase,
+	snoozeTaskUseCase apptask.SnoozeTaskUseCase,
+	moveTaskUseCase apptask.MoveTaskUseCase,
+	searchTasksUseCase apptask.SearchTasksUseCase,
+	batchUpdateTasksUseCase apptask.BatchUpdateTasksUseCase,
+	batchDeleteTasksUseCase apptask.BatchDeleteTasksUseCase,
+	watchTasksUseCase apptask.WatchTasksUseCase,
+	syncTasksUseCase apptask.SyncTasksUseCase,
+	importTasksUseCase apptask.ImportTasksUseCase,
 ) *Service {
 	return &Service{
 		createTask:      createTaskUseCase,
@@ -39,6 +56,14 @@ func NewService(
 		listActiveTasks: listActiveTasksUseCase,
 		updateTask:      updateTaskUseCase,
 		deleteTask:      deleteTaskUseCase,
+		snoozeTask:      snoozeTaskUseCase,
+		moveTask:        moveTaskUseCase,
+		searchTasks:     searchTasksUseCase,
+		batchUpdate:     batchUpdateTasksUseCase,
+		batchDelete:     batchDeleteTasksUseCase,
+		watchTasks:      watchTasksUseCase,
+		syncTasks:       syncTasksUseCase,
+		importTasks:     importTasksUseCase,
 		logger:          slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("service"),
 	}
 }
@@ -76,6 +101,7 @@ func (s *Service) CreateTask(
 		Description:  req.GetDescription(),
 		ScheduledAt:  scheduledAt,
 		Color:        req.GetColor(),
+		Recurrence:   protoRecurrenceToRecurrence(req.GetRecurrence()),
 	})
 	if err != nil {
 		switch {
@@ -107,7 +133,11 @@ func (s *Service) CreateTask(
 			errors.Is(err, domaintask.ErrIDInvalidFormat),
 			errors.Is(err, domaintask.ErrIDInvalidV7),
 			errors.Is(err, domaintask.ErrColorEmpty),
-			errors.Is(err, domaintask.ErrColorInvalidFormat):
+			errors.Is(err, domaintask.ErrColorInvalidFormat),
+			errors.Is(err, domaintask.ErrRecurrenceRuleEmpty),
+			errors.Is(err, domaintask.ErrInvalidRecurrenceRule),
+			errors.Is(err, domaintask.ErrInvalidRecurrenceTimezone),
+			errors.Is(err, domaintask.ErrRecurrenceNotAllowed):
 			s.logger.Warn("invalid create task request", slog.String("error", err.Error()))
 
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -138,9 +168,11 @@ func (s *Service) CreateTask(
 
 				return nil
 			}(),
-			CreatedAt: timestamppb.New(result.CreatedAt),
-			TargetAt:  timestamppb.New(result.TargetAt),
-			Color:     result.Color,
+			CreatedAt:  timestamppb.New(result.CreatedAt),
+			TargetAt:   timestamppb.New(result.TargetAt),
+			Color:      result.Color,
+			Recurrence: recurrenceToProto(result.Recurrence),
+			Version:    result.Version,
 		},
 	}, nil
 }
@@ -197,15 +229,19 @@ func (s *Service) GetTask(
 
 	response := &taskv1.GetTaskResponse{
 		Task: &taskv1.Task{
-			TaskId:      result.TaskID,
-			Title:       result.Title,
-			TaskType:    protoTaskType,
-			TaskStatus:  protoTaskStatus,
-			Description: result.Description,
-			ScheduledAt: scheduledAt,
-			CreatedAt:   timestamppb.New(result.CreatedAt),
-			TargetAt:    timestamppb.New(result.TargetAt),
-			Color:       result.Color,
+			TaskId:            result.TaskID,
+			Title:             result.Title,
+			TaskType:          protoTaskType,
+			TaskStatus:        protoTaskStatus,
+			Description:       result.Description,
+			ScheduledAt:       scheduledAt,
+			CreatedAt:         timestamppb.New(result.CreatedAt),
+			TargetAt:          timestamppb.New(result.TargetAt),
+			Color:             result.Color,
+			Recurrence:        recurrenceToProto(result.Recurrence),
+			ChecklistProgress: checklistProgressToProto(result.Checklist),
+			Tags:              tagsToProto(result.Tags),
+			Version:           result.Version,
 		},
 	}
 
@@ -232,9 +268,51 @@ func (s *Service) ListActiveTasks(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	sortDirection, err := protoSortDirectionToSortDirection(req.GetSortDirection())
+	if err != nil {
+		s.logger.Warn("invalid sort direction", slog.String("error", err.Error()))
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var taskType *domaintask.Type
+
+	if req.TaskType != nil {
+		parsed, err := protoTaskTypeToString(req.GetTaskType())
+		if err != nil {
+			s.logger.Warn("invalid task type filter", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		taskType = &parsed
+	}
+
+	var targetAtFrom *time.Time
+
+	if req.GetTargetAtFrom() != nil {
+		t := req.GetTargetAtFrom().AsTime()
+		targetAtFrom = &t
+	}
+
+	var targetAtTo *time.Time
+
+	if req.GetTargetAtTo() != nil {
+		t := req.GetTargetAtTo().AsTime()
+		targetAtTo = &t
+	}
+
 	result, err := s.listActiveTasks.ListActiveTasks(ctx, &apptask.ListActiveTasksRequest{
-		SessionToken: token,
-		SortType:     sortType,
+		SessionToken:  token,
+		SortType:      sortType,
+		SortDirection: sortDirection,
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+		TaskType:      taskType,
+		Color:         req.Color,
+		TargetAtFrom:  targetAtFrom,
+		TargetAtTo:    targetAtTo,
+		TagID:         req.TagId,
 	})
 	if err != nil {
 		switch {
@@ -247,7 +325,15 @@ func (s *Service) ListActiveTasks(
 
 			return nil, connect.NewError(connect.CodeUnavailable, err)
 		case errors.Is(err, apptask.ErrListActiveTasksRequestRequired),
-			errors.Is(err, apptask.ErrInvalidSortType):
+			errors.Is(err, apptask.ErrInvalidSortType),
+			errors.Is(err, apptask.ErrInvalidSortDirection),
+			errors.Is(err, apptask.ErrInvalidPageSize),
+			errors.Is(err, apptask.ErrInvalidPageToken),
+			errors.Is(err, apptask.ErrInvalidTargetAtRange),
+			errors.Is(err, domaintask.ErrColorEmpty),
+			errors.Is(err, domaintask.ErrColorInvalidFormat),
+			errors.Is(err, domaintask.ErrTagIDInvalidFormat),
+			errors.Is(err, domaintask.ErrTagIDInvalidV7):
 			s.logger.Warn("invalid list active tasks request", slog.String("error", err.Error()))
 
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -260,31 +346,62 @@ func (s *Service) ListActiveTasks(
 
 	protoTasks := make([]*taskv1.Task, 0, len(result.Tasks))
 	for _, task := range result.Tasks {
-		var scheduledAt *timestamppb.Timestamp
-		if task.ScheduledAt != nil {
-			scheduledAt = timestamppb.New(*task.ScheduledAt)
-		}
-
-		protoTasks = append(protoTasks, &taskv1.Task{
-			TaskId:      task.TaskID,
-			Title:       task.Title,
-			TaskType:    stringToProtoTaskType(string(task.TaskType)),
-			TaskStatus:  stringToProtoTaskStatus(string(task.TaskStatus)),
-			Description: task.Description,
-			ScheduledAt: scheduledAt,
-			CreatedAt:   timestamppb.New(task.CreatedAt),
-			TargetAt:    timestamppb.New(task.TargetAt),
-			Color:       task.Color,
-		})
+		protoTasks = append(protoTasks, toProtoTask(task))
 	}
 
 	s.logger.Info("active tasks listed", slog.Int("count", len(protoTasks)))
 
 	return &taskv1.ListActiveTasksResponse{
-		Tasks: protoTasks,
+		Tasks:         protoTasks,
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
+func toProtoTask(task apptask.TaskItem) *taskv1.Task {
+	var scheduledAt *timestamppb.Timestamp
+	if task.ScheduledAt != nil {
+		scheduledAt = timestamppb.New(*task.ScheduledAt)
+	}
+
+	return &taskv1.Task{
+		TaskId:            task.TaskID,
+		Title:             task.Title,
+		TaskType:          stringToProtoTaskType(string(task.TaskType)),
+		TaskStatus:        stringToProtoTaskStatus(string(task.TaskStatus)),
+		Description:       task.Description,
+		ScheduledAt:       scheduledAt,
+		CreatedAt:         timestamppb.New(task.CreatedAt),
+		TargetAt:          timestamppb.New(task.TargetAt),
+		Color:             task.Color,
+		Recurrence:        recurrenceToProto(task.Recurrence),
+		ChecklistProgress: checklistProgressToProto(task.Checklist),
+		Tags:              tagsToProto(task.Tags),
+		Version:           task.Version,
+	}
+}
+
+func protoRecurrenceToRecurrence(recurrence *taskv1.Recurrence) *apptask.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &apptask.Recurrence{
+		Rule:     recurrence.GetRule(),
+		Timezone: recurrence.GetTimezone(),
+	}
+}
+
+func recurrenceToProto(recurrence *apptask.Recurrence) *taskv1.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &taskv1.Recurrence{
+		Rule:     recurrence.Rule,
+		Timezone: recurrence.Timezone,
+	}
+}
+
 func extractSessionTokenFromContext(ctx context.Context) string {
 	return interceptor.ExtractSessionToken(ctx)
 }
@@ -327,6 +444,8 @@ func stringToProtoTaskStatus(taskStatus string) taskv1.TaskStatus {
 		return taskv1.TaskStatus_TASK_STATUS_ACTIVE
 	case string(domaintask.StatusCompleted):
 		return taskv1.TaskStatus_TASK_STATUS_COMPLETED
+	case string(domaintask.StatusReminderFailed):
+		return taskv1.TaskStatus_TASK_STATUS_REMINDER_FAILED
 	case string(domaintask.StatusPendingReminders):
 		return taskv1.TaskStatus_TASK_STATUS_UNSPECIFIED
 	default:
@@ -338,6 +457,16 @@ func protoSortTypeToString(sortType taskv1.TaskSortType) (domaintask.SortType, e
 	switch sortType {
 	case taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT:
 		return domaintask.SortTypeTargetAt, nil
+	case taskv1.TaskSortType_TASK_SORT_TYPE_CREATED_AT:
+		return domaintask.SortTypeCreatedAt, nil
+	case taskv1.TaskSortType_TASK_SORT_TYPE_TITLE:
+		return domaintask.SortTypeTitle, nil
+	case taskv1.TaskSortType_TASK_SORT_TYPE_TASK_TYPE:
+		return domaintask.SortTypeTaskType, nil
+	case taskv1.TaskSortType_TASK_SORT_TYPE_COLOR:
+		return domaintask.SortTypeColor, nil
+	case taskv1.TaskSortType_TASK_SORT_TYPE_MANUAL:
+		return domaintask.SortTypeManual, nil
 	case taskv1.TaskSortType_TASK_SORT_TYPE_UNSPECIFIED:
 		return "", errors.New("sort type is required")
 	default:
@@ -345,6 +474,18 @@ func protoSortTypeToString(sortType taskv1.TaskSortType) (domaintask.SortType, e
 	}
 }
 
+func protoSortDirectionToSortDirection(direction taskv1.SortDirection) (domaintask.SortDirection, error) {
+	switch direction {
+	case taskv1.SortDirection_SORT_DIRECTION_UNSPECIFIED,
+		taskv1.SortDirection_SORT_DIRECTION_ASC:
+		return domaintask.SortDirectionAsc, nil
+	case taskv1.SortDirection_SORT_DIRECTION_DESC:
+		return domaintask.SortDirectionDesc, nil
+	default:
+		return "", errors.New("unsupported sort direction")
+	}
+}
+
 func protoTaskStatusToStatus(status taskv1.TaskStatus) (domaintask.Status, error) {
 	switch status {
 	case taskv1.TaskStatus_TASK_STATUS_ACTIVE:
@@ -369,6 +510,67 @@ func (s *Service) UpdateTask(
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
 	}
 
+	useCaseReq, err := toUpdateTaskRequest(token, req)
+	if err != nil {
+		s.logger.Warn("invalid task status in update", slog.String("error", err.Error()))
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.updateTask.UpdateTask(ctx, useCaseReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized update task attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during update task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrDeviceServiceUnavailable):
+			s.logger.Error("device service unavailable during update task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrDeviceInvalidArgument):
+			s.logger.Error("device service rejected request during update task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, apptask.ErrCancelRemindFailed):
+			s.logger.Error("failed to cancel remind during update task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrRemindQueueRegistrationFailed):
+			s.logger.Error("failed to register remind during update task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrTaskNotFound):
+			s.logger.Info("task not found", slog.String("task_id", req.GetTaskId()))
+
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, apptask.ErrTaskVersionConflict):
+			s.logger.Info("update task conflicts with a concurrent edit", slog.String("task_id", req.GetTaskId()))
+
+			return nil, s.versionConflictError(err)
+		case isInvalidUpdateTaskError(err):
+			s.logger.Warn("invalid update task request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected update task error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("task updated", slog.String("task_id", result.TaskID))
+
+	return toUpdateTaskResponse(result), nil
+}
+
+// toUpdateTaskRequest converts the fields named in the update mask of req. It fails only on
+// an invalid task status.
+func toUpdateTaskRequest(token string, req *taskv1.UpdateTaskRequest) (*apptask.UpdateTaskRequest, error) {
 	var updateMask []string
 	if req.GetUpdateMask() != nil {
 		updateMask = req.GetUpdateMask().GetPaths()
@@ -380,14 +582,17 @@ func (s *Service) UpdateTask(
 		UpdateMask:   updateMask,
 	}
 
+	if req.ExpectedVersion != nil {
+		expectedVersion := req.GetExpectedVersion()
+		useCaseReq.ExpectedVersion = &expectedVersion
+	}
+
 	for _, path := range updateMask {
 		switch path {
 		case "task_status":
 			status, err := protoTaskStatusToStatus(req.GetTaskStatus())
 			if err != nil {
-				s.logger.Warn("invalid task status in update", slog.String("error", err.Error()))
-
-				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+				return nil, err
 			}
 
 			useCaseReq.TaskStatus = &status
@@ -407,48 +612,20 @@ func (s *Service) UpdateTask(
 		case "color":
 			color := req.GetColor()
 			useCaseReq.Color = &color
+		case "recurrence":
+			useCaseReq.Recurrence = protoRecurrenceToRecurrence(req.GetRecurrence())
 		}
 	}
 
-	result, err := s.updateTask.UpdateTask(ctx, useCaseReq)
-	if err != nil {
-		switch {
-		case errors.Is(err, apptask.ErrUnauthorized):
-			s.logger.Info("unauthorized update task attempt")
-
-			return nil, connect.NewError(connect.CodeUnauthenticated, err)
-		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
-			s.logger.Error("auth service unavailable during update task", slog.String("error", err.Error()))
-
-			return nil, connect.NewError(connect.CodeUnavailable, err)
-		case errors.Is(err, apptask.ErrTaskNotFound):
-			s.logger.Info("task not found", slog.String("task_id", req.GetTaskId()))
-
-			return nil, connect.NewError(connect.CodeNotFound, err)
-		case errors.Is(err, apptask.ErrTaskIDRequired),
-			errors.Is(err, domaintask.ErrIDInvalidFormat),
-			errors.Is(err, domaintask.ErrIDInvalidV7),
-			errors.Is(err, domaintask.ErrTitleTooLong),
-			errors.Is(err, domaintask.ErrScheduledAtRequired),
-			errors.Is(err, domaintask.ErrScheduledAtNotAllowed),
-			errors.Is(err, domaintask.ErrScheduledAtBeforeCreatedAt),
-			errors.Is(err, domaintask.ErrColorEmpty),
-			errors.Is(err, domaintask.ErrColorInvalidFormat),
-			errors.Is(err, domaintask.ErrNoFieldsToUpdate),
-			errors.Is(err, domaintask.ErrInvalidUpdateField),
-			errors.Is(err, domaintask.ErrInvalidTaskStatus):
-			s.logger.Warn("invalid update task request", slog.String("error", err.Error()))
-
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
-		default:
-			s.logger.Error("unexpected update task error", slog.String("error", err.Error()))
+	return useCaseReq, nil
+}
 
-			return nil, connect.NewError(connect.CodeInternal, err)
-		}
+func toUpdateTaskResponse(result *apptask.UpdateTaskResult) *taskv1.UpdateTaskResponse {
+	var nextOccurrence *taskv1.Task
+	if result.NextOccurrence != nil {
+		nextOccurrence = toProtoTask(*result.NextOccurrence)
 	}
 
-	s.logger.Info("task updated", slog.String("task_id", result.TaskID))
-
 	return &taskv1.UpdateTaskResponse{
 		Task: &taskv1.Task{
 			TaskId:      result.TaskID,
@@ -463,11 +640,59 @@ func (s *Service) UpdateTask(
 
 				return nil
 			}(),
-			CreatedAt: timestamppb.New(result.CreatedAt),
-			TargetAt:  timestamppb.New(result.TargetAt),
-			Color:     result.Color,
+			CreatedAt:         timestamppb.New(result.CreatedAt),
+			TargetAt:          timestamppb.New(result.TargetAt),
+			Color:             result.Color,
+			Recurrence:        recurrenceToProto(result.Recurrence),
+			ChecklistProgress: checklistProgressToProto(result.Checklist),
+			Tags:              tagsToProto(result.Tags),
+			Version:           result.Version,
 		},
-	}, nil
+		NextOccurrence: nextOccurrence,
+	}
+}
+
+// versionConflictError rejects an edit of an outdated task version. The current task, when
+// known, goes into the error details so that the client can merge its edit into it.
+func (s *Service) versionConflictError(err error) *connect.Error {
+	connectErr := connect.NewError(connect.CodeAborted, err)
+
+	var conflict *apptask.TaskVersionConflictError
+	if !errors.As(err, &conflict) {
+		return connectErr
+	}
+
+	detail, detailErr := connect.NewErrorDetail(toProtoTask(conflict.Current))
+	if detailErr != nil {
+		s.logger.Error("failed to attach current task to conflict", slog.String("error", detailErr.Error()))
+
+		return connectErr
+	}
+
+	connectErr.AddDetail(detail)
+
+	return connectErr
+}
+
+// isInvalidUpdateTaskError reports whether err rejects the content of an update task request.
+func isInvalidUpdateTaskError(err error) bool {
+	return errors.Is(err, apptask.ErrTaskIDRequired) ||
+		errors.Is(err, domaintask.ErrIDInvalidFormat) ||
+		errors.Is(err, domaintask.ErrIDInvalidV7) ||
+		errors.Is(err, domaintask.ErrTitleTooLong) ||
+		errors.Is(err, domaintask.ErrScheduledAtRequired) ||
+		errors.Is(err, domaintask.ErrScheduledAtNotAllowed) ||
+		errors.Is(err, domaintask.ErrScheduledAtBeforeCreatedAt) ||
+		errors.Is(err, domaintask.ErrColorEmpty) ||
+		errors.Is(err, domaintask.ErrColorInvalidFormat) ||
+		errors.Is(err, domaintask.ErrNoFieldsToUpdate) ||
+		errors.Is(err, domaintask.ErrInvalidUpdateField) ||
+		errors.Is(err, domaintask.ErrInvalidExpectedVersion) ||
+		errors.Is(err, domaintask.ErrInvalidTaskStatus) ||
+		errors.Is(err, domaintask.ErrRecurrenceRuleEmpty) ||
+		errors.Is(err, domaintask.ErrInvalidRecurrenceRule) ||
+		errors.Is(err, domaintask.ErrInvalidRecurrenceTimezone) ||
+		errors.Is(err, domaintask.ErrRecurrenceNotAllowed)
 }
 
 func (s *Service) DeleteTask(
@@ -520,3 +745,733 @@ func (s *Service) DeleteTask(
 
 	return &taskv1.DeleteTaskResponse{}, nil
 }
+
+func (s *Service) SnoozeTask(
+	ctx context.Context,
+	req *taskv1.SnoozeTaskRequest,
+) (*taskv1.SnoozeTaskResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("snooze task called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	useCaseReq := &apptask.SnoozeTaskRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+	}
+
+	switch snooze := req.GetSnooze().(type) {
+	case *taskv1.SnoozeTaskRequest_Duration:
+		duration := snooze.Duration.AsDuration()
+		useCaseReq.Duration = &duration
+	case *taskv1.SnoozeTaskRequest_Until:
+		until := snooze.Until.AsTime()
+		useCaseReq.Until = &until
+	}
+
+	result, err := s.snoozeTask.SnoozeTask(ctx, useCaseReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized snooze task attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during snooze task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrDeviceServiceUnavailable):
+			s.logger.Error("device service unavailable during snooze task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrDeviceInvalidArgument):
+			s.logger.Error("device service rejected request during snooze task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, apptask.ErrCancelRemindFailed):
+			s.logger.Error("failed to cancel remind during snooze task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrRemindQueueRegistrationFailed):
+			s.logger.Error("failed to register remind during snooze task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrTaskNotFound):
+			s.logger.Info("task not found", slog.String("task_id", req.GetTaskId()))
+
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, apptask.ErrSnoozeCompletedTask):
+			s.logger.Info("snooze on completed task", slog.String("task_id", req.GetTaskId()))
+
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, apptask.ErrTaskVersionConflict):
+			s.logger.Info("snooze task conflicts with a concurrent edit", slog.String("task_id", req.GetTaskId()))
+
+			return nil, s.versionConflictError(err)
+		case errors.Is(err, apptask.ErrSnoozeTaskRequestRequired),
+			errors.Is(err, apptask.ErrTaskIDRequired),
+			errors.Is(err, apptask.ErrSnoozeTargetRequired),
+			errors.Is(err, apptask.ErrSnoozeUntilNotInFuture),
+			errors.Is(err, domaintask.ErrIDInvalidFormat),
+			errors.Is(err, domaintask.ErrIDInvalidV7):
+			s.logger.Warn("invalid snooze task request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected snooze task error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("task snoozed", slog.String("task_id", result.TaskID))
+
+	return &taskv1.SnoozeTaskResponse{
+		Task: toProtoTask(apptask.TaskItem{
+			TaskID:      result.TaskID,
+			Title:       result.Title,
+			TaskType:    result.TaskType,
+			TaskStatus:  result.TaskStatus,
+			Description: result.Description,
+			ScheduledAt: result.ScheduledAt,
+			CreatedAt:   result.CreatedAt,
+			TargetAt:    result.TargetAt,
+			Color:       result.Color,
+			Recurrence:  result.Recurrence,
+			Checklist:   result.Checklist,
+			Tags:        result.Tags,
+			Version:     result.Version,
+		}),
+	}, nil
+}
+
+func (s *Service) MoveTask(
+	ctx context.Context,
+	req *taskv1.MoveTaskRequest,
+) (*taskv1.MoveTaskResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("move task called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.moveTask.MoveTask(ctx, &apptask.MoveTaskRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		BeforeID:     req.GetBeforeId(),
+		AfterID:      req.GetAfterId(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized move task attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during move task", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrTaskNotFound):
+			s.logger.Info("task not found during move task", slog.String("task_id", req.GetTaskId()))
+
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, apptask.ErrMoveTaskRequestRequired),
+			errors.Is(err, apptask.ErrTaskIDRequired),
+			errors.Is(err, apptask.ErrMoveTargetRequired),
+			errors.Is(err, apptask.ErrInvalidMovePosition),
+			errors.Is(err, domaintask.ErrIDInvalidFormat),
+			errors.Is(err, domaintask.ErrIDInvalidV7):
+			s.logger.Warn("invalid move task request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected move task error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	s.logger.Info("task moved", slog.String("task_id", result.TaskID))
+
+	return &taskv1.MoveTaskResponse{
+		Task: toProtoTask(apptask.TaskItem{
+			TaskID:      result.TaskID,
+			Title:       result.Title,
+			TaskType:    result.TaskType,
+			TaskStatus:  result.TaskStatus,
+			Description: result.Description,
+			ScheduledAt: result.ScheduledAt,
+			CreatedAt:   result.CreatedAt,
+			TargetAt:    result.TargetAt,
+			Color:       result.Color,
+			Recurrence:  result.Recurrence,
+			Checklist:   result.Checklist,
+			Tags:        result.Tags,
+			Version:     result.Version,
+		}),
+	}, nil
+}
+
+func (s *Service) SearchTasks(
+	ctx context.Context,
+	req *taskv1.SearchTasksRequest,
+) (*taskv1.SearchTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("search tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.searchTasks.SearchTasks(ctx, &apptask.SearchTasksRequest{
+		SessionToken: token,
+		Text:         req.GetQuery(),
+		TagID:        req.TagId,
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized search tasks attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during search tasks", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrSearchTasksRequestRequired),
+			errors.Is(err, apptask.ErrSearchTextEmpty),
+			errors.Is(err, apptask.ErrSearchTextTooLong),
+			errors.Is(err, apptask.ErrInvalidPageSize),
+			errors.Is(err, apptask.ErrInvalidPageToken),
+			errors.Is(err, domaintask.ErrTagIDInvalidFormat),
+			errors.Is(err, domaintask.ErrTagIDInvalidV7):
+			s.logger.Warn("invalid search tasks request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected search tasks error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	hits := make([]*taskv1.SearchTaskHit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		hits = append(hits, toProtoSearchTaskHit(hit))
+	}
+
+	s.logger.Info("tasks searched", slog.Int("count", len(hits)))
+
+	return &taskv1.SearchTasksResponse{
+		Hits:          hits,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func toProtoSearchTaskHit(hit apptask.SearchHitItem) *taskv1.SearchTaskHit {
+	protoHit := &taskv1.SearchTaskHit{
+		Task:                 nil,
+		Score:                float32(hit.Score),
+		TitleHighlight:       hit.TitleHighlight,
+		DescriptionHighlight: hit.DescriptionHighlight,
+	}
+
+	if hit.Task != nil {
+		protoHit.Task = &taskv1.SearchTaskHit_ActiveTask{ActiveTask: toProtoTask(*hit.Task)}
+	} else if hit.CompletedTask != nil {
+		protoHit.Task = &taskv1.SearchTaskHit_CompletedTask{CompletedTask: toProtoCompletedTask(*hit.CompletedTask)}
+	}
+
+	return protoHit
+}
+
+func (s *Service) BatchUpdateTasks(
+	ctx context.Context,
+	req *taskv1.BatchUpdateTasksRequest,
+) (*taskv1.BatchUpdateTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("batch update tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	updates := make([]apptask.UpdateTaskRequest, 0, len(req.GetUpdates()))
+
+	for _, update := range req.GetUpdates() {
+		useCaseReq, err := toUpdateTaskRequest(token, update)
+		if err != nil {
+			s.logger.Warn("invalid task status in batch update", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		updates = append(updates, *useCaseReq)
+	}
+
+	result, err := s.batchUpdate.BatchUpdateTasks(ctx, &apptask.BatchUpdateTasksRequest{
+		SessionToken: token,
+		Updates:      updates,
+	})
+	if err != nil {
+		return nil, s.batchError(err, "batch update tasks")
+	}
+
+	results := make([]*taskv1.BatchUpdateTaskResult, 0, len(result.Results))
+
+	for _, itemResult := range result.Results {
+		protoResult := &taskv1.BatchUpdateTaskResult{
+			TaskId: itemResult.TaskID,
+			Result: nil,
+		}
+
+		if itemResult.Err != nil {
+			protoResult.Result = &taskv1.BatchUpdateTaskResult_Error{Error: s.batchTaskError(itemResult.TaskID, itemResult.Err)}
+		} else {
+			protoResult.Result = &taskv1.BatchUpdateTaskResult_Updated{Updated: toUpdateTaskResponse(itemResult.Task)}
+		}
+
+		results = append(results, protoResult)
+	}
+
+	s.logger.Info("tasks batch updated", slog.Int("count", len(results)))
+
+	return &taskv1.BatchUpdateTasksResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *Service) BatchDeleteTasks(
+	ctx context.Context,
+	req *taskv1.BatchDeleteTasksRequest,
+) (*taskv1.BatchDeleteTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("batch delete tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.batchDelete.BatchDeleteTasks(ctx, &apptask.BatchDeleteTasksRequest{
+		SessionToken: token,
+		TaskIDs:      req.GetTaskIds(),
+	})
+	if err != nil {
+		return nil, s.batchError(err, "batch delete tasks")
+	}
+
+	results := make([]*taskv1.BatchDeleteTaskResult, 0, len(result.Results))
+
+	for _, itemResult := range result.Results {
+		protoResult := &taskv1.BatchDeleteTaskResult{
+			TaskId: itemResult.TaskID,
+			Error:  nil,
+		}
+
+		if itemResult.Err != nil {
+			protoResult.Error = s.batchTaskError(itemResult.TaskID, itemResult.Err)
+		}
+
+		results = append(results, protoResult)
+	}
+
+	s.logger.Info("tasks batch deleted", slog.Int("count", len(results)))
+
+	return &taskv1.BatchDeleteTasksResponse{
+		Results: results,
+	}, nil
+}
+
+// WatchTasks streams the user's task events until the client disconnects or the watch ends
+func (s *Service) WatchTasks(
+	ctx context.Context,
+	_ *taskv1.WatchTasksRequest,
+	stream *connect.ServerStream[taskv1.TaskEvent],
+) error {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("watch tasks called without session token")
+
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	err := s.watchTasks.WatchTasks(ctx, &apptask.WatchTasksRequest{SessionToken: token}, func(event apptask.TaskEventItem) error {
+		return stream.Send(toProtoTaskEvent(event))
+	})
+	if err != nil {
+		return s.watchError(err)
+	}
+
+	return nil
+}
+
+func toProtoTaskEvent(event apptask.TaskEventItem) *taskv1.TaskEvent {
+	protoEvent := &taskv1.TaskEvent{
+		Type:       toProtoTaskEventType(event.Type),
+		TaskId:     event.TaskID,
+		Task:       nil,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+
+	if event.Task != nil {
+		protoEvent.Task = toProtoTask(*event.Task)
+	}
+
+	return protoEvent
+}
+
+func toProtoTaskEventType(eventType taskevent.Type) taskv1.TaskEventType {
+	switch eventType {
+	case taskevent.TypeCreated:
+		return taskv1.TaskEventType_TASK_EVENT_TYPE_CREATED
+	case taskevent.TypeUpdated:
+		return taskv1.TaskEventType_TASK_EVENT_TYPE_UPDATED
+	case taskevent.TypeCompleted:
+		return taskv1.TaskEventType_TASK_EVENT_TYPE_COMPLETED
+	case taskevent.TypeDeleted:
+		return taskv1.TaskEventType_TASK_EVENT_TYPE_DELETED
+	default:
+		return taskv1.TaskEventType_TASK_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func (s *Service) SyncTasks(
+	ctx context.Context,
+	req *taskv1.SyncTasksRequest,
+) (*taskv1.SyncTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("sync tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.syncTasks.SyncTasks(ctx, &apptask.SyncTasksRequest{
+		SessionToken: token,
+		SinceToken:   req.GetSinceToken(),
+		PageSize:     int(req.GetPageSize()),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apptask.ErrUnauthorized):
+			s.logger.Info("unauthorized sync tasks attempt")
+
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+			s.logger.Error("auth service unavailable during sync tasks", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case errors.Is(err, apptask.ErrSyncTokenExpired):
+			s.logger.Info("sync tasks token expired", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, apptask.ErrSyncTasksRequestRequired),
+			errors.Is(err, apptask.ErrInvalidSyncToken),
+			errors.Is(err, apptask.ErrInvalidSyncPageSize):
+			s.logger.Warn("invalid sync tasks request", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		default:
+			s.logger.Error("unexpected sync tasks error", slog.String("error", err.Error()))
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	tasks := make([]*taskv1.Task, 0, len(result.Tasks))
+	for _, task := range result.Tasks {
+		tasks = append(tasks, toProtoTask(task))
+	}
+
+	tombstones := make([]*taskv1.TaskTombstone, 0, len(result.Tombstones))
+	for _, tombstone := range result.Tombstones {
+		tombstones = append(tombstones, &taskv1.TaskTombstone{
+			TaskId:    tombstone.TaskID,
+			Reason:    toProtoTombstoneReason(tombstone.Reason),
+			RemovedAt: timestamppb.New(tombstone.RemovedAt),
+		})
+	}
+
+	s.logger.Info("tasks synced", slog.Int("tasks", len(tasks)), slog.Int("tombstones", len(tombstones)))
+
+	return &taskv1.SyncTasksResponse{
+		Tasks:         tasks,
+		Tombstones:    tombstones,
+		NextSyncToken: result.NextSyncToken,
+		HasMore:       result.HasMore,
+	}, nil
+}
+
+func toProtoTombstoneReason(reason domaintask.ChangeType) taskv1.TaskTombstoneReason {
+	switch reason {
+	case domaintask.ChangeTypeCompleted:
+		return taskv1.TaskTombstoneReason_TASK_TOMBSTONE_REASON_COMPLETED
+	case domaintask.ChangeTypeDeleted:
+		return taskv1.TaskTombstoneReason_TASK_TOMBSTONE_REASON_DELETED
+	case domaintask.ChangeTypeUpserted:
+		return taskv1.TaskTombstoneReason_TASK_TOMBSTONE_REASON_UNSPECIFIED
+	default:
+		return taskv1.TaskTombstoneReason_TASK_TOMBSTONE_REASON_UNSPECIFIED
+	}
+}
+
+// ImportTasks creates tasks from an iCalendar or CSV file, or only validates them on a dry run
+func (s *Service) ImportTasks(
+	ctx context.Context,
+	req *taskv1.ImportTasksRequest,
+) (*taskv1.ImportTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("import tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	format, err := protoImportFormatToString(req.GetFormat())
+	if err != nil {
+		s.logger.Warn("invalid import format", slog.String("error", err.Error()))
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	defaultTaskType, err := protoTaskTypeToString(req.GetDefaultTaskType())
+	if err != nil {
+		s.logger.Warn("invalid default task type", slog.String("error", err.Error()))
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.importTasks.ImportTasks(ctx, &apptask.ImportTasksRequest{
+		SessionToken:    token,
+		Format:          format,
+		Data:            req.GetData(),
+		DefaultTaskType: defaultTaskType,
+		DefaultColor:    req.GetDefaultColor(),
+		Timezone:        req.GetTimezone(),
+		DryRun:          req.GetDryRun(),
+	})
+	if err != nil {
+		return nil, s.importError(err)
+	}
+
+	results := make([]*taskv1.ImportTaskResult, 0, len(result.Results))
+
+	for _, itemResult := range result.Results {
+		protoResult := &taskv1.ImportTaskResult{
+			Row:    int32(itemResult.Row),
+			Title:  itemResult.Title,
+			Result: nil,
+		}
+
+		if itemResult.Err != nil {
+			protoResult.Result = &taskv1.ImportTaskResult_Error{Error: s.importTaskError(itemResult.Row, itemResult.Err)}
+		} else {
+			protoResult.Result = &taskv1.ImportTaskResult_Task{Task: toProtoTask(*itemResult.Task)}
+		}
+
+		results = append(results, protoResult)
+	}
+
+	s.logger.Info("tasks import handled",
+		slog.Bool("dry_run", req.GetDryRun()),
+		slog.Int("entries", len(results)),
+		slog.Int("imported", result.Imported),
+	)
+
+	return &taskv1.ImportTasksResponse{
+		Results:  results,
+		Imported: int32(result.Imported),
+	}, nil
+}
+
+func protoImportFormatToString(format taskv1.ImportFormat) (string, error) {
+	switch format {
+	case taskv1.ImportFormat_IMPORT_FORMAT_ICS:
+		return "ics", nil
+	case taskv1.ImportFormat_IMPORT_FORMAT_CSV:
+		return "csv", nil
+	case taskv1.ImportFormat_IMPORT_FORMAT_UNSPECIFIED:
+		return "", errors.New("import format is required")
+	default:
+		return "", errors.New("unsupported import format")
+	}
+}
+
+// importError maps an error that failed a whole import to a Connect error.
+func (s *Service) importError(err error) error {
+	switch {
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized import tasks attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during import tasks", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrDeviceServiceUnavailable):
+		s.logger.Error("device service unavailable during import tasks", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrDeviceInvalidArgument):
+		s.logger.Error("device service invalid argument during import tasks", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, apptask.ErrRemindQueueRegistrationFailed):
+		s.logger.Error("remind queue registration failed during import tasks", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrImportTasksRequestRequired),
+		errors.Is(err, apptask.ErrInvalidImportDefaultTaskType),
+		errors.Is(err, apptask.ErrInvalidImportTimezone),
+		errors.Is(err, apptask.ErrUnsupportedImportFormat),
+		errors.Is(err, apptask.ErrImportTooLarge),
+		errors.Is(err, apptask.ErrImportEmpty),
+		errors.Is(err, apptask.ErrInvalidImportICS),
+		errors.Is(err, apptask.ErrInvalidImportCSV),
+		errors.Is(err, apptask.ErrImportTitleColumnMissing):
+		s.logger.Warn("invalid import tasks request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected import tasks error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// importTaskError describes why a single entry of an import was rejected.
+func (s *Service) importTaskError(row int, err error) *taskv1.BatchTaskError {
+	code := connect.CodeInvalidArgument
+
+	if !isInvalidImportEntryError(err) {
+		s.logger.Error("unexpected import entry error",
+			slog.Int("row", row),
+			slog.String("error", err.Error()),
+		)
+
+		code = connect.CodeInternal
+	}
+
+	return &taskv1.BatchTaskError{
+		Code:    code.String(),
+		Message: err.Error(),
+	}
+}
+
+func isInvalidImportEntryError(err error) bool {
+	return errors.Is(err, apptask.ErrTitleRequired) ||
+		errors.Is(err, apptask.ErrScheduledAtInPast) ||
+		errors.Is(err, apptask.ErrInvalidImportDate) ||
+		errors.Is(err, apptask.ErrImportEntryClosed) ||
+		errors.Is(err, domaintask.ErrTitleTooLong) ||
+		errors.Is(err, domaintask.ErrScheduledAtRequired) ||
+		errors.Is(err, domaintask.ErrScheduledAtNotAllowed) ||
+		errors.Is(err, domaintask.ErrScheduledAtBeforeCreatedAt) ||
+		errors.Is(err, domaintask.ErrColorEmpty) ||
+		errors.Is(err, domaintask.ErrColorInvalidFormat)
+}
+
+// watchError maps an error that ended a watch to a Connect error.
+func (s *Service) watchError(err error) error {
+	var connectErr *connect.Error
+
+	switch {
+	case errors.As(err, &connectErr):
+		// Sending to the client failed; the stream already carries the cause
+		s.logger.Info("watch tasks stream send failed", slog.String("error", err.Error()))
+
+		return connectErr
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized watch tasks attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during watch tasks", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrTaskEventStreamClosed):
+		s.logger.Info("watch tasks stream closed", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrWatchTasksRequestRequired):
+		s.logger.Warn("invalid watch tasks request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected watch tasks error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// batchError maps an error that failed a whole batch to a Connect error.
+func (s *Service) batchError(err error, operation string) error {
+	switch {
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized " + operation + " attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrCancelRemindFailed),
+		errors.Is(err, apptask.ErrRemindQueueRegistrationFailed):
+		s.logger.Error("remind queue failed during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrTaskVersionConflict):
+		s.logger.Info(operation+" conflicts with a concurrent edit", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, apptask.ErrBatchUpdateTasksRequestRequired),
+		errors.Is(err, apptask.ErrBatchDeleteTasksRequestRequired),
+		errors.Is(err, apptask.ErrBatchEmpty),
+		errors.Is(err, apptask.ErrBatchTooLarge):
+		s.logger.Warn("invalid "+operation+" request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected "+operation+" error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// batchTaskError describes the failure of a single task of a batch with the code its single
+// task RPC would have returned.
+func (s *Service) batchTaskError(taskID string, err error) *taskv1.BatchTaskError {
+	var code connect.Code
+
+	switch {
+	case errors.Is(err, apptask.ErrTaskNotFound):
+		code = connect.CodeNotFound
+	case errors.Is(err, apptask.ErrTaskVersionConflict):
+		code = connect.CodeAborted
+	case errors.Is(err, apptask.ErrUnauthorized):
+		code = connect.CodeUnauthenticated
+	case errors.Is(err, apptask.ErrDeviceServiceUnavailable):
+		code = connect.CodeUnavailable
+	case errors.Is(err, apptask.ErrDeviceInvalidArgument),
+		errors.Is(err, apptask.ErrDuplicateTaskInBatch),
+		isInvalidUpdateTaskError(err):
+		code = connect.CodeInvalidArgument
+	default:
+		s.logger.Error("unexpected batch task error",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+
+		code = connect.CodeInternal
+	}
+
+	return &taskv1.BatchTaskError{
+		Code:    code.String(),
+		Message: err.Error(),
+	}
+}
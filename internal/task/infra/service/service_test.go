@@ -3,16 +3,22 @@ package task
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	connect "connectrpc.com/connect"
 	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
 	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
 	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/interceptor"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -183,7 +189,7 @@ func TestCreateTaskSuccess(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUseCase := tt.expectedCall(t, ctrl)
-			svc := NewService(mockUseCase, nil, nil, nil, nil)
+			svc := NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			token := "token-normal"
 			if tt.name == "task with description and scheduled time" {
@@ -221,16 +227,20 @@ func TestCreateTaskError(t *testing.T) {
 		expectedCode connect.Code
 	}{
 		{
-			name:         "missing session token",
-			ctx:          context.Background(),
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil) },
+			name: "missing session token",
+			ctx:  context.Background(),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeUnauthenticated,
 		},
 		{
-			name:    "invalid task type",
-			ctx:     ctxWithSessionToken(t, "token"),
-			service: func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil) },
+			name: "invalid task type",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
 			req: &taskv1.CreateTaskRequest{
 				Title:    "title",
 				TaskType: taskv1.TaskType_TASK_TYPE_UNSPECIFIED,
@@ -247,7 +257,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrUnauthorized)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeUnauthenticated,
@@ -261,7 +271,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrAuthServiceUnavailable)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeUnavailable,
@@ -275,7 +285,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrDeviceServiceUnavailable)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeUnavailable,
@@ -289,7 +299,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrDeviceInvalidArgument)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeInvalidArgument,
@@ -303,7 +313,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrTitleRequired)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeInvalidArgument,
@@ -317,7 +327,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("boom"))
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "#FF6B6B"},
 			expectedCode: connect.CodeInternal,
@@ -331,7 +341,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, domaintask.ErrIDInvalidFormat)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req: func() *taskv1.CreateTaskRequest {
 				invalidUUID := "invalid-uuid"
@@ -349,7 +359,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, domaintask.ErrIDInvalidV7)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req: func() *taskv1.CreateTaskRequest {
 				uuidv4 := uuid.New()
@@ -373,7 +383,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrTaskIDAlreadyExists)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req: func() *taskv1.CreateTaskRequest {
 				existingID, _ := domaintask.NewID()
@@ -397,7 +407,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, domaintask.ErrColorEmpty)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: ""},
 			expectedCode: connect.CodeInvalidArgument,
@@ -411,7 +421,7 @@ func TestCreateTaskError(t *testing.T) {
 					CreateTask(gomock.Any(), gomock.Any()).
 					Return(nil, domaintask.ErrColorInvalidFormat)
 
-				return NewService(mockUseCase, nil, nil, nil, nil)
+				return NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.CreateTaskRequest{Title: "title", TaskType: taskv1.TaskType_TASK_TYPE_NEAR, Color: "invalid"},
 			expectedCode: connect.CodeInvalidArgument,
@@ -523,7 +533,7 @@ func TestGetTaskSuccess(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUseCase := tt.expectedCall(t, ctrl)
-			svc := NewService(nil, mockUseCase, nil, nil, nil)
+			svc := NewService(nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			ctx := ctxWithSessionToken(t, "token")
 
 			resp, err := svc.GetTask(ctx, tt.req)
@@ -563,9 +573,11 @@ func TestGetTaskError(t *testing.T) {
 		expectedCode connect.Code
 	}{
 		{
-			name:         "missing session token",
-			ctx:          context.Background(),
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil) },
+			name: "missing session token",
+			ctx:  context.Background(),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
 			req:          &taskv1.GetTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeUnauthenticated,
 		},
@@ -578,7 +590,7 @@ func TestGetTaskError(t *testing.T) {
 					GetTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrUnauthorized)
 
-				return NewService(nil, mockUseCase, nil, nil, nil)
+				return NewService(nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.GetTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeUnauthenticated,
@@ -592,7 +604,7 @@ func TestGetTaskError(t *testing.T) {
 					GetTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrAuthServiceUnavailable)
 
-				return NewService(nil, mockUseCase, nil, nil, nil)
+				return NewService(nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.GetTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeUnavailable,
@@ -606,7 +618,7 @@ func TestGetTaskError(t *testing.T) {
 					GetTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrTaskNotFound)
 
-				return NewService(nil, mockUseCase, nil, nil, nil)
+				return NewService(nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.GetTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeNotFound,
@@ -620,7 +632,7 @@ func TestGetTaskError(t *testing.T) {
 					GetTask(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrTaskIDRequired)
 
-				return NewService(nil, mockUseCase, nil, nil, nil)
+				return NewService(nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.GetTaskRequest{TaskId: ""},
 			expectedCode: connect.CodeInvalidArgument,
@@ -634,7 +646,7 @@ func TestGetTaskError(t *testing.T) {
 					GetTask(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("boom"))
 
-				return NewService(nil, mockUseCase, nil, nil, nil)
+				return NewService(nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.GetTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeInternal,
@@ -701,7 +713,7 @@ func TestListActiveTasksSuccess(t *testing.T) {
 			}, nil
 		})
 
-	svc := NewService(nil, nil, mockUseCase, nil, nil)
+	svc := NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := ctxWithSessionToken(t, "valid-token")
 
 	resp, err := svc.ListActiveTasks(ctx, &taskv1.ListActiveTasksRequest{
@@ -724,6 +736,118 @@ func TestListActiveTasksSuccess(t *testing.T) {
 	}
 }
 
+func TestListActiveTasksPaginationAndFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	color := "#FF6B6B"
+
+	mockUseCase := NewMockListActiveTasksUseCase(ctrl)
+	mockUseCase.EXPECT().
+		ListActiveTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.ListActiveTasksRequest) (*apptask.ListActiveTasksResult, error) {
+			if req.PageSize != 10 {
+				t.Fatalf("expected page size 10, got %d", req.PageSize)
+			}
+
+			if req.PageToken != "page-token" {
+				t.Fatalf("expected page token page-token, got %s", req.PageToken)
+			}
+
+			if req.TaskType == nil || *req.TaskType != domaintask.TypeNear {
+				t.Fatalf("expected task type filter near, got %v", req.TaskType)
+			}
+
+			if req.Color == nil || *req.Color != color {
+				t.Fatalf("expected color filter %s, got %v", color, req.Color)
+			}
+
+			if req.TargetAtFrom == nil || !req.TargetAtFrom.Equal(from) {
+				t.Fatalf("expected target_at_from %v, got %v", from, req.TargetAtFrom)
+			}
+
+			if req.TargetAtTo == nil || !req.TargetAtTo.Equal(to) {
+				t.Fatalf("expected target_at_to %v, got %v", to, req.TargetAtTo)
+			}
+
+			return &apptask.ListActiveTasksResult{
+				Tasks:         []apptask.TaskItem{},
+				NextPageToken: "next-token",
+			}, nil
+		})
+
+	svc := NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := ctxWithSessionToken(t, "valid-token")
+
+	taskType := taskv1.TaskType_TASK_TYPE_NEAR
+
+	resp, err := svc.ListActiveTasks(ctx, &taskv1.ListActiveTasksRequest{
+		SortType:     taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT,
+		PageSize:     10,
+		PageToken:    "page-token",
+		TaskType:     &taskType,
+		Color:        &color,
+		TargetAtFrom: timestamppb.New(from),
+		TargetAtTo:   timestamppb.New(to),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.GetNextPageToken() != "next-token" {
+		t.Fatalf("expected next page token next-token, got %s", resp.GetNextPageToken())
+	}
+}
+
+func TestListActiveTasksSortMapping(t *testing.T) {
+	tests := []struct {
+		name              string
+		sortType          taskv1.TaskSortType
+		sortDirection     taskv1.SortDirection
+		expectedSortType  domaintask.SortType
+		expectedDirection domaintask.SortDirection
+	}{
+		{name: "created at", sortType: taskv1.TaskSortType_TASK_SORT_TYPE_CREATED_AT, expectedSortType: domaintask.SortTypeCreatedAt, expectedDirection: domaintask.SortDirectionAsc},
+		{name: "title descending", sortType: taskv1.TaskSortType_TASK_SORT_TYPE_TITLE, sortDirection: taskv1.SortDirection_SORT_DIRECTION_DESC, expectedSortType: domaintask.SortTypeTitle, expectedDirection: domaintask.SortDirectionDesc},
+		{name: "task type", sortType: taskv1.TaskSortType_TASK_SORT_TYPE_TASK_TYPE, sortDirection: taskv1.SortDirection_SORT_DIRECTION_ASC, expectedSortType: domaintask.SortTypeTaskType, expectedDirection: domaintask.SortDirectionAsc},
+		{name: "color", sortType: taskv1.TaskSortType_TASK_SORT_TYPE_COLOR, expectedSortType: domaintask.SortTypeColor, expectedDirection: domaintask.SortDirectionAsc},
+		{name: "manual", sortType: taskv1.TaskSortType_TASK_SORT_TYPE_MANUAL, expectedSortType: domaintask.SortTypeManual, expectedDirection: domaintask.SortDirectionAsc},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockListActiveTasksUseCase(ctrl)
+			mockUseCase.EXPECT().
+				ListActiveTasks(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *apptask.ListActiveTasksRequest) (*apptask.ListActiveTasksResult, error) {
+					if req.SortType != tt.expectedSortType {
+						t.Fatalf("expected sort type %s, got %s", tt.expectedSortType, req.SortType)
+					}
+
+					if req.SortDirection != tt.expectedDirection {
+						t.Fatalf("expected sort direction %s, got %s", tt.expectedDirection, req.SortDirection)
+					}
+
+					return &apptask.ListActiveTasksResult{Tasks: []apptask.TaskItem{}}, nil
+				})
+
+			svc := NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if _, err := svc.ListActiveTasks(ctxWithSessionToken(t, "valid-token"), &taskv1.ListActiveTasksRequest{
+				SortType:      tt.sortType,
+				SortDirection: tt.sortDirection,
+			}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 func TestListActiveTasksError(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -733,19 +857,49 @@ func TestListActiveTasksError(t *testing.T) {
 		expectedCode connect.Code
 	}{
 		{
-			name:         "missing session token",
-			ctx:          context.Background(),
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil) },
+			name: "missing session token",
+			ctx:  context.Background(),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
 			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT},
 			expectedCode: connect.CodeUnauthenticated,
 		},
 		{
-			name:         "invalid sort type (unspecified)",
-			ctx:          ctxWithSessionToken(t, "token"),
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil) },
+			name: "invalid sort type (unspecified)",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
 			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_UNSPECIFIED},
 			expectedCode: connect.CodeInvalidArgument,
 		},
+		{
+			name: "unspecified task type filter",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
+			req: &taskv1.ListActiveTasksRequest{
+				SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT,
+				TaskType: taskv1.TaskType_TASK_TYPE_UNSPECIFIED.Enum(),
+			},
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "invalid page token from use case",
+			ctx:  ctxWithSessionToken(t, "token"),
+			service: func(ctrl *gomock.Controller) *Service {
+				mockUseCase := NewMockListActiveTasksUseCase(ctrl)
+				mockUseCase.EXPECT().
+					ListActiveTasks(gomock.Any(), gomock.Any()).
+					Return(nil, apptask.ErrInvalidPageToken)
+
+				return NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
+			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT, PageToken: "bad"},
+			expectedCode: connect.CodeInvalidArgument,
+		},
 		{
 			name: "unauthorized",
 			ctx:  ctxWithSessionToken(t, "token"),
@@ -755,7 +909,7 @@ func TestListActiveTasksError(t *testing.T) {
 					ListActiveTasks(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrUnauthorized)
 
-				return NewService(nil, nil, mockUseCase, nil, nil)
+				return NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT},
 			expectedCode: connect.CodeUnauthenticated,
@@ -769,7 +923,7 @@ func TestListActiveTasksError(t *testing.T) {
 					ListActiveTasks(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrAuthServiceUnavailable)
 
-				return NewService(nil, nil, mockUseCase, nil, nil)
+				return NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT},
 			expectedCode: connect.CodeUnavailable,
@@ -783,7 +937,7 @@ func TestListActiveTasksError(t *testing.T) {
 					ListActiveTasks(gomock.Any(), gomock.Any()).
 					Return(nil, apptask.ErrInvalidSortType)
 
-				return NewService(nil, nil, mockUseCase, nil, nil)
+				return NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT},
 			expectedCode: connect.CodeInvalidArgument,
@@ -797,7 +951,7 @@ func TestListActiveTasksError(t *testing.T) {
 					ListActiveTasks(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("database error"))
 
-				return NewService(nil, nil, mockUseCase, nil, nil)
+				return NewService(nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.ListActiveTasksRequest{SortType: taskv1.TaskSortType_TASK_SORT_TYPE_TARGET_AT},
 			expectedCode: connect.CodeInternal,
@@ -841,7 +995,7 @@ func TestDeleteTaskSuccess(t *testing.T) {
 			return nil
 		})
 
-	svc := NewService(nil, nil, nil, nil, mockUseCase)
+	svc := NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := ctxWithSessionToken(t, "valid-token")
 
 	resp, err := svc.DeleteTask(ctx, &taskv1.DeleteTaskRequest{TaskId: "task-id-1"})
@@ -863,9 +1017,11 @@ func TestDeleteTaskError(t *testing.T) {
 		expectedCode connect.Code
 	}{
 		{
-			name:         "missing session token",
-			ctx:          context.Background(),
-			service:      func(_ *gomock.Controller) *Service { return NewService(nil, nil, nil, nil, nil) },
+			name: "missing session token",
+			ctx:  context.Background(),
+			service: func(_ *gomock.Controller) *Service {
+				return NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeUnauthenticated,
 		},
@@ -876,7 +1032,7 @@ func TestDeleteTaskError(t *testing.T) {
 				mockUseCase := NewMockDeleteTaskUseCase(ctrl)
 				mockUseCase.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(apptask.ErrUnauthorized)
 
-				return NewService(nil, nil, nil, nil, mockUseCase)
+				return NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeUnauthenticated,
@@ -888,7 +1044,7 @@ func TestDeleteTaskError(t *testing.T) {
 				mockUseCase := NewMockDeleteTaskUseCase(ctrl)
 				mockUseCase.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(apptask.ErrAuthServiceUnavailable)
 
-				return NewService(nil, nil, nil, nil, mockUseCase)
+				return NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeUnavailable,
@@ -900,7 +1056,7 @@ func TestDeleteTaskError(t *testing.T) {
 				mockUseCase := NewMockDeleteTaskUseCase(ctrl)
 				mockUseCase.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(apptask.ErrTaskNotFound)
 
-				return NewService(nil, nil, nil, nil, mockUseCase)
+				return NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeNotFound,
@@ -912,7 +1068,7 @@ func TestDeleteTaskError(t *testing.T) {
 				mockUseCase := NewMockDeleteTaskUseCase(ctrl)
 				mockUseCase.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(apptask.ErrTaskIDRequired)
 
-				return NewService(nil, nil, nil, nil, mockUseCase)
+				return NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: ""},
 			expectedCode: connect.CodeInvalidArgument,
@@ -924,7 +1080,7 @@ func TestDeleteTaskError(t *testing.T) {
 				mockUseCase := NewMockDeleteTaskUseCase(ctrl)
 				mockUseCase.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(domaintask.ErrIDInvalidFormat)
 
-				return NewService(nil, nil, nil, nil, mockUseCase)
+				return NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: "invalid-uuid"},
 			expectedCode: connect.CodeInvalidArgument,
@@ -936,7 +1092,7 @@ func TestDeleteTaskError(t *testing.T) {
 				mockUseCase := NewMockDeleteTaskUseCase(ctrl)
 				mockUseCase.EXPECT().DeleteTask(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
 
-				return NewService(nil, nil, nil, nil, mockUseCase)
+				return NewService(nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
 			},
 			req:          &taskv1.DeleteTaskRequest{TaskId: "id"},
 			expectedCode: connect.CodeInternal,
@@ -969,7 +1125,7 @@ func ctxWithSessionToken(t *testing.T, token string) context.Context {
 
 	var capturedCtx context.Context
 
-	_, err := interceptor.AuthInterceptor()(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+	_, err := interceptor.AuthInterceptor().WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
 		capturedCtx = ctx
 
 		return connect.NewResponse(&taskv1.CreateTaskResponse{}), nil
@@ -980,3 +1136,1069 @@ func ctxWithSessionToken(t *testing.T, token string) context.Context {
 
 	return capturedCtx
 }
+
+func TestCreateTaskWithRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scheduledAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockCreateTaskUseCase(ctrl)
+	mockUseCase.EXPECT().CreateTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.CreateTaskRequest) (*apptask.CreateTaskResult, error) {
+			if req.Recurrence == nil ||
+				req.Recurrence.Rule != "FREQ=WEEKLY;BYDAY=MO" ||
+				req.Recurrence.Timezone != "Asia/Tokyo" {
+				t.Fatalf("unexpected recurrence: %+v", req.Recurrence)
+			}
+
+			return &apptask.CreateTaskResult{
+				TaskID:      "task-id-1",
+				TaskType:    string(domaintask.TypeScheduled),
+				ScheduledAt: &scheduledAt,
+				TargetAt:    scheduledAt,
+				Color:       "#FF6B6B",
+				Recurrence:  req.Recurrence,
+			}, nil
+		})
+
+	svc := NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	resp, err := svc.CreateTask(ctxWithSessionToken(t, "token"), &taskv1.CreateTaskRequest{
+		Title:       "Weekly review",
+		TaskType:    taskv1.TaskType_TASK_TYPE_SCHEDULED,
+		ScheduledAt: timestamppb.New(scheduledAt),
+		Color:       "#FF6B6B",
+		Recurrence:  &taskv1.Recurrence{Rule: "FREQ=WEEKLY;BYDAY=MO", Timezone: "Asia/Tokyo"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.GetTask().GetRecurrence().GetRule() != "FREQ=WEEKLY;BYDAY=MO" {
+		t.Fatalf("expected recurrence in response, got %v", resp.GetTask().GetRecurrence())
+	}
+}
+
+func TestCreateTaskInvalidRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := NewMockCreateTaskUseCase(ctrl)
+	mockUseCase.EXPECT().CreateTask(gomock.Any(), gomock.Any()).
+		Return(nil, domaintask.ErrInvalidRecurrenceRule)
+
+	svc := NewService(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.CreateTask(ctxWithSessionToken(t, "token"), &taskv1.CreateTaskRequest{
+		Title:      "Weekly review",
+		TaskType:   taskv1.TaskType_TASK_TYPE_SCHEDULED,
+		Color:      "#FF6B6B",
+		Recurrence: &taskv1.Recurrence{Rule: "FREQ=HOURLY"},
+	})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected code %v, got %v", connect.CodeInvalidArgument, connect.CodeOf(err))
+	}
+}
+
+func TestUpdateTaskRecurrence(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	next := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name           string
+		req            *taskv1.UpdateTaskRequest
+		wantRecurrence *apptask.Recurrence
+	}{
+		{
+			name: "set recurrence",
+			req: &taskv1.UpdateTaskRequest{
+				TaskId:     "task-id-1",
+				Recurrence: &taskv1.Recurrence{Rule: "FREQ=DAILY"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"recurrence"}},
+			},
+			wantRecurrence: &apptask.Recurrence{Rule: "FREQ=DAILY"},
+		},
+		{
+			name: "clear recurrence",
+			req: &taskv1.UpdateTaskRequest{
+				TaskId:     "task-id-1",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"recurrence"}},
+			},
+			wantRecurrence: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockUpdateTaskUseCase(ctrl)
+			mockUseCase.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *apptask.UpdateTaskRequest) (*apptask.UpdateTaskResult, error) {
+					if (req.Recurrence == nil) != (tt.wantRecurrence == nil) ||
+						(req.Recurrence != nil && *req.Recurrence != *tt.wantRecurrence) {
+						t.Fatalf("expected recurrence %+v, got %+v", tt.wantRecurrence, req.Recurrence)
+					}
+
+					return &apptask.UpdateTaskResult{
+						TaskID:     "task-id-1",
+						TaskType:   domaintask.TypeScheduled,
+						TaskStatus: domaintask.StatusActive,
+						TargetAt:   now,
+						Recurrence: req.Recurrence,
+					}, nil
+				})
+
+			svc := NewService(nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			resp, err := svc.UpdateTask(ctxWithSessionToken(t, "token"), tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (resp.GetTask().GetRecurrence() == nil) != (tt.wantRecurrence == nil) {
+				t.Fatalf("unexpected recurrence in response: %v", resp.GetTask().GetRecurrence())
+			}
+
+			if resp.GetNextOccurrence() != nil {
+				t.Fatalf("expected no next occurrence, got %v", resp.GetNextOccurrence())
+			}
+		})
+	}
+
+	t.Run("completion returns next occurrence", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recurrence := &apptask.Recurrence{Rule: "FREQ=DAILY;COUNT=2"}
+
+		mockUseCase := NewMockUpdateTaskUseCase(ctrl)
+		mockUseCase.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+			Return(&apptask.UpdateTaskResult{
+				TaskID:      "task-id-1",
+				TaskType:    domaintask.TypeScheduled,
+				TaskStatus:  domaintask.StatusCompleted,
+				ScheduledAt: &now,
+				TargetAt:    now,
+				Recurrence:  &apptask.Recurrence{Rule: "FREQ=DAILY;COUNT=3"},
+				NextOccurrence: &apptask.TaskItem{
+					TaskID:      "task-id-2",
+					TaskType:    domaintask.TypeScheduled,
+					TaskStatus:  domaintask.StatusActive,
+					ScheduledAt: &next,
+					TargetAt:    next,
+					Recurrence:  recurrence,
+				},
+			}, nil)
+
+		svc := NewService(nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		resp, err := svc.UpdateTask(ctxWithSessionToken(t, "token"), &taskv1.UpdateTaskRequest{
+			TaskId:     "task-id-1",
+			TaskStatus: taskv1.TaskStatus_TASK_STATUS_COMPLETED,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"task_status"}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := resp.GetNextOccurrence()
+		if got.GetTaskId() != "task-id-2" || !got.GetTargetAt().AsTime().Equal(next) {
+			t.Fatalf("unexpected next occurrence: %v", got)
+		}
+
+		if got.GetRecurrence().GetRule() != recurrence.Rule {
+			t.Fatalf("expected next occurrence rule %s, got %s", recurrence.Rule, got.GetRecurrence().GetRule())
+		}
+	})
+}
+
+func TestSnoozeTask(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	until := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name         string
+		req          *taskv1.SnoozeTaskRequest
+		checkRequest func(t *testing.T, req *apptask.SnoozeTaskRequest)
+	}{
+		{
+			name: "duration",
+			req: &taskv1.SnoozeTaskRequest{
+				TaskId: "task-id-1",
+				Snooze: &taskv1.SnoozeTaskRequest_Duration{Duration: durationpb.New(15 * time.Minute)},
+			},
+			checkRequest: func(t *testing.T, req *apptask.SnoozeTaskRequest) {
+				if req.Duration == nil || *req.Duration != 15*time.Minute || req.Until != nil {
+					t.Fatalf("expected duration 15m only, got duration %v until %v", req.Duration, req.Until)
+				}
+			},
+		},
+		{
+			name: "until",
+			req: &taskv1.SnoozeTaskRequest{
+				TaskId: "task-id-1",
+				Snooze: &taskv1.SnoozeTaskRequest_Until{Until: timestamppb.New(until)},
+			},
+			checkRequest: func(t *testing.T, req *apptask.SnoozeTaskRequest) {
+				if req.Until == nil || !req.Until.Equal(until) || req.Duration != nil {
+					t.Fatalf("expected until %v only, got duration %v until %v", until, req.Duration, req.Until)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockSnoozeTaskUseCase(ctrl)
+			mockUseCase.EXPECT().SnoozeTask(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *apptask.SnoozeTaskRequest) (*apptask.SnoozeTaskResult, error) {
+					if req.SessionToken != "token" || req.TaskID != "task-id-1" {
+						t.Fatalf("unexpected request: %+v", req)
+					}
+
+					tt.checkRequest(t, req)
+
+					return &apptask.SnoozeTaskResult{
+						TaskID:     "task-id-1",
+						TaskType:   domaintask.TypeNear,
+						TaskStatus: domaintask.StatusActive,
+						CreatedAt:  now,
+						TargetAt:   until,
+						Color:      "#FF6B6B",
+					}, nil
+				})
+
+			svc := NewService(nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil)
+
+			resp, err := svc.SnoozeTask(ctxWithSessionToken(t, "token"), tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !resp.GetTask().GetTargetAt().AsTime().Equal(until) {
+				t.Fatalf("expected targetAt %v, got %v", until, resp.GetTask().GetTargetAt().AsTime())
+			}
+
+			if resp.GetTask().GetTaskStatus() != taskv1.TaskStatus_TASK_STATUS_ACTIVE {
+				t.Fatalf("expected active status, got %v", resp.GetTask().GetTaskStatus())
+			}
+		})
+	}
+}
+
+func TestSnoozeTaskError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		withToken    bool
+		expectedCode connect.Code
+	}{
+		{name: "missing session token", withToken: false, expectedCode: connect.CodeUnauthenticated},
+		{name: "unauthorized", useCaseErr: apptask.ErrUnauthorized, withToken: true, expectedCode: connect.CodeUnauthenticated},
+		{name: "not found", useCaseErr: apptask.ErrTaskNotFound, withToken: true, expectedCode: connect.CodeNotFound},
+		{name: "no snooze target", useCaseErr: apptask.ErrSnoozeTargetRequired, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "until in the past", useCaseErr: apptask.ErrSnoozeUntilNotInFuture, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "cancel remind failed", useCaseErr: apptask.ErrCancelRemindFailed, withToken: true, expectedCode: connect.CodeUnavailable},
+		{name: "register remind failed", useCaseErr: apptask.ErrRemindQueueRegistrationFailed, withToken: true, expectedCode: connect.CodeUnavailable},
+		{name: "unexpected", useCaseErr: errors.New("boom"), withToken: true, expectedCode: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockSnoozeTaskUseCase(ctrl)
+
+			ctx := context.Background()
+			if tt.withToken {
+				ctx = ctxWithSessionToken(t, "token")
+
+				mockUseCase.EXPECT().SnoozeTask(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewService(nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := svc.SnoozeTask(ctx, &taskv1.SnoozeTaskRequest{
+				TaskId: "task-id-1",
+				Snooze: &taskv1.SnoozeTaskRequest_Duration{Duration: durationpb.New(time.Minute)},
+			})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestUpdateTaskReminderErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{name: "device service unavailable", useCaseErr: apptask.ErrDeviceServiceUnavailable, expectedCode: connect.CodeUnavailable},
+		{name: "cancel remind failed", useCaseErr: apptask.ErrCancelRemindFailed, expectedCode: connect.CodeUnavailable},
+		{name: "register remind failed", useCaseErr: apptask.ErrRemindQueueRegistrationFailed, expectedCode: connect.CodeUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockUpdateTaskUseCase(ctrl)
+			mockUseCase.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+
+			svc := NewService(nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := svc.UpdateTask(ctxWithSessionToken(t, "token"), &taskv1.UpdateTaskRequest{
+				TaskId:      "task-id-1",
+				ScheduledAt: timestamppb.New(time.Now().Add(time.Hour)),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"scheduled_at"}},
+			})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestUpdateTaskVersionConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockUpdateTaskUseCase(ctrl)
+	mockUseCase.EXPECT().UpdateTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.UpdateTaskRequest) (*apptask.UpdateTaskResult, error) {
+			if req.ExpectedVersion == nil || *req.ExpectedVersion != 3 {
+				t.Fatalf("expected version 3 to be passed through, got %v", req.ExpectedVersion)
+			}
+
+			return nil, &apptask.TaskVersionConflictError{Current: apptask.TaskItem{
+				TaskID:     "task-id-1",
+				Title:      "Edited elsewhere",
+				TaskType:   domaintask.TypeNear,
+				TaskStatus: domaintask.StatusActive,
+				CreatedAt:  now,
+				TargetAt:   now.Add(time.Hour),
+				Color:      "#FF6B6B",
+				Version:    4,
+			}}
+		})
+
+	svc := NewService(nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	expectedVersion := int64(3)
+
+	_, err := svc.UpdateTask(ctxWithSessionToken(t, "token"), &taskv1.UpdateTaskRequest{
+		TaskId:          "task-id-1",
+		Title:           "Renamed",
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		ExpectedVersion: &expectedVersion,
+	})
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeAborted {
+		t.Fatalf("expected code %v, got %v", connect.CodeAborted, connect.CodeOf(err))
+	}
+
+	if len(connectErr.Details()) != 1 {
+		t.Fatalf("expected the current task in the details, got %d details", len(connectErr.Details()))
+	}
+
+	detail, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("failed to decode detail: %v", err)
+	}
+
+	current, ok := detail.(*taskv1.Task)
+	if !ok || current.GetTitle() != "Edited elsewhere" || current.GetVersion() != 4 {
+		t.Fatalf("unexpected current task %v", detail)
+	}
+}
+
+func TestMoveTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	beforeID := "before-id"
+
+	mockUseCase := NewMockMoveTaskUseCase(ctrl)
+	mockUseCase.EXPECT().MoveTask(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.MoveTaskRequest) (*apptask.MoveTaskResult, error) {
+			if req.SessionToken != "token" || req.TaskID != "task-id-1" || req.BeforeID != beforeID || req.AfterID != "" {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.MoveTaskResult{
+				TaskID:     "task-id-1",
+				TaskType:   domaintask.TypeNear,
+				TaskStatus: domaintask.StatusActive,
+				CreatedAt:  now,
+				TargetAt:   now.Add(time.Hour),
+				Color:      "#FF6B6B",
+			}, nil
+		})
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil)
+
+	resp, err := svc.MoveTask(ctxWithSessionToken(t, "token"), &taskv1.MoveTaskRequest{
+		TaskId:   "task-id-1",
+		BeforeId: &beforeID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.GetTask().GetTaskId() != "task-id-1" {
+		t.Fatalf("expected task-id-1, got %s", resp.GetTask().GetTaskId())
+	}
+}
+
+func TestMoveTaskError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		withToken    bool
+		expectedCode connect.Code
+	}{
+		{name: "missing session token", withToken: false, expectedCode: connect.CodeUnauthenticated},
+		{name: "unauthorized", useCaseErr: apptask.ErrUnauthorized, withToken: true, expectedCode: connect.CodeUnauthenticated},
+		{name: "not found", useCaseErr: apptask.ErrTaskNotFound, withToken: true, expectedCode: connect.CodeNotFound},
+		{name: "no neighbor", useCaseErr: apptask.ErrMoveTargetRequired, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "invalid position", useCaseErr: apptask.ErrInvalidMovePosition, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "unexpected", useCaseErr: errors.New("boom"), withToken: true, expectedCode: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockMoveTaskUseCase(ctrl)
+
+			ctx := context.Background()
+			if tt.withToken {
+				ctx = ctxWithSessionToken(t, "token")
+
+				mockUseCase.EXPECT().MoveTask(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewService(nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil, nil)
+
+			_, err := svc.MoveTask(ctx, &taskv1.MoveTaskRequest{TaskId: "task-id-1"})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestSearchTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockSearchTasksUseCase(ctrl)
+	mockUseCase.EXPECT().SearchTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.SearchTasksRequest) (*apptask.SearchTasksResult, error) {
+			if req.SessionToken != "token" || req.Text != "groceries" || req.PageSize != 10 || req.PageToken != "page-token" {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.SearchTasksResult{
+				Hits: []apptask.SearchHitItem{
+					{
+						Task: &apptask.TaskItem{
+							TaskID:     "task-id-1",
+							TaskType:   domaintask.TypeNear,
+							TaskStatus: domaintask.StatusActive,
+							CreatedAt:  now,
+							TargetAt:   now.Add(time.Hour),
+							Color:      "#FF6B6B",
+						},
+						Score:          0.75,
+						TitleHighlight: "Buy <mark>groceries</mark>",
+					},
+					{
+						CompletedTask: &apptask.CompletedTaskItem{
+							TaskID:      "task-id-2",
+							TaskType:    domaintask.TypeShort,
+							CreatedAt:   now,
+							TargetAt:    now,
+							Color:       "#4ECDC4",
+							CompletedAt: now,
+						},
+						Score:                0.25,
+						DescriptionHighlight: "<mark>groceries</mark> and bread",
+					},
+				},
+				NextPageToken: "next-token",
+			}, nil
+		})
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil)
+
+	resp, err := svc.SearchTasks(ctxWithSessionToken(t, "token"), &taskv1.SearchTasksRequest{
+		Query:     "groceries",
+		PageSize:  10,
+		PageToken: "page-token",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetHits()) != 2 {
+		t.Fatalf("expected 2 hits, got %d", len(resp.GetHits()))
+	}
+
+	if resp.GetHits()[0].GetActiveTask().GetTaskId() != "task-id-1" {
+		t.Errorf("expected active task task-id-1, got %v", resp.GetHits()[0].GetTask())
+	}
+
+	if resp.GetHits()[0].GetTitleHighlight() != "Buy <mark>groceries</mark>" {
+		t.Errorf("unexpected title highlight %q", resp.GetHits()[0].GetTitleHighlight())
+	}
+
+	if resp.GetHits()[1].GetCompletedTask().GetTaskId() != "task-id-2" {
+		t.Errorf("expected completed task task-id-2, got %v", resp.GetHits()[1].GetTask())
+	}
+
+	if resp.GetNextPageToken() != "next-token" {
+		t.Errorf("expected next page token next-token, got %s", resp.GetNextPageToken())
+	}
+}
+
+func TestSearchTasksError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		withToken    bool
+		expectedCode connect.Code
+	}{
+		{name: "missing session token", withToken: false, expectedCode: connect.CodeUnauthenticated},
+		{name: "unauthorized", useCaseErr: apptask.ErrUnauthorized, withToken: true, expectedCode: connect.CodeUnauthenticated},
+		{name: "empty text", useCaseErr: apptask.ErrSearchTextEmpty, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "invalid page token", useCaseErr: apptask.ErrInvalidPageToken, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "unexpected", useCaseErr: errors.New("boom"), withToken: true, expectedCode: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockSearchTasksUseCase(ctrl)
+
+			ctx := context.Background()
+			if tt.withToken {
+				ctx = ctxWithSessionToken(t, "token")
+
+				mockUseCase.EXPECT().SearchTasks(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewService(nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil, nil)
+
+			_, err := svc.SearchTasks(ctx, &taskv1.SearchTasksRequest{Query: "groceries"})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestBatchUpdateTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockBatchUpdateTasksUseCase(ctrl)
+	mockUseCase.EXPECT().BatchUpdateTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.BatchUpdateTasksRequest) (*apptask.BatchUpdateTasksResult, error) {
+			if req.SessionToken != "token" || len(req.Updates) != 2 {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			if req.Updates[0].Title == nil || *req.Updates[0].Title != "Renamed" {
+				t.Fatalf("expected the update mask to be applied, got %+v", req.Updates[0])
+			}
+
+			return &apptask.BatchUpdateTasksResult{
+				Results: []apptask.BatchUpdateTaskResult{
+					{
+						TaskID: "task-id-1",
+						Task: &apptask.UpdateTaskResult{
+							TaskID:     "task-id-1",
+							Title:      "Renamed",
+							TaskType:   domaintask.TypeNear,
+							TaskStatus: domaintask.StatusActive,
+							CreatedAt:  now,
+							TargetAt:   now.Add(time.Hour),
+							Color:      "#FF6B6B",
+						},
+					},
+					{TaskID: "task-id-2", Err: apptask.ErrTaskNotFound},
+				},
+			}, nil
+		})
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil, nil)
+
+	resp, err := svc.BatchUpdateTasks(ctxWithSessionToken(t, "token"), &taskv1.BatchUpdateTasksRequest{
+		Updates: []*taskv1.UpdateTaskRequest{
+			{TaskId: "task-id-1", Title: "Renamed", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+			{TaskId: "task-id-2", Title: "Renamed", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetResults()) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(resp.GetResults()))
+	}
+
+	if got := resp.GetResults()[0].GetUpdated().GetTask().GetTitle(); got != "Renamed" {
+		t.Errorf("expected updated task, got %q", got)
+	}
+
+	if got := resp.GetResults()[1].GetError().GetCode(); got != connect.CodeNotFound.String() {
+		t.Errorf("expected not_found, got %q", got)
+	}
+}
+
+func TestBatchDeleteTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUseCase := NewMockBatchDeleteTasksUseCase(ctrl)
+	mockUseCase.EXPECT().BatchDeleteTasks(gomock.Any(), &apptask.BatchDeleteTasksRequest{
+		SessionToken: "token",
+		TaskIDs:      []string{"task-id-1", "task-id-2", "task-id-1"},
+	}).Return(&apptask.BatchDeleteTasksResult{
+		Results: []apptask.BatchDeleteTaskResult{
+			{TaskID: "task-id-1"},
+			{TaskID: "task-id-2", Err: domaintask.ErrIDInvalidFormat},
+			{TaskID: "task-id-1", Err: apptask.ErrDuplicateTaskInBatch},
+		},
+	}, nil)
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil)
+
+	resp, err := svc.BatchDeleteTasks(ctxWithSessionToken(t, "token"), &taskv1.BatchDeleteTasksRequest{
+		TaskIds: []string{"task-id-1", "task-id-2", "task-id-1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := resp.GetResults()
+	if len(results) != 3 || results[0].Error != nil {
+		t.Fatalf("expected the first task to be deleted, got %v", results)
+	}
+
+	for _, result := range results[1:] {
+		if result.GetError().GetCode() != connect.CodeInvalidArgument.String() {
+			t.Errorf("expected invalid_argument for %s, got %q", result.GetTaskId(), result.GetError().GetCode())
+		}
+	}
+}
+
+func TestBatchTasksError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		withToken    bool
+		expectedCode connect.Code
+	}{
+		{name: "missing session token", withToken: false, expectedCode: connect.CodeUnauthenticated},
+		{name: "unauthorized", useCaseErr: apptask.ErrUnauthorized, withToken: true, expectedCode: connect.CodeUnauthenticated},
+		{name: "empty batch", useCaseErr: apptask.ErrBatchEmpty, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "too large", useCaseErr: apptask.ErrBatchTooLarge, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "cancel failed", useCaseErr: apptask.ErrCancelRemindFailed, withToken: true, expectedCode: connect.CodeUnavailable},
+		{name: "version conflict", useCaseErr: apptask.ErrTaskVersionConflict, withToken: true, expectedCode: connect.CodeAborted},
+		{name: "unexpected", useCaseErr: errors.New("boom"), withToken: true, expectedCode: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockBatchDeleteTasksUseCase(ctrl)
+
+			ctx := context.Background()
+			if tt.withToken {
+				ctx = ctxWithSessionToken(t, "token")
+
+				mockUseCase.EXPECT().BatchDeleteTasks(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil, nil, nil)
+
+			_, err := svc.BatchDeleteTasks(ctx, &taskv1.BatchDeleteTasksRequest{TaskIds: []string{"task-id-1"}})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func newWatchTasksClient(t *testing.T, useCase apptask.WatchTasksUseCase) taskv1connect.TaskServiceClient {
+	t.Helper()
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, useCase, nil, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle(taskv1connect.NewTaskServiceHandler(svc, connect.WithInterceptors(interceptor.AuthInterceptor())))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return taskv1connect.NewTaskServiceClient(server.Client(), server.URL)
+}
+
+func TestWatchTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	occurredAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	mockUseCase := NewMockWatchTasksUseCase(ctrl)
+	mockUseCase.EXPECT().
+		WatchTasks(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.WatchTasksRequest, send func(apptask.TaskEventItem) error) error {
+			if req.SessionToken != "valid-token" {
+				t.Errorf("unexpected session token %q", req.SessionToken)
+			}
+
+			events := []apptask.TaskEventItem{
+				{
+					Type:   taskevent.TypeCreated,
+					TaskID: "task-1",
+					Task: &apptask.TaskItem{
+						TaskID:     "task-1",
+						Title:      "Watched",
+						TaskType:   domaintask.TypeNear,
+						TaskStatus: domaintask.StatusActive,
+						CreatedAt:  occurredAt,
+						TargetAt:   occurredAt.Add(time.Hour),
+						Color:      "#FF6B6B",
+					},
+					OccurredAt: occurredAt,
+				},
+				{Type: taskevent.TypeDeleted, TaskID: "task-2", Task: nil, OccurredAt: occurredAt},
+			}
+
+			for _, event := range events {
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+
+			return apptask.ErrTaskEventStreamClosed
+		})
+
+	client := newWatchTasksClient(t, mockUseCase)
+
+	ctx, callInfo := connect.NewClientContext(context.Background())
+	callInfo.RequestHeader().Set("Authorization", "Bearer valid-token")
+
+	stream, err := client.WatchTasks(ctx, &taskv1.WatchTasksRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var events []*taskv1.TaskEvent
+	for stream.Receive() {
+		events = append(events, stream.Msg())
+	}
+
+	if connect.CodeOf(stream.Err()) != connect.CodeUnavailable {
+		t.Fatalf("expected the stream to end with unavailable, got %v", stream.Err())
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	created := events[0]
+	if created.GetType() != taskv1.TaskEventType_TASK_EVENT_TYPE_CREATED ||
+		created.GetTask().GetTitle() != "Watched" ||
+		!created.GetOccurredAt().AsTime().Equal(occurredAt) {
+		t.Errorf("unexpected created event: %v", created)
+	}
+
+	deleted := events[1]
+	if deleted.GetType() != taskv1.TaskEventType_TASK_EVENT_TYPE_DELETED || deleted.GetTaskId() != "task-2" || deleted.Task != nil {
+		t.Errorf("unexpected deleted event: %v", deleted)
+	}
+}
+
+func TestWatchTasksError(t *testing.T) {
+	t.Run("missing session token", func(t *testing.T) {
+		client := newWatchTasksClient(t, nil)
+
+		stream, err := client.WatchTasks(context.Background(), &taskv1.WatchTasksRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for stream.Receive() {
+			t.Fatalf("unexpected event: %v", stream.Msg())
+		}
+
+		if connect.CodeOf(stream.Err()) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", stream.Err())
+		}
+	})
+
+	t.Run("unauthorized session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockWatchTasksUseCase(ctrl)
+		mockUseCase.EXPECT().WatchTasks(gomock.Any(), gomock.Any(), gomock.Any()).Return(apptask.ErrUnauthorized)
+
+		client := newWatchTasksClient(t, mockUseCase)
+
+		ctx, callInfo := connect.NewClientContext(context.Background())
+		callInfo.RequestHeader().Set("Authorization", "Bearer expired-token")
+
+		stream, err := client.WatchTasks(ctx, &taskv1.WatchTasksRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for stream.Receive() {
+			t.Fatalf("unexpected event: %v", stream.Msg())
+		}
+
+		if connect.CodeOf(stream.Err()) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", stream.Err())
+		}
+	})
+}
+
+func TestSyncTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockSyncTasksUseCase(ctrl)
+	mockUseCase.EXPECT().SyncTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.SyncTasksRequest) (*apptask.SyncTasksResult, error) {
+			if req.SessionToken != "token" || req.SinceToken != "since-token" || req.PageSize != 100 {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.SyncTasksResult{
+				Tasks: []apptask.TaskItem{{
+					TaskID:     "task-id-1",
+					TaskType:   domaintask.TypeNear,
+					TaskStatus: domaintask.StatusActive,
+					CreatedAt:  now,
+					TargetAt:   now.Add(time.Hour),
+					Color:      "#FF6B6B",
+				}},
+				Tombstones: []apptask.TombstoneItem{
+					{TaskID: "task-id-2", Reason: domaintask.ChangeTypeCompleted, RemovedAt: now},
+					{TaskID: "task-id-3", Reason: domaintask.ChangeTypeDeleted, RemovedAt: now},
+				},
+				NextSyncToken: "next-token",
+				HasMore:       true,
+			}, nil
+		})
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil)
+
+	resp, err := svc.SyncTasks(ctxWithSessionToken(t, "token"), &taskv1.SyncTasksRequest{
+		SinceToken: "since-token",
+		PageSize:   100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetTasks()) != 1 || resp.GetTasks()[0].GetTaskId() != "task-id-1" {
+		t.Errorf("unexpected tasks: %v", resp.GetTasks())
+	}
+
+	tombstones := resp.GetTombstones()
+	if len(tombstones) != 2 {
+		t.Fatalf("expected 2 tombstones, got %d", len(tombstones))
+	}
+
+	if tombstones[0].GetReason() != taskv1.TaskTombstoneReason_TASK_TOMBSTONE_REASON_COMPLETED ||
+		tombstones[1].GetReason() != taskv1.TaskTombstoneReason_TASK_TOMBSTONE_REASON_DELETED {
+		t.Errorf("unexpected tombstone reasons: %v", tombstones)
+	}
+
+	if !tombstones[0].GetRemovedAt().AsTime().Equal(now) {
+		t.Errorf("expected removed_at %v, got %v", now, tombstones[0].GetRemovedAt().AsTime())
+	}
+
+	if resp.GetNextSyncToken() != "next-token" || !resp.GetHasMore() {
+		t.Errorf("unexpected next token %q (more: %v)", resp.GetNextSyncToken(), resp.GetHasMore())
+	}
+}
+
+func TestSyncTasksError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		withToken    bool
+		expectedCode connect.Code
+	}{
+		{name: "missing session token", withToken: false, expectedCode: connect.CodeUnauthenticated},
+		{name: "unauthorized", useCaseErr: apptask.ErrUnauthorized, withToken: true, expectedCode: connect.CodeUnauthenticated},
+		{name: "invalid sync token", useCaseErr: apptask.ErrInvalidSyncToken, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "expired sync token", useCaseErr: apptask.ErrSyncTokenExpired, withToken: true, expectedCode: connect.CodeFailedPrecondition},
+		{name: "unexpected", useCaseErr: errors.New("boom"), withToken: true, expectedCode: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockSyncTasksUseCase(ctrl)
+
+			ctx := context.Background()
+			if tt.withToken {
+				ctx = ctxWithSessionToken(t, "token")
+
+				mockUseCase.EXPECT().SyncTasks(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase, nil)
+
+			_, err := svc.SyncTasks(ctx, &taskv1.SyncTasksRequest{})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestImportTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mockUseCase := NewMockImportTasksUseCase(ctrl)
+	mockUseCase.EXPECT().ImportTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.ImportTasksRequest) (*apptask.ImportTasksResult, error) {
+			if req.SessionToken != "token" || req.Format != "csv" || string(req.Data) != "title\nTask\n" ||
+				req.DefaultTaskType != domaintask.TypeRelaxed || req.Timezone != "Asia/Tokyo" || !req.DryRun {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.ImportTasksResult{
+				Results: []apptask.ImportTaskResult{
+					{
+						Row:   1,
+						Title: "Task",
+						Task: &apptask.TaskItem{
+							TaskID:     "task-id-1",
+							Title:      "Task",
+							TaskType:   domaintask.TypeRelaxed,
+							TaskStatus: domaintask.StatusActive,
+							CreatedAt:  now,
+							TargetAt:   now.Add(time.Hour),
+							Color:      "#FF6B6B",
+						},
+					},
+					{Row: 2, Title: "Late", Err: domaintask.ErrScheduledAtBeforeCreatedAt},
+				},
+				Imported: 0,
+			}, nil
+		})
+
+	svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase)
+
+	resp, err := svc.ImportTasks(ctxWithSessionToken(t, "token"), &taskv1.ImportTasksRequest{
+		Format:          taskv1.ImportFormat_IMPORT_FORMAT_CSV,
+		Data:            []byte("title\nTask\n"),
+		DefaultTaskType: taskv1.TaskType_TASK_TYPE_RELAXED,
+		DefaultColor:    "#FF6B6B",
+		Timezone:        "Asia/Tokyo",
+		DryRun:          true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := resp.GetResults()
+	if len(results) != 2 || resp.GetImported() != 0 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+
+	if results[0].GetRow() != 1 || results[0].GetTask().GetTaskId() != "task-id-1" {
+		t.Errorf("unexpected first result: %v", results[0])
+	}
+
+	if results[1].GetRow() != 2 || results[1].GetError().GetCode() != connect.CodeInvalidArgument.String() {
+		t.Errorf("unexpected second result: %v", results[1])
+	}
+}
+
+func TestImportTasksError(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       taskv1.ImportFormat
+		useCaseErr   error
+		withToken    bool
+		expectedCode connect.Code
+	}{
+		{name: "missing session token", format: taskv1.ImportFormat_IMPORT_FORMAT_ICS, withToken: false, expectedCode: connect.CodeUnauthenticated},
+		{name: "missing format", format: taskv1.ImportFormat_IMPORT_FORMAT_UNSPECIFIED, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "unauthorized", format: taskv1.ImportFormat_IMPORT_FORMAT_ICS, useCaseErr: apptask.ErrUnauthorized, withToken: true, expectedCode: connect.CodeUnauthenticated},
+		{name: "invalid file", format: taskv1.ImportFormat_IMPORT_FORMAT_ICS, useCaseErr: apptask.ErrInvalidImportICS, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "too many entries", format: taskv1.ImportFormat_IMPORT_FORMAT_ICS, useCaseErr: apptask.ErrImportTooLarge, withToken: true, expectedCode: connect.CodeInvalidArgument},
+		{name: "remind queue failed", format: taskv1.ImportFormat_IMPORT_FORMAT_ICS, useCaseErr: apptask.ErrRemindQueueRegistrationFailed, withToken: true, expectedCode: connect.CodeUnavailable},
+		{name: "unexpected", format: taskv1.ImportFormat_IMPORT_FORMAT_ICS, useCaseErr: errors.New("boom"), withToken: true, expectedCode: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockImportTasksUseCase(ctrl)
+
+			ctx := context.Background()
+			if tt.withToken {
+				ctx = ctxWithSessionToken(t, "token")
+			}
+
+			if tt.useCaseErr != nil {
+				mockUseCase.EXPECT().ImportTasks(gomock.Any(), gomock.Any()).Return(nil, tt.useCaseErr)
+			}
+
+			svc := NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockUseCase)
+
+			_, err := svc.ImportTasks(ctx, &taskv1.ImportTasksRequest{
+				Format:          tt.format,
+				Data:            []byte("BEGIN:VCALENDAR"),
+				DefaultTaskType: taskv1.TaskType_TASK_TYPE_NEAR,
+			})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Fatalf("expected code %v, got %v", tt.expectedCode, connect.CodeOf(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TagService implements the TagService
+type TagService struct {
+	createTag apptask.CreateTagUseCase
+	listTags  apptask.ListTagsUseCase
+	updateTag apptask.UpdateTagUseCase
+	deleteTag apptask.DeleteTagUseCase
+	attachTag apptask.AttachTagUseCase
+	detachTag apptask.DetachTagUseCase
+	logger    *slog.Logger
+}
+
+var _ taskv1connect.TagServiceHandler = (*TagService)(nil)
+
+// NewTagService creates a new TagService
+func NewTagService(
+	createTagUseCase apptask.CreateTagUseCase,
+	listTagsUseCase apptask.ListTagsUseCase,
+	updateTagUseCase apptask.UpdateTagUseCase,
+	deleteTagUseCase apptask.DeleteTagUseCase,
+	attachTagUseCase apptask.AttachTagUseCase,
+	detachTagUseCase apptask.DetachTagUseCase,
+) *TagService {
+	return &TagService{
+		createTag: createTagUseCase,
+		listTags:  listTagsUseCase,
+		updateTag: updateTagUseCase,
+		deleteTag: deleteTagUseCase,
+		attachTag: attachTagUseCase,
+		detachTag: detachTagUseCase,
+		logger:    slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("tag"),
+	}
+}
+
+// CreateTag creates a tag for the user
+func (s *TagService) CreateTag(
+	ctx context.Context,
+	req *taskv1.CreateTagRequest,
+) (*taskv1.CreateTagResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("create tag called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.createTag.CreateTag(ctx, &apptask.CreateTagRequest{
+		SessionToken: token,
+		Name:         req.GetName(),
+		Color:        req.GetColor(),
+	})
+	if err != nil {
+		return nil, s.tagError(err, "create tag")
+	}
+
+	s.logger.Info("tag created", slog.String("tag_id", result.TagID))
+
+	return &taskv1.CreateTagResponse{
+		Tag: tagToProto(*result),
+	}, nil
+}
+
+// ListTags returns all tags of the user
+func (s *TagService) ListTags(
+	ctx context.Context,
+	_ *taskv1.ListTagsRequest,
+) (*taskv1.ListTagsResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("list tags called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.listTags.ListTags(ctx, &apptask.ListTagsRequest{
+		SessionToken: token,
+	})
+	if err != nil {
+		return nil, s.tagError(err, "list tags")
+	}
+
+	return &taskv1.ListTagsResponse{
+		Tags: tagsToProto(result.Tags),
+	}, nil
+}
+
+// UpdateTag renames or recolors a tag
+func (s *TagService) UpdateTag(
+	ctx context.Context,
+	req *taskv1.UpdateTagRequest,
+) (*taskv1.UpdateTagResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("update tag called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.updateTag.UpdateTag(ctx, &apptask.UpdateTagRequest{
+		SessionToken: token,
+		TagID:        req.GetTagId(),
+		Name:         req.Name,
+		Color:        req.Color,
+	})
+	if err != nil {
+		return nil, s.tagError(err, "update tag")
+	}
+
+	s.logger.Info("tag updated", slog.String("tag_id", result.TagID))
+
+	return &taskv1.UpdateTagResponse{
+		Tag: tagToProto(*result),
+	}, nil
+}
+
+// DeleteTag deletes a tag and detaches it from every task
+func (s *TagService) DeleteTag(
+	ctx context.Context,
+	req *taskv1.DeleteTagRequest,
+) (*taskv1.DeleteTagResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("delete tag called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	if err := s.deleteTag.DeleteTag(ctx, &apptask.DeleteTagRequest{
+		SessionToken: token,
+		TagID:        req.GetTagId(),
+	}); err != nil {
+		return nil, s.tagError(err, "delete tag")
+	}
+
+	s.logger.Info("tag deleted", slog.String("tag_id", req.GetTagId()))
+
+	return &taskv1.DeleteTagResponse{}, nil
+}
+
+// AttachTag attaches a tag to an active task
+func (s *TagService) AttachTag(
+	ctx context.Context,
+	req *taskv1.AttachTagRequest,
+) (*taskv1.AttachTagResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("attach tag called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.attachTag.AttachTag(ctx, &apptask.AttachTagRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		TagID:        req.GetTagId(),
+	})
+	if err != nil {
+		return nil, s.tagError(err, "attach tag")
+	}
+
+	s.logger.Info("tag attached", slog.String("task_id", result.TaskID))
+
+	return &taskv1.AttachTagResponse{
+		Tags: tagsToProto(result.Tags),
+	}, nil
+}
+
+// DetachTag detaches a tag from an active task
+func (s *TagService) DetachTag(
+	ctx context.Context,
+	req *taskv1.DetachTagRequest,
+) (*taskv1.DetachTagResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("detach tag called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.detachTag.DetachTag(ctx, &apptask.DetachTagRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+		TagID:        req.GetTagId(),
+	})
+	if err != nil {
+		return nil, s.tagError(err, "detach tag")
+	}
+
+	s.logger.Info("tag detached", slog.String("task_id", result.TaskID))
+
+	return &taskv1.DetachTagResponse{
+		Tags: tagsToProto(result.Tags),
+	}, nil
+}
+
+// tagError maps a tag use case error to a Connect error. All tag RPCs fail the same way,
+// so they share the mapping.
+func (s *TagService) tagError(err error, operation string) error {
+	switch {
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized " + operation + " attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrTaskNotFound),
+		errors.Is(err, apptask.ErrTagNotFound):
+		s.logger.Info("not found during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, apptask.ErrTagNameAlreadyExists):
+		s.logger.Info("tag name already exists during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, apptask.ErrTooManyTags):
+		s.logger.Info("too many tags during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, apptask.ErrCreateTagRequestRequired),
+		errors.Is(err, apptask.ErrListTagsRequestRequired),
+		errors.Is(err, apptask.ErrUpdateTagRequestRequired),
+		errors.Is(err, apptask.ErrDeleteTagRequestRequired),
+		errors.Is(err, apptask.ErrAttachTagRequestRequired),
+		errors.Is(err, apptask.ErrDetachTagRequestRequired),
+		errors.Is(err, apptask.ErrTaskIDRequired),
+		errors.Is(err, apptask.ErrTagIDRequired),
+		errors.Is(err, apptask.ErrTagNameEmpty),
+		errors.Is(err, apptask.ErrTagNameTooLong),
+		errors.Is(err, domaintask.ErrNoFieldsToUpdate),
+		errors.Is(err, domaintask.ErrColorEmpty),
+		errors.Is(err, domaintask.ErrColorInvalidFormat),
+		errors.Is(err, domaintask.ErrIDInvalidFormat),
+		errors.Is(err, domaintask.ErrIDInvalidV7),
+		errors.Is(err, domaintask.ErrTagIDInvalidFormat),
+		errors.Is(err, domaintask.ErrTagIDInvalidV7):
+		s.logger.Warn("invalid "+operation+" request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected "+operation+" error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func tagToProto(tag apptask.TagItem) *taskv1.Tag {
+	return &taskv1.Tag{
+		TagId:     tag.TagID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: timestamppb.New(tag.CreatedAt),
+	}
+}
+
+func tagsToProto(tags []apptask.TagItem) []*taskv1.Tag {
+	protoTags := make([]*taskv1.Tag, 0, len(tags))
+	for _, tag := range tags {
+		protoTags = append(protoTags, tagToProto(tag))
+	}
+
+	return protoTags
+}
@@ -0,0 +1,153 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAttachTagSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	mockUseCase := NewMockAttachTagUseCase(ctrl)
+	mockUseCase.EXPECT().
+		AttachTag(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.AttachTagRequest) (*apptask.TaskTagsResult, error) {
+			if req.SessionToken != "valid-token" || req.TaskID != "task-1" || req.TagID != "tag-1" {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.TaskTagsResult{
+				TaskID: "task-1",
+				Tags: []apptask.TagItem{
+					{TagID: "tag-2", Name: "home", Color: "#FF6B6B", CreatedAt: createdAt},
+					{TagID: "tag-1", Name: "work", Color: "#4ECDC4", CreatedAt: createdAt},
+				},
+			}, nil
+		})
+
+	svc := NewTagService(nil, nil, nil, nil, mockUseCase, nil)
+	ctx := ctxWithSessionToken(t, "valid-token")
+
+	resp, err := svc.AttachTag(ctx, &taskv1.AttachTagRequest{
+		TaskId: "task-1",
+		TagId:  "tag-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetTags()) != 2 || resp.GetTags()[1].GetName() != "work" || resp.GetTags()[1].GetColor() != "#4ECDC4" {
+		t.Fatalf("unexpected tags: %v", resp.GetTags())
+	}
+
+	if !resp.GetTags()[0].GetCreatedAt().AsTime().Equal(createdAt) {
+		t.Errorf("unexpected created at: %v", resp.GetTags()[0].GetCreatedAt())
+	}
+}
+
+func TestTagServiceError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "unauthorized",
+			useCaseErr:   apptask.ErrUnauthorized,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "tag not found",
+			useCaseErr:   apptask.ErrTagNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "duplicate name",
+			useCaseErr:   apptask.ErrTagNameAlreadyExists,
+			expectedCode: connect.CodeAlreadyExists,
+		},
+		{
+			name:         "empty name",
+			useCaseErr:   apptask.ErrTagNameEmpty,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "nothing to update",
+			useCaseErr:   domaintask.ErrNoFieldsToUpdate,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "invalid tag ID",
+			useCaseErr:   domaintask.ErrTagIDInvalidFormat,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "unexpected error",
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockUpdateTagUseCase(ctrl)
+			mockUseCase.EXPECT().
+				UpdateTag(gomock.Any(), gomock.Any()).
+				Return(nil, tt.useCaseErr)
+
+			svc := NewTagService(nil, nil, mockUseCase, nil, nil, nil)
+			ctx := ctxWithSessionToken(t, "valid-token")
+
+			_, err := svc.UpdateTag(ctx, &taskv1.UpdateTagRequest{TagId: "tag-1"})
+
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("expected connect error, got %v", err)
+			}
+
+			if connectErr.Code() != tt.expectedCode {
+				t.Errorf("expected code %v, got %v", tt.expectedCode, connectErr.Code())
+			}
+		})
+	}
+
+	t.Run("too many tags", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUseCase := NewMockAttachTagUseCase(ctrl)
+		mockUseCase.EXPECT().
+			AttachTag(gomock.Any(), gomock.Any()).
+			Return(nil, apptask.ErrTooManyTags)
+
+		svc := NewTagService(nil, nil, nil, nil, mockUseCase, nil)
+
+		_, err := svc.AttachTag(ctxWithSessionToken(t, "valid-token"), &taskv1.AttachTagRequest{TaskId: "task-1", TagId: "tag-1"})
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected failed precondition, got %v", err)
+		}
+	})
+
+	t.Run("missing session token", func(t *testing.T) {
+		svc := NewTagService(nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.ListTags(context.Background(), &taskv1.ListTagsRequest{})
+		if connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", err)
+		}
+	})
+}
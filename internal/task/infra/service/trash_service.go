@@ -0,0 +1,143 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TrashService implements the TaskTrashService
+type TrashService struct {
+	listDeletedTasks apptask.ListDeletedTasksUseCase
+	restoreTask      apptask.RestoreTaskUseCase
+	logger           *slog.Logger
+}
+
+var _ taskv1connect.TaskTrashServiceHandler = (*TrashService)(nil)
+
+// NewTrashService creates a new TrashService
+func NewTrashService(
+	listDeletedTasksUseCase apptask.ListDeletedTasksUseCase,
+	restoreTaskUseCase apptask.RestoreTaskUseCase,
+) *TrashService {
+	return &TrashService{
+		listDeletedTasks: listDeletedTasksUseCase,
+		restoreTask:      restoreTaskUseCase,
+		logger:           slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("trash"),
+	}
+}
+
+// ListDeletedTasks lists the tasks in the user's trash, most recently deleted first
+func (s *TrashService) ListDeletedTasks(
+	ctx context.Context,
+	req *taskv1.ListDeletedTasksRequest,
+) (*taskv1.ListDeletedTasksResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("list deleted tasks called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.listDeletedTasks.ListDeletedTasks(ctx, &apptask.ListDeletedTasksRequest{
+		SessionToken: token,
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, s.trashError(err, "list deleted tasks")
+	}
+
+	protoDeletedTasks := make([]*taskv1.DeletedTask, 0, len(result.DeletedTasks))
+	for _, deletedTask := range result.DeletedTasks {
+		protoDeletedTasks = append(protoDeletedTasks, &taskv1.DeletedTask{
+			Task:      toProtoTask(deletedTask.Task),
+			DeletedAt: timestamppb.New(deletedTask.DeletedAt),
+		})
+	}
+
+	s.logger.Info("deleted tasks listed", slog.Int("count", len(protoDeletedTasks)))
+
+	return &taskv1.ListDeletedTasksResponse{
+		DeletedTasks:  protoDeletedTasks,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// RestoreTask moves a task out of the trash and registers its reminders again
+func (s *TrashService) RestoreTask(
+	ctx context.Context,
+	req *taskv1.RestoreTaskRequest,
+) (*taskv1.RestoreTaskResponse, error) {
+	token := extractSessionTokenFromContext(ctx)
+	if token == "" {
+		s.logger.Warn("restore task called without session token")
+
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("session token required"))
+	}
+
+	result, err := s.restoreTask.RestoreTask(ctx, &apptask.RestoreTaskRequest{
+		SessionToken: token,
+		TaskID:       req.GetTaskId(),
+	})
+	if err != nil {
+		return nil, s.trashError(err, "restore task")
+	}
+
+	s.logger.Info("task restored", slog.String("task_id", result.Task.TaskID))
+
+	return &taskv1.RestoreTaskResponse{
+		Task: toProtoTask(result.Task),
+	}, nil
+}
+
+// trashError maps a trash use case error to a Connect error.
+func (s *TrashService) trashError(err error, operation string) error {
+	switch {
+	case errors.Is(err, apptask.ErrUnauthorized):
+		s.logger.Info("unauthorized " + operation + " attempt")
+
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, apptask.ErrAuthServiceUnavailable):
+		s.logger.Error("auth service unavailable during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrDeviceServiceUnavailable):
+		s.logger.Error("device service unavailable during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrDeviceInvalidArgument):
+		s.logger.Error("device service invalid argument during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, apptask.ErrRemindQueueRegistrationFailed):
+		s.logger.Error("remind queue registration failed during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, apptask.ErrDeletedTaskNotFound):
+		s.logger.Info("deleted task not found during "+operation, slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, apptask.ErrListDeletedTasksRequestRequired),
+		errors.Is(err, apptask.ErrRestoreTaskRequestRequired),
+		errors.Is(err, apptask.ErrTaskIDRequired),
+		errors.Is(err, apptask.ErrInvalidPageSize),
+		errors.Is(err, apptask.ErrInvalidPageToken),
+		errors.Is(err, domaintask.ErrIDInvalidFormat),
+		errors.Is(err, domaintask.ErrIDInvalidV7):
+		s.logger.Warn("invalid "+operation+" request", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		s.logger.Error("unexpected "+operation+" error", slog.String("error", err.Error()))
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,127 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	taskv1 "github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1"
+	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDeletedTasksSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deletedAt := time.Now().UTC().Truncate(time.Microsecond)
+
+	mockUseCase := NewMockListDeletedTasksUseCase(ctrl)
+	mockUseCase.EXPECT().
+		ListDeletedTasks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *apptask.ListDeletedTasksRequest) (*apptask.ListDeletedTasksResult, error) {
+			if req.SessionToken != "valid-token" || req.PageSize != 10 || req.PageToken != "page-2" {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+
+			return &apptask.ListDeletedTasksResult{
+				DeletedTasks: []apptask.DeletedTaskItem{{
+					Task: apptask.TaskItem{
+						TaskID:     "task-1",
+						Title:      "Trashed",
+						TaskType:   domaintask.TypeNear,
+						TaskStatus: domaintask.StatusActive,
+						CreatedAt:  deletedAt.Add(-time.Hour),
+						TargetAt:   deletedAt.Add(time.Hour),
+						Color:      "#FF6B6B",
+					},
+					DeletedAt: deletedAt,
+				}},
+				NextPageToken: "page-3",
+			}, nil
+		})
+
+	svc := NewTrashService(mockUseCase, nil)
+
+	resp, err := svc.ListDeletedTasks(ctxWithSessionToken(t, "valid-token"), &taskv1.ListDeletedTasksRequest{
+		PageSize:  10,
+		PageToken: "page-2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.GetDeletedTasks()) != 1 || resp.GetNextPageToken() != "page-3" {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+
+	deleted := resp.GetDeletedTasks()[0]
+	if deleted.GetTask().GetTaskId() != "task-1" || !deleted.GetDeletedAt().AsTime().Equal(deletedAt) {
+		t.Errorf("unexpected deleted task: %v", deleted)
+	}
+}
+
+func TestTrashServiceError(t *testing.T) {
+	tests := []struct {
+		name         string
+		useCaseErr   error
+		expectedCode connect.Code
+	}{
+		{
+			name:         "unauthorized",
+			useCaseErr:   apptask.ErrUnauthorized,
+			expectedCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:         "not in trash",
+			useCaseErr:   apptask.ErrDeletedTaskNotFound,
+			expectedCode: connect.CodeNotFound,
+		},
+		{
+			name:         "remind registration failed",
+			useCaseErr:   apptask.ErrRemindQueueRegistrationFailed,
+			expectedCode: connect.CodeUnavailable,
+		},
+		{
+			name:         "invalid task ID",
+			useCaseErr:   domaintask.ErrIDInvalidFormat,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:         "unexpected error",
+			useCaseErr:   errors.New("database error"),
+			expectedCode: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := NewMockRestoreTaskUseCase(ctrl)
+			mockUseCase.EXPECT().
+				RestoreTask(gomock.Any(), gomock.Any()).
+				Return(nil, tt.useCaseErr)
+
+			svc := NewTrashService(nil, mockUseCase)
+
+			_, err := svc.RestoreTask(ctxWithSessionToken(t, "valid-token"), &taskv1.RestoreTaskRequest{TaskId: "task-1"})
+			if connect.CodeOf(err) != tt.expectedCode {
+				t.Errorf("expected code %v, got %v", tt.expectedCode, err)
+			}
+		})
+	}
+
+	t.Run("missing session token", func(t *testing.T) {
+		svc := NewTrashService(nil, nil)
+
+		_, err := svc.ListDeletedTasks(context.Background(), &taskv1.ListDeletedTasksRequest{})
+		if connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", err)
+		}
+	})
+}
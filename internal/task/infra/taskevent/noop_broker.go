@@ -0,0 +1,29 @@
+package taskevent
+
+import "context"
+
+// NoopBroker drops published events and never delivers any.
+// It is used when no event fan-out is configured.
+type NoopBroker struct{}
+
+// NewNoopBroker creates a new NoopBroker.
+func NewNoopBroker() *NoopBroker {
+	return &NoopBroker{}
+}
+
+// Publish discards the event.
+func (b *NoopBroker) Publish(_ context.Context, _ Event) error {
+	return nil
+}
+
+// Subscribe returns a channel that stays empty and is closed when ctx is done.
+func (b *NoopBroker) Subscribe(ctx context.Context, _ string) (<-chan Event, error) {
+	events := make(chan Event)
+
+	go func() {
+		<-ctx.Done()
+		close(events)
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,200 @@
+package taskevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	channelPrefix = "task:events:"
+
+	// DefaultWatcherBuffer is how many undelivered events a watcher may hold before it is dropped
+	DefaultWatcherBuffer = 64
+)
+
+func channelName(userID string) string {
+	return channelPrefix + userID
+}
+
+// RedisBroker fans task events out across replicas through Redis pub/sub. Events are
+// published to a per-user channel; each replica holds a single pattern subscription and
+// hands the events to the watchers connected to it.
+type RedisBroker struct {
+	client     *redis.Client
+	bufferSize int
+	mu         sync.Mutex
+	watchers   map[string]map[*watcher]struct{}
+	closed     bool
+	logger     *slog.Logger
+}
+
+type watcher struct {
+	events chan Event
+}
+
+func NewRedisBroker(client *redis.Client, bufferSize int) *RedisBroker {
+	if bufferSize <= 0 {
+		bufferSize = DefaultWatcherBuffer
+	}
+
+	return &RedisBroker{
+		client:     client,
+		bufferSize: bufferSize,
+		mu:         sync.Mutex{},
+		watchers:   make(map[string]map[*watcher]struct{}),
+		closed:     false,
+		logger:     slog.Default().With(slog.String("module", "task")).WithGroup("task").WithGroup("taskevent"),
+	}
+}
+
+// Publish sends the event to every replica watching the event's user.
+func (b *RedisBroker) Publish(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task event: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, channelName(event.UserID), payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish task event: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe registers a watcher for the user's events on this replica.
+func (b *RedisBroker) Subscribe(ctx context.Context, userID string) (<-chan Event, error) {
+	w := &watcher{events: make(chan Event, b.bufferSize)}
+
+	b.mu.Lock()
+
+	if b.closed {
+		b.mu.Unlock()
+
+		return nil, ErrBrokerClosed
+	}
+
+	if b.watchers[userID] == nil {
+		b.watchers[userID] = make(map[*watcher]struct{})
+	}
+
+	b.watchers[userID][w] = struct{}{}
+
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.remove(userID, w)
+	}()
+
+	return w.events, nil
+}
+
+// Run receives events from Redis and dispatches them to local watchers until ctx is
+// cancelled. Every watcher is closed when it returns, so open streams end with it.
+func (b *RedisBroker) Run(ctx context.Context) {
+	ctx = logging.WithModule(ctx, logging.Module("task"))
+
+	pubsub := b.client.PSubscribe(ctx, channelPrefix+"*")
+
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			b.logger.Warn("failed to close task event subscription", slog.String("error", err.Error()))
+		}
+
+		b.closeAll()
+	}()
+
+	b.logger.Info("task event broker started")
+
+	// The channel reconnects and resubscribes on its own if the Redis connection drops
+	messages := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.logger.Info("task event broker stopped")
+
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				b.logger.Warn("task event subscription closed")
+
+				return
+			}
+
+			b.dispatch(msg)
+		}
+	}
+}
+
+func (b *RedisBroker) dispatch(msg *redis.Message) {
+	var event Event
+	if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+		b.logger.Warn("dropping malformed task event",
+			slog.String("channel", msg.Channel),
+			slog.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	// The channel is authoritative for who the event belongs to
+	event.UserID = strings.TrimPrefix(msg.Channel, channelPrefix)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for w := range b.watchers[event.UserID] {
+		select {
+		case w.events <- event:
+		default:
+			// A watcher that cannot keep up is dropped; its client reconnects and refetches
+			b.logger.Warn("dropping task event watcher that fell behind", slog.String("user_id", event.UserID))
+
+			b.removeLocked(event.UserID, w)
+		}
+	}
+}
+
+func (b *RedisBroker) remove(userID string, w *watcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.removeLocked(userID, w)
+}
+
+func (b *RedisBroker) removeLocked(userID string, w *watcher) {
+	userWatchers := b.watchers[userID]
+	if _, ok := userWatchers[w]; !ok {
+		return
+	}
+
+	delete(userWatchers, w)
+	close(w.events)
+
+	if len(userWatchers) == 0 {
+		delete(b.watchers, userID)
+	}
+}
+
+func (b *RedisBroker) closeAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	for userID, userWatchers := range b.watchers {
+		for w := range userWatchers {
+			close(w.events)
+		}
+
+		delete(b.watchers, userID)
+	}
+}
@@ -0,0 +1,146 @@
+package taskevent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestMessage(t *testing.T, userID string, event Event) *redis.Message {
+	t.Helper()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("failed to marshal event: %v", err)
+	}
+
+	return &redis.Message{Channel: channelName(userID), Payload: string(payload)}
+}
+
+func receive(t *testing.T, events <-chan Event) (Event, bool) {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+
+		return Event{}, false
+	}
+}
+
+func TestRedisBrokerDispatch(t *testing.T) {
+	t.Run("delivers only the user's events", func(t *testing.T) {
+		broker := NewRedisBroker(nil, 4)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := broker.Subscribe(ctx, "user-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		broker.dispatch(newTestMessage(t, "user-2", Event{Type: TypeCreated, TaskID: "task-2", UserID: "user-2"}))
+		// A payload naming another user is still routed by its channel
+		broker.dispatch(newTestMessage(t, "user-1", Event{Type: TypeDeleted, TaskID: "task-1", UserID: "user-2"}))
+
+		event, ok := receive(t, events)
+		if !ok || event.TaskID != "task-1" || event.Type != TypeDeleted || event.UserID != "user-1" {
+			t.Fatalf("unexpected event %+v (open: %v)", event, ok)
+		}
+
+		cancel()
+
+		if _, ok := receive(t, events); ok {
+			t.Fatal("expected the channel to be closed after ctx is done")
+		}
+	})
+
+	t.Run("drops a watcher that falls behind", func(t *testing.T) {
+		broker := NewRedisBroker(nil, 1)
+
+		events, err := broker.Subscribe(context.Background(), "user-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		broker.dispatch(newTestMessage(t, "user-1", Event{Type: TypeCreated, TaskID: "task-1"}))
+		broker.dispatch(newTestMessage(t, "user-1", Event{Type: TypeUpdated, TaskID: "task-1"}))
+
+		if event, ok := receive(t, events); !ok || event.Type != TypeCreated {
+			t.Fatalf("expected the buffered event first, got %+v (open: %v)", event, ok)
+		}
+
+		if _, ok := receive(t, events); ok {
+			t.Fatal("expected the lagging watcher to be closed")
+		}
+	})
+
+	t.Run("rejects subscriptions after shutdown", func(t *testing.T) {
+		broker := NewRedisBroker(nil, 1)
+
+		events, err := broker.Subscribe(context.Background(), "user-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		broker.closeAll()
+
+		if _, ok := receive(t, events); ok {
+			t.Fatal("expected open watchers to be closed")
+		}
+
+		if _, err := broker.Subscribe(context.Background(), "user-1"); err != ErrBrokerClosed {
+			t.Fatalf("expected ErrBrokerClosed, got %v", err)
+		}
+	})
+}
+
+func TestRedisBrokerFanOut(t *testing.T) {
+	ctx := context.Background()
+
+	client, cleanup := testutil.SetupRedisContainer(ctx, t)
+	defer cleanup()
+
+	// Two brokers on one Redis stand in for two replicas
+	publisher := NewRedisBroker(client, 0)
+	subscriber := NewRedisBroker(client, 0)
+
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	go subscriber.Run(runCtx)
+
+	events, err := subscriber.Subscribe(runCtx, "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The pattern subscription is set up asynchronously, so publish until it arrives
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		if err := publisher.Publish(ctx, Event{Type: TypeCreated, TaskID: "task-1", UserID: "user-1"}); err != nil {
+			t.Fatalf("failed to publish: %v", err)
+		}
+
+		select {
+		case event := <-events:
+			if event.TaskID != "task-1" || event.Type != TypeCreated {
+				t.Fatalf("unexpected event %+v", event)
+			}
+
+			return
+		case <-time.After(100 * time.Millisecond):
+			if time.Now().After(deadline) {
+				t.Fatal("timed out waiting for event")
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package taskevent
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+//go:generate mockgen -source=taskevent.go -destination=mock_taskevent.go -package=taskevent
+
+var ErrBrokerClosed = errors.New("task event broker closed")
+
+type Type string
+
+const (
+	TypeCreated   Type = "created"
+	TypeUpdated   Type = "updated"
+	TypeCompleted Type = "completed"
+	TypeDeleted   Type = "deleted"
+)
+
+// Event tells a user's watchers that one of their tasks changed. It carries no task
+// snapshot; watchers load the current state themselves.
+type Event struct {
+	Type       Type      `json:"type"`
+	TaskID     string    `json:"task_id"`
+	UserID     string    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+type Subscriber interface {
+	// Subscribe returns the user's events until ctx is done. The channel is closed when the
+	// subscription ends, including when the watcher falls too far behind to be kept.
+	Subscribe(ctx context.Context, userID string) (<-chan Event, error)
+}
+
+type Broker interface {
+	Publisher
+	Subscriber
+}
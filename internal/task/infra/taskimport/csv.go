@@ -0,0 +1,120 @@
+package taskimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// csvColumns maps the header names accepted for each column to the column. Names are
+// matched case-insensitively.
+var csvColumns = map[string]string{
+	"title":        "title",
+	"name":         "title",
+	"summary":      "title",
+	"description":  "description",
+	"notes":        "description",
+	"scheduled_at": "date",
+	"due":          "date",
+	"date":         "date",
+	"start":        "date",
+	"color":        "color",
+}
+
+var csvDateLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseCSV reads a CSV file whose first record is a header naming its columns. Only the
+// title column is required; unknown columns are ignored.
+func parseCSV(text string, loc *time.Location) ([]Entry, error) {
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidCSV
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		column, ok := csvColumns[strings.ToLower(strings.TrimSpace(name))]
+		if _, seen := columns[column]; ok && !seen {
+			columns[column] = i
+		}
+	}
+
+	if _, ok := columns["title"]; !ok {
+		return nil, ErrMissingTitle
+	}
+
+	var entries []Entry
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, ErrInvalidCSV
+		}
+
+		if len(entries) == MaxEntries {
+			return nil, ErrTooManyEntries
+		}
+
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		entry := Entry{
+			Title:       field("title"),
+			Description: field("description"),
+			At:          nil,
+			Color:       field("color"),
+			Err:         nil,
+		}
+
+		if date := field("date"); date != "" {
+			at, err := parseCSVTime(date, loc)
+			if err != nil {
+				entry.Err = err
+			} else {
+				entry.At = &at
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parseCSVTime reads an RFC 3339 time, or a date or time without an offset in loc.
+func parseCSVTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	for _, layout := range csvDateLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrInvalidDate
+}
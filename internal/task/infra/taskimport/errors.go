@@ -0,0 +1,14 @@
+package taskimport
+
+import "errors"
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported import format")
+	ErrTooManyEntries    = errors.New("import cannot contain more than 500 entries")
+	ErrNoEntries         = errors.New("import contains no tasks")
+	ErrInvalidICS        = errors.New("import is not a valid iCalendar file")
+	ErrInvalidCSV        = errors.New("import is not a valid CSV file")
+	ErrMissingTitle      = errors.New("CSV header must have a title column")
+	ErrInvalidDate       = errors.New("entry date could not be parsed")
+	ErrEntryClosed       = errors.New("entry is already completed or cancelled")
+)
@@ -0,0 +1,211 @@
+package taskimport
+
+import (
+	"strings"
+	"time"
+
+	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
+)
+
+// contentLine is an iCalendar property, e.g. DTSTART;TZID=Asia/Tokyo:20261018T103000.
+type contentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICS reads the VTODO and VEVENT components of an iCalendar file. Components nested
+// inside them, such as alarms, are skipped.
+func parseICS(text string, loc *time.Location) ([]Entry, error) {
+	lines := unfoldLines(text)
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, ErrInvalidICS
+	}
+
+	var (
+		entries   []Entry
+		component *icsComponent
+		nested    int
+		closed    bool
+	)
+
+	for _, raw := range lines {
+		line, ok := parseContentLine(raw)
+		if !ok {
+			return nil, ErrInvalidICS
+		}
+
+		switch {
+		case line.name == "BEGIN" && component == nil:
+			kind := strings.ToUpper(line.value)
+			if kind == "VTODO" || kind == "VEVENT" {
+				component = &icsComponent{kind: kind, props: make(map[string]contentLine)}
+			}
+		case line.name == "BEGIN":
+			nested++
+		case line.name == "END" && component != nil && nested > 0:
+			nested--
+		case line.name == "END" && component != nil:
+			entries = append(entries, component.entry(loc))
+			component = nil
+		case line.name == "END" && strings.EqualFold(line.value, "VCALENDAR"):
+			closed = true
+		case component != nil && nested == 0:
+			if _, seen := component.props[line.name]; !seen {
+				component.props[line.name] = line
+			}
+		}
+	}
+
+	if component != nil || !closed {
+		return nil, ErrInvalidICS
+	}
+
+	return entries, nil
+}
+
+type icsComponent struct {
+	kind  string
+	props map[string]contentLine
+}
+
+// entry maps the component onto an entry. An event is dated by its start and a to-do by
+// its due date, falling back to its start.
+func (c *icsComponent) entry(loc *time.Location) Entry {
+	entry := Entry{
+		Title:       unescapeText(c.props["SUMMARY"].value),
+		Description: unescapeText(c.props["DESCRIPTION"].value),
+		At:          nil,
+		Color:       "",
+		Err:         nil,
+	}
+
+	switch strings.ToUpper(c.props["STATUS"].value) {
+	case "COMPLETED", "CANCELLED":
+		entry.Err = ErrEntryClosed
+
+		return entry
+	}
+
+	date, ok := c.props["DUE"]
+	if c.kind == "VEVENT" || !ok {
+		date, ok = c.props["DTSTART"]
+	}
+
+	if !ok {
+		return entry
+	}
+
+	at, err := parseICSTime(date, loc)
+	if err != nil {
+		entry.Err = err
+
+		return entry
+	}
+
+	entry.At = &at
+
+	return entry
+}
+
+// unfoldLines splits text into content lines, joining the lines RFC 5545 folded.
+func unfoldLines(text string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+func parseContentLine(raw string) (contentLine, bool) {
+	// The value starts at the first colon outside a quoted parameter value
+	quoted := false
+	colon := -1
+
+	for i, r := range raw {
+		if r == '"' {
+			quoted = !quoted
+		}
+
+		if r == ':' && !quoted {
+			colon = i
+
+			break
+		}
+	}
+
+	if colon <= 0 {
+		return contentLine{}, false
+	}
+
+	parts := strings.Split(raw[:colon], ";")
+	line := contentLine{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  raw[colon+1:],
+	}
+
+	for _, param := range parts[1:] {
+		name, value, found := strings.Cut(param, "=")
+		if !found {
+			return contentLine{}, false
+		}
+
+		line.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+	}
+
+	return line, true
+}
+
+// parseICSTime reads a DATE or DATE-TIME value. Times in UTC end with Z; others are in the
+// time zone named by TZID, or loc when there is none. A date is midnight in that zone.
+func parseICSTime(line contentLine, loc *time.Location) (time.Time, error) {
+	if tzid, ok := line.params["TZID"]; ok {
+		tz, ok := domaintask.LoadUserLocation(tzid)
+		if !ok {
+			return time.Time{}, ErrInvalidDate
+		}
+
+		loc = tz
+	}
+
+	value := line.value
+
+	layouts := []string{"20060102T150405", "20060102"}
+	if strings.HasSuffix(value, "Z") {
+		layouts = []string{"20060102T150405Z"}
+		loc = time.UTC
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrInvalidDate
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, ";",
+	`\,`, ",",
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,73 @@
+// Package taskimport reads tasks exported by other apps.
+package taskimport
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxEntries is the largest number of entries a single import may contain.
+const MaxEntries = 500
+
+type Format string
+
+const (
+	FormatICS Format = "ics"
+	FormatCSV Format = "csv"
+)
+
+func NewFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatICS, FormatCSV:
+		return Format(format), nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Entry is a task read from an import, in the order it appears in the file. Err is set
+// when the entry itself could not be read; the rest of the import is unaffected.
+type Entry struct {
+	Title       string
+	Description string
+	// At is the entry's date, if it has one
+	At *time.Time
+	// Color is empty unless the file sets one
+	Color string
+	Err   error
+}
+
+// Parse reads the entries of data. Dates without a UTC offset or time zone are read in
+// loc. It fails when the file as a whole cannot be read.
+func Parse(format Format, data []byte, loc *time.Location) ([]Entry, error) {
+	var (
+		entries []Entry
+		err     error
+	)
+
+	// Spreadsheet apps tend to write a byte order mark
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	switch format {
+	case FormatICS:
+		entries, err = parseICS(text, loc)
+	case FormatCSV:
+		entries, err = parseCSV(text, loc)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, ErrNoEntries
+	}
+
+	if len(entries) > MaxEntries {
+		return nil, ErrTooManyEntries
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,204 @@
+package taskimport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseICS(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:Asia/Tokyo",
+		"BEGIN:STANDARD",
+		"DTSTART:19700101T000000",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"SUMMARY:Dentist",
+		"DTSTART;TZID=Asia/Tokyo:20261018T103000",
+		"BEGIN:VALARM",
+		"DESCRIPTION:alarm",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		`SUMMARY:Buy milk\, eggs`,
+		"DESCRIPTION:a long description that was folded ",
+		" across two lines",
+		"DUE:20261019T090000Z",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:Someday",
+		"DUE;VALUE=DATE:20261020",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:Undated",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:Done",
+		"STATUS:COMPLETED",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:Broken date",
+		"DUE:tomorrow",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:Server time",
+		"DUE;TZID=Local:20261021T090000",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	entries, err := Parse(FormatICS, []byte(data), tokyo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entries) != 7 {
+		t.Fatalf("expected 7 entries, got %d", len(entries))
+	}
+
+	event := entries[0]
+	if event.Title != "Dentist" || event.Description != "" || event.At == nil ||
+		!event.At.Equal(time.Date(2026, 10, 18, 10, 30, 0, 0, tokyo)) {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	todo := entries[1]
+	if todo.Title != "Buy milk, eggs" || todo.Description != "a long description that was folded across two lines" ||
+		todo.At == nil || !todo.At.Equal(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected to-do %+v", todo)
+	}
+
+	if at := entries[2].At; at == nil || !at.Equal(time.Date(2026, 10, 20, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("expected a date to be midnight in the import time zone, got %v", at)
+	}
+
+	if entries[3].At != nil || entries[3].Err != nil {
+		t.Errorf("expected an undated entry, got %+v", entries[3])
+	}
+
+	if !errors.Is(entries[4].Err, ErrEntryClosed) {
+		t.Errorf("expected ErrEntryClosed, got %v", entries[4].Err)
+	}
+
+	if !errors.Is(entries[5].Err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate, got %v", entries[5].Err)
+	}
+
+	// Local is the server's time zone, not one the file can name
+	if !errors.Is(entries[6].Err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate for TZID=Local, got %v", entries[6].Err)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	data := "\ufeffTitle,Notes,Due,Color,Priority\n" +
+		"Dentist,,2026-10-18 10:30,#FF6B6B,high\n" +
+		"\"Buy milk, eggs\",\"two\nlines\",2026-10-19T09:00:00Z,,\n" +
+		"Undated\n" +
+		"Broken date,,tomorrow,,\n"
+
+	entries, err := Parse(FormatCSV, []byte(data), time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 entries, got %d", len(entries))
+	}
+
+	if e := entries[0]; e.Title != "Dentist" || e.Color != "#FF6B6B" || e.At == nil ||
+		!e.At.Equal(time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected entry %+v", e)
+	}
+
+	if e := entries[1]; e.Title != "Buy milk, eggs" || e.Description != "two\nlines" || e.At == nil {
+		t.Errorf("unexpected entry %+v", e)
+	}
+
+	if e := entries[2]; e.Title != "Undated" || e.At != nil || e.Err != nil {
+		t.Errorf("unexpected entry %+v", e)
+	}
+
+	if !errors.Is(entries[3].Err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate, got %v", entries[3].Err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	var tooMany strings.Builder
+
+	tooMany.WriteString("title\n")
+
+	for i := range MaxEntries + 1 {
+		fmt.Fprintf(&tooMany, "task %d\n", i)
+	}
+
+	tests := []struct {
+		name        string
+		format      Format
+		data        string
+		expectedErr error
+	}{
+		{
+			name:        "unsupported format",
+			format:      Format("xlsx"),
+			data:        "title\nTask\n",
+			expectedErr: ErrUnsupportedFormat,
+		},
+		{
+			name:        "not a calendar",
+			format:      FormatICS,
+			data:        "BEGIN:VTODO\r\nSUMMARY:Task\r\nEND:VTODO\r\n",
+			expectedErr: ErrInvalidICS,
+		},
+		{
+			name:        "unterminated calendar",
+			format:      FormatICS,
+			data:        "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:Task\r\n",
+			expectedErr: ErrInvalidICS,
+		},
+		{
+			name:        "empty calendar",
+			format:      FormatICS,
+			data:        "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n",
+			expectedErr: ErrNoEntries,
+		},
+		{
+			name:        "missing title column",
+			format:      FormatCSV,
+			data:        "description,due\nsomething,2026-10-18\n",
+			expectedErr: ErrMissingTitle,
+		},
+		{
+			name:        "malformed csv",
+			format:      FormatCSV,
+			data:        "title\n\"unterminated\n",
+			expectedErr: ErrInvalidCSV,
+		},
+		{
+			name:        "too many entries",
+			format:      FormatCSV,
+			data:        tooMany.String(),
+			expectedErr: ErrTooManyEntries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.format, []byte(tt.data), time.UTC); !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -9,18 +9,25 @@ import (
 	"connectrpc.com/connect"
 	"connectrpc.com/otelconnect"
 	"github.com/KasumiMercury/primind-central-backend/internal/gen/task/v1/taskv1connect"
+	"github.com/KasumiMercury/primind-central-backend/internal/idempotency"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/logging"
 	"github.com/KasumiMercury/primind-central-backend/internal/observability/middleware"
 	appperiodsetting "github.com/KasumiMercury/primind-central-backend/internal/task/app/period"
 	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/config"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
 	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/authclient"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/calendarfeed"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/deviceclient"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/interceptor"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/outbox"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/purge"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/reconcile"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
 	tasksvc "github.com/KasumiMercury/primind-central-backend/internal/task/infra/service"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskqueue"
 )
 
@@ -29,12 +36,23 @@ const moduleName logging.Module = "task"
 type Repositories struct {
 	Tasks               domaintask.TaskRepository
 	TaskArchive         domaintask.TaskArchiveRepository
+	TaskSearch          domaintask.TaskSearchRepository
+	TaskSync            domaintask.TaskSyncRepository
+	TaskStats           domaintask.TaskStatsRepository
+	Checklists          domaintask.ChecklistRepository
+	Tags                domaintask.TagRepository
+	CalendarFeedTokens  domaintask.CalendarFeedTokenRepository
+	Transactor          domaintask.Transactor
 	PeriodSettings      period.PeriodSettingRepository
+	ReminderIntervals   period.ReminderIntervalSettingRepository
+	QuietHours          period.QuietHoursSettingRepository
 	AuthClient          authclient.AuthClient
 	DeviceClient        deviceclient.DeviceClient
 	RemindRegisterQueue remindregister.Queue
 	RemindCancelQueue   remindcancel.Queue
 	TaskQueueClient     taskqueue.Client
+	TaskEvents          taskevent.Broker
+	Idempotency         idempotency.Store
 }
 
 func (r *Repositories) Close() error {
@@ -96,13 +114,49 @@ func NewTaskServiceHandler(ctx context.Context, repos Repositories) (string, htt
 		return "", nil, fmt.Errorf("period settings repository is not configured")
 	}
 
-	createTaskUseCase := apptask.NewCreateTaskHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.PeriodSettings, repos.RemindRegisterQueue)
+	if repos.ReminderIntervals == nil {
+		return "", nil, fmt.Errorf("reminder interval settings repository is not configured")
+	}
+
+	if repos.QuietHours == nil {
+		return "", nil, fmt.Errorf("quiet hours settings repository is not configured")
+	}
+
+	if repos.Transactor == nil {
+		return "", nil, fmt.Errorf("transactor is not configured")
+	}
+
+	if repos.TaskSearch == nil {
+		return "", nil, fmt.Errorf("task search repository is not configured")
+	}
+
+	if repos.TaskEvents == nil {
+		return "", nil, fmt.Errorf("task event broker is not configured")
+	}
+
+	if repos.TaskSync == nil {
+		return "", nil, fmt.Errorf("task sync repository is not configured")
+	}
+
+	if repos.Idempotency == nil {
+		return "", nil, fmt.Errorf("idempotency store is not configured")
+	}
+
+	createTaskUseCase := apptask.NewCreateTaskHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.PeriodSettings, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.Transactor, repos.TaskEvents)
 	getTaskUseCase := apptask.NewGetTaskHandler(repos.AuthClient, repos.Tasks)
 	listActiveTasksUseCase := apptask.NewListActiveTasksHandler(repos.AuthClient, repos.Tasks)
-	updateTaskUseCase := apptask.NewUpdateTaskHandler(repos.AuthClient, repos.Tasks, repos.TaskArchive, repos.RemindCancelQueue)
-	deleteTaskUseCase := apptask.NewDeleteTaskHandler(repos.AuthClient, repos.Tasks, repos.RemindCancelQueue)
-
-	taskService := tasksvc.NewService(createTaskUseCase, getTaskUseCase, listActiveTasksUseCase, updateTaskUseCase, deleteTaskUseCase)
+	updateTaskUseCase := apptask.NewUpdateTaskHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.TaskArchive, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.RemindCancelQueue, repos.Transactor, repos.TaskEvents)
+	deleteTaskUseCase := apptask.NewDeleteTaskHandler(repos.AuthClient, repos.Tasks, repos.RemindCancelQueue, repos.Transactor, repos.TaskEvents)
+	snoozeTaskUseCase := apptask.NewSnoozeTaskHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.RemindCancelQueue, repos.Transactor, repos.TaskEvents)
+	moveTaskUseCase := apptask.NewMoveTaskHandler(repos.AuthClient, repos.Tasks, repos.TaskEvents)
+	searchTasksUseCase := apptask.NewSearchTasksHandler(repos.AuthClient, repos.TaskSearch)
+	batchUpdateTasksUseCase := apptask.NewBatchUpdateTasksHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.TaskArchive, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.RemindCancelQueue, repos.Transactor, repos.TaskEvents)
+	batchDeleteTasksUseCase := apptask.NewBatchDeleteTasksHandler(repos.AuthClient, repos.Tasks, repos.RemindCancelQueue, repos.Transactor, repos.TaskEvents)
+	watchTasksUseCase := apptask.NewWatchTasksHandler(repos.AuthClient, repos.Tasks, repos.TaskEvents)
+	syncTasksUseCase := apptask.NewSyncTasksHandler(repos.AuthClient, repos.TaskSync)
+	importTasksUseCase := apptask.NewImportTasksHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.PeriodSettings, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.Transactor, repos.TaskEvents)
+
+	taskService := tasksvc.NewService(createTaskUseCase, getTaskUseCase, listActiveTasksUseCase, updateTaskUseCase, deleteTaskUseCase, snoozeTaskUseCase, moveTaskUseCase, searchTasksUseCase, batchUpdateTasksUseCase, batchDeleteTasksUseCase, watchTasksUseCase, syncTasksUseCase, importTasksUseCase)
 
 	interceptorOpts, err := newInterceptorOptions()
 	if err != nil {
@@ -111,10 +165,245 @@ func NewTaskServiceHandler(ctx context.Context, repos Repositories) (string, htt
 		return "", nil, err
 	}
 
-	taskPath, taskHandler := taskv1connect.NewTaskServiceHandler(taskService, interceptorOpts)
+	// Runs after the auth interceptor, which puts the session token and a session cache on
+	// the context, so the handler reuses the user resolved here
+	idempotencyInterceptor := idempotency.NewInterceptor(
+		repos.Idempotency,
+		func(ctx context.Context) (string, error) {
+			return repos.AuthClient.ValidateSession(ctx, interceptor.ExtractSessionToken(ctx))
+		},
+		taskv1connect.TaskServiceCreateTaskProcedure,
+		taskv1connect.TaskServiceUpdateTaskProcedure,
+		taskv1connect.TaskServiceDeleteTaskProcedure,
+		taskv1connect.TaskServiceImportTasksProcedure,
+	)
+
+	taskPath, taskHandler := taskv1connect.NewTaskServiceHandler(taskService, interceptorOpts, connect.WithInterceptors(idempotencyInterceptor))
 	logger.Info("task service handler registered", slog.String("path", taskPath))
 
-	return taskPath, taskHandler, nil
+	// WatchTasks streams for longer than the server timeouts allow
+	return taskPath, middleware.WithoutDeadlines(taskHandler, taskv1connect.TaskServiceWatchTasksProcedure), nil
+}
+
+// NewCompletedTaskServiceHandler creates and returns the CompletedTaskService HTTP handler.
+// It returns the service path, handler, and any initialization error.
+func NewCompletedTaskServiceHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+	logger := slog.Default().With(
+		slog.String("module", string(moduleName)),
+	).WithGroup("completed_task")
+
+	logger.Debug("initializing completed task service")
+
+	if repos.Tasks == nil {
+		return "", nil, fmt.Errorf("task repository is not configured")
+	}
+
+	if repos.AuthClient == nil {
+		return "", nil, fmt.Errorf("auth client is not configured")
+	}
+
+	if repos.DeviceClient == nil {
+		return "", nil, fmt.Errorf("device client is not configured")
+	}
+
+	if repos.RemindRegisterQueue == nil {
+		return "", nil, fmt.Errorf("remind register queue is not configured")
+	}
+
+	if repos.TaskArchive == nil {
+		return "", nil, fmt.Errorf("task archive repository is not configured")
+	}
+
+	if repos.PeriodSettings == nil {
+		return "", nil, fmt.Errorf("period settings repository is not configured")
+	}
+
+	if repos.ReminderIntervals == nil {
+		return "", nil, fmt.Errorf("reminder interval settings repository is not configured")
+	}
+
+	if repos.QuietHours == nil {
+		return "", nil, fmt.Errorf("quiet hours settings repository is not configured")
+	}
+
+	if repos.Transactor == nil {
+		return "", nil, fmt.Errorf("transactor is not configured")
+	}
+
+	if repos.TaskEvents == nil {
+		return "", nil, fmt.Errorf("task event broker is not configured")
+	}
+
+	if repos.TaskStats == nil {
+		return "", nil, fmt.Errorf("task stats repository is not configured")
+	}
+
+	listCompletedTasksUseCase := apptask.NewListCompletedTasksHandler(repos.AuthClient, repos.TaskArchive)
+	getCompletedTaskUseCase := apptask.NewGetCompletedTaskHandler(repos.AuthClient, repos.TaskArchive)
+	reopenTaskUseCase := apptask.NewReopenTaskHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.TaskArchive, repos.PeriodSettings, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.Transactor, repos.TaskEvents)
+	getTaskStatsUseCase := apptask.NewGetTaskStatsHandler(repos.AuthClient, repos.TaskStats)
+	completedTaskService := tasksvc.NewCompletedTaskService(listCompletedTasksUseCase, getCompletedTaskUseCase, reopenTaskUseCase, getTaskStatsUseCase)
+
+	interceptorOpts, err := newInterceptorOptions()
+	if err != nil {
+		logger.Error("failed to create interceptor options", slog.String("error", err.Error()))
+
+		return "", nil, err
+	}
+
+	completedTaskPath, completedTaskHandler := taskv1connect.NewCompletedTaskServiceHandler(completedTaskService, interceptorOpts)
+	logger.Info("completed task service handler registered", slog.String("path", completedTaskPath))
+
+	return completedTaskPath, completedTaskHandler, nil
+}
+
+// NewTrashServiceHandler creates and returns the TaskTrashService HTTP handler.
+// It returns the service path, handler, and any initialization error.
+func NewTrashServiceHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+	logger := slog.Default().With(
+		slog.String("module", string(moduleName)),
+	).WithGroup("trash")
+
+	logger.Debug("initializing trash service")
+
+	if repos.Tasks == nil {
+		return "", nil, fmt.Errorf("task repository is not configured")
+	}
+
+	if repos.AuthClient == nil {
+		return "", nil, fmt.Errorf("auth client is not configured")
+	}
+
+	if repos.DeviceClient == nil {
+		return "", nil, fmt.Errorf("device client is not configured")
+	}
+
+	if repos.RemindRegisterQueue == nil {
+		return "", nil, fmt.Errorf("remind register queue is not configured")
+	}
+
+	if repos.ReminderIntervals == nil {
+		return "", nil, fmt.Errorf("reminder interval settings repository is not configured")
+	}
+
+	if repos.QuietHours == nil {
+		return "", nil, fmt.Errorf("quiet hours settings repository is not configured")
+	}
+
+	if repos.Transactor == nil {
+		return "", nil, fmt.Errorf("transactor is not configured")
+	}
+
+	if repos.TaskEvents == nil {
+		return "", nil, fmt.Errorf("task event broker is not configured")
+	}
+
+	listDeletedTasksUseCase := apptask.NewListDeletedTasksHandler(repos.AuthClient, repos.Tasks)
+	restoreTaskUseCase := apptask.NewRestoreTaskHandler(repos.AuthClient, repos.DeviceClient, repos.Tasks, repos.ReminderIntervals, repos.QuietHours, repos.RemindRegisterQueue, repos.Transactor, repos.TaskEvents)
+	trashService := tasksvc.NewTrashService(listDeletedTasksUseCase, restoreTaskUseCase)
+
+	interceptorOpts, err := newInterceptorOptions()
+	if err != nil {
+		logger.Error("failed to create interceptor options", slog.String("error", err.Error()))
+
+		return "", nil, err
+	}
+
+	trashPath, trashHandler := taskv1connect.NewTaskTrashServiceHandler(trashService, interceptorOpts)
+	logger.Info("trash service handler registered", slog.String("path", trashPath))
+
+	return trashPath, trashHandler, nil
+}
+
+// NewChecklistServiceHandler creates and returns the TaskChecklistService HTTP handler.
+// It returns the service path, handler, and any initialization error.
+func NewChecklistServiceHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+	logger := slog.Default().With(
+		slog.String("module", string(moduleName)),
+	).WithGroup("checklist")
+
+	logger.Debug("initializing checklist service")
+
+	if repos.AuthClient == nil {
+		return "", nil, fmt.Errorf("auth client is not configured")
+	}
+
+	if repos.Checklists == nil {
+		return "", nil, fmt.Errorf("checklist repository is not configured")
+	}
+
+	if repos.Transactor == nil {
+		return "", nil, fmt.Errorf("transactor is not configured")
+	}
+
+	if repos.TaskEvents == nil {
+		return "", nil, fmt.Errorf("task event broker is not configured")
+	}
+
+	listChecklistItemsUseCase := apptask.NewListChecklistItemsHandler(repos.AuthClient, repos.Checklists)
+	addChecklistItemUseCase := apptask.NewAddChecklistItemHandler(repos.AuthClient, repos.Checklists, repos.Transactor, repos.TaskEvents)
+	toggleChecklistItemUseCase := apptask.NewToggleChecklistItemHandler(repos.AuthClient, repos.Checklists, repos.Transactor, repos.TaskEvents)
+	reorderChecklistItemsUseCase := apptask.NewReorderChecklistItemsHandler(repos.AuthClient, repos.Checklists, repos.Transactor, repos.TaskEvents)
+	deleteChecklistItemUseCase := apptask.NewDeleteChecklistItemHandler(repos.AuthClient, repos.Checklists, repos.Transactor, repos.TaskEvents)
+	checklistService := tasksvc.NewChecklistService(listChecklistItemsUseCase, addChecklistItemUseCase, toggleChecklistItemUseCase, reorderChecklistItemsUseCase, deleteChecklistItemUseCase)
+
+	interceptorOpts, err := newInterceptorOptions()
+	if err != nil {
+		logger.Error("failed to create interceptor options", slog.String("error", err.Error()))
+
+		return "", nil, err
+	}
+
+	checklistPath, checklistHandler := taskv1connect.NewTaskChecklistServiceHandler(checklistService, interceptorOpts)
+	logger.Info("checklist service handler registered", slog.String("path", checklistPath))
+
+	return checklistPath, checklistHandler, nil
+}
+
+// NewTagServiceHandler creates and returns the TagService HTTP handler.
+// It returns the service path, handler, and any initialization error.
+func NewTagServiceHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+	logger := slog.Default().With(
+		slog.String("module", string(moduleName)),
+	).WithGroup("tag")
+
+	logger.Debug("initializing tag service")
+
+	if repos.AuthClient == nil {
+		return "", nil, fmt.Errorf("auth client is not configured")
+	}
+
+	if repos.Tags == nil {
+		return "", nil, fmt.Errorf("tag repository is not configured")
+	}
+
+	if repos.Transactor == nil {
+		return "", nil, fmt.Errorf("transactor is not configured")
+	}
+
+	if repos.TaskEvents == nil {
+		return "", nil, fmt.Errorf("task event broker is not configured")
+	}
+
+	createTagUseCase := apptask.NewCreateTagHandler(repos.AuthClient, repos.Tags)
+	listTagsUseCase := apptask.NewListTagsHandler(repos.AuthClient, repos.Tags)
+	updateTagUseCase := apptask.NewUpdateTagHandler(repos.AuthClient, repos.Tags, repos.Transactor)
+	deleteTagUseCase := apptask.NewDeleteTagHandler(repos.AuthClient, repos.Tags)
+	attachTagUseCase := apptask.NewAttachTagHandler(repos.AuthClient, repos.Tags, repos.Transactor, repos.TaskEvents)
+	detachTagUseCase := apptask.NewDetachTagHandler(repos.AuthClient, repos.Tags, repos.Transactor, repos.TaskEvents)
+	tagService := tasksvc.NewTagService(createTagUseCase, listTagsUseCase, updateTagUseCase, deleteTagUseCase, attachTagUseCase, detachTagUseCase)
+
+	interceptorOpts, err := newInterceptorOptions()
+	if err != nil {
+		logger.Error("failed to create interceptor options", slog.String("error", err.Error()))
+
+		return "", nil, err
+	}
+
+	tagPath, tagHandler := taskv1connect.NewTagServiceHandler(tagService, interceptorOpts)
+	logger.Info("tag service handler registered", slog.String("path", tagPath))
+
+	return tagPath, tagHandler, nil
 }
 
 // NewPeriodSettingsServiceHandler creates and returns the UserPeriodSettingsService HTTP handler.
@@ -134,9 +423,28 @@ func NewPeriodSettingsServiceHandler(ctx context.Context, repos Repositories) (s
 		return "", nil, fmt.Errorf("period settings repository is not configured")
 	}
 
+	if repos.ReminderIntervals == nil {
+		return "", nil, fmt.Errorf("reminder interval settings repository is not configured")
+	}
+
+	if repos.QuietHours == nil {
+		return "", nil, fmt.Errorf("quiet hours settings repository is not configured")
+	}
+
 	getPeriodSettingsUseCase := appperiodsetting.NewGetPeriodSettingsHandler(repos.AuthClient, repos.PeriodSettings)
 	updatePeriodSettingsUseCase := appperiodsetting.NewUpdatePeriodSettingsHandler(repos.AuthClient, repos.PeriodSettings)
-	periodSettingService := tasksvc.NewPeriodSettingService(getPeriodSettingsUseCase, updatePeriodSettingsUseCase)
+	getReminderIntervalsUseCase := appperiodsetting.NewGetReminderIntervalSettingsHandler(repos.AuthClient, repos.ReminderIntervals)
+	updateReminderIntervalsUseCase := appperiodsetting.NewUpdateReminderIntervalSettingsHandler(repos.AuthClient, repos.ReminderIntervals)
+	getQuietHoursUseCase := appperiodsetting.NewGetQuietHoursSettingsHandler(repos.AuthClient, repos.QuietHours)
+	updateQuietHoursUseCase := appperiodsetting.NewUpdateQuietHoursSettingsHandler(repos.AuthClient, repos.QuietHours)
+	periodSettingService := tasksvc.NewPeriodSettingService(
+		getPeriodSettingsUseCase,
+		updatePeriodSettingsUseCase,
+		getReminderIntervalsUseCase,
+		updateReminderIntervalsUseCase,
+		getQuietHoursUseCase,
+		updateQuietHoursUseCase,
+	)
 
 	interceptorOpts, err := newInterceptorOptions()
 	if err != nil {
@@ -150,3 +458,132 @@ func NewPeriodSettingsServiceHandler(ctx context.Context, repos Repositories) (s
 
 	return periodSettingPath, periodSettingHandler, nil
 }
+
+// NewCalendarFeedServiceHandler creates and returns the CalendarFeedService HTTP handler.
+// It returns the service path, handler, and any initialization error.
+func NewCalendarFeedServiceHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+	logger := slog.Default().With(
+		slog.String("module", string(moduleName)),
+	).WithGroup("calendar_feed")
+
+	logger.Debug("initializing calendar feed service")
+
+	if repos.AuthClient == nil {
+		return "", nil, fmt.Errorf("auth client is not configured")
+	}
+
+	if repos.CalendarFeedTokens == nil {
+		return "", nil, fmt.Errorf("calendar feed token repository is not configured")
+	}
+
+	createCalendarFeedTokenUseCase := apptask.NewCreateCalendarFeedTokenHandler(repos.AuthClient, repos.CalendarFeedTokens)
+	rotateCalendarFeedTokenUseCase := apptask.NewRotateCalendarFeedTokenHandler(repos.AuthClient, repos.CalendarFeedTokens)
+	revokeCalendarFeedTokenUseCase := apptask.NewRevokeCalendarFeedTokenHandler(repos.AuthClient, repos.CalendarFeedTokens)
+	calendarFeedService := tasksvc.NewCalendarFeedService(createCalendarFeedTokenUseCase, rotateCalendarFeedTokenUseCase, revokeCalendarFeedTokenUseCase)
+
+	interceptorOpts, err := newInterceptorOptions()
+	if err != nil {
+		logger.Error("failed to create interceptor options", slog.String("error", err.Error()))
+
+		return "", nil, err
+	}
+
+	calendarFeedPath, calendarFeedHandler := taskv1connect.NewCalendarFeedServiceHandler(calendarFeedService, interceptorOpts)
+	logger.Info("calendar feed service handler registered", slog.String("path", calendarFeedPath))
+
+	return calendarFeedPath, calendarFeedHandler, nil
+}
+
+// NewCalendarFeedHTTPHandler creates the plain HTTP handler serving iCalendar feeds.
+// Calendar apps authenticate with the feed token in the path instead of a session token.
+// It returns the ServeMux pattern, handler, and any initialization error.
+func NewCalendarFeedHTTPHandler(ctx context.Context, repos Repositories) (string, http.Handler, error) {
+	logger := slog.Default().With(
+		slog.String("module", string(moduleName)),
+	).WithGroup("calendar_feed")
+
+	if repos.Tasks == nil {
+		return "", nil, fmt.Errorf("task repository is not configured")
+	}
+
+	if repos.TaskArchive == nil {
+		return "", nil, fmt.Errorf("task archive repository is not configured")
+	}
+
+	if repos.CalendarFeedTokens == nil {
+		return "", nil, fmt.Errorf("calendar feed token repository is not configured")
+	}
+
+	getCalendarFeedUseCase := apptask.NewGetCalendarFeedHandler(repos.CalendarFeedTokens, repos.Tasks, repos.TaskArchive)
+
+	logger.Info("calendar feed http handler registered", slog.String("pattern", calendarfeed.Pattern))
+
+	return calendarfeed.Pattern, calendarfeed.NewHandler(getCalendarFeedUseCase), nil
+}
+
+// NewRemindOutboxDispatcher creates the dispatcher that drains the remind outbox into the
+// remind queues created by NewRemindQueues. The caller runs it until shutdown.
+func NewRemindOutboxDispatcher(
+	store outbox.Store,
+	remindQueue remindregister.Queue,
+	cancelRemindQueue remindcancel.Queue,
+	cfg *config.RemindOutboxConfig,
+) (*outbox.Dispatcher, error) {
+	if store == nil {
+		return nil, fmt.Errorf("remind outbox store is not configured")
+	}
+
+	if remindQueue == nil {
+		return nil, fmt.Errorf("remind register queue is not configured")
+	}
+
+	if cancelRemindQueue == nil {
+		return nil, fmt.Errorf("remind cancel queue is not configured")
+	}
+
+	if cfg == nil {
+		return nil, fmt.Errorf("remind outbox config is not configured")
+	}
+
+	dispatcherCfg := outbox.DefaultConfig()
+	dispatcherCfg.PollInterval = cfg.PollInterval
+	dispatcherCfg.MaxAttempts = cfg.MaxAttempts
+
+	return outbox.NewDispatcher(store, remindQueue, cancelRemindQueue, dispatcherCfg), nil
+}
+
+// NewPendingReminderReconciler creates the reconciler that resolves tasks stuck in
+// pending_reminders. It is safe to run on every replica.
+func NewPendingReminderReconciler(store reconcile.Store, cfg *config.PendingReminderConfig) (*reconcile.Reconciler, error) {
+	if store == nil {
+		return nil, fmt.Errorf("pending reminder store is not configured")
+	}
+
+	if cfg == nil {
+		return nil, fmt.Errorf("pending reminder config is not configured")
+	}
+
+	reconcileCfg := reconcile.DefaultConfig()
+	reconcileCfg.Interval = cfg.ReconcileInterval
+	reconcileCfg.StaleAfter = cfg.StaleAfter
+
+	return reconcile.NewReconciler(store, reconcileCfg)
+}
+
+// NewTrashPurger creates the purger that permanently removes deleted tasks once their
+// retention period has ended. It is safe to run on every replica.
+func NewTrashPurger(store purge.Store, cfg *config.TrashConfig) (*purge.Purger, error) {
+	if store == nil {
+		return nil, fmt.Errorf("trash purge store is not configured")
+	}
+
+	if cfg == nil {
+		return nil, fmt.Errorf("trash config is not configured")
+	}
+
+	purgeCfg := purge.DefaultConfig()
+	purgeCfg.Interval = cfg.PurgeInterval
+	purgeCfg.Retention = cfg.Retention
+
+	return purge.NewPurger(store, purgeCfg)
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/KasumiMercury/primind-central-backend/internal/idempotency"
 	apptask "github.com/KasumiMercury/primind-central-backend/internal/task/app/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/domain/period"
 	domaintask "github.com/KasumiMercury/primind-central-backend/internal/task/domain/task"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindcancel"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/remindregister"
 	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/repository"
+	"github.com/KasumiMercury/primind-central-backend/internal/task/infra/taskevent"
 	"github.com/KasumiMercury/primind-central-backend/internal/testutil"
 	"go.uber.org/mock/gomock"
 )
@@ -36,7 +38,14 @@ func TestNewTaskServiceHandlerSuccess(t *testing.T) {
 	path, handler, err := NewTaskServiceHandler(context.Background(), Repositories{
 		Tasks:               repo,
 		TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+		TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+		TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+		TaskEvents:          taskevent.NewMockBroker(ctrl),
+		Idempotency:         idempotency.NewMockStore(ctrl),
+		Transactor:          domaintask.NewMockTransactor(ctrl),
 		PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+		ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+		QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 		AuthClient:          apptask.NewMockAuthClient(ctrl),
 		DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 		RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -71,7 +80,14 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               nil,
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -90,7 +106,10 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         nil,
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -109,7 +128,66 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      nil,
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			name: "missing reminder interval settings repository",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   nil,
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			name: "missing quiet hours settings repository",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          nil,
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -128,7 +206,14 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          nil,
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -147,7 +232,14 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        nil,
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -166,7 +258,14 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: nil,
@@ -185,7 +284,14 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
@@ -195,6 +301,136 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 			ctx:         context.Background(),
 			expectError: true,
 		},
+		{
+			name: "missing transactor",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          nil,
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			name: "missing task search repository",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          nil,
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			name: "missing task event broker",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          nil,
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			name: "missing task sync repository",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            nil,
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			name: "missing idempotency store",
+			repos: func(t *testing.T) Repositories {
+				ctrl := gomock.NewController(t)
+				t.Cleanup(ctrl.Finish)
+
+				return Repositories{
+					Tasks:               setupTaskRepo(t),
+					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         nil,
+					Transactor:          domaintask.NewMockTransactor(ctrl),
+					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
+					AuthClient:          apptask.NewMockAuthClient(ctrl),
+					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
+					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
+					RemindCancelQueue:   remindcancel.NewMockQueue(ctrl),
+				}
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
 		{
 			name: "context canceled",
 			repos: func(t *testing.T) Repositories {
@@ -204,7 +440,14 @@ func TestNewTaskServiceHandlerError(t *testing.T) {
 				return Repositories{
 					Tasks:               setupTaskRepo(t),
 					TaskArchive:         domaintask.NewMockTaskArchiveRepository(ctrl),
+					TaskSearch:          domaintask.NewMockTaskSearchRepository(ctrl),
+					TaskSync:            domaintask.NewMockTaskSyncRepository(ctrl),
+					TaskEvents:          taskevent.NewMockBroker(ctrl),
+					Idempotency:         idempotency.NewMockStore(ctrl),
+					Transactor:          domaintask.NewMockTransactor(ctrl),
 					PeriodSettings:      period.NewMockPeriodSettingRepository(ctrl),
+					ReminderIntervals:   period.NewMockReminderIntervalSettingRepository(ctrl),
+					QuietHours:          period.NewMockQuietHoursSettingRepository(ctrl),
 					AuthClient:          apptask.NewMockAuthClient(ctrl),
 					DeviceClient:        apptask.NewMockDeviceClient(ctrl),
 					RemindRegisterQueue: remindregister.NewMockQueue(ctrl),
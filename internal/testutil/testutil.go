@@ -83,5 +83,11 @@ func SetupPostgresContainer(ctx context.Context, t *testing.T) (*gorm.DB, func()
 		}
 	}
 
+	// Extensions are created by the migrations in production, before any table uses them
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		cleanup()
+		t.Fatalf("failed to create pg_trgm extension: %v", err)
+	}
+
 	return db, cleanup
 }
@@ -0,0 +1,2 @@
+-- Create index "idx_tasks_user_id_target_at" to table: "tasks"
+CREATE INDEX "idx_tasks_user_id_target_at" ON "public"."tasks" ("user_id", "target_at", "id");
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "recurrence_rule" character varying(256) NULL, ADD COLUMN "recurrence_timezone" character varying(64) NULL;
@@ -0,0 +1,17 @@
+-- Create "remind_outbox" table
+CREATE TABLE "public"."remind_outbox" (
+  "id" bigserial NOT NULL,
+  "kind" character varying(32) NOT NULL,
+  "task_id" uuid NOT NULL,
+  "payload" jsonb NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "last_error" text NULL,
+  "failed_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_remind_outbox_next_attempt_at" to table: "remind_outbox"
+CREATE INDEX "idx_remind_outbox_next_attempt_at" ON "public"."remind_outbox" ("next_attempt_at");
+-- Create index "idx_remind_outbox_task_id" to table: "remind_outbox"
+CREATE INDEX "idx_remind_outbox_task_id" ON "public"."remind_outbox" ("task_id");
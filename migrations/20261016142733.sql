@@ -0,0 +1,4 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "updated_at" timestamptz NOT NULL DEFAULT now();
+-- Create index "idx_tasks_task_status_updated_at" to table: "tasks"
+CREATE INDEX "idx_tasks_task_status_updated_at" ON "public"."tasks" ("task_status", "updated_at");
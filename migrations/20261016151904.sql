@@ -0,0 +1,11 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "rank" text COLLATE "C" NULL;
+-- Backfill "rank" so that existing tasks keep their creation order
+UPDATE "public"."tasks" AS t SET "rank" = r."rank" FROM (
+  SELECT "id", lpad(to_hex(row_number() OVER (PARTITION BY "user_id" ORDER BY "created_at", "id")), 8, '0') || 'i' AS "rank"
+  FROM "public"."tasks"
+) AS r WHERE t."id" = r."id";
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ALTER COLUMN "rank" SET NOT NULL;
+-- Create index "idx_tasks_user_id_rank" to table: "tasks"
+CREATE INDEX "idx_tasks_user_id_rank" ON "public"."tasks" ("user_id", "rank");
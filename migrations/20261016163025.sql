@@ -0,0 +1,18 @@
+-- Add "pg_trgm" extension
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+-- Modify "completed_tasks" table
+ALTER TABLE "public"."completed_tasks" ADD COLUMN "search_vector" tsvector NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, COALESCE((title)::text, ''::text)), 'A'::"char") || setweight(to_tsvector('simple'::regconfig, COALESCE(description, ''::text)), 'B'::"char")) STORED;
+-- Create index "idx_completed_tasks_description_trgm" to table: "completed_tasks"
+CREATE INDEX "idx_completed_tasks_description_trgm" ON "public"."completed_tasks" USING gin ("description" gin_trgm_ops);
+-- Create index "idx_completed_tasks_search_vector" to table: "completed_tasks"
+CREATE INDEX "idx_completed_tasks_search_vector" ON "public"."completed_tasks" USING gin ("search_vector");
+-- Create index "idx_completed_tasks_title_trgm" to table: "completed_tasks"
+CREATE INDEX "idx_completed_tasks_title_trgm" ON "public"."completed_tasks" USING gin ("title" gin_trgm_ops);
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "search_vector" tsvector NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, COALESCE((title)::text, ''::text)), 'A'::"char") || setweight(to_tsvector('simple'::regconfig, COALESCE(description, ''::text)), 'B'::"char")) STORED;
+-- Create index "idx_tasks_description_trgm" to table: "tasks"
+CREATE INDEX "idx_tasks_description_trgm" ON "public"."tasks" USING gin ("description" gin_trgm_ops);
+-- Create index "idx_tasks_search_vector" to table: "tasks"
+CREATE INDEX "idx_tasks_search_vector" ON "public"."tasks" USING gin ("search_vector");
+-- Create index "idx_tasks_title_trgm" to table: "tasks"
+CREATE INDEX "idx_tasks_title_trgm" ON "public"."tasks" USING gin ("title" gin_trgm_ops);
@@ -0,0 +1,15 @@
+-- Modify "completed_tasks" table
+ALTER TABLE "public"."completed_tasks" ADD COLUMN "checklist" jsonb NOT NULL DEFAULT '[]';
+-- Create "task_checklist_items" table
+CREATE TABLE "public"."task_checklist_items" (
+  "id" uuid NOT NULL,
+  "task_id" uuid NOT NULL,
+  "text" character varying(500) NOT NULL,
+  "done" boolean NOT NULL DEFAULT false,
+  "position" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_task_checklist_items_task" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE CASCADE ON DELETE CASCADE
+);
+-- Create index "idx_task_checklist_items_task_id_position" to table: "task_checklist_items"
+CREATE INDEX "idx_task_checklist_items_task_id_position" ON "public"."task_checklist_items" ("task_id", "position");
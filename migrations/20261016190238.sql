@@ -0,0 +1,21 @@
+-- Create "tags" table
+CREATE TABLE "public"."tags" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "name" character varying(50) NOT NULL,
+  "color" character varying(7) NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_tags_user_id_name" to table: "tags"
+CREATE UNIQUE INDEX "idx_tags_user_id_name" ON "public"."tags" ("user_id", "name");
+-- Create "task_tags" table
+CREATE TABLE "public"."task_tags" (
+  "task_id" uuid NOT NULL,
+  "tag_id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("task_id", "tag_id"),
+  CONSTRAINT "fk_task_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "public"."tags" ("id") ON UPDATE CASCADE ON DELETE CASCADE
+);
+-- Create index "idx_task_tags_tag_id" to table: "task_tags"
+CREATE INDEX "idx_task_tags_tag_id" ON "public"."task_tags" ("tag_id");
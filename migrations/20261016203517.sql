@@ -0,0 +1,4 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "idx_tasks_deleted_at" to table: "tasks"
+CREATE INDEX "idx_tasks_deleted_at" ON "public"."tasks" ("deleted_at");
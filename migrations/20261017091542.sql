@@ -0,0 +1,24 @@
+-- Create "task_change_sequences" table
+CREATE TABLE "public"."task_change_sequences" (
+  "user_id" uuid NOT NULL,
+  "last_seq" bigint NOT NULL,
+  PRIMARY KEY ("user_id")
+);
+-- Create "task_changes" table
+CREATE TABLE "public"."task_changes" (
+  "user_id" uuid NOT NULL,
+  "task_id" uuid NOT NULL,
+  "seq" bigint NOT NULL,
+  "change_type" character varying(20) NOT NULL,
+  "changed_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user_id", "task_id")
+);
+-- Create index "idx_task_changes_user_id_seq" to table: "task_changes"
+CREATE UNIQUE INDEX "idx_task_changes_user_id_seq" ON "public"."task_changes" ("user_id", "seq");
+-- Start the change log of every user with the tasks they already have
+INSERT INTO "public"."task_changes" ("user_id", "task_id", "seq", "change_type", "changed_at")
+SELECT "user_id", "id", row_number() OVER (PARTITION BY "user_id" ORDER BY "created_at", "id"), 'upserted', now()
+FROM "public"."tasks"
+WHERE "deleted_at" IS NULL;
+INSERT INTO "public"."task_change_sequences" ("user_id", "last_seq")
+SELECT "user_id", max("seq") FROM "public"."task_changes" GROUP BY "user_id";
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
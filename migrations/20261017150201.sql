@@ -0,0 +1,9 @@
+-- Create "calendar_feed_tokens" table
+CREATE TABLE "public"."calendar_feed_tokens" (
+  "user_id" uuid NOT NULL,
+  "token_hash" character(64) NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user_id")
+);
+-- Create index "idx_calendar_feed_tokens_token_hash" to table: "calendar_feed_tokens"
+CREATE UNIQUE INDEX "idx_calendar_feed_tokens_token_hash" ON "public"."calendar_feed_tokens" ("token_hash");
@@ -0,0 +1,12 @@
+-- Create "account_deletion_jobs" table
+CREATE TABLE "public"."account_deletion_jobs" (
+  "user_id" uuid NOT NULL,
+  "status" character varying(20) NOT NULL,
+  "completed_steps" jsonb NOT NULL DEFAULT '[]',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "completed_at" timestamptz NULL,
+  PRIMARY KEY ("user_id")
+);
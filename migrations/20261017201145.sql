@@ -0,0 +1,2 @@
+-- Modify "user_period_settings" table
+ALTER TABLE "public"."user_period_settings" ADD COLUMN "reminder_intervals" jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+-- Modify "completed_tasks" table
+ALTER TABLE "public"."completed_tasks" ADD COLUMN "recurrence_rule" character varying(256) NULL, ADD COLUMN "recurrence_timezone" character varying(64) NULL, ADD COLUMN "next_occurrence_id" uuid NULL;